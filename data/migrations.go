@@ -0,0 +1,27 @@
+package data
+
+import (
+	"log/slog"
+	"time"
+	"word-flashcard/data/peers"
+)
+
+// MigrateWordReminders turns the deprecated free-text words.reminder markers
+// into due-dated reminders, due now. It only ever runs once per database; a
+// failure is logged and retried on the next start.
+func MigrateWordReminders() {
+	reminderPeer, err := peers.NewReminderPeer()
+	if err != nil {
+		slog.Error("Failed to migrate word reminders. ReminderPeer has error.", "error", err)
+		return
+	}
+
+	created, err := reminderPeer.MigrateWordReminders(time.Now().UTC())
+	if err != nil {
+		slog.Error("Failed to migrate word reminders", "error", err)
+		return
+	}
+	if created > 0 {
+		slog.Info("Migrated word reminder markers to reminders", "count", created)
+	}
+}
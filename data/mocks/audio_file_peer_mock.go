@@ -0,0 +1,167 @@
+package mocks
+
+import (
+	"word-flashcard/data/models"
+
+	"github.com/Masterminds/squirrel"
+	"github.com/stretchr/testify/mock"
+)
+
+// MockAudioFilePeer is a mock implementation for AudioFilePeer
+type MockAudioFilePeer struct {
+	mock.Mock
+}
+
+// MockAudioFilePeer_Expecter is an expecter for MockAudioFilePeer
+type MockAudioFilePeer_Expecter struct {
+	mock *mock.Mock
+}
+
+// NewMockAudioFilePeer creates a new mock AudioFilePeer instance
+func NewMockAudioFilePeer(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockAudioFilePeer {
+	mockPeer := &MockAudioFilePeer{}
+	mockPeer.Mock.Test(t)
+
+	t.Cleanup(func() { mockPeer.AssertExpectations(t) })
+
+	return mockPeer
+}
+
+func (_m *MockAudioFilePeer) EXPECT() *MockAudioFilePeer_Expecter {
+	return &MockAudioFilePeer_Expecter{mock: &_m.Mock}
+}
+
+// Select expecter method
+func (_e *MockAudioFilePeer_Expecter) Select(columns interface{}, where interface{}, orderBy interface{}, limit interface{}, offset interface{}) *mock.Call {
+	return _e.mock.On("Select", columns, where, orderBy, limit, offset)
+}
+
+// Insert expecter method
+func (_e *MockAudioFilePeer_Expecter) Insert(audioFile interface{}) *mock.Call {
+	return _e.mock.On("Insert", audioFile)
+}
+
+// Update expecter method
+func (_e *MockAudioFilePeer_Expecter) Update(audioFile interface{}, where interface{}) *mock.Call {
+	return _e.mock.On("Update", audioFile, where)
+}
+
+// Delete expecter method
+func (_e *MockAudioFilePeer_Expecter) Delete(where interface{}) *mock.Call {
+	return _e.mock.On("Delete", where)
+}
+
+// Count expecter method
+func (_e *MockAudioFilePeer_Expecter) Count(where interface{}) *mock.Call {
+	return _e.mock.On("Count", where)
+}
+
+// Select mock implementation
+func (_m *MockAudioFilePeer) Select(columns []*string, where squirrel.Sqlizer, orderBy []*string, limit *uint64, offset *uint64) ([]*models.AudioFile, error) {
+	ret := _m.Called(columns, where, orderBy, limit, offset)
+
+	var r0 []*models.AudioFile
+	if rf, ok := ret.Get(0).(func([]*string, squirrel.Sqlizer, []*string, *uint64, *uint64) []*models.AudioFile); ok {
+		r0 = rf(columns, where, orderBy, limit, offset)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*models.AudioFile)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func([]*string, squirrel.Sqlizer, []*string, *uint64, *uint64) error); ok {
+		r1 = rf(columns, where, orderBy, limit, offset)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Insert mock implementation
+func (_m *MockAudioFilePeer) Insert(audioFile *models.AudioFile) (int64, error) {
+	ret := _m.Called(audioFile)
+
+	var r0 int64
+	if rf, ok := ret.Get(0).(func(*models.AudioFile) int64); ok {
+		r0 = rf(audioFile)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*models.AudioFile) error); ok {
+		r1 = rf(audioFile)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Update mock implementation
+func (_m *MockAudioFilePeer) Update(audioFile *models.AudioFile, where squirrel.Sqlizer) (int64, error) {
+	ret := _m.Called(audioFile, where)
+
+	var r0 int64
+	if rf, ok := ret.Get(0).(func(*models.AudioFile, squirrel.Sqlizer) int64); ok {
+		r0 = rf(audioFile, where)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*models.AudioFile, squirrel.Sqlizer) error); ok {
+		r1 = rf(audioFile, where)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Delete mock implementation
+func (_m *MockAudioFilePeer) Delete(where squirrel.Sqlizer) (int64, error) {
+	ret := _m.Called(where)
+
+	var r0 int64
+	if rf, ok := ret.Get(0).(func(squirrel.Sqlizer) int64); ok {
+		r0 = rf(where)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(squirrel.Sqlizer) error); ok {
+		r1 = rf(where)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Count mock implementation
+func (_m *MockAudioFilePeer) Count(where squirrel.Sqlizer) (int64, error) {
+	ret := _m.Called(where)
+
+	var r0 int64
+	if rf, ok := ret.Get(0).(func(squirrel.Sqlizer) int64); ok {
+		r0 = rf(where)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(squirrel.Sqlizer) error); ok {
+		r1 = rf(where)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
@@ -0,0 +1,167 @@
+package mocks
+
+import (
+	"word-flashcard/data/models"
+
+	"github.com/Masterminds/squirrel"
+	"github.com/stretchr/testify/mock"
+)
+
+// MockDictionaryCachePeer is a mock implementation for DictionaryCachePeer
+type MockDictionaryCachePeer struct {
+	mock.Mock
+}
+
+// MockDictionaryCachePeer_Expecter is an expecter for MockDictionaryCachePeer
+type MockDictionaryCachePeer_Expecter struct {
+	mock *mock.Mock
+}
+
+// NewMockDictionaryCachePeer creates a new mock DictionaryCachePeer instance
+func NewMockDictionaryCachePeer(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockDictionaryCachePeer {
+	mockPeer := &MockDictionaryCachePeer{}
+	mockPeer.Mock.Test(t)
+
+	t.Cleanup(func() { mockPeer.AssertExpectations(t) })
+
+	return mockPeer
+}
+
+func (_m *MockDictionaryCachePeer) EXPECT() *MockDictionaryCachePeer_Expecter {
+	return &MockDictionaryCachePeer_Expecter{mock: &_m.Mock}
+}
+
+// Select expecter method
+func (_e *MockDictionaryCachePeer_Expecter) Select(columns interface{}, where interface{}, orderBy interface{}, limit interface{}, offset interface{}) *mock.Call {
+	return _e.mock.On("Select", columns, where, orderBy, limit, offset)
+}
+
+// Insert expecter method
+func (_e *MockDictionaryCachePeer_Expecter) Insert(entry interface{}) *mock.Call {
+	return _e.mock.On("Insert", entry)
+}
+
+// Update expecter method
+func (_e *MockDictionaryCachePeer_Expecter) Update(entry interface{}, where interface{}) *mock.Call {
+	return _e.mock.On("Update", entry, where)
+}
+
+// Delete expecter method
+func (_e *MockDictionaryCachePeer_Expecter) Delete(where interface{}) *mock.Call {
+	return _e.mock.On("Delete", where)
+}
+
+// Count expecter method
+func (_e *MockDictionaryCachePeer_Expecter) Count(where interface{}) *mock.Call {
+	return _e.mock.On("Count", where)
+}
+
+// Select mock implementation
+func (_m *MockDictionaryCachePeer) Select(columns []*string, where squirrel.Sqlizer, orderBy []*string, limit *uint64, offset *uint64) ([]*models.DictionaryCacheEntry, error) {
+	ret := _m.Called(columns, where, orderBy, limit, offset)
+
+	var r0 []*models.DictionaryCacheEntry
+	if rf, ok := ret.Get(0).(func([]*string, squirrel.Sqlizer, []*string, *uint64, *uint64) []*models.DictionaryCacheEntry); ok {
+		r0 = rf(columns, where, orderBy, limit, offset)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*models.DictionaryCacheEntry)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func([]*string, squirrel.Sqlizer, []*string, *uint64, *uint64) error); ok {
+		r1 = rf(columns, where, orderBy, limit, offset)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Insert mock implementation
+func (_m *MockDictionaryCachePeer) Insert(entry *models.DictionaryCacheEntry) (int64, error) {
+	ret := _m.Called(entry)
+
+	var r0 int64
+	if rf, ok := ret.Get(0).(func(*models.DictionaryCacheEntry) int64); ok {
+		r0 = rf(entry)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*models.DictionaryCacheEntry) error); ok {
+		r1 = rf(entry)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Update mock implementation
+func (_m *MockDictionaryCachePeer) Update(entry *models.DictionaryCacheEntry, where squirrel.Sqlizer) (int64, error) {
+	ret := _m.Called(entry, where)
+
+	var r0 int64
+	if rf, ok := ret.Get(0).(func(*models.DictionaryCacheEntry, squirrel.Sqlizer) int64); ok {
+		r0 = rf(entry, where)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*models.DictionaryCacheEntry, squirrel.Sqlizer) error); ok {
+		r1 = rf(entry, where)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Delete mock implementation
+func (_m *MockDictionaryCachePeer) Delete(where squirrel.Sqlizer) (int64, error) {
+	ret := _m.Called(where)
+
+	var r0 int64
+	if rf, ok := ret.Get(0).(func(squirrel.Sqlizer) int64); ok {
+		r0 = rf(where)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(squirrel.Sqlizer) error); ok {
+		r1 = rf(where)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Count mock implementation
+func (_m *MockDictionaryCachePeer) Count(where squirrel.Sqlizer) (int64, error) {
+	ret := _m.Called(where)
+
+	var r0 int64
+	if rf, ok := ret.Get(0).(func(squirrel.Sqlizer) int64); ok {
+		r0 = rf(where)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(squirrel.Sqlizer) error); ok {
+		r1 = rf(where)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
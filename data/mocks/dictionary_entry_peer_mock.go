@@ -0,0 +1,186 @@
+package mocks
+
+import (
+	"word-flashcard/data/models"
+
+	"github.com/Masterminds/squirrel"
+	"github.com/stretchr/testify/mock"
+)
+
+// MockDictionaryEntryPeer is a mock implementation for DictionaryEntryPeer
+type MockDictionaryEntryPeer struct {
+	mock.Mock
+}
+
+// MockDictionaryEntryPeer_Expecter is an expecter for MockDictionaryEntryPeer
+type MockDictionaryEntryPeer_Expecter struct {
+	mock *mock.Mock
+}
+
+// NewMockDictionaryEntryPeer creates a new mock DictionaryEntryPeer instance
+func NewMockDictionaryEntryPeer(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockDictionaryEntryPeer {
+	mockPeer := &MockDictionaryEntryPeer{}
+	mockPeer.Mock.Test(t)
+
+	t.Cleanup(func() { mockPeer.AssertExpectations(t) })
+
+	return mockPeer
+}
+
+func (_m *MockDictionaryEntryPeer) EXPECT() *MockDictionaryEntryPeer_Expecter {
+	return &MockDictionaryEntryPeer_Expecter{mock: &_m.Mock}
+}
+
+// Select expecter method
+func (_e *MockDictionaryEntryPeer_Expecter) Select(columns interface{}, where interface{}, orderBy interface{}, limit interface{}, offset interface{}) *mock.Call {
+	return _e.mock.On("Select", columns, where, orderBy, limit, offset)
+}
+
+// Insert expecter method
+func (_e *MockDictionaryEntryPeer_Expecter) Insert(entry interface{}) *mock.Call {
+	return _e.mock.On("Insert", entry)
+}
+
+// Update expecter method
+func (_e *MockDictionaryEntryPeer_Expecter) Update(entry interface{}, where interface{}) *mock.Call {
+	return _e.mock.On("Update", entry, where)
+}
+
+// Delete expecter method
+func (_e *MockDictionaryEntryPeer_Expecter) Delete(where interface{}) *mock.Call {
+	return _e.mock.On("Delete", where)
+}
+
+// InsertBatch expecter method
+func (_e *MockDictionaryEntryPeer_Expecter) InsertBatch(entries interface{}) *mock.Call {
+	return _e.mock.On("InsertBatch", entries)
+}
+
+// ReplaceSource expecter method
+func (_e *MockDictionaryEntryPeer_Expecter) ReplaceSource(staging interface{}, source interface{}, language interface{}) *mock.Call {
+	return _e.mock.On("ReplaceSource", staging, source, language)
+}
+
+// Select mock implementation
+func (_m *MockDictionaryEntryPeer) Select(columns []*string, where squirrel.Sqlizer, orderBy []*string, limit *uint64, offset *uint64) ([]*models.DictionaryEntry, error) {
+	ret := _m.Called(columns, where, orderBy, limit, offset)
+
+	var r0 []*models.DictionaryEntry
+	if rf, ok := ret.Get(0).(func([]*string, squirrel.Sqlizer, []*string, *uint64, *uint64) []*models.DictionaryEntry); ok {
+		r0 = rf(columns, where, orderBy, limit, offset)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*models.DictionaryEntry)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func([]*string, squirrel.Sqlizer, []*string, *uint64, *uint64) error); ok {
+		r1 = rf(columns, where, orderBy, limit, offset)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Insert mock implementation
+func (_m *MockDictionaryEntryPeer) Insert(entry *models.DictionaryEntry) (int64, error) {
+	ret := _m.Called(entry)
+
+	var r0 int64
+	if rf, ok := ret.Get(0).(func(*models.DictionaryEntry) int64); ok {
+		r0 = rf(entry)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*models.DictionaryEntry) error); ok {
+		r1 = rf(entry)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Update mock implementation
+func (_m *MockDictionaryEntryPeer) Update(entry *models.DictionaryEntry, where squirrel.Sqlizer) (int64, error) {
+	ret := _m.Called(entry, where)
+
+	var r0 int64
+	if rf, ok := ret.Get(0).(func(*models.DictionaryEntry, squirrel.Sqlizer) int64); ok {
+		r0 = rf(entry, where)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*models.DictionaryEntry, squirrel.Sqlizer) error); ok {
+		r1 = rf(entry, where)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Delete mock implementation
+func (_m *MockDictionaryEntryPeer) Delete(where squirrel.Sqlizer) (int64, error) {
+	ret := _m.Called(where)
+
+	var r0 int64
+	if rf, ok := ret.Get(0).(func(squirrel.Sqlizer) int64); ok {
+		r0 = rf(where)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(squirrel.Sqlizer) error); ok {
+		r1 = rf(where)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// InsertBatch mock implementation
+func (_m *MockDictionaryEntryPeer) InsertBatch(entries []*models.DictionaryEntry) error {
+	ret := _m.Called(entries)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func([]*models.DictionaryEntry) error); ok {
+		r0 = rf(entries)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// ReplaceSource mock implementation
+func (_m *MockDictionaryEntryPeer) ReplaceSource(staging string, source string, language string) (int64, error) {
+	ret := _m.Called(staging, source, language)
+
+	var r0 int64
+	if rf, ok := ret.Get(0).(func(string, string, string) int64); ok {
+		r0 = rf(staging, source, language)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(string, string, string) error); ok {
+		r1 = rf(staging, source, language)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
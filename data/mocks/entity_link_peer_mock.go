@@ -0,0 +1,141 @@
+package mocks
+
+import (
+	"word-flashcard/data/models"
+
+	"github.com/Masterminds/squirrel"
+	"github.com/stretchr/testify/mock"
+)
+
+// MockEntityLinkPeer is a mock implementation for EntityLinkPeer
+type MockEntityLinkPeer struct {
+	mock.Mock
+}
+
+// MockEntityLinkPeer_Expecter is an expecter for MockEntityLinkPeer
+type MockEntityLinkPeer_Expecter struct {
+	mock *mock.Mock
+}
+
+// NewMockEntityLinkPeer creates a new mock EntityLinkPeer instance
+func NewMockEntityLinkPeer(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockEntityLinkPeer {
+	mockPeer := &MockEntityLinkPeer{}
+	mockPeer.Mock.Test(t)
+
+	t.Cleanup(func() { mockPeer.AssertExpectations(t) })
+
+	return mockPeer
+}
+
+func (_m *MockEntityLinkPeer) EXPECT() *MockEntityLinkPeer_Expecter {
+	return &MockEntityLinkPeer_Expecter{mock: &_m.Mock}
+}
+
+// Select expecter method
+func (_e *MockEntityLinkPeer_Expecter) Select(columns interface{}, where interface{}, orderBy interface{}, limit interface{}, offset interface{}) *mock.Call {
+	return _e.mock.On("Select", columns, where, orderBy, limit, offset)
+}
+
+// Insert expecter method
+func (_e *MockEntityLinkPeer_Expecter) Insert(link interface{}) *mock.Call {
+	return _e.mock.On("Insert", link)
+}
+
+// Update expecter method
+func (_e *MockEntityLinkPeer_Expecter) Update(link interface{}, where interface{}) *mock.Call {
+	return _e.mock.On("Update", link, where)
+}
+
+// Delete expecter method
+func (_e *MockEntityLinkPeer_Expecter) Delete(where interface{}) *mock.Call {
+	return _e.mock.On("Delete", where)
+}
+
+// Select mock implementation
+func (_m *MockEntityLinkPeer) Select(columns []*string, where squirrel.Sqlizer, orderBy []*string, limit *uint64, offset *uint64) ([]*models.EntityLink, error) {
+	ret := _m.Called(columns, where, orderBy, limit, offset)
+
+	var r0 []*models.EntityLink
+	if rf, ok := ret.Get(0).(func([]*string, squirrel.Sqlizer, []*string, *uint64, *uint64) []*models.EntityLink); ok {
+		r0 = rf(columns, where, orderBy, limit, offset)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*models.EntityLink)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func([]*string, squirrel.Sqlizer, []*string, *uint64, *uint64) error); ok {
+		r1 = rf(columns, where, orderBy, limit, offset)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Insert mock implementation
+func (_m *MockEntityLinkPeer) Insert(link *models.EntityLink) (int64, error) {
+	ret := _m.Called(link)
+
+	var r0 int64
+	if rf, ok := ret.Get(0).(func(*models.EntityLink) int64); ok {
+		r0 = rf(link)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*models.EntityLink) error); ok {
+		r1 = rf(link)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Update mock implementation
+func (_m *MockEntityLinkPeer) Update(link *models.EntityLink, where squirrel.Sqlizer) (int64, error) {
+	ret := _m.Called(link, where)
+
+	var r0 int64
+	if rf, ok := ret.Get(0).(func(*models.EntityLink, squirrel.Sqlizer) int64); ok {
+		r0 = rf(link, where)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*models.EntityLink, squirrel.Sqlizer) error); ok {
+		r1 = rf(link, where)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Delete mock implementation
+func (_m *MockEntityLinkPeer) Delete(where squirrel.Sqlizer) (int64, error) {
+	ret := _m.Called(where)
+
+	var r0 int64
+	if rf, ok := ret.Get(0).(func(squirrel.Sqlizer) int64); ok {
+		r0 = rf(where)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(squirrel.Sqlizer) error); ok {
+		r1 = rf(where)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
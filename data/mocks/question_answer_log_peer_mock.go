@@ -1,6 +1,8 @@
 package mocks
 
 import (
+	"time"
+
 	"word-flashcard/data/models"
 
 	"github.com/Masterminds/squirrel"
@@ -44,6 +46,16 @@ func (_e *MockQuestionAnswerLogPeer_Expecter) Insert(log interface{}) *mock.Call
 	return _e.mock.On("Insert", log)
 }
 
+// Count expecter method
+func (_e *MockQuestionAnswerLogPeer_Expecter) Count(where interface{}) *mock.Call {
+	return _e.mock.On("Count", where)
+}
+
+// DailyStats expecter method
+func (_e *MockQuestionAnswerLogPeer_Expecter) DailyStats(since interface{}, loc interface{}) *mock.Call {
+	return _e.mock.On("DailyStats", since, loc)
+}
+
 // Select mock implementation
 func (_m *MockQuestionAnswerLogPeer) Select(columns []*string, where squirrel.Sqlizer, orderBy []*string, limit *uint64, offset *uint64) ([]*models.QuestionAnswerLog, error) {
 	ret := _m.Called(columns, where, orderBy, limit, offset)
@@ -87,3 +99,47 @@ func (_m *MockQuestionAnswerLogPeer) Insert(log *models.QuestionAnswerLog) (int6
 
 	return r0, r1
 }
+
+// DailyStats mock implementation
+func (_m *MockQuestionAnswerLogPeer) DailyStats(since time.Time, loc *time.Location) ([]*models.QuestionAnswerLogDailyStats, error) {
+	ret := _m.Called(since, loc)
+
+	var r0 []*models.QuestionAnswerLogDailyStats
+	if rf, ok := ret.Get(0).(func(time.Time, *time.Location) []*models.QuestionAnswerLogDailyStats); ok {
+		r0 = rf(since, loc)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*models.QuestionAnswerLogDailyStats)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(time.Time, *time.Location) error); ok {
+		r1 = rf(since, loc)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Count mock implementation
+func (_m *MockQuestionAnswerLogPeer) Count(where squirrel.Sqlizer) (int64, error) {
+	ret := _m.Called(where)
+
+	var r0 int64
+	if rf, ok := ret.Get(0).(func(squirrel.Sqlizer) int64); ok {
+		r0 = rf(where)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(squirrel.Sqlizer) error); ok {
+		r1 = rf(where)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
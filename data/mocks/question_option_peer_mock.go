@@ -0,0 +1,141 @@
+package mocks
+
+import (
+	"word-flashcard/data/models"
+
+	"github.com/Masterminds/squirrel"
+	"github.com/stretchr/testify/mock"
+)
+
+// MockQuestionOptionPeer is a mock implementation for QuestionOptionPeer
+type MockQuestionOptionPeer struct {
+	mock.Mock
+}
+
+// MockQuestionOptionPeer_Expecter is an expecter for MockQuestionOptionPeer
+type MockQuestionOptionPeer_Expecter struct {
+	mock *mock.Mock
+}
+
+// NewMockQuestionOptionPeer creates a new mock QuestionOptionPeer instance
+func NewMockQuestionOptionPeer(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockQuestionOptionPeer {
+	mockPeer := &MockQuestionOptionPeer{}
+	mockPeer.Mock.Test(t)
+
+	t.Cleanup(func() { mockPeer.AssertExpectations(t) })
+
+	return mockPeer
+}
+
+func (_m *MockQuestionOptionPeer) EXPECT() *MockQuestionOptionPeer_Expecter {
+	return &MockQuestionOptionPeer_Expecter{mock: &_m.Mock}
+}
+
+// Select expecter method
+func (_e *MockQuestionOptionPeer_Expecter) Select(columns interface{}, where interface{}, orderBy interface{}, limit interface{}, offset interface{}) *mock.Call {
+	return _e.mock.On("Select", columns, where, orderBy, limit, offset)
+}
+
+// Insert expecter method
+func (_e *MockQuestionOptionPeer_Expecter) Insert(option interface{}) *mock.Call {
+	return _e.mock.On("Insert", option)
+}
+
+// Update expecter method
+func (_e *MockQuestionOptionPeer_Expecter) Update(option interface{}, where interface{}) *mock.Call {
+	return _e.mock.On("Update", option, where)
+}
+
+// Delete expecter method
+func (_e *MockQuestionOptionPeer_Expecter) Delete(where interface{}) *mock.Call {
+	return _e.mock.On("Delete", where)
+}
+
+// Select mock implementation
+func (_m *MockQuestionOptionPeer) Select(columns []*string, where squirrel.Sqlizer, orderBy []*string, limit *uint64, offset *uint64) ([]*models.QuestionOption, error) {
+	ret := _m.Called(columns, where, orderBy, limit, offset)
+
+	var r0 []*models.QuestionOption
+	if rf, ok := ret.Get(0).(func([]*string, squirrel.Sqlizer, []*string, *uint64, *uint64) []*models.QuestionOption); ok {
+		r0 = rf(columns, where, orderBy, limit, offset)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*models.QuestionOption)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func([]*string, squirrel.Sqlizer, []*string, *uint64, *uint64) error); ok {
+		r1 = rf(columns, where, orderBy, limit, offset)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Insert mock implementation
+func (_m *MockQuestionOptionPeer) Insert(option *models.QuestionOption) (int64, error) {
+	ret := _m.Called(option)
+
+	var r0 int64
+	if rf, ok := ret.Get(0).(func(*models.QuestionOption) int64); ok {
+		r0 = rf(option)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*models.QuestionOption) error); ok {
+		r1 = rf(option)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Update mock implementation
+func (_m *MockQuestionOptionPeer) Update(option *models.QuestionOption, where squirrel.Sqlizer) (int64, error) {
+	ret := _m.Called(option, where)
+
+	var r0 int64
+	if rf, ok := ret.Get(0).(func(*models.QuestionOption, squirrel.Sqlizer) int64); ok {
+		r0 = rf(option, where)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*models.QuestionOption, squirrel.Sqlizer) error); ok {
+		r1 = rf(option, where)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Delete mock implementation
+func (_m *MockQuestionOptionPeer) Delete(where squirrel.Sqlizer) (int64, error) {
+	ret := _m.Called(where)
+
+	var r0 int64
+	if rf, ok := ret.Get(0).(func(squirrel.Sqlizer) int64); ok {
+		r0 = rf(where)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(squirrel.Sqlizer) error); ok {
+		r1 = rf(where)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
@@ -2,6 +2,7 @@ package mocks
 
 import (
 	"word-flashcard/data/models"
+	"word-flashcard/data/peers"
 
 	"github.com/Masterminds/squirrel"
 	"github.com/stretchr/testify/mock"
@@ -44,11 +45,21 @@ func (_e *MockQuestionPeer_Expecter) Insert(question interface{}) *mock.Call {
 	return _e.mock.On("Insert", question)
 }
 
+// InsertWithOptions expecter method
+func (_e *MockQuestionPeer_Expecter) InsertWithOptions(questions interface{}) *mock.Call {
+	return _e.mock.On("InsertWithOptions", questions)
+}
+
 // Update expecter method
 func (_e *MockQuestionPeer_Expecter) Update(question interface{}, where interface{}) *mock.Call {
 	return _e.mock.On("Update", question, where)
 }
 
+// UpdateWithOptions expecter method
+func (_e *MockQuestionPeer_Expecter) UpdateWithOptions(questionID interface{}, question interface{}, options interface{}) *mock.Call {
+	return _e.mock.On("UpdateWithOptions", questionID, question, options)
+}
+
 // Delete expecter method
 func (_e *MockQuestionPeer_Expecter) Delete(where interface{}) *mock.Call {
 	return _e.mock.On("Delete", where)
@@ -59,6 +70,11 @@ func (_e *MockQuestionPeer_Expecter) Count() *mock.Call {
 	return _e.mock.On("Count")
 }
 
+// CountByPractise expecter method
+func (_e *MockQuestionPeer_Expecter) CountByPractise() *mock.Call {
+	return _e.mock.On("CountByPractise")
+}
+
 // Select mock implementation
 func (_m *MockQuestionPeer) Select(columns []*string, where squirrel.Sqlizer, orderBy []*string, limit *uint64, offset *uint64) ([]*models.Question, error) {
 	ret := _m.Called(columns, where, orderBy, limit, offset)
@@ -103,6 +119,29 @@ func (_m *MockQuestionPeer) Insert(question *models.Question) (int64, error) {
 	return r0, r1
 }
 
+// InsertWithOptions mock implementation
+func (_m *MockQuestionPeer) InsertWithOptions(questions []*peers.QuestionWithOptions) ([]int64, error) {
+	ret := _m.Called(questions)
+
+	var r0 []int64
+	if rf, ok := ret.Get(0).(func([]*peers.QuestionWithOptions) []int64); ok {
+		r0 = rf(questions)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]int64)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func([]*peers.QuestionWithOptions) error); ok {
+		r1 = rf(questions)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // Update mock implementation
 func (_m *MockQuestionPeer) Update(question *models.Question, where squirrel.Sqlizer) (int64, error) {
 	ret := _m.Called(question, where)
@@ -124,6 +163,27 @@ func (_m *MockQuestionPeer) Update(question *models.Question, where squirrel.Sql
 	return r0, r1
 }
 
+// UpdateWithOptions mock implementation
+func (_m *MockQuestionPeer) UpdateWithOptions(questionID int, question *models.Question, options []*models.QuestionOption) (int64, error) {
+	ret := _m.Called(questionID, question, options)
+
+	var r0 int64
+	if rf, ok := ret.Get(0).(func(int, *models.Question, []*models.QuestionOption) int64); ok {
+		r0 = rf(questionID, question, options)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(int, *models.Question, []*models.QuestionOption) error); ok {
+		r1 = rf(questionID, question, options)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // Delete mock implementation
 func (_m *MockQuestionPeer) Delete(where squirrel.Sqlizer) (int64, error) {
 	ret := _m.Called(where)
@@ -165,3 +225,26 @@ func (_m *MockQuestionPeer) Count() (int64, error) {
 
 	return r0, r1
 }
+
+// CountByPractise mock implementation
+func (_m *MockQuestionPeer) CountByPractise() ([]*models.QuestionPractiseGroup, error) {
+	ret := _m.Called()
+
+	var r0 []*models.QuestionPractiseGroup
+	if rf, ok := ret.Get(0).(func() []*models.QuestionPractiseGroup); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*models.QuestionPractiseGroup)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func() error); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
@@ -0,0 +1,89 @@
+package mocks
+
+import (
+	"word-flashcard/data/models"
+
+	"github.com/Masterminds/squirrel"
+	"github.com/stretchr/testify/mock"
+)
+
+// MockReminderLogPeer is a mock implementation for ReminderLogPeer
+type MockReminderLogPeer struct {
+	mock.Mock
+}
+
+// MockReminderLogPeer_Expecter is an expecter for MockReminderLogPeer
+type MockReminderLogPeer_Expecter struct {
+	mock *mock.Mock
+}
+
+// NewMockReminderLogPeer creates a new mock ReminderLogPeer instance
+func NewMockReminderLogPeer(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockReminderLogPeer {
+	mockPeer := &MockReminderLogPeer{}
+	mockPeer.Mock.Test(t)
+
+	t.Cleanup(func() { mockPeer.AssertExpectations(t) })
+
+	return mockPeer
+}
+
+func (_m *MockReminderLogPeer) EXPECT() *MockReminderLogPeer_Expecter {
+	return &MockReminderLogPeer_Expecter{mock: &_m.Mock}
+}
+
+// Select expecter method
+func (_e *MockReminderLogPeer_Expecter) Select(columns interface{}, where interface{}, orderBy interface{}, limit interface{}, offset interface{}) *mock.Call {
+	return _e.mock.On("Select", columns, where, orderBy, limit, offset)
+}
+
+// Insert expecter method
+func (_e *MockReminderLogPeer_Expecter) Insert(reminderLog interface{}) *mock.Call {
+	return _e.mock.On("Insert", reminderLog)
+}
+
+// Select mock implementation
+func (_m *MockReminderLogPeer) Select(columns []*string, where squirrel.Sqlizer, orderBy []*string, limit *uint64, offset *uint64) ([]*models.ReminderLog, error) {
+	ret := _m.Called(columns, where, orderBy, limit, offset)
+
+	var r0 []*models.ReminderLog
+	if rf, ok := ret.Get(0).(func([]*string, squirrel.Sqlizer, []*string, *uint64, *uint64) []*models.ReminderLog); ok {
+		r0 = rf(columns, where, orderBy, limit, offset)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*models.ReminderLog)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func([]*string, squirrel.Sqlizer, []*string, *uint64, *uint64) error); ok {
+		r1 = rf(columns, where, orderBy, limit, offset)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Insert mock implementation
+func (_m *MockReminderLogPeer) Insert(reminderLog *models.ReminderLog) (int64, error) {
+	ret := _m.Called(reminderLog)
+
+	var r0 int64
+	if rf, ok := ret.Get(0).(func(*models.ReminderLog) int64); ok {
+		r0 = rf(reminderLog)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*models.ReminderLog) error); ok {
+		r1 = rf(reminderLog)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
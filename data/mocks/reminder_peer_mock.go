@@ -0,0 +1,193 @@
+package mocks
+
+import (
+	"word-flashcard/data/models"
+
+	"github.com/Masterminds/squirrel"
+	"github.com/stretchr/testify/mock"
+)
+
+// MockReminderPeer is a mock implementation for ReminderPeer
+type MockReminderPeer struct {
+	mock.Mock
+}
+
+// MockReminderPeer_Expecter is an expecter for MockReminderPeer
+type MockReminderPeer_Expecter struct {
+	mock *mock.Mock
+}
+
+// NewMockReminderPeer creates a new mock ReminderPeer instance
+func NewMockReminderPeer(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockReminderPeer {
+	mockPeer := &MockReminderPeer{}
+	mockPeer.Mock.Test(t)
+
+	t.Cleanup(func() { mockPeer.AssertExpectations(t) })
+
+	return mockPeer
+}
+
+func (_m *MockReminderPeer) EXPECT() *MockReminderPeer_Expecter {
+	return &MockReminderPeer_Expecter{mock: &_m.Mock}
+}
+
+// Select expecter method
+func (_e *MockReminderPeer_Expecter) Select(columns interface{}, where interface{}, orderBy interface{}, limit interface{}, offset interface{}) *mock.Call {
+	return _e.mock.On("Select", columns, where, orderBy, limit, offset)
+}
+
+// Insert expecter method
+func (_e *MockReminderPeer_Expecter) Insert(reminder interface{}) *mock.Call {
+	return _e.mock.On("Insert", reminder)
+}
+
+// Update expecter method
+func (_e *MockReminderPeer_Expecter) Update(reminder interface{}, where interface{}) *mock.Call {
+	return _e.mock.On("Update", reminder, where)
+}
+
+// Delete expecter method
+func (_e *MockReminderPeer_Expecter) Delete(where interface{}) *mock.Call {
+	return _e.mock.On("Delete", where)
+}
+
+// Clear expecter method
+func (_e *MockReminderPeer_Expecter) Clear(reminder interface{}, next interface{}) *mock.Call {
+	return _e.mock.On("Clear", reminder, next)
+}
+
+// DeleteWithHistory expecter method
+func (_e *MockReminderPeer_Expecter) DeleteWithHistory(where interface{}) *mock.Call {
+	return _e.mock.On("DeleteWithHistory", where)
+}
+
+// Select mock implementation
+func (_m *MockReminderPeer) Select(columns []*string, where squirrel.Sqlizer, orderBy []*string, limit *uint64, offset *uint64) ([]*models.Reminder, error) {
+	ret := _m.Called(columns, where, orderBy, limit, offset)
+
+	var r0 []*models.Reminder
+	if rf, ok := ret.Get(0).(func([]*string, squirrel.Sqlizer, []*string, *uint64, *uint64) []*models.Reminder); ok {
+		r0 = rf(columns, where, orderBy, limit, offset)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*models.Reminder)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func([]*string, squirrel.Sqlizer, []*string, *uint64, *uint64) error); ok {
+		r1 = rf(columns, where, orderBy, limit, offset)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Insert mock implementation
+func (_m *MockReminderPeer) Insert(reminder *models.Reminder) (int64, error) {
+	ret := _m.Called(reminder)
+
+	var r0 int64
+	if rf, ok := ret.Get(0).(func(*models.Reminder) int64); ok {
+		r0 = rf(reminder)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*models.Reminder) error); ok {
+		r1 = rf(reminder)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Update mock implementation
+func (_m *MockReminderPeer) Update(reminder *models.Reminder, where squirrel.Sqlizer) (int64, error) {
+	ret := _m.Called(reminder, where)
+
+	var r0 int64
+	if rf, ok := ret.Get(0).(func(*models.Reminder, squirrel.Sqlizer) int64); ok {
+		r0 = rf(reminder, where)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*models.Reminder, squirrel.Sqlizer) error); ok {
+		r1 = rf(reminder, where)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Delete mock implementation
+func (_m *MockReminderPeer) Delete(where squirrel.Sqlizer) (int64, error) {
+	ret := _m.Called(where)
+
+	var r0 int64
+	if rf, ok := ret.Get(0).(func(squirrel.Sqlizer) int64); ok {
+		r0 = rf(where)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(squirrel.Sqlizer) error); ok {
+		r1 = rf(where)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Clear mock implementation
+func (_m *MockReminderPeer) Clear(reminder *models.Reminder, next *models.Reminder) (*models.ReminderLog, error) {
+	ret := _m.Called(reminder, next)
+
+	var r0 *models.ReminderLog
+	if rf, ok := ret.Get(0).(func(*models.Reminder, *models.Reminder) *models.ReminderLog); ok {
+		r0 = rf(reminder, next)
+	} else if ret.Get(0) != nil {
+		r0 = ret.Get(0).(*models.ReminderLog)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*models.Reminder, *models.Reminder) error); ok {
+		r1 = rf(reminder, next)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DeleteWithHistory mock implementation
+func (_m *MockReminderPeer) DeleteWithHistory(where squirrel.Sqlizer) (int64, error) {
+	ret := _m.Called(where)
+
+	var r0 int64
+	if rf, ok := ret.Get(0).(func(squirrel.Sqlizer) int64); ok {
+		r0 = rf(where)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(squirrel.Sqlizer) error); ok {
+		r1 = rf(where)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
@@ -0,0 +1,115 @@
+package mocks
+
+import (
+	"word-flashcard/data/models"
+
+	"github.com/Masterminds/squirrel"
+	"github.com/stretchr/testify/mock"
+)
+
+// MockSettingPeer is a mock implementation for SettingPeer
+type MockSettingPeer struct {
+	mock.Mock
+}
+
+// MockSettingPeer_Expecter is an expecter for MockSettingPeer
+type MockSettingPeer_Expecter struct {
+	mock *mock.Mock
+}
+
+// NewMockSettingPeer creates a new mock SettingPeer instance
+func NewMockSettingPeer(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockSettingPeer {
+	mockPeer := &MockSettingPeer{}
+	mockPeer.Mock.Test(t)
+
+	t.Cleanup(func() { mockPeer.AssertExpectations(t) })
+
+	return mockPeer
+}
+
+func (_m *MockSettingPeer) EXPECT() *MockSettingPeer_Expecter {
+	return &MockSettingPeer_Expecter{mock: &_m.Mock}
+}
+
+// Select expecter method
+func (_e *MockSettingPeer_Expecter) Select(columns interface{}, where interface{}, orderBy interface{}, limit interface{}, offset interface{}) *mock.Call {
+	return _e.mock.On("Select", columns, where, orderBy, limit, offset)
+}
+
+// Insert expecter method
+func (_e *MockSettingPeer_Expecter) Insert(setting interface{}) *mock.Call {
+	return _e.mock.On("Insert", setting)
+}
+
+// Update expecter method
+func (_e *MockSettingPeer_Expecter) Update(setting interface{}, where interface{}) *mock.Call {
+	return _e.mock.On("Update", setting, where)
+}
+
+// Select mock implementation
+func (_m *MockSettingPeer) Select(columns []*string, where squirrel.Sqlizer, orderBy []*string, limit *uint64, offset *uint64) ([]*models.Setting, error) {
+	ret := _m.Called(columns, where, orderBy, limit, offset)
+
+	var r0 []*models.Setting
+	if rf, ok := ret.Get(0).(func([]*string, squirrel.Sqlizer, []*string, *uint64, *uint64) []*models.Setting); ok {
+		r0 = rf(columns, where, orderBy, limit, offset)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*models.Setting)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func([]*string, squirrel.Sqlizer, []*string, *uint64, *uint64) error); ok {
+		r1 = rf(columns, where, orderBy, limit, offset)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Insert mock implementation
+func (_m *MockSettingPeer) Insert(setting *models.Setting) (int64, error) {
+	ret := _m.Called(setting)
+
+	var r0 int64
+	if rf, ok := ret.Get(0).(func(*models.Setting) int64); ok {
+		r0 = rf(setting)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*models.Setting) error); ok {
+		r1 = rf(setting)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Update mock implementation
+func (_m *MockSettingPeer) Update(setting *models.Setting, where squirrel.Sqlizer) (int64, error) {
+	ret := _m.Called(setting, where)
+
+	var r0 int64
+	if rf, ok := ret.Get(0).(func(*models.Setting, squirrel.Sqlizer) int64); ok {
+		r0 = rf(setting, where)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*models.Setting, squirrel.Sqlizer) error); ok {
+		r1 = rf(setting, where)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
@@ -2,6 +2,7 @@ package mocks
 
 import (
 	"word-flashcard/data/models"
+	"word-flashcard/data/peers"
 
 	"github.com/Masterminds/squirrel"
 	"github.com/stretchr/testify/mock"
@@ -59,6 +60,21 @@ func (_e *MockWordPeer_Expecter) Count(where interface{}) *mock.Call {
 	return _e.mock.On("Count", where)
 }
 
+// SaveWithDefinitions expecter method
+func (_e *MockWordPeer_Expecter) SaveWithDefinitions(word interface{}, definitions interface{}) *mock.Call {
+	return _e.mock.On("SaveWithDefinitions", word, definitions)
+}
+
+// MergeWords expecter method
+func (_e *MockWordPeer_Expecter) MergeWords(targetID interface{}, sourceIDs interface{}) *mock.Call {
+	return _e.mock.On("MergeWords", targetID, sourceIDs)
+}
+
+// CountByPractiseCount expecter method
+func (_e *MockWordPeer_Expecter) CountByPractiseCount() *mock.Call {
+	return _e.mock.On("CountByPractiseCount")
+}
+
 // Select mock implementation
 func (_m *MockWordPeer) Select(columns []*string, where squirrel.Sqlizer, orderBy []*string, limit *uint64, offset *uint64) ([]*models.Word, error) {
 	ret := _m.Called(columns, where, orderBy, limit, offset)
@@ -165,3 +181,72 @@ func (_m *MockWordPeer) Count(where squirrel.Sqlizer) (int64, error) {
 
 	return r0, r1
 }
+
+// SaveWithDefinitions mock implementation
+func (_m *MockWordPeer) SaveWithDefinitions(word *models.Word, definitions []*models.WordDefinition) (*peers.SavedWord, error) {
+	ret := _m.Called(word, definitions)
+
+	var r0 *peers.SavedWord
+	if rf, ok := ret.Get(0).(func(*models.Word, []*models.WordDefinition) *peers.SavedWord); ok {
+		r0 = rf(word, definitions)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*peers.SavedWord)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*models.Word, []*models.WordDefinition) error); ok {
+		r1 = rf(word, definitions)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MergeWords mock implementation
+func (_m *MockWordPeer) MergeWords(targetID int64, sourceIDs []int64) (*peers.MergedWord, error) {
+	ret := _m.Called(targetID, sourceIDs)
+
+	var r0 *peers.MergedWord
+	if rf, ok := ret.Get(0).(func(int64, []int64) *peers.MergedWord); ok {
+		r0 = rf(targetID, sourceIDs)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*peers.MergedWord)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(int64, []int64) error); ok {
+		r1 = rf(targetID, sourceIDs)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// CountByPractiseCount mock implementation
+func (_m *MockWordPeer) CountByPractiseCount() ([]*models.WordPractiseCountGroup, error) {
+	ret := _m.Called()
+
+	var r0 []*models.WordPractiseCountGroup
+	if rf, ok := ret.Get(0).(func() []*models.WordPractiseCountGroup); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*models.WordPractiseCountGroup)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func() error); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
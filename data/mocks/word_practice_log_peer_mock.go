@@ -1,6 +1,8 @@
 package mocks
 
 import (
+	"time"
+
 	"word-flashcard/data/models"
 
 	"github.com/Masterminds/squirrel"
@@ -49,6 +51,21 @@ func (_e *MockWordPracticeLogPeer_Expecter) Update(log interface{}, where interf
 	return _e.mock.On("Update", log, where)
 }
 
+// Count expecter method
+func (_e *MockWordPracticeLogPeer_Expecter) Count(where interface{}) *mock.Call {
+	return _e.mock.On("Count", where)
+}
+
+// DailyStats expecter method
+func (_e *MockWordPracticeLogPeer_Expecter) DailyStats(since interface{}, loc interface{}) *mock.Call {
+	return _e.mock.On("DailyStats", since, loc)
+}
+
+// TransitionCounts expecter method
+func (_e *MockWordPracticeLogPeer_Expecter) TransitionCounts(since interface{}) *mock.Call {
+	return _e.mock.On("TransitionCounts", since)
+}
+
 // Select mock implementation
 func (_m *MockWordPracticeLogPeer) Select(columns []*string, where squirrel.Sqlizer, orderBy []*string, limit *uint64, offset *uint64) ([]*models.WordPracticeLog, error) {
 	ret := _m.Called(columns, where, orderBy, limit, offset)
@@ -113,3 +130,70 @@ func (_m *MockWordPracticeLogPeer) Update(log *models.WordPracticeLog, where squ
 
 	return r0, r1
 }
+
+// DailyStats mock implementation
+func (_m *MockWordPracticeLogPeer) DailyStats(since time.Time, loc *time.Location) ([]*models.WordPracticeLogDailyStats, error) {
+	ret := _m.Called(since, loc)
+
+	var r0 []*models.WordPracticeLogDailyStats
+	if rf, ok := ret.Get(0).(func(time.Time, *time.Location) []*models.WordPracticeLogDailyStats); ok {
+		r0 = rf(since, loc)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*models.WordPracticeLogDailyStats)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(time.Time, *time.Location) error); ok {
+		r1 = rf(since, loc)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// TransitionCounts mock implementation
+func (_m *MockWordPracticeLogPeer) TransitionCounts(since time.Time) ([]*models.WordFamiliarityTransitionCount, error) {
+	ret := _m.Called(since)
+
+	var r0 []*models.WordFamiliarityTransitionCount
+	if rf, ok := ret.Get(0).(func(time.Time) []*models.WordFamiliarityTransitionCount); ok {
+		r0 = rf(since)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*models.WordFamiliarityTransitionCount)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(time.Time) error); ok {
+		r1 = rf(since)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Count mock implementation
+func (_m *MockWordPracticeLogPeer) Count(where squirrel.Sqlizer) (int64, error) {
+	ret := _m.Called(where)
+
+	var r0 int64
+	if rf, ok := ret.Get(0).(func(squirrel.Sqlizer) int64); ok {
+		r0 = rf(where)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(squirrel.Sqlizer) error); ok {
+		r1 = rf(where)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
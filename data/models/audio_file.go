@@ -0,0 +1,16 @@
+package models
+
+import "time"
+
+// AudioFile represents one locally stored pronunciation recording
+type AudioFile struct {
+	Id          *int       `db:"id" json:"id"`
+	SourceUrl   *string    `db:"source_url" json:"source_url"`
+	SourceHash  *string    `db:"source_hash" json:"source_hash"`
+	ContentType *string    `db:"content_type" json:"content_type"`
+	Size        *int       `db:"size" json:"size"`
+	Checksum    *string    `db:"checksum" json:"checksum"`
+	FileName    *string    `db:"file_name" json:"file_name"`
+	CreatedAt   *time.Time `db:"created_at" json:"created_at"`
+	UpdatedAt   *time.Time `db:"updated_at" json:"updated_at"`
+}
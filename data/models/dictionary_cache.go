@@ -0,0 +1,17 @@
+package models
+
+import "time"
+
+// DictionaryCacheEntry represents one cached dictionary lookup result
+type DictionaryCacheEntry struct {
+	Id             *int       `db:"id" json:"id"`
+	Provider       *string    `db:"provider" json:"provider"`
+	Language       *string    `db:"language" json:"language"`
+	NormalizedWord *string    `db:"normalized_word" json:"normalized_word"`
+	Entry          *string    `db:"entry" json:"entry"`
+	Hits           *int       `db:"hits" json:"hits"`
+	CachedAt       *time.Time `db:"cached_at" json:"cached_at"`
+	LastAccessedAt *time.Time `db:"last_accessed_at" json:"last_accessed_at"`
+	CreatedAt      *time.Time `db:"created_at" json:"created_at"`
+	UpdatedAt      *time.Time `db:"updated_at" json:"updated_at"`
+}
@@ -0,0 +1,15 @@
+package models
+
+import "time"
+
+// DictionaryEntry represents one headword of an offline dictionary
+type DictionaryEntry struct {
+	Id             *int       `db:"id" json:"id"`
+	Source         *string    `db:"source" json:"source"`
+	Language       *string    `db:"language" json:"language"`
+	Word           *string    `db:"word" json:"word"`
+	NormalizedWord *string    `db:"normalized_word" json:"normalized_word"`
+	Entry          *string    `db:"entry" json:"entry"`
+	CreatedAt      *time.Time `db:"created_at" json:"created_at"`
+	UpdatedAt      *time.Time `db:"updated_at" json:"updated_at"`
+}
@@ -0,0 +1,15 @@
+package models
+
+import "time"
+
+// EntityLink represents a link between two of the word, question and note
+// records
+type EntityLink struct {
+	Id         *int       `db:"id" json:"id"`
+	SourceType *string    `db:"source_type" json:"source_type"`
+	SourceId   *int       `db:"source_id" json:"source_id"`
+	TargetType *string    `db:"target_type" json:"target_type"`
+	TargetId   *int       `db:"target_id" json:"target_id"`
+	CreatedAt  *time.Time `db:"created_at" json:"created_at"`
+	UpdatedAt  *time.Time `db:"updated_at" json:"updated_at"`
+}
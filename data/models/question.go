@@ -16,6 +16,18 @@ type Question struct {
 	CountPractise        *int       `db:"count_practise" json:"count_practise"`
 	CountFailurePractise *int       `db:"count_failure_practise" json:"count_failure_practise"`
 	LastAnsweredAt       *time.Time `db:"last_answered_at" json:"last_answered_at"`
+	QuestionType         *string    `db:"question_type" json:"question_type"`
+	IsLeech              *bool      `db:"is_leech" json:"is_leech"`
+	IsSuspended          *bool      `db:"is_suspended" json:"is_suspended"`
 	CreatedAt            *time.Time `db:"created_at" json:"created_at"`
 	UpdatedAt            *time.Time `db:"updated_at" json:"updated_at"`
 }
+
+// QuestionPractiseGroup is the number of questions sharing one question type
+// and pair of practice/failure counters, which is all accuracy stats need
+type QuestionPractiseGroup struct {
+	QuestionType         *string `db:"question_type" json:"question_type"`
+	CountPractise        *int    `db:"count_practise" json:"count_practise"`
+	CountFailurePractise *int    `db:"count_failure_practise" json:"count_failure_practise"`
+	QuestionCount        *int    `db:"question_count" json:"question_count"`
+}
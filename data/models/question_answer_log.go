@@ -3,12 +3,23 @@ package models
 import "time"
 
 // QuestionAnswerLog represents a single quiz answer for a question, including
-// which option (matching the question's own option_a-d ordering) was selected.
+// which option (matching the question's own option_a-d ordering) was selected
+// or, for question types with question_options, the JSON-encoded response.
 type QuestionAnswerLog struct {
 	Id             *int       `db:"id" json:"id"`
 	QuestionId     *int       `db:"question_id" json:"question_id"`
 	SelectedOption *string    `db:"selected_option" json:"selected_option"`
 	IsCorrect      *bool      `db:"is_correct" json:"is_correct"`
+	Response       *string    `db:"response" json:"response"`
+	ResponseMs     *int       `db:"response_ms" json:"response_ms"`
 	CreatedAt      *time.Time `db:"created_at" json:"created_at"`
 	UpdatedAt      *time.Time `db:"updated_at" json:"updated_at"`
 }
+
+// QuestionAnswerLogDailyStats is one day of answer logs aggregated in SQL:
+// how many answers were logged and how many of them were correct.
+type QuestionAnswerLogDailyStats struct {
+	Day          *string `db:"day" json:"day"`
+	AnswerCount  *int    `db:"answer_count" json:"answer_count"`
+	CorrectCount *int    `db:"correct_count" json:"correct_count"`
+}
@@ -0,0 +1,16 @@
+package models
+
+import "time"
+
+// QuestionOption represents one option of a question stored in the
+// question_options child table
+type QuestionOption struct {
+	Id           *int       `db:"id" json:"id"`
+	QuestionId   *int       `db:"question_id" json:"question_id"`
+	Position     *int       `db:"position" json:"position"`
+	Content      *string    `db:"content" json:"content"`
+	IsCorrect    *bool      `db:"is_correct" json:"is_correct"`
+	MatchContent *string    `db:"match_content" json:"match_content"`
+	CreatedAt    *time.Time `db:"created_at" json:"created_at"`
+	UpdatedAt    *time.Time `db:"updated_at" json:"updated_at"`
+}
@@ -0,0 +1,17 @@
+package models
+
+import "time"
+
+// Reminder represents a due-dated reminder to revisit a word, question or
+// note
+type Reminder struct {
+	Id         *int       `db:"id" json:"id"`
+	EntityType *string    `db:"entity_type" json:"entity_type"`
+	EntityId   *int       `db:"entity_id" json:"entity_id"`
+	DueAt      *time.Time `db:"due_at" json:"due_at"`
+	Recurrence *string    `db:"recurrence" json:"recurrence"`
+	Note       *string    `db:"note" json:"note"`
+	IsOverdue  *bool      `db:"is_overdue" json:"is_overdue"`
+	CreatedAt  *time.Time `db:"created_at" json:"created_at"`
+	UpdatedAt  *time.Time `db:"updated_at" json:"updated_at"`
+}
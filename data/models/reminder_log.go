@@ -0,0 +1,17 @@
+package models
+
+import "time"
+
+// ReminderLog represents a reminder as it was when it was cleared or deleted
+type ReminderLog struct {
+	Id         *int       `db:"id" json:"id"`
+	ReminderId *int       `db:"reminder_id" json:"reminder_id"`
+	EntityType *string    `db:"entity_type" json:"entity_type"`
+	EntityId   *int       `db:"entity_id" json:"entity_id"`
+	DueAt      *time.Time `db:"due_at" json:"due_at"`
+	Recurrence *string    `db:"recurrence" json:"recurrence"`
+	Note       *string    `db:"note" json:"note"`
+	Action     *string    `db:"action" json:"action"`
+	CreatedAt  *time.Time `db:"created_at" json:"created_at"`
+	UpdatedAt  *time.Time `db:"updated_at" json:"updated_at"`
+}
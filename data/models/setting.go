@@ -0,0 +1,12 @@
+package models
+
+import "time"
+
+// Setting represents one named learner preference
+type Setting struct {
+	Id        *int       `db:"id" json:"id"`
+	Name      *string    `db:"name" json:"name"`
+	Value     *string    `db:"value" json:"value"`
+	CreatedAt *time.Time `db:"created_at" json:"created_at"`
+	UpdatedAt *time.Time `db:"updated_at" json:"updated_at"`
+}
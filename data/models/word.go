@@ -10,6 +10,14 @@ type Word struct {
 	Reminder        *string    `db:"reminder" json:"reminder"`
 	CountPractise   *int       `db:"count_practise" json:"count_practise"`
 	LastPracticedAt *time.Time `db:"last_practiced_at" json:"last_practiced_at"`
+	IsLeech         *bool      `db:"is_leech" json:"is_leech"`
+	IsSuspended     *bool      `db:"is_suspended" json:"is_suspended"`
 	CreatedAt       *time.Time `db:"created_at" json:"created_at"`
 	UpdatedAt       *time.Time `db:"updated_at" json:"updated_at"`
 }
+
+// WordPractiseCountGroup is the number of words sharing one count_practise value
+type WordPractiseCountGroup struct {
+	CountPractise *int `db:"count_practise" json:"count_practise"`
+	WordCount     *int `db:"word_count" json:"word_count"`
+}
@@ -11,6 +11,11 @@ type WordDefinition struct {
 	Phonetics    *string    `db:"phonetics" json:"phonetics"`
 	Examples     *string    `db:"examples" json:"examples"`
 	Notes        *string    `db:"notes" json:"notes"`
+	CefrLevel    *string    `db:"cefr_level" json:"cefr_level"`
+	Grammar      *string    `db:"grammar" json:"grammar"`
+	Labels       *string    `db:"labels" json:"labels"`
+	Synonyms     *string    `db:"synonyms" json:"synonyms"`
+	Antonyms     *string    `db:"antonyms" json:"antonyms"`
 	CreatedAt    *time.Time `db:"created_at" json:"created_at"`
 	UpdatedAt    *time.Time `db:"updated_at" json:"updated_at"`
 }
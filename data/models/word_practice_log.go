@@ -9,6 +9,28 @@ type WordPracticeLog struct {
 	Familiarity         *string    `db:"familiarity" json:"familiarity"`
 	PreviousFamiliarity *string    `db:"previous_familiarity" json:"previous_familiarity"`
 	QuizSessionID       *string    `db:"quiz_session_id" json:"quiz_session_id"`
+	Mode                *string    `db:"mode" json:"mode"`
+	TypedAnswer         *string    `db:"typed_answer" json:"typed_answer"`
+	IsCorrect           *bool      `db:"is_correct" json:"is_correct"`
+	ResponseMs          *int       `db:"response_ms" json:"response_ms"`
 	CreatedAt           *time.Time `db:"created_at" json:"created_at"`
 	UpdatedAt           *time.Time `db:"updated_at" json:"updated_at"`
 }
+
+// WordPracticeLogDailyStats is one day of practice logs aggregated in SQL:
+// how many answers were logged, how many raised the familiarity level, and
+// the mean familiarity level (0/1/2 for red/yellow/green) after answering.
+type WordPracticeLogDailyStats struct {
+	Day                 *string  `db:"day" json:"day"`
+	PracticeCount       *int     `db:"practice_count" json:"practice_count"`
+	ImprovedCount       *int     `db:"improved_count" json:"improved_count"`
+	AvgFamiliarityLevel *float64 `db:"avg_familiarity_level" json:"avg_familiarity_level"`
+}
+
+// WordFamiliarityTransitionCount is how many self-reported practice logs
+// moved a word from one familiarity to another, aggregated in SQL.
+type WordFamiliarityTransitionCount struct {
+	PreviousFamiliarity *string `db:"previous_familiarity" json:"previous_familiarity"`
+	Familiarity         *string `db:"familiarity" json:"familiarity"`
+	TransitionCount     *int    `db:"transition_count" json:"transition_count"`
+}
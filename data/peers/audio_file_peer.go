@@ -0,0 +1,84 @@
+package peers
+
+import (
+	"word-flashcard/data/models"
+	"word-flashcard/data/schema"
+
+	"github.com/Masterminds/squirrel"
+)
+
+// AudioFilePeer provides database operations for AudioFile business entities
+type AudioFilePeer struct {
+	*BasePeer
+	tableName string
+}
+
+// NewAudioFilePeer creates a new AudioFilePeer instance
+func NewAudioFilePeer() (*AudioFilePeer, error) {
+	base, err := NewBasePeer()
+	if err != nil {
+		return nil, err
+	}
+
+	return &AudioFilePeer{
+		BasePeer:  base,
+		tableName: schema.AUDIO_FILE_TABLE_NAME,
+	}, nil
+}
+
+// Select retrieves AudioFile records from the database based on the provided criteria
+func (ap *AudioFilePeer) Select(columns []*string, where squirrel.Sqlizer, orderBy []*string, limit *uint64, offset *uint64) ([]*models.AudioFile, error) {
+	var audioFiles []*models.AudioFile
+
+	// Perform the select operation
+	err := ap.db.Select(ap.tableName, columns, where, orderBy, limit, offset, &audioFiles)
+	if err != nil {
+		return nil, err
+	}
+
+	return audioFiles, nil
+}
+
+// Insert adds a new AudioFile record to the database
+func (ap *AudioFilePeer) Insert(audioFile *models.AudioFile) (int64, error) {
+	// Perform the insert operation
+	result, err := ap.db.Insert(ap.tableName, audioFile)
+	if err != nil {
+		return 0, err
+	}
+
+	return result, nil
+}
+
+// Update modifies an existing AudioFile record in the database
+func (ap *AudioFilePeer) Update(audioFile *models.AudioFile, where squirrel.Sqlizer) (int64, error) {
+	// Perform the update operation
+	result, err := ap.db.Update(ap.tableName, audioFile, where)
+	if err != nil {
+		return 0, err
+	}
+
+	return result, nil
+}
+
+// Delete removes AudioFile records from the database based on the provided criteria
+func (ap *AudioFilePeer) Delete(where squirrel.Sqlizer) (int64, error) {
+	// Perform the delete operation
+	result, err := ap.db.Delete(ap.tableName, where)
+	if err != nil {
+		return 0, err
+	}
+
+	return result, nil
+}
+
+// Count returns the number of AudioFile records matching the criteria
+func (ap *AudioFilePeer) Count(where squirrel.Sqlizer) (int64, error) {
+	// Perform the count operation
+	result, err := ap.db.Count(ap.tableName, where)
+	if err != nil {
+		return 0, err
+	}
+
+	return result, nil
+}
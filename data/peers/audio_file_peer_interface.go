@@ -0,0 +1,15 @@
+package peers
+
+import (
+	"word-flashcard/data/models"
+
+	"github.com/Masterminds/squirrel"
+)
+
+type AudioFilePeerInterface interface {
+	Select(columns []*string, where squirrel.Sqlizer, orderBy []*string, limit *uint64, offset *uint64) ([]*models.AudioFile, error)
+	Insert(audioFile *models.AudioFile) (int64, error)
+	Update(audioFile *models.AudioFile, where squirrel.Sqlizer) (int64, error)
+	Delete(where squirrel.Sqlizer) (int64, error)
+	Count(where squirrel.Sqlizer) (int64, error)
+}
@@ -13,15 +13,25 @@ import (
 
 // restoreOrder lists every table parent-first: writing rows in this order
 // never violates a foreign key (word_definitions/word_practice_logs
-// reference words, question_answer_logs references questions). Wiping the
-// database for a restore walks this list in reverse (child-first) instead.
+// reference words, question_options/question_answer_logs reference
+// questions). entity_links, settings, reminders, reminder_logs and
+// audio_files have no foreign keys and simply go last. Wiping the database
+// for a restore walks this list in reverse (child-first) instead.
+// dictionary_entries and dictionary_cache hold imported reference data and
+// refetchable lookups, not user data, and are neither backed up nor wiped.
 var restoreOrder = []string{
 	schema.WORD_TABLE_NAME,
 	schema.QUESTION_TABLE_NAME,
 	schema.NOTE_TABLE_NAME,
 	schema.WORD_DEFINITIONS_TABLE_NAME,
+	schema.QUESTION_OPTION_TABLE_NAME,
 	schema.QUESTION_ANSWER_LOG_TABLE_NAME,
 	schema.WORD_PRACTICE_LOG_TABLE_NAME,
+	schema.ENTITY_LINK_TABLE_NAME,
+	schema.SETTING_TABLE_NAME,
+	schema.REMINDER_TABLE_NAME,
+	schema.REMINDER_LOG_TABLE_NAME,
+	schema.AUDIO_FILE_TABLE_NAME,
 }
 
 // BackupPeer provides the transactional, full-database restore operation
@@ -99,12 +109,30 @@ func (bp *BackupPeer) restore(tx *sql.Tx, payload *RestorePayload) error {
 	if err := restoreTable(tx, pf, schema.WORD_DEFINITIONS_TABLE_NAME, payload.WordDefinitions); err != nil {
 		return err
 	}
+	if err := restoreTable(tx, pf, schema.QUESTION_OPTION_TABLE_NAME, payload.QuestionOptions); err != nil {
+		return err
+	}
 	if err := restoreTable(tx, pf, schema.QUESTION_ANSWER_LOG_TABLE_NAME, payload.QuestionAnswerLogs); err != nil {
 		return err
 	}
 	if err := restoreTable(tx, pf, schema.WORD_PRACTICE_LOG_TABLE_NAME, payload.WordPracticeLogs); err != nil {
 		return err
 	}
+	if err := restoreTable(tx, pf, schema.ENTITY_LINK_TABLE_NAME, payload.EntityLinks); err != nil {
+		return err
+	}
+	if err := restoreTable(tx, pf, schema.SETTING_TABLE_NAME, payload.Settings); err != nil {
+		return err
+	}
+	if err := restoreTable(tx, pf, schema.REMINDER_TABLE_NAME, payload.Reminders); err != nil {
+		return err
+	}
+	if err := restoreTable(tx, pf, schema.REMINDER_LOG_TABLE_NAME, payload.ReminderLogs); err != nil {
+		return err
+	}
+	if err := restoreTable(tx, pf, schema.AUDIO_FILE_TABLE_NAME, payload.AudioFiles); err != nil {
+		return err
+	}
 
 	if bp.dbType == "postgresql" {
 		if err := bp.resyncSequences(tx); err != nil {
@@ -13,9 +13,15 @@ type RestorePayload struct {
 	Words              []*models.Word
 	WordDefinitions    []*models.WordDefinition
 	Questions          []*models.Question
+	QuestionOptions    []*models.QuestionOption
 	QuestionAnswerLogs []*models.QuestionAnswerLog
 	WordPracticeLogs   []*models.WordPracticeLog
 	Notes              []*models.Note
+	EntityLinks        []*models.EntityLink
+	Settings           []*models.Setting
+	Reminders          []*models.Reminder
+	ReminderLogs       []*models.ReminderLog
+	AudioFiles         []*models.AudioFile
 }
 
 // BackupPeerInterface defines the database operations needed to fully
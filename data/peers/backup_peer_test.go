@@ -63,7 +63,8 @@ func (s *backupPeerTestSuite) TestAllColumnsWithValues() {
 			input: &models.Word{Id: &id, Word: &word},
 			wantColumns: []string{
 				"id", "word", "familiarity", "reminder",
-				"count_practise", "last_practiced_at", "created_at", "updated_at",
+				"count_practise", "last_practiced_at", "is_leech", "is_suspended",
+				"created_at", "updated_at",
 			},
 		},
 		{
@@ -112,11 +113,19 @@ func (s *backupPeerTestSuite) TestRestore() {
 	}
 
 	// deleteAllTables walks restoreOrder (words, questions, notes,
-	// word_definitions, question_answer_logs, word_practice_logs)
+	// word_definitions, question_options, question_answer_logs,
+	// word_practice_logs, entity_links, settings, reminders, reminder_logs,
+	// audio_files)
 	// in reverse, so the actual DELETE order is the mirror image of that.
 	expectDeletes := func(mock sqlmock.Sqlmock) {
+		mock.ExpectExec("DELETE FROM audio_files").WillReturnResult(sqlmock.NewResult(0, 0))
+		mock.ExpectExec("DELETE FROM reminder_logs").WillReturnResult(sqlmock.NewResult(0, 0))
+		mock.ExpectExec("DELETE FROM reminders").WillReturnResult(sqlmock.NewResult(0, 0))
+		mock.ExpectExec("DELETE FROM settings").WillReturnResult(sqlmock.NewResult(0, 0))
+		mock.ExpectExec("DELETE FROM entity_links").WillReturnResult(sqlmock.NewResult(0, 0))
 		mock.ExpectExec("DELETE FROM word_practice_logs").WillReturnResult(sqlmock.NewResult(0, 0))
 		mock.ExpectExec("DELETE FROM question_answer_logs").WillReturnResult(sqlmock.NewResult(0, 0))
+		mock.ExpectExec("DELETE FROM question_options").WillReturnResult(sqlmock.NewResult(0, 0))
 		mock.ExpectExec("DELETE FROM word_definitions").WillReturnResult(sqlmock.NewResult(0, 0))
 		mock.ExpectExec("DELETE FROM notes").WillReturnResult(sqlmock.NewResult(0, 0))
 		mock.ExpectExec("DELETE FROM questions").WillReturnResult(sqlmock.NewResult(0, 0))
@@ -150,8 +159,14 @@ func (s *backupPeerTestSuite) TestRestore() {
 				mock.ExpectExec(`SELECT setval\(pg_get_serial_sequence\('questions'`).WillReturnResult(sqlmock.NewResult(0, 0))
 				mock.ExpectExec(`SELECT setval\(pg_get_serial_sequence\('notes'`).WillReturnResult(sqlmock.NewResult(0, 0))
 				mock.ExpectExec(`SELECT setval\(pg_get_serial_sequence\('word_definitions'`).WillReturnResult(sqlmock.NewResult(0, 0))
+				mock.ExpectExec(`SELECT setval\(pg_get_serial_sequence\('question_options'`).WillReturnResult(sqlmock.NewResult(0, 0))
 				mock.ExpectExec(`SELECT setval\(pg_get_serial_sequence\('question_answer_logs'`).WillReturnResult(sqlmock.NewResult(0, 0))
 				mock.ExpectExec(`SELECT setval\(pg_get_serial_sequence\('word_practice_logs'`).WillReturnResult(sqlmock.NewResult(0, 0))
+				mock.ExpectExec(`SELECT setval\(pg_get_serial_sequence\('entity_links'`).WillReturnResult(sqlmock.NewResult(0, 0))
+				mock.ExpectExec(`SELECT setval\(pg_get_serial_sequence\('settings'`).WillReturnResult(sqlmock.NewResult(0, 0))
+				mock.ExpectExec(`SELECT setval\(pg_get_serial_sequence\('reminders'`).WillReturnResult(sqlmock.NewResult(0, 0))
+				mock.ExpectExec(`SELECT setval\(pg_get_serial_sequence\('reminder_logs'`).WillReturnResult(sqlmock.NewResult(0, 0))
+				mock.ExpectExec(`SELECT setval\(pg_get_serial_sequence\('audio_files'`).WillReturnResult(sqlmock.NewResult(0, 0))
 			},
 		},
 		{
@@ -167,7 +182,7 @@ func (s *backupPeerTestSuite) TestRestore() {
 			dbType:  "mysql",
 			payload: samplePayload,
 			setupMock: func(mock sqlmock.Sqlmock) {
-				mock.ExpectExec("DELETE FROM word_practice_logs").WillReturnError(errors.New("db down"))
+				mock.ExpectExec("DELETE FROM audio_files").WillReturnError(errors.New("db down"))
 			},
 			wantErr: true,
 		},
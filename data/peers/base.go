@@ -1,8 +1,15 @@
 package peers
 
 import (
+	"database/sql"
 	"fmt"
+	"reflect"
+	"time"
+
+	"word-flashcard/data/schema"
 	"word-flashcard/utils/database"
+
+	"github.com/Masterminds/squirrel"
 )
 
 // BasePeer provides common database operations for all peers
@@ -32,3 +39,108 @@ func NewBasePeer() (*BasePeer, error) {
 		db: universalDB,
 	}, nil
 }
+
+// insertRow inserts a data/models row inside tx and returns its new ID. Like
+// Database.Insert it leaves out nil fields and id, and stamps
+// created_at/updated_at with the current time; it exists for writes that
+// must share a transaction, which Database.Insert can't join.
+func insertRow(tx *sql.Tx, dbType, table string, row interface{}) (int64, error) {
+	allColumns, allValues, err := allColumnsWithValues(row)
+	if err != nil {
+		return 0, fmt.Errorf("failed to prepare row for table %s: %w", table, err)
+	}
+
+	now := time.Now().UTC().Format(database.FORMAT_TIMESTAMP)
+	columns := []string{schema.COMMON_CREATED_AT, schema.COMMON_UPDATED_AT}
+	values := []interface{}{now, now}
+	for i, column := range allColumns {
+		if column == schema.COMMON_ID || column == schema.COMMON_CREATED_AT || column == schema.COMMON_UPDATED_AT {
+			continue
+		}
+		if value := reflect.ValueOf(allValues[i]); value.Kind() == reflect.Pointer && value.IsNil() {
+			continue
+		}
+		columns = append(columns, column)
+		values = append(values, allValues[i])
+	}
+
+	query := squirrel.Insert(table).
+		Columns(columns...).
+		Values(values...).
+		PlaceholderFormat(placeholderFormat(dbType))
+
+	// PostgreSQL drivers don't report the last insert ID, so ask for it back
+	if dbType == "postgresql" {
+		sqlStr, args, err := query.Suffix("RETURNING " + schema.COMMON_ID).ToSql()
+		if err != nil {
+			return 0, fmt.Errorf("failed to build insert for table %s: %w", table, err)
+		}
+		var id int64
+		if err := tx.QueryRow(sqlStr, args...).Scan(&id); err != nil {
+			return 0, fmt.Errorf("failed to insert row into table %s: %w", table, err)
+		}
+		return id, nil
+	}
+
+	sqlStr, args, err := query.ToSql()
+	if err != nil {
+		return 0, fmt.Errorf("failed to build insert for table %s: %w", table, err)
+	}
+	result, err := tx.Exec(sqlStr, args...)
+	if err != nil {
+		return 0, fmt.Errorf("failed to insert row into table %s: %w", table, err)
+	}
+	return result.LastInsertId()
+}
+
+// updateRow updates the rows of table matching where inside tx and returns
+// how many were changed. Like Database.Update it only sets row's non-nil
+// fields and stamps updated_at, leaving id and created_at alone; unlike it,
+// matching nothing isn't an error, so callers can tell "not found" apart.
+func updateRow(tx *sql.Tx, dbType, table string, row interface{}, where squirrel.Sqlizer) (int64, error) {
+	allColumns, allValues, err := allColumnsWithValues(row)
+	if err != nil {
+		return 0, fmt.Errorf("failed to prepare row for table %s: %w", table, err)
+	}
+
+	query := squirrel.Update(table).
+		Set(schema.COMMON_UPDATED_AT, time.Now().UTC().Format(database.FORMAT_TIMESTAMP)).
+		Where(where).
+		PlaceholderFormat(placeholderFormat(dbType))
+	for i, column := range allColumns {
+		if column == schema.COMMON_ID || column == schema.COMMON_CREATED_AT || column == schema.COMMON_UPDATED_AT {
+			continue
+		}
+		if value := reflect.ValueOf(allValues[i]); value.Kind() == reflect.Pointer && value.IsNil() {
+			continue
+		}
+		query = query.Set(column, allValues[i])
+	}
+
+	sqlStr, args, err := query.ToSql()
+	if err != nil {
+		return 0, fmt.Errorf("failed to build update for table %s: %w", table, err)
+	}
+	result, err := tx.Exec(sqlStr, args...)
+	if err != nil {
+		return 0, fmt.Errorf("failed to update table %s: %w", table, err)
+	}
+	return result.RowsAffected()
+}
+
+// deleteRows deletes the rows of table matching where inside tx and returns
+// how many were removed. Like updateRow, matching nothing isn't an error.
+func deleteRows(tx *sql.Tx, dbType, table string, where squirrel.Sqlizer) (int64, error) {
+	sqlStr, args, err := squirrel.Delete(table).
+		Where(where).
+		PlaceholderFormat(placeholderFormat(dbType)).
+		ToSql()
+	if err != nil {
+		return 0, fmt.Errorf("failed to build delete for table %s: %w", table, err)
+	}
+	result, err := tx.Exec(sqlStr, args...)
+	if err != nil {
+		return 0, fmt.Errorf("failed to delete from table %s: %w", table, err)
+	}
+	return result.RowsAffected()
+}
@@ -0,0 +1,84 @@
+package peers
+
+import (
+	"word-flashcard/data/models"
+	"word-flashcard/data/schema"
+
+	"github.com/Masterminds/squirrel"
+)
+
+// DictionaryCachePeer provides database operations for DictionaryCacheEntry business entities
+type DictionaryCachePeer struct {
+	*BasePeer
+	tableName string
+}
+
+// NewDictionaryCachePeer creates a new DictionaryCachePeer instance
+func NewDictionaryCachePeer() (*DictionaryCachePeer, error) {
+	base, err := NewBasePeer()
+	if err != nil {
+		return nil, err
+	}
+
+	return &DictionaryCachePeer{
+		BasePeer:  base,
+		tableName: schema.DICTIONARY_CACHE_TABLE_NAME,
+	}, nil
+}
+
+// Select retrieves DictionaryCacheEntry records from the database based on the provided criteria
+func (cp *DictionaryCachePeer) Select(columns []*string, where squirrel.Sqlizer, orderBy []*string, limit *uint64, offset *uint64) ([]*models.DictionaryCacheEntry, error) {
+	var entries []*models.DictionaryCacheEntry
+
+	// Perform the select operation
+	err := cp.db.Select(cp.tableName, columns, where, orderBy, limit, offset, &entries)
+	if err != nil {
+		return nil, err
+	}
+
+	return entries, nil
+}
+
+// Insert adds a new DictionaryCacheEntry record to the database
+func (cp *DictionaryCachePeer) Insert(entry *models.DictionaryCacheEntry) (int64, error) {
+	// Perform the insert operation
+	result, err := cp.db.Insert(cp.tableName, entry)
+	if err != nil {
+		return 0, err
+	}
+
+	return result, nil
+}
+
+// Update modifies an existing DictionaryCacheEntry record in the database
+func (cp *DictionaryCachePeer) Update(entry *models.DictionaryCacheEntry, where squirrel.Sqlizer) (int64, error) {
+	// Perform the update operation
+	result, err := cp.db.Update(cp.tableName, entry, where)
+	if err != nil {
+		return 0, err
+	}
+
+	return result, nil
+}
+
+// Delete removes DictionaryCacheEntry records from the database based on the provided criteria
+func (cp *DictionaryCachePeer) Delete(where squirrel.Sqlizer) (int64, error) {
+	// Perform the delete operation
+	result, err := cp.db.Delete(cp.tableName, where)
+	if err != nil {
+		return 0, err
+	}
+
+	return result, nil
+}
+
+// Count returns the number of DictionaryCacheEntry records matching the criteria
+func (cp *DictionaryCachePeer) Count(where squirrel.Sqlizer) (int64, error) {
+	// Perform the count operation
+	result, err := cp.db.Count(cp.tableName, where)
+	if err != nil {
+		return 0, err
+	}
+
+	return result, nil
+}
@@ -0,0 +1,15 @@
+package peers
+
+import (
+	"word-flashcard/data/models"
+
+	"github.com/Masterminds/squirrel"
+)
+
+type DictionaryCachePeerInterface interface {
+	Select(columns []*string, where squirrel.Sqlizer, orderBy []*string, limit *uint64, offset *uint64) ([]*models.DictionaryCacheEntry, error)
+	Insert(entry *models.DictionaryCacheEntry) (int64, error)
+	Update(entry *models.DictionaryCacheEntry, where squirrel.Sqlizer) (int64, error)
+	Delete(where squirrel.Sqlizer) (int64, error)
+	Count(where squirrel.Sqlizer) (int64, error)
+}
@@ -0,0 +1,149 @@
+package peers
+
+import (
+	"database/sql"
+	"fmt"
+
+	"word-flashcard/data/models"
+	"word-flashcard/data/schema"
+	"word-flashcard/utils/database"
+
+	"github.com/Masterminds/squirrel"
+)
+
+// DictionaryEntryPeer provides database operations for DictionaryEntry business entities
+type DictionaryEntryPeer struct {
+	*BasePeer
+	tableName string
+	dbType    string
+}
+
+// NewDictionaryEntryPeer creates a new DictionaryEntryPeer instance
+func NewDictionaryEntryPeer() (*DictionaryEntryPeer, error) {
+	base, err := NewBasePeer()
+	if err != nil {
+		return nil, err
+	}
+
+	config, err := database.LoadConfig()
+	if err != nil {
+		return nil, err
+	}
+
+	return &DictionaryEntryPeer{
+		BasePeer:  base,
+		tableName: schema.DICTIONARY_ENTRY_TABLE_NAME,
+		dbType:    config.Type,
+	}, nil
+}
+
+// Select retrieves DictionaryEntry records from the database based on the provided criteria
+func (ep *DictionaryEntryPeer) Select(columns []*string, where squirrel.Sqlizer, orderBy []*string, limit *uint64, offset *uint64) ([]*models.DictionaryEntry, error) {
+	var entries []*models.DictionaryEntry
+
+	// Perform the select operation
+	err := ep.db.Select(ep.tableName, columns, where, orderBy, limit, offset, &entries)
+	if err != nil {
+		return nil, err
+	}
+
+	return entries, nil
+}
+
+// Insert adds a new DictionaryEntry record to the database
+func (ep *DictionaryEntryPeer) Insert(entry *models.DictionaryEntry) (int64, error) {
+	// Perform the insert operation
+	result, err := ep.db.Insert(ep.tableName, entry)
+	if err != nil {
+		return 0, err
+	}
+
+	return result, nil
+}
+
+// Update modifies an existing DictionaryEntry record in the database
+func (ep *DictionaryEntryPeer) Update(entry *models.DictionaryEntry, where squirrel.Sqlizer) (int64, error) {
+	// Perform the update operation
+	result, err := ep.db.Update(ep.tableName, entry, where)
+	if err != nil {
+		return 0, err
+	}
+
+	return result, nil
+}
+
+// Delete removes DictionaryEntry records from the database based on the provided criteria
+func (ep *DictionaryEntryPeer) Delete(where squirrel.Sqlizer) (int64, error) {
+	// Perform the delete operation
+	result, err := ep.db.Delete(ep.tableName, where)
+	if err != nil {
+		return 0, err
+	}
+
+	return result, nil
+}
+
+// InsertBatch adds entries in a single transaction, so a batch is either
+// stored whole or not at all
+func (ep *DictionaryEntryPeer) InsertBatch(entries []*models.DictionaryEntry) error {
+	tx, err := ep.db.GetDB().Begin()
+	if err != nil {
+		return fmt.Errorf("failed to begin dictionary entry transaction: %w", err)
+	}
+
+	if err := ep.insertBatch(tx, entries); err != nil {
+		_ = tx.Rollback()
+		return err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit dictionary entry transaction: %w", err)
+	}
+
+	return nil
+}
+
+// insertBatch runs InsertBatch against an already-open transaction
+func (ep *DictionaryEntryPeer) insertBatch(tx *sql.Tx, entries []*models.DictionaryEntry) error {
+	for _, entry := range entries {
+		if _, err := insertRow(tx, ep.dbType, ep.tableName, entry); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ReplaceSource swaps the entries stored for language under source for the
+// ones stored under staging: source's rows are deleted and staging's moved
+// to source in a single transaction, so lookups see either the old entries
+// or the new ones, never a mix or neither. It returns how many entries were
+// moved.
+func (ep *DictionaryEntryPeer) ReplaceSource(staging, source, language string) (int64, error) {
+	tx, err := ep.db.GetDB().Begin()
+	if err != nil {
+		return 0, fmt.Errorf("failed to begin dictionary entry transaction: %w", err)
+	}
+
+	moved, err := ep.replaceSource(tx, staging, source, language)
+	if err != nil {
+		_ = tx.Rollback()
+		return 0, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return 0, fmt.Errorf("failed to commit dictionary entry transaction: %w", err)
+	}
+
+	return moved, nil
+}
+
+// replaceSource runs ReplaceSource against an already-open transaction
+func (ep *DictionaryEntryPeer) replaceSource(tx *sql.Tx, staging, source, language string) (int64, error) {
+	where := squirrel.Eq{schema.DICTIONARY_ENTRY_SOURCE: source, schema.DICTIONARY_ENTRY_LANGUAGE: language}
+	if _, err := deleteRows(tx, ep.dbType, ep.tableName, where); err != nil {
+		return 0, err
+	}
+
+	where = squirrel.Eq{schema.DICTIONARY_ENTRY_SOURCE: staging, schema.DICTIONARY_ENTRY_LANGUAGE: language}
+	return updateRow(tx, ep.dbType, ep.tableName, &models.DictionaryEntry{Source: &source}, where)
+}
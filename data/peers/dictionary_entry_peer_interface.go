@@ -0,0 +1,16 @@
+package peers
+
+import (
+	"word-flashcard/data/models"
+
+	"github.com/Masterminds/squirrel"
+)
+
+type DictionaryEntryPeerInterface interface {
+	Select(columns []*string, where squirrel.Sqlizer, orderBy []*string, limit *uint64, offset *uint64) ([]*models.DictionaryEntry, error)
+	Insert(entry *models.DictionaryEntry) (int64, error)
+	Update(entry *models.DictionaryEntry, where squirrel.Sqlizer) (int64, error)
+	Delete(where squirrel.Sqlizer) (int64, error)
+	InsertBatch(entries []*models.DictionaryEntry) error
+	ReplaceSource(staging, source, language string) (int64, error)
+}
@@ -0,0 +1,138 @@
+package peers
+
+import (
+	"errors"
+	"testing"
+
+	"word-flashcard/data/models"
+	"word-flashcard/utils"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/suite"
+)
+
+// dictionaryEntryPeerTestSuite is a test suite for DictionaryEntryPeer
+type dictionaryEntryPeerTestSuite struct {
+	suite.Suite
+}
+
+// TestDictionaryEntryPeerSuite runs the dictionaryEntryPeerTestSuite
+func TestDictionaryEntryPeerSuite(t *testing.T) {
+	suite.Run(t, new(dictionaryEntryPeerTestSuite))
+}
+
+// TestInsertBatch verifies every entry is inserted and a failure stops
+// before the next one
+func (s *dictionaryEntryPeerTestSuite) TestInsertBatch() {
+	entries := []*models.DictionaryEntry{
+		{Source: utils.StrPtr("local"), Language: utils.StrPtr("en"), Word: utils.StrPtr("Run"), NormalizedWord: utils.StrPtr("run"), Entry: utils.StrPtr("{}")},
+		{Source: utils.StrPtr("local"), Language: utils.StrPtr("en"), Word: utils.StrPtr("walk"), NormalizedWord: utils.StrPtr("walk"), Entry: utils.StrPtr("{}")},
+	}
+
+	tests := []struct {
+		name      string
+		setupMock func(mock sqlmock.Sqlmock)
+		wantErr   bool
+	}{
+		{
+			name: "entries are inserted",
+			setupMock: func(mock sqlmock.Sqlmock) {
+				mock.ExpectExec(`INSERT INTO dictionary_entries \(created_at,updated_at,source,language,word,normalized_word,entry\)`).
+					WithArgs(sqlmock.AnyArg(), sqlmock.AnyArg(), "local", "en", "Run", "run", "{}").
+					WillReturnResult(sqlmock.NewResult(1, 1))
+				mock.ExpectExec("INSERT INTO dictionary_entries").
+					WithArgs(sqlmock.AnyArg(), sqlmock.AnyArg(), "local", "en", "walk", "walk", "{}").
+					WillReturnResult(sqlmock.NewResult(2, 1))
+			},
+		},
+		{
+			name: "insert failure stops the batch",
+			setupMock: func(mock sqlmock.Sqlmock) {
+				mock.ExpectExec("INSERT INTO dictionary_entries").WillReturnError(errors.New("disk full"))
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		s.Run(tt.name, func() {
+			db, mock, err := sqlmock.New()
+			s.Require().NoError(err)
+			defer db.Close()
+
+			mock.ExpectBegin()
+			tt.setupMock(mock)
+
+			tx, err := db.Begin()
+			s.Require().NoError(err)
+
+			ep := &DictionaryEntryPeer{tableName: "dictionary_entries", dbType: "mysql"}
+			insertErr := ep.insertBatch(tx, entries)
+
+			if tt.wantErr {
+				s.Error(insertErr)
+			} else {
+				s.NoError(insertErr)
+			}
+
+			s.NoError(mock.ExpectationsWereMet())
+		})
+	}
+}
+
+// TestReplaceSource verifies the source's rows are deleted before the
+// staging rows are moved to it, and a failed delete moves nothing
+func (s *dictionaryEntryPeerTestSuite) TestReplaceSource() {
+	tests := []struct {
+		name      string
+		setupMock func(mock sqlmock.Sqlmock)
+		want      int64
+		wantErr   bool
+	}{
+		{
+			name: "staging rows replace the source's",
+			setupMock: func(mock sqlmock.Sqlmock) {
+				mock.ExpectExec(`DELETE FROM dictionary_entries WHERE language = \? AND source = \?`).
+					WithArgs("en", "wiktionary").
+					WillReturnResult(sqlmock.NewResult(0, 10))
+				mock.ExpectExec(`UPDATE dictionary_entries SET updated_at = \?, source = \? WHERE language = \? AND source = \?`).
+					WithArgs(sqlmock.AnyArg(), "wiktionary", "en", "wiktionary#staging").
+					WillReturnResult(sqlmock.NewResult(0, 12))
+			},
+			want: 12,
+		},
+		{
+			name: "delete failure stops before moving",
+			setupMock: func(mock sqlmock.Sqlmock) {
+				mock.ExpectExec("DELETE FROM dictionary_entries").WillReturnError(errors.New("deadlock"))
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		s.Run(tt.name, func() {
+			db, mock, err := sqlmock.New()
+			s.Require().NoError(err)
+			defer db.Close()
+
+			mock.ExpectBegin()
+			tt.setupMock(mock)
+
+			tx, err := db.Begin()
+			s.Require().NoError(err)
+
+			ep := &DictionaryEntryPeer{tableName: "dictionary_entries", dbType: "mysql"}
+			moved, replaceErr := ep.replaceSource(tx, "wiktionary#staging", "wiktionary", "en")
+
+			if tt.wantErr {
+				s.Error(replaceErr)
+			} else {
+				s.Require().NoError(replaceErr)
+				s.Equal(tt.want, moved)
+			}
+
+			s.NoError(mock.ExpectationsWereMet())
+		})
+	}
+}
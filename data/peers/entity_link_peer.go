@@ -0,0 +1,73 @@
+package peers
+
+import (
+	"word-flashcard/data/models"
+	"word-flashcard/data/schema"
+
+	"github.com/Masterminds/squirrel"
+)
+
+// EntityLinkPeer provides database operations for EntityLink business entities
+type EntityLinkPeer struct {
+	*BasePeer
+	tableName string
+}
+
+// NewEntityLinkPeer creates a new EntityLinkPeer instance
+func NewEntityLinkPeer() (*EntityLinkPeer, error) {
+	base, err := NewBasePeer()
+	if err != nil {
+		return nil, err
+	}
+
+	return &EntityLinkPeer{
+		BasePeer:  base,
+		tableName: schema.ENTITY_LINK_TABLE_NAME,
+	}, nil
+}
+
+// Select retrieves EntityLink records from the database based on the provided criteria
+func (lp *EntityLinkPeer) Select(columns []*string, where squirrel.Sqlizer, orderBy []*string, limit *uint64, offset *uint64) ([]*models.EntityLink, error) {
+	var links []*models.EntityLink
+
+	// Perform the select operation
+	err := lp.db.Select(lp.tableName, columns, where, orderBy, limit, offset, &links)
+	if err != nil {
+		return nil, err
+	}
+
+	return links, nil
+}
+
+// Insert adds a new EntityLink record to the database
+func (lp *EntityLinkPeer) Insert(link *models.EntityLink) (int64, error) {
+	// Perform the insert operation
+	result, err := lp.db.Insert(lp.tableName, link)
+	if err != nil {
+		return 0, err
+	}
+
+	return result, nil
+}
+
+// Update modifies an existing EntityLink record in the database
+func (lp *EntityLinkPeer) Update(link *models.EntityLink, where squirrel.Sqlizer) (int64, error) {
+	// Perform the update operation
+	result, err := lp.db.Update(lp.tableName, link, where)
+	if err != nil {
+		return 0, err
+	}
+
+	return result, nil
+}
+
+// Delete removes EntityLink records from the database based on the provided criteria
+func (lp *EntityLinkPeer) Delete(where squirrel.Sqlizer) (int64, error) {
+	// Perform the delete operation
+	result, err := lp.db.Delete(lp.tableName, where)
+	if err != nil {
+		return 0, err
+	}
+
+	return result, nil
+}
@@ -0,0 +1,14 @@
+package peers
+
+import (
+	"word-flashcard/data/models"
+
+	"github.com/Masterminds/squirrel"
+)
+
+type EntityLinkPeerInterface interface {
+	Select(columns []*string, where squirrel.Sqlizer, orderBy []*string, limit *uint64, offset *uint64) ([]*models.EntityLink, error)
+	Insert(link *models.EntityLink) (int64, error)
+	Update(link *models.EntityLink, where squirrel.Sqlizer) (int64, error)
+	Delete(where squirrel.Sqlizer) (int64, error)
+}
@@ -1,8 +1,12 @@
 package peers
 
 import (
+	"fmt"
+	"time"
+
 	"word-flashcard/data/models"
 	"word-flashcard/data/schema"
+	"word-flashcard/utils/database"
 
 	"github.com/Masterminds/squirrel"
 )
@@ -47,3 +51,35 @@ func (qp *QuestionAnswerLogPeer) Insert(log *models.QuestionAnswerLog) (int64, e
 
 	return result, nil
 }
+
+// Count returns the number of QuestionAnswerLog records matching the specified criteria
+func (qp *QuestionAnswerLogPeer) Count(where squirrel.Sqlizer) (int64, error) {
+	result, err := qp.db.Count(qp.tableName, where)
+	if err != nil {
+		return 0, err
+	}
+
+	return result, nil
+}
+
+// DailyStats aggregates the answer logs created at or after since into one
+// row per calendar day in loc, ascending by day. Days without logs are
+// omitted; callers zero-fill them.
+func (qp *QuestionAnswerLogPeer) DailyStats(since time.Time, loc *time.Location) ([]*models.QuestionAnswerLogDailyStats, error) {
+	var stats []*models.QuestionAnswerLogDailyStats
+
+	err := qp.db.Aggregate(qp.tableName,
+		[]database.Grouping{database.GroupByDate(schema.COMMON_CREATED_AT, loc, "day")},
+		[]database.Aggregation{
+			database.CountAll("answer_count"),
+			database.Sum(fmt.Sprintf("CASE WHEN %s THEN 1 ELSE 0 END", schema.QUESTION_ANSWER_LOG_IS_CORRECT), "correct_count"),
+		},
+		squirrel.GtOrEq{schema.COMMON_CREATED_AT: since.UTC()},
+		&stats,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return stats, nil
+}
@@ -1,14 +1,18 @@
 package peers
 
 import (
+	"time"
+
 	"word-flashcard/data/models"
 
 	"github.com/Masterminds/squirrel"
 )
 
 // QuestionAnswerLogPeerInterface defines the database operations for question answer logs.
-// This is append-only: only Select and Insert are needed, no Update/Delete.
+// This is append-only: only Select, Insert and read-only counts and aggregation are needed, no Update/Delete.
 type QuestionAnswerLogPeerInterface interface {
 	Select(columns []*string, where squirrel.Sqlizer, orderBy []*string, limit *uint64, offset *uint64) ([]*models.QuestionAnswerLog, error)
 	Insert(log *models.QuestionAnswerLog) (int64, error)
+	Count(where squirrel.Sqlizer) (int64, error)
+	DailyStats(since time.Time, loc *time.Location) ([]*models.QuestionAnswerLogDailyStats, error)
 }
@@ -0,0 +1,73 @@
+package peers
+
+import (
+	"word-flashcard/data/models"
+	"word-flashcard/data/schema"
+
+	"github.com/Masterminds/squirrel"
+)
+
+// QuestionOptionPeer provides database operations for QuestionOption business entities
+type QuestionOptionPeer struct {
+	*BasePeer
+	tableName string
+}
+
+// NewQuestionOptionPeer creates a new QuestionOptionPeer instance
+func NewQuestionOptionPeer() (*QuestionOptionPeer, error) {
+	base, err := NewBasePeer()
+	if err != nil {
+		return nil, err
+	}
+
+	return &QuestionOptionPeer{
+		BasePeer:  base,
+		tableName: schema.QUESTION_OPTION_TABLE_NAME,
+	}, nil
+}
+
+// Select retrieves QuestionOption records from the database based on the provided criteria
+func (op *QuestionOptionPeer) Select(columns []*string, where squirrel.Sqlizer, orderBy []*string, limit *uint64, offset *uint64) ([]*models.QuestionOption, error) {
+	var options []*models.QuestionOption
+
+	// Perform the select operation
+	err := op.db.Select(op.tableName, columns, where, orderBy, limit, offset, &options)
+	if err != nil {
+		return nil, err
+	}
+
+	return options, nil
+}
+
+// Insert adds a new QuestionOption record to the database
+func (op *QuestionOptionPeer) Insert(option *models.QuestionOption) (int64, error) {
+	// Perform the insert operation
+	result, err := op.db.Insert(op.tableName, option)
+	if err != nil {
+		return 0, err
+	}
+
+	return result, nil
+}
+
+// Update modifies an existing QuestionOption record in the database
+func (op *QuestionOptionPeer) Update(option *models.QuestionOption, where squirrel.Sqlizer) (int64, error) {
+	// Perform the update operation
+	result, err := op.db.Update(op.tableName, option, where)
+	if err != nil {
+		return 0, err
+	}
+
+	return result, nil
+}
+
+// Delete removes QuestionOption records from the database based on the provided criteria
+func (op *QuestionOptionPeer) Delete(where squirrel.Sqlizer) (int64, error) {
+	// Perform the delete operation
+	result, err := op.db.Delete(op.tableName, where)
+	if err != nil {
+		return 0, err
+	}
+
+	return result, nil
+}
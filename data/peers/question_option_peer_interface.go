@@ -0,0 +1,14 @@
+package peers
+
+import (
+	"word-flashcard/data/models"
+
+	"github.com/Masterminds/squirrel"
+)
+
+type QuestionOptionPeerInterface interface {
+	Select(columns []*string, where squirrel.Sqlizer, orderBy []*string, limit *uint64, offset *uint64) ([]*models.QuestionOption, error)
+	Insert(option *models.QuestionOption) (int64, error)
+	Update(option *models.QuestionOption, where squirrel.Sqlizer) (int64, error)
+	Delete(where squirrel.Sqlizer) (int64, error)
+}
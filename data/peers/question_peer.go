@@ -1,8 +1,12 @@
 package peers
 
 import (
+	"database/sql"
+	"fmt"
+
 	"word-flashcard/data/models"
 	"word-flashcard/data/schema"
+	"word-flashcard/utils/database"
 
 	"github.com/Masterminds/squirrel"
 )
@@ -11,6 +15,7 @@ import (
 type QuestionPeer struct {
 	*BasePeer
 	tableName string
+	dbType    string
 }
 
 // NewQuestionPeer creates a new QuestionPeer instance
@@ -20,9 +25,15 @@ func NewQuestionPeer() (*QuestionPeer, error) {
 		return nil, err
 	}
 
+	config, err := database.LoadConfig()
+	if err != nil {
+		return nil, err
+	}
+
 	return &QuestionPeer{
 		BasePeer:  base,
 		tableName: schema.QUESTION_TABLE_NAME,
+		dbType:    config.Type,
 	}, nil
 }
 
@@ -50,6 +61,95 @@ func (qp *QuestionPeer) Insert(question *models.Question) (int64, error) {
 	return result, nil
 }
 
+// InsertWithOptions inserts questions together with their options in a
+// single transaction and returns the new question IDs in the same order.
+// Any failure rolls back the whole transaction, so a question never ends
+// up without some of its options and a batch is never half stored.
+func (qp *QuestionPeer) InsertWithOptions(questions []*QuestionWithOptions) ([]int64, error) {
+	tx, err := qp.db.GetDB().Begin()
+	if err != nil {
+		return nil, fmt.Errorf("failed to begin question transaction: %w", err)
+	}
+
+	ids, err := qp.insertWithOptions(tx, questions)
+	if err != nil {
+		_ = tx.Rollback()
+		return nil, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, fmt.Errorf("failed to commit question transaction: %w", err)
+	}
+
+	return ids, nil
+}
+
+// insertWithOptions runs InsertWithOptions against an already-open transaction
+func (qp *QuestionPeer) insertWithOptions(tx *sql.Tx, questions []*QuestionWithOptions) ([]int64, error) {
+	ids := make([]int64, 0, len(questions))
+	for _, question := range questions {
+		id, err := insertRow(tx, qp.dbType, schema.QUESTION_TABLE_NAME, question.Question)
+		if err != nil {
+			return nil, err
+		}
+
+		questionID := int(id)
+		for _, option := range question.Options {
+			option.QuestionId = &questionID
+			if _, err := insertRow(tx, qp.dbType, schema.QUESTION_OPTION_TABLE_NAME, option); err != nil {
+				return nil, err
+			}
+		}
+		ids = append(ids, id)
+	}
+	return ids, nil
+}
+
+// UpdateWithOptions updates question questionID and replaces all of its
+// question_options rows with options (none drops them all) in a single
+// transaction, so a failure never leaves the question with its new type but
+// missing some of its options. It returns 0 without changing anything when
+// the question doesn't exist.
+func (qp *QuestionPeer) UpdateWithOptions(questionID int, question *models.Question, options []*models.QuestionOption) (int64, error) {
+	tx, err := qp.db.GetDB().Begin()
+	if err != nil {
+		return 0, fmt.Errorf("failed to begin question transaction: %w", err)
+	}
+
+	updated, err := qp.updateWithOptions(tx, questionID, question, options)
+	if err != nil || updated == 0 {
+		_ = tx.Rollback()
+		return 0, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return 0, fmt.Errorf("failed to commit question transaction: %w", err)
+	}
+
+	return updated, nil
+}
+
+// updateWithOptions runs UpdateWithOptions against an already-open transaction
+func (qp *QuestionPeer) updateWithOptions(tx *sql.Tx, questionID int, question *models.Question, options []*models.QuestionOption) (int64, error) {
+	updated, err := updateRow(tx, qp.dbType, schema.QUESTION_TABLE_NAME, question, squirrel.Eq{schema.QUESTION_ID: questionID})
+	if err != nil || updated == 0 {
+		return 0, err
+	}
+
+	optionsWhere := squirrel.Eq{schema.QUESTION_OPTION_QUESTION_ID: questionID}
+	if _, err := deleteRows(tx, qp.dbType, schema.QUESTION_OPTION_TABLE_NAME, optionsWhere); err != nil {
+		return 0, err
+	}
+
+	for _, option := range options {
+		option.QuestionId = &questionID
+		if _, err := insertRow(tx, qp.dbType, schema.QUESTION_OPTION_TABLE_NAME, option); err != nil {
+			return 0, err
+		}
+	}
+	return updated, nil
+}
+
 // Update modifies an existing Question record in the database
 func (qp *QuestionPeer) Update(question *models.Question, where squirrel.Sqlizer) (int64, error) {
 	// Perform the update operation
@@ -82,3 +182,26 @@ func (qp *QuestionPeer) Count() (int64, error) {
 
 	return result, nil
 }
+
+// CountByPractise returns how many questions share each combination of
+// question type, count_practise and count_failure_practise, which is all
+// accuracy statistics need without loading every question row
+func (qp *QuestionPeer) CountByPractise() ([]*models.QuestionPractiseGroup, error) {
+	var groups []*models.QuestionPractiseGroup
+
+	err := qp.db.Aggregate(qp.tableName,
+		[]database.Grouping{
+			database.GroupByColumn(schema.QUESTION_QUESTION_TYPE),
+			database.GroupByColumn(schema.QUESTION_COUNT_PRACTISE),
+			database.GroupByColumn(schema.QUESTION_COUNT_FAILURE_PRACTISE),
+		},
+		[]database.Aggregation{database.CountAll("question_count")},
+		nil,
+		&groups,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return groups, nil
+}
@@ -9,7 +9,18 @@ import (
 type QuestionPeerInterface interface {
 	Select(columns []*string, where squirrel.Sqlizer, orderBy []*string, limit *uint64, offset *uint64) ([]*models.Question, error)
 	Insert(question *models.Question) (int64, error)
+	InsertWithOptions(questions []*QuestionWithOptions) ([]int64, error)
 	Update(question *models.Question, where squirrel.Sqlizer) (int64, error)
+	UpdateWithOptions(questionID int, question *models.Question, options []*models.QuestionOption) (int64, error)
 	Delete(where squirrel.Sqlizer) (int64, error)
 	Count() (int64, error)
+	CountByPractise() ([]*models.QuestionPractiseGroup, error)
+}
+
+// QuestionWithOptions is a question to insert by InsertWithOptions together
+// with its question_options rows, whose question_id is filled in once the
+// question has one
+type QuestionWithOptions struct {
+	Question *models.Question
+	Options  []*models.QuestionOption
 }
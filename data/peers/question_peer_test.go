@@ -0,0 +1,188 @@
+package peers
+
+import (
+	"errors"
+	"testing"
+
+	"word-flashcard/data/models"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/suite"
+)
+
+// questionPeerTestSuite is a test suite for QuestionPeer
+type questionPeerTestSuite struct {
+	suite.Suite
+}
+
+// TestQuestionPeerSuite runs the questionPeerTestSuite
+func TestQuestionPeerSuite(t *testing.T) {
+	suite.Run(t, new(questionPeerTestSuite))
+}
+
+// TestInsertWithOptions verifies every question is inserted followed by its
+// options pointing at the new question ID, and a failure along the way is
+// surfaced without any further statement running.
+func (s *questionPeerTestSuite) TestInsertWithOptions() {
+	questions := func() []*QuestionWithOptions {
+		first, second := "Which words are colours?", "Spell the colour"
+		red, blue := "red", "blue"
+		position0, position1 := 0, 1
+		return []*QuestionWithOptions{
+			{
+				Question: &models.Question{Question: &first},
+				Options: []*models.QuestionOption{
+					{Position: &position0, Content: &red},
+					{Position: &position1, Content: &blue},
+				},
+			},
+			{Question: &models.Question{Question: &second}},
+		}
+	}
+
+	tests := []struct {
+		name      string
+		dbType    string
+		setupMock func(mock sqlmock.Sqlmock)
+		want      []int64
+		wantErr   bool
+	}{
+		{
+			name:   "questions are inserted with their options",
+			dbType: "mysql",
+			setupMock: func(mock sqlmock.Sqlmock) {
+				mock.ExpectExec("INSERT INTO questions").WillReturnResult(sqlmock.NewResult(7, 1))
+				mock.ExpectExec(`INSERT INTO question_options \(created_at,updated_at,question_id,position,content\)`).
+					WithArgs(sqlmock.AnyArg(), sqlmock.AnyArg(), 7, 0, "red").
+					WillReturnResult(sqlmock.NewResult(1, 1))
+				mock.ExpectExec("INSERT INTO question_options").
+					WithArgs(sqlmock.AnyArg(), sqlmock.AnyArg(), 7, 1, "blue").
+					WillReturnResult(sqlmock.NewResult(2, 1))
+				mock.ExpectExec("INSERT INTO questions").WillReturnResult(sqlmock.NewResult(8, 1))
+			},
+			want: []int64{7, 8},
+		},
+		{
+			name:   "postgresql reads the new ids back with RETURNING",
+			dbType: "postgresql",
+			setupMock: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery(`INSERT INTO questions .* RETURNING id`).WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(7))
+				mock.ExpectQuery(`INSERT INTO question_options .* RETURNING id`).WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(1))
+				mock.ExpectQuery(`INSERT INTO question_options .* RETURNING id`).WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(2))
+				mock.ExpectQuery(`INSERT INTO questions .* RETURNING id`).WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(8))
+			},
+			want: []int64{7, 8},
+		},
+		{
+			name:   "option insert failure stops the batch",
+			dbType: "mysql",
+			setupMock: func(mock sqlmock.Sqlmock) {
+				mock.ExpectExec("INSERT INTO questions").WillReturnResult(sqlmock.NewResult(7, 1))
+				mock.ExpectExec("INSERT INTO question_options").WillReturnError(errors.New("db down"))
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		s.Run(tt.name, func() {
+			db, mock, err := sqlmock.New()
+			s.Require().NoError(err)
+			defer db.Close()
+
+			mock.ExpectBegin()
+			tt.setupMock(mock)
+
+			tx, err := db.Begin()
+			s.Require().NoError(err)
+
+			qp := &QuestionPeer{dbType: tt.dbType}
+			ids, insertErr := qp.insertWithOptions(tx, questions())
+
+			if tt.wantErr {
+				s.Error(insertErr)
+			} else {
+				s.Require().NoError(insertErr)
+				s.Equal(tt.want, ids)
+			}
+
+			s.NoError(mock.ExpectationsWereMet())
+		})
+	}
+}
+
+// TestUpdateWithOptions verifies the question is updated, its old options
+// deleted and the new ones inserted, and that a missing question or a
+// failure stops before any further statement runs.
+func (s *questionPeerTestSuite) TestUpdateWithOptions() {
+	options := func() []*models.QuestionOption {
+		red := "red"
+		position0 := 0
+		return []*models.QuestionOption{{Position: &position0, Content: &red}}
+	}
+
+	tests := []struct {
+		name      string
+		setupMock func(mock sqlmock.Sqlmock)
+		want      int64
+		wantErr   bool
+	}{
+		{
+			name: "question is updated and its options replaced",
+			setupMock: func(mock sqlmock.Sqlmock) {
+				mock.ExpectExec(`UPDATE questions SET updated_at = \?, question = \? WHERE id = \?`).
+					WithArgs(sqlmock.AnyArg(), "Which words are colours?", 5).
+					WillReturnResult(sqlmock.NewResult(0, 1))
+				mock.ExpectExec(`DELETE FROM question_options WHERE question_id = \?`).
+					WithArgs(5).
+					WillReturnResult(sqlmock.NewResult(0, 3))
+				mock.ExpectExec(`INSERT INTO question_options \(created_at,updated_at,question_id,position,content\)`).
+					WithArgs(sqlmock.AnyArg(), sqlmock.AnyArg(), 5, 0, "red").
+					WillReturnResult(sqlmock.NewResult(1, 1))
+			},
+			want: 1,
+		},
+		{
+			name: "missing question leaves the options alone",
+			setupMock: func(mock sqlmock.Sqlmock) {
+				mock.ExpectExec("UPDATE questions").WillReturnResult(sqlmock.NewResult(0, 0))
+			},
+			want: 0,
+		},
+		{
+			name: "delete failure stops before inserting",
+			setupMock: func(mock sqlmock.Sqlmock) {
+				mock.ExpectExec("UPDATE questions").WillReturnResult(sqlmock.NewResult(0, 1))
+				mock.ExpectExec("DELETE FROM question_options").WillReturnError(errors.New("db down"))
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		s.Run(tt.name, func() {
+			db, mock, err := sqlmock.New()
+			s.Require().NoError(err)
+			defer db.Close()
+
+			mock.ExpectBegin()
+			tt.setupMock(mock)
+
+			tx, err := db.Begin()
+			s.Require().NoError(err)
+
+			text := "Which words are colours?"
+			qp := &QuestionPeer{dbType: "mysql"}
+			updated, updateErr := qp.updateWithOptions(tx, 5, &models.Question{Question: &text}, options())
+
+			if tt.wantErr {
+				s.Error(updateErr)
+			} else {
+				s.Require().NoError(updateErr)
+				s.Equal(tt.want, updated)
+			}
+
+			s.NoError(mock.ExpectationsWereMet())
+		})
+	}
+}
@@ -0,0 +1,51 @@
+package peers
+
+import (
+	"word-flashcard/data/models"
+	"word-flashcard/data/schema"
+
+	"github.com/Masterminds/squirrel"
+)
+
+// ReminderLogPeer provides database operations for ReminderLog business entities
+type ReminderLogPeer struct {
+	*BasePeer
+	tableName string
+}
+
+// NewReminderLogPeer creates a new ReminderLogPeer instance
+func NewReminderLogPeer() (*ReminderLogPeer, error) {
+	base, err := NewBasePeer()
+	if err != nil {
+		return nil, err
+	}
+
+	return &ReminderLogPeer{
+		BasePeer:  base,
+		tableName: schema.REMINDER_LOG_TABLE_NAME,
+	}, nil
+}
+
+// Select retrieves ReminderLog records from the database based on the provided criteria
+func (rlp *ReminderLogPeer) Select(columns []*string, where squirrel.Sqlizer, orderBy []*string, limit *uint64, offset *uint64) ([]*models.ReminderLog, error) {
+	var reminderLogs []*models.ReminderLog
+
+	// Perform the select operation
+	err := rlp.db.Select(rlp.tableName, columns, where, orderBy, limit, offset, &reminderLogs)
+	if err != nil {
+		return nil, err
+	}
+
+	return reminderLogs, nil
+}
+
+// Insert adds a new ReminderLog record to the database
+func (rlp *ReminderLogPeer) Insert(reminderLog *models.ReminderLog) (int64, error) {
+	// Perform the insert operation
+	result, err := rlp.db.Insert(rlp.tableName, reminderLog)
+	if err != nil {
+		return 0, err
+	}
+
+	return result, nil
+}
@@ -0,0 +1,14 @@
+package peers
+
+import (
+	"word-flashcard/data/models"
+
+	"github.com/Masterminds/squirrel"
+)
+
+// ReminderLogPeerInterface defines the database operations for reminder logs.
+// This is append-only: only Select and Insert are needed, no Update/Delete.
+type ReminderLogPeerInterface interface {
+	Select(columns []*string, where squirrel.Sqlizer, orderBy []*string, limit *uint64, offset *uint64) ([]*models.ReminderLog, error)
+	Insert(reminderLog *models.ReminderLog) (int64, error)
+}
@@ -0,0 +1,290 @@
+package peers
+
+import (
+	"database/sql"
+	"fmt"
+	"strings"
+	"time"
+
+	"word-flashcard/data/models"
+	"word-flashcard/data/schema"
+	"word-flashcard/utils/database"
+
+	"github.com/Masterminds/squirrel"
+)
+
+// ReminderPeer provides database operations for Reminder business entities
+type ReminderPeer struct {
+	*BasePeer
+	tableName string
+	dbType    string
+}
+
+// NewReminderPeer creates a new ReminderPeer instance
+func NewReminderPeer() (*ReminderPeer, error) {
+	base, err := NewBasePeer()
+	if err != nil {
+		return nil, err
+	}
+
+	config, err := database.LoadConfig()
+	if err != nil {
+		return nil, err
+	}
+
+	return &ReminderPeer{
+		BasePeer:  base,
+		tableName: schema.REMINDER_TABLE_NAME,
+		dbType:    config.Type,
+	}, nil
+}
+
+// Select retrieves Reminder records from the database based on the provided criteria
+func (rp *ReminderPeer) Select(columns []*string, where squirrel.Sqlizer, orderBy []*string, limit *uint64, offset *uint64) ([]*models.Reminder, error) {
+	var reminders []*models.Reminder
+
+	// Perform the select operation
+	err := rp.db.Select(rp.tableName, columns, where, orderBy, limit, offset, &reminders)
+	if err != nil {
+		return nil, err
+	}
+
+	return reminders, nil
+}
+
+// Insert adds a new Reminder record to the database
+func (rp *ReminderPeer) Insert(reminder *models.Reminder) (int64, error) {
+	// Perform the insert operation
+	result, err := rp.db.Insert(rp.tableName, reminder)
+	if err != nil {
+		return 0, err
+	}
+
+	return result, nil
+}
+
+// Update modifies an existing Reminder record in the database
+func (rp *ReminderPeer) Update(reminder *models.Reminder, where squirrel.Sqlizer) (int64, error) {
+	// Perform the update operation
+	result, err := rp.db.Update(rp.tableName, reminder, where)
+	if err != nil {
+		return 0, err
+	}
+
+	return result, nil
+}
+
+// Delete removes Reminder records from the database based on the provided criteria
+func (rp *ReminderPeer) Delete(where squirrel.Sqlizer) (int64, error) {
+	// Perform the delete operation
+	result, err := rp.db.Delete(rp.tableName, where)
+	if err != nil {
+		return 0, err
+	}
+
+	return result, nil
+}
+
+// Clear marks reminder as done: it records the reminder in reminder_logs as
+// cleared, then moves it to next's due time or, when next is nil, deletes
+// it, all in a single transaction so a reminder is never cleared without
+// its history row or the other way round. It returns the history row, or
+// nil without changing anything when the reminder no longer exists.
+func (rp *ReminderPeer) Clear(reminder *models.Reminder, next *models.Reminder) (*models.ReminderLog, error) {
+	tx, err := rp.db.GetDB().Begin()
+	if err != nil {
+		return nil, fmt.Errorf("failed to begin reminder transaction: %w", err)
+	}
+
+	entry, err := rp.clear(tx, reminder, next)
+	if err != nil || entry == nil {
+		_ = tx.Rollback()
+		return nil, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, fmt.Errorf("failed to commit reminder transaction: %w", err)
+	}
+
+	return entry, nil
+}
+
+// clear runs Clear against an already-open transaction
+func (rp *ReminderPeer) clear(tx *sql.Tx, reminder *models.Reminder, next *models.Reminder) (*models.ReminderLog, error) {
+	where := squirrel.Eq{schema.REMINDER_ID: *reminder.Id}
+	var changed int64
+	var err error
+	if next == nil {
+		changed, err = deleteRows(tx, rp.dbType, rp.tableName, where)
+	} else {
+		changed, err = updateRow(tx, rp.dbType, rp.tableName, next, where)
+	}
+	if err != nil || changed == 0 {
+		return nil, err
+	}
+
+	entry := reminderHistory(reminder, schema.REMINDER_ACTION_CLEARED)
+	id, err := insertRow(tx, rp.dbType, schema.REMINDER_LOG_TABLE_NAME, entry)
+	if err != nil {
+		return nil, err
+	}
+	now := time.Now().UTC()
+	logID := int(id)
+	entry.Id, entry.CreatedAt = &logID, &now
+	return entry, nil
+}
+
+// DeleteWithHistory deletes the reminders matching where, recording each in
+// reminder_logs as deleted in the same transaction. It returns how many
+// reminders were deleted; matching none is not an error.
+func (rp *ReminderPeer) DeleteWithHistory(where squirrel.Sqlizer) (int64, error) {
+	reminders, err := rp.Select([]*string{}, where, nil, nil, nil)
+	if err != nil || len(reminders) == 0 {
+		return 0, err
+	}
+
+	tx, err := rp.db.GetDB().Begin()
+	if err != nil {
+		return 0, fmt.Errorf("failed to begin reminder transaction: %w", err)
+	}
+
+	deleted, err := rp.deleteWithHistory(tx, reminders)
+	if err != nil {
+		_ = tx.Rollback()
+		return 0, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return 0, fmt.Errorf("failed to commit reminder transaction: %w", err)
+	}
+
+	return deleted, nil
+}
+
+// deleteWithHistory runs DeleteWithHistory against an already-open
+// transaction, for reminders already read
+func (rp *ReminderPeer) deleteWithHistory(tx *sql.Tx, reminders []*models.Reminder) (int64, error) {
+	ids := make([]int, 0, len(reminders))
+	for _, reminder := range reminders {
+		if _, err := insertRow(tx, rp.dbType, schema.REMINDER_LOG_TABLE_NAME, reminderHistory(reminder, schema.REMINDER_ACTION_DELETED)); err != nil {
+			return 0, err
+		}
+		ids = append(ids, *reminder.Id)
+	}
+	return deleteRows(tx, rp.dbType, rp.tableName, squirrel.Eq{schema.REMINDER_ID: ids})
+}
+
+// reminderHistory copies reminder into a reminder log recording action
+func reminderHistory(reminder *models.Reminder, action string) *models.ReminderLog {
+	return &models.ReminderLog{
+		ReminderId: reminder.Id,
+		EntityType: reminder.EntityType,
+		EntityId:   reminder.EntityId,
+		DueAt:      reminder.DueAt,
+		Recurrence: reminder.Recurrence,
+		Note:       reminder.Note,
+		Action:     &action,
+	}
+}
+
+// MigrateWordReminders turns the deprecated free-text words.reminder markers
+// into reminders rows due at dueAt, keeping each marker as its reminder's
+// note; words that already have a reminder are skipped. It only ever runs
+// once: a settings row records that it did, in the same transaction as the
+// new reminders, so a reminder cleared afterwards isn't recreated from its
+// marker. It returns how many reminders it created.
+func (rp *ReminderPeer) MigrateWordReminders(dueAt time.Time) (int64, error) {
+	tx, err := rp.db.GetDB().Begin()
+	if err != nil {
+		return 0, fmt.Errorf("failed to begin reminder transaction: %w", err)
+	}
+
+	created, err := rp.migrateWordReminders(tx, dueAt)
+	if err != nil {
+		_ = tx.Rollback()
+		return 0, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return 0, fmt.Errorf("failed to commit reminder transaction: %w", err)
+	}
+
+	return created, nil
+}
+
+// migrateWordReminders runs MigrateWordReminders against an already-open
+// transaction
+func (rp *ReminderPeer) migrateWordReminders(tx *sql.Tx, dueAt time.Time) (int64, error) {
+	pf := placeholderFormat(rp.dbType)
+	sqlStr, args, err := squirrel.Select("COUNT(*)").
+		From(schema.SETTING_TABLE_NAME).
+		Where(squirrel.Eq{schema.SETTING_NAME: schema.SETTING_WORD_REMINDERS_MIGRATED}).
+		PlaceholderFormat(pf).
+		ToSql()
+	if err != nil {
+		return 0, fmt.Errorf("failed to build migration lookup: %w", err)
+	}
+	var migrated int
+	if err := tx.QueryRow(sqlStr, args...).Scan(&migrated); err != nil {
+		return 0, fmt.Errorf("failed to read migration state: %w", err)
+	}
+	if migrated > 0 {
+		return 0, nil
+	}
+
+	withReminder := fmt.Sprintf("%s NOT IN (SELECT %s FROM %s WHERE %s = ?)",
+		schema.WORD_ID, schema.REMINDER_ENTITY_ID, schema.REMINDER_TABLE_NAME, schema.REMINDER_ENTITY_TYPE)
+	sqlStr, args, err = squirrel.Select(schema.WORD_ID, schema.WORD_REMINDER).
+		From(schema.WORD_TABLE_NAME).
+		Where(squirrel.And{
+			squirrel.NotEq{schema.WORD_REMINDER: nil},
+			squirrel.NotEq{schema.WORD_REMINDER: ""},
+			squirrel.Expr(withReminder, schema.ENTITY_TYPE_WORD),
+		}).
+		OrderBy(schema.WORD_ID).
+		PlaceholderFormat(pf).
+		ToSql()
+	if err != nil {
+		return 0, fmt.Errorf("failed to build word reminder lookup: %w", err)
+	}
+	rows, err := tx.Query(sqlStr, args...)
+	if err != nil {
+		return 0, fmt.Errorf("failed to read word reminders: %w", err)
+	}
+
+	var reminders []*models.Reminder
+	for rows.Next() {
+		var wordID int
+		var marker string
+		if err := rows.Scan(&wordID, &marker); err != nil {
+			rows.Close()
+			return 0, fmt.Errorf("failed to read word reminders: %w", err)
+		}
+		if note := strings.TrimSpace(marker); note != "" {
+			entityType, notOverdue := schema.ENTITY_TYPE_WORD, false
+			reminders = append(reminders, &models.Reminder{
+				EntityType: &entityType,
+				EntityId:   &wordID,
+				DueAt:      &dueAt,
+				Note:       &note,
+				IsOverdue:  &notOverdue,
+			})
+		}
+	}
+	rows.Close()
+	if err := rows.Err(); err != nil {
+		return 0, fmt.Errorf("failed to read word reminders: %w", err)
+	}
+
+	for _, reminder := range reminders {
+		if _, err := insertRow(tx, rp.dbType, rp.tableName, reminder); err != nil {
+			return 0, err
+		}
+	}
+
+	name, value := schema.SETTING_WORD_REMINDERS_MIGRATED, "true"
+	if _, err := insertRow(tx, rp.dbType, schema.SETTING_TABLE_NAME, &models.Setting{Name: &name, Value: &value}); err != nil {
+		return 0, err
+	}
+	return int64(len(reminders)), nil
+}
@@ -0,0 +1,16 @@
+package peers
+
+import (
+	"word-flashcard/data/models"
+
+	"github.com/Masterminds/squirrel"
+)
+
+type ReminderPeerInterface interface {
+	Select(columns []*string, where squirrel.Sqlizer, orderBy []*string, limit *uint64, offset *uint64) ([]*models.Reminder, error)
+	Insert(reminder *models.Reminder) (int64, error)
+	Update(reminder *models.Reminder, where squirrel.Sqlizer) (int64, error)
+	Delete(where squirrel.Sqlizer) (int64, error)
+	Clear(reminder *models.Reminder, next *models.Reminder) (*models.ReminderLog, error)
+	DeleteWithHistory(where squirrel.Sqlizer) (int64, error)
+}
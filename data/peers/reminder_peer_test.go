@@ -0,0 +1,255 @@
+package peers
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"word-flashcard/data/models"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/suite"
+)
+
+// reminderPeerTestSuite is a test suite for ReminderPeer
+type reminderPeerTestSuite struct {
+	suite.Suite
+}
+
+// TestReminderPeerSuite runs the reminderPeerTestSuite
+func TestReminderPeerSuite(t *testing.T) {
+	suite.Run(t, new(reminderPeerTestSuite))
+}
+
+// sampleReminder returns a one-off reminder of word 3
+func sampleReminder(id int) *models.Reminder {
+	entityType, entityID, note := "word", 3, "check the phrasal verbs"
+	dueAt := time.Date(2026, 5, 10, 9, 0, 0, 0, time.UTC)
+	return &models.Reminder{Id: &id, EntityType: &entityType, EntityId: &entityID, DueAt: &dueAt, Note: &note}
+}
+
+// TestClear verifies a one-off reminder is deleted and a recurring one moved
+// ahead before the history row is written, and that a missing reminder or a
+// failure stops before any further statement runs.
+func (s *reminderPeerTestSuite) TestClear() {
+	nextDue := time.Date(2026, 5, 17, 9, 0, 0, 0, time.UTC)
+	notOverdue := false
+
+	tests := []struct {
+		name      string
+		next      *models.Reminder
+		setupMock func(mock sqlmock.Sqlmock)
+		wantID    int
+		wantNil   bool
+		wantErr   bool
+	}{
+		{
+			name: "one-off reminder is deleted and logged",
+			setupMock: func(mock sqlmock.Sqlmock) {
+				mock.ExpectExec(`DELETE FROM reminders WHERE id = \?`).
+					WithArgs(5).
+					WillReturnResult(sqlmock.NewResult(0, 1))
+				mock.ExpectExec(`INSERT INTO reminder_logs \(created_at,updated_at,reminder_id,entity_type,entity_id,due_at,note,action\)`).
+					WithArgs(sqlmock.AnyArg(), sqlmock.AnyArg(), 5, "word", 3, sqlmock.AnyArg(), "check the phrasal verbs", "cleared").
+					WillReturnResult(sqlmock.NewResult(9, 1))
+			},
+			wantID: 9,
+		},
+		{
+			name: "recurring reminder is moved ahead and logged",
+			next: &models.Reminder{DueAt: &nextDue, IsOverdue: &notOverdue},
+			setupMock: func(mock sqlmock.Sqlmock) {
+				mock.ExpectExec(`UPDATE reminders SET updated_at = \?, due_at = \?, is_overdue = \? WHERE id = \?`).
+					WithArgs(sqlmock.AnyArg(), nextDue, false, 5).
+					WillReturnResult(sqlmock.NewResult(0, 1))
+				mock.ExpectExec("INSERT INTO reminder_logs").WillReturnResult(sqlmock.NewResult(10, 1))
+			},
+			wantID: 10,
+		},
+		{
+			name: "missing reminder is not logged",
+			setupMock: func(mock sqlmock.Sqlmock) {
+				mock.ExpectExec("DELETE FROM reminders").WillReturnResult(sqlmock.NewResult(0, 0))
+			},
+			wantNil: true,
+		},
+		{
+			name: "delete failure stops before logging",
+			setupMock: func(mock sqlmock.Sqlmock) {
+				mock.ExpectExec("DELETE FROM reminders").WillReturnError(errors.New("db down"))
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		s.Run(tt.name, func() {
+			db, mock, err := sqlmock.New()
+			s.Require().NoError(err)
+			defer db.Close()
+
+			mock.ExpectBegin()
+			tt.setupMock(mock)
+
+			tx, err := db.Begin()
+			s.Require().NoError(err)
+
+			rp := &ReminderPeer{tableName: "reminders", dbType: "mysql"}
+			entry, clearErr := rp.clear(tx, sampleReminder(5), tt.next)
+
+			switch {
+			case tt.wantErr:
+				s.Error(clearErr)
+			case tt.wantNil:
+				s.Require().NoError(clearErr)
+				s.Nil(entry)
+			default:
+				s.Require().NoError(clearErr)
+				s.Require().NotNil(entry)
+				s.Equal(tt.wantID, *entry.Id)
+				s.Equal("cleared", *entry.Action)
+				s.NotNil(entry.CreatedAt)
+			}
+
+			s.NoError(mock.ExpectationsWereMet())
+		})
+	}
+}
+
+// TestDeleteWithHistory verifies every reminder is logged as deleted before
+// they are deleted together, and a failure along the way is surfaced
+// without any further statement running.
+func (s *reminderPeerTestSuite) TestDeleteWithHistory() {
+	tests := []struct {
+		name      string
+		setupMock func(mock sqlmock.Sqlmock)
+		want      int64
+		wantErr   bool
+	}{
+		{
+			name: "reminders are logged then deleted",
+			setupMock: func(mock sqlmock.Sqlmock) {
+				mock.ExpectExec("INSERT INTO reminder_logs").
+					WithArgs(sqlmock.AnyArg(), sqlmock.AnyArg(), 5, "word", 3, sqlmock.AnyArg(), "check the phrasal verbs", "deleted").
+					WillReturnResult(sqlmock.NewResult(1, 1))
+				mock.ExpectExec("INSERT INTO reminder_logs").
+					WithArgs(sqlmock.AnyArg(), sqlmock.AnyArg(), 6, "word", 3, sqlmock.AnyArg(), "check the phrasal verbs", "deleted").
+					WillReturnResult(sqlmock.NewResult(2, 1))
+				mock.ExpectExec(`DELETE FROM reminders WHERE id IN \(\?,\?\)`).
+					WithArgs(5, 6).
+					WillReturnResult(sqlmock.NewResult(0, 2))
+			},
+			want: 2,
+		},
+		{
+			name: "log failure stops before deleting",
+			setupMock: func(mock sqlmock.Sqlmock) {
+				mock.ExpectExec("INSERT INTO reminder_logs").WillReturnError(errors.New("db down"))
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		s.Run(tt.name, func() {
+			db, mock, err := sqlmock.New()
+			s.Require().NoError(err)
+			defer db.Close()
+
+			mock.ExpectBegin()
+			tt.setupMock(mock)
+
+			tx, err := db.Begin()
+			s.Require().NoError(err)
+
+			rp := &ReminderPeer{tableName: "reminders", dbType: "mysql"}
+			deleted, deleteErr := rp.deleteWithHistory(tx, []*models.Reminder{sampleReminder(5), sampleReminder(6)})
+
+			if tt.wantErr {
+				s.Error(deleteErr)
+			} else {
+				s.Require().NoError(deleteErr)
+				s.Equal(tt.want, deleted)
+			}
+
+			s.NoError(mock.ExpectationsWereMet())
+		})
+	}
+}
+
+// TestMigrateWordReminders verifies every word with a non-blank marker and
+// no reminder gets one carrying the marker as its note, the migration is
+// recorded, and it does nothing once recorded.
+func (s *reminderPeerTestSuite) TestMigrateWordReminders() {
+	dueAt := time.Date(2026, 5, 10, 9, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name      string
+		setupMock func(mock sqlmock.Sqlmock)
+		want      int64
+		wantErr   bool
+	}{
+		{
+			name: "markers become reminders and the migration is recorded",
+			setupMock: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery(`SELECT COUNT\(\*\) FROM settings WHERE name = \?`).
+					WithArgs("word_reminders_migrated").
+					WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(0))
+				mock.ExpectQuery(`SELECT id, reminder FROM words WHERE \(reminder IS NOT NULL AND reminder <> \? AND `+
+					`id NOT IN \(SELECT entity_id FROM reminders WHERE entity_type = \?\)\) ORDER BY id`).
+					WithArgs("", "word").
+					WillReturnRows(sqlmock.NewRows([]string{"id", "reminder"}).AddRow(3, " review idioms ").AddRow(4, "   "))
+				mock.ExpectExec(`INSERT INTO reminders \(created_at,updated_at,entity_type,entity_id,due_at,note,is_overdue\)`).
+					WithArgs(sqlmock.AnyArg(), sqlmock.AnyArg(), "word", 3, dueAt, "review idioms", false).
+					WillReturnResult(sqlmock.NewResult(1, 1))
+				mock.ExpectExec(`INSERT INTO settings \(created_at,updated_at,name,value\)`).
+					WithArgs(sqlmock.AnyArg(), sqlmock.AnyArg(), "word_reminders_migrated", "true").
+					WillReturnResult(sqlmock.NewResult(1, 1))
+			},
+			want: 1,
+		},
+		{
+			name: "an applied migration is not run again",
+			setupMock: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery("SELECT COUNT").WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(1))
+			},
+			want: 0,
+		},
+		{
+			name: "insert failure is surfaced before the migration is recorded",
+			setupMock: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery("SELECT COUNT").WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(0))
+				mock.ExpectQuery("SELECT id, reminder FROM words").
+					WillReturnRows(sqlmock.NewRows([]string{"id", "reminder"}).AddRow(3, "review idioms"))
+				mock.ExpectExec("INSERT INTO reminders").WillReturnError(errors.New("db down"))
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		s.Run(tt.name, func() {
+			db, mock, err := sqlmock.New()
+			s.Require().NoError(err)
+			defer db.Close()
+
+			mock.ExpectBegin()
+			tt.setupMock(mock)
+
+			tx, err := db.Begin()
+			s.Require().NoError(err)
+
+			rp := &ReminderPeer{tableName: "reminders", dbType: "mysql"}
+			created, migrateErr := rp.migrateWordReminders(tx, dueAt)
+
+			if tt.wantErr {
+				s.Error(migrateErr)
+			} else {
+				s.Require().NoError(migrateErr)
+				s.Equal(tt.want, created)
+			}
+
+			s.NoError(mock.ExpectationsWereMet())
+		})
+	}
+}
@@ -0,0 +1,59 @@
+package peers
+
+import (
+	"word-flashcard/data/models"
+	"word-flashcard/data/schema"
+
+	"github.com/Masterminds/squirrel"
+)
+
+// SettingPeer provides database operations for Setting business entities
+type SettingPeer struct {
+	*BasePeer
+	tableName string
+}
+
+// NewSettingPeer creates a new SettingPeer instance
+func NewSettingPeer() (*SettingPeer, error) {
+	base, err := NewBasePeer()
+	if err != nil {
+		return nil, err
+	}
+
+	return &SettingPeer{
+		BasePeer:  base,
+		tableName: schema.SETTING_TABLE_NAME,
+	}, nil
+}
+
+// Select retrieves Setting records from the database based on the provided criteria
+func (sp *SettingPeer) Select(columns []*string, where squirrel.Sqlizer, orderBy []*string, limit *uint64, offset *uint64) ([]*models.Setting, error) {
+	var settings []*models.Setting
+
+	err := sp.db.Select(sp.tableName, columns, where, orderBy, limit, offset, &settings)
+	if err != nil {
+		return nil, err
+	}
+
+	return settings, nil
+}
+
+// Insert adds a new Setting record to the database
+func (sp *SettingPeer) Insert(setting *models.Setting) (int64, error) {
+	result, err := sp.db.Insert(sp.tableName, setting)
+	if err != nil {
+		return 0, err
+	}
+
+	return result, nil
+}
+
+// Update modifies an existing Setting record in the database
+func (sp *SettingPeer) Update(setting *models.Setting, where squirrel.Sqlizer) (int64, error) {
+	result, err := sp.db.Update(sp.tableName, setting, where)
+	if err != nil {
+		return 0, err
+	}
+
+	return result, nil
+}
@@ -0,0 +1,13 @@
+package peers
+
+import (
+	"word-flashcard/data/models"
+
+	"github.com/Masterminds/squirrel"
+)
+
+type SettingPeerInterface interface {
+	Select(columns []*string, where squirrel.Sqlizer, orderBy []*string, limit *uint64, offset *uint64) ([]*models.Setting, error)
+	Insert(setting *models.Setting) (int64, error)
+	Update(setting *models.Setting, where squirrel.Sqlizer) (int64, error)
+}
@@ -1,8 +1,16 @@
 package peers
 
 import (
+	"database/sql"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
 	"word-flashcard/data/models"
 	"word-flashcard/data/schema"
+	"word-flashcard/utils/database"
 
 	"github.com/Masterminds/squirrel"
 )
@@ -11,6 +19,7 @@ import (
 type WordPeer struct {
 	*BasePeer
 	tableName string
+	dbType    string
 }
 
 // NewWordPeer creates a new WordPeer instance
@@ -20,9 +29,15 @@ func NewWordPeer() (*WordPeer, error) {
 		return nil, err
 	}
 
+	config, err := database.LoadConfig()
+	if err != nil {
+		return nil, err
+	}
+
 	return &WordPeer{
 		BasePeer:  base,
 		tableName: schema.WORD_TABLE_NAME,
+		dbType:    config.Type,
 	}, nil
 }
 
@@ -82,3 +97,607 @@ func (wp *WordPeer) Count(where squirrel.Sqlizer) (int64, error) {
 
 	return result, nil
 }
+
+// CountByPractiseCount returns how many words share each count_practise
+// value, ascending by count_practise, so practice histograms don't need
+// every word row
+func (wp *WordPeer) CountByPractiseCount() ([]*models.WordPractiseCountGroup, error) {
+	var groups []*models.WordPractiseCountGroup
+
+	err := wp.db.Aggregate(wp.tableName,
+		[]database.Grouping{database.GroupByColumn(schema.WORD_COUNT_PRACTISE)},
+		[]database.Aggregation{database.CountAll("word_count")},
+		nil,
+		&groups,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return groups, nil
+}
+
+// SaveWithDefinitions writes word and definitions in a single transaction.
+// A new word is inserted with all of its definitions; when a word with the
+// same text already exists, the definitions are merged into it instead,
+// skipping any it already has with the same part of speech and definition
+// text. Any failure rolls back the whole transaction, so a word never ends
+// up with only some of its definitions.
+func (wp *WordPeer) SaveWithDefinitions(word *models.Word, definitions []*models.WordDefinition) (*SavedWord, error) {
+	tx, err := wp.db.GetDB().Begin()
+	if err != nil {
+		return nil, fmt.Errorf("failed to begin word transaction: %w", err)
+	}
+
+	saved, err := wp.saveWithDefinitions(tx, word, definitions)
+	if err != nil {
+		_ = tx.Rollback()
+		return nil, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, fmt.Errorf("failed to commit word transaction: %w", err)
+	}
+
+	return saved, nil
+}
+
+// saveWithDefinitions runs SaveWithDefinitions against an already-open
+// transaction. The existing word is read FOR UPDATE so two merges into the
+// same word can't both add the same definition.
+func (wp *WordPeer) saveWithDefinitions(tx *sql.Tx, word *models.Word, definitions []*models.WordDefinition) (*SavedWord, error) {
+	if word.Word == nil {
+		return nil, fmt.Errorf("word text is required")
+	}
+	pf := placeholderFormat(wp.dbType)
+	saved := &SavedWord{}
+
+	sqlStr, args, err := squirrel.Select(schema.WORD_ID).
+		From(schema.WORD_TABLE_NAME).
+		Where(squirrel.Eq{schema.WORD_WORD: *word.Word}).
+		Suffix("FOR UPDATE").
+		PlaceholderFormat(pf).
+		ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("failed to build word lookup: %w", err)
+	}
+	err = tx.QueryRow(sqlStr, args...).Scan(&saved.WordID)
+	switch {
+	case errors.Is(err, sql.ErrNoRows):
+		if saved.WordID, err = insertRow(tx, wp.dbType, schema.WORD_TABLE_NAME, word); err != nil {
+			return nil, err
+		}
+		saved.Created = true
+	case err != nil:
+		return nil, fmt.Errorf("failed to look up word: %w", err)
+	}
+
+	existing := make(map[string]bool)
+	if !saved.Created {
+		if existing, err = existingDefinitionKeys(tx, pf, saved.WordID); err != nil {
+			return nil, err
+		}
+	}
+
+	wordID := int(saved.WordID)
+	for _, definition := range definitions {
+		key := definitionKey(definition.PartOfSpeech, definition.Definition)
+		if existing[key] {
+			saved.Skipped++
+			continue
+		}
+		existing[key] = true
+
+		definition.WordId = &wordID
+		if _, err := insertRow(tx, wp.dbType, schema.WORD_DEFINITIONS_TABLE_NAME, definition); err != nil {
+			return nil, err
+		}
+		saved.Added++
+	}
+
+	return saved, nil
+}
+
+// existingDefinitionKeys returns the definitionKey of every definition the
+// word with wordID already has
+func existingDefinitionKeys(tx *sql.Tx, pf squirrel.PlaceholderFormat, wordID int64) (map[string]bool, error) {
+	sqlStr, args, err := squirrel.Select(schema.WORD_DEFINITIONS_PART_OF_SPEECH, schema.WORD_DEFINITIONS_DEFINITION).
+		From(schema.WORD_DEFINITIONS_TABLE_NAME).
+		Where(squirrel.Eq{schema.WORD_DEFINITIONS_WORD_ID: wordID}).
+		PlaceholderFormat(pf).
+		ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("failed to build definition lookup: %w", err)
+	}
+
+	rows, err := tx.Query(sqlStr, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read definitions: %w", err)
+	}
+	defer rows.Close()
+
+	keys := make(map[string]bool)
+	for rows.Next() {
+		var partOfSpeech, definition sql.NullString
+		if err := rows.Scan(&partOfSpeech, &definition); err != nil {
+			return nil, fmt.Errorf("failed to read definitions: %w", err)
+		}
+		keys[definitionKey(&partOfSpeech.String, &definition.String)] = true
+	}
+	return keys, rows.Err()
+}
+
+// definitionKey identifies a definition by its part of speech and text,
+// ignoring case and surrounding whitespace
+func definitionKey(partOfSpeech, definition *string) string {
+	var pos, text string
+	if partOfSpeech != nil {
+		pos = strings.ToLower(strings.TrimSpace(*partOfSpeech))
+	}
+	if definition != nil {
+		text = strings.ToLower(strings.TrimSpace(*definition))
+	}
+	return pos + "\x00" + text
+}
+
+// familiarityRank orders familiarity levels from least to most familiar, so
+// merged words can keep the more conservative one
+var familiarityRank = map[string]int{
+	schema.WORD_FAMILIARITY_RED:    0,
+	schema.WORD_FAMILIARITY_YELLOW: 1,
+	schema.WORD_FAMILIARITY_GREEN:  2,
+}
+
+// mergedWordRow holds the words columns MergeWords combines
+type mergedWordRow struct {
+	id              int64
+	familiarity     sql.NullString
+	reminder        sql.NullString
+	countPractise   sql.NullInt64
+	lastPracticedAt sql.NullTime
+}
+
+// MergeWords merges the words with sourceIDs into the word with targetID in
+// a single transaction. The sources' definitions, practice logs, links and
+// reminders are moved to the target, dropping definitions the target
+// already has with the same part of speech and text, links it already has,
+// and all but one reminder (its own, or else the source reminder due
+// first). The target then gets the sum of their practice counts, the latest practice
+// time and the least familiar familiarity of them all, keeps its reminder
+// (or takes the first source's when it has none), and the sources are
+// deleted. ErrWordNotFound is returned when any of the words doesn't exist.
+func (wp *WordPeer) MergeWords(targetID int64, sourceIDs []int64) (*MergedWord, error) {
+	tx, err := wp.db.GetDB().Begin()
+	if err != nil {
+		return nil, fmt.Errorf("failed to begin merge transaction: %w", err)
+	}
+
+	merged, err := wp.mergeWords(tx, targetID, sourceIDs)
+	if err != nil {
+		_ = tx.Rollback()
+		return nil, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, fmt.Errorf("failed to commit merge transaction: %w", err)
+	}
+
+	return merged, nil
+}
+
+// mergeWords runs MergeWords against an already-open transaction. Every
+// word involved is read FOR UPDATE first, so a practice answer landing on
+// a source mid-merge waits for the merge rather than being lost with it.
+func (wp *WordPeer) mergeWords(tx *sql.Tx, targetID int64, sourceIDs []int64) (*MergedWord, error) {
+	pf := placeholderFormat(wp.dbType)
+	merged := &MergedWord{WordID: targetID}
+
+	// ================ 1. Lock the words ================
+	rows, err := lockMergedWords(tx, pf, append([]int64{targetID}, sourceIDs...))
+	if err != nil {
+		return nil, err
+	}
+	target, ok := rows[targetID]
+	if !ok {
+		return nil, fmt.Errorf("%w: %d", ErrWordNotFound, targetID)
+	}
+	sources := make([]*mergedWordRow, 0, len(sourceIDs))
+	for _, id := range sourceIDs {
+		source, ok := rows[id]
+		if !ok {
+			return nil, fmt.Errorf("%w: %d", ErrWordNotFound, id)
+		}
+		sources = append(sources, source)
+	}
+
+	// ================ 2. Move definitions ================
+	if err := moveDefinitions(tx, pf, targetID, sourceIDs, merged); err != nil {
+		return nil, err
+	}
+
+	// ================ 3. Move practice logs ================
+	sqlStr, args, err := squirrel.Update(schema.WORD_PRACTICE_LOG_TABLE_NAME).
+		Set(schema.WORD_PRACTICE_LOG_WORD_ID, targetID).
+		Where(squirrel.Eq{schema.WORD_PRACTICE_LOG_WORD_ID: sourceIDs}).
+		PlaceholderFormat(pf).
+		ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("failed to build practice log update: %w", err)
+	}
+	result, err := tx.Exec(sqlStr, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to move practice logs: %w", err)
+	}
+	if moved, err := result.RowsAffected(); err == nil {
+		merged.LogsMoved = int(moved)
+	}
+
+	// ================ 4. Move links ================
+	if err := moveLinks(tx, pf, targetID, sourceIDs, merged); err != nil {
+		return nil, err
+	}
+
+	// ================ 5. Move reminders ================
+	if err := moveReminders(tx, pf, targetID, sourceIDs, merged); err != nil {
+		return nil, err
+	}
+
+	// ================ 6. Combine the word itself ================
+	combined := *target
+	for _, source := range sources {
+		combined.countPractise.Int64 += source.countPractise.Int64
+		if source.lastPracticedAt.Valid && (!combined.lastPracticedAt.Valid || source.lastPracticedAt.Time.After(combined.lastPracticedAt.Time)) {
+			combined.lastPracticedAt = source.lastPracticedAt
+		}
+		if source.familiarity.Valid && (!combined.familiarity.Valid || familiarityRank[source.familiarity.String] < familiarityRank[combined.familiarity.String]) {
+			combined.familiarity = source.familiarity
+		}
+		if !combined.reminder.Valid {
+			combined.reminder = source.reminder
+		}
+	}
+
+	set := map[string]interface{}{
+		schema.WORD_COUNT_PRACTISE: combined.countPractise.Int64,
+		schema.WORD_FAMILIARITY:    nullable(combined.familiarity.String, combined.familiarity.Valid),
+		schema.WORD_REMINDER:       nullable(combined.reminder.String, combined.reminder.Valid),
+		schema.COMMON_UPDATED_AT:   time.Now().UTC().Format(database.FORMAT_TIMESTAMP),
+	}
+	if combined.lastPracticedAt.Valid {
+		set[schema.WORD_LAST_PRACTISED_AT] = combined.lastPracticedAt.Time.UTC().Format(database.FORMAT_TIMESTAMP)
+	}
+	sqlStr, args, err = squirrel.Update(schema.WORD_TABLE_NAME).
+		SetMap(set).
+		Where(squirrel.Eq{schema.WORD_ID: targetID}).
+		PlaceholderFormat(pf).
+		ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("failed to build word update: %w", err)
+	}
+	if _, err := tx.Exec(sqlStr, args...); err != nil {
+		return nil, fmt.Errorf("failed to update merged word: %w", err)
+	}
+
+	// ================ 7. Delete the sources ================
+	sqlStr, args, err = squirrel.Delete(schema.WORD_TABLE_NAME).
+		Where(squirrel.Eq{schema.WORD_ID: sourceIDs}).
+		PlaceholderFormat(pf).
+		ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("failed to build word delete: %w", err)
+	}
+	if _, err := tx.Exec(sqlStr, args...); err != nil {
+		return nil, fmt.Errorf("failed to delete merged words: %w", err)
+	}
+
+	return merged, nil
+}
+
+// lockMergedWords reads the words with ids FOR UPDATE, keyed by ID
+func lockMergedWords(tx *sql.Tx, pf squirrel.PlaceholderFormat, ids []int64) (map[int64]*mergedWordRow, error) {
+	sqlStr, args, err := squirrel.Select(schema.WORD_ID, schema.WORD_FAMILIARITY, schema.WORD_REMINDER, schema.WORD_COUNT_PRACTISE, schema.WORD_LAST_PRACTISED_AT).
+		From(schema.WORD_TABLE_NAME).
+		Where(squirrel.Eq{schema.WORD_ID: ids}).
+		OrderBy(schema.WORD_ID).
+		Suffix("FOR UPDATE").
+		PlaceholderFormat(pf).
+		ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("failed to build word lookup: %w", err)
+	}
+
+	rows, err := tx.Query(sqlStr, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read words: %w", err)
+	}
+	defer rows.Close()
+
+	words := make(map[int64]*mergedWordRow, len(ids))
+	for rows.Next() {
+		row := &mergedWordRow{}
+		if err := rows.Scan(&row.id, &row.familiarity, &row.reminder, &row.countPractise, &row.lastPracticedAt); err != nil {
+			return nil, fmt.Errorf("failed to read words: %w", err)
+		}
+		words[row.id] = row
+	}
+	return words, rows.Err()
+}
+
+// moveDefinitions moves the definitions of the words with sourceIDs to the
+// word with targetID, deleting those the target already has
+func moveDefinitions(tx *sql.Tx, pf squirrel.PlaceholderFormat, targetID int64, sourceIDs []int64, merged *MergedWord) error {
+	existing, err := existingDefinitionKeys(tx, pf, targetID)
+	if err != nil {
+		return err
+	}
+
+	sqlStr, args, err := squirrel.Select(schema.WORD_DEFINITIONS_ID, schema.WORD_DEFINITIONS_PART_OF_SPEECH, schema.WORD_DEFINITIONS_DEFINITION).
+		From(schema.WORD_DEFINITIONS_TABLE_NAME).
+		Where(squirrel.Eq{schema.WORD_DEFINITIONS_WORD_ID: sourceIDs}).
+		OrderBy(schema.WORD_DEFINITIONS_ID).
+		PlaceholderFormat(pf).
+		ToSql()
+	if err != nil {
+		return fmt.Errorf("failed to build definition lookup: %w", err)
+	}
+	rows, err := tx.Query(sqlStr, args...)
+	if err != nil {
+		return fmt.Errorf("failed to read definitions: %w", err)
+	}
+	var moveIDs, dropIDs []int64
+	for rows.Next() {
+		var id int64
+		var partOfSpeech, definition sql.NullString
+		if err := rows.Scan(&id, &partOfSpeech, &definition); err != nil {
+			rows.Close()
+			return fmt.Errorf("failed to read definitions: %w", err)
+		}
+		key := definitionKey(&partOfSpeech.String, &definition.String)
+		if existing[key] {
+			dropIDs = append(dropIDs, id)
+			continue
+		}
+		existing[key] = true
+		moveIDs = append(moveIDs, id)
+	}
+	rows.Close()
+	if err := rows.Err(); err != nil {
+		return fmt.Errorf("failed to read definitions: %w", err)
+	}
+
+	if len(moveIDs) > 0 {
+		sqlStr, args, err := squirrel.Update(schema.WORD_DEFINITIONS_TABLE_NAME).
+			Set(schema.WORD_DEFINITIONS_WORD_ID, targetID).
+			Where(squirrel.Eq{schema.WORD_DEFINITIONS_ID: moveIDs}).
+			PlaceholderFormat(pf).
+			ToSql()
+		if err != nil {
+			return fmt.Errorf("failed to build definition update: %w", err)
+		}
+		if _, err := tx.Exec(sqlStr, args...); err != nil {
+			return fmt.Errorf("failed to move definitions: %w", err)
+		}
+	}
+	if len(dropIDs) > 0 {
+		sqlStr, args, err := squirrel.Delete(schema.WORD_DEFINITIONS_TABLE_NAME).
+			Where(squirrel.Eq{schema.WORD_DEFINITIONS_ID: dropIDs}).
+			PlaceholderFormat(pf).
+			ToSql()
+		if err != nil {
+			return fmt.Errorf("failed to build definition delete: %w", err)
+		}
+		if _, err := tx.Exec(sqlStr, args...); err != nil {
+			return fmt.Errorf("failed to delete duplicate definitions: %w", err)
+		}
+	}
+
+	merged.DefinitionsMoved = len(moveIDs)
+	merged.DefinitionsSkipped = len(dropIDs)
+	return nil
+}
+
+// moveLinks points the links of the words with sourceIDs at the word with
+// targetID. Links between the merged words themselves, and links the target
+// already has, are deleted instead. Words sort first among entity types, so
+// a word is always the source end of a link unless the other end is a word
+// with a lower ID (see links.CanonicalLink).
+func moveLinks(tx *sql.Tx, pf squirrel.PlaceholderFormat, targetID int64, sourceIDs []int64, merged *MergedWord) error {
+	mergedIDs := append([]int64{targetID}, sourceIDs...)
+	sqlStr, args, err := squirrel.Select(schema.ENTITY_LINK_ID, schema.ENTITY_LINK_SOURCE_TYPE, schema.ENTITY_LINK_SOURCE_ID, schema.ENTITY_LINK_TARGET_TYPE, schema.ENTITY_LINK_TARGET_ID).
+		From(schema.ENTITY_LINK_TABLE_NAME).
+		Where(squirrel.Or{
+			squirrel.Eq{schema.ENTITY_LINK_SOURCE_TYPE: schema.ENTITY_TYPE_WORD, schema.ENTITY_LINK_SOURCE_ID: mergedIDs},
+			squirrel.Eq{schema.ENTITY_LINK_TARGET_TYPE: schema.ENTITY_TYPE_WORD, schema.ENTITY_LINK_TARGET_ID: mergedIDs},
+		}).
+		OrderBy(schema.ENTITY_LINK_ID).
+		PlaceholderFormat(pf).
+		ToSql()
+	if err != nil {
+		return fmt.Errorf("failed to build link lookup: %w", err)
+	}
+	rows, err := tx.Query(sqlStr, args...)
+	if err != nil {
+		return fmt.Errorf("failed to read links: %w", err)
+	}
+
+	type linkEnds struct {
+		sourceType string
+		sourceID   int64
+		targetType string
+		targetID   int64
+	}
+	isMerged := func(entityType string, id int64) bool {
+		return entityType == schema.ENTITY_TYPE_WORD && slices.Contains(mergedIDs, id)
+	}
+	isTarget := func(entityType string, id int64) bool {
+		return entityType == schema.ENTITY_TYPE_WORD && id == targetID
+	}
+	// The target's own links are kept as they are; a source link is moved
+	// to the target unless that would repeat a link the target has, and a
+	// link between two of the merged words is dropped
+	kept := make(map[linkEnds]bool)
+	var candidates []int64
+	links := make(map[int64]linkEnds)
+	var dropIDs []int64
+	for rows.Next() {
+		var id int64
+		var link linkEnds
+		if err := rows.Scan(&id, &link.sourceType, &link.sourceID, &link.targetType, &link.targetID); err != nil {
+			rows.Close()
+			return fmt.Errorf("failed to read links: %w", err)
+		}
+		switch {
+		case isMerged(link.sourceType, link.sourceID) && isMerged(link.targetType, link.targetID):
+			dropIDs = append(dropIDs, id)
+		case isTarget(link.sourceType, link.sourceID) || isTarget(link.targetType, link.targetID):
+			kept[link] = true
+		default:
+			candidates = append(candidates, id)
+			links[id] = link
+		}
+	}
+	rows.Close()
+	if err := rows.Err(); err != nil {
+		return fmt.Errorf("failed to read links: %w", err)
+	}
+
+	for _, id := range candidates {
+		link := links[id]
+		otherType, otherID := link.targetType, link.targetID
+		if isMerged(link.targetType, link.targetID) {
+			otherType, otherID = link.sourceType, link.sourceID
+		}
+		moved := linkEnds{schema.ENTITY_TYPE_WORD, targetID, otherType, otherID}
+		if otherType == schema.ENTITY_TYPE_WORD && otherID < targetID {
+			moved = linkEnds{schema.ENTITY_TYPE_WORD, otherID, schema.ENTITY_TYPE_WORD, targetID}
+		}
+		if kept[moved] {
+			dropIDs = append(dropIDs, id)
+			continue
+		}
+		kept[moved] = true
+
+		sqlStr, args, err := squirrel.Update(schema.ENTITY_LINK_TABLE_NAME).
+			SetMap(map[string]interface{}{
+				schema.ENTITY_LINK_SOURCE_TYPE: moved.sourceType,
+				schema.ENTITY_LINK_SOURCE_ID:   moved.sourceID,
+				schema.ENTITY_LINK_TARGET_TYPE: moved.targetType,
+				schema.ENTITY_LINK_TARGET_ID:   moved.targetID,
+			}).
+			Where(squirrel.Eq{schema.ENTITY_LINK_ID: id}).
+			PlaceholderFormat(pf).
+			ToSql()
+		if err != nil {
+			return fmt.Errorf("failed to build link update: %w", err)
+		}
+		if _, err := tx.Exec(sqlStr, args...); err != nil {
+			return fmt.Errorf("failed to move links: %w", err)
+		}
+		merged.LinksMoved++
+	}
+
+	if len(dropIDs) > 0 {
+		sqlStr, args, err := squirrel.Delete(schema.ENTITY_LINK_TABLE_NAME).
+			Where(squirrel.Eq{schema.ENTITY_LINK_ID: dropIDs}).
+			PlaceholderFormat(pf).
+			ToSql()
+		if err != nil {
+			return fmt.Errorf("failed to build link delete: %w", err)
+		}
+		if _, err := tx.Exec(sqlStr, args...); err != nil {
+			return fmt.Errorf("failed to delete merged links: %w", err)
+		}
+	}
+	return nil
+}
+
+// moveReminders moves the sources' reminders and reminder history to
+// targetID. A word has at most one reminder, so the target's own reminder
+// is kept when it has one, otherwise the source reminder due first; the
+// other source reminders are deleted.
+func moveReminders(tx *sql.Tx, pf squirrel.PlaceholderFormat, targetID int64, sourceIDs []int64, merged *MergedWord) error {
+	sqlStr, args, err := squirrel.Select(schema.REMINDER_ID, schema.REMINDER_ENTITY_ID).
+		From(schema.REMINDER_TABLE_NAME).
+		Where(squirrel.Eq{
+			schema.REMINDER_ENTITY_TYPE: schema.ENTITY_TYPE_WORD,
+			schema.REMINDER_ENTITY_ID:   append([]int64{targetID}, sourceIDs...),
+		}).
+		OrderBy(schema.REMINDER_DUE_AT, schema.REMINDER_ID).
+		PlaceholderFormat(pf).
+		ToSql()
+	if err != nil {
+		return fmt.Errorf("failed to build reminder lookup: %w", err)
+	}
+	rows, err := tx.Query(sqlStr, args...)
+	if err != nil {
+		return fmt.Errorf("failed to read reminders: %w", err)
+	}
+
+	var sourceReminderIDs []int64
+	targetHasReminder := false
+	for rows.Next() {
+		var id, entityID int64
+		if err := rows.Scan(&id, &entityID); err != nil {
+			rows.Close()
+			return fmt.Errorf("failed to read reminders: %w", err)
+		}
+		if entityID == targetID {
+			targetHasReminder = true
+		} else {
+			sourceReminderIDs = append(sourceReminderIDs, id)
+		}
+	}
+	rows.Close()
+	if err := rows.Err(); err != nil {
+		return fmt.Errorf("failed to read reminders: %w", err)
+	}
+
+	dropIDs := sourceReminderIDs
+	if !targetHasReminder && len(sourceReminderIDs) > 0 {
+		dropIDs = sourceReminderIDs[1:]
+	}
+	if len(dropIDs) > 0 {
+		sqlStr, args, err := squirrel.Delete(schema.REMINDER_TABLE_NAME).
+			Where(squirrel.Eq{schema.REMINDER_ID: dropIDs}).
+			PlaceholderFormat(pf).
+			ToSql()
+		if err != nil {
+			return fmt.Errorf("failed to build reminder delete: %w", err)
+		}
+		if _, err := tx.Exec(sqlStr, args...); err != nil {
+			return fmt.Errorf("failed to delete merged reminders: %w", err)
+		}
+	}
+
+	for _, table := range []string{schema.REMINDER_TABLE_NAME, schema.REMINDER_LOG_TABLE_NAME} {
+		sqlStr, args, err := squirrel.Update(table).
+			Set(schema.REMINDER_ENTITY_ID, targetID).
+			Where(squirrel.Eq{
+				schema.REMINDER_ENTITY_TYPE: schema.ENTITY_TYPE_WORD,
+				schema.REMINDER_ENTITY_ID:   sourceIDs,
+			}).
+			PlaceholderFormat(pf).
+			ToSql()
+		if err != nil {
+			return fmt.Errorf("failed to build reminder update: %w", err)
+		}
+		result, err := tx.Exec(sqlStr, args...)
+		if err != nil {
+			return fmt.Errorf("failed to move reminders: %w", err)
+		}
+		if table == schema.REMINDER_TABLE_NAME {
+			if moved, err := result.RowsAffected(); err == nil {
+				merged.RemindersMoved = int(moved)
+			}
+		}
+	}
+	return nil
+}
+
+// nullable returns value, or nil to write NULL when valid is false
+func nullable(value string, valid bool) interface{} {
+	if !valid {
+		return nil
+	}
+	return value
+}
@@ -1,6 +1,8 @@
 package peers
 
 import (
+	"errors"
+
 	"word-flashcard/data/models"
 
 	"github.com/Masterminds/squirrel"
@@ -13,4 +15,32 @@ type WordPeerInterface interface {
 	Update(word *models.Word, where squirrel.Sqlizer) (int64, error)
 	Delete(where squirrel.Sqlizer) (int64, error)
 	Count(where squirrel.Sqlizer) (int64, error)
+	CountByPractiseCount() ([]*models.WordPractiseCountGroup, error)
+	SaveWithDefinitions(word *models.Word, definitions []*models.WordDefinition) (*SavedWord, error)
+	MergeWords(targetID int64, sourceIDs []int64) (*MergedWord, error)
+}
+
+// ErrWordNotFound is returned by MergeWords when one of the words doesn't exist
+var ErrWordNotFound = errors.New("word not found")
+
+// SavedWord reports what SaveWithDefinitions wrote: the ID of the word,
+// whether it was newly created rather than merged into, and how many
+// definitions were added or skipped as already present
+type SavedWord struct {
+	WordID  int64
+	Created bool
+	Added   int
+	Skipped int
+}
+
+// MergedWord reports what MergeWords did: the ID of the word merged into,
+// how many definitions were moved to it or deleted as already present, and
+// how many practice logs, links and reminders were moved to it
+type MergedWord struct {
+	WordID             int64
+	DefinitionsMoved   int
+	DefinitionsSkipped int
+	LogsMoved          int
+	LinksMoved         int
+	RemindersMoved     int
 }
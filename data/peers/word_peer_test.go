@@ -0,0 +1,293 @@
+package peers
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"word-flashcard/data/models"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/suite"
+)
+
+// wordPeerTestSuite is a test suite for WordPeer
+type wordPeerTestSuite struct {
+	suite.Suite
+}
+
+// TestWordPeerSuite runs the wordPeerTestSuite
+func TestWordPeerSuite(t *testing.T) {
+	suite.Run(t, new(wordPeerTestSuite))
+}
+
+// TestSaveWithDefinitions verifies a new word is inserted with all of its
+// definitions, an existing one only gets the definitions it doesn't have
+// yet, and a failure along the way is surfaced without any further
+// statement running.
+func (s *wordPeerTestSuite) TestSaveWithDefinitions() {
+	word := "apple"
+	noun := "noun"
+	fruit := "a round fruit"
+	tree := "an apple tree"
+
+	definitions := func() []*models.WordDefinition {
+		return []*models.WordDefinition{
+			{PartOfSpeech: &noun, Definition: &fruit},
+			{PartOfSpeech: &noun, Definition: &tree},
+		}
+	}
+
+	tests := []struct {
+		name      string
+		dbType    string
+		setupMock func(mock sqlmock.Sqlmock)
+		want      *SavedWord
+		wantErr   bool
+	}{
+		{
+			name:   "new word is inserted with every definition",
+			dbType: "mysql",
+			setupMock: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery(`SELECT id FROM words WHERE word = \? FOR UPDATE`).
+					WithArgs(word).
+					WillReturnRows(sqlmock.NewRows([]string{"id"}))
+				mock.ExpectExec("INSERT INTO words").WillReturnResult(sqlmock.NewResult(7, 1))
+				mock.ExpectExec("INSERT INTO word_definitions").WillReturnResult(sqlmock.NewResult(1, 1))
+				mock.ExpectExec("INSERT INTO word_definitions").WillReturnResult(sqlmock.NewResult(2, 1))
+			},
+			want: &SavedWord{WordID: 7, Created: true, Added: 2},
+		},
+		{
+			name:   "postgresql reads the new id back with RETURNING",
+			dbType: "postgresql",
+			setupMock: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery(`SELECT id FROM words WHERE word = \$1 FOR UPDATE`).
+					WillReturnRows(sqlmock.NewRows([]string{"id"}))
+				mock.ExpectQuery(`INSERT INTO words .* RETURNING id`).WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(7))
+				mock.ExpectQuery(`INSERT INTO word_definitions .* RETURNING id`).WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(1))
+				mock.ExpectQuery(`INSERT INTO word_definitions .* RETURNING id`).WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(2))
+			},
+			want: &SavedWord{WordID: 7, Created: true, Added: 2},
+		},
+		{
+			name:   "existing word only gets definitions it doesn't have",
+			dbType: "mysql",
+			setupMock: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery("SELECT id FROM words").WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(3))
+				mock.ExpectQuery(`SELECT part_of_speech, definition FROM word_definitions WHERE word_id = \?`).
+					WithArgs(int64(3)).
+					WillReturnRows(sqlmock.NewRows([]string{"part_of_speech", "definition"}).AddRow("Noun", " A round fruit"))
+				mock.ExpectExec("INSERT INTO word_definitions").WillReturnResult(sqlmock.NewResult(9, 1))
+			},
+			want: &SavedWord{WordID: 3, Added: 1, Skipped: 1},
+		},
+		{
+			name:   "word insert failure is surfaced",
+			dbType: "mysql",
+			setupMock: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery("SELECT id FROM words").WillReturnRows(sqlmock.NewRows([]string{"id"}))
+				mock.ExpectExec("INSERT INTO words").WillReturnError(errors.New("constraint violation"))
+			},
+			wantErr: true,
+		},
+		{
+			name:   "definition insert failure is surfaced",
+			dbType: "mysql",
+			setupMock: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery("SELECT id FROM words").WillReturnRows(sqlmock.NewRows([]string{"id"}))
+				mock.ExpectExec("INSERT INTO words").WillReturnResult(sqlmock.NewResult(7, 1))
+				mock.ExpectExec("INSERT INTO word_definitions").WillReturnError(errors.New("db down"))
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		s.Run(tt.name, func() {
+			db, mock, err := sqlmock.New()
+			s.Require().NoError(err)
+			defer db.Close()
+
+			mock.ExpectBegin()
+			tt.setupMock(mock)
+
+			tx, err := db.Begin()
+			s.Require().NoError(err)
+
+			wp := &WordPeer{dbType: tt.dbType}
+			saved, saveErr := wp.saveWithDefinitions(tx, &models.Word{Word: &word}, definitions())
+
+			if tt.wantErr {
+				s.Error(saveErr)
+			} else {
+				s.Require().NoError(saveErr)
+				s.Equal(tt.want, saved)
+			}
+
+			s.NoError(mock.ExpectationsWereMet())
+		})
+	}
+}
+
+// TestMergeWords verifies the sources' definitions, practice logs, links
+// and reminders are moved to the target with duplicates dropped, the
+// target's practice stats are combined, and a missing word or a failing
+// statement stops the merge.
+func (s *wordPeerTestSuite) TestMergeWords() {
+	earlier := time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC)
+	later := earlier.Add(48 * time.Hour)
+	wordColumns := []string{"id", "familiarity", "reminder", "count_practise", "last_practiced_at"}
+	linkColumns := []string{"id", "source_type", "source_id", "target_type", "target_id"}
+
+	lockWords := func(mock sqlmock.Sqlmock) {
+		mock.ExpectQuery(`SELECT id, familiarity, reminder, count_practise, last_practiced_at FROM words WHERE id IN \(\?,\?,\?\) ORDER BY id FOR UPDATE`).
+			WithArgs(int64(1), int64(2), int64(3)).
+			WillReturnRows(sqlmock.NewRows(wordColumns).
+				AddRow(1, "green", nil, 3, earlier).
+				AddRow(2, "red", "revisit", 2, later).
+				AddRow(3, "yellow", nil, nil, nil))
+	}
+
+	tests := []struct {
+		name      string
+		setupMock func(mock sqlmock.Sqlmock)
+		want      *MergedWord
+		wantErr   error
+	}{
+		{
+			name: "sources are merged into the target",
+			setupMock: func(mock sqlmock.Sqlmock) {
+				lockWords(mock)
+				mock.ExpectQuery(`SELECT part_of_speech, definition FROM word_definitions WHERE word_id = \?`).
+					WithArgs(int64(1)).
+					WillReturnRows(sqlmock.NewRows([]string{"part_of_speech", "definition"}).AddRow("noun", "a fruit"))
+				mock.ExpectQuery(`SELECT id, part_of_speech, definition FROM word_definitions WHERE word_id IN \(\?,\?\) ORDER BY id`).
+					WithArgs(int64(2), int64(3)).
+					WillReturnRows(sqlmock.NewRows([]string{"id", "part_of_speech", "definition"}).
+						AddRow(10, "Noun", "A fruit ").
+						AddRow(11, "verb", "to run"))
+				mock.ExpectExec(`UPDATE word_definitions SET word_id = \? WHERE id IN \(\?\)`).
+					WithArgs(int64(1), int64(11)).
+					WillReturnResult(sqlmock.NewResult(0, 1))
+				mock.ExpectExec(`DELETE FROM word_definitions WHERE id IN \(\?\)`).
+					WithArgs(int64(10)).
+					WillReturnResult(sqlmock.NewResult(0, 1))
+				mock.ExpectExec(`UPDATE word_practice_logs SET word_id = \? WHERE word_id IN \(\?,\?\)`).
+					WithArgs(int64(1), int64(2), int64(3)).
+					WillReturnResult(sqlmock.NewResult(0, 4))
+				mock.ExpectQuery(`SELECT id, source_type, source_id, target_type, target_id FROM entity_links`).
+					WillReturnRows(sqlmock.NewRows(linkColumns).
+						AddRow(20, "word", 1, "note", 5).
+						AddRow(21, "word", 2, "note", 5).
+						AddRow(22, "word", 2, "question", 7).
+						AddRow(23, "word", 1, "word", 2).
+						AddRow(24, "word", 3, "word", 9))
+				mock.ExpectExec(`UPDATE entity_links SET source_id = \?, source_type = \?, target_id = \?, target_type = \? WHERE id = \?`).
+					WithArgs(int64(1), "word", int64(7), "question", int64(22)).
+					WillReturnResult(sqlmock.NewResult(0, 1))
+				mock.ExpectExec(`UPDATE entity_links SET`).
+					WithArgs(int64(1), "word", int64(9), "word", int64(24)).
+					WillReturnResult(sqlmock.NewResult(0, 1))
+				mock.ExpectExec(`DELETE FROM entity_links WHERE id IN \(\?,\?\)`).
+					WithArgs(int64(23), int64(21)).
+					WillReturnResult(sqlmock.NewResult(0, 2))
+				mock.ExpectQuery(`SELECT id, entity_id FROM reminders WHERE entity_id IN \(\?,\?,\?\) AND entity_type = \? ORDER BY due_at, id`).
+					WithArgs(int64(1), int64(2), int64(3), "word").
+					WillReturnRows(sqlmock.NewRows([]string{"id", "entity_id"}).
+						AddRow(31, 3).
+						AddRow(30, 2))
+				mock.ExpectExec(`DELETE FROM reminders WHERE id IN \(\?\)`).
+					WithArgs(int64(30)).
+					WillReturnResult(sqlmock.NewResult(0, 1))
+				mock.ExpectExec(`UPDATE reminders SET entity_id = \? WHERE entity_id IN \(\?,\?\) AND entity_type = \?`).
+					WithArgs(int64(1), int64(2), int64(3), "word").
+					WillReturnResult(sqlmock.NewResult(0, 1))
+				mock.ExpectExec(`UPDATE reminder_logs SET entity_id = \? WHERE entity_id IN \(\?,\?\) AND entity_type = \?`).
+					WithArgs(int64(1), int64(2), int64(3), "word").
+					WillReturnResult(sqlmock.NewResult(0, 2))
+				mock.ExpectExec(`UPDATE words SET count_practise = \?, familiarity = \?, last_practiced_at = \?, reminder = \?, updated_at = \? WHERE id = \?`).
+					WithArgs(int64(5), "red", later.Format("2006-01-02 15:04:05"), "revisit", sqlmock.AnyArg(), int64(1)).
+					WillReturnResult(sqlmock.NewResult(0, 1))
+				mock.ExpectExec(`DELETE FROM words WHERE id IN \(\?,\?\)`).
+					WithArgs(int64(2), int64(3)).
+					WillReturnResult(sqlmock.NewResult(0, 2))
+			},
+			want: &MergedWord{WordID: 1, DefinitionsMoved: 1, DefinitionsSkipped: 1, LogsMoved: 4, LinksMoved: 2, RemindersMoved: 1},
+		},
+		{
+			name: "target keeps its own reminder",
+			setupMock: func(mock sqlmock.Sqlmock) {
+				lockWords(mock)
+				mock.ExpectQuery("SELECT part_of_speech, definition FROM word_definitions").
+					WillReturnRows(sqlmock.NewRows([]string{"part_of_speech", "definition"}))
+				mock.ExpectQuery("SELECT id, part_of_speech, definition FROM word_definitions").
+					WillReturnRows(sqlmock.NewRows([]string{"id", "part_of_speech", "definition"}))
+				mock.ExpectExec("UPDATE word_practice_logs").WillReturnResult(sqlmock.NewResult(0, 0))
+				mock.ExpectQuery("SELECT id, source_type, source_id, target_type, target_id FROM entity_links").
+					WillReturnRows(sqlmock.NewRows(linkColumns))
+				mock.ExpectQuery("SELECT id, entity_id FROM reminders").
+					WillReturnRows(sqlmock.NewRows([]string{"id", "entity_id"}).
+						AddRow(30, 2).
+						AddRow(32, 1))
+				mock.ExpectExec(`DELETE FROM reminders WHERE id IN \(\?\)`).
+					WithArgs(int64(30)).
+					WillReturnResult(sqlmock.NewResult(0, 1))
+				mock.ExpectExec("UPDATE reminders SET entity_id").WillReturnResult(sqlmock.NewResult(0, 0))
+				mock.ExpectExec("UPDATE reminder_logs SET entity_id").WillReturnResult(sqlmock.NewResult(0, 1))
+				mock.ExpectExec("UPDATE words SET").WillReturnResult(sqlmock.NewResult(0, 1))
+				mock.ExpectExec("DELETE FROM words").WillReturnResult(sqlmock.NewResult(0, 2))
+			},
+			want: &MergedWord{WordID: 1},
+		},
+		{
+			name: "missing source is reported as not found",
+			setupMock: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery("SELECT id, familiarity").
+					WillReturnRows(sqlmock.NewRows(wordColumns).AddRow(1, "green", nil, 3, nil).AddRow(2, "red", nil, 0, nil))
+			},
+			wantErr: ErrWordNotFound,
+		},
+		{
+			name: "practice log move failure is surfaced",
+			setupMock: func(mock sqlmock.Sqlmock) {
+				lockWords(mock)
+				mock.ExpectQuery("SELECT part_of_speech, definition FROM word_definitions").
+					WillReturnRows(sqlmock.NewRows([]string{"part_of_speech", "definition"}))
+				mock.ExpectQuery("SELECT id, part_of_speech, definition FROM word_definitions").
+					WillReturnRows(sqlmock.NewRows([]string{"id", "part_of_speech", "definition"}))
+				mock.ExpectExec("UPDATE word_practice_logs").WillReturnError(errors.New("db down"))
+			},
+			wantErr: errors.New("failed to move practice logs: db down"),
+		},
+	}
+
+	for _, tt := range tests {
+		s.Run(tt.name, func() {
+			db, mock, err := sqlmock.New()
+			s.Require().NoError(err)
+			defer db.Close()
+
+			mock.ExpectBegin()
+			tt.setupMock(mock)
+
+			tx, err := db.Begin()
+			s.Require().NoError(err)
+
+			wp := &WordPeer{dbType: "mysql"}
+			merged, mergeErr := wp.mergeWords(tx, 1, []int64{2, 3})
+
+			switch {
+			case errors.Is(tt.wantErr, ErrWordNotFound):
+				s.ErrorIs(mergeErr, ErrWordNotFound)
+			case tt.wantErr != nil:
+				s.EqualError(mergeErr, tt.wantErr.Error())
+			default:
+				s.Require().NoError(mergeErr)
+				s.Equal(tt.want, merged)
+			}
+
+			s.NoError(mock.ExpectationsWereMet())
+		})
+	}
+}
@@ -1,8 +1,12 @@
 package peers
 
 import (
+	"fmt"
+	"time"
+
 	"word-flashcard/data/models"
 	"word-flashcard/data/schema"
+	"word-flashcard/utils/database"
 
 	"github.com/Masterminds/squirrel"
 )
@@ -57,3 +61,74 @@ func (wp *WordPracticeLogPeer) Update(log *models.WordPracticeLog, where squirre
 
 	return result, nil
 }
+
+// Count returns the number of WordPracticeLog records matching the specified criteria
+func (wp *WordPracticeLogPeer) Count(where squirrel.Sqlizer) (int64, error) {
+	result, err := wp.db.Count(wp.tableName, where)
+	if err != nil {
+		return 0, err
+	}
+
+	return result, nil
+}
+
+// DailyStats aggregates the practice logs created at or after since into one
+// row per calendar day in loc, ascending by day. Days without logs are
+// omitted; callers zero-fill them.
+func (wp *WordPracticeLogPeer) DailyStats(since time.Time, loc *time.Location) ([]*models.WordPracticeLogDailyStats, error) {
+	var stats []*models.WordPracticeLogDailyStats
+
+	current := familiarityLevelExpr(schema.WORD_PRACTICE_LOG_FAMILIARITY)
+	previous := familiarityLevelExpr(schema.WORD_PRACTICE_LOG_PREVIOUS_FAMILIARITY)
+	err := wp.db.Aggregate(wp.tableName,
+		[]database.Grouping{database.GroupByDate(schema.COMMON_CREATED_AT, loc, "day")},
+		[]database.Aggregation{
+			database.CountAll("practice_count"),
+			database.Sum(fmt.Sprintf("CASE WHEN %s > %s THEN 1 ELSE 0 END", current, previous), "improved_count"),
+			database.Avg(current, "avg_familiarity_level"),
+		},
+		squirrel.GtOrEq{schema.COMMON_CREATED_AT: since.UTC()},
+		&stats,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return stats, nil
+}
+
+// TransitionCounts counts the self-reported practice logs created at or
+// after since per (previous_familiarity, familiarity) pair. Graded answers
+// (spelling, cloze, ...) are left out: they never change familiarity, so
+// they would only inflate the unchanged pairs.
+func (wp *WordPracticeLogPeer) TransitionCounts(since time.Time) ([]*models.WordFamiliarityTransitionCount, error) {
+	var counts []*models.WordFamiliarityTransitionCount
+
+	err := wp.db.Aggregate(wp.tableName,
+		[]database.Grouping{
+			database.GroupByColumn(schema.WORD_PRACTICE_LOG_PREVIOUS_FAMILIARITY),
+			database.GroupByColumn(schema.WORD_PRACTICE_LOG_FAMILIARITY),
+		},
+		[]database.Aggregation{database.CountAll("transition_count")},
+		squirrel.And{
+			squirrel.GtOrEq{schema.COMMON_CREATED_AT: since.UTC()},
+			squirrel.Or{
+				squirrel.Eq{schema.WORD_PRACTICE_LOG_MODE: nil},
+				squirrel.Eq{schema.WORD_PRACTICE_LOG_MODE: schema.WORD_PRACTICE_MODE_FAMILIARITY},
+			},
+		},
+		&counts,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return counts, nil
+}
+
+// familiarityLevelExpr maps a familiarity column to its ordinal 0/1/2
+// (red/yellow/green) in SQL, treating unrecognized values as red
+func familiarityLevelExpr(column string) string {
+	return fmt.Sprintf("CASE %s WHEN '%s' THEN 1 WHEN '%s' THEN 2 ELSE 0 END",
+		column, schema.WORD_FAMILIARITY_YELLOW, schema.WORD_FAMILIARITY_GREEN)
+}
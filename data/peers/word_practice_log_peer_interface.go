@@ -1,6 +1,8 @@
 package peers
 
 import (
+	"time"
+
 	"word-flashcard/data/models"
 
 	"github.com/Masterminds/squirrel"
@@ -14,4 +16,7 @@ type WordPracticeLogPeerInterface interface {
 	Select(columns []*string, where squirrel.Sqlizer, orderBy []*string, limit *uint64, offset *uint64) ([]*models.WordPracticeLog, error)
 	Insert(log *models.WordPracticeLog) (int64, error)
 	Update(log *models.WordPracticeLog, where squirrel.Sqlizer) (int64, error)
+	Count(where squirrel.Sqlizer) (int64, error)
+	DailyStats(since time.Time, loc *time.Location) ([]*models.WordPracticeLogDailyStats, error)
+	TransitionCounts(since time.Time) ([]*models.WordFamiliarityTransitionCount, error)
 }
@@ -15,9 +15,17 @@ func RegisterAllTables() {
 		schema.WordsTable(),
 		schema.WordDefinitionsTable(),
 		schema.QuestionsTable(),
+		schema.QuestionOptionsTable(),
 		schema.NotesTable(),
 		schema.WordPracticeLogsTable(),
 		schema.QuestionAnswerLogsTable(),
+		schema.EntityLinksTable(),
+		schema.DictionaryEntriesTable(),
+		schema.DictionaryCacheTable(),
+		schema.AudioFilesTable(),
+		schema.SettingsTable(),
+		schema.RemindersTable(),
+		schema.ReminderLogsTable(),
 	}
 
 	for _, table := range tables {
@@ -21,22 +21,46 @@ func TestRegisterAllTables(t *testing.T) {
 	// Verify that both words and word_definitions tables are registered
 	tableSchema := map[string][]string{
 		"words": {
-			"id", "word", "familiarity", "reminder", "count_practise", "last_practiced_at", "created_at", "updated_at",
+			"id", "word", "familiarity", "reminder", "count_practise", "last_practiced_at", "is_leech", "is_suspended", "created_at", "updated_at",
 		},
 		"word_definitions": {
-			"id", "word_id", "part_of_speech", "definition", "phonetics", "examples", "notes", "created_at", "updated_at",
+			"id", "word_id", "part_of_speech", "definition", "phonetics", "examples", "notes", "cefr_level", "grammar", "labels", "synonyms", "antonyms", "created_at", "updated_at",
 		},
 		"questions": {
-			"id", "question", "option_a", "option_b", "option_c", "option_d", "answer", "reference", "notes", "count_practise", "count_failure_practise", "last_answered_at", "created_at", "updated_at",
+			"id", "question", "option_a", "option_b", "option_c", "option_d", "answer", "reference", "notes", "count_practise", "count_failure_practise", "last_answered_at", "question_type", "is_leech", "is_suspended", "created_at", "updated_at",
+		},
+		"question_options": {
+			"id", "question_id", "position", "content", "is_correct", "match_content", "created_at", "updated_at",
 		},
 		"notes": {
 			"id", "title", "content", "sort_order", "created_at", "updated_at",
 		},
 		"word_practice_logs": {
-			"id", "word_id", "familiarity", "previous_familiarity", "quiz_session_id", "created_at", "updated_at",
+			"id", "word_id", "familiarity", "previous_familiarity", "quiz_session_id", "mode", "typed_answer", "is_correct", "response_ms", "created_at", "updated_at",
 		},
 		"question_answer_logs": {
-			"id", "question_id", "selected_option", "is_correct", "created_at", "updated_at",
+			"id", "question_id", "selected_option", "is_correct", "response", "response_ms", "created_at", "updated_at",
+		},
+		"entity_links": {
+			"id", "source_type", "source_id", "target_type", "target_id", "created_at", "updated_at",
+		},
+		"dictionary_entries": {
+			"id", "source", "language", "word", "normalized_word", "entry", "created_at", "updated_at",
+		},
+		"dictionary_cache": {
+			"id", "provider", "language", "normalized_word", "entry", "hits", "cached_at", "last_accessed_at", "created_at", "updated_at",
+		},
+		"audio_files": {
+			"id", "source_url", "source_hash", "content_type", "size", "checksum", "file_name", "created_at", "updated_at",
+		},
+		"settings": {
+			"id", "name", "value", "created_at", "updated_at",
+		},
+		"reminders": {
+			"id", "entity_type", "entity_id", "due_at", "recurrence", "note", "is_overdue", "created_at", "updated_at",
+		},
+		"reminder_logs": {
+			"id", "reminder_id", "entity_type", "entity_id", "due_at", "recurrence", "note", "action", "created_at", "updated_at",
 		},
 	}
 
@@ -123,7 +147,7 @@ func TestMultipleRegistrations(t *testing.T) {
 
 	// Should still have the same number of tables
 	tables := database.GetAllTables()
-	expectedTableCount := 6
+	expectedTableCount := 14
 	if len(tables) != expectedTableCount {
 		t.Errorf("Expected %d tables after multiple registrations, got %d", expectedTableCount, len(tables))
 	}
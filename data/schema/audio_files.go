@@ -0,0 +1,85 @@
+package schema
+
+import "word-flashcard/utils/database/domain"
+
+const (
+	AUDIO_FILE_TABLE_NAME   = "audio_files"
+	AUDIO_FILE_ID           = COMMON_ID
+	AUDIO_FILE_SOURCE_URL   = "source_url"
+	AUDIO_FILE_SOURCE_HASH  = "source_hash"
+	AUDIO_FILE_CONTENT_TYPE = "content_type"
+	AUDIO_FILE_SIZE         = "size"
+	AUDIO_FILE_CHECKSUM     = "checksum"
+	AUDIO_FILE_FILE_NAME    = "file_name"
+)
+
+// AudioFilesTable defines the audio_files table structure. Each row is one
+// pronunciation recording downloaded when a word was saved; the bytes live on
+// disk under file_name and the row only records where they came from.
+// source_hash is the SHA-256 of source_url, so the same recording is never
+// downloaded twice, and checksum is the SHA-256 of the bytes, so a restored
+// backup can be verified.
+func AudioFilesTable() *domain.TableDefinition {
+	return &domain.TableDefinition{
+		Name: AUDIO_FILE_TABLE_NAME,
+		Columns: []domain.Column{
+			{
+				Name:          AUDIO_FILE_ID,
+				Type:          domain.IntType,
+				NotNull:       true,
+				AutoIncrement: true,
+				PrimaryKey:    true,
+			},
+			{
+				Name:    AUDIO_FILE_SOURCE_URL,
+				Type:    domain.TextType,
+				NotNull: true,
+			},
+			{
+				Name:    AUDIO_FILE_SOURCE_HASH,
+				Type:    domain.VarcharType(64),
+				NotNull: true,
+			},
+			{
+				Name:    AUDIO_FILE_CONTENT_TYPE,
+				Type:    domain.VarcharType(50),
+				NotNull: true,
+			},
+			{
+				Name:    AUDIO_FILE_SIZE,
+				Type:    domain.IntType,
+				NotNull: true,
+			},
+			{
+				Name:    AUDIO_FILE_CHECKSUM,
+				Type:    domain.VarcharType(64),
+				NotNull: true,
+			},
+			{
+				Name:    AUDIO_FILE_FILE_NAME,
+				Type:    domain.VarcharType(255),
+				NotNull: true,
+			},
+			{
+				Name:    COMMON_CREATED_AT,
+				Type:    domain.TimestampType,
+				NotNull: true,
+				Default: "CURRENT_TIMESTAMP",
+			},
+			{
+				Name:    COMMON_UPDATED_AT,
+				Type:    domain.TimestampType,
+				NotNull: true,
+				Default: "CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP",
+			},
+		},
+		Indexes: []domain.Index{
+			{
+				Name:    "source_hash_index",
+				Columns: []string{AUDIO_FILE_SOURCE_HASH},
+				Unique:  true,
+			},
+		},
+		Description: "Pronunciation audio downloaded and stored locally",
+	}
+}
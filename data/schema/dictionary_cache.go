@@ -0,0 +1,95 @@
+package schema
+
+import "word-flashcard/utils/database/domain"
+
+const (
+	DICTIONARY_CACHE_TABLE_NAME       = "dictionary_cache"
+	DICTIONARY_CACHE_ID               = COMMON_ID
+	DICTIONARY_CACHE_PROVIDER         = "provider"
+	DICTIONARY_CACHE_LANGUAGE         = "language"
+	DICTIONARY_CACHE_NORMALIZED_WORD  = "normalized_word"
+	DICTIONARY_CACHE_ENTRY            = "entry"
+	DICTIONARY_CACHE_HITS             = "hits"
+	DICTIONARY_CACHE_CACHED_AT        = "cached_at"
+	DICTIONARY_CACHE_LAST_ACCESSED_AT = "last_accessed_at"
+)
+
+// DictionaryCacheTable defines the dictionary_cache table structure. Each row
+// is one lookup result fetched from an online provider, kept as JSON so it
+// survives restarts. cached_at drives expiry and last_accessed_at picks the
+// least recently used rows to evict. Like dictionary_entries, the rows can
+// always be fetched again, so they are left out of backups.
+func DictionaryCacheTable() *domain.TableDefinition {
+	return &domain.TableDefinition{
+		Name: DICTIONARY_CACHE_TABLE_NAME,
+		Columns: []domain.Column{
+			{
+				Name:          DICTIONARY_CACHE_ID,
+				Type:          domain.IntType,
+				NotNull:       true,
+				AutoIncrement: true,
+				PrimaryKey:    true,
+			},
+			{
+				Name:    DICTIONARY_CACHE_PROVIDER,
+				Type:    domain.VarcharType(20),
+				NotNull: true,
+			},
+			{
+				Name:    DICTIONARY_CACHE_LANGUAGE,
+				Type:    domain.VarcharType(20),
+				NotNull: true,
+			},
+			{
+				Name:    DICTIONARY_CACHE_NORMALIZED_WORD,
+				Type:    domain.VarcharType(255),
+				NotNull: true,
+			},
+			{
+				Name:    DICTIONARY_CACHE_ENTRY,
+				Type:    domain.TextType,
+				NotNull: true,
+			},
+			{
+				Name:    DICTIONARY_CACHE_HITS,
+				Type:    domain.IntType,
+				NotNull: true,
+				Default: "0",
+			},
+			{
+				Name:    DICTIONARY_CACHE_CACHED_AT,
+				Type:    domain.TimestampType,
+				NotNull: true,
+			},
+			{
+				Name:    DICTIONARY_CACHE_LAST_ACCESSED_AT,
+				Type:    domain.TimestampType,
+				NotNull: true,
+			},
+			{
+				Name:    COMMON_CREATED_AT,
+				Type:    domain.TimestampType,
+				NotNull: true,
+				Default: "CURRENT_TIMESTAMP",
+			},
+			{
+				Name:    COMMON_UPDATED_AT,
+				Type:    domain.TimestampType,
+				NotNull: true,
+				Default: "CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP",
+			},
+		},
+		Indexes: []domain.Index{
+			{
+				Name:    "provider_language_word_index",
+				Columns: []string{DICTIONARY_CACHE_PROVIDER, DICTIONARY_CACHE_LANGUAGE, DICTIONARY_CACHE_NORMALIZED_WORD},
+				Unique:  true,
+			},
+			{
+				Name:    "last_accessed_at_index",
+				Columns: []string{DICTIONARY_CACHE_LAST_ACCESSED_AT},
+			},
+		},
+		Description: "Dictionary lookup results cached from online providers",
+	}
+}
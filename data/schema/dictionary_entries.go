@@ -0,0 +1,78 @@
+package schema
+
+import "word-flashcard/utils/database/domain"
+
+const (
+	DICTIONARY_ENTRY_TABLE_NAME      = "dictionary_entries"
+	DICTIONARY_ENTRY_ID              = COMMON_ID
+	DICTIONARY_ENTRY_SOURCE          = "source"
+	DICTIONARY_ENTRY_LANGUAGE        = "language"
+	DICTIONARY_ENTRY_WORD            = "word"
+	DICTIONARY_ENTRY_NORMALIZED_WORD = "normalized_word"
+	DICTIONARY_ENTRY_ENTRY           = "entry"
+)
+
+// DictionaryEntriesTable defines the dictionary_entries table structure. Each
+// row is one headword of an offline dictionary, with the full entry kept as
+// JSON in the same shape the dictionary endpoint returns. The rows are
+// imported reference data rather than user data, so they are left out of
+// backups.
+func DictionaryEntriesTable() *domain.TableDefinition {
+	return &domain.TableDefinition{
+		Name: DICTIONARY_ENTRY_TABLE_NAME,
+		Columns: []domain.Column{
+			{
+				Name:          DICTIONARY_ENTRY_ID,
+				Type:          domain.IntType,
+				NotNull:       true,
+				AutoIncrement: true,
+				PrimaryKey:    true,
+			},
+			{
+				Name:    DICTIONARY_ENTRY_SOURCE,
+				Type:    domain.VarcharType(20),
+				NotNull: true,
+			},
+			{
+				Name:    DICTIONARY_ENTRY_LANGUAGE,
+				Type:    domain.VarcharType(20),
+				NotNull: true,
+			},
+			{
+				Name:    DICTIONARY_ENTRY_WORD,
+				Type:    domain.VarcharType(255),
+				NotNull: true,
+			},
+			{
+				Name:    DICTIONARY_ENTRY_NORMALIZED_WORD,
+				Type:    domain.VarcharType(255),
+				NotNull: true,
+			},
+			{
+				Name:    DICTIONARY_ENTRY_ENTRY,
+				Type:    domain.TextType,
+				NotNull: true,
+			},
+			{
+				Name:    COMMON_CREATED_AT,
+				Type:    domain.TimestampType,
+				NotNull: true,
+				Default: "CURRENT_TIMESTAMP",
+			},
+			{
+				Name:    COMMON_UPDATED_AT,
+				Type:    domain.TimestampType,
+				NotNull: true,
+				Default: "CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP",
+			},
+		},
+		Indexes: []domain.Index{
+			{
+				Name:    "source_language_word_index",
+				Columns: []string{DICTIONARY_ENTRY_SOURCE, DICTIONARY_ENTRY_LANGUAGE, DICTIONARY_ENTRY_NORMALIZED_WORD},
+				Unique:  true,
+			},
+		},
+		Description: "Headwords of offline dictionaries imported from dictionary dumps",
+	}
+}
@@ -0,0 +1,85 @@
+package schema
+
+import "word-flashcard/utils/database/domain"
+
+const (
+	ENTITY_LINK_TABLE_NAME  = "entity_links"
+	ENTITY_LINK_ID          = COMMON_ID
+	ENTITY_LINK_SOURCE_TYPE = "source_type"
+	ENTITY_LINK_SOURCE_ID   = "source_id"
+	ENTITY_LINK_TARGET_TYPE = "target_type"
+	ENTITY_LINK_TARGET_ID   = "target_id"
+)
+
+// Entity types that can be linked to each other, in the order a link's two
+// ends are stored: the end that sorts first (by type, then by ID) is the source.
+const (
+	ENTITY_TYPE_WORD     = "word"
+	ENTITY_TYPE_QUESTION = "question"
+	ENTITY_TYPE_NOTE     = "note"
+)
+
+// EntityLinksTable defines the entity_links table structure. A link is
+// undirected; storing its ends in a fixed order means each pair of entities
+// has exactly one row, which the unique index enforces. The ends reference
+// different tables depending on their type, so there are no foreign keys and
+// links are removed explicitly when either end is deleted.
+func EntityLinksTable() *domain.TableDefinition {
+	return &domain.TableDefinition{
+		Name: ENTITY_LINK_TABLE_NAME,
+		Columns: []domain.Column{
+			{
+				Name:          ENTITY_LINK_ID,
+				Type:          domain.IntType,
+				NotNull:       true,
+				AutoIncrement: true,
+				PrimaryKey:    true,
+			},
+			{
+				Name:    ENTITY_LINK_SOURCE_TYPE,
+				Type:    domain.VarcharType(20),
+				NotNull: true,
+			},
+			{
+				Name:    ENTITY_LINK_SOURCE_ID,
+				Type:    domain.IntType,
+				NotNull: true,
+			},
+			{
+				Name:    ENTITY_LINK_TARGET_TYPE,
+				Type:    domain.VarcharType(20),
+				NotNull: true,
+			},
+			{
+				Name:    ENTITY_LINK_TARGET_ID,
+				Type:    domain.IntType,
+				NotNull: true,
+			},
+			{
+				Name:    COMMON_CREATED_AT,
+				Type:    domain.TimestampType,
+				NotNull: true,
+				Default: "CURRENT_TIMESTAMP",
+			},
+			{
+				Name:    COMMON_UPDATED_AT,
+				Type:    domain.TimestampType,
+				NotNull: true,
+				Default: "CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP",
+			},
+		},
+		Indexes: []domain.Index{
+			{
+				Name:    "source_target_index",
+				Columns: []string{ENTITY_LINK_SOURCE_TYPE, ENTITY_LINK_SOURCE_ID, ENTITY_LINK_TARGET_TYPE, ENTITY_LINK_TARGET_ID},
+				Unique:  true,
+			},
+			{
+				Name:    "target_index",
+				Columns: []string{ENTITY_LINK_TARGET_TYPE, ENTITY_LINK_TARGET_ID},
+				Unique:  false,
+			},
+		},
+		Description: "Undirected links between words, questions and notes",
+	}
+}
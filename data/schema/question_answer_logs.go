@@ -8,13 +8,18 @@ const (
 	QUESTION_ANSWER_LOG_QUESTION_ID     = "question_id"
 	QUESTION_ANSWER_LOG_SELECTED_OPTION = "selected_option"
 	QUESTION_ANSWER_LOG_IS_CORRECT      = "is_correct"
+	QUESTION_ANSWER_LOG_RESPONSE        = "response"
+	QUESTION_ANSWER_LOG_RESPONSE_MS     = "response_ms"
 )
 
 // QuestionAnswerLogsTable defines the question_answer_logs table structure.
 // Each row is an append-only record of a single quiz answer, including which
 // option was selected. selected_option must always refer to the question's
 // own option_a-d ordering, not the shuffled order shown during the quiz, so
-// per-option error rates can be derived correctly.
+// per-option error rates can be derived correctly. Question types whose
+// options live in question_options log an empty selected_option and record
+// the answer as JSON in response instead (option ids, typed text or pairs).
+// response_ms is how long the learner took to answer, when the quiz timed it.
 //
 // question_id intentionally carries no FK constraint: deleting a question
 // must never touch its answer history, so stats/trend charts stay unchanged
@@ -47,6 +52,16 @@ func QuestionAnswerLogsTable() *domain.TableDefinition {
 				Type:    domain.BooleanType,
 				NotNull: true,
 			},
+			{
+				Name:    QUESTION_ANSWER_LOG_RESPONSE,
+				Type:    domain.TextType,
+				NotNull: false,
+			},
+			{
+				Name:    QUESTION_ANSWER_LOG_RESPONSE_MS,
+				Type:    domain.IntType,
+				NotNull: false,
+			},
 			{
 				Name:    COMMON_CREATED_AT,
 				Type:    domain.TimestampType,
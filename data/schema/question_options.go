@@ -0,0 +1,82 @@
+package schema
+
+import "word-flashcard/utils/database/domain"
+
+const (
+	QUESTION_OPTION_TABLE_NAME    = "question_options"
+	QUESTION_OPTION_ID            = COMMON_ID
+	QUESTION_OPTION_QUESTION_ID   = "question_id"
+	QUESTION_OPTION_POSITION      = "position"
+	QUESTION_OPTION_CONTENT       = "content"
+	QUESTION_OPTION_IS_CORRECT    = "is_correct"
+	QUESTION_OPTION_MATCH_CONTENT = "match_content"
+)
+
+// QuestionOptionsTable defines the question_options table structure. It holds
+// an arbitrary number of options for the question types that don't fit the
+// fixed option_a-d columns:
+//   - multiple_select: every option, is_correct marks the ones to select
+//   - free_text: each row's content is one accepted answer
+//   - ordering: position is the option's place in the correct order
+//   - matching: content is paired with match_content
+//
+// Options belong to their question and are deleted along with it.
+func QuestionOptionsTable() *domain.TableDefinition {
+	return &domain.TableDefinition{
+		Name: QUESTION_OPTION_TABLE_NAME,
+		Columns: []domain.Column{
+			{
+				Name:          QUESTION_OPTION_ID,
+				Type:          domain.IntType,
+				NotNull:       true,
+				AutoIncrement: true,
+				PrimaryKey:    true,
+			},
+			{
+				Name:    QUESTION_OPTION_QUESTION_ID,
+				Type:    domain.IntType,
+				NotNull: true,
+				Index:   true,
+				ForeignKey: &domain.ForeignKey{
+					Table:  QUESTION_TABLE_NAME,
+					Column: QUESTION_ID,
+				},
+			},
+			{
+				Name:    QUESTION_OPTION_POSITION,
+				Type:    domain.IntType,
+				NotNull: true,
+				Default: "0",
+			},
+			{
+				Name:    QUESTION_OPTION_CONTENT,
+				Type:    domain.VarcharType(255),
+				NotNull: true,
+			},
+			{
+				Name:    QUESTION_OPTION_IS_CORRECT,
+				Type:    domain.BooleanType,
+				NotNull: false,
+			},
+			{
+				Name:    QUESTION_OPTION_MATCH_CONTENT,
+				Type:    domain.VarcharType(255),
+				NotNull: false,
+			},
+			{
+				Name:    COMMON_CREATED_AT,
+				Type:    domain.TimestampType,
+				NotNull: true,
+				Default: "CURRENT_TIMESTAMP",
+			},
+			{
+				Name:    COMMON_UPDATED_AT,
+				Type:    domain.TimestampType,
+				NotNull: true,
+				Default: "CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP",
+			},
+		},
+		Indexes:     []domain.Index{},
+		Description: "Options of questions whose type doesn't fit the fixed option_a-d columns",
+	}
+}
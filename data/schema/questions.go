@@ -16,6 +16,22 @@ const (
 	QUESTION_COUNT_PRACTISE         = "count_practise"
 	QUESTION_COUNT_FAILURE_PRACTISE = "count_failure_practise"
 	QUESTION_LAST_ANSWERED_AT       = "last_answered_at"
+	QUESTION_QUESTION_TYPE          = "question_type"
+	QUESTION_IS_LEECH               = "is_leech"
+	QUESTION_IS_SUSPENDED           = "is_suspended"
+)
+
+// Question types. A NULL question_type is a legacy row and is treated as
+// single_choice. single_choice and true_false keep using the option_a-d and
+// answer columns; every other type stores its options in question_options
+// and leaves option_a/answer empty.
+const (
+	QUESTION_TYPE_SINGLE_CHOICE   = "single_choice"
+	QUESTION_TYPE_TRUE_FALSE      = "true_false"
+	QUESTION_TYPE_MULTIPLE_SELECT = "multiple_select"
+	QUESTION_TYPE_FREE_TEXT       = "free_text"
+	QUESTION_TYPE_ORDERING        = "ordering"
+	QUESTION_TYPE_MATCHING        = "matching"
 )
 
 // QuestionsTable defines the questions table structure
@@ -87,6 +103,23 @@ func QuestionsTable() *domain.TableDefinition {
 				Type:    domain.TimestampType,
 				NotNull: false,
 			},
+			{
+				Name:    QUESTION_QUESTION_TYPE,
+				Type:    domain.VarcharType(20),
+				NotNull: false,
+			},
+			{
+				Name:    QUESTION_IS_LEECH,
+				Type:    domain.BooleanType,
+				NotNull: true,
+				Default: "FALSE",
+			},
+			{
+				Name:    QUESTION_IS_SUSPENDED,
+				Type:    domain.BooleanType,
+				NotNull: true,
+				Default: "FALSE",
+			},
 			{
 				Name:    COMMON_CREATED_AT,
 				Type:    domain.TimestampType,
@@ -0,0 +1,94 @@
+package schema
+
+import "word-flashcard/utils/database/domain"
+
+const (
+	REMINDER_LOG_TABLE_NAME  = "reminder_logs"
+	REMINDER_LOG_ID          = COMMON_ID
+	REMINDER_LOG_REMINDER_ID = "reminder_id"
+	REMINDER_LOG_ENTITY_TYPE = "entity_type"
+	REMINDER_LOG_ENTITY_ID   = "entity_id"
+	REMINDER_LOG_DUE_AT      = "due_at"
+	REMINDER_LOG_RECURRENCE  = "recurrence"
+	REMINDER_LOG_NOTE        = "note"
+	REMINDER_LOG_ACTION      = "action"
+)
+
+// Reminder log actions
+const (
+	REMINDER_ACTION_CLEARED = "cleared"
+	REMINDER_ACTION_DELETED = "deleted"
+)
+
+// ReminderLogsTable defines the reminder_logs table structure. A row is
+// written each time a reminder is cleared or deleted, copying the reminder as
+// it was, so the history survives the reminder itself.
+func ReminderLogsTable() *domain.TableDefinition {
+	return &domain.TableDefinition{
+		Name: REMINDER_LOG_TABLE_NAME,
+		Columns: []domain.Column{
+			{
+				Name:          REMINDER_LOG_ID,
+				Type:          domain.IntType,
+				NotNull:       true,
+				AutoIncrement: true,
+				PrimaryKey:    true,
+			},
+			{
+				Name:    REMINDER_LOG_REMINDER_ID,
+				Type:    domain.IntType,
+				NotNull: true,
+			},
+			{
+				Name:    REMINDER_LOG_ENTITY_TYPE,
+				Type:    domain.VarcharType(20),
+				NotNull: true,
+			},
+			{
+				Name:    REMINDER_LOG_ENTITY_ID,
+				Type:    domain.IntType,
+				NotNull: true,
+			},
+			{
+				Name:    REMINDER_LOG_DUE_AT,
+				Type:    domain.TimestampType,
+				NotNull: true,
+			},
+			{
+				Name:    REMINDER_LOG_RECURRENCE,
+				Type:    domain.VarcharType(10),
+				NotNull: false,
+			},
+			{
+				Name:    REMINDER_LOG_NOTE,
+				Type:    domain.VarcharType(255),
+				NotNull: false,
+			},
+			{
+				Name:    REMINDER_LOG_ACTION,
+				Type:    domain.VarcharType(20),
+				NotNull: true,
+			},
+			{
+				Name:    COMMON_CREATED_AT,
+				Type:    domain.TimestampType,
+				NotNull: true,
+				Default: "CURRENT_TIMESTAMP",
+			},
+			{
+				Name:    COMMON_UPDATED_AT,
+				Type:    domain.TimestampType,
+				NotNull: true,
+				Default: "CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP",
+			},
+		},
+		Indexes: []domain.Index{
+			{
+				Name:    "entity_index",
+				Columns: []string{REMINDER_LOG_ENTITY_TYPE, REMINDER_LOG_ENTITY_ID},
+				Unique:  false,
+			},
+		},
+		Description: "History of cleared and deleted reminders",
+	}
+}
@@ -0,0 +1,97 @@
+package schema
+
+import "word-flashcard/utils/database/domain"
+
+const (
+	REMINDER_TABLE_NAME  = "reminders"
+	REMINDER_ID          = COMMON_ID
+	REMINDER_ENTITY_TYPE = "entity_type"
+	REMINDER_ENTITY_ID   = "entity_id"
+	REMINDER_DUE_AT      = "due_at"
+	REMINDER_RECURRENCE  = "recurrence"
+	REMINDER_NOTE        = "note"
+	REMINDER_IS_OVERDUE  = "is_overdue"
+)
+
+// Reminder recurrences. A reminder without one is removed once cleared.
+const (
+	REMINDER_RECURRENCE_DAILY  = "daily"
+	REMINDER_RECURRENCE_WEEKLY = "weekly"
+)
+
+// RemindersTable defines the reminders table structure. A reminder asks to
+// revisit a word, question or note at due_at; like entity_links it
+// references different tables depending on entity_type, so there are no
+// foreign keys. Each entity has at most one reminder, which the unique index
+// enforces. is_overdue is set by the reminder scheduler once a due reminder
+// has gone uncleared for too long.
+func RemindersTable() *domain.TableDefinition {
+	return &domain.TableDefinition{
+		Name: REMINDER_TABLE_NAME,
+		Columns: []domain.Column{
+			{
+				Name:          REMINDER_ID,
+				Type:          domain.IntType,
+				NotNull:       true,
+				AutoIncrement: true,
+				PrimaryKey:    true,
+			},
+			{
+				Name:    REMINDER_ENTITY_TYPE,
+				Type:    domain.VarcharType(20),
+				NotNull: true,
+			},
+			{
+				Name:    REMINDER_ENTITY_ID,
+				Type:    domain.IntType,
+				NotNull: true,
+			},
+			{
+				Name:    REMINDER_DUE_AT,
+				Type:    domain.TimestampType,
+				NotNull: true,
+			},
+			{
+				Name:    REMINDER_RECURRENCE,
+				Type:    domain.VarcharType(10),
+				NotNull: false,
+			},
+			{
+				Name:    REMINDER_NOTE,
+				Type:    domain.VarcharType(255),
+				NotNull: false,
+			},
+			{
+				Name:    REMINDER_IS_OVERDUE,
+				Type:    domain.BooleanType,
+				NotNull: true,
+				Default: "FALSE",
+			},
+			{
+				Name:    COMMON_CREATED_AT,
+				Type:    domain.TimestampType,
+				NotNull: true,
+				Default: "CURRENT_TIMESTAMP",
+			},
+			{
+				Name:    COMMON_UPDATED_AT,
+				Type:    domain.TimestampType,
+				NotNull: true,
+				Default: "CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP",
+			},
+		},
+		Indexes: []domain.Index{
+			{
+				Name:    "entity_index",
+				Columns: []string{REMINDER_ENTITY_TYPE, REMINDER_ENTITY_ID},
+				Unique:  true,
+			},
+			{
+				Name:    "due_at_index",
+				Columns: []string{REMINDER_DUE_AT},
+				Unique:  false,
+			},
+		},
+		Description: "Due-dated reminders to revisit words, questions and notes",
+	}
+}
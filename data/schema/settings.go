@@ -0,0 +1,68 @@
+package schema
+
+import "word-flashcard/utils/database/domain"
+
+const (
+	SETTING_TABLE_NAME = "settings"
+	SETTING_ID         = COMMON_ID
+	SETTING_NAME       = "name"
+	SETTING_VALUE      = "value"
+)
+
+// Setting names stored in the settings table
+const (
+	SETTING_DAILY_GOAL_WORDS     = "daily_goal_words"
+	SETTING_DAILY_GOAL_QUESTIONS = "daily_goal_questions"
+	SETTING_LEECH_WORD_LAPSES    = "leech_word_lapses"
+	SETTING_LEECH_QUESTION_FAILS = "leech_question_failures"
+	SETTING_LEECH_AUTO_SUSPEND   = "leech_auto_suspend"
+	SETTING_REPORT_TIMEZONE      = "report_timezone"
+
+	// SETTING_WORD_REMINDERS_MIGRATED records that the deprecated
+	// words.reminder markers were turned into reminders rows, so the
+	// migration only ever runs once
+	SETTING_WORD_REMINDERS_MIGRATED = "word_reminders_migrated"
+)
+
+// SettingsTable defines the settings table structure. Each row holds one
+// named preference the learner changes from the app (e.g. the daily goal),
+// as opposed to deployment configuration, which lives in environment
+// variables. A setting without a row uses its default.
+func SettingsTable() *domain.TableDefinition {
+	return &domain.TableDefinition{
+		Name: SETTING_TABLE_NAME,
+		Columns: []domain.Column{
+			{
+				Name:          SETTING_ID,
+				Type:          domain.IntType,
+				NotNull:       true,
+				AutoIncrement: true,
+				PrimaryKey:    true,
+			},
+			{
+				Name:    SETTING_NAME,
+				Type:    domain.VarcharType(64),
+				NotNull: true,
+				Unique:  true,
+			},
+			{
+				Name:    SETTING_VALUE,
+				Type:    domain.TextType,
+				NotNull: true,
+			},
+			{
+				Name:    COMMON_CREATED_AT,
+				Type:    domain.TimestampType,
+				NotNull: true,
+				Default: "CURRENT_TIMESTAMP",
+			},
+			{
+				Name:    COMMON_UPDATED_AT,
+				Type:    domain.TimestampType,
+				NotNull: true,
+				Default: "CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP",
+			},
+		},
+		Description: "Named learner preferences such as the daily goal",
+	}
+}
@@ -11,6 +11,21 @@ const (
 	WORD_DEFINITIONS_PHONETICS      = "phonetics"
 	WORD_DEFINITIONS_EXAMPLES       = "examples"
 	WORD_DEFINITIONS_NOTES          = "notes"
+	WORD_DEFINITIONS_CEFR_LEVEL     = "cefr_level"
+	WORD_DEFINITIONS_GRAMMAR        = "grammar"
+	WORD_DEFINITIONS_LABELS         = "labels"
+	WORD_DEFINITIONS_SYNONYMS       = "synonyms"
+	WORD_DEFINITIONS_ANTONYMS       = "antonyms"
+)
+
+// CEFR levels a definition can be tagged with, easiest first
+const (
+	WORD_DEFINITIONS_CEFR_A1 = "A1"
+	WORD_DEFINITIONS_CEFR_A2 = "A2"
+	WORD_DEFINITIONS_CEFR_B1 = "B1"
+	WORD_DEFINITIONS_CEFR_B2 = "B2"
+	WORD_DEFINITIONS_CEFR_C1 = "C1"
+	WORD_DEFINITIONS_CEFR_C2 = "C2"
 )
 
 // WordDefinitionsTable defines the word_definitions table structure
@@ -60,6 +75,32 @@ func WordDefinitionsTable() *domain.TableDefinition {
 				Type:    domain.TextType,
 				NotNull: false,
 			},
+			{
+				Name:    WORD_DEFINITIONS_CEFR_LEVEL,
+				Type:    domain.VarcharType(2),
+				NotNull: false,
+				Index:   true,
+			},
+			{
+				Name:    WORD_DEFINITIONS_GRAMMAR,
+				Type:    domain.TextType,
+				NotNull: false,
+			},
+			{
+				Name:    WORD_DEFINITIONS_LABELS,
+				Type:    domain.TextType,
+				NotNull: false,
+			},
+			{
+				Name:    WORD_DEFINITIONS_SYNONYMS,
+				Type:    domain.TextType,
+				NotNull: false,
+			},
+			{
+				Name:    WORD_DEFINITIONS_ANTONYMS,
+				Type:    domain.TextType,
+				NotNull: false,
+			},
 			{
 				Name:    COMMON_CREATED_AT,
 				Type:    domain.TimestampType,
@@ -79,6 +120,11 @@ func WordDefinitionsTable() *domain.TableDefinition {
 				Columns: []string{"word_id"},
 				Unique:  false,
 			},
+			{
+				Name:    "cefr_level_index",
+				Columns: []string{"cefr_level"},
+				Unique:  false,
+			},
 		},
 		Description: "Word definitions with multiple entries per word",
 	}
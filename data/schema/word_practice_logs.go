@@ -9,6 +9,16 @@ const (
 	WORD_PRACTICE_LOG_FAMILIARITY          = "familiarity"
 	WORD_PRACTICE_LOG_PREVIOUS_FAMILIARITY = "previous_familiarity"
 	WORD_PRACTICE_LOG_QUIZ_SESSION_ID      = "quiz_session_id"
+	WORD_PRACTICE_LOG_MODE                 = "mode"
+	WORD_PRACTICE_LOG_TYPED_ANSWER         = "typed_answer"
+	WORD_PRACTICE_LOG_IS_CORRECT           = "is_correct"
+	WORD_PRACTICE_LOG_RESPONSE_MS          = "response_ms"
+
+	// Quiz modes recorded in word_practice_logs.mode. Rows written before the
+	// column existed have a NULL mode and are familiarity self-reports.
+	WORD_PRACTICE_MODE_FAMILIARITY = "familiarity"
+	WORD_PRACTICE_MODE_SPELLING    = "spelling"
+	WORD_PRACTICE_MODE_CLOZE       = "cloze"
 )
 
 // WordPracticeLogsTable defines the word_practice_logs table structure.
@@ -24,6 +34,12 @@ const (
 // never touch its practice history, so stats/trend charts stay unchanged
 // after deletion. This means word_id can end up referencing a word that no
 // longer exists -- that is expected, not a data integrity bug.
+//
+// mode distinguishes self-reported familiarity answers from graded
+// production answers (e.g. spelling). typed_answer and is_correct are only
+// filled in for graded modes; for those rows familiarity and
+// previous_familiarity both hold the word's unchanged familiarity.
+// response_ms is how long the learner took to answer, when the quiz timed it.
 func WordPracticeLogsTable() *domain.TableDefinition {
 	return &domain.TableDefinition{
 		Name: WORD_PRACTICE_LOG_TABLE_NAME,
@@ -56,6 +72,26 @@ func WordPracticeLogsTable() *domain.TableDefinition {
 				Type:    domain.VarcharType(36),
 				NotNull: false,
 			},
+			{
+				Name:    WORD_PRACTICE_LOG_MODE,
+				Type:    domain.VarcharType(20),
+				NotNull: false,
+			},
+			{
+				Name:    WORD_PRACTICE_LOG_TYPED_ANSWER,
+				Type:    domain.VarcharType(255),
+				NotNull: false,
+			},
+			{
+				Name:    WORD_PRACTICE_LOG_IS_CORRECT,
+				Type:    domain.BooleanType,
+				NotNull: false,
+			},
+			{
+				Name:    WORD_PRACTICE_LOG_RESPONSE_MS,
+				Type:    domain.IntType,
+				NotNull: false,
+			},
 			{
 				Name:    COMMON_CREATED_AT,
 				Type:    domain.TimestampType,
@@ -10,12 +10,16 @@ const (
 	WORD_REMINDER           = "reminder"
 	WORD_COUNT_PRACTISE     = "count_practise"
 	WORD_LAST_PRACTISED_AT  = "last_practiced_at"
+	WORD_IS_LEECH           = "is_leech"
+	WORD_IS_SUSPENDED       = "is_suspended"
 	WORD_FAMILIARITY_RED    = "red"
 	WORD_FAMILIARITY_YELLOW = "yellow"
 	WORD_FAMILIARITY_GREEN  = "green"
 )
 
-// WordsTable defines the words table structure
+// WordsTable defines the words table structure. The reminder column is a
+// deprecated free-text marker; due-dated reminders live in the reminders
+// table, which alone schedules reviews.
 func WordsTable() *domain.TableDefinition {
 	return &domain.TableDefinition{
 		Name: WORD_TABLE_NAME,
@@ -55,6 +59,18 @@ func WordsTable() *domain.TableDefinition {
 				Type:    domain.TimestampType,
 				NotNull: false,
 			},
+			{
+				Name:    WORD_IS_LEECH,
+				Type:    domain.BooleanType,
+				NotNull: true,
+				Default: "FALSE",
+			},
+			{
+				Name:    WORD_IS_SUSPENDED,
+				Type:    domain.BooleanType,
+				NotNull: true,
+				Default: "FALSE",
+			},
 			{
 				Name:    COMMON_CREATED_AT,
 				Type:    domain.TimestampType,
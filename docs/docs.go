@@ -855,7 +855,7 @@ const docTemplate = `{
                 "parameters": [
                     {
                         "type": "integer",
-                        "description": "Number of days to include (default: 30, max: 90)",
+                        "description": "Number of days to include (default: 30, max: 3650)",
                         "name": "days",
                         "in": "query"
                     }
@@ -1535,7 +1535,7 @@ const docTemplate = `{
                 "parameters": [
                     {
                         "type": "integer",
-                        "description": "Number of days to include (default: 30, max: 90)",
+                        "description": "Number of days to include (default: 30, max: 3650)",
                         "name": "days",
                         "in": "query"
                     }
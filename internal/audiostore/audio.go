@@ -0,0 +1,341 @@
+// Package audiostore keeps downloaded pronunciation recordings on disk for
+// the controllers that save, serve and back them up.
+package audiostore
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"mime"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"time"
+
+	dbModels "word-flashcard/data/models"
+	"word-flashcard/data/peers"
+	"word-flashcard/data/schema"
+	"word-flashcard/internal/models"
+	"word-flashcard/utils"
+	"word-flashcard/utils/config"
+
+	"github.com/Masterminds/squirrel"
+)
+
+// AudioRoutePrefix is the path stored audio is served under; the ID of the
+// audio_files row follows it
+const AudioRoutePrefix = "/api/audio/"
+
+// Defaults for the audio store: files go to ./audio, only the hosts the
+// dictionary providers link to are downloaded from, and a recording may be
+// at most 2 MiB
+const (
+	defaultAudioDir          = "audio"
+	defaultAudioAllowedHosts = "dictionary.cambridge.org,upload.wikimedia.org"
+	defaultAudioMaxBytes     = 2 << 20
+)
+
+// audioDownloadTimeout bounds how long a single recording may take to download
+const audioDownloadTimeout = 10 * time.Second
+
+// audioMaxRedirects caps how many redirects a single download may follow
+const audioMaxRedirects = 3
+
+// audioExtensions maps the content types accepted as pronunciation audio to
+// the extension their files are stored with
+var audioExtensions = map[string]string{
+	"audio/mpeg":      ".mp3",
+	"audio/mp3":       ".mp3",
+	"audio/ogg":       ".ogg",
+	"application/ogg": ".ogg",
+}
+
+var (
+	// ErrAudioNotFound is returned for an audio ID with no stored recording
+	ErrAudioNotFound = errors.New("audio not found")
+	// ErrAudioChecksumMismatch is returned when restored audio data doesn't
+	// match the checksum recorded alongside it
+	ErrAudioChecksumMismatch = errors.New("audio checksum mismatch")
+)
+
+// AudioStore downloads pronunciation recordings and keeps them on disk, so
+// audio keeps playing offline or after the dictionary moves its files. It is
+// shared by the word controller, which stores audio as definitions are
+// saved, the audio controller, which serves it, and the backup controller,
+// which bundles it into exports.
+type AudioStore struct {
+	audioFilePeer peers.AudioFilePeerInterface
+	httpClient    *http.Client
+	allowedHosts  []string
+	maxBytes      int64
+}
+
+// NewAudioStore creates a new AudioStore instance. Recordings are only
+// downloaded from AUDIO_ALLOWED_HOSTS (comma-separated) and may be at most
+// AUDIO_MAX_BYTES long; files are kept in AUDIO_DIR. Redirects are only
+// followed to allowed hosts, and at most audioMaxRedirects of them.
+func NewAudioStore(audioFilePeer peers.AudioFilePeerInterface) *AudioStore {
+	var allowedHosts []string
+	for _, host := range strings.Split(config.GetOrDefault("AUDIO_ALLOWED_HOSTS", defaultAudioAllowedHosts), ",") {
+		if host = strings.ToLower(strings.TrimSpace(host)); host != "" {
+			allowedHosts = append(allowedHosts, host)
+		}
+	}
+
+	store := &AudioStore{
+		audioFilePeer: audioFilePeer,
+		allowedHosts:  allowedHosts,
+		maxBytes:      int64(config.GetOrDefaultInt("AUDIO_MAX_BYTES", defaultAudioMaxBytes)),
+	}
+	store.httpClient = &http.Client{Timeout: audioDownloadTimeout, CheckRedirect: store.checkRedirect}
+	return store
+}
+
+// checkRedirect stops a download from being redirected off the allowed
+// hosts, so the allowlist can't be bypassed through an open redirect, and
+// from following more than audioMaxRedirects redirects
+func (s *AudioStore) checkRedirect(req *http.Request, via []*http.Request) error {
+	if len(via) > audioMaxRedirects {
+		return fmt.Errorf("stopped after %d redirects", audioMaxRedirects)
+	}
+	if !s.isAllowedSource(req.URL.String()) {
+		return fmt.Errorf("redirect to disallowed source %q", req.URL.Redacted())
+	}
+	return nil
+}
+
+// AudioURL returns the path an audio_files row is served under
+func AudioURL(id int) string {
+	return fmt.Sprintf("%s%d", AudioRoutePrefix, id)
+}
+
+// audioDir returns the directory audio files are stored in
+func audioDir() string {
+	return config.GetOrDefault("AUDIO_DIR", defaultAudioDir)
+}
+
+// LocalizePhonetics downloads every remote recording referenced by a
+// definition's phonetics and points the entry at the stored copy instead. A
+// plain URL value becomes {"audio": "/api/audio/<id>", "url": <original>};
+// an object value with a url but no audio gets an audio key added. Values
+// that aren't downloadable URLs are left alone, and a recording that can't
+// be stored is logged and keeps its original URL, so saving a word never
+// fails because of audio.
+func (s *AudioStore) LocalizePhonetics(phonetics *map[string]interface{}) {
+	if s == nil || phonetics == nil {
+		return
+	}
+
+	for key, value := range *phonetics {
+		switch v := value.(type) {
+		case string:
+			if audio := s.localize(v); audio != "" {
+				(*phonetics)[key] = map[string]interface{}{"audio": audio, "url": v}
+			}
+		case map[string]interface{}:
+			if _, hasAudio := v["audio"]; hasAudio {
+				continue
+			}
+			if source, ok := v["url"].(string); ok {
+				if audio := s.localize(source); audio != "" {
+					v["audio"] = audio
+				}
+			}
+		}
+	}
+}
+
+// localize stores the recording at source and returns the path it is served
+// under, or "" when source isn't a URL the store downloads from or the
+// download failed
+func (s *AudioStore) localize(source string) string {
+	if !s.isAllowedSource(source) {
+		return ""
+	}
+	audioFile, err := s.Store(source)
+	if err != nil {
+		slog.Warn("Failed to store pronunciation audio", "url", source, "error", err)
+		return ""
+	}
+	return AudioURL(*audioFile.Id)
+}
+
+// isAllowedSource reports whether source is an http(s) URL on one of the
+// allowed hosts
+func (s *AudioStore) isAllowedSource(source string) bool {
+	parsed, err := url.Parse(strings.TrimSpace(source))
+	if err != nil || (parsed.Scheme != "http" && parsed.Scheme != "https") {
+		return false
+	}
+	return slices.Contains(s.allowedHosts, strings.ToLower(parsed.Hostname()))
+}
+
+// Store returns the stored recording downloaded from source, downloading it
+// first if it hasn't been already
+func (s *AudioStore) Store(source string) (*dbModels.AudioFile, error) {
+	hash := sha256Hex([]byte(source))
+	if existing, err := s.findBySourceHash(hash); err != nil || existing != nil {
+		return existing, err
+	}
+
+	data, contentType, err := s.download(source)
+	if err != nil {
+		return nil, err
+	}
+
+	audioFile := &dbModels.AudioFile{
+		SourceUrl:   utils.StrPtr(source),
+		SourceHash:  utils.StrPtr(hash),
+		ContentType: utils.StrPtr(contentType),
+		Size:        utils.IntPtr(len(data)),
+		Checksum:    utils.StrPtr(sha256Hex(data)),
+		FileName:    utils.StrPtr(hash + audioExtensions[contentType]),
+	}
+	if err := s.WriteData(audioFile, data); err != nil {
+		return nil, err
+	}
+
+	id, err := s.audioFilePeer.Insert(audioFile)
+	if err != nil {
+		// Another request stored the same recording first
+		if existing, findErr := s.findBySourceHash(hash); findErr == nil && existing != nil {
+			return existing, nil
+		}
+		return nil, fmt.Errorf("failed to record audio file: %w", err)
+	}
+	audioFile.Id = utils.IntPtr(int(id))
+	return audioFile, nil
+}
+
+// findBySourceHash returns the recording downloaded from the URL hashing to
+// hash, or nil when there is none
+func (s *AudioStore) findBySourceHash(hash string) (*dbModels.AudioFile, error) {
+	limit := uint64(1)
+	audioFiles, err := s.audioFilePeer.Select([]*string{}, squirrel.Eq{schema.AUDIO_FILE_SOURCE_HASH: hash}, nil, &limit, nil)
+	if err != nil {
+		return nil, err
+	}
+	if len(audioFiles) == 0 || audioFiles[0].Id == nil {
+		return nil, nil
+	}
+	return audioFiles[0], nil
+}
+
+// download fetches source, accepting only mp3 and ogg responses no longer
+// than maxBytes
+func (s *AudioStore) download(source string) ([]byte, string, error) {
+	resp, err := s.httpClient.Get(source)
+	if err != nil {
+		return nil, "", fmt.Errorf("failed to download audio: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, "", fmt.Errorf("failed to download audio: unexpected status %d", resp.StatusCode)
+	}
+	contentType, _, err := mime.ParseMediaType(resp.Header.Get("Content-Type"))
+	if _, ok := audioExtensions[contentType]; err != nil || !ok {
+		return nil, "", fmt.Errorf("unsupported audio content type %q", resp.Header.Get("Content-Type"))
+	}
+
+	data, err := io.ReadAll(io.LimitReader(resp.Body, s.maxBytes+1))
+	if err != nil {
+		return nil, "", fmt.Errorf("failed to read audio: %w", err)
+	}
+	if int64(len(data)) > s.maxBytes {
+		return nil, "", fmt.Errorf("audio exceeds %d bytes", s.maxBytes)
+	}
+	return data, contentType, nil
+}
+
+// Open returns the audio_files row with id together with its file, which the
+// caller must close
+func (s *AudioStore) Open(id int) (*dbModels.AudioFile, *os.File, error) {
+	limit := uint64(1)
+	audioFiles, err := s.audioFilePeer.Select([]*string{}, squirrel.Eq{schema.AUDIO_FILE_ID: id}, nil, &limit, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+	if len(audioFiles) == 0 || audioFiles[0].FileName == nil {
+		return nil, nil, ErrAudioNotFound
+	}
+
+	file, err := os.Open(filepath.Join(audioDir(), filepath.Base(*audioFiles[0].FileName)))
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, nil, fmt.Errorf("%w: %s is missing from disk", ErrAudioNotFound, *audioFiles[0].FileName)
+	} else if err != nil {
+		return nil, nil, err
+	}
+	return audioFiles[0], file, nil
+}
+
+// WriteData writes the bytes of audioFile to disk, creating the audio
+// directory if needed. The bytes must match the recorded checksum when there
+// is one.
+func (s *AudioStore) WriteData(audioFile *dbModels.AudioFile, data []byte) error {
+	if audioFile.FileName == nil || *audioFile.FileName != filepath.Base(*audioFile.FileName) {
+		return fmt.Errorf("invalid audio file name")
+	}
+	if audioFile.Checksum != nil && *audioFile.Checksum != sha256Hex(data) {
+		return fmt.Errorf("%w: %s", ErrAudioChecksumMismatch, *audioFile.FileName)
+	}
+
+	dir := audioDir()
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return fmt.Errorf("failed to create audio directory: %w", err)
+	}
+	if err := os.WriteFile(filepath.Join(dir, *audioFile.FileName), data, 0o644); err != nil {
+		return fmt.Errorf("failed to write audio file: %w", err)
+	}
+	return nil
+}
+
+// Bundle returns every audio_files row, ordered by id, together with the
+// bytes of its file, for a full export. A file missing from disk is logged
+// and left out rather than failing the whole export.
+func (s *AudioStore) Bundle() ([]*models.AudioFileBundle, error) {
+	orderBy := fmt.Sprintf("%s ASC", schema.AUDIO_FILE_ID)
+	audioFiles, err := s.audioFilePeer.Select([]*string{}, nil, []*string{&orderBy}, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	bundles := make([]*models.AudioFileBundle, 0, len(audioFiles))
+	for _, audioFile := range audioFiles {
+		if audioFile.FileName == nil {
+			continue
+		}
+		data, err := os.ReadFile(filepath.Join(audioDir(), filepath.Base(*audioFile.FileName)))
+		if err != nil {
+			slog.Warn("Leaving unreadable audio file out of export", "file", *audioFile.FileName, "error", err)
+			continue
+		}
+		bundles = append(bundles, &models.AudioFileBundle{AudioFile: *audioFile, Data: data})
+	}
+	return bundles, nil
+}
+
+// Unbundle writes the files of an export's audio bundles to disk and
+// returns their rows for restoring
+func (s *AudioStore) Unbundle(bundles []*models.AudioFileBundle) ([]*dbModels.AudioFile, error) {
+	audioFiles := make([]*dbModels.AudioFile, 0, len(bundles))
+	for _, bundle := range bundles {
+		if err := s.WriteData(&bundle.AudioFile, bundle.Data); err != nil {
+			return nil, err
+		}
+		audioFiles = append(audioFiles, &bundle.AudioFile)
+	}
+	return audioFiles, nil
+}
+
+// sha256Hex returns the hex-encoded SHA-256 digest of data
+func sha256Hex(data []byte) string {
+	sum := sha256.Sum256(data)
+	return hex.EncodeToString(sum[:])
+}
@@ -0,0 +1,332 @@
+package audiostore
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"word-flashcard/data/mocks"
+	dbModels "word-flashcard/data/models"
+	"word-flashcard/data/schema"
+	"word-flashcard/internal/models"
+	"word-flashcard/utils"
+
+	"github.com/Masterminds/squirrel"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+)
+
+// AudioStoreTestSuite is a test suite for AudioStore
+type AudioStoreTestSuite struct {
+	suite.Suite
+	store             *AudioStore
+	mockAudioFilePeer *mocks.MockAudioFilePeer
+	server            *httptest.Server
+	requests          atomic.Int32
+	audioDir          string
+}
+
+// TestAudioStoreTestSuite runs the AudioStoreTestSuite
+func TestAudioStoreTestSuite(t *testing.T) {
+	suite.Run(t, new(AudioStoreTestSuite))
+}
+
+// SetupTest serves a few recordings and non-audio responses, and points the
+// store at a temporary audio directory
+func (suite *AudioStoreTestSuite) SetupTest() {
+	suite.requests.Store(0)
+	suite.server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		suite.requests.Add(1)
+		switch r.URL.Path {
+		case "/uk.mp3":
+			w.Header().Set("Content-Type", "audio/mpeg")
+			w.Write([]byte("uk recording"))
+		case "/us.ogg":
+			w.Header().Set("Content-Type", "audio/ogg; codecs=opus")
+			w.Write([]byte("us recording"))
+		case "/page.mp3":
+			w.Header().Set("Content-Type", "text/html")
+			w.Write([]byte("<html></html>"))
+		case "/long.mp3":
+			w.Header().Set("Content-Type", "audio/mpeg")
+			w.Write([]byte(strings.Repeat("x", 64)))
+		case "/moved.mp3":
+			http.Redirect(w, r, "/uk.mp3", http.StatusFound)
+		case "/offsite.mp3":
+			// localhost reaches the same server but isn't an allowed host
+			http.Redirect(w, r, strings.Replace(suite.server.URL, "127.0.0.1", "localhost", 1)+"/uk.mp3", http.StatusFound)
+		case "/loop.mp3":
+			http.Redirect(w, r, "/loop.mp3", http.StatusFound)
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	suite.T().Cleanup(suite.server.Close)
+
+	suite.audioDir = suite.T().TempDir()
+	suite.T().Setenv("AUDIO_DIR", suite.audioDir)
+	suite.T().Setenv("AUDIO_ALLOWED_HOSTS", " 127.0.0.1 ,dictionary.cambridge.org")
+	suite.T().Setenv("AUDIO_MAX_BYTES", "32")
+
+	suite.mockAudioFilePeer = mocks.NewMockAudioFilePeer(suite.T())
+	suite.store = NewAudioStore(suite.mockAudioFilePeer)
+}
+
+// expectNotStored sets up source as never downloaded before
+func (suite *AudioStoreTestSuite) expectNotStored(source string) {
+	suite.mockAudioFilePeer.EXPECT().
+		Select(mock.Anything, squirrel.Eq{schema.AUDIO_FILE_SOURCE_HASH: sha256Hex([]byte(source))}, mock.Anything, mock.Anything, mock.Anything).
+		Return([]*dbModels.AudioFile{}, nil).Times(1)
+}
+
+// TestNewAudioStore tests that allowed hosts and the size limit are read from the environment
+func (suite *AudioStoreTestSuite) TestNewAudioStore() {
+	suite.Equal([]string{"127.0.0.1", "dictionary.cambridge.org"}, suite.store.allowedHosts)
+	suite.Equal(int64(32), suite.store.maxBytes)
+}
+
+// TestLocalizePhonetics tests that remote recordings are stored and
+// referenced, while other values are left alone
+func (suite *AudioStoreTestSuite) TestLocalizePhonetics() {
+	ukURL, usURL := suite.server.URL+"/uk.mp3", suite.server.URL+"/us.ogg"
+	suite.expectNotStored(ukURL)
+	suite.expectNotStored(usURL)
+	suite.mockAudioFilePeer.EXPECT().
+		Insert(mock.MatchedBy(func(audioFile *dbModels.AudioFile) bool { return *audioFile.SourceUrl == ukURL })).
+		Run(func(args mock.Arguments) {
+			audioFile := args.Get(0).(*dbModels.AudioFile)
+			suite.Equal("audio/mpeg", *audioFile.ContentType)
+			suite.Equal(len("uk recording"), *audioFile.Size)
+			suite.Equal(sha256Hex([]byte("uk recording")), *audioFile.Checksum)
+			suite.Equal(sha256Hex([]byte(ukURL))+".mp3", *audioFile.FileName)
+		}).
+		Return(int64(5), nil).Times(1)
+	suite.mockAudioFilePeer.EXPECT().
+		Insert(mock.MatchedBy(func(audioFile *dbModels.AudioFile) bool { return *audioFile.SourceUrl == usURL })).
+		Return(int64(6), nil).Times(1)
+
+	phonetics := map[string]interface{}{
+		"uk":      ukURL,
+		"us":      map[string]interface{}{"url": usURL},
+		"ipa":     "/həˈləʊ/",
+		"foreign": "https://example.com/hello.mp3",
+		"stored":  map[string]interface{}{"audio": "/api/audio/1", "url": ukURL},
+	}
+	suite.store.LocalizePhonetics(&phonetics)
+
+	suite.Equal(map[string]interface{}{"audio": "/api/audio/5", "url": ukURL}, phonetics["uk"])
+	suite.Equal(map[string]interface{}{"audio": "/api/audio/6", "url": usURL}, phonetics["us"])
+	suite.Equal("/həˈləʊ/", phonetics["ipa"])
+	suite.Equal("https://example.com/hello.mp3", phonetics["foreign"])
+	suite.Equal(map[string]interface{}{"audio": "/api/audio/1", "url": ukURL}, phonetics["stored"])
+
+	data, err := os.ReadFile(filepath.Join(suite.audioDir, sha256Hex([]byte(ukURL))+".mp3"))
+	suite.Require().NoError(err)
+	suite.Equal("uk recording", string(data))
+	_, err = os.Stat(filepath.Join(suite.audioDir, sha256Hex([]byte(usURL))+".ogg"))
+	suite.NoError(err)
+}
+
+// TestLocalizePhoneticsKeepsURLOnFailure tests that recordings that can't
+// be downloaded keep their original URL
+func (suite *AudioStoreTestSuite) TestLocalizePhoneticsKeepsURLOnFailure() {
+	for _, path := range []string{"/page.mp3", "/long.mp3", "/missing.mp3"} {
+		suite.Run(path, func() {
+			source := suite.server.URL + path
+			suite.expectNotStored(source)
+
+			phonetics := map[string]interface{}{"uk": source}
+			suite.store.LocalizePhonetics(&phonetics)
+
+			suite.Equal(source, phonetics["uk"])
+		})
+	}
+
+	entries, err := os.ReadDir(suite.audioDir)
+	suite.Require().NoError(err)
+	suite.Empty(entries)
+}
+
+// TestLocalizePhoneticsWithoutStore tests that a nil store or phonetics are ignored
+func (suite *AudioStoreTestSuite) TestLocalizePhoneticsWithoutStore() {
+	phonetics := map[string]interface{}{"uk": suite.server.URL + "/uk.mp3"}
+
+	(*AudioStore)(nil).LocalizePhonetics(&phonetics)
+	suite.store.LocalizePhonetics(nil)
+
+	suite.Equal(suite.server.URL+"/uk.mp3", phonetics["uk"])
+	suite.Zero(suite.requests.Load())
+}
+
+// TestStoreReusesDownloadedAudio tests that a recording downloaded before
+// isn't fetched again
+func (suite *AudioStoreTestSuite) TestStoreReusesDownloadedAudio() {
+	existing := &dbModels.AudioFile{Id: utils.IntPtr(3), FileName: utils.StrPtr("uk.mp3")}
+	suite.mockAudioFilePeer.EXPECT().
+		Select(mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+		Return([]*dbModels.AudioFile{existing}, nil).Times(1)
+
+	audioFile, err := suite.store.Store(suite.server.URL + "/uk.mp3")
+
+	suite.Require().NoError(err)
+	suite.Same(existing, audioFile)
+	suite.Zero(suite.requests.Load())
+}
+
+// TestStoreConcurrentInsert tests that a recording stored by another request
+// in the meantime is returned instead of failing
+func (suite *AudioStoreTestSuite) TestStoreConcurrentInsert() {
+	source := suite.server.URL + "/uk.mp3"
+	existing := &dbModels.AudioFile{Id: utils.IntPtr(3)}
+	suite.expectNotStored(source)
+	suite.mockAudioFilePeer.EXPECT().Insert(mock.Anything).Return(int64(0), errors.New("Duplicate entry")).Times(1)
+	suite.mockAudioFilePeer.EXPECT().
+		Select(mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+		Return([]*dbModels.AudioFile{existing}, nil).Times(1)
+
+	audioFile, err := suite.store.Store(source)
+
+	suite.Require().NoError(err)
+	suite.Same(existing, audioFile)
+}
+
+// TestStoreFailures tests that database failures are reported
+func (suite *AudioStoreTestSuite) TestStoreFailures() {
+	suite.Run("lookup", func() {
+		suite.mockAudioFilePeer.EXPECT().
+			Select(mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+			Return(nil, errors.New("connection refused")).Times(1)
+
+		_, err := suite.store.Store(suite.server.URL + "/uk.mp3")
+
+		suite.Error(err)
+	})
+	suite.Run("insert", func() {
+		suite.SetupTest()
+		suite.expectNotStored(suite.server.URL + "/uk.mp3")
+		suite.mockAudioFilePeer.EXPECT().Insert(mock.Anything).Return(int64(0), errors.New("connection refused")).Times(1)
+		suite.mockAudioFilePeer.EXPECT().
+			Select(mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+			Return(nil, errors.New("connection refused")).Times(1)
+
+		_, err := suite.store.Store(suite.server.URL + "/uk.mp3")
+
+		suite.ErrorContains(err, "failed to record audio file")
+	})
+}
+
+// TestDownloadRedirects tests that redirects are followed within the
+// allowed hosts only, and no more than audioMaxRedirects times
+func (suite *AudioStoreTestSuite) TestDownloadRedirects() {
+	data, contentType, err := suite.store.download(suite.server.URL + "/moved.mp3")
+	suite.Require().NoError(err)
+	suite.Equal("uk recording", string(data))
+	suite.Equal("audio/mpeg", contentType)
+	suite.Equal(int32(2), suite.requests.Load())
+
+	suite.requests.Store(0)
+	_, _, err = suite.store.download(suite.server.URL + "/offsite.mp3")
+	suite.ErrorContains(err, "redirect to disallowed source")
+	suite.Equal(int32(1), suite.requests.Load())
+
+	suite.requests.Store(0)
+	_, _, err = suite.store.download(suite.server.URL + "/loop.mp3")
+	suite.ErrorContains(err, "stopped after 3 redirects")
+	suite.Equal(int32(audioMaxRedirects+1), suite.requests.Load())
+}
+
+// TestIsAllowedSource tests which URLs the store downloads from
+func (suite *AudioStoreTestSuite) TestIsAllowedSource() {
+	suite.True(suite.store.isAllowedSource("https://dictionary.cambridge.org/media/english/uk_pron/hello.mp3"))
+	suite.True(suite.store.isAllowedSource("http://DICTIONARY.cambridge.org/hello.mp3"))
+	suite.False(suite.store.isAllowedSource("ftp://dictionary.cambridge.org/hello.mp3"))
+	suite.False(suite.store.isAllowedSource("//dictionary.cambridge.org/hello.mp3"))
+	suite.False(suite.store.isAllowedSource("https://dictionary.cambridge.org.example.com/hello.mp3"))
+	suite.False(suite.store.isAllowedSource("/api/audio/1"))
+}
+
+// TestOpen tests that stored files are opened by ID and that missing rows
+// or files are reported as not found
+func (suite *AudioStoreTestSuite) TestOpen() {
+	suite.Require().NoError(os.WriteFile(filepath.Join(suite.audioDir, "uk.mp3"), []byte("uk recording"), 0o644))
+	tests := []struct {
+		name      string
+		rows      []*dbModels.AudioFile
+		selectErr error
+		wantErrIs error
+	}{
+		{"found", []*dbModels.AudioFile{{Id: utils.IntPtr(3), FileName: utils.StrPtr("uk.mp3")}}, nil, nil},
+		{"no row", []*dbModels.AudioFile{}, nil, ErrAudioNotFound},
+		{"file missing", []*dbModels.AudioFile{{Id: utils.IntPtr(3), FileName: utils.StrPtr("us.ogg")}}, nil, ErrAudioNotFound},
+		{"database failure", nil, errors.New("connection refused"), nil},
+	}
+
+	for _, tt := range tests {
+		suite.Run(tt.name, func() {
+			suite.mockAudioFilePeer.EXPECT().
+				Select(mock.Anything, squirrel.Eq{schema.AUDIO_FILE_ID: 3}, mock.Anything, mock.Anything, mock.Anything).
+				Return(tt.rows, tt.selectErr).Times(1)
+
+			audioFile, file, err := suite.store.Open(3)
+
+			switch {
+			case tt.wantErrIs != nil:
+				suite.ErrorIs(err, tt.wantErrIs)
+			case tt.selectErr != nil:
+				suite.ErrorIs(err, tt.selectErr)
+			default:
+				suite.Require().NoError(err)
+				defer file.Close()
+				suite.Equal("uk.mp3", *audioFile.FileName)
+			}
+		})
+	}
+}
+
+// TestBundle tests that every row is bundled with its file, skipping files
+// missing from disk
+func (suite *AudioStoreTestSuite) TestBundle() {
+	suite.Require().NoError(os.WriteFile(filepath.Join(suite.audioDir, "uk.mp3"), []byte("uk recording"), 0o644))
+	orderBy := "id ASC"
+	suite.mockAudioFilePeer.EXPECT().
+		Select([]*string{}, nil, []*string{&orderBy}, (*uint64)(nil), (*uint64)(nil)).
+		Return([]*dbModels.AudioFile{
+			{Id: utils.IntPtr(1), FileName: utils.StrPtr("uk.mp3")},
+			{Id: utils.IntPtr(2), FileName: utils.StrPtr("us.ogg")},
+		}, nil).Times(1)
+
+	bundles, err := suite.store.Bundle()
+
+	suite.Require().NoError(err)
+	suite.Require().Len(bundles, 1)
+	suite.Equal(1, *bundles[0].Id)
+	suite.Equal([]byte("uk recording"), bundles[0].Data)
+}
+
+// TestUnbundle tests that bundled files are written to disk, refusing data
+// that doesn't match its checksum and names outside the audio directory
+func (suite *AudioStoreTestSuite) TestUnbundle() {
+	bundle := func(name, data, checksumOf string) *models.AudioFileBundle {
+		return &models.AudioFileBundle{
+			AudioFile: dbModels.AudioFile{Id: utils.IntPtr(1), FileName: utils.StrPtr(name), Checksum: utils.StrPtr(sha256Hex([]byte(checksumOf)))},
+			Data:      []byte(data),
+		}
+	}
+
+	audioFiles, err := suite.store.Unbundle([]*models.AudioFileBundle{bundle("uk.mp3", "uk recording", "uk recording")})
+	suite.Require().NoError(err)
+	suite.Len(audioFiles, 1)
+	data, err := os.ReadFile(filepath.Join(suite.audioDir, "uk.mp3"))
+	suite.Require().NoError(err)
+	suite.Equal("uk recording", string(data))
+
+	_, err = suite.store.Unbundle([]*models.AudioFileBundle{bundle("us.ogg", "us recording", "uk recording")})
+	suite.ErrorIs(err, ErrAudioChecksumMismatch)
+
+	_, err = suite.store.Unbundle([]*models.AudioFileBundle{bundle("../us.ogg", "us recording", "us recording")})
+	suite.ErrorContains(err, "invalid audio file name")
+}
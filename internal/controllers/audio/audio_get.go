@@ -0,0 +1,59 @@
+package audio
+
+import (
+	"errors"
+	"net/http"
+	"time"
+	"word-flashcard/internal/audiostore"
+	"word-flashcard/internal/controllers/common"
+	"word-flashcard/internal/models"
+
+	"github.com/gin-gonic/gin"
+)
+
+// GetAudio @Summary Stream stored pronunciation audio
+// @Description Streams a pronunciation recording downloaded when a word was saved. Supports Range requests, so players can seek and resume.
+// @Tags audio
+// @Produce audio/mpeg
+// @Produce audio/ogg
+// @Param id path int true "Audio file ID"
+// @Param Range header string false "Byte range to return, e.g. bytes=0-1023"
+// @Success 200 {file} file "Audio file contents"
+// @Success 206 {file} file "Requested byte range of the audio file"
+// @Failure 400 {object} models.ErrorResponse "Bad request - Invalid audio ID"
+// @Failure 404 {object} models.ErrorResponse "Not found - Audio not found"
+// @Failure 416 {string} string "Requested range not satisfiable"
+// @Failure 500 {object} models.ErrorResponse "Internal server error - Failed to read audio"
+// @Router /api/audio/{id} [get]
+func (ac *Controller) GetAudio(c *gin.Context) {
+	// ================ 1. Parse request parameter ================
+	audioID, err := common.ParseIDFromPath(c, "id")
+	if err != nil {
+		common.ResponseError(http.StatusBadRequest, "Invalid audio ID.", models.ErrCodeInvalidRequest, err, c)
+		return
+	}
+
+	// ================ 2. Open stored file ================
+	audioFile, file, err := ac.audioStore.Open(audioID)
+	if errors.Is(err, audiostore.ErrAudioNotFound) {
+		common.ResponseError(http.StatusNotFound, "Audio not found", models.ErrCodeNotFound, err, c)
+		return
+	} else if err != nil {
+		common.ResponseError(http.StatusInternalServerError, "Failed to read audio", models.ErrCodeInternalError, err, c)
+		return
+	}
+	defer file.Close()
+
+	// ================ 3. Stream file ================
+	// JSONMiddleware has already set a JSON Content-Type, which ServeContent
+	// would otherwise keep
+	if audioFile.ContentType != nil {
+		c.Header("Content-Type", *audioFile.ContentType)
+	}
+	c.Header("Cache-Control", "public, max-age=31536000, immutable")
+	var modTime time.Time
+	if audioFile.CreatedAt != nil {
+		modTime = *audioFile.CreatedAt
+	}
+	http.ServeContent(c.Writer, c.Request, *audioFile.FileName, modTime, file)
+}
@@ -0,0 +1,110 @@
+package audio
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+
+	dbModels "word-flashcard/data/models"
+	"word-flashcard/data/schema"
+	"word-flashcard/utils"
+
+	"github.com/Masterminds/squirrel"
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/mock"
+)
+
+// serveAudio runs GetAudio for id with the JSON Content-Type JSONMiddleware
+// sets on every API route, and optionally a Range header
+func (suite *ControllerTestSuite) serveAudio(id, rangeHeader string) *httptest.ResponseRecorder {
+	w := httptest.NewRecorder()
+	ctx, _ := gin.CreateTestContext(w)
+	ctx.Request = httptest.NewRequest(http.MethodGet, "/api/audio/"+id, nil)
+	if rangeHeader != "" {
+		ctx.Request.Header.Set("Range", rangeHeader)
+	}
+	ctx.Params = gin.Params{gin.Param{Key: "id", Value: id}}
+	ctx.Header("Content-Type", "application/json")
+	suite.controller.GetAudio(ctx)
+	return w
+}
+
+// TestGetAudio tests that a stored file is streamed with its own content type
+func (suite *ControllerTestSuite) TestGetAudio() {
+	suite.mockAudioFilePeer.EXPECT().
+		Select(mock.Anything, squirrel.Eq{schema.AUDIO_FILE_ID: 3}, mock.Anything, mock.Anything, mock.Anything).
+		Return([]*dbModels.AudioFile{suite.sampleAudioFile()}, nil).Times(1)
+
+	w := suite.serveAudio("3", "")
+
+	suite.Equal(http.StatusOK, w.Code)
+	suite.Equal("audio/mpeg", w.Header().Get("Content-Type"))
+	suite.Equal("bytes", w.Header().Get("Accept-Ranges"))
+	suite.Equal(testAudioCreateTime.Format(http.TimeFormat), w.Header().Get("Last-Modified"))
+	suite.Equal(sampleAudioData, w.Body.String())
+}
+
+// TestGetAudioRange tests that a Range request returns only the requested bytes
+func (suite *ControllerTestSuite) TestGetAudioRange() {
+	suite.mockAudioFilePeer.EXPECT().
+		Select(mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+		Return([]*dbModels.AudioFile{suite.sampleAudioFile()}, nil).Times(1)
+
+	w := suite.serveAudio("3", "bytes=4-7")
+
+	suite.Equal(http.StatusPartialContent, w.Code)
+	suite.Equal("bytes 4-7/17", w.Header().Get("Content-Range"))
+	suite.Equal("fake", w.Body.String())
+}
+
+// TestGetAudioUnsatisfiableRange tests that a range past the end of the file returns 416
+func (suite *ControllerTestSuite) TestGetAudioUnsatisfiableRange() {
+	suite.mockAudioFilePeer.EXPECT().
+		Select(mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+		Return([]*dbModels.AudioFile{suite.sampleAudioFile()}, nil).Times(1)
+
+	w := suite.serveAudio("3", "bytes=100-200")
+
+	suite.Equal(http.StatusRequestedRangeNotSatisfiable, w.Code)
+}
+
+// TestGetAudioInvalidID tests that an invalid audio ID returns 400
+func (suite *ControllerTestSuite) TestGetAudioInvalidID() {
+	w := suite.serveAudio("abc", "")
+
+	suite.Equal(http.StatusBadRequest, w.Code)
+}
+
+// TestGetAudioNotFound tests that an unknown ID or a file missing from disk returns 404
+func (suite *ControllerTestSuite) TestGetAudioNotFound() {
+	tests := []struct {
+		name string
+		rows []*dbModels.AudioFile
+	}{
+		{"no row", []*dbModels.AudioFile{}},
+		{"file missing", []*dbModels.AudioFile{{Id: utils.IntPtr(3), FileName: utils.StrPtr("missing.mp3")}}},
+	}
+
+	for _, tt := range tests {
+		suite.Run(tt.name, func() {
+			suite.mockAudioFilePeer.EXPECT().
+				Select(mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+				Return(tt.rows, nil).Times(1)
+
+			w := suite.serveAudio("3", "")
+
+			suite.Equal(http.StatusNotFound, w.Code)
+		})
+	}
+}
+
+// TestGetAudioSelectError tests that a database failure returns 500
+func (suite *ControllerTestSuite) TestGetAudioSelectError() {
+	suite.mockAudioFilePeer.EXPECT().
+		Select(mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+		Return(nil, errors.New("connection refused")).Times(1)
+
+	w := suite.serveAudio("3", "")
+
+	suite.Equal(http.StatusInternalServerError, w.Code)
+}
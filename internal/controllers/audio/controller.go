@@ -0,0 +1,23 @@
+package audio
+
+import (
+	"word-flashcard/data/peers"
+	"word-flashcard/internal/audiostore"
+)
+
+// Controller handles requests for stored pronunciation audio
+type Controller struct {
+	audioStore *audiostore.AudioStore
+}
+
+// New creates a new Controller instance
+func New(audioStore *audiostore.AudioStore) *Controller {
+	return &Controller{
+		audioStore: audioStore,
+	}
+}
+
+// GetReelPeer returns the real database peer for audio files
+func GetReelPeer() (peers.AudioFilePeerInterface, error) {
+	return peers.NewAudioFilePeer()
+}
@@ -0,0 +1,53 @@
+package audio
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+	"word-flashcard/data/mocks"
+	dbModels "word-flashcard/data/models"
+	"word-flashcard/internal/audiostore"
+	"word-flashcard/utils"
+
+	"github.com/stretchr/testify/suite"
+)
+
+// ControllerTestSuite is a test suite for the audio Controller
+type ControllerTestSuite struct {
+	suite.Suite
+	controller        *Controller
+	mockAudioFilePeer *mocks.MockAudioFilePeer
+	audioDir          string
+}
+
+// TestControllerTestSuite runs the ControllerTestSuite
+func TestControllerTestSuite(t *testing.T) {
+	suite.Run(t, new(ControllerTestSuite))
+}
+
+// SetupTest sets up the test environment before each test
+func (suite *ControllerTestSuite) SetupTest() {
+	suite.audioDir = suite.T().TempDir()
+	suite.T().Setenv("AUDIO_DIR", suite.audioDir)
+	suite.mockAudioFilePeer = mocks.NewMockAudioFilePeer(suite.T())
+	suite.controller = New(audiostore.NewAudioStore(suite.mockAudioFilePeer))
+}
+
+var testAudioCreateTime = time.Date(2024, 1, 15, 10, 0, 0, 0, time.UTC)
+
+// sampleAudioData is the content of the file sampleAudioFile describes
+const sampleAudioData = "ID3 fake mp3 data"
+
+// sampleAudioFile writes sampleAudioData to the audio directory and returns
+// the row describing it
+func (suite *ControllerTestSuite) sampleAudioFile() *dbModels.AudioFile {
+	suite.Require().NoError(os.WriteFile(filepath.Join(suite.audioDir, "hello.mp3"), []byte(sampleAudioData), 0o644))
+	return &dbModels.AudioFile{
+		Id:          utils.IntPtr(3),
+		ContentType: utils.StrPtr("audio/mpeg"),
+		Size:        utils.IntPtr(len(sampleAudioData)),
+		FileName:    utils.StrPtr("hello.mp3"),
+		CreatedAt:   &testAudioCreateTime,
+	}
+}
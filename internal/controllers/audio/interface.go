@@ -0,0 +1,8 @@
+package audio
+
+import "github.com/gin-gonic/gin"
+
+// ControllerInterface defines the interface for audio controller
+type ControllerInterface interface {
+	GetAudio(c *gin.Context)
+}
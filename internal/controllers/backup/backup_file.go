@@ -17,8 +17,9 @@ const BackupFilePrefix = "word-flashcard-backup-"
 
 // WriteBackupFile builds a full export (see BuildExport) and writes it as
 // an indented JSON file inside dir, creating dir if it doesn't already
-// exist. It returns the full path of the file just written.
-func (bc *Controller) WriteBackupFile(dir string) (string, error) {
+// exist. The file name's timestamp is the current time in loc. It returns
+// the full path of the file just written.
+func (bc *Controller) WriteBackupFile(dir string, loc *time.Location) (string, error) {
 	export, err := bc.BuildExport()
 	if err != nil {
 		return "", fmt.Errorf("failed to build export: %w", err)
@@ -28,7 +29,7 @@ func (bc *Controller) WriteBackupFile(dir string) (string, error) {
 		return "", fmt.Errorf("failed to create backup directory: %w", err)
 	}
 
-	filename := fmt.Sprintf("%s%s.json", BackupFilePrefix, time.Now().UTC().Format("20060102-150405"))
+	filename := fmt.Sprintf("%s%s.json", BackupFilePrefix, time.Now().In(loc).Format("20060102-150405"))
 	path := filepath.Join(dir, filename)
 
 	data, err := json.MarshalIndent(export, "", "  ")
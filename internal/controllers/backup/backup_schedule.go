@@ -0,0 +1,50 @@
+package backup
+
+import (
+	"time"
+	"word-flashcard/internal/models"
+	"word-flashcard/utils/config"
+)
+
+// defaultBackupEnabled and defaultBackupIntervalHours mirror
+// internal/scheduler's defaults for the same reason defaultBackupDir does.
+const (
+	defaultBackupEnabled       = true
+	defaultBackupIntervalHours = 72
+)
+
+// BackupSchedule reports when the automatic backup scheduler writes its
+// next backup: one backup interval after the newest backup file, or now if
+// that is already past or there is no backup yet. The scheduler only looks
+// on each of its checks, so the backup itself may follow up to one check
+// interval later. It backs the calendar feed.
+func (bc *Controller) BackupSchedule(now time.Time) (models.BackupSchedule, error) {
+	intervalHours := config.GetOrDefaultInt("BACKUP_INTERVAL_HOURS", defaultBackupIntervalHours)
+	schedule := models.BackupSchedule{
+		Enabled:       config.GetOrDefaultBool("BACKUP_ENABLED", defaultBackupEnabled),
+		IntervalHours: intervalHours,
+	}
+	if !schedule.Enabled {
+		return schedule, nil
+	}
+
+	files, err := ListBackupFiles(config.GetOrDefault("BACKUP_DIR", defaultBackupDir))
+	if err != nil {
+		return models.BackupSchedule{}, err
+	}
+
+	next := now
+	for _, f := range files {
+		if schedule.LastBackupAt == nil || f.ModTime.After(*schedule.LastBackupAt) {
+			modTime := f.ModTime
+			schedule.LastBackupAt = &modTime
+		}
+	}
+	if schedule.LastBackupAt != nil {
+		if due := schedule.LastBackupAt.Add(time.Duration(intervalHours) * time.Hour); due.After(now) {
+			next = due
+		}
+	}
+	schedule.NextBackupAt = &next
+	return schedule, nil
+}
@@ -0,0 +1,84 @@
+package backup
+
+import (
+	"path/filepath"
+	"time"
+)
+
+// TestBackupSchedule covers when the next automatic backup is reported:
+// disabled, before the first backup, overdue, and one interval after the
+// newest backup file.
+func (suite *ControllerTestSuite) TestBackupSchedule() {
+	now := time.Date(2024, 1, 15, 10, 0, 0, 0, time.UTC)
+	older := now.Add(-100 * time.Hour)
+	recent := now.Add(-2 * time.Hour)
+
+	tests := []struct {
+		name     string
+		enabled  string
+		setupDir func() string
+		wantLast *time.Time
+		wantNext *time.Time
+	}{
+		{
+			name:     "disabled scheduler schedules nothing",
+			enabled:  "false",
+			setupDir: func() string { return suite.T().TempDir() },
+		},
+		{
+			name:     "no backup yet is due now",
+			enabled:  "true",
+			setupDir: func() string { return filepath.Join(suite.T().TempDir(), "does-not-exist") },
+			wantNext: &now,
+		},
+		{
+			name:    "overdue backup is due now",
+			enabled: "true",
+			setupDir: func() string {
+				dir := suite.T().TempDir()
+				suite.writeBackupFile(filepath.Join(dir, BackupFilePrefix+"20240111-060000.json"), older)
+				return dir
+			},
+			wantLast: &older,
+			wantNext: &now,
+		},
+		{
+			name:    "recent backup is due one interval after the newest file",
+			enabled: "true",
+			setupDir: func() string {
+				dir := suite.T().TempDir()
+				suite.writeBackupFile(filepath.Join(dir, BackupFilePrefix+"20240111-060000.json"), older)
+				suite.writeBackupFile(filepath.Join(dir, BackupFilePrefix+"20240115-080000.json"), recent)
+				return dir
+			},
+			wantLast: &recent,
+			wantNext: func() *time.Time { next := recent.Add(72 * time.Hour); return &next }(),
+		},
+	}
+
+	for _, tt := range tests {
+		suite.Run(tt.name, func() {
+			suite.T().Setenv("BACKUP_ENABLED", tt.enabled)
+			suite.T().Setenv("BACKUP_INTERVAL_HOURS", "72")
+			suite.T().Setenv("BACKUP_DIR", tt.setupDir())
+
+			schedule, err := suite.controller.BackupSchedule(now)
+
+			suite.Require().NoError(err)
+			suite.Equal(tt.enabled == "true", schedule.Enabled)
+			suite.Equal(72, schedule.IntervalHours)
+			if tt.wantLast == nil {
+				suite.Nil(schedule.LastBackupAt)
+			} else {
+				suite.Require().NotNil(schedule.LastBackupAt)
+				suite.True(tt.wantLast.Equal(*schedule.LastBackupAt))
+			}
+			if tt.wantNext == nil {
+				suite.Nil(schedule.NextBackupAt)
+			} else {
+				suite.Require().NotNil(schedule.NextBackupAt)
+				suite.True(tt.wantNext.Equal(*schedule.NextBackupAt))
+			}
+		})
+	}
+}
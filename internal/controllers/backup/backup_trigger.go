@@ -14,13 +14,21 @@ import (
 // @Description Writes a new scheduled-style backup file (word-flashcard-backup-*.json) right now; this becomes the newest file, so it delays the next automatic scheduled backup.
 // @Tags data
 // @Produce json
+// @Param tz query string false "IANA timezone of the file name's timestamp (default: X-Timezone header, else the stored report timezone, else REPORT_TIMEZONE)"
+// @Param X-Timezone header string false "IANA timezone of the file name's timestamp when tz is not given"
 // @Success 200 {object} models.BackupFile "The newly created backup file"
+// @Failure 400 {object} models.ErrorResponse "Bad request - Invalid timezone parameter"
 // @Failure 500 {object} models.ErrorResponse "Internal server error - Failed to write the backup file"
 // @Router /api/data/backups [post]
 func (bc *Controller) TriggerBackup(c *gin.Context) {
+	loc, err := common.RequestReportLocation(c)
+	if err != nil {
+		common.ResponseError(http.StatusBadRequest, "Invalid timezone parameter", models.ErrCodeInvalidRequest, err, c)
+		return
+	}
 	dir := config.GetOrDefault("BACKUP_DIR", defaultBackupDir)
 
-	path, err := bc.WriteBackupFile(dir)
+	path, err := bc.WriteBackupFile(dir, loc)
 	if err != nil {
 		common.ResponseError(http.StatusInternalServerError, "Failed to write the backup file", models.ErrCodeInternalError, err, c)
 		return
@@ -38,10 +38,22 @@ func (suite *ControllerTestSuite) TestTriggerBackup() {
 					Return([]*dbModels.Note{}, nil).Times(1)
 				suite.mockWordDefinitionPeer.EXPECT().Select(mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).
 					Return([]*dbModels.WordDefinition{}, nil).Times(1)
+				suite.mockQuestionOptionPeer.EXPECT().Select(mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+					Return([]*dbModels.QuestionOption{}, nil).Times(1)
 				suite.mockQuestionAnswerLogPeer.EXPECT().Select(mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).
 					Return([]*dbModels.QuestionAnswerLog{}, nil).Times(1)
 				suite.mockWordPracticeLogPeer.EXPECT().Select(mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).
 					Return([]*dbModels.WordPracticeLog{}, nil).Times(1)
+				suite.mockEntityLinkPeer.EXPECT().Select(mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+					Return([]*dbModels.EntityLink{}, nil).Times(1)
+				suite.mockSettingPeer.EXPECT().Select(mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+					Return([]*dbModels.Setting{}, nil).Times(1)
+				suite.mockReminderPeer.EXPECT().Select(mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+					Return([]*dbModels.Reminder{}, nil).Times(1)
+				suite.mockReminderLogPeer.EXPECT().Select(mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+					Return([]*dbModels.ReminderLog{}, nil).Times(1)
+				suite.mockAudioFilePeer.EXPECT().Select(mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+					Return([]*dbModels.AudioFile{}, nil).Times(1)
 			},
 			dir:        func() string { return suite.T().TempDir() },
 			wantStatus: http.StatusOK,
@@ -66,10 +78,22 @@ func (suite *ControllerTestSuite) TestTriggerBackup() {
 					Return([]*dbModels.Note{}, nil).Times(1)
 				suite.mockWordDefinitionPeer.EXPECT().Select(mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).
 					Return([]*dbModels.WordDefinition{}, nil).Times(1)
+				suite.mockQuestionOptionPeer.EXPECT().Select(mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+					Return([]*dbModels.QuestionOption{}, nil).Times(1)
 				suite.mockQuestionAnswerLogPeer.EXPECT().Select(mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).
 					Return([]*dbModels.QuestionAnswerLog{}, nil).Times(1)
 				suite.mockWordPracticeLogPeer.EXPECT().Select(mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).
 					Return([]*dbModels.WordPracticeLog{}, nil).Times(1)
+				suite.mockEntityLinkPeer.EXPECT().Select(mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+					Return([]*dbModels.EntityLink{}, nil).Times(1)
+				suite.mockSettingPeer.EXPECT().Select(mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+					Return([]*dbModels.Setting{}, nil).Times(1)
+				suite.mockReminderPeer.EXPECT().Select(mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+					Return([]*dbModels.Reminder{}, nil).Times(1)
+				suite.mockReminderLogPeer.EXPECT().Select(mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+					Return([]*dbModels.ReminderLog{}, nil).Times(1)
+				suite.mockAudioFilePeer.EXPECT().Select(mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+					Return([]*dbModels.AudioFile{}, nil).Times(1)
 			},
 			dir: func() string {
 				path := filepath.Join(suite.T().TempDir(), "not-a-directory")
@@ -2,6 +2,7 @@ package backup
 
 import (
 	"word-flashcard/data/peers"
+	"word-flashcard/internal/audiostore"
 )
 
 // Controller handles full-database export/import requests
@@ -9,10 +10,16 @@ type Controller struct {
 	wordPeer              peers.WordPeerInterface
 	wordDefinitionPeer    peers.WordDefinitionsPeerInterface
 	questionPeer          peers.QuestionPeerInterface
+	questionOptionPeer    peers.QuestionOptionPeerInterface
 	questionAnswerLogPeer peers.QuestionAnswerLogPeerInterface
 	wordPracticeLogPeer   peers.WordPracticeLogPeerInterface
 	notePeer              peers.NotePeerInterface
+	entityLinkPeer        peers.EntityLinkPeerInterface
+	settingPeer           peers.SettingPeerInterface
+	reminderPeer          peers.ReminderPeerInterface
+	reminderLogPeer       peers.ReminderLogPeerInterface
 	backupPeer            peers.BackupPeerInterface
+	audioStore            *audiostore.AudioStore
 }
 
 // New creates a new Controller instance
@@ -20,19 +27,31 @@ func New(
 	wordPeer peers.WordPeerInterface,
 	wordDefinitionPeer peers.WordDefinitionsPeerInterface,
 	questionPeer peers.QuestionPeerInterface,
+	questionOptionPeer peers.QuestionOptionPeerInterface,
 	questionAnswerLogPeer peers.QuestionAnswerLogPeerInterface,
 	wordPracticeLogPeer peers.WordPracticeLogPeerInterface,
 	notePeer peers.NotePeerInterface,
+	entityLinkPeer peers.EntityLinkPeerInterface,
+	settingPeer peers.SettingPeerInterface,
+	reminderPeer peers.ReminderPeerInterface,
+	reminderLogPeer peers.ReminderLogPeerInterface,
 	backupPeer peers.BackupPeerInterface,
+	audioStore *audiostore.AudioStore,
 ) *Controller {
 	return &Controller{
 		wordPeer:              wordPeer,
 		wordDefinitionPeer:    wordDefinitionPeer,
 		questionPeer:          questionPeer,
+		questionOptionPeer:    questionOptionPeer,
 		questionAnswerLogPeer: questionAnswerLogPeer,
 		wordPracticeLogPeer:   wordPracticeLogPeer,
 		notePeer:              notePeer,
+		entityLinkPeer:        entityLinkPeer,
+		settingPeer:           settingPeer,
+		reminderPeer:          reminderPeer,
+		reminderLogPeer:       reminderLogPeer,
 		backupPeer:            backupPeer,
+		audioStore:            audioStore,
 	}
 }
 
@@ -41,46 +60,77 @@ func GetReelPeers() (
 	peers.WordPeerInterface,
 	peers.WordDefinitionsPeerInterface,
 	peers.QuestionPeerInterface,
+	peers.QuestionOptionPeerInterface,
 	peers.QuestionAnswerLogPeerInterface,
 	peers.WordPracticeLogPeerInterface,
 	peers.NotePeerInterface,
+	peers.EntityLinkPeerInterface,
+	peers.SettingPeerInterface,
+	peers.ReminderPeerInterface,
+	peers.ReminderLogPeerInterface,
 	peers.BackupPeerInterface,
 	error,
 ) {
 	wordPeer, err := peers.NewWordPeer()
 	if err != nil {
-		return nil, nil, nil, nil, nil, nil, nil, err
+		return nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, err
 	}
 
 	wordDefinitionPeer, err := peers.NewWordDefinitionsPeer()
 	if err != nil {
-		return nil, nil, nil, nil, nil, nil, nil, err
+		return nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, err
 	}
 
 	questionPeer, err := peers.NewQuestionPeer()
 	if err != nil {
-		return nil, nil, nil, nil, nil, nil, nil, err
+		return nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, err
+	}
+
+	questionOptionPeer, err := peers.NewQuestionOptionPeer()
+	if err != nil {
+		return nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, err
 	}
 
 	questionAnswerLogPeer, err := peers.NewQuestionAnswerLogPeer()
 	if err != nil {
-		return nil, nil, nil, nil, nil, nil, nil, err
+		return nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, err
 	}
 
 	wordPracticeLogPeer, err := peers.NewWordPracticeLogPeer()
 	if err != nil {
-		return nil, nil, nil, nil, nil, nil, nil, err
+		return nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, err
 	}
 
 	notePeer, err := peers.NewNotePeer()
 	if err != nil {
-		return nil, nil, nil, nil, nil, nil, nil, err
+		return nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, err
+	}
+
+	entityLinkPeer, err := peers.NewEntityLinkPeer()
+	if err != nil {
+		return nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, err
+	}
+
+	settingPeer, err := peers.NewSettingPeer()
+	if err != nil {
+		return nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, err
+	}
+
+	reminderPeer, err := peers.NewReminderPeer()
+	if err != nil {
+		return nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, err
+	}
+
+	reminderLogPeer, err := peers.NewReminderLogPeer()
+	if err != nil {
+		return nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, err
 	}
 
 	backupPeer, err := peers.NewBackupPeer()
 	if err != nil {
-		return nil, nil, nil, nil, nil, nil, nil, err
+		return nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, err
 	}
 
-	return wordPeer, wordDefinitionPeer, questionPeer, questionAnswerLogPeer, wordPracticeLogPeer, notePeer, backupPeer, nil
+	return wordPeer, wordDefinitionPeer, questionPeer, questionOptionPeer, questionAnswerLogPeer, wordPracticeLogPeer,
+		notePeer, entityLinkPeer, settingPeer, reminderPeer, reminderLogPeer, backupPeer, nil
 }
@@ -1,11 +1,14 @@
 package backup
 
 import (
+	"crypto/sha256"
+	"encoding/hex"
 	"testing"
 	"time"
 
 	"word-flashcard/data/mocks"
 	dbModels "word-flashcard/data/models"
+	"word-flashcard/internal/audiostore"
 
 	"github.com/stretchr/testify/suite"
 )
@@ -17,10 +20,16 @@ type ControllerTestSuite struct {
 	mockWordPeer              *mocks.MockWordPeer
 	mockWordDefinitionPeer    *mocks.MockWordDefinitionsPeer
 	mockQuestionPeer          *mocks.MockQuestionPeer
+	mockQuestionOptionPeer    *mocks.MockQuestionOptionPeer
 	mockQuestionAnswerLogPeer *mocks.MockQuestionAnswerLogPeer
 	mockWordPracticeLogPeer   *mocks.MockWordPracticeLogPeer
 	mockNotePeer              *mocks.MockNotePeer
+	mockEntityLinkPeer        *mocks.MockEntityLinkPeer
+	mockSettingPeer           *mocks.MockSettingPeer
+	mockReminderPeer          *mocks.MockReminderPeer
+	mockReminderLogPeer       *mocks.MockReminderLogPeer
 	mockBackupPeer            *mocks.MockBackupPeer
+	mockAudioFilePeer         *mocks.MockAudioFilePeer
 }
 
 // TestControllerTestSuite runs the ControllerTestSuite
@@ -33,19 +42,31 @@ func (suite *ControllerTestSuite) SetupTest() {
 	suite.mockWordPeer = mocks.NewMockWordPeer(suite.T())
 	suite.mockWordDefinitionPeer = mocks.NewMockWordDefinitionsPeer(suite.T())
 	suite.mockQuestionPeer = mocks.NewMockQuestionPeer(suite.T())
+	suite.mockQuestionOptionPeer = mocks.NewMockQuestionOptionPeer(suite.T())
 	suite.mockQuestionAnswerLogPeer = mocks.NewMockQuestionAnswerLogPeer(suite.T())
 	suite.mockWordPracticeLogPeer = mocks.NewMockWordPracticeLogPeer(suite.T())
 	suite.mockNotePeer = mocks.NewMockNotePeer(suite.T())
+	suite.mockEntityLinkPeer = mocks.NewMockEntityLinkPeer(suite.T())
+	suite.mockSettingPeer = mocks.NewMockSettingPeer(suite.T())
+	suite.mockReminderPeer = mocks.NewMockReminderPeer(suite.T())
+	suite.mockReminderLogPeer = mocks.NewMockReminderLogPeer(suite.T())
 	suite.mockBackupPeer = mocks.NewMockBackupPeer(suite.T())
+	suite.mockAudioFilePeer = mocks.NewMockAudioFilePeer(suite.T())
 
 	suite.controller = New(
 		suite.mockWordPeer,
 		suite.mockWordDefinitionPeer,
 		suite.mockQuestionPeer,
+		suite.mockQuestionOptionPeer,
 		suite.mockQuestionAnswerLogPeer,
 		suite.mockWordPracticeLogPeer,
 		suite.mockNotePeer,
+		suite.mockEntityLinkPeer,
+		suite.mockSettingPeer,
+		suite.mockReminderPeer,
+		suite.mockReminderLogPeer,
 		suite.mockBackupPeer,
+		audiostore.NewAudioStore(suite.mockAudioFilePeer),
 	)
 }
 
@@ -86,6 +107,16 @@ func sampleQuestion(id int) *dbModels.Question {
 	}
 }
 
+// sampleQuestionOption returns a minimally valid QuestionOption db model for testing
+func sampleQuestionOption(id, questionID int) *dbModels.QuestionOption {
+	position := 0
+	content := "two"
+	return &dbModels.QuestionOption{
+		Id: &id, QuestionId: &questionID, Position: &position, Content: &content,
+		CreatedAt: &testModifyTime, UpdatedAt: &testModifyTime,
+	}
+}
+
 // sampleQuestionAnswerLog returns a minimally valid QuestionAnswerLog db model for testing
 func sampleQuestionAnswerLog(id, questionID int) *dbModels.QuestionAnswerLog {
 	selected := "A"
@@ -115,3 +146,59 @@ func sampleNote(id int) *dbModels.Note {
 		CreatedAt: &testModifyTime, UpdatedAt: &testModifyTime,
 	}
 }
+
+// sampleEntityLink returns a minimally valid EntityLink db model for testing
+func sampleEntityLink(id int) *dbModels.EntityLink {
+	sourceType, targetType := "word", "note"
+	sourceID, targetID := 1, 1
+	return &dbModels.EntityLink{
+		Id: &id, SourceType: &sourceType, SourceId: &sourceID, TargetType: &targetType, TargetId: &targetID,
+		CreatedAt: &testModifyTime, UpdatedAt: &testModifyTime,
+	}
+}
+
+// sampleSetting returns a minimally valid Setting db model for testing
+func sampleSetting(id int) *dbModels.Setting {
+	name, value := "daily_goal_words", "20"
+	return &dbModels.Setting{
+		Id: &id, Name: &name, Value: &value,
+		CreatedAt: &testModifyTime, UpdatedAt: &testModifyTime,
+	}
+}
+
+// sampleReminder returns a minimally valid Reminder db model for testing
+func sampleReminder(id int) *dbModels.Reminder {
+	entityType, entityID, isOverdue := "word", 1, false
+	return &dbModels.Reminder{
+		Id: &id, EntityType: &entityType, EntityId: &entityID, DueAt: &testModifyTime, IsOverdue: &isOverdue,
+		CreatedAt: &testModifyTime, UpdatedAt: &testModifyTime,
+	}
+}
+
+// sampleReminderLog returns a minimally valid ReminderLog db model for testing
+func sampleReminderLog(id int) *dbModels.ReminderLog {
+	reminderID, entityType, entityID, action := 1, "word", 1, "cleared"
+	return &dbModels.ReminderLog{
+		Id: &id, ReminderId: &reminderID, EntityType: &entityType, EntityId: &entityID, DueAt: &testModifyTime, Action: &action,
+		CreatedAt: &testModifyTime, UpdatedAt: &testModifyTime,
+	}
+}
+
+// sampleAudioData is the recording sampleAudioFile describes
+var sampleAudioData = []byte("ID3 fake mp3 data")
+
+// sampleAudioFile returns a minimally valid AudioFile db model for testing,
+// whose size and checksum match sampleAudioData
+func sampleAudioFile(id int) *dbModels.AudioFile {
+	sourceURL := "https://dictionary.cambridge.org/media/english/uk_pron/u/ukh/ukhef/ukheft_029.mp3"
+	sourceHash := "2f1c7a8e"
+	contentType := "audio/mpeg"
+	size := len(sampleAudioData)
+	sum := sha256.Sum256(sampleAudioData)
+	checksum := hex.EncodeToString(sum[:])
+	fileName := "2f1c7a8e.mp3"
+	return &dbModels.AudioFile{
+		Id: &id, SourceUrl: &sourceURL, SourceHash: &sourceHash, ContentType: &contentType, Size: &size,
+		Checksum: &checksum, FileName: &fileName, CreatedAt: &testModifyTime, UpdatedAt: &testModifyTime,
+	}
+}
@@ -15,23 +15,33 @@ import (
 // @Description Returns every row of every table as a single JSON document, including ids and timestamps, suitable for a later POST /api/data/import restore.
 // @Tags data
 // @Produce json
+// @Param tz query string false "IANA timezone of the download file name's timestamp (default: X-Timezone header, else the stored report timezone, else REPORT_TIMEZONE)"
+// @Param X-Timezone header string false "IANA timezone of the download file name's timestamp when tz is not given"
 // @Success 200 {object} models.DataExport "Full database snapshot"
+// @Failure 400 {object} models.ErrorResponse "Bad request - Invalid timezone parameter"
 // @Failure 500 {object} models.ErrorResponse "Internal server error - Failed to fetch data from database"
 // @Router /api/data/export [get]
 func (bc *Controller) ExportData(c *gin.Context) {
+	loc, err := common.RequestReportLocation(c)
+	if err != nil {
+		common.ResponseError(http.StatusBadRequest, "Invalid timezone parameter", models.ErrCodeInvalidRequest, err, c)
+		return
+	}
+
 	export, err := bc.BuildExport()
 	if err != nil {
 		common.ResponseError(http.StatusInternalServerError, "Failed to fetch data from database", models.ErrCodeInternalError, err, c)
 		return
 	}
 
-	filename := fmt.Sprintf("word-flashcard-export-%s.json", time.Now().UTC().Format("20060102-150405"))
+	filename := fmt.Sprintf("word-flashcard-export-%s.json", time.Now().In(loc).Format("20060102-150405"))
 	c.Header("Content-Disposition", fmt.Sprintf("attachment; filename=%q", filename))
 	common.ResponseSuccess(http.StatusOK, export, c)
 }
 
 // BuildExport fetches every table, ordered by id, and assembles them into a
-// single full-database snapshot. It has no HTTP dependency, so it's reusable
+// single full-database snapshot, bundling the stored pronunciation audio
+// alongside its rows. It has no HTTP dependency, so it's reusable
 // both by ExportData (which streams the result as a download) and by the
 // scheduled backup job (internal/scheduler), which writes it to disk instead.
 func (bc *Controller) BuildExport() (*models.DataExport, error) {
@@ -59,6 +69,12 @@ func (bc *Controller) BuildExport() (*models.DataExport, error) {
 		return nil, err
 	}
 
+	questionOptionOrder := fmt.Sprintf("%s ASC", schema.QUESTION_OPTION_ID)
+	questionOptions, err := bc.questionOptionPeer.Select([]*string{}, nil, []*string{&questionOptionOrder}, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+
 	questionAnswerLogOrder := fmt.Sprintf("%s ASC", schema.QUESTION_ANSWER_LOG_ID)
 	questionAnswerLogs, err := bc.questionAnswerLogPeer.Select([]*string{}, nil, []*string{&questionAnswerLogOrder}, nil, nil)
 	if err != nil {
@@ -71,13 +87,48 @@ func (bc *Controller) BuildExport() (*models.DataExport, error) {
 		return nil, err
 	}
 
+	entityLinkOrder := fmt.Sprintf("%s ASC", schema.ENTITY_LINK_ID)
+	entityLinks, err := bc.entityLinkPeer.Select([]*string{}, nil, []*string{&entityLinkOrder}, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	settingOrder := fmt.Sprintf("%s ASC", schema.SETTING_ID)
+	settings, err := bc.settingPeer.Select([]*string{}, nil, []*string{&settingOrder}, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	reminderOrder := fmt.Sprintf("%s ASC", schema.REMINDER_ID)
+	reminders, err := bc.reminderPeer.Select([]*string{}, nil, []*string{&reminderOrder}, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	reminderLogOrder := fmt.Sprintf("%s ASC", schema.REMINDER_LOG_ID)
+	reminderLogs, err := bc.reminderLogPeer.Select([]*string{}, nil, []*string{&reminderLogOrder}, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	audioFiles, err := bc.audioStore.Bundle()
+	if err != nil {
+		return nil, err
+	}
+
 	return &models.DataExport{
 		ExportedAt:         time.Now().UTC(),
 		Words:              words,
 		WordDefinitions:    wordDefinitions,
 		Questions:          questions,
+		QuestionOptions:    questionOptions,
 		QuestionAnswerLogs: questionAnswerLogs,
 		WordPracticeLogs:   wordPracticeLogs,
 		Notes:              notes,
+		EntityLinks:        entityLinks,
+		Settings:           settings,
+		Reminders:          reminders,
+		ReminderLogs:       reminderLogs,
+		AudioFiles:         audioFiles,
 	}, nil
 }
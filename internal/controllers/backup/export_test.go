@@ -5,6 +5,8 @@ import (
 	"errors"
 	"net/http"
 	"net/http/httptest"
+	"os"
+	"path/filepath"
 	"strings"
 
 	dbModels "word-flashcard/data/models"
@@ -36,10 +38,22 @@ func (suite *ControllerTestSuite) TestBuildExport() {
 					Return([]*dbModels.Note{sampleNote(1)}, nil).Times(1)
 				suite.mockWordDefinitionPeer.EXPECT().Select(mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).
 					Return([]*dbModels.WordDefinition{sampleWordDefinition(1, 1)}, nil).Times(1)
+				suite.mockQuestionOptionPeer.EXPECT().Select(mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+					Return([]*dbModels.QuestionOption{sampleQuestionOption(1, 1)}, nil).Times(1)
 				suite.mockQuestionAnswerLogPeer.EXPECT().Select(mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).
 					Return([]*dbModels.QuestionAnswerLog{sampleQuestionAnswerLog(1, 1)}, nil).Times(1)
 				suite.mockWordPracticeLogPeer.EXPECT().Select(mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).
 					Return([]*dbModels.WordPracticeLog{sampleWordPracticeLog(1, 1)}, nil).Times(1)
+				suite.mockEntityLinkPeer.EXPECT().Select(mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+					Return([]*dbModels.EntityLink{sampleEntityLink(1)}, nil).Times(1)
+				suite.mockSettingPeer.EXPECT().Select(mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+					Return([]*dbModels.Setting{sampleSetting(1)}, nil).Times(1)
+				suite.mockReminderPeer.EXPECT().Select(mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+					Return([]*dbModels.Reminder{sampleReminder(1)}, nil).Times(1)
+				suite.mockReminderLogPeer.EXPECT().Select(mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+					Return([]*dbModels.ReminderLog{sampleReminderLog(1)}, nil).Times(1)
+				suite.mockAudioFilePeer.EXPECT().Select(mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+					Return([]*dbModels.AudioFile{sampleAudioFile(1)}, nil).Times(1)
 			},
 		},
 		{
@@ -86,6 +100,22 @@ func (suite *ControllerTestSuite) TestBuildExport() {
 			},
 			wantErr: true,
 		},
+		{
+			name: "question option peer failure",
+			setupMocks: func() {
+				suite.mockWordPeer.EXPECT().Select(mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+					Return([]*dbModels.Word{}, nil).Times(1)
+				suite.mockQuestionPeer.EXPECT().Select(mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+					Return([]*dbModels.Question{}, nil).Times(1)
+				suite.mockNotePeer.EXPECT().Select(mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+					Return([]*dbModels.Note{}, nil).Times(1)
+				suite.mockWordDefinitionPeer.EXPECT().Select(mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+					Return([]*dbModels.WordDefinition{}, nil).Times(1)
+				suite.mockQuestionOptionPeer.EXPECT().Select(mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+					Return(nil, fetchErr).Times(1)
+			},
+			wantErr: true,
+		},
 		{
 			name: "question answer log peer failure",
 			setupMocks: func() {
@@ -97,6 +127,8 @@ func (suite *ControllerTestSuite) TestBuildExport() {
 					Return([]*dbModels.Note{}, nil).Times(1)
 				suite.mockWordDefinitionPeer.EXPECT().Select(mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).
 					Return([]*dbModels.WordDefinition{}, nil).Times(1)
+				suite.mockQuestionOptionPeer.EXPECT().Select(mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+					Return([]*dbModels.QuestionOption{}, nil).Times(1)
 				suite.mockQuestionAnswerLogPeer.EXPECT().Select(mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).
 					Return(nil, fetchErr).Times(1)
 			},
@@ -113,9 +145,113 @@ func (suite *ControllerTestSuite) TestBuildExport() {
 					Return([]*dbModels.Note{}, nil).Times(1)
 				suite.mockWordDefinitionPeer.EXPECT().Select(mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).
 					Return([]*dbModels.WordDefinition{}, nil).Times(1)
+				suite.mockQuestionOptionPeer.EXPECT().Select(mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+					Return([]*dbModels.QuestionOption{}, nil).Times(1)
+				suite.mockQuestionAnswerLogPeer.EXPECT().Select(mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+					Return([]*dbModels.QuestionAnswerLog{}, nil).Times(1)
+				suite.mockWordPracticeLogPeer.EXPECT().Select(mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+					Return(nil, fetchErr).Times(1)
+			},
+			wantErr: true,
+		},
+		{
+			name: "entity link peer failure",
+			setupMocks: func() {
+				suite.mockWordPeer.EXPECT().Select(mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+					Return([]*dbModels.Word{}, nil).Times(1)
+				suite.mockQuestionPeer.EXPECT().Select(mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+					Return([]*dbModels.Question{}, nil).Times(1)
+				suite.mockNotePeer.EXPECT().Select(mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+					Return([]*dbModels.Note{}, nil).Times(1)
+				suite.mockWordDefinitionPeer.EXPECT().Select(mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+					Return([]*dbModels.WordDefinition{}, nil).Times(1)
+				suite.mockQuestionOptionPeer.EXPECT().Select(mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+					Return([]*dbModels.QuestionOption{}, nil).Times(1)
+				suite.mockQuestionAnswerLogPeer.EXPECT().Select(mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+					Return([]*dbModels.QuestionAnswerLog{}, nil).Times(1)
+				suite.mockWordPracticeLogPeer.EXPECT().Select(mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+					Return([]*dbModels.WordPracticeLog{}, nil).Times(1)
+				suite.mockEntityLinkPeer.EXPECT().Select(mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+					Return(nil, fetchErr).Times(1)
+			},
+			wantErr: true,
+		},
+		{
+			name: "setting peer failure",
+			setupMocks: func() {
+				suite.mockWordPeer.EXPECT().Select(mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+					Return([]*dbModels.Word{}, nil).Times(1)
+				suite.mockQuestionPeer.EXPECT().Select(mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+					Return([]*dbModels.Question{}, nil).Times(1)
+				suite.mockNotePeer.EXPECT().Select(mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+					Return([]*dbModels.Note{}, nil).Times(1)
+				suite.mockWordDefinitionPeer.EXPECT().Select(mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+					Return([]*dbModels.WordDefinition{}, nil).Times(1)
+				suite.mockQuestionOptionPeer.EXPECT().Select(mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+					Return([]*dbModels.QuestionOption{}, nil).Times(1)
 				suite.mockQuestionAnswerLogPeer.EXPECT().Select(mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).
 					Return([]*dbModels.QuestionAnswerLog{}, nil).Times(1)
 				suite.mockWordPracticeLogPeer.EXPECT().Select(mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+					Return([]*dbModels.WordPracticeLog{}, nil).Times(1)
+				suite.mockEntityLinkPeer.EXPECT().Select(mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+					Return([]*dbModels.EntityLink{}, nil).Times(1)
+				suite.mockSettingPeer.EXPECT().Select(mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+					Return(nil, fetchErr).Times(1)
+			},
+			wantErr: true,
+		},
+		{
+			name: "reminder peer failure",
+			setupMocks: func() {
+				suite.mockWordPeer.EXPECT().Select(mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+					Return([]*dbModels.Word{}, nil).Times(1)
+				suite.mockQuestionPeer.EXPECT().Select(mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+					Return([]*dbModels.Question{}, nil).Times(1)
+				suite.mockNotePeer.EXPECT().Select(mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+					Return([]*dbModels.Note{}, nil).Times(1)
+				suite.mockWordDefinitionPeer.EXPECT().Select(mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+					Return([]*dbModels.WordDefinition{}, nil).Times(1)
+				suite.mockQuestionOptionPeer.EXPECT().Select(mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+					Return([]*dbModels.QuestionOption{}, nil).Times(1)
+				suite.mockQuestionAnswerLogPeer.EXPECT().Select(mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+					Return([]*dbModels.QuestionAnswerLog{}, nil).Times(1)
+				suite.mockWordPracticeLogPeer.EXPECT().Select(mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+					Return([]*dbModels.WordPracticeLog{}, nil).Times(1)
+				suite.mockEntityLinkPeer.EXPECT().Select(mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+					Return([]*dbModels.EntityLink{}, nil).Times(1)
+				suite.mockSettingPeer.EXPECT().Select(mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+					Return([]*dbModels.Setting{}, nil).Times(1)
+				suite.mockReminderPeer.EXPECT().Select(mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+					Return(nil, fetchErr).Times(1)
+			},
+			wantErr: true,
+		},
+		{
+			name: "audio file peer failure",
+			setupMocks: func() {
+				suite.mockWordPeer.EXPECT().Select(mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+					Return([]*dbModels.Word{}, nil).Times(1)
+				suite.mockQuestionPeer.EXPECT().Select(mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+					Return([]*dbModels.Question{}, nil).Times(1)
+				suite.mockNotePeer.EXPECT().Select(mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+					Return([]*dbModels.Note{}, nil).Times(1)
+				suite.mockWordDefinitionPeer.EXPECT().Select(mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+					Return([]*dbModels.WordDefinition{}, nil).Times(1)
+				suite.mockQuestionOptionPeer.EXPECT().Select(mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+					Return([]*dbModels.QuestionOption{}, nil).Times(1)
+				suite.mockQuestionAnswerLogPeer.EXPECT().Select(mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+					Return([]*dbModels.QuestionAnswerLog{}, nil).Times(1)
+				suite.mockWordPracticeLogPeer.EXPECT().Select(mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+					Return([]*dbModels.WordPracticeLog{}, nil).Times(1)
+				suite.mockEntityLinkPeer.EXPECT().Select(mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+					Return([]*dbModels.EntityLink{}, nil).Times(1)
+				suite.mockSettingPeer.EXPECT().Select(mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+					Return([]*dbModels.Setting{}, nil).Times(1)
+				suite.mockReminderPeer.EXPECT().Select(mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+					Return([]*dbModels.Reminder{}, nil).Times(1)
+				suite.mockReminderLogPeer.EXPECT().Select(mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+					Return([]*dbModels.ReminderLog{}, nil).Times(1)
+				suite.mockAudioFilePeer.EXPECT().Select(mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).
 					Return(nil, fetchErr).Times(1)
 			},
 			wantErr: true,
@@ -125,6 +261,9 @@ func (suite *ControllerTestSuite) TestBuildExport() {
 	for _, tt := range tests {
 		suite.Run(tt.name, func() {
 			suite.SetupTest()
+			audioDir := suite.T().TempDir()
+			suite.T().Setenv("AUDIO_DIR", audioDir)
+			suite.Require().NoError(os.WriteFile(filepath.Join(audioDir, "2f1c7a8e.mp3"), sampleAudioData, 0o644))
 			tt.setupMocks()
 
 			export, err := suite.controller.BuildExport()
@@ -139,10 +278,17 @@ func (suite *ControllerTestSuite) TestBuildExport() {
 			suite.Require().NotNil(export)
 			suite.Len(export.Words, 1)
 			suite.Len(export.Questions, 1)
+			suite.Len(export.QuestionOptions, 1)
 			suite.Len(export.Notes, 1)
 			suite.Len(export.WordDefinitions, 1)
 			suite.Len(export.QuestionAnswerLogs, 1)
 			suite.Len(export.WordPracticeLogs, 1)
+			suite.Len(export.EntityLinks, 1)
+			suite.Len(export.Settings, 1)
+			suite.Len(export.Reminders, 1)
+			suite.Len(export.ReminderLogs, 1)
+			suite.Require().Len(export.AudioFiles, 1)
+			suite.Equal(sampleAudioData, export.AudioFiles[0].Data)
 		})
 	}
 }
@@ -167,10 +313,22 @@ func (suite *ControllerTestSuite) TestExportData() {
 					Return([]*dbModels.Note{}, nil).Times(1)
 				suite.mockWordDefinitionPeer.EXPECT().Select(mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).
 					Return([]*dbModels.WordDefinition{}, nil).Times(1)
+				suite.mockQuestionOptionPeer.EXPECT().Select(mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+					Return([]*dbModels.QuestionOption{}, nil).Times(1)
 				suite.mockQuestionAnswerLogPeer.EXPECT().Select(mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).
 					Return([]*dbModels.QuestionAnswerLog{}, nil).Times(1)
 				suite.mockWordPracticeLogPeer.EXPECT().Select(mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).
 					Return([]*dbModels.WordPracticeLog{}, nil).Times(1)
+				suite.mockEntityLinkPeer.EXPECT().Select(mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+					Return([]*dbModels.EntityLink{}, nil).Times(1)
+				suite.mockSettingPeer.EXPECT().Select(mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+					Return([]*dbModels.Setting{}, nil).Times(1)
+				suite.mockReminderPeer.EXPECT().Select(mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+					Return([]*dbModels.Reminder{}, nil).Times(1)
+				suite.mockReminderLogPeer.EXPECT().Select(mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+					Return([]*dbModels.ReminderLog{}, nil).Times(1)
+				suite.mockAudioFilePeer.EXPECT().Select(mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+					Return([]*dbModels.AudioFile{}, nil).Times(1)
 			},
 			wantStatus: http.StatusOK,
 		},
@@ -3,15 +3,18 @@ package backup
 import (
 	"net/http"
 
+	dbModels "word-flashcard/data/models"
 	"word-flashcard/data/peers"
 	"word-flashcard/internal/controllers/common"
 	"word-flashcard/internal/models"
+	"word-flashcard/utils"
 
 	"github.com/gin-gonic/gin"
 )
 
 // ImportData @Summary Restore the entire database from an export
-// @Description Wipes every table and rewrites it from the uploaded snapshot, preserving each row's original id/created_at/updated_at. Destructive: all existing data is permanently replaced.
+// @Description Wipes every table and rewrites it from the uploaded snapshot, preserving each row's original id/created_at/updated_at.
+// @Description Bundled pronunciation audio is written back to AUDIO_DIR first. Destructive: all existing data is permanently replaced.
 // @Tags data
 // @Accept json
 // @Produce json
@@ -43,28 +46,72 @@ func (bc *Controller) ImportData(c *gin.Context) {
 		return
 	}
 
-	// ================ 3. Restore every table inside a single transaction ================
+	// ================ 3. Write bundled audio files to disk ================
+	// Files are named after the hash of their source URL, so any left behind
+	// by a failed restore are simply reused by the next one.
+	audioFiles, err := bc.audioStore.Unbundle(export.AudioFiles)
+	if err != nil {
+		common.ResponseError(http.StatusInternalServerError, "Failed to restore audio files", models.ErrCodeInternalError, err, c)
+		return
+	}
+
+	// ================ 4. Restore every table inside a single transaction ================
+	defaultLeechFlags(export.Words, export.Questions)
 	payload := &peers.RestorePayload{
 		Words:              export.Words,
 		WordDefinitions:    export.WordDefinitions,
 		Questions:          export.Questions,
+		QuestionOptions:    export.QuestionOptions,
 		QuestionAnswerLogs: export.QuestionAnswerLogs,
 		WordPracticeLogs:   export.WordPracticeLogs,
 		Notes:              export.Notes,
+		EntityLinks:        export.EntityLinks,
+		Settings:           export.Settings,
+		Reminders:          export.Reminders,
+		ReminderLogs:       export.ReminderLogs,
+		AudioFiles:         audioFiles,
 	}
 	if err := bc.backupPeer.RestoreAll(payload); err != nil {
 		common.ResponseError(http.StatusInternalServerError, "Failed to restore data into database", models.ErrCodeInternalError, err, c)
 		return
 	}
 
-	// ================ 4. Send response ================
+	// ================ 5. Send response ================
 	summary := models.ImportSummary{
 		Words:              len(export.Words),
 		WordDefinitions:    len(export.WordDefinitions),
 		Questions:          len(export.Questions),
+		QuestionOptions:    len(export.QuestionOptions),
 		QuestionAnswerLogs: len(export.QuestionAnswerLogs),
 		WordPracticeLogs:   len(export.WordPracticeLogs),
 		Notes:              len(export.Notes),
+		EntityLinks:        len(export.EntityLinks),
+		Settings:           len(export.Settings),
+		Reminders:          len(export.Reminders),
+		ReminderLogs:       len(export.ReminderLogs),
+		AudioFiles:         len(export.AudioFiles),
 	}
 	common.ResponseSuccess(http.StatusOK, summary, c)
 }
+
+// defaultLeechFlags sets is_leech and is_suspended to false on rows from an
+// export made before leech detection existed, as restoring them as NULL
+// would violate the columns' NOT NULL constraint
+func defaultLeechFlags(words []*dbModels.Word, questions []*dbModels.Question) {
+	for _, word := range words {
+		if word.IsLeech == nil {
+			word.IsLeech = utils.BoolPtr(false)
+		}
+		if word.IsSuspended == nil {
+			word.IsSuspended = utils.BoolPtr(false)
+		}
+	}
+	for _, question := range questions {
+		if question.IsLeech == nil {
+			question.IsLeech = utils.BoolPtr(false)
+		}
+		if question.IsSuspended == nil {
+			question.IsSuspended = utils.BoolPtr(false)
+		}
+	}
+}
@@ -7,8 +7,11 @@ import (
 	"io"
 	"net/http"
 	"net/http/httptest"
+	"os"
+	"path/filepath"
 
 	dbModels "word-flashcard/data/models"
+	"word-flashcard/data/peers"
 	"word-flashcard/internal/models"
 
 	"github.com/gin-gonic/gin"
@@ -21,10 +24,16 @@ func validImportBody(suite *ControllerTestSuite) []byte {
 	export := models.DataExport{
 		Words:              []*dbModels.Word{sampleWord(1)},
 		Questions:          []*dbModels.Question{sampleQuestion(1)},
+		QuestionOptions:    []*dbModels.QuestionOption{sampleQuestionOption(1, 1)},
 		Notes:              []*dbModels.Note{sampleNote(1)},
 		WordDefinitions:    []*dbModels.WordDefinition{sampleWordDefinition(1, 1)},
 		QuestionAnswerLogs: []*dbModels.QuestionAnswerLog{sampleQuestionAnswerLog(1, 1)},
 		WordPracticeLogs:   []*dbModels.WordPracticeLog{sampleWordPracticeLog(1, 1)},
+		EntityLinks:        []*dbModels.EntityLink{sampleEntityLink(1)},
+		Settings:           []*dbModels.Setting{sampleSetting(1)},
+		Reminders:          []*dbModels.Reminder{sampleReminder(1)},
+		ReminderLogs:       []*dbModels.ReminderLog{sampleReminderLog(1)},
+		AudioFiles:         []*models.AudioFileBundle{{AudioFile: *sampleAudioFile(1), Data: sampleAudioData}},
 	}
 
 	body, err := json.Marshal(export)
@@ -34,8 +43,9 @@ func validImportBody(suite *ControllerTestSuite) []byte {
 
 // TestImportData verifies a well-formed export restores successfully and
 // reports accurate per-table counts, while a missing body, malformed JSON,
-// a row missing a required field, or a restore failure all stop the request
-// with the appropriate status before (or despite) touching the database.
+// a row missing a required field, audio that can't be written or a restore
+// failure all stop the request with the appropriate status before (or
+// despite) touching the database. Bundled audio is written to AUDIO_DIR.
 func (suite *ControllerTestSuite) TestImportData() {
 	restoreErr := errors.New("restore failed")
 
@@ -68,6 +78,28 @@ func (suite *ControllerTestSuite) TestImportData() {
 			}(),
 			wantStatus: http.StatusBadRequest,
 		},
+		{
+			name: "audio data not matching its checksum returns 400",
+			body: func() []byte {
+				export := models.DataExport{
+					AudioFiles: []*models.AudioFileBundle{{AudioFile: *sampleAudioFile(1), Data: []byte("ID3 other mp3 data")}},
+				}
+				body, err := json.Marshal(export)
+				suite.Require().NoError(err)
+				return body
+			}(),
+			wantStatus: http.StatusBadRequest,
+		},
+		{
+			name: "unwritable audio directory returns 500 without restoring",
+			body: validImportBody(suite),
+			setupMocks: func() {
+				blocker := filepath.Join(suite.T().TempDir(), "audio")
+				suite.Require().NoError(os.WriteFile(blocker, nil, 0o644))
+				suite.T().Setenv("AUDIO_DIR", blocker)
+			},
+			wantStatus: http.StatusInternalServerError,
+		},
 		{
 			name: "restore failure returns 500",
 			body: validImportBody(suite),
@@ -80,7 +112,14 @@ func (suite *ControllerTestSuite) TestImportData() {
 			name: "success restores and returns row counts",
 			body: validImportBody(suite),
 			setupMocks: func() {
-				suite.mockBackupPeer.EXPECT().RestoreAll(mock.Anything).Return(nil).Times(1)
+				suite.mockBackupPeer.EXPECT().
+					RestoreAll(mock.MatchedBy(func(payload *peers.RestorePayload) bool {
+						// The sample rows predate leech detection, so their flags default to false
+						isLeechDefaulted := payload.Words[0].IsLeech != nil && !*payload.Words[0].IsLeech
+						isSuspendedDefaulted := payload.Questions[0].IsSuspended != nil && !*payload.Questions[0].IsSuspended
+						return len(payload.AudioFiles) == 1 && *payload.AudioFiles[0].FileName == "2f1c7a8e.mp3" && isLeechDefaulted && isSuspendedDefaulted
+					})).
+					Return(nil).Times(1)
 			},
 			wantStatus: http.StatusOK,
 		},
@@ -89,6 +128,8 @@ func (suite *ControllerTestSuite) TestImportData() {
 	for _, tt := range tests {
 		suite.Run(tt.name, func() {
 			suite.SetupTest()
+			audioDir := suite.T().TempDir()
+			suite.T().Setenv("AUDIO_DIR", audioDir)
 			if tt.setupMocks != nil {
 				tt.setupMocks()
 			}
@@ -110,10 +151,20 @@ func (suite *ControllerTestSuite) TestImportData() {
 				suite.Require().NoError(json.Unmarshal(w.Body.Bytes(), &summary))
 				suite.Equal(1, summary.Words)
 				suite.Equal(1, summary.Questions)
+				suite.Equal(1, summary.QuestionOptions)
 				suite.Equal(1, summary.Notes)
 				suite.Equal(1, summary.WordDefinitions)
 				suite.Equal(1, summary.QuestionAnswerLogs)
 				suite.Equal(1, summary.WordPracticeLogs)
+				suite.Equal(1, summary.EntityLinks)
+				suite.Equal(1, summary.Settings)
+				suite.Equal(1, summary.Reminders)
+				suite.Equal(1, summary.ReminderLogs)
+				suite.Equal(1, summary.AudioFiles)
+
+				data, err := os.ReadFile(filepath.Join(audioDir, "2f1c7a8e.mp3"))
+				suite.Require().NoError(err)
+				suite.Equal(sampleAudioData, data)
 			}
 		})
 	}
@@ -1,7 +1,10 @@
 package backup
 
 import (
+	"crypto/sha256"
+	"encoding/hex"
 	"fmt"
+	"path/filepath"
 
 	dbModels "word-flashcard/data/models"
 	"word-flashcard/internal/controllers/common"
@@ -24,13 +27,31 @@ func validateExport(export *models.DataExport) error {
 	if err := validateQuestions(export.Questions); err != nil {
 		return err
 	}
+	if err := validateQuestionOptions(export.QuestionOptions); err != nil {
+		return err
+	}
 	if err := validateQuestionAnswerLogs(export.QuestionAnswerLogs); err != nil {
 		return err
 	}
 	if err := validateWordPracticeLogs(export.WordPracticeLogs); err != nil {
 		return err
 	}
-	return validateNotes(export.Notes)
+	if err := validateNotes(export.Notes); err != nil {
+		return err
+	}
+	if err := validateEntityLinks(export.EntityLinks); err != nil {
+		return err
+	}
+	if err := validateSettings(export.Settings); err != nil {
+		return err
+	}
+	if err := validateReminders(export.Reminders); err != nil {
+		return err
+	}
+	if err := validateReminderLogs(export.ReminderLogs); err != nil {
+		return err
+	}
+	return validateAudioFiles(export.AudioFiles)
 }
 
 func validateWords(words []*dbModels.Word) error {
@@ -99,6 +120,27 @@ func validateQuestions(questions []*dbModels.Question) error {
 	return nil
 }
 
+func validateQuestionOptions(options []*dbModels.QuestionOption) error {
+	for i, option := range options {
+		if option.Id == nil {
+			return common.NewFieldError(fmt.Sprintf("question_options[%d]: id is required", i))
+		}
+		if option.QuestionId == nil {
+			return common.NewFieldError(fmt.Sprintf("question_options[%d]: question_id is required", i))
+		}
+		if option.Position == nil {
+			return common.NewFieldError(fmt.Sprintf("question_options[%d]: position is required", i))
+		}
+		if option.Content == nil {
+			return common.NewFieldError(fmt.Sprintf("question_options[%d]: content is required", i))
+		}
+		if option.CreatedAt == nil || option.UpdatedAt == nil {
+			return common.NewFieldError(fmt.Sprintf("question_options[%d]: created_at/updated_at are required", i))
+		}
+	}
+	return nil
+}
+
 func validateQuestionAnswerLogs(logs []*dbModels.QuestionAnswerLog) error {
 	for i, log := range logs {
 		if log.Id == nil {
@@ -158,3 +200,99 @@ func validateNotes(notes []*dbModels.Note) error {
 	}
 	return nil
 }
+
+func validateEntityLinks(links []*dbModels.EntityLink) error {
+	for i, link := range links {
+		if link.Id == nil {
+			return common.NewFieldError(fmt.Sprintf("entity_links[%d]: id is required", i))
+		}
+		if link.SourceType == nil || link.SourceId == nil {
+			return common.NewFieldError(fmt.Sprintf("entity_links[%d]: source_type/source_id are required", i))
+		}
+		if link.TargetType == nil || link.TargetId == nil {
+			return common.NewFieldError(fmt.Sprintf("entity_links[%d]: target_type/target_id are required", i))
+		}
+		if link.CreatedAt == nil || link.UpdatedAt == nil {
+			return common.NewFieldError(fmt.Sprintf("entity_links[%d]: created_at/updated_at are required", i))
+		}
+	}
+	return nil
+}
+
+func validateSettings(settings []*dbModels.Setting) error {
+	for i, setting := range settings {
+		if setting.Id == nil {
+			return common.NewFieldError(fmt.Sprintf("settings[%d]: id is required", i))
+		}
+		if setting.Name == nil || setting.Value == nil {
+			return common.NewFieldError(fmt.Sprintf("settings[%d]: name/value are required", i))
+		}
+		if setting.CreatedAt == nil || setting.UpdatedAt == nil {
+			return common.NewFieldError(fmt.Sprintf("settings[%d]: created_at/updated_at are required", i))
+		}
+	}
+	return nil
+}
+
+func validateReminders(reminders []*dbModels.Reminder) error {
+	for i, reminder := range reminders {
+		if reminder.Id == nil {
+			return common.NewFieldError(fmt.Sprintf("reminders[%d]: id is required", i))
+		}
+		if reminder.EntityType == nil || reminder.EntityId == nil {
+			return common.NewFieldError(fmt.Sprintf("reminders[%d]: entity_type/entity_id are required", i))
+		}
+		if reminder.DueAt == nil || reminder.IsOverdue == nil {
+			return common.NewFieldError(fmt.Sprintf("reminders[%d]: due_at/is_overdue are required", i))
+		}
+		if reminder.CreatedAt == nil || reminder.UpdatedAt == nil {
+			return common.NewFieldError(fmt.Sprintf("reminders[%d]: created_at/updated_at are required", i))
+		}
+	}
+	return nil
+}
+
+func validateReminderLogs(logs []*dbModels.ReminderLog) error {
+	for i, log := range logs {
+		if log.Id == nil {
+			return common.NewFieldError(fmt.Sprintf("reminder_logs[%d]: id is required", i))
+		}
+		if log.ReminderId == nil || log.EntityType == nil || log.EntityId == nil {
+			return common.NewFieldError(fmt.Sprintf("reminder_logs[%d]: reminder_id/entity_type/entity_id are required", i))
+		}
+		if log.DueAt == nil || log.Action == nil {
+			return common.NewFieldError(fmt.Sprintf("reminder_logs[%d]: due_at/action are required", i))
+		}
+		if log.CreatedAt == nil || log.UpdatedAt == nil {
+			return common.NewFieldError(fmt.Sprintf("reminder_logs[%d]: created_at/updated_at are required", i))
+		}
+	}
+	return nil
+}
+
+// validateAudioFiles also checks each bundle's data against its size and
+// checksum, and that its file name can't point outside the audio directory.
+func validateAudioFiles(bundles []*models.AudioFileBundle) error {
+	for i, bundle := range bundles {
+		if bundle.Id == nil {
+			return common.NewFieldError(fmt.Sprintf("audio_files[%d]: id is required", i))
+		}
+		if bundle.SourceUrl == nil || bundle.SourceHash == nil {
+			return common.NewFieldError(fmt.Sprintf("audio_files[%d]: source_url/source_hash are required", i))
+		}
+		if bundle.ContentType == nil || bundle.Size == nil || bundle.Checksum == nil {
+			return common.NewFieldError(fmt.Sprintf("audio_files[%d]: content_type/size/checksum are required", i))
+		}
+		if bundle.FileName == nil || *bundle.FileName == "" || *bundle.FileName != filepath.Base(*bundle.FileName) {
+			return common.NewFieldError(fmt.Sprintf("audio_files[%d]: file_name must be a plain file name", i))
+		}
+		if bundle.CreatedAt == nil || bundle.UpdatedAt == nil {
+			return common.NewFieldError(fmt.Sprintf("audio_files[%d]: created_at/updated_at are required", i))
+		}
+		sum := sha256.Sum256(bundle.Data)
+		if len(bundle.Data) != *bundle.Size || hex.EncodeToString(sum[:]) != *bundle.Checksum {
+			return common.NewFieldError(fmt.Sprintf("audio_files[%d]: data does not match size/checksum", i))
+		}
+	}
+	return nil
+}
@@ -41,9 +41,14 @@ func (suite *ValidationTestSuite) TestValidateExport() {
 				Words:              []*dbModels.Word{sampleWord(1)},
 				WordDefinitions:    []*dbModels.WordDefinition{sampleWordDefinition(1, 1)},
 				Questions:          []*dbModels.Question{sampleQuestion(1)},
+				QuestionOptions:    []*dbModels.QuestionOption{sampleQuestionOption(1, 1)},
 				QuestionAnswerLogs: []*dbModels.QuestionAnswerLog{sampleQuestionAnswerLog(1, 1)},
 				WordPracticeLogs:   []*dbModels.WordPracticeLog{sampleWordPracticeLog(1, 1)},
 				Notes:              []*dbModels.Note{sampleNote(1)},
+				EntityLinks:        []*dbModels.EntityLink{sampleEntityLink(1)},
+				Settings:           []*dbModels.Setting{sampleSetting(1)},
+				Reminders:          []*dbModels.Reminder{sampleReminder(1)},
+				ReminderLogs:       []*dbModels.ReminderLog{sampleReminderLog(1)},
 			},
 			wantErr: false,
 		},
@@ -98,7 +103,7 @@ func (suite *ValidationTestSuite) TestValidateExport() {
 			wantErrMsg: "word_practice_logs[0]: familiarity is required",
 		},
 		{
-			name: "invalid notes is reached last, once every other table is valid",
+			name: "invalid notes is reached once every table before it is valid",
 			export: &models.DataExport{
 				Words:              []*dbModels.Word{sampleWord(1)},
 				WordDefinitions:    []*dbModels.WordDefinition{sampleWordDefinition(1, 1)},
@@ -110,6 +115,16 @@ func (suite *ValidationTestSuite) TestValidateExport() {
 			wantErr:    true,
 			wantErrMsg: "notes[0]: sort_order is required",
 		},
+		{
+			name: "invalid entity_links is reached last, once every other table is valid",
+			export: &models.DataExport{
+				Words:       []*dbModels.Word{sampleWord(1)},
+				Notes:       []*dbModels.Note{sampleNote(1)},
+				EntityLinks: []*dbModels.EntityLink{func() *dbModels.EntityLink { l := sampleEntityLink(1); l.Id = nil; return l }()},
+			},
+			wantErr:    true,
+			wantErrMsg: "entity_links[0]: id is required",
+		},
 	}
 
 	for _, tc := range testCases {
@@ -329,6 +344,110 @@ func (suite *ValidationTestSuite) TestValidateQuestions() {
 	}
 }
 
+// TestValidateQuestionOptions tests the validateQuestionOptions function
+func (suite *ValidationTestSuite) TestValidateQuestionOptions() {
+	testCases := []struct {
+		name       string
+		options    []*dbModels.QuestionOption
+		wantErr    bool
+		wantErrMsg string
+	}{
+		{name: "empty slice is valid", options: nil, wantErr: false},
+		{name: "valid option", options: []*dbModels.QuestionOption{sampleQuestionOption(1, 1)}, wantErr: false},
+		{
+			name:       "nil id",
+			options:    []*dbModels.QuestionOption{func() *dbModels.QuestionOption { o := sampleQuestionOption(1, 1); o.Id = nil; return o }()},
+			wantErr:    true,
+			wantErrMsg: "question_options[0]: id is required",
+		},
+		{
+			name:       "nil question_id",
+			options:    []*dbModels.QuestionOption{func() *dbModels.QuestionOption { o := sampleQuestionOption(1, 1); o.QuestionId = nil; return o }()},
+			wantErr:    true,
+			wantErrMsg: "question_options[0]: question_id is required",
+		},
+		{
+			name:       "nil position",
+			options:    []*dbModels.QuestionOption{func() *dbModels.QuestionOption { o := sampleQuestionOption(1, 1); o.Position = nil; return o }()},
+			wantErr:    true,
+			wantErrMsg: "question_options[0]: position is required",
+		},
+		{
+			name:       "nil content",
+			options:    []*dbModels.QuestionOption{func() *dbModels.QuestionOption { o := sampleQuestionOption(1, 1); o.Content = nil; return o }()},
+			wantErr:    true,
+			wantErrMsg: "question_options[0]: content is required",
+		},
+		{
+			name:       "nil created_at",
+			options:    []*dbModels.QuestionOption{func() *dbModels.QuestionOption { o := sampleQuestionOption(1, 1); o.CreatedAt = nil; return o }()},
+			wantErr:    true,
+			wantErrMsg: "question_options[0]: created_at/updated_at are required",
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			err := validateQuestionOptions(tc.options)
+			if tc.wantErr {
+				suite.Error(err)
+				suite.Equal(tc.wantErrMsg, err.Error())
+			} else {
+				suite.NoError(err)
+			}
+		})
+	}
+}
+
+// TestValidateEntityLinks tests the validateEntityLinks function
+func (suite *ValidationTestSuite) TestValidateEntityLinks() {
+	testCases := []struct {
+		name       string
+		links      []*dbModels.EntityLink
+		wantErr    bool
+		wantErrMsg string
+	}{
+		{name: "empty slice is valid", links: nil, wantErr: false},
+		{name: "valid link", links: []*dbModels.EntityLink{sampleEntityLink(1)}, wantErr: false},
+		{
+			name:       "nil id",
+			links:      []*dbModels.EntityLink{func() *dbModels.EntityLink { l := sampleEntityLink(1); l.Id = nil; return l }()},
+			wantErr:    true,
+			wantErrMsg: "entity_links[0]: id is required",
+		},
+		{
+			name:       "nil source_type",
+			links:      []*dbModels.EntityLink{func() *dbModels.EntityLink { l := sampleEntityLink(1); l.SourceType = nil; return l }()},
+			wantErr:    true,
+			wantErrMsg: "entity_links[0]: source_type/source_id are required",
+		},
+		{
+			name:       "nil target_id",
+			links:      []*dbModels.EntityLink{func() *dbModels.EntityLink { l := sampleEntityLink(1); l.TargetId = nil; return l }()},
+			wantErr:    true,
+			wantErrMsg: "entity_links[0]: target_type/target_id are required",
+		},
+		{
+			name:       "nil updated_at",
+			links:      []*dbModels.EntityLink{func() *dbModels.EntityLink { l := sampleEntityLink(1); l.UpdatedAt = nil; return l }()},
+			wantErr:    true,
+			wantErrMsg: "entity_links[0]: created_at/updated_at are required",
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			err := validateEntityLinks(tc.links)
+			if tc.wantErr {
+				suite.Error(err)
+				suite.Equal(tc.wantErrMsg, err.Error())
+			} else {
+				suite.NoError(err)
+			}
+		})
+	}
+}
+
 // TestValidateQuestionAnswerLogs tests the validateQuestionAnswerLogs function
 func (suite *ValidationTestSuite) TestValidateQuestionAnswerLogs() {
 	testCases := []struct {
@@ -505,3 +624,193 @@ func (suite *ValidationTestSuite) TestValidateNotes() {
 		})
 	}
 }
+
+// TestValidateSettings tests the validateSettings function
+func (suite *ValidationTestSuite) TestValidateSettings() {
+	testCases := []struct {
+		name       string
+		settings   []*dbModels.Setting
+		wantErr    bool
+		wantErrMsg string
+	}{
+		{name: "empty slice is valid", settings: nil, wantErr: false},
+		{name: "valid setting", settings: []*dbModels.Setting{sampleSetting(1)}, wantErr: false},
+		{
+			name:       "nil id",
+			settings:   []*dbModels.Setting{func() *dbModels.Setting { st := sampleSetting(1); st.Id = nil; return st }()},
+			wantErr:    true,
+			wantErrMsg: "settings[0]: id is required",
+		},
+		{
+			name:       "nil value",
+			settings:   []*dbModels.Setting{func() *dbModels.Setting { st := sampleSetting(1); st.Value = nil; return st }()},
+			wantErr:    true,
+			wantErrMsg: "settings[0]: name/value are required",
+		},
+		{
+			name:       "nil created_at",
+			settings:   []*dbModels.Setting{func() *dbModels.Setting { st := sampleSetting(1); st.CreatedAt = nil; return st }()},
+			wantErr:    true,
+			wantErrMsg: "settings[0]: created_at/updated_at are required",
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			err := validateSettings(tc.settings)
+			if tc.wantErr {
+				suite.Error(err)
+				suite.Equal(tc.wantErrMsg, err.Error())
+			} else {
+				suite.NoError(err)
+			}
+		})
+	}
+}
+
+// TestValidateReminders tests the validateReminders function
+func (suite *ValidationTestSuite) TestValidateReminders() {
+	testCases := []struct {
+		name       string
+		reminders  []*dbModels.Reminder
+		wantErr    bool
+		wantErrMsg string
+	}{
+		{name: "empty slice is valid", reminders: nil, wantErr: false},
+		{name: "valid reminder", reminders: []*dbModels.Reminder{sampleReminder(1)}, wantErr: false},
+		{
+			name:       "nil entity_id",
+			reminders:  []*dbModels.Reminder{func() *dbModels.Reminder { r := sampleReminder(1); r.EntityId = nil; return r }()},
+			wantErr:    true,
+			wantErrMsg: "reminders[0]: entity_type/entity_id are required",
+		},
+		{
+			name:       "nil is_overdue",
+			reminders:  []*dbModels.Reminder{func() *dbModels.Reminder { r := sampleReminder(1); r.IsOverdue = nil; return r }()},
+			wantErr:    true,
+			wantErrMsg: "reminders[0]: due_at/is_overdue are required",
+		},
+		{
+			name:       "nil updated_at",
+			reminders:  []*dbModels.Reminder{func() *dbModels.Reminder { r := sampleReminder(1); r.UpdatedAt = nil; return r }()},
+			wantErr:    true,
+			wantErrMsg: "reminders[0]: created_at/updated_at are required",
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			err := validateReminders(tc.reminders)
+			if tc.wantErr {
+				suite.Error(err)
+				suite.Equal(tc.wantErrMsg, err.Error())
+			} else {
+				suite.NoError(err)
+			}
+		})
+	}
+}
+
+// TestValidateReminderLogs tests the validateReminderLogs function
+func (suite *ValidationTestSuite) TestValidateReminderLogs() {
+	testCases := []struct {
+		name       string
+		logs       []*dbModels.ReminderLog
+		wantErr    bool
+		wantErrMsg string
+	}{
+		{name: "empty slice is valid", logs: nil, wantErr: false},
+		{name: "valid reminder log", logs: []*dbModels.ReminderLog{sampleReminderLog(1)}, wantErr: false},
+		{
+			name:       "nil id",
+			logs:       []*dbModels.ReminderLog{func() *dbModels.ReminderLog { l := sampleReminderLog(1); l.Id = nil; return l }()},
+			wantErr:    true,
+			wantErrMsg: "reminder_logs[0]: id is required",
+		},
+		{
+			name:       "nil action",
+			logs:       []*dbModels.ReminderLog{func() *dbModels.ReminderLog { l := sampleReminderLog(1); l.Action = nil; return l }()},
+			wantErr:    true,
+			wantErrMsg: "reminder_logs[0]: due_at/action are required",
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			err := validateReminderLogs(tc.logs)
+			if tc.wantErr {
+				suite.Error(err)
+				suite.Equal(tc.wantErrMsg, err.Error())
+			} else {
+				suite.NoError(err)
+			}
+		})
+	}
+}
+
+// TestValidateAudioFiles tests the validateAudioFiles function
+func (suite *ValidationTestSuite) TestValidateAudioFiles() {
+	bundle := func(modify func(b *models.AudioFileBundle)) []*models.AudioFileBundle {
+		b := &models.AudioFileBundle{AudioFile: *sampleAudioFile(1), Data: sampleAudioData}
+		modify(b)
+		return []*models.AudioFileBundle{b}
+	}
+
+	testCases := []struct {
+		name       string
+		bundles    []*models.AudioFileBundle
+		wantErr    bool
+		wantErrMsg string
+	}{
+		{name: "empty slice is valid", bundles: nil, wantErr: false},
+		{name: "valid bundle", bundles: bundle(func(b *models.AudioFileBundle) {}), wantErr: false},
+		{
+			name:       "nil id",
+			bundles:    bundle(func(b *models.AudioFileBundle) { b.Id = nil }),
+			wantErr:    true,
+			wantErrMsg: "audio_files[0]: id is required",
+		},
+		{
+			name:       "nil source_hash",
+			bundles:    bundle(func(b *models.AudioFileBundle) { b.SourceHash = nil }),
+			wantErr:    true,
+			wantErrMsg: "audio_files[0]: source_url/source_hash are required",
+		},
+		{
+			name:       "nil checksum",
+			bundles:    bundle(func(b *models.AudioFileBundle) { b.Checksum = nil }),
+			wantErr:    true,
+			wantErrMsg: "audio_files[0]: content_type/size/checksum are required",
+		},
+		{
+			name:       "file name escaping the audio directory",
+			bundles:    bundle(func(b *models.AudioFileBundle) { name := "../word-flashcard.db"; b.FileName = &name }),
+			wantErr:    true,
+			wantErrMsg: "audio_files[0]: file_name must be a plain file name",
+		},
+		{
+			name:       "nil created_at",
+			bundles:    bundle(func(b *models.AudioFileBundle) { b.CreatedAt = nil }),
+			wantErr:    true,
+			wantErrMsg: "audio_files[0]: created_at/updated_at are required",
+		},
+		{
+			name:       "data not matching checksum",
+			bundles:    bundle(func(b *models.AudioFileBundle) { b.Data = []byte("ID3 fake mp3 date") }),
+			wantErr:    true,
+			wantErrMsg: "audio_files[0]: data does not match size/checksum",
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			err := validateAudioFiles(tc.bundles)
+			if tc.wantErr {
+				suite.Error(err)
+				suite.Equal(tc.wantErrMsg, err.Error())
+			} else {
+				suite.NoError(err)
+			}
+		})
+	}
+}
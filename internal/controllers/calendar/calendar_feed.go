@@ -0,0 +1,90 @@
+package calendar
+
+import (
+	"crypto/subtle"
+	"net/http"
+	"time"
+	"word-flashcard/internal/controllers/common"
+	"word-flashcard/internal/models"
+	"word-flashcard/utils/config"
+
+	"github.com/gin-gonic/gin"
+)
+
+const (
+	// defaultFeedDays is how many days of reviews the feed covers when days isn't given
+	defaultFeedDays = 30
+
+	// maxFeedDays caps the days query parameter, matching the review forecast's
+	maxFeedDays = 365
+)
+
+// GetCalendarFeed @Summary Subscribe to the calendar feed
+// @Description An iCalendar (RFC 5545) feed for calendar apps to subscribe to. Each reminder is an event at its due time with an alert,
+// @Description recurring daily or weekly like the reminder; each day with reviews due is an all-day event counting the words and
+// @Description questions due, as forecast by GET /api/progress/forecast; and the automatic backup is an event at its next run recurring
+// @Description every BACKUP_INTERVAL_HOURS, left out when BACKUP_ENABLED=false. Times are given in the reporting timezone, defined in a
+// @Description VTIMEZONE block. The feed is only served when CALENDAR_TOKEN is set, and only to requests whose token matches it; any
+// @Description other request gets 404.
+// @Tags calendar
+// @Produce text/calendar
+// @Param token query string true "The configured CALENDAR_TOKEN"
+// @Param days query int false "Number of days of reviews to publish, today included (default: 30, max: 365)"
+// @Param tz query string false "IANA timezone of the published times and days (default: X-Timezone header, else the stored report timezone, else REPORT_TIMEZONE)"
+// @Param X-Timezone header string false "IANA timezone of the published times and days when tz is not given"
+// @Success 200 {string} string "iCalendar feed"
+// @Failure 400 {object} models.ErrorResponse "Bad request - Invalid days or timezone parameter"
+// @Failure 404 {object} models.ErrorResponse "Not found - Feed not enabled or wrong token"
+// @Failure 500 {object} models.ErrorResponse "Internal server error - Failed to fetch data from database"
+// @Router /api/calendar.ics [get]
+func (cc *Controller) GetCalendarFeed(c *gin.Context) {
+	// ================ 1. Check the token ================
+	// A missing feed and a wrong token look the same, so the feed's URL
+	// can't be guessed one token at a time
+	token := config.GetOrDefault("CALENDAR_TOKEN", "")
+	if token == "" || subtle.ConstantTimeCompare([]byte(c.Query("token")), []byte(token)) != 1 {
+		common.ResponseError(http.StatusNotFound, "Calendar feed not found", models.ErrCodeNotFound, nil, c)
+		return
+	}
+
+	// ================ 2. Parse query parameters ================
+	days, err := common.ParseIntQueryParam(c, "days", defaultFeedDays)
+	if err != nil || days < 1 || days > maxFeedDays {
+		common.ResponseError(http.StatusBadRequest, "Invalid days parameter", models.ErrCodeInvalidRequest, err, c)
+		return
+	}
+	loc, err := common.RequestReportLocation(c)
+	if err != nil {
+		common.ResponseError(http.StatusBadRequest, "Invalid timezone parameter", models.ErrCodeInvalidRequest, err, c)
+		return
+	}
+
+	now := time.Now().In(loc)
+	start := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, loc)
+
+	// ================ 3. Gather the feed ================
+	reminders, err := cc.reminders.Reminders()
+	if err != nil {
+		common.ResponseError(http.StatusInternalServerError, "Failed to fetch data from database", models.ErrCodeInternalError, err, c)
+		return
+	}
+	forecast, err := cc.forecaster.Forecast(start, days)
+	if err != nil {
+		common.ResponseError(http.StatusInternalServerError, "Failed to fetch data from database", models.ErrCodeInternalError, err, c)
+		return
+	}
+	backups, err := cc.backups.BackupSchedule(now)
+	if err != nil {
+		common.ResponseError(http.StatusInternalServerError, "Failed to read the backup directory", models.ErrCodeInternalError, err, c)
+		return
+	}
+
+	// ================ 4. Send the calendar ================
+	// JSONMiddleware has already set a JSON Content-Type, which c.Data
+	// would otherwise keep
+	body := buildCalendar(feed{reminders: reminders, forecast: forecast, backups: backups}, loc, now)
+	c.Header("Content-Type", "text/calendar; charset=utf-8")
+	c.Header("Content-Disposition", `inline; filename="word-flashcard.ics"`)
+	c.Header("Cache-Control", "no-store")
+	c.Data(http.StatusOK, "text/calendar; charset=utf-8", []byte(body))
+}
@@ -0,0 +1,111 @@
+package calendar
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+)
+
+// getFeed calls GetCalendarFeed with query
+func (suite *ControllerTestSuite) getFeed(query string) *httptest.ResponseRecorder {
+	w := httptest.NewRecorder()
+	ctx, _ := gin.CreateTestContext(w)
+	ctx.Request = httptest.NewRequest(http.MethodGet, "/api/calendar.ics"+query, nil)
+	ctx.Header("Content-Type", "application/json")
+	suite.controller.GetCalendarFeed(ctx)
+	return w
+}
+
+// TestGetCalendarFeed tests that the feed is served as iCalendar, in the
+// requested timezone and over the requested days
+func (suite *ControllerTestSuite) TestGetCalendarFeed() {
+	suite.T().Setenv("CALENDAR_TOKEN", "s3cret")
+	f := getSampleFeed()
+	suite.reminders.reminders = f.reminders
+	suite.forecaster.forecast = f.forecast
+	suite.backups.schedule = f.backups
+
+	w := suite.getFeed("?token=s3cret&days=7&tz=Europe/Berlin")
+
+	assert.Equal(suite.T(), http.StatusOK, w.Code)
+	assert.Equal(suite.T(), "text/calendar; charset=utf-8", w.Header().Get("Content-Type"))
+	assert.True(suite.T(), strings.HasPrefix(w.Body.String(), "BEGIN:VCALENDAR\r\n"))
+	assert.Contains(suite.T(), w.Body.String(), "TZID:Europe/Berlin\r\n")
+	assert.Contains(suite.T(), w.Body.String(), "UID:reminder-1@word-flashcard\r\n")
+	assert.Equal(suite.T(), 7, suite.forecaster.days)
+	assert.Equal(suite.T(), "Europe/Berlin", suite.forecaster.start.Location().String())
+	assert.Zero(suite.T(), suite.forecaster.start.Hour())
+}
+
+// TestGetCalendarFeedToken tests that the feed is hidden unless a token is
+// configured and given
+func (suite *ControllerTestSuite) TestGetCalendarFeedToken() {
+	testCases := []struct {
+		name  string
+		token string
+		query string
+	}{
+		{name: "feed not enabled", token: "", query: "?token="},
+		{name: "missing token", token: "s3cret", query: ""},
+		{name: "wrong token", token: "s3cret", query: "?token=guess"},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.T().Setenv("CALENDAR_TOKEN", tc.token)
+
+			w := suite.getFeed(tc.query)
+
+			suite.Equal(http.StatusNotFound, w.Code)
+			suite.Contains(w.Body.String(), "Calendar feed not found")
+		})
+	}
+}
+
+// TestGetCalendarFeedInvalidParameters tests that invalid days or timezone return 400
+func (suite *ControllerTestSuite) TestGetCalendarFeedInvalidParameters() {
+	suite.T().Setenv("CALENDAR_TOKEN", "s3cret")
+
+	for _, query := range []string{"&days=0", "&days=366", "&days=many", "&tz=Mars/Olympus"} {
+		w := suite.getFeed("?token=s3cret" + query)
+
+		suite.Equal(http.StatusBadRequest, w.Code, query)
+	}
+}
+
+// TestGetCalendarFeedSourceErrors tests that a failure in any source returns 500
+func (suite *ControllerTestSuite) TestGetCalendarFeedSourceErrors() {
+	suite.T().Setenv("CALENDAR_TOKEN", "s3cret")
+	failures := map[string]func(){
+		"reminders": func() { suite.reminders.err = errors.New("select failed") },
+		"forecast":  func() { suite.forecaster.err = errors.New("select failed") },
+		"backups":   func() { suite.backups.err = errors.New("read failed") },
+	}
+
+	for name, fail := range failures {
+		suite.Run(name, func() {
+			suite.SetupTest()
+			fail()
+
+			w := suite.getFeed("?token=s3cret")
+
+			suite.Equal(http.StatusInternalServerError, w.Code)
+		})
+	}
+}
+
+// TestGetCalendarFeedDefaultDays tests the default number of days
+func (suite *ControllerTestSuite) TestGetCalendarFeedDefaultDays() {
+	suite.T().Setenv("CALENDAR_TOKEN", "s3cret")
+
+	w := suite.getFeed("?token=s3cret")
+
+	suite.Equal(http.StatusOK, w.Code)
+	suite.Equal(defaultFeedDays, suite.forecaster.days)
+	suite.WithinDuration(time.Now(), suite.forecaster.start, 24*time.Hour)
+}
@@ -0,0 +1,42 @@
+package calendar
+
+import (
+	"time"
+	"word-flashcard/internal/models"
+)
+
+// ReminderSource lists the reminders the feed publishes; implemented by the
+// reminder controller
+type ReminderSource interface {
+	Reminders() ([]*models.Reminder, error)
+}
+
+// ReviewForecaster forecasts the reviews coming due each day; implemented by
+// the progress controller
+type ReviewForecaster interface {
+	Forecast(start time.Time, days int) (models.ReviewForecast, error)
+}
+
+// BackupScheduler reports when the next automatic backup is written;
+// implemented by the backup controller
+type BackupScheduler interface {
+	BackupSchedule(now time.Time) (models.BackupSchedule, error)
+}
+
+// Controller serves the calendar feed. It holds no peers of its own: the
+// reminders, review forecast and backup schedule it publishes come from the
+// controllers that own them.
+type Controller struct {
+	reminders  ReminderSource
+	forecaster ReviewForecaster
+	backups    BackupScheduler
+}
+
+// New creates a new Controller instance
+func New(reminders ReminderSource, forecaster ReviewForecaster, backups BackupScheduler) *Controller {
+	return &Controller{
+		reminders:  reminders,
+		forecaster: forecaster,
+		backups:    backups,
+	}
+}
@@ -0,0 +1,65 @@
+package calendar
+
+import (
+	"testing"
+	"time"
+	"word-flashcard/internal/models"
+
+	"github.com/stretchr/testify/suite"
+)
+
+// stubReminders is a ReminderSource returning fixed reminders or an error
+type stubReminders struct {
+	reminders []*models.Reminder
+	err       error
+}
+
+func (s *stubReminders) Reminders() ([]*models.Reminder, error) {
+	return s.reminders, s.err
+}
+
+// stubForecaster is a ReviewForecaster returning a fixed forecast or an
+// error, recording the days it was asked for
+type stubForecaster struct {
+	forecast models.ReviewForecast
+	err      error
+	start    time.Time
+	days     int
+}
+
+func (s *stubForecaster) Forecast(start time.Time, days int) (models.ReviewForecast, error) {
+	s.start, s.days = start, days
+	return s.forecast, s.err
+}
+
+// stubBackups is a BackupScheduler returning a fixed schedule or an error
+type stubBackups struct {
+	schedule models.BackupSchedule
+	err      error
+}
+
+func (s *stubBackups) BackupSchedule(now time.Time) (models.BackupSchedule, error) {
+	return s.schedule, s.err
+}
+
+// ControllerTestSuite is a test suite for the calendar Controller
+type ControllerTestSuite struct {
+	suite.Suite
+	controller *Controller
+	reminders  *stubReminders
+	forecaster *stubForecaster
+	backups    *stubBackups
+}
+
+// TestControllerTestSuite runs the ControllerTestSuite
+func TestControllerTestSuite(t *testing.T) {
+	suite.Run(t, new(ControllerTestSuite))
+}
+
+// SetupTest sets up the test environment before each test
+func (suite *ControllerTestSuite) SetupTest() {
+	suite.reminders = &stubReminders{}
+	suite.forecaster = &stubForecaster{}
+	suite.backups = &stubBackups{}
+	suite.controller = New(suite.reminders, suite.forecaster, suite.backups)
+}
@@ -0,0 +1,213 @@
+package calendar
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+	"word-flashcard/data/schema"
+	"word-flashcard/internal/models"
+	"word-flashcard/utils"
+)
+
+const (
+	// productID identifies this application as the calendar's creator
+	productID = "-//word-flashcard//Calendar Feed//EN"
+
+	// uidDomain makes event UIDs globally unique, as RFC 5545 asks
+	uidDomain = "word-flashcard"
+
+	// refreshInterval is how often subscribed calendars are asked to fetch
+	// the feed again
+	refreshInterval = "PT1H"
+
+	// eventDuration is how long reminder and backup events last
+	eventDuration = "PT15M"
+
+	// timezoneHorizon is how far past the last event the VTIMEZONE defines
+	// transitions, so occurrences of recurring events keep the right offset
+	timezoneHorizon = 2 * 365 * 24 * time.Hour
+)
+
+// feed holds everything the calendar publishes
+type feed struct {
+	reminders []*models.Reminder
+	forecast  models.ReviewForecast
+	backups   models.BackupSchedule
+}
+
+// recurrenceRules maps a reminder recurrence to its RRULE value
+var recurrenceRules = map[string]string{
+	schema.REMINDER_RECURRENCE_DAILY:  "FREQ=DAILY",
+	schema.REMINDER_RECURRENCE_WEEKLY: "FREQ=WEEKLY",
+}
+
+// buildCalendar renders f as an iCalendar object with its date-times in
+// loc: one event per reminder, an all-day event for each day with reviews
+// due, and a recurring event for the automatic backup when it is enabled
+func buildCalendar(f feed, loc *time.Location, now time.Time) string {
+	tzid := loc.String()
+	from, to := feedSpan(f, loc, now)
+
+	w := &icalWriter{}
+	w.line("BEGIN", "VCALENDAR")
+	w.line("VERSION", "2.0")
+	w.line("PRODID", productID)
+	w.line("CALSCALE", "GREGORIAN")
+	w.line("METHOD", "PUBLISH")
+	w.text("X-WR-CALNAME", "Word Flashcard")
+	w.text("X-WR-TIMEZONE", tzid)
+	w.line("REFRESH-INTERVAL;VALUE=DURATION", refreshInterval)
+	w.line("X-PUBLISHED-TTL", refreshInterval)
+	writeTimezone(w, loc, from, to.Add(timezoneHorizon))
+
+	for _, reminder := range f.reminders {
+		writeReminder(w, reminder, loc, now)
+	}
+	writeReviewDays(w, f.forecast, loc, now)
+	if f.backups.Enabled && f.backups.NextBackupAt != nil {
+		writeBackup(w, f.backups, loc, now)
+	}
+
+	w.line("END", "VCALENDAR")
+	return w.String()
+}
+
+// feedSpan returns the earliest and latest times f publishes, now included
+func feedSpan(f feed, loc *time.Location, now time.Time) (time.Time, time.Time) {
+	local := now.In(loc)
+	from := time.Date(local.Year(), local.Month(), local.Day(), 0, 0, 0, 0, loc)
+	to := from.AddDate(0, 0, len(f.forecast.Points))
+	include := func(t time.Time) {
+		if t.Before(from) {
+			from = t
+		}
+		if t.After(to) {
+			to = t
+		}
+	}
+	for _, reminder := range f.reminders {
+		if reminder.DueAt != nil {
+			include(*reminder.DueAt)
+		}
+	}
+	if f.backups.NextBackupAt != nil {
+		include(*f.backups.NextBackupAt)
+	}
+	return from, to
+}
+
+// reminderSummary returns the title of a reminder's event, such as
+// "Review word: take off"
+func reminderSummary(reminder *models.Reminder) string {
+	summary := "Review " + utils.DerefStr(reminder.EntityType)
+	if label := strings.TrimSpace(utils.DerefStr(reminder.Label)); label != "" {
+		summary += ": " + label
+	}
+	return summary
+}
+
+// writeReminder writes a reminder as an event at its due time that alerts
+// when it starts, recurring like the reminder does
+func writeReminder(w *icalWriter, reminder *models.Reminder, loc *time.Location, now time.Time) {
+	if reminder.ID == nil || reminder.DueAt == nil {
+		return
+	}
+	summary := reminderSummary(reminder)
+
+	w.line("BEGIN", "VEVENT")
+	w.line("UID", fmt.Sprintf("reminder-%d@%s", *reminder.ID, uidDomain))
+	w.utc("DTSTAMP", now)
+	if reminder.CreatedAt != nil {
+		w.utc("CREATED", *reminder.CreatedAt)
+	}
+	if reminder.UpdatedAt != nil {
+		w.utc("LAST-MODIFIED", *reminder.UpdatedAt)
+	}
+	w.local("DTSTART", reminder.DueAt.In(loc), loc.String())
+	w.line("DURATION", eventDuration)
+	if rule, ok := recurrenceRules[utils.DerefStr(reminder.Recurrence)]; ok {
+		w.line("RRULE", rule)
+	}
+	w.text("SUMMARY", summary)
+	if note := utils.DerefStr(reminder.Note); note != "" {
+		w.text("DESCRIPTION", note)
+	}
+	w.text("CATEGORIES", "Reminder")
+	w.line("TRANSP", "TRANSPARENT")
+	w.line("BEGIN", "VALARM")
+	w.line("ACTION", "DISPLAY")
+	w.text("DESCRIPTION", summary)
+	w.line("TRIGGER", "PT0M")
+	w.line("END", "VALARM")
+	w.line("END", "VEVENT")
+}
+
+// plural returns count followed by noun, with an s unless count is 1
+func plural(count int, noun string) string {
+	if count == 1 {
+		return "1 " + noun
+	}
+	return strconv.Itoa(count) + " " + noun + "s"
+}
+
+// writeReviewDays writes an all-day event for each forecast day with words
+// or questions due, titled with the counts. The first day also counts the
+// overdue reviews, which its description mentions.
+func writeReviewDays(w *icalWriter, forecast models.ReviewForecast, loc *time.Location, now time.Time) {
+	for i, point := range forecast.Points {
+		if point.WordCount+point.QuestionCount == 0 {
+			continue
+		}
+		day, err := time.ParseInLocation("2006-01-02", point.Date, loc)
+		if err != nil {
+			continue
+		}
+
+		var counts []string
+		if point.WordCount > 0 {
+			counts = append(counts, plural(point.WordCount, "word"))
+		}
+		if point.QuestionCount > 0 {
+			counts = append(counts, plural(point.QuestionCount, "question"))
+		}
+		description := fmt.Sprintf("About %s minutes of review.", strconv.FormatFloat(point.EstimatedMinutes, 'f', -1, 64))
+		if overdue := forecast.OverdueWords + forecast.OverdueQuestions; i == 0 && overdue > 0 {
+			description += fmt.Sprintf(" Includes %s already overdue.", plural(overdue, "review"))
+		}
+
+		w.line("BEGIN", "VEVENT")
+		w.line("UID", fmt.Sprintf("reviews-%s@%s", day.Format(dateFormat), uidDomain))
+		w.utc("DTSTAMP", now)
+		w.date("DTSTART", day)
+		w.date("DTEND", day.AddDate(0, 0, 1))
+		w.text("SUMMARY", "Reviews due: "+strings.Join(counts, ", "))
+		w.text("DESCRIPTION", description)
+		w.text("CATEGORIES", "Reviews")
+		w.line("TRANSP", "TRANSPARENT")
+		w.line("END", "VEVENT")
+	}
+}
+
+// writeBackup writes the automatic backup as an event at its next run,
+// recurring every backup interval
+func writeBackup(w *icalWriter, schedule models.BackupSchedule, loc *time.Location, now time.Time) {
+	description := "No backup has been written yet."
+	if schedule.LastBackupAt != nil {
+		description = "Last backup: " + schedule.LastBackupAt.In(loc).Format("2006-01-02 15:04 MST") + "."
+	}
+
+	w.line("BEGIN", "VEVENT")
+	w.line("UID", "backup@"+uidDomain)
+	w.utc("DTSTAMP", now)
+	w.local("DTSTART", schedule.NextBackupAt.In(loc), loc.String())
+	w.line("DURATION", eventDuration)
+	if schedule.IntervalHours > 0 {
+		w.line("RRULE", fmt.Sprintf("FREQ=HOURLY;INTERVAL=%d", schedule.IntervalHours))
+	}
+	w.text("SUMMARY", "Scheduled backup")
+	w.text("DESCRIPTION", description)
+	w.text("CATEGORIES", "Backup")
+	w.line("TRANSP", "TRANSPARENT")
+	w.line("END", "VEVENT")
+}
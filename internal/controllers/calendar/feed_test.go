@@ -0,0 +1,114 @@
+package calendar
+
+import (
+	"strings"
+	"time"
+	"word-flashcard/data/schema"
+	"word-flashcard/internal/models"
+	"word-flashcard/utils"
+)
+
+var testFeedNow = time.Date(2024, 1, 15, 2, 0, 0, 0, time.UTC)
+
+// getSampleFeed returns a feed with a one-off word reminder, a weekly note
+// reminder, two days of forecast with reviews due on the first only, and an
+// enabled backup schedule
+func getSampleFeed() feed {
+	due := time.Date(2024, 1, 15, 10, 0, 0, 0, time.UTC)
+	lastBackup := time.Date(2024, 1, 14, 1, 0, 0, 0, time.UTC)
+	nextBackup := lastBackup.Add(72 * time.Hour)
+	return feed{
+		reminders: []*models.Reminder{
+			{
+				ID:         utils.IntPtr(1),
+				EntityType: utils.StrPtr(schema.ENTITY_TYPE_WORD),
+				EntityID:   utils.IntPtr(1),
+				Label:      utils.StrPtr("take off"),
+				DueAt:      &due,
+				Note:       utils.StrPtr("Phrasal verbs; see note 2, then\nquiz"),
+				CreatedAt:  &due,
+				UpdatedAt:  &due,
+			},
+			{
+				ID:         utils.IntPtr(2),
+				EntityType: utils.StrPtr(schema.ENTITY_TYPE_NOTE),
+				EntityID:   utils.IntPtr(2),
+				Label:      utils.StrPtr("Irregular verbs"),
+				DueAt:      &due,
+				Recurrence: utils.StrPtr(schema.REMINDER_RECURRENCE_WEEKLY),
+			},
+		},
+		forecast: models.ReviewForecast{
+			Days:         2,
+			OverdueWords: 2,
+			Points: []models.ForecastDay{
+				{Date: "2024-01-15", WordCount: 8, QuestionCount: 1, EstimatedMinutes: 1.8},
+				{Date: "2024-01-16"},
+			},
+		},
+		backups: models.BackupSchedule{
+			Enabled:       true,
+			IntervalHours: 72,
+			LastBackupAt:  &lastBackup,
+			NextBackupAt:  &nextBackup,
+		},
+	}
+}
+
+// TestBuildCalendar tests the events published for reminders, review days
+// and the automatic backup
+func (suite *ControllerTestSuite) TestBuildCalendar() {
+	loc, err := time.LoadLocation("Asia/Taipei")
+	suite.Require().NoError(err)
+
+	out := buildCalendar(getSampleFeed(), loc, testFeedNow)
+
+	suite.True(strings.HasPrefix(out, "BEGIN:VCALENDAR\r\nVERSION:2.0\r\nPRODID:-//word-flashcard//Calendar Feed//EN\r\n"))
+	suite.True(strings.HasSuffix(out, "END:VCALENDAR\r\n"))
+	suite.Contains(out, "X-WR-TIMEZONE:Asia/Taipei\r\n")
+	suite.Contains(out, "BEGIN:VTIMEZONE\r\nTZID:Asia/Taipei\r\n")
+	for _, line := range strings.Split(strings.TrimSuffix(out, "\r\n"), "\r\n") {
+		suite.LessOrEqual(len(line), maxLineOctets, line)
+		suite.NotContains(line, "\n")
+	}
+	// Long lines are folded; compare content lines as unfolded
+	out = strings.ReplaceAll(out, "\r\n ", "")
+
+	// The one-off word reminder, due at 18:00 Taipei time, alerts when due
+	suite.Contains(out, "BEGIN:VEVENT\r\nUID:reminder-1@word-flashcard\r\nDTSTAMP:20240115T020000Z\r\nCREATED:20240115T100000Z\r\nLAST-MODIFIED:20240115T100000Z\r\n"+
+		"DTSTART;TZID=Asia/Taipei:20240115T180000\r\nDURATION:PT15M\r\nSUMMARY:Review word: take off\r\n"+
+		"DESCRIPTION:Phrasal verbs\\; see note 2\\, then\\nquiz\r\nCATEGORIES:Reminder\r\nTRANSP:TRANSPARENT\r\n"+
+		"BEGIN:VALARM\r\nACTION:DISPLAY\r\nDESCRIPTION:Review word: take off\r\nTRIGGER:PT0M\r\nEND:VALARM\r\nEND:VEVENT\r\n")
+	// The weekly note reminder recurs
+	suite.Contains(out, "UID:reminder-2@word-flashcard\r\nDTSTAMP:20240115T020000Z\r\nDTSTART;TZID=Asia/Taipei:20240115T180000\r\nDURATION:PT15M\r\nRRULE:FREQ=WEEKLY\r\nSUMMARY:Review note: Irregular verbs\r\n")
+
+	// Only the day with reviews due is published, counting the overdue ones
+	suite.Contains(out, "UID:reviews-20240115@word-flashcard\r\nDTSTAMP:20240115T020000Z\r\nDTSTART;VALUE=DATE:20240115\r\nDTEND;VALUE=DATE:20240116\r\n"+
+		"SUMMARY:Reviews due: 8 words\\, 1 question\r\nDESCRIPTION:About 1.8 minutes of review. Includes 2 reviews already overdue.\r\n")
+	suite.NotContains(out, "reviews-20240116")
+
+	// The backup recurs every interval from its next run
+	suite.Contains(out, "UID:backup@word-flashcard\r\nDTSTAMP:20240115T020000Z\r\nDTSTART;TZID=Asia/Taipei:20240117T090000\r\nDURATION:PT15M\r\n"+
+		"RRULE:FREQ=HOURLY;INTERVAL=72\r\nSUMMARY:Scheduled backup\r\nDESCRIPTION:Last backup: 2024-01-14 09:00 CST.\r\n")
+}
+
+// TestBuildCalendarBackupDisabled tests that no backup is published when
+// the automatic backup is disabled
+func (suite *ControllerTestSuite) TestBuildCalendarBackupDisabled() {
+	f := getSampleFeed()
+	f.backups = models.BackupSchedule{Enabled: false, IntervalHours: 72}
+
+	out := buildCalendar(f, time.UTC, testFeedNow)
+
+	suite.NotContains(out, "backup@word-flashcard")
+	suite.Contains(out, "DTSTART;TZID=UTC:20240115T100000\r\n")
+}
+
+// TestBuildCalendarEmpty tests that a feed with nothing to publish is
+// still a valid calendar
+func (suite *ControllerTestSuite) TestBuildCalendarEmpty() {
+	out := buildCalendar(feed{}, time.UTC, testFeedNow)
+
+	suite.NotContains(out, "BEGIN:VEVENT")
+	suite.Contains(out, "END:VTIMEZONE\r\nEND:VCALENDAR\r\n")
+}
@@ -0,0 +1,154 @@
+package calendar
+
+import (
+	"fmt"
+	"strings"
+	"time"
+	"unicode/utf8"
+)
+
+const (
+	// maxLineOctets is the longest a content line may be before it is
+	// folded, not counting the CRLF that ends it (RFC 5545 section 3.1)
+	maxLineOctets = 75
+
+	// Date and date-time value formats (RFC 5545 sections 3.3.4 and 3.3.5).
+	// A local date-time has no zone of its own and is read in the TZID given
+	// alongside it.
+	dateFormat          = "20060102"
+	localDateTimeFormat = "20060102T150405"
+	utcDateTimeFormat   = "20060102T150405Z"
+)
+
+// icalWriter builds an iCalendar object one content line at a time,
+// folding long lines and ending every line with CRLF
+type icalWriter struct {
+	b strings.Builder
+}
+
+// line writes the content line name:value, with value written as is
+func (w *icalWriter) line(name, value string) {
+	w.b.WriteString(foldLine(name + ":" + value))
+	w.b.WriteString("\r\n")
+}
+
+// text writes a content line whose value is free text, escaping it
+func (w *icalWriter) text(name, value string) {
+	w.line(name, escapeText(value))
+}
+
+// utc writes a content line whose value is t as a UTC date-time
+func (w *icalWriter) utc(name string, t time.Time) {
+	w.line(name, t.UTC().Format(utcDateTimeFormat))
+}
+
+// local writes a content line whose value is t as a date-time in the TZID
+// tzid, which the calendar must define in a VTIMEZONE
+func (w *icalWriter) local(name string, t time.Time, tzid string) {
+	w.line(name+";TZID="+tzid, t.Format(localDateTimeFormat))
+}
+
+// date writes a content line whose value is the calendar date of t
+func (w *icalWriter) date(name string, t time.Time) {
+	w.line(name+";VALUE=DATE", t.Format(dateFormat))
+}
+
+// String returns the iCalendar object written so far
+func (w *icalWriter) String() string {
+	return w.b.String()
+}
+
+// foldLine splits line into lines of at most maxLineOctets octets, each
+// continuation starting with a single space. Lines are only ever split
+// between characters, never inside a multi-octet UTF-8 sequence.
+func foldLine(line string) string {
+	if len(line) <= maxLineOctets {
+		return line
+	}
+
+	var b strings.Builder
+	width := 0
+	for _, r := range line {
+		size := utf8.RuneLen(r)
+		if width+size > maxLineOctets {
+			b.WriteString("\r\n ")
+			// The leading space counts toward the continuation's length
+			width = 1
+		}
+		b.WriteRune(r)
+		width += size
+	}
+	return b.String()
+}
+
+// textEscaper escapes the characters with a meaning of their own in a TEXT
+// value (RFC 5545 section 3.3.11). Line breaks become a literal \n.
+var textEscaper = strings.NewReplacer(
+	`\`, `\\`,
+	`;`, `\;`,
+	`,`, `\,`,
+	"\r\n", `\n`,
+	"\n", `\n`,
+	"\r", `\n`,
+)
+
+// escapeText escapes s for use as a TEXT value
+func escapeText(s string) string {
+	return textEscaper.Replace(s)
+}
+
+// utcOffset formats an offset east of UTC in seconds as a UTC-OFFSET value
+// such as +0800 or -0330, with seconds only when there are any
+func utcOffset(seconds int) string {
+	sign := "+"
+	if seconds < 0 {
+		sign = "-"
+		seconds = -seconds
+	}
+	offset := fmt.Sprintf("%s%02d%02d", sign, seconds/3600, seconds/60%60)
+	if seconds%60 != 0 {
+		offset += fmt.Sprintf("%02d", seconds%60)
+	}
+	return offset
+}
+
+// writeTimezone writes a VTIMEZONE defining loc from from until to, with one
+// STANDARD or DAYLIGHT observance for each period of a single UTC offset in
+// that span. A zone with no transitions, such as UTC, gets a single
+// observance starting in 1970.
+func writeTimezone(w *icalWriter, loc *time.Location, from time.Time, to time.Time) {
+	w.line("BEGIN", "VTIMEZONE")
+	w.line("TZID", loc.String())
+
+	t := from.In(loc)
+	for {
+		name, offset := t.Zone()
+		start, end := t.ZoneBounds()
+
+		kind := "STANDARD"
+		if t.IsDST() {
+			kind = "DAYLIGHT"
+		}
+		// An observance starts at the local time of its transition as read
+		// in the offset it replaces
+		onset, offsetFrom := time.Date(1970, 1, 1, 0, 0, 0, 0, time.UTC), offset
+		if !start.IsZero() {
+			_, offsetFrom = start.Add(-time.Second).In(loc).Zone()
+			onset = start.In(time.FixedZone("", offsetFrom))
+		}
+
+		w.line("BEGIN", kind)
+		w.line("DTSTART", onset.Format(localDateTimeFormat))
+		w.line("TZOFFSETFROM", utcOffset(offsetFrom))
+		w.line("TZOFFSETTO", utcOffset(offset))
+		w.text("TZNAME", name)
+		w.line("END", kind)
+
+		if end.IsZero() || end.After(to) {
+			break
+		}
+		t = end.In(loc)
+	}
+
+	w.line("END", "VTIMEZONE")
+}
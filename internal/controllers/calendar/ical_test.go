@@ -0,0 +1,88 @@
+package calendar
+
+import (
+	"strings"
+	"time"
+)
+
+// TestFoldLine tests that long lines are split into lines of at most 75
+// octets without splitting a character
+func (suite *ControllerTestSuite) TestFoldLine() {
+	suite.Equal("SUMMARY:short", foldLine("SUMMARY:short"))
+
+	exact := "SUMMARY:" + strings.Repeat("a", maxLineOctets-len("SUMMARY:"))
+	suite.Equal(exact, foldLine(exact))
+
+	long := "DESCRIPTION:" + strings.Repeat("a", 200)
+	folded := foldLine(long)
+	suite.Equal(long, strings.ReplaceAll(folded, "\r\n ", ""))
+	for i, line := range strings.Split(folded, "\r\n") {
+		suite.LessOrEqual(len(line), maxLineOctets)
+		if i > 0 {
+			suite.True(strings.HasPrefix(line, " "))
+		}
+	}
+
+	// Three-octet characters that would straddle the 75th octet move to the
+	// next line whole
+	multibyte := "SUMMARY:" + strings.Repeat("字", 40)
+	folded = foldLine(multibyte)
+	suite.Equal(multibyte, strings.ReplaceAll(folded, "\r\n ", ""))
+	for _, line := range strings.Split(folded, "\r\n") {
+		suite.LessOrEqual(len(line), maxLineOctets)
+		suite.True(strings.ToValidUTF8(line, "?") == line)
+	}
+}
+
+// TestEscapeText tests the escaping of characters special to TEXT values
+func (suite *ControllerTestSuite) TestEscapeText() {
+	suite.Equal(`a\, b\; c\\d\ne\nf`, escapeText("a, b; c\\d\r\ne\nf"))
+	suite.Equal("plain text", escapeText("plain text"))
+}
+
+// TestUTCOffset tests the formatting of UTC offsets
+func (suite *ControllerTestSuite) TestUTCOffset() {
+	suite.Equal("+0800", utcOffset(8*3600))
+	suite.Equal("-0330", utcOffset(-(3*3600 + 30*60)))
+	suite.Equal("+0000", utcOffset(0))
+	suite.Equal("+001915", utcOffset(19*60+15))
+}
+
+// TestWriteTimezone tests the observances written for zones with and
+// without daylight saving time
+func (suite *ControllerTestSuite) TestWriteTimezone() {
+	suite.Run("zone without transitions in the span", func() {
+		loc, err := time.LoadLocation("Asia/Taipei")
+		suite.Require().NoError(err)
+		w := &icalWriter{}
+		writeTimezone(w, loc, time.Date(2024, 1, 1, 0, 0, 0, 0, loc), time.Date(2026, 1, 1, 0, 0, 0, 0, loc))
+
+		out := w.String()
+		suite.Contains(out, "TZID:Asia/Taipei\r\n")
+		suite.Equal(1, strings.Count(out, "BEGIN:STANDARD\r\n"))
+		suite.NotContains(out, "BEGIN:DAYLIGHT")
+		// Taiwan last changed its offset when daylight saving time ended in 1979
+		suite.Contains(out, "DTSTART:19791001T000000\r\nTZOFFSETFROM:+0900\r\nTZOFFSETTO:+0800\r\nTZNAME:CST\r\n")
+	})
+
+	suite.Run("zone with daylight saving time", func() {
+		loc, err := time.LoadLocation("America/New_York")
+		suite.Require().NoError(err)
+		w := &icalWriter{}
+		writeTimezone(w, loc, time.Date(2024, 1, 15, 0, 0, 0, 0, loc), time.Date(2024, 12, 31, 0, 0, 0, 0, loc))
+
+		out := w.String()
+		suite.Equal(2, strings.Count(out, "BEGIN:STANDARD\r\n"))
+		suite.Equal(1, strings.Count(out, "BEGIN:DAYLIGHT\r\n"))
+		suite.Contains(out, "BEGIN:DAYLIGHT\r\nDTSTART:20240310T020000\r\nTZOFFSETFROM:-0500\r\nTZOFFSETTO:-0400\r\nTZNAME:EDT\r\nEND:DAYLIGHT\r\n")
+		suite.Contains(out, "BEGIN:STANDARD\r\nDTSTART:20241103T020000\r\nTZOFFSETFROM:-0400\r\nTZOFFSETTO:-0500\r\nTZNAME:EST\r\nEND:STANDARD\r\n")
+		suite.True(strings.HasSuffix(out, "END:VTIMEZONE\r\n"))
+	})
+
+	suite.Run("UTC", func() {
+		w := &icalWriter{}
+		writeTimezone(w, time.UTC, time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC), time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC))
+
+		suite.Equal("BEGIN:VTIMEZONE\r\nTZID:UTC\r\nBEGIN:STANDARD\r\nDTSTART:19700101T000000\r\nTZOFFSETFROM:+0000\r\nTZOFFSETTO:+0000\r\nTZNAME:UTC\r\nEND:STANDARD\r\nEND:VTIMEZONE\r\n", w.String())
+	})
+}
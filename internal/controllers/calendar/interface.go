@@ -0,0 +1,8 @@
+package calendar
+
+import "github.com/gin-gonic/gin"
+
+// ControllerInterface defines the interface for calendar controller
+type ControllerInterface interface {
+	GetCalendarFeed(c *gin.Context)
+}
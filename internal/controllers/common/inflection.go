@@ -0,0 +1,168 @@
+package common
+
+import (
+	"regexp"
+	"sort"
+	"strings"
+)
+
+// Inflection kinds produced by Inflect. InflectionS covers both the plural
+// of a noun and the third person singular of a verb, which share spelling
+// rules in English.
+const (
+	InflectionBase = "base"
+	InflectionS    = "s"
+	InflectionED   = "ed"
+	InflectionING  = "ing"
+)
+
+// inflectionKinds lists every kind Inflect understands, base form first.
+var inflectionKinds = []string{InflectionBase, InflectionS, InflectionED, InflectionING}
+
+// Inflect applies the regular English spelling rules for kind to word. For a
+// multi-word phrase only the first word is inflected (look up -> looked up).
+// Irregular forms (ran, mice) are not produced.
+func Inflect(word, kind string) string {
+	head, tail, _ := strings.Cut(word, " ")
+	if tail != "" {
+		tail = " " + tail
+	}
+	lower := strings.ToLower(head)
+	if lower == "" {
+		return word
+	}
+
+	switch kind {
+	case InflectionS:
+		switch {
+		case endsWithConsonantY(lower):
+			return head[:len(head)-1] + "ies" + tail
+		case hasAnySuffix(lower, "s", "x", "z", "ch", "sh") || endsWithConsonantO(lower):
+			return head + "es" + tail
+		default:
+			return head + "s" + tail
+		}
+	case InflectionED:
+		switch {
+		case strings.HasSuffix(lower, "e"):
+			return head + "d" + tail
+		case endsWithConsonantY(lower):
+			return head[:len(head)-1] + "ied" + tail
+		case doublesFinalConsonant(lower):
+			return head + head[len(head)-1:] + "ed" + tail
+		default:
+			return head + "ed" + tail
+		}
+	case InflectionING:
+		switch {
+		case strings.HasSuffix(lower, "ie"):
+			return head[:len(head)-2] + "ying" + tail
+		case strings.HasSuffix(lower, "e") && !hasAnySuffix(lower, "ee", "ye", "oe") && len(lower) > 2:
+			return head[:len(head)-1] + "ing" + tail
+		case doublesFinalConsonant(lower):
+			return head + head[len(head)-1:] + "ing" + tail
+		default:
+			return head + "ing" + tail
+		}
+	default:
+		return word
+	}
+}
+
+// InflectedForms returns word's regular inflections keyed by kind (see
+// Inflect). The base form is always included.
+func InflectedForms(word string) map[string]string {
+	forms := make(map[string]string, len(inflectionKinds))
+	for _, kind := range inflectionKinds {
+		forms[kind] = Inflect(word, kind)
+	}
+	return forms
+}
+
+// FindInflection locates the first occurrence of word, in any regular
+// inflected form, as a whole word in text (case-insensitive). It returns the
+// byte range of the match and the inflection kind, or ok=false when the word
+// does not appear.
+func FindInflection(text, word string) (start, end int, kind string, ok bool) {
+	re, byForm := inflectionPattern(word)
+	if re == nil {
+		return 0, 0, "", false
+	}
+	loc := re.FindStringIndex(text)
+	if loc == nil {
+		return 0, 0, "", false
+	}
+	return loc[0], loc[1], byForm[strings.ToLower(text[loc[0]:loc[1]])], true
+}
+
+// inflectionPattern compiles a case-insensitive whole-word pattern matching
+// word in any regular inflected form, along with the kind of each lower-cased
+// form. It returns a nil pattern when word is blank.
+func inflectionPattern(word string) (*regexp.Regexp, map[string]string) {
+	forms := InflectedForms(strings.TrimSpace(word))
+	byForm := make(map[string]string, len(forms))
+	alternatives := make([]string, 0, len(forms))
+	for _, k := range inflectionKinds {
+		form := strings.ToLower(forms[k])
+		if form == "" {
+			continue
+		}
+		if _, seen := byForm[form]; !seen {
+			byForm[form] = k
+			alternatives = append(alternatives, regexp.QuoteMeta(form))
+		}
+	}
+	if len(alternatives) == 0 {
+		return nil, nil
+	}
+	// Longest alternatives first so "apples" wins over "apple".
+	sort.Slice(alternatives, func(i, j int) bool { return len(alternatives[i]) > len(alternatives[j]) })
+
+	return regexp.MustCompile(`(?i)\b(?:` + strings.Join(alternatives, "|") + `)\b`), byForm
+}
+
+// hasAnySuffix reports whether s ends with any of suffixes.
+func hasAnySuffix(s string, suffixes ...string) bool {
+	for _, suffix := range suffixes {
+		if strings.HasSuffix(s, suffix) {
+			return true
+		}
+	}
+	return false
+}
+
+// isVowel reports whether b is an ASCII vowel.
+func isVowel(b byte) bool {
+	return strings.IndexByte("aeiou", b) >= 0
+}
+
+// endsWithConsonantY reports whether s ends in a consonant followed by "y" (try, city).
+func endsWithConsonantY(s string) bool {
+	return len(s) > 1 && s[len(s)-1] == 'y' && !isVowel(s[len(s)-2])
+}
+
+// endsWithConsonantO reports whether s ends in a consonant followed by "o" (potato, go).
+func endsWithConsonantO(s string) bool {
+	return len(s) > 1 && s[len(s)-1] == 'o' && !isVowel(s[len(s)-2])
+}
+
+// doublesFinalConsonant reports whether s is a single-syllable
+// consonant-vowel-consonant word whose final consonant doubles before a
+// vowel suffix (stop -> stopped, run -> running). Longer words are left
+// alone since doubling there depends on stress.
+func doublesFinalConsonant(s string) bool {
+	n := len(s)
+	if n < 3 || strings.IndexByte("wxy", s[n-1]) >= 0 {
+		return false
+	}
+	if isVowel(s[n-1]) || !isVowel(s[n-2]) || isVowel(s[n-3]) {
+		return false
+	}
+	vowelGroups := 0
+	for i := 0; i < n; i++ {
+		if isVowel(s[i]) && (i == 0 || !isVowel(s[i-1])) {
+			vowelGroups++
+		}
+	}
+	return vowelGroups == 1
+}
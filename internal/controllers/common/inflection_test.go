@@ -0,0 +1,89 @@
+package common
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+)
+
+// InflectionTestSuite is a test suite for regular English inflection helpers
+type InflectionTestSuite struct {
+	suite.Suite
+}
+
+// TestInflectionTestSuite runs the InflectionTestSuite
+func TestInflectionTestSuite(t *testing.T) {
+	suite.Run(t, new(InflectionTestSuite))
+}
+
+// TestInflect tests the regular spelling rules for each inflection kind
+func (suite *InflectionTestSuite) TestInflect() {
+	testCases := []struct {
+		word, kind, expected string
+	}{
+		{"apple", InflectionS, "apples"},
+		{"box", InflectionS, "boxes"},
+		{"watch", InflectionS, "watches"},
+		{"city", InflectionS, "cities"},
+		{"play", InflectionS, "plays"},
+		{"potato", InflectionS, "potatoes"},
+		{"bake", InflectionED, "baked"},
+		{"try", InflectionED, "tried"},
+		{"stop", InflectionED, "stopped"},
+		{"visit", InflectionED, "visited"},
+		{"fix", InflectionED, "fixed"},
+		{"make", InflectionING, "making"},
+		{"see", InflectionING, "seeing"},
+		{"lie", InflectionING, "lying"},
+		{"run", InflectionING, "running"},
+		{"open", InflectionING, "opening"},
+		{"look up", InflectionED, "looked up"},
+		{"apple", InflectionBase, "apple"},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.word+"/"+tc.kind, func() {
+			suite.Equal(tc.expected, Inflect(tc.word, tc.kind))
+		})
+	}
+}
+
+// TestInflectedForms tests that every kind is present in the returned map
+func (suite *InflectionTestSuite) TestInflectedForms() {
+	suite.Equal(map[string]string{
+		InflectionBase: "walk",
+		InflectionS:    "walks",
+		InflectionED:   "walked",
+		InflectionING:  "walking",
+	}, InflectedForms("walk"))
+}
+
+// TestFindInflection tests locating inflected forms as whole words
+func (suite *InflectionTestSuite) TestFindInflection() {
+	testCases := []struct {
+		name     string
+		text     string
+		word     string
+		found    string
+		kind     string
+		expectOK bool
+	}{
+		{"plural wins over base", "Apples are red.", "apple", "Apples", InflectionS, true},
+		{"past tense", "She baked a cake.", "bake", "baked", InflectionED, true},
+		{"progressive with doubling", "He is running late.", "run", "running", InflectionING, true},
+		{"phrase", "I looked up the word.", "look up", "looked up", InflectionED, true},
+		{"no match inside another word", "pineapple juice", "apple", "", "", false},
+		{"irregular form not found", "He ran home.", "run", "", "", false},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			start, end, kind, ok := FindInflection(tc.text, tc.word)
+			suite.Equal(tc.expectOK, ok)
+			if ok {
+				suite.Equal(tc.found, tc.text[start:end])
+				suite.Equal(tc.kind, kind)
+			}
+		})
+	}
+}
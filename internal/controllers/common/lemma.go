@@ -0,0 +1,388 @@
+package common
+
+import (
+	"slices"
+	"strings"
+)
+
+// Lemmatizer reduces a word to the dictionary forms it may be an inflection
+// of, so "ran", "running" and "run" can be recognised as the same entry.
+// Words are expected to be normalised with NormalizeWord first.
+type Lemmatizer interface {
+	// Lemmas returns the dictionary forms word may be an inflection of,
+	// most likely first, not including word itself. A word that doesn't
+	// look inflected returns nothing.
+	Lemmas(word string) []string
+}
+
+// minLemmaLength is the shortest stem a suffix may be stripped down to, so
+// short words like "bus" or "red" aren't taken for inflections.
+const minLemmaLength = 3
+
+// irregularLemmas maps irregular English inflections to their dictionary
+// forms. A few regular forms the suffix rules would get wrong (agreed ->
+// "agre") are listed here too, as are words that are an inflection of two
+// different entries (leaves -> leaf, leave).
+var irregularLemmas = map[string][]string{
+	// verbs
+	"am":         {"be"},
+	"is":         {"be"},
+	"are":        {"be"},
+	"was":        {"be"},
+	"were":       {"be"},
+	"been":       {"be"},
+	"has":        {"have"},
+	"had":        {"have"},
+	"does":       {"do"},
+	"did":        {"do"},
+	"done":       {"do"},
+	"goes":       {"go"},
+	"went":       {"go"},
+	"gone":       {"go"},
+	"ate":        {"eat"},
+	"eaten":      {"eat"},
+	"began":      {"begin"},
+	"begun":      {"begin"},
+	"bent":       {"bend"},
+	"blew":       {"blow"},
+	"blown":      {"blow"},
+	"broke":      {"break"},
+	"broken":     {"break"},
+	"brought":    {"bring"},
+	"built":      {"build"},
+	"bought":     {"buy"},
+	"came":       {"come"},
+	"caught":     {"catch"},
+	"chose":      {"choose"},
+	"chosen":     {"choose"},
+	"dealt":      {"deal"},
+	"drew":       {"draw"},
+	"drawn":      {"draw"},
+	"drank":      {"drink"},
+	"drunk":      {"drink"},
+	"drove":      {"drive"},
+	"driven":     {"drive"},
+	"dug":        {"dig"},
+	"fed":        {"feed"},
+	"fell":       {"fall"},
+	"fallen":     {"fall"},
+	"felt":       {"feel"},
+	"fought":     {"fight"},
+	"fled":       {"flee"},
+	"flew":       {"fly"},
+	"flown":      {"fly"},
+	"forgot":     {"forget"},
+	"forgotten":  {"forget"},
+	"forgave":    {"forgive"},
+	"forgiven":   {"forgive"},
+	"froze":      {"freeze"},
+	"frozen":     {"freeze"},
+	"gave":       {"give"},
+	"given":      {"give"},
+	"got":        {"get"},
+	"gotten":     {"get"},
+	"grew":       {"grow"},
+	"grown":      {"grow"},
+	"heard":      {"hear"},
+	"held":       {"hold"},
+	"hid":        {"hide"},
+	"hidden":     {"hide"},
+	"hung":       {"hang"},
+	"kept":       {"keep"},
+	"knew":       {"know"},
+	"known":      {"know"},
+	"led":        {"lead"},
+	"left":       {"leave"},
+	"lent":       {"lend"},
+	"lost":       {"lose"},
+	"made":       {"make"},
+	"meant":      {"mean"},
+	"met":        {"meet"},
+	"paid":       {"pay"},
+	"ran":        {"run"},
+	"rode":       {"ride"},
+	"ridden":     {"ride"},
+	"said":       {"say"},
+	"sang":       {"sing"},
+	"sung":       {"sing"},
+	"sat":        {"sit"},
+	"saw":        {"see"},
+	"seen":       {"see"},
+	"sought":     {"seek"},
+	"sold":       {"sell"},
+	"sent":       {"send"},
+	"shook":      {"shake"},
+	"shaken":     {"shake"},
+	"slept":      {"sleep"},
+	"spent":      {"spend"},
+	"spoke":      {"speak"},
+	"spoken":     {"speak"},
+	"stole":      {"steal"},
+	"stolen":     {"steal"},
+	"stood":      {"stand"},
+	"struck":     {"strike"},
+	"stuck":      {"stick"},
+	"swam":       {"swim"},
+	"swum":       {"swim"},
+	"swept":      {"sweep"},
+	"took":       {"take"},
+	"taken":      {"take"},
+	"taught":     {"teach"},
+	"tore":       {"tear"},
+	"torn":       {"tear"},
+	"told":       {"tell"},
+	"thought":    {"think"},
+	"threw":      {"throw"},
+	"thrown":     {"throw"},
+	"understood": {"understand"},
+	"woke":       {"wake"},
+	"woken":      {"wake"},
+	"wore":       {"wear"},
+	"worn":       {"wear"},
+	"won":        {"win"},
+	"wrote":      {"write"},
+	"written":    {"write"},
+	"agreed":     {"agree"},
+	"freed":      {"free"},
+	"guaranteed": {"guarantee"},
+	// nouns
+	"children": {"child"},
+	"feet":     {"foot"},
+	"geese":    {"goose"},
+	"knives":   {"knife"},
+	"leaves":   {"leaf", "leave"},
+	"lives":    {"life", "live"},
+	"men":      {"man"},
+	"mice":     {"mouse"},
+	"teeth":    {"tooth"},
+	"wives":    {"wife"},
+	"women":    {"woman"},
+}
+
+// irregularInflections maps a dictionary form to its irregular inflections,
+// the reverse of irregularLemmas
+var irregularInflections = func() map[string][]string {
+	inflections := make(map[string][]string)
+	for form, lemmas := range irregularLemmas {
+		for _, lemma := range lemmas {
+			inflections[lemma] = append(inflections[lemma], form)
+		}
+	}
+	return inflections
+}()
+
+// lemmaExceptions are words that look inflected but are dictionary forms in
+// their own right, and would otherwise be matched with an unrelated word
+// (evening -> even).
+var lemmaExceptions = map[string]bool{
+	"always": true, "anything": true, "ceiling": true, "during": true,
+	"evening": true, "everything": true, "morning": true, "news": true,
+	"nothing": true, "series": true, "something": true, "species": true,
+}
+
+// EnglishLemmatizer is a rule-based Lemmatizer for English. Irregular forms
+// come from a fixed list; otherwise the regular suffixes are stripped and a
+// stem is kept only when Inflect spells it back into the word, which rules
+// out most stems that aren't words. For a phrase only the first word is
+// lemmatised (looked up -> look up), as with Inflect.
+type EnglishLemmatizer struct{}
+
+// Lemmas implements Lemmatizer
+func (EnglishLemmatizer) Lemmas(word string) []string {
+	head, tail, _ := strings.Cut(word, " ")
+	if tail != "" {
+		tail = " " + tail
+	}
+
+	stems, ok := irregularLemmas[head]
+	if !ok {
+		stems = regularLemmas(head)
+	}
+
+	lemmas := make([]string, 0, len(stems))
+	for _, stem := range stems {
+		lemmas = append(lemmas, stem+tail)
+	}
+	return lemmas
+}
+
+// regularLemmas returns the stems word is a regular inflection of
+func regularLemmas(word string) []string {
+	if lemmaExceptions[word] || !isLowerASCII(word) {
+		return nil
+	}
+
+	var stems []string
+	// try keeps stem+ending when it is long enough to be a word, has a
+	// vowel, and Inflect spells it back into word
+	try := func(stem, ending, kind string) {
+		lemma := stem + ending
+		if len(lemma) >= minLemmaLength && strings.ContainsAny(stem+strings.TrimSuffix(ending, "e"), "aeiouy") &&
+			Inflect(lemma, kind) == word && !slices.Contains(stems, lemma) {
+			stems = append(stems, lemma)
+		}
+	}
+	// tryVowelSuffix handles -ed and -ing, which may have doubled the last
+	// consonant (stopped) or dropped a silent e (baked)
+	tryVowelSuffix := func(stem, kind string) {
+		switch {
+		case !endsWithDoubleConsonant(stem):
+			try(stem, "", kind)
+			try(stem, "e", kind)
+		case hasAnySuffix(stem, "ll", "ss", "ff", "zz"):
+			// filled, kissed: the double letter belongs to the word
+			try(stem, "", kind)
+		default:
+			try(stem[:len(stem)-1], "", kind)
+		}
+	}
+
+	switch {
+	case strings.HasSuffix(word, "s"):
+		// glass, status and analysis aren't plurals
+		if hasAnySuffix(word, "ss", "us", "is") {
+			break
+		}
+		stem := strings.TrimSuffix(word, "es")
+		switch {
+		case strings.HasSuffix(word, "ies"):
+			try(strings.TrimSuffix(word, "ies"), "y", InflectionS)
+		case hasAnySuffix(stem, "ss", "x", "zz", "ch", "sh") || endsWithConsonantO(stem):
+			try(stem, "", InflectionS)
+		default:
+			try(strings.TrimSuffix(word, "s"), "", InflectionS)
+		}
+	case strings.HasSuffix(word, "ed"):
+		// need, exceed and succeed are dictionary forms; the few regular
+		// verbs ending in -ee are listed in irregularLemmas
+		if strings.HasSuffix(word, "eed") {
+			break
+		}
+		if strings.HasSuffix(word, "ied") {
+			try(strings.TrimSuffix(word, "ied"), "y", InflectionED)
+			break
+		}
+		tryVowelSuffix(strings.TrimSuffix(word, "ed"), InflectionED)
+	case strings.HasSuffix(word, "ing"):
+		if strings.HasSuffix(word, "ying") {
+			try(strings.TrimSuffix(word, "ying"), "ie", InflectionING)
+		}
+		tryVowelSuffix(strings.TrimSuffix(word, "ing"), InflectionING)
+	}
+	return stems
+}
+
+// endsWithDoubleConsonant reports whether s ends in the same consonant twice (stopp, fill)
+func endsWithDoubleConsonant(s string) bool {
+	n := len(s)
+	return n > 1 && s[n-1] == s[n-2] && !isVowel(s[n-1])
+}
+
+// isLowerASCII reports whether s consists only of the letters a-z
+func isLowerASCII(s string) bool {
+	for i := 0; i < len(s); i++ {
+		if s[i] < 'a' || s[i] > 'z' {
+			return false
+		}
+	}
+	return s != ""
+}
+
+// NormalizeWord folds a word into the form duplicates are compared in: as
+// NormalizeAnswer, with hyphens treated as spaces so "ice-cream" and
+// "Ice cream" match.
+func NormalizeWord(s string) string {
+	return NormalizeAnswer(strings.ReplaceAll(s, "-", " "))
+}
+
+// DuplicateKeys returns the keys word is matched on when looking for
+// duplicates: its normalised form followed by the lemmas of it. Two words
+// are likely duplicates when their keys overlap.
+func DuplicateKeys(word string, lemmatizer Lemmatizer) []string {
+	normalized := NormalizeWord(word)
+	if normalized == "" {
+		return nil
+	}
+
+	keys := []string{normalized}
+	for _, lemma := range lemmatizer.Lemmas(normalized) {
+		if !slices.Contains(keys, lemma) {
+			keys = append(keys, lemma)
+		}
+	}
+	return keys
+}
+
+// DuplicateInitials returns the letters a word's normalised form may start
+// with if it shares one of keys (from DuplicateKeys): each key's first letter
+// and that of its irregular inflections (go -> went). Only the first letter
+// is safe to filter on, since a regular inflection may change the ones after
+// it (lie -> lying), so this narrows the words to compare but doesn't match
+// them.
+func DuplicateInitials(keys []string) []string {
+	var initials []string
+	add := func(word string) {
+		for _, r := range word {
+			if initial := string(r); !slices.Contains(initials, initial) {
+				initials = append(initials, initial)
+			}
+			return
+		}
+	}
+	for _, key := range keys {
+		add(key)
+		head, _, _ := strings.Cut(key, " ")
+		for _, form := range irregularInflections[head] {
+			add(form)
+		}
+	}
+	return initials
+}
+
+// GroupByKeys groups the indexes of keys whose key sets overlap, directly
+// or through other members of the group. Only groups of two or more are
+// returned, ordered by their first index, each in ascending order.
+func GroupByKeys(keys [][]string) [][]int {
+	parent := make([]int, len(keys))
+	for i := range parent {
+		parent[i] = i
+	}
+	var find func(int) int
+	find = func(i int) int {
+		if parent[i] != i {
+			parent[i] = find(parent[i])
+		}
+		return parent[i]
+	}
+
+	owner := make(map[string]int)
+	for i, set := range keys {
+		for _, key := range set {
+			if j, ok := owner[key]; ok {
+				if ri, rj := find(i), find(j); ri != rj {
+					parent[max(ri, rj)] = min(ri, rj)
+				}
+				continue
+			}
+			owner[key] = i
+		}
+	}
+
+	members := make(map[int][]int)
+	var roots []int
+	for i := range keys {
+		root := find(i)
+		if _, ok := members[root]; !ok {
+			roots = append(roots, root)
+		}
+		members[root] = append(members[root], i)
+	}
+
+	var groups [][]int
+	for _, root := range roots {
+		if len(members[root]) > 1 {
+			groups = append(groups, members[root])
+		}
+	}
+	return groups
+}
@@ -0,0 +1,102 @@
+package common
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+)
+
+// LemmaTestSuite is a test suite for word normalisation and lemmatisation
+type LemmaTestSuite struct {
+	suite.Suite
+}
+
+// TestLemmaTestSuite runs the LemmaTestSuite
+func TestLemmaTestSuite(t *testing.T) {
+	suite.Run(t, new(LemmaTestSuite))
+}
+
+// TestEnglishLemmas tests regular and irregular forms are reduced to their
+// dictionary forms and words that only look inflected are left alone
+func (suite *LemmaTestSuite) TestEnglishLemmas() {
+	testCases := []struct {
+		word     string
+		expected []string
+	}{
+		{"apples", []string{"apple"}},
+		{"boxes", []string{"box"}},
+		{"cities", []string{"city"}},
+		{"potatoes", []string{"potato"}},
+		{"horses", []string{"horse"}},
+		{"baked", []string{"bake"}},
+		{"stopped", []string{"stop"}},
+		{"tried", []string{"try"}},
+		{"visited", []string{"visit"}},
+		{"running", []string{"run"}},
+		{"making", []string{"make"}},
+		{"lying", []string{"lie"}},
+		{"looked up", []string{"look up"}},
+		{"ran", []string{"run"}},
+		{"children", []string{"child"}},
+		{"leaves", []string{"leaf", "leave"}},
+		{"agreed", []string{"agree"}},
+		{"run", nil},
+		{"glass", nil},
+		{"status", nil},
+		{"need", nil},
+		{"exceed", nil},
+		{"evening", nil},
+		{"string", nil},
+		{"bus", nil},
+		{"café", nil},
+	}
+
+	lemmatizer := EnglishLemmatizer{}
+	for _, tc := range testCases {
+		suite.Run(tc.word, func() {
+			lemmas := lemmatizer.Lemmas(tc.word)
+			if tc.expected == nil {
+				suite.Empty(lemmas)
+				return
+			}
+			suite.Equal(tc.expected[0], lemmas[0])
+			suite.Subset(lemmas, tc.expected)
+		})
+	}
+}
+
+// TestNormalizeWord tests case, spacing, hyphens and accents are folded
+func (suite *LemmaTestSuite) TestNormalizeWord() {
+	suite.Equal("ice cream", NormalizeWord("  Ice-Cream "))
+	suite.Equal("look up", NormalizeWord("Look   up"))
+	suite.Equal("cafe", NormalizeWord("Café"))
+}
+
+// TestDuplicateKeys tests the keys are the normalised word followed by its lemmas
+func (suite *LemmaTestSuite) TestDuplicateKeys() {
+	suite.Equal([]string{"running", "run"}, DuplicateKeys(" Running", EnglishLemmatizer{}))
+	suite.Equal([]string{"run"}, DuplicateKeys("run", EnglishLemmatizer{}))
+	suite.Nil(DuplicateKeys("  ", EnglishLemmatizer{}))
+}
+
+// TestDuplicateInitials tests the initials cover the keys and the irregular
+// inflections of their first word
+func (suite *LemmaTestSuite) TestDuplicateInitials() {
+	suite.Equal([]string{"r"}, DuplicateInitials(DuplicateKeys("running", EnglishLemmatizer{})))
+	suite.ElementsMatch([]string{"b", "a", "i", "w"}, DuplicateInitials([]string{"be"}))
+	suite.Equal([]string{"w", "g"}, DuplicateInitials(DuplicateKeys("went up", EnglishLemmatizer{})))
+	suite.Empty(DuplicateInitials(nil))
+}
+
+// TestGroupByKeys tests words sharing a key, directly or through another
+// word, end up in one group and words without a match are left out
+func (suite *LemmaTestSuite) TestGroupByKeys() {
+	words := []string{"run", "apple", "Running", "ran", "pear", "Apple ", "banana"}
+	keys := make([][]string, len(words))
+	for i, word := range words {
+		keys[i] = DuplicateKeys(word, EnglishLemmatizer{})
+	}
+
+	suite.Equal([][]int{{0, 2, 3}, {1, 5}}, GroupByKeys(keys))
+	suite.Empty(GroupByKeys([][]string{{"a"}, {"b"}}))
+}
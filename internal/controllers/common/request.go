@@ -6,6 +6,7 @@ import (
 	"io"
 	"log/slog"
 	"strconv"
+	"strings"
 
 	"github.com/gin-gonic/gin"
 	"github.com/gin-gonic/gin/binding"
@@ -66,6 +67,17 @@ func ParseIntQueryParam(c *gin.Context, paramName string, defaultValue int) (int
 	return paramInt, nil
 }
 
+// HasInclude reports whether the comma-separated "include" query parameter
+// lists name, e.g. ?include=links asks for linked entities to be embedded.
+func HasInclude(c *gin.Context, name string) bool {
+	for _, include := range strings.Split(c.Query("include"), ",") {
+		if strings.TrimSpace(include) == name {
+			return true
+		}
+	}
+	return false
+}
+
 // ParseRequestBody parses the JSON request body into the provided object and logs the details.
 func ParseRequestBody(obj any, c *gin.Context) error {
 	// Check if the request body is empty
@@ -126,6 +126,27 @@ func (suite *RequestHelperTestSuite) TestParseIntQueryParam() {
 	}
 }
 
+// TestHasInclude tests matching a name in the comma-separated include parameter
+func (suite *RequestHelperTestSuite) TestHasInclude() {
+	testCases := []struct {
+		name  string
+		query string
+		want  bool
+	}{
+		{name: "absent", query: "", want: false},
+		{name: "single value", query: "?include=links", want: true},
+		{name: "among others", query: "?include=definitions,%20links", want: true},
+		{name: "other value only", query: "?include=linksx", want: false},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			ctx := newRequestTestContext(http.MethodGet, "/api/notes"+tc.query, nil, nil)
+			suite.Equal(tc.want, HasInclude(ctx, "links"))
+		})
+	}
+}
+
 // TestParseRequestBody tests the no-op paths (empty body, empty "{}" body),
 // a successful bind, and a malformed-JSON error.
 func (suite *RequestHelperTestSuite) TestParseRequestBody() {
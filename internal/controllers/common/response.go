@@ -6,6 +6,7 @@ import (
 	"log/slog"
 
 	"word-flashcard/internal/models"
+	"word-flashcard/utils"
 
 	"github.com/gin-gonic/gin"
 )
@@ -21,7 +22,7 @@ func ResponseSuccess(statusCode int, data any, c *gin.Context) {
 		} else {
 			c.Status(statusCode)
 		}
-		slog.Debug("API success response.", "path", c.Request.RequestURI, "status", statusCode, "data", "null")
+		slog.Debug("API success response.", "path", utils.RedactURI(c.Request.RequestURI), "status", statusCode, "data", "null")
 	} else {
 		c.JSON(statusCode, data)
 
@@ -33,7 +34,7 @@ func ResponseSuccess(statusCode int, data any, c *gin.Context) {
 			dataJSON = string(jsonBytes)
 		}
 
-		slog.Debug("API success response.", "path", c.Request.RequestURI, "status", statusCode, "data", dataJSON)
+		slog.Debug("API success response.", "path", utils.RedactURI(c.Request.RequestURI), "status", statusCode, "data", dataJSON)
 	}
 }
 
@@ -48,7 +49,7 @@ func ResponseError(statusCode int, message string, code models.ErrorCode, err er
 	c.JSON(statusCode, models.ErrorResponse{Error: message, Code: code})
 
 	// Log the error, enriched with any internal-only detail attached to err
-	logArgs := []any{"path", c.Request.RequestURI, "status", statusCode, "message", message, "code", code, "error", err}
+	logArgs := []any{"path", utils.RedactURI(c.Request.RequestURI), "status", statusCode, "message", message, "code", code, "error", err}
 	var de *DetailedError
 	if errors.As(err, &de) {
 		logArgs = append(logArgs, de.LogDetail()...)
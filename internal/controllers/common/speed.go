@@ -0,0 +1,154 @@
+package common
+
+import (
+	"math"
+	"slices"
+	"sort"
+
+	"word-flashcard/internal/models"
+)
+
+const (
+	// MaxResponseMs caps the response_ms a quiz may report, 30 minutes; a
+	// longer gap is a learner who walked away, not an answer time
+	MaxResponseMs = 30 * 60 * 1000
+
+	// DefaultSpeedDays is how many days of timed answers speed stats cover by default
+	DefaultSpeedDays = 90
+
+	// SlowShare is the share of a random draw reserved for items that are
+	// answered correctly but slowly when the caller favours them
+	SlowShare = 0.3
+
+	// minSlowAnswers is how many timed correct answers an item needs before
+	// it can count as slow
+	minSlowAnswers = 2
+
+	// slowPercentile is the percentile of every timed correct answer an
+	// item's median correct answer time must reach to count as slow
+	slowPercentile = 75
+)
+
+// ValidateResponseMs checks an optional response_ms is within 1..MaxResponseMs
+func ValidateResponseMs(responseMs *int) error {
+	if responseMs != nil && (*responseMs <= 0 || *responseMs > MaxResponseMs) {
+		return NewFieldError("response_ms is invalid", "value", *responseMs, "min", 1, "max", MaxResponseMs)
+	}
+	return nil
+}
+
+// Percentile returns the p-th percentile (0-100) of sorted, interpolating
+// linearly between the two closest ranks. sorted must be ascending and
+// non-empty.
+func Percentile(sorted []int, p float64) float64 {
+	rank := p / 100 * float64(len(sorted)-1)
+	lower := int(math.Floor(rank))
+	upper := int(math.Ceil(rank))
+	return float64(sorted[lower]) + (rank-float64(lower))*float64(sorted[upper]-sorted[lower])
+}
+
+// SummarizeResponseTimes returns the answer count and the median, 75th and
+// 90th percentile of responseMs, rounded to whole milliseconds. The
+// percentiles are null when there are no answers.
+func SummarizeResponseTimes(responseMs []int) models.ResponseTimes {
+	summary := models.ResponseTimes{AnswerCount: len(responseMs)}
+	if len(responseMs) == 0 {
+		return summary
+	}
+
+	sorted := slices.Clone(responseMs)
+	slices.Sort(sorted)
+	at := func(p float64) *int {
+		ms := int(math.Round(Percentile(sorted, p)))
+		return &ms
+	}
+	summary.MedianMs = at(50)
+	summary.P75Ms = at(75)
+	summary.P90Ms = at(90)
+	return summary
+}
+
+// TimedAnswer is one answer of a word or question with how long it took
+type TimedAnswer struct {
+	ItemID     int
+	ResponseMs int
+	Correct    bool
+}
+
+// SummarizeAnswers summarizes the answer times of answers
+func SummarizeAnswers(answers []TimedAnswer) models.ResponseTimes {
+	responseMs := make([]int, len(answers))
+	for i, answer := range answers {
+		responseMs[i] = answer.ResponseMs
+	}
+	return SummarizeResponseTimes(responseMs)
+}
+
+// ItemSpeeds summarizes the answer times of each item in answers, and
+// reports whether it is answered correctly but slowly: its median correct
+// answer time, over at least minSlowAnswers answers, reaches the
+// slowPercentile of every correct answer time. Items are ordered slowest
+// median first, ties by ID.
+func ItemSpeeds(answers []TimedAnswer) []models.ItemSpeed {
+	var allCorrect []int
+	byItem := make(map[int][]TimedAnswer)
+	for _, answer := range answers {
+		byItem[answer.ItemID] = append(byItem[answer.ItemID], answer)
+		if answer.Correct {
+			allCorrect = append(allCorrect, answer.ResponseMs)
+		}
+	}
+	var threshold float64
+	if len(allCorrect) > 0 {
+		slices.Sort(allCorrect)
+		threshold = Percentile(allCorrect, slowPercentile)
+	}
+
+	speeds := make([]models.ItemSpeed, 0, len(byItem))
+	for itemID, itemAnswers := range byItem {
+		var all, correct []int
+		for _, answer := range itemAnswers {
+			all = append(all, answer.ResponseMs)
+			if answer.Correct {
+				correct = append(correct, answer.ResponseMs)
+			}
+		}
+		speed := models.ItemSpeed{
+			ID:            itemID,
+			ResponseTimes: SummarizeResponseTimes(all),
+			CorrectCount:  len(correct),
+		}
+		if len(correct) >= minSlowAnswers {
+			slices.Sort(correct)
+			speed.SlowButCorrect = Percentile(correct, 50) >= threshold
+		}
+		speeds = append(speeds, speed)
+	}
+
+	sort.Slice(speeds, func(i, j int) bool {
+		a, b := speeds[i].MedianMs, speeds[j].MedianMs
+		if *a != *b {
+			return *a > *b
+		}
+		return speeds[i].ID < speeds[j].ID
+	})
+	return speeds
+}
+
+// SlowItemIDs returns the IDs of the items in answers that are answered
+// correctly but slowly, slowest first
+func SlowItemIDs(answers []TimedAnswer) []int {
+	var ids []int
+	for _, speed := range ItemSpeeds(answers) {
+		if speed.SlowButCorrect {
+			ids = append(ids, speed.ID)
+		}
+	}
+	return ids
+}
+
+// SlowQuota returns how many of count items a draw favouring slow items
+// reserves for them
+func SlowQuota(count int) int {
+	return int(math.Round(float64(count) * SlowShare))
+}
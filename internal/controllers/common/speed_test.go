@@ -0,0 +1,84 @@
+package common
+
+import (
+	"testing"
+	"word-flashcard/internal/models"
+	"word-flashcard/utils"
+
+	"github.com/stretchr/testify/suite"
+)
+
+// SpeedTestSuite is a test suite for the answer-time helpers
+type SpeedTestSuite struct {
+	suite.Suite
+}
+
+// TestSpeedTestSuite runs the SpeedTestSuite
+func TestSpeedTestSuite(t *testing.T) {
+	suite.Run(t, new(SpeedTestSuite))
+}
+
+// TestValidateResponseMs tests the accepted response_ms range
+func (suite *SpeedTestSuite) TestValidateResponseMs() {
+	suite.NoError(ValidateResponseMs(nil))
+	suite.NoError(ValidateResponseMs(utils.IntPtr(1)))
+	suite.NoError(ValidateResponseMs(utils.IntPtr(MaxResponseMs)))
+	suite.Error(ValidateResponseMs(utils.IntPtr(0)))
+	suite.Error(ValidateResponseMs(utils.IntPtr(MaxResponseMs + 1)))
+}
+
+// TestPercentile tests interpolation between ranks and single values
+func (suite *SpeedTestSuite) TestPercentile() {
+	sorted := []int{1000, 2000, 3000, 4000}
+	suite.InDelta(2500, Percentile(sorted, 50), 0.001)
+	suite.InDelta(3250, Percentile(sorted, 75), 0.001)
+	suite.InDelta(1000, Percentile(sorted, 0), 0.001)
+	suite.InDelta(4000, Percentile(sorted, 100), 0.001)
+	suite.InDelta(700, Percentile([]int{700}, 90), 0.001)
+}
+
+// TestSummarizeResponseTimes tests the summary of unsorted answer times and
+// of no answers at all
+func (suite *SpeedTestSuite) TestSummarizeResponseTimes() {
+	suite.Equal(models.ResponseTimes{
+		AnswerCount: 5,
+		MedianMs:    utils.IntPtr(3000),
+		P75Ms:       utils.IntPtr(4000),
+		P90Ms:       utils.IntPtr(4600),
+	}, SummarizeResponseTimes([]int{5000, 1000, 3000, 2000, 4000}))
+
+	suite.Equal(models.ResponseTimes{}, SummarizeResponseTimes(nil))
+}
+
+// TestItemSpeeds tests per-item summaries, their order and which items are
+// slow but correct
+func (suite *SpeedTestSuite) TestItemSpeeds() {
+	answers := []TimedAnswer{
+		// Quick and correct
+		{ItemID: 1, ResponseMs: 1000, Correct: true},
+		{ItemID: 1, ResponseMs: 1500, Correct: true},
+		// Slow and correct
+		{ItemID: 2, ResponseMs: 6000, Correct: true},
+		{ItemID: 2, ResponseMs: 7000, Correct: true},
+		// Slow, but only answered correctly once
+		{ItemID: 3, ResponseMs: 5000, Correct: true},
+		{ItemID: 3, ResponseMs: 9000, Correct: false},
+	}
+
+	speeds := ItemSpeeds(answers)
+
+	suite.Require().Len(speeds, 3)
+	suite.Equal([]int{3, 2, 1}, []int{speeds[0].ID, speeds[1].ID, speeds[2].ID})
+	suite.Equal(1, speeds[0].CorrectCount)
+	suite.False(speeds[0].SlowButCorrect)
+	suite.True(speeds[1].SlowButCorrect)
+	suite.False(speeds[2].SlowButCorrect)
+	suite.Equal([]int{2}, SlowItemIDs(answers))
+}
+
+// TestSlowQuota tests the share of a draw reserved for slow items
+func (suite *SpeedTestSuite) TestSlowQuota() {
+	suite.Equal(0, SlowQuota(1))
+	suite.Equal(3, SlowQuota(10))
+	suite.Equal(6, SlowQuota(20))
+}
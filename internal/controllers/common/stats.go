@@ -60,12 +60,14 @@ func practiceCountBoundaries(maxCP int) []int {
 	return append(eus, maxCP+1)
 }
 
-// BuildPracticeCountBuckets partitions counts into dynamically sized histogram
-// buckets. The zero bucket is always separate; remaining buckets use log-spaced
-// nice-number boundaries so the chart stays readable whether the max is 5 or 5000.
-func BuildPracticeCountBuckets(counts []int) []models.PracticeCountBucket {
+// BuildPracticeCountBucketsFromFrequencies partitions practice counts already
+// grouped in SQL into dynamically sized histogram buckets: frequencies maps each
+// practice count to how many items share it. The zero bucket is always separate;
+// remaining buckets use log-spaced nice-number boundaries so the chart stays
+// readable whether the max is 5 or 5000.
+func BuildPracticeCountBucketsFromFrequencies(frequencies map[int]int) []models.PracticeCountBucket {
 	maxCP := 0
-	for _, c := range counts {
+	for c := range frequencies {
 		if c > maxCP {
 			maxCP = c
 		}
@@ -108,10 +110,10 @@ func BuildPracticeCountBuckets(counts []int) []models.PracticeCountBucket {
 	}
 
 	// Count each value into its bucket.
-	for _, c := range counts {
+	for c, n := range frequencies {
 		for i, d := range defs {
 			if (d.hi == -1 && c >= d.lo) || (d.hi >= 0 && c >= d.lo && c <= d.hi) {
-				buckets[i].Count++
+				buckets[i].Count += n
 				break
 			}
 		}
@@ -120,6 +122,11 @@ func BuildPracticeCountBuckets(counts []int) []models.PracticeCountBucket {
 	return buckets
 }
 
+// MaxTrendDays caps the days query parameter of trend endpoints. Trends are
+// aggregated per day in SQL, so this only bounds the zero-filled response
+// size and allows multi-year ranges.
+const MaxTrendDays = 3650
+
 // DailyDateKeys returns `days` ascending "YYYY-MM-DD" date keys, the most
 // recent one being now's calendar day, so trend endpoints can zero-fill days
 // with no activity instead of omitting them from the response.
@@ -131,6 +138,17 @@ func DailyDateKeys(days int, now time.Time) []string {
 	return keys
 }
 
+// TrendWindowStart returns midnight, in now's location, of the oldest day
+// DailyDateKeys(days, now) covers, so trend queries include that whole day.
+func TrendWindowStart(days int, now time.Time) time.Time {
+	first := now.AddDate(0, 0, -(days - 1))
+	return time.Date(first.Year(), first.Month(), first.Day(), 0, 0, 0, 0, now.Location())
+}
+
 // Round1 rounds f to 1 decimal place, used to keep trend/average values
 // stable and readable across controller responses.
 func Round1(f float64) float64 { return math.Round(f*10) / 10 }
+
+// Round2 rounds f to 2 decimal places, for indices in [-1, 1] where one
+// decimal would hide meaningful differences.
+func Round2(f float64) float64 { return math.Round(f*100) / 100 }
@@ -29,11 +29,10 @@ func (suite *StatsTestSuite) TestPracticeCountBoundaries() {
 	suite.Equal([]int{5, 20, 50, 200, 500, 1001}, result)
 }
 
-// TestBuildPracticeCountBuckets tests the BuildPracticeCountBuckets function with a small max value
-// that triggers the individual-integer-step path, verifying both labels and counts.
-func (suite *StatsTestSuite) TestBuildPracticeCountBuckets() {
-	counts := []int{0, 1, 2, 3, 4, 5, 6, 6}
-	result := BuildPracticeCountBuckets(counts)
+// TestBuildPracticeCountBucketsSmallMax tests BuildPracticeCountBucketsFromFrequencies with a small max
+// value that triggers the individual-integer-step path, verifying both labels and counts.
+func (suite *StatsTestSuite) TestBuildPracticeCountBucketsSmallMax() {
+	result := BuildPracticeCountBucketsFromFrequencies(map[int]int{0: 1, 1: 1, 2: 1, 3: 1, 4: 1, 5: 1, 6: 2})
 
 	expected := []models.PracticeCountBucket{
 		{Range: "0", Count: 1},
@@ -47,6 +46,22 @@ func (suite *StatsTestSuite) TestBuildPracticeCountBuckets() {
 	suite.Equal(expected, result)
 }
 
+// TestBuildPracticeCountBucketsFromFrequencies tests that pre-grouped counts
+// are weighted by their frequency.
+func (suite *StatsTestSuite) TestBuildPracticeCountBucketsFromFrequencies() {
+	result := BuildPracticeCountBucketsFromFrequencies(map[int]int{0: 4, 2: 1, 30: 3})
+
+	expected := []models.PracticeCountBucket{
+		{Range: "0", Count: 4},
+		{Range: "1", Count: 0},
+		{Range: "2 ~ 4", Count: 1},
+		{Range: "5 ~ 9", Count: 0},
+		{Range: "10 ~ 19", Count: 0},
+		{Range: "20+", Count: 3},
+	}
+	suite.Equal(expected, result)
+}
+
 // TestDailyDateKeys tests DailyDateKeys with a fixed now, verifying the keys
 // are ascending and end at now's calendar day.
 func (suite *StatsTestSuite) TestDailyDateKeys() {
@@ -64,3 +79,9 @@ func (suite *StatsTestSuite) TestRound1() {
 	suite.Equal(10.0, Round1(10.0))
 	suite.Equal(-2.5, Round1(-2.46))
 }
+
+// TestRound2 tests Round2 across a fractional value and a negative value.
+func (suite *StatsTestSuite) TestRound2() {
+	suite.Equal(0.33, Round2(1.0/3))
+	suite.Equal(-0.67, Round2(-2.0/3))
+}
@@ -0,0 +1,221 @@
+package common
+
+import (
+	"strings"
+	"unicode"
+
+	"golang.org/x/text/runes"
+	"golang.org/x/text/transform"
+	"golang.org/x/text/unicode/norm"
+)
+
+// Match grades returned by GradeTypedAnswer.
+const (
+	MatchCorrect   = "correct"
+	MatchNearMiss  = "near_miss"
+	MatchIncorrect = "incorrect"
+)
+
+// TypedAnswerGrade is the outcome of comparing a learner's typed answer
+// against a set of accepted spellings. Distance is the edit distance
+// to the closest accepted spelling after normalisation (0 when correct).
+type TypedAnswerGrade struct {
+	Result   string
+	Distance int
+	Closest  string
+}
+
+// IsCorrect reports whether the grade counts as a correct answer. A near
+// miss is surfaced to the learner but still logged as incorrect.
+func (g TypedAnswerGrade) IsCorrect() bool {
+	return g.Result == MatchCorrect
+}
+
+// NormalizeAnswer folds text into the form typed answers are compared in:
+// diacritics stripped (café -> cafe), lower-cased, curly apostrophes
+// straightened, and surrounding/repeated whitespace collapsed.
+func NormalizeAnswer(s string) string {
+	folded, _, err := transform.String(transform.Chain(norm.NFD, runes.Remove(runes.In(unicode.Mn)), norm.NFC), s)
+	if err != nil {
+		folded = s
+	}
+	folded = strings.NewReplacer("’", "'", "‘", "'").Replace(folded)
+	return strings.Join(strings.Fields(strings.ToLower(folded)), " ")
+}
+
+// EditDistance returns the Levenshtein distance between a and b, counted in
+// runes, with a swap of two adjacent letters (teh -> the) counted as a
+// single edit (optimal string alignment) since that is the most common
+// typing slip.
+func EditDistance(a, b string) int {
+	ra, rb := []rune(a), []rune(b)
+	if len(ra) == 0 {
+		return len(rb)
+	}
+	if len(rb) == 0 {
+		return len(ra)
+	}
+
+	d := make([][]int, len(ra)+1)
+	for i := range d {
+		d[i] = make([]int, len(rb)+1)
+		d[i][0] = i
+	}
+	for j := range d[0] {
+		d[0][j] = j
+	}
+	for i := 1; i <= len(ra); i++ {
+		for j := 1; j <= len(rb); j++ {
+			cost := 1
+			if ra[i-1] == rb[j-1] {
+				cost = 0
+			}
+			d[i][j] = min(d[i-1][j]+1, d[i][j-1]+1, d[i-1][j-1]+cost)
+			if i > 1 && j > 1 && ra[i-1] == rb[j-2] && ra[i-2] == rb[j-1] {
+				d[i][j] = min(d[i][j], d[i-2][j-2]+1)
+			}
+		}
+	}
+	return d[len(ra)][len(rb)]
+}
+
+// NearMissThreshold is the largest edit distance still treated as a near
+// miss for an answer of the given length: one typo for short words, two
+// once the word is long enough that a double slip is plausible.
+func NearMissThreshold(length int) int {
+	if length <= 6 {
+		return 1
+	}
+	return 2
+}
+
+// AcceptedSpellings expands a canonical answer into the spellings a learner
+// may legitimately type for it: hyphenated and spaced compounds written
+// either way, and the common British/American suffix variants. The
+// canonical form (normalised) is always the first element.
+func AcceptedSpellings(answer string) []string {
+	base := NormalizeAnswer(answer)
+	if base == "" {
+		return nil
+	}
+
+	seen := map[string]bool{}
+	var out []string
+	add := func(s string) {
+		if s != "" && !seen[s] {
+			seen[s] = true
+			out = append(out, s)
+		}
+	}
+
+	add(base)
+	if strings.ContainsAny(base, "- ") {
+		add(strings.ReplaceAll(base, "-", " "))
+		add(strings.ReplaceAll(base, " ", "-"))
+		add(strings.NewReplacer("-", "", " ", "").Replace(base))
+	}
+	for _, s := range append([]string(nil), out...) {
+		for _, v := range regionalVariants(s) {
+			add(v)
+		}
+	}
+	return out
+}
+
+// regionalSuffixPairs lists British/American spelling pairs matched at the
+// end of a word (optionally followed by a common inflection).
+var regionalSuffixPairs = [][2]string{
+	{"our", "or"},
+	{"ise", "ize"},
+	{"yse", "yze"},
+	{"tre", "ter"},
+	{"ogue", "og"},
+}
+
+// regionalInflections are the endings that may follow a regional suffix
+// (colours, organised, analysing) without changing which variant it is.
+var regionalInflections = []string{"", "s", "d", "r", "rs", "ed", "ing", "ation", "ations"}
+
+// regionalVariants returns the British/American counterparts of s, if any.
+func regionalVariants(s string) []string {
+	var out []string
+	for _, pair := range regionalSuffixPairs {
+		for _, from := range []string{pair[0], pair[1]} {
+			to := pair[0]
+			if from == pair[0] {
+				to = pair[1]
+			}
+			for _, infl := range regionalInflections {
+				if v, ok := swapSuffix(s, from, to, infl); ok {
+					out = append(out, v)
+				}
+			}
+		}
+	}
+	return out
+}
+
+// swapSuffix replaces from with to when s ends in from+infl. "ise"/"ize"
+// style suffixes drop their trailing "e" before vowel-initial inflections
+// (organising), so both spellings of the stem are tried.
+func swapSuffix(s, from, to, infl string) (string, bool) {
+	try := func(f, t string) (string, bool) {
+		end := f + infl
+		if len(s) <= len(end)+1 || !strings.HasSuffix(s, end) {
+			return "", false
+		}
+		return s[:len(s)-len(end)] + t + infl, true
+	}
+	if v, ok := try(from, to); ok {
+		return v, true
+	}
+	if strings.HasSuffix(from, "e") && strings.HasSuffix(to, "e") && infl != "" && strings.ContainsAny(infl[:1], "aeiou") {
+		return try(strings.TrimSuffix(from, "e"), strings.TrimSuffix(to, "e"))
+	}
+	return "", false
+}
+
+// GradeTypedAnswer compares a typed answer with every accepted spelling and
+// classifies it as correct, a near miss (within NearMissThreshold edits of
+// the closest accepted spelling) or incorrect. An empty answer is always
+// incorrect.
+func GradeTypedAnswer(typed string, accepted []string) TypedAnswerGrade {
+	answer := NormalizeAnswer(typed)
+	grade := TypedAnswerGrade{Result: MatchIncorrect, Distance: -1}
+	if len(accepted) == 0 {
+		return grade
+	}
+
+	for _, candidate := range accepted {
+		d := EditDistance(answer, NormalizeAnswer(candidate))
+		if grade.Distance < 0 || d < grade.Distance {
+			grade.Distance = d
+			grade.Closest = candidate
+		}
+	}
+
+	switch {
+	case answer == "":
+		grade.Result = MatchIncorrect
+	case grade.Distance == 0:
+		grade.Result = MatchCorrect
+	case grade.Distance <= NearMissThreshold(len([]rune(NormalizeAnswer(grade.Closest)))):
+		grade.Result = MatchNearMiss
+	}
+	return grade
+}
+
+// WordBlank replaces the word wherever it is hidden from a prompt.
+const WordBlank = "____"
+
+// MaskWord hides every occurrence of word in text, including its regular
+// inflected forms (apples, cherries, used; see Inflect), so a definition or
+// example sentence never reveals the answer to a prompt. Other words that
+// merely start with it (car in career) are left alone.
+func MaskWord(text, word string) string {
+	re, _ := inflectionPattern(word)
+	if re == nil {
+		return text
+	}
+	return re.ReplaceAllString(text, WordBlank)
+}
@@ -0,0 +1,114 @@
+package common
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+)
+
+// TextMatchTestSuite is a test suite for typed-answer grading helpers
+type TextMatchTestSuite struct {
+	suite.Suite
+}
+
+// TestTextMatchTestSuite runs the TextMatchTestSuite
+func TestTextMatchTestSuite(t *testing.T) {
+	suite.Run(t, new(TextMatchTestSuite))
+}
+
+// TestNormalizeAnswer tests case, diacritic, apostrophe and whitespace folding.
+func (suite *TextMatchTestSuite) TestNormalizeAnswer() {
+	suite.Equal("cafe", NormalizeAnswer("  Café "))
+	suite.Equal("naive resume", NormalizeAnswer("Naïve   RÉSUMÉ"))
+	suite.Equal("don't", NormalizeAnswer("don’t"))
+	suite.Equal("", NormalizeAnswer("   "))
+}
+
+// TestEditDistance tests edit distances including transpositions, empty and multi-byte inputs.
+func (suite *TextMatchTestSuite) TestEditDistance() {
+	suite.Equal(0, EditDistance("apple", "apple"))
+	suite.Equal(1, EditDistance("aple", "apple"))
+	suite.Equal(1, EditDistance("appel", "apple"))
+	suite.Equal(3, EditDistance("kitten", "sitting"))
+	suite.Equal(5, EditDistance("", "apple"))
+	suite.Equal(1, EditDistance("café", "cafe"))
+}
+
+// TestAcceptedSpellings tests compound and British/American variant expansion.
+func (suite *TextMatchTestSuite) TestAcceptedSpellings() {
+	suite.Equal([]string{"colour", "color"}, AcceptedSpellings("Colour"))
+	suite.Contains(AcceptedSpellings("organise"), "organize")
+	suite.Contains(AcceptedSpellings("organising"), "organizing")
+	suite.Contains(AcceptedSpellings("centre"), "center")
+	suite.ElementsMatch([]string{"e-mail", "e mail", "email"}, AcceptedSpellings("e-mail"))
+	suite.Equal([]string{"four"}, AcceptedSpellings("four"))
+	suite.Nil(AcceptedSpellings(" "))
+}
+
+// TestGradeTypedAnswer tests correct, near-miss and incorrect classification.
+func (suite *TextMatchTestSuite) TestGradeTypedAnswer() {
+	accepted := AcceptedSpellings("necessary")
+
+	testCases := []struct {
+		name     string
+		typed    string
+		result   string
+		distance int
+	}{
+		{"exact", "necessary", MatchCorrect, 0},
+		{"case and spaces", "  NECESSARY ", MatchCorrect, 0},
+		{"one typo", "neccessary", MatchNearMiss, 1},
+		{"two typos", "neccesary", MatchNearMiss, 2},
+		{"wrong word", "needed", MatchIncorrect, 6},
+		{"empty", "", MatchIncorrect, 9},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			grade := GradeTypedAnswer(tc.typed, accepted)
+			suite.Equal(tc.result, grade.Result)
+			suite.Equal(tc.distance, grade.Distance)
+			suite.Equal(tc.result == MatchCorrect, grade.IsCorrect())
+		})
+	}
+}
+
+// TestGradeTypedAnswerVariant tests that a regional variant grades as correct.
+func (suite *TextMatchTestSuite) TestGradeTypedAnswerVariant() {
+	grade := GradeTypedAnswer("color", AcceptedSpellings("colour"))
+	suite.Equal(MatchCorrect, grade.Result)
+	suite.Equal("color", grade.Closest)
+}
+
+// TestGradeTypedAnswerNoAccepted tests grading with nothing to compare against.
+func (suite *TextMatchTestSuite) TestGradeTypedAnswerNoAccepted() {
+	grade := GradeTypedAnswer("anything", nil)
+	suite.Equal(MatchIncorrect, grade.Result)
+	suite.Equal(-1, grade.Distance)
+}
+
+// TestMaskWord tests that the word and its inflected forms are masked in prompt text
+func (suite *TextMatchTestSuite) TestMaskWord() {
+	testCases := []struct {
+		name     string
+		text     string
+		word     string
+		expected string
+	}{
+		{"exact match", "I ate an apple.", "apple", "I ate an ____."},
+		{"case insensitive plural", "Apples are red.", "apple", "____ are red."},
+		{"trailing y inflection", "She picked cherries.", "cherry", "She picked ____."},
+		{"short word keeps its stem", "We used it.", "use", "We ____ it."},
+		{"no partial match inside another word", "pineapple juice", "apple", "pineapple juice"},
+		{"no match on a longer word sharing the stem", "A career in cars.", "car", "A career in ____."},
+		{"doubled consonant inflection", "He was running late.", "run", "He was ____ late."},
+		{"phrase inflects its first word", "She looked up the word.", "look up", "She ____ the word."},
+		{"empty word", "anything", " ", "anything"},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.Equal(tc.expected, MaskWord(tc.text, tc.word))
+		})
+	}
+}
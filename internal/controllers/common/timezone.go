@@ -5,38 +5,147 @@ package common
 
 import (
 	"fmt"
+	"log/slog"
+	"strings"
+	"sync"
+	"sync/atomic"
 	"time"
+
+	"word-flashcard/utils/config"
+
+	"github.com/gin-gonic/gin"
+)
+
+const (
+	// DefaultReportTimeZone is the IANA timezone name used to bucket calendar
+	// days for trend/report endpoints when REPORT_TIMEZONE is unset or invalid.
+	DefaultReportTimeZone = "Asia/Taipei"
+
+	// ReportTimeZoneQueryParam and ReportTimeZoneHeader let a single request
+	// override REPORT_TIMEZONE with the viewer's own IANA timezone name; the
+	// query parameter wins when both are present.
+	ReportTimeZoneQueryParam = "tz"
+	ReportTimeZoneHeader     = "X-Timezone"
 )
 
-// ReportTimeZone is the single IANA timezone name used to bucket calendar
-// days for trend/report endpoints (e.g. daily trend charts). Changing the
-// reporting timezone in the future only requires updating this constant.
-const ReportTimeZone = "Asia/Taipei"
+// locations caches resolved *time.Location values by name, since every
+// report request resolves one and time.LoadLocation re-reads the tz database.
+var locations sync.Map
+
+// reportTimezoneSource is the stored timezone ReportLocation consults, once
+// UseReportTimezoneSource has been called
+var reportTimezoneSource atomic.Pointer[ReportTimezoneSource]
 
-// reportLocation is resolved once at package init since ReportTimeZone is a
-// fixed constant; a failure here means the timezone database is unavailable
-// in the running environment, which is a startup-time configuration problem
-// rather than something a single request can recover from.
-var reportLocation = mustLoadLocation(ReportTimeZone)
+// ReportTimezoneSource is a reporting timezone the learner stored, which
+// takes precedence over REPORT_TIMEZONE (see reporttz.ReportTimezoneSetting).
+// Name returns "" when none is stored.
+type ReportTimezoneSource interface {
+	Name() (string, error)
+}
+
+// UseReportTimezoneSource makes ReportLocation prefer source over
+// REPORT_TIMEZONE; nil goes back to REPORT_TIMEZONE alone
+func UseReportTimezoneSource(source ReportTimezoneSource) {
+	if source == nil {
+		reportTimezoneSource.Store(nil)
+		return
+	}
+	reportTimezoneSource.Store(&source)
+}
+
+// ValidateReportTimezone checks name is "" (no stored timezone) or an IANA
+// timezone LoadReportLocation accepts
+func ValidateReportTimezone(name string) error {
+	if strings.TrimSpace(name) == "" {
+		return nil
+	}
+	if _, err := LoadReportLocation(name); err != nil {
+		return NewFieldError("timezone must be an IANA timezone such as Europe/Berlin", "timezone", name, "error", err)
+	}
+	return nil
+}
+
+// LoadReportLocation resolves an IANA timezone name such as "Europe/Berlin".
+// "Local" is rejected because it names the server process's timezone, which
+// means nothing to the client asking for it.
+func LoadReportLocation(name string) (*time.Location, error) {
+	name = strings.TrimSpace(name)
+	if cached, ok := locations.Load(name); ok {
+		return cached.(*time.Location), nil
+	}
+	if name == "" || name == "Local" {
+		return nil, fmt.Errorf("invalid timezone %q", name)
+	}
 
-func mustLoadLocation(name string) *time.Location {
 	loc, err := time.LoadLocation(name)
 	if err != nil {
-		panic(fmt.Sprintf("common: failed to load timezone %q: %v", name, err))
+		return nil, fmt.Errorf("invalid timezone %q: %w", name, err)
+	}
+	locations.Store(name, loc)
+	return loc, nil
+}
+
+// ReportLocation returns the configured reporting timezone: the stored
+// report timezone setting, else REPORT_TIMEZONE, else DefaultReportTimeZone.
+// It is for work that isn't tied to a request such as scheduled backups;
+// handlers should use RequestReportLocation instead.
+func ReportLocation() *time.Location {
+	if source := reportTimezoneSource.Load(); source != nil {
+		name, err := (*source).Name()
+		if err != nil {
+			slog.Warn("Failed to read the report timezone setting, falling back to REPORT_TIMEZONE", "error", err)
+		}
+		if name != "" {
+			loc, err := LoadReportLocation(name)
+			if err == nil {
+				return loc
+			}
+			slog.Warn("Invalid stored report timezone, falling back to REPORT_TIMEZONE", "timezone", name, "error", err)
+		}
+	}
+
+	name := config.GetOrDefault("REPORT_TIMEZONE", DefaultReportTimeZone)
+	loc, err := LoadReportLocation(name)
+	if err == nil {
+		return loc
+	}
+
+	slog.Warn("Invalid REPORT_TIMEZONE, falling back to the default", "timezone", name, "default", DefaultReportTimeZone, "error", err)
+	loc, err = LoadReportLocation(DefaultReportTimeZone)
+	if err != nil {
+		// The timezone database itself is unavailable in the running
+		// environment, a startup-time configuration problem rather than
+		// something a single request can recover from.
+		panic(fmt.Sprintf("common: failed to load timezone %q: %v", DefaultReportTimeZone, err))
 	}
 	return loc
 }
 
-// NowInReportTimeZone returns the current time in ReportTimeZone, so trend
+// RequestReportLocation returns the reporting timezone for the request: the
+// tz query parameter, else the X-Timezone header, else ReportLocation (the
+// stored setting, else REPORT_TIMEZONE). An override that isn't a valid IANA
+// timezone name is an error rather than a silent fallback, so the caller can
+// answer 400 instead of wrong day buckets.
+func RequestReportLocation(c *gin.Context) (*time.Location, error) {
+	if name := c.Query(ReportTimeZoneQueryParam); name != "" {
+		return LoadReportLocation(name)
+	}
+	if name := c.GetHeader(ReportTimeZoneHeader); name != "" {
+		return LoadReportLocation(name)
+	}
+	return ReportLocation(), nil
+}
+
+// NowInReportTimeZone returns the current time in ReportLocation, so trend
 // endpoints compute "today" using the same calendar day report viewers see,
 // regardless of the timezone the server process itself runs in.
 func NowInReportTimeZone() time.Time {
-	return time.Now().In(reportLocation)
+	return time.Now().In(ReportLocation())
 }
 
-// ReportDateKey formats t as a "YYYY-MM-DD" key in ReportTimeZone, so daily
+// ReportDateKey formats t as a "YYYY-MM-DD" key in ReportLocation, so daily
 // aggregation buckets by the calendar day report viewers expect instead of
 // whichever timezone t happens to be stored/parsed in (e.g. UTC).
 func ReportDateKey(t time.Time) string {
-	return t.In(reportLocation).Format("2006-01-02")
+	return t.In(ReportLocation()).Format("2006-01-02")
 }
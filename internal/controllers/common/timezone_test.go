@@ -1,13 +1,17 @@
 package common
 
 import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
 	"testing"
 	"time"
 
+	"github.com/gin-gonic/gin"
 	"github.com/stretchr/testify/suite"
 )
 
-// TimezoneTestSuite is a test suite for the ReportTimeZone helper functions.
+// TimezoneTestSuite is a test suite for the reporting timezone helper functions.
 type TimezoneTestSuite struct {
 	suite.Suite
 }
@@ -18,11 +22,12 @@ func TestTimezoneTestSuite(t *testing.T) {
 }
 
 // TestNowInReportTimeZone tests that NowInReportTimeZone returns the current
-// instant located in whatever time.LoadLocation(ReportTimeZone) resolves to,
-// rather than a hardcoded zone name, so it stays correct if ReportTimeZone is
-// ever repointed at a different zone.
+// instant located in whatever time.LoadLocation(DefaultReportTimeZone)
+// resolves to when REPORT_TIMEZONE is unset, rather than a hardcoded zone
+// name, so it stays correct if the default is ever repointed.
 func (suite *TimezoneTestSuite) TestNowInReportTimeZone() {
-	loc, err := time.LoadLocation(ReportTimeZone)
+	suite.T().Setenv("REPORT_TIMEZONE", "")
+	loc, err := time.LoadLocation(DefaultReportTimeZone)
 	suite.Require().NoError(err)
 
 	before := time.Now().In(loc)
@@ -35,10 +40,13 @@ func (suite *TimezoneTestSuite) TestNowInReportTimeZone() {
 }
 
 // TestReportDateKey tests ReportDateKey across a timestamp that lands on the
-// same calendar day in both UTC and ReportTimeZone, and timestamps straddling
-// the UTC/ReportTimeZone day boundary -- the exact discrepancy that caused
-// trend charts to bucket a practice log into the wrong day.
+// same calendar day in both UTC and DefaultReportTimeZone, and timestamps
+// straddling the UTC/DefaultReportTimeZone day boundary -- the exact
+// discrepancy that caused trend charts to bucket a practice log into the
+// wrong day.
 func (suite *TimezoneTestSuite) TestReportDateKey() {
+	suite.T().Setenv("REPORT_TIMEZONE", "")
+
 	tests := []struct {
 		name     string
 		input    time.Time
@@ -67,3 +75,111 @@ func (suite *TimezoneTestSuite) TestReportDateKey() {
 		})
 	}
 }
+
+// TestReportLocation tests that REPORT_TIMEZONE configures the reporting
+// timezone and that an invalid value falls back to DefaultReportTimeZone.
+func (suite *TimezoneTestSuite) TestReportLocation() {
+	tests := []struct {
+		name     string
+		env      string
+		expected string
+	}{
+		{name: "unset uses the default", env: "", expected: DefaultReportTimeZone},
+		{name: "configured timezone", env: "Europe/Berlin", expected: "Europe/Berlin"},
+		{name: "invalid timezone falls back to the default", env: "Mars/Olympus_Mons", expected: DefaultReportTimeZone},
+	}
+
+	for _, tt := range tests {
+		suite.Run(tt.name, func() {
+			suite.T().Setenv("REPORT_TIMEZONE", tt.env)
+			suite.Equal(tt.expected, ReportLocation().String())
+		})
+	}
+}
+
+// TestRequestReportLocation tests that the tz query parameter overrides the
+// X-Timezone header, which overrides REPORT_TIMEZONE, and that an invalid
+// override is reported as an error instead of falling back.
+func (suite *TimezoneTestSuite) TestRequestReportLocation() {
+	tests := []struct {
+		name     string
+		query    string
+		header   string
+		expected string
+		wantErr  bool
+	}{
+		{name: "no override uses REPORT_TIMEZONE", expected: "Europe/Berlin"},
+		{name: "header overrides config", header: "America/New_York", expected: "America/New_York"},
+		{name: "query overrides header", query: "Asia/Tokyo", header: "America/New_York", expected: "Asia/Tokyo"},
+		{name: "invalid query", query: "Not/AZone", wantErr: true},
+		{name: "server local timezone is rejected", header: "Local", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		suite.Run(tt.name, func() {
+			suite.T().Setenv("REPORT_TIMEZONE", "Europe/Berlin")
+			ctx, _ := gin.CreateTestContext(httptest.NewRecorder())
+			ctx.Request = httptest.NewRequest(http.MethodGet, "/api/words/trend", nil)
+			if tt.query != "" {
+				ctx.Request.URL.RawQuery = ReportTimeZoneQueryParam + "=" + tt.query
+			}
+			if tt.header != "" {
+				ctx.Request.Header.Set(ReportTimeZoneHeader, tt.header)
+			}
+
+			loc, err := RequestReportLocation(ctx)
+
+			if tt.wantErr {
+				suite.Error(err)
+				return
+			}
+			suite.Require().NoError(err)
+			suite.Equal(tt.expected, loc.String())
+		})
+	}
+}
+
+// stubTimezoneSource is a ReportTimezoneSource returning a fixed name or error
+type stubTimezoneSource struct {
+	name string
+	err  error
+}
+
+// Name returns the stub's name and error
+func (s stubTimezoneSource) Name() (string, error) {
+	return s.name, s.err
+}
+
+// TestReportLocationStoredSetting tests that a stored report timezone takes
+// precedence over REPORT_TIMEZONE, and that an empty, invalid or unreadable
+// one falls back to it
+func (suite *TimezoneTestSuite) TestReportLocationStoredSetting() {
+	tests := []struct {
+		name     string
+		source   stubTimezoneSource
+		expected string
+	}{
+		{name: "stored timezone wins", source: stubTimezoneSource{name: "Asia/Tokyo"}, expected: "Asia/Tokyo"},
+		{name: "nothing stored uses REPORT_TIMEZONE", expected: "Europe/Berlin"},
+		{name: "invalid stored timezone uses REPORT_TIMEZONE", source: stubTimezoneSource{name: "Mars/Olympus_Mons"}, expected: "Europe/Berlin"},
+		{name: "database error uses REPORT_TIMEZONE", source: stubTimezoneSource{err: errors.New("database error")}, expected: "Europe/Berlin"},
+	}
+
+	for _, tt := range tests {
+		suite.Run(tt.name, func() {
+			suite.T().Setenv("REPORT_TIMEZONE", "Europe/Berlin")
+			UseReportTimezoneSource(tt.source)
+			defer UseReportTimezoneSource(nil)
+
+			suite.Equal(tt.expected, ReportLocation().String())
+		})
+	}
+}
+
+// TestValidateReportTimezone tests that only "" and IANA timezones are accepted
+func (suite *TimezoneTestSuite) TestValidateReportTimezone() {
+	suite.NoError(ValidateReportTimezone(""))
+	suite.NoError(ValidateReportTimezone("Europe/Berlin"))
+	suite.Error(ValidateReportTimezone("Mars/Olympus_Mons"))
+	suite.Error(ValidateReportTimezone("Local"))
+}
@@ -0,0 +1,155 @@
+package dictionary
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"word-flashcard/internal/models"
+)
+
+// Defaults for batch lookups: at most 500 words per request looked up by 4
+// workers, at most one request every 500ms to the same host, and up to 3
+// retries of a transient failure, waiting 500ms, 1s and then 2s
+const (
+	defaultBatchMaxWords       = 500
+	defaultBatchConcurrency    = 4
+	defaultBatchHostIntervalMS = 500
+	defaultBatchMaxRetries     = 3
+	defaultBatchBackoffMS      = 500
+)
+
+// hostLimiter spaces out requests to the same host by at least interval. It
+// is shared by every batch, so concurrent batches don't add up to more
+// traffic than one would send.
+type hostLimiter struct {
+	mu       sync.Mutex
+	interval time.Duration
+	next     map[string]time.Time
+}
+
+// newHostLimiter creates a hostLimiter allowing one request per interval per host
+func newHostLimiter(interval time.Duration) *hostLimiter {
+	return &hostLimiter{interval: interval, next: make(map[string]time.Time)}
+}
+
+// wait blocks until a request to host may be sent, reserving that slot. An
+// empty host is never limited.
+func (l *hostLimiter) wait(ctx context.Context, host string) error {
+	if host == "" || l.interval <= 0 {
+		return nil
+	}
+
+	l.mu.Lock()
+	slot := time.Now()
+	if next := l.next[host]; next.After(slot) {
+		slot = next
+	}
+	l.next[host] = slot.Add(l.interval)
+	l.mu.Unlock()
+
+	return sleepContext(ctx, time.Until(slot))
+}
+
+// sleepContext waits for d, returning early with the context's error when
+// ctx is done first
+func sleepContext(ctx context.Context, d time.Duration) error {
+	if d <= 0 {
+		return ctx.Err()
+	}
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
+
+// fetchWithRetry asks provider for word, waiting for the provider's host to
+// be free before every attempt and retrying transient failures with
+// exponential backoff. attempts is increased once per request sent.
+func (dc *Controller) fetchWithRetry(ctx context.Context, provider DictionaryProvider, word, language string, attempts *int) (*models.DictionaryResult, error) {
+	host := providerHost(provider)
+	for retry := 0; ; retry++ {
+		if err := dc.hostLimiter.wait(ctx, host); err != nil {
+			return nil, fmt.Errorf("%w: %v", errUpstreamUnavailable, err)
+		}
+
+		*attempts++
+		result, err := provider.Lookup(word, language)
+		if err == nil || !errors.Is(err, errTransient) || retry >= dc.batchMaxRetries {
+			return result, err
+		}
+
+		if err := sleepContext(ctx, dc.batchBackoff<<retry); err != nil {
+			return nil, fmt.Errorf("%w: %v", errUpstreamUnavailable, err)
+		}
+	}
+}
+
+// lookupBatchWord looks up one word of a batch and, when create is set, adds
+// it to the word list. The returned progress has everything but the counters
+// filled in.
+func (dc *Controller) lookupBatchWord(ctx context.Context, index int, word, language string, providerNames []string, create bool) models.DictionaryBatchProgress {
+	progress := models.DictionaryBatchProgress{
+		Type:  models.DictionaryBatchEventProgress,
+		Index: index,
+		Word:  word,
+	}
+
+	result, err := dc.lookupWith(word, language, providerNames, func(provider DictionaryProvider) (*models.DictionaryResult, error) {
+		return dc.fetchWithRetry(ctx, provider, word, language, &progress.Attempts)
+	})
+	switch {
+	case errors.Is(err, errWordNotFound):
+		progress.Status = models.DictionaryBatchNotFound
+		return progress
+	case errors.Is(err, errUnsupportedLanguage):
+		progress.Status = models.DictionaryBatchFailed
+		progress.Error = fmt.Sprintf("Unsupported language '%s'", language)
+		return progress
+	case err != nil:
+		progress.Status = models.DictionaryBatchFailed
+		progress.Error = "Dictionary service is currently unavailable"
+		return progress
+	}
+
+	progress.Status = models.DictionaryBatchFound
+	progress.Result = result
+	if !create {
+		return progress
+	}
+
+	wordID, created, err := dc.createWord(word, result)
+	if err != nil {
+		progress.Status = models.DictionaryBatchFailed
+		progress.Error = "Failed to insert data into database"
+		return progress
+	}
+	progress.WordID = &wordID
+	if created {
+		progress.Status = models.DictionaryBatchCreated
+	} else {
+		progress.Status = models.DictionaryBatchExisting
+	}
+	return progress
+}
+
+// createWord adds word to the word list with the definitions of its lookup
+// result. A word already in the list is left untouched and its ID returned
+// with created false.
+func (dc *Controller) createWord(word string, result *models.DictionaryResult) (int, bool, error) {
+	if wordID, err := dc.findWordID(word); err != nil || wordID != 0 {
+		return wordID, false, err
+	}
+
+	saved, err := dc.saveWord(word, result)
+	if err != nil {
+		return 0, false, err
+	}
+	return int(saved.WordID), saved.Created, nil
+}
@@ -1,42 +1,134 @@
 package dictionary
 
-import "time"
+import (
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"time"
 
-// getFromCache retrieves data from the cache
-func (dc *Controller) getFromCache(key string) interface{} {
-	dc.cacheMutex.RLock()
-	defer dc.cacheMutex.RUnlock()
+	dbModels "word-flashcard/data/models"
+	"word-flashcard/data/schema"
+	"word-flashcard/internal/models"
+	"word-flashcard/utils"
 
-	entry, exists := dc.cache[key]
-	if !exists {
+	"github.com/Masterminds/squirrel"
+)
+
+// isCached reports whether results of a provider go through the lookup
+// cache. Offline providers already answer from the database, so only online
+// ones are cached.
+func isCached(provider DictionaryProvider) bool {
+	_, offline := provider.(*offlineProvider)
+	return !offline
+}
+
+// cacheWhere matches the cached result of a provider for a word in language
+func cacheWhere(provider, language, word string) squirrel.Eq {
+	return squirrel.Eq{
+		schema.DICTIONARY_CACHE_PROVIDER:        provider,
+		schema.DICTIONARY_CACHE_LANGUAGE:        language,
+		schema.DICTIONARY_CACHE_NORMALIZED_WORD: normalizeHeadword(word),
+	}
+}
+
+// getFromCache returns the cached result of a provider for a word, or nil
+// when there is none or it has expired. A hit is counted and marks the entry
+// as recently used. Cache failures are logged and treated as misses, so a
+// broken cache never breaks lookups.
+func (dc *Controller) getFromCache(provider, language, word string) *models.DictionaryResult {
+	limit := uint64(1)
+	entries, err := dc.dictionaryCachePeer.Select([]*string{}, cacheWhere(provider, language, word), nil, &limit, nil)
+	if err != nil {
+		slog.Warn("Failed to read dictionary cache", "provider", provider, "word", word, "error", err)
 		return nil
 	}
+	if len(entries) == 0 || entries[0].Id == nil {
+		return nil
+	}
+	entry := entries[0]
+	where := squirrel.Eq{schema.DICTIONARY_CACHE_ID: *entry.Id}
 
-	if time.Since(entry.Timestamp) > dc.cacheTTL {
-		delete(dc.cache, key)
+	var result models.DictionaryResult
+	if entry.Entry == nil || entry.CachedAt == nil || time.Since(*entry.CachedAt) > dc.cacheTTL ||
+		json.Unmarshal([]byte(*entry.Entry), &result) != nil {
+		if _, err := dc.dictionaryCachePeer.Delete(where); err != nil {
+			slog.Warn("Failed to drop stale dictionary cache entry", "id", *entry.Id, "error", err)
+		}
 		return nil
 	}
 
-	return entry.Data
+	hits := 1
+	if entry.Hits != nil {
+		hits += *entry.Hits
+	}
+	now := time.Now().UTC()
+	if _, err := dc.dictionaryCachePeer.Update(&dbModels.DictionaryCacheEntry{Hits: &hits, LastAccessedAt: &now}, where); err != nil {
+		slog.Warn("Failed to record dictionary cache hit", "id", *entry.Id, "error", err)
+	}
+	return &result
 }
 
-// setCache stores data in the cache
-func (dc *Controller) setCache(key string, data interface{}) {
-	dc.cacheMutex.Lock()
-	defer dc.cacheMutex.Unlock()
+// setCache stores the result of a provider for a word, replacing any
+// earlier one, then evicts the least recently used entries beyond the
+// configured maximum
+func (dc *Controller) setCache(provider, language, word string, result *models.DictionaryResult) {
+	encoded, err := json.Marshal(result)
+	if err != nil {
+		slog.Warn("Failed to encode dictionary cache entry", "provider", provider, "word", word, "error", err)
+		return
+	}
 
-	dc.cache[key] = CacheEntry{
-		Data:      data,
-		Timestamp: time.Now(),
+	now := time.Now().UTC()
+	entry := &dbModels.DictionaryCacheEntry{
+		Provider:       utils.StrPtr(provider),
+		Language:       utils.StrPtr(language),
+		NormalizedWord: utils.StrPtr(normalizeHeadword(word)),
+		Entry:          utils.StrPtr(string(encoded)),
+		CachedAt:       &now,
+		LastAccessedAt: &now,
 	}
+	if _, err := dc.dictionaryCachePeer.Insert(entry); err != nil {
+		// Another request cached the word first
+		refresh := &dbModels.DictionaryCacheEntry{Entry: entry.Entry, CachedAt: &now, LastAccessedAt: &now}
+		if _, err := dc.dictionaryCachePeer.Update(refresh, cacheWhere(provider, language, word)); err != nil {
+			slog.Warn("Failed to write dictionary cache entry", "provider", provider, "word", word, "error", err)
+			return
+		}
+	}
+
+	dc.evictCache()
+}
 
-	// Clean up old cache entries if cache size exceeds 1000
-	if len(dc.cache) > 1000 {
-		now := time.Now()
-		for k, v := range dc.cache {
-			if now.Sub(v.Timestamp) > dc.cacheTTL {
-				delete(dc.cache, k)
-			}
+// evictCache deletes the least recently used entries beyond cacheMaxEntries.
+// A maximum of zero or less leaves the cache unbounded.
+func (dc *Controller) evictCache() {
+	if dc.cacheMaxEntries <= 0 {
+		return
+	}
+	count, err := dc.dictionaryCachePeer.Count(squirrel.And{})
+	if err != nil || count <= int64(dc.cacheMaxEntries) {
+		return
+	}
+
+	excess := uint64(count) - uint64(dc.cacheMaxEntries)
+	columns := []*string{utils.StrPtr(schema.DICTIONARY_CACHE_ID)}
+	orderBy := fmt.Sprintf("%s ASC", schema.DICTIONARY_CACHE_LAST_ACCESSED_AT)
+	entries, err := dc.dictionaryCachePeer.Select(columns, squirrel.And{}, []*string{&orderBy}, &excess, nil)
+	if err != nil {
+		slog.Warn("Failed to find dictionary cache entries to evict", "error", err)
+		return
+	}
+
+	ids := make([]int, 0, len(entries))
+	for _, entry := range entries {
+		if entry.Id != nil {
+			ids = append(ids, *entry.Id)
 		}
 	}
+	if len(ids) == 0 {
+		return
+	}
+	if _, err := dc.dictionaryCachePeer.Delete(squirrel.Eq{schema.DICTIONARY_CACHE_ID: ids}); err != nil {
+		slog.Warn("Failed to evict dictionary cache entries", "count", len(ids), "error", err)
+	}
 }
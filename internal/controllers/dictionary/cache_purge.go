@@ -0,0 +1,71 @@
+package dictionary
+
+import (
+	"net/http"
+	"time"
+
+	"word-flashcard/data/schema"
+	"word-flashcard/internal/controllers/common"
+	"word-flashcard/internal/models"
+
+	"github.com/Masterminds/squirrel"
+	"github.com/gin-gonic/gin"
+)
+
+// PurgeCache handles dictionary cache purge requests
+// @Summary Purge the dictionary cache
+// @Description Deletes cached lookup results so the next lookup fetches them again. Without filters the whole cache is purged; the
+// @Description filters narrow it to one provider, language or word, or to entries that have already expired.
+// @Tags dictionary
+// @Produce json
+// @Param provider query string false "Only purge entries of this provider"
+// @Param language query string false "Only purge entries of this language slug"
+// @Param word query string false "Only purge entries of this word"
+// @Param expired query bool false "Only purge entries older than the cache TTL"
+// @Success 200 {object} models.DictionaryCachePurgeResult "Number of entries purged"
+// @Failure 400 {object} models.ErrorResponse "Bad request - Unknown provider or invalid expired parameter"
+// @Failure 500 {object} models.ErrorResponse "Internal server error - Failed to delete data from database"
+// @Router /api/dictionary/cache [delete]
+func (dc *Controller) PurgeCache(c *gin.Context) {
+	// ================ 1. Build filter ================
+	where := squirrel.And{}
+	if provider := c.Query("provider"); provider != "" {
+		if _, ok := dc.providers[provider]; !ok {
+			common.ResponseError(http.StatusBadRequest, "Unknown provider '"+provider+"'", models.ErrCodeInvalidRequest, nil, c)
+			return
+		}
+		where = append(where, squirrel.Eq{schema.DICTIONARY_CACHE_PROVIDER: provider})
+	}
+	if language := c.Query("language"); language != "" {
+		where = append(where, squirrel.Eq{schema.DICTIONARY_CACHE_LANGUAGE: language})
+	}
+	if word := c.Query("word"); word != "" {
+		where = append(where, squirrel.Eq{schema.DICTIONARY_CACHE_NORMALIZED_WORD: normalizeHeadword(word)})
+	}
+	switch c.Query("expired") {
+	case "", "false":
+	case "true":
+		where = append(where, squirrel.Lt{schema.DICTIONARY_CACHE_CACHED_AT: time.Now().UTC().Add(-dc.cacheTTL)})
+	default:
+		common.ResponseError(http.StatusBadRequest, "Invalid expired parameter", models.ErrCodeInvalidRequest, nil, c)
+		return
+	}
+
+	// ================ 2. Delete matching entries ================
+	// Delete fails when nothing matches, so count first
+	result := models.DictionaryCachePurgeResult{}
+	count, err := dc.dictionaryCachePeer.Count(where)
+	if err != nil {
+		common.ResponseError(http.StatusInternalServerError, "Failed to fetch data from database", models.ErrCodeInternalError, err, c)
+		return
+	}
+	if count > 0 {
+		if result.Purged, err = dc.dictionaryCachePeer.Delete(where); err != nil {
+			common.ResponseError(http.StatusInternalServerError, "Failed to delete data from database", models.ErrCodeInternalError, err, c)
+			return
+		}
+	}
+
+	// ================ 3. Send response ================
+	common.ResponseSuccess(http.StatusOK, result, c)
+}
@@ -0,0 +1,94 @@
+package dictionary
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"time"
+
+	"word-flashcard/data/schema"
+
+	"github.com/Masterminds/squirrel"
+	"github.com/stretchr/testify/mock"
+)
+
+// deleteCache sends a purge request and returns the recorder
+func (suite *ControllerTestSuite) deleteCache(query string) *httptest.ResponseRecorder {
+	req := httptest.NewRequest("DELETE", "/api/dictionary/cache"+query, nil)
+	recorder := httptest.NewRecorder()
+	suite.router.ServeHTTP(recorder, req)
+	return recorder
+}
+
+// TestPurgeCacheAll tests that a purge without filters empties the cache
+func (suite *ControllerTestSuite) TestPurgeCacheAll() {
+	peer := suite.useCachePeer()
+	peer.EXPECT().Count(squirrel.And{}).Return(int64(12), nil).Times(1)
+	peer.EXPECT().Delete(squirrel.And{}).Return(int64(12), nil).Times(1)
+
+	recorder := suite.deleteCache("")
+
+	suite.Equal(http.StatusOK, recorder.Code)
+	suite.JSONEq(`{"purged":12}`, recorder.Body.String())
+}
+
+// TestPurgeCacheFiltered tests that the filters narrow what is purged
+func (suite *ControllerTestSuite) TestPurgeCacheFiltered() {
+	peer := suite.useCachePeer()
+	isFiltered := mock.MatchedBy(func(where squirrel.And) bool {
+		if len(where) != 4 {
+			return false
+		}
+		expiredBefore, ok := where[3].(squirrel.Lt)[schema.DICTIONARY_CACHE_CACHED_AT].(time.Time)
+		return ok &&
+			where[0].(squirrel.Eq)[schema.DICTIONARY_CACHE_PROVIDER] == ProviderCambridge &&
+			where[1].(squirrel.Eq)[schema.DICTIONARY_CACHE_LANGUAGE] == "en-tw" &&
+			where[2].(squirrel.Eq)[schema.DICTIONARY_CACHE_NORMALIZED_WORD] == "look up" &&
+			time.Since(expiredBefore.Add(suite.controller.cacheTTL)) < time.Minute
+	})
+	peer.EXPECT().Count(isFiltered).Return(int64(1), nil).Times(1)
+	peer.EXPECT().Delete(isFiltered).Return(int64(1), nil).Times(1)
+
+	recorder := suite.deleteCache("?provider=cambridge&language=en-tw&word=Look%20Up&expired=true")
+
+	suite.Equal(http.StatusOK, recorder.Code)
+	suite.JSONEq(`{"purged":1}`, recorder.Body.String())
+}
+
+// TestPurgeCacheNothingToPurge tests that no matching entries is not an error
+func (suite *ControllerTestSuite) TestPurgeCacheNothingToPurge() {
+	peer := suite.useCachePeer()
+	peer.EXPECT().Count(mock.Anything).Return(int64(0), nil).Times(1)
+
+	recorder := suite.deleteCache("?expired=false&word=hello")
+
+	suite.Equal(http.StatusOK, recorder.Code)
+	suite.JSONEq(`{"purged":0}`, recorder.Body.String())
+}
+
+// TestPurgeCacheBadRequest tests rejected filters
+func (suite *ControllerTestSuite) TestPurgeCacheBadRequest() {
+	for _, query := range []string{"?provider=unknown", "?expired=yes"} {
+		recorder := suite.deleteCache(query)
+
+		suite.Equal(http.StatusBadRequest, recorder.Code, query)
+	}
+}
+
+// TestPurgeCacheDatabaseErrors tests that failed counts and deletes are server errors
+func (suite *ControllerTestSuite) TestPurgeCacheDatabaseErrors() {
+	suite.Run("count failure", func() {
+		peer := suite.useCachePeer()
+		peer.EXPECT().Count(mock.Anything).Return(int64(0), errors.New("connection refused")).Times(1)
+
+		suite.Equal(http.StatusInternalServerError, suite.deleteCache("").Code)
+	})
+
+	suite.Run("delete failure", func() {
+		peer := suite.useCachePeer()
+		peer.EXPECT().Count(mock.Anything).Return(int64(3), nil).Times(1)
+		peer.EXPECT().Delete(mock.Anything).Return(int64(0), errors.New("connection refused")).Times(1)
+
+		suite.Equal(http.StatusInternalServerError, suite.deleteCache("").Code)
+	})
+}
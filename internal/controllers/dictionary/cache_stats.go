@@ -0,0 +1,75 @@
+package dictionary
+
+import (
+	"net/http"
+	"sort"
+	"time"
+
+	"word-flashcard/data/schema"
+	"word-flashcard/internal/controllers/common"
+	"word-flashcard/internal/models"
+	"word-flashcard/utils"
+
+	"github.com/gin-gonic/gin"
+)
+
+// GetCacheStats handles dictionary cache statistics requests
+// @Summary Get dictionary cache statistics
+// @Description Reports how many lookup results are cached, how many of them have expired, how often the cache answered and the per-provider breakdown, along with the configured TTL and maximum size.
+// @Tags dictionary
+// @Produce json
+// @Success 200 {object} models.DictionaryCacheStats "Cache statistics"
+// @Failure 500 {object} models.ErrorResponse "Internal server error - Failed to fetch data from database"
+// @Router /api/dictionary/cache/stats [get]
+func (dc *Controller) GetCacheStats(c *gin.Context) {
+	// ================ 1. Fetch cache entries ================
+	columns := []*string{
+		utils.StrPtr(schema.DICTIONARY_CACHE_PROVIDER),
+		utils.StrPtr(schema.DICTIONARY_CACHE_HITS),
+		utils.StrPtr(schema.DICTIONARY_CACHE_CACHED_AT),
+	}
+	entries, err := dc.dictionaryCachePeer.Select(columns, nil, nil, nil, nil)
+	if err != nil {
+		common.ResponseError(http.StatusInternalServerError, "Failed to fetch data from database", models.ErrCodeInternalError, err, c)
+		return
+	}
+
+	// ================ 2. Summarise ================
+	stats := models.DictionaryCacheStats{
+		Entries:    len(entries),
+		MaxEntries: dc.cacheMaxEntries,
+		TTLHours:   int(dc.cacheTTL / time.Hour),
+		Providers:  []models.DictionaryCacheProviderStats{},
+	}
+	byProvider := make(map[string]*models.DictionaryCacheProviderStats)
+	for _, entry := range entries {
+		hits := 0
+		if entry.Hits != nil {
+			hits = *entry.Hits
+		}
+		stats.Hits += hits
+
+		if entry.CachedAt != nil {
+			if time.Since(*entry.CachedAt) > dc.cacheTTL {
+				stats.Expired++
+			}
+			if stats.OldestCachedAt == nil || entry.CachedAt.Before(*stats.OldestCachedAt) {
+				stats.OldestCachedAt = entry.CachedAt
+			}
+		}
+
+		provider := utils.DerefStr(entry.Provider)
+		if byProvider[provider] == nil {
+			byProvider[provider] = &models.DictionaryCacheProviderStats{Provider: provider}
+		}
+		byProvider[provider].Entries++
+		byProvider[provider].Hits += hits
+	}
+	for _, providerStats := range byProvider {
+		stats.Providers = append(stats.Providers, *providerStats)
+	}
+	sort.Slice(stats.Providers, func(i, j int) bool { return stats.Providers[i].Provider < stats.Providers[j].Provider })
+
+	// ================ 3. Send response ================
+	common.ResponseSuccess(http.StatusOK, stats, c)
+}
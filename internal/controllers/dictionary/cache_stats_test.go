@@ -0,0 +1,79 @@
+package dictionary
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"time"
+
+	dbModels "word-flashcard/data/models"
+	"word-flashcard/internal/models"
+	"word-flashcard/utils"
+
+	"github.com/stretchr/testify/mock"
+)
+
+// TestGetCacheStats tests that entries, hits and expiry are summarised in
+// total and per provider
+func (suite *ControllerTestSuite) TestGetCacheStats() {
+	suite.controller.cacheTTL = 24 * time.Hour
+	suite.controller.cacheMaxEntries = 100
+	oldest := time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC)
+	recent := time.Now().UTC().Add(-time.Hour)
+	peer := suite.useCachePeer()
+	peer.EXPECT().
+		Select(mock.Anything, nil, mock.Anything, mock.Anything, mock.Anything).
+		Return([]*dbModels.DictionaryCacheEntry{
+			{Provider: utils.StrPtr(ProviderCambridge), Hits: utils.IntPtr(4), CachedAt: &recent},
+			{Provider: utils.StrPtr(ProviderCambridge), Hits: utils.IntPtr(0), CachedAt: &oldest},
+			{Provider: utils.StrPtr("archived"), Hits: utils.IntPtr(1), CachedAt: &recent},
+		}, nil).Times(1)
+
+	req := httptest.NewRequest("GET", "/api/dictionary/cache/stats", nil)
+	recorder := httptest.NewRecorder()
+	suite.router.ServeHTTP(recorder, req)
+
+	suite.Require().Equal(http.StatusOK, recorder.Code)
+	var stats models.DictionaryCacheStats
+	suite.Require().NoError(json.Unmarshal(recorder.Body.Bytes(), &stats))
+	suite.Equal(3, stats.Entries)
+	suite.Equal(1, stats.Expired)
+	suite.Equal(5, stats.Hits)
+	suite.Equal(100, stats.MaxEntries)
+	suite.Equal(24, stats.TTLHours)
+	suite.Require().NotNil(stats.OldestCachedAt)
+	suite.True(oldest.Equal(*stats.OldestCachedAt))
+	suite.Equal([]models.DictionaryCacheProviderStats{
+		{Provider: "archived", Entries: 1, Hits: 1},
+		{Provider: ProviderCambridge, Entries: 2, Hits: 4},
+	}, stats.Providers)
+}
+
+// TestGetCacheStatsEmpty tests the statistics of an empty cache
+func (suite *ControllerTestSuite) TestGetCacheStatsEmpty() {
+	req := httptest.NewRequest("GET", "/api/dictionary/cache/stats", nil)
+	recorder := httptest.NewRecorder()
+	suite.router.ServeHTTP(recorder, req)
+
+	suite.Require().Equal(http.StatusOK, recorder.Code)
+	var stats map[string]any
+	suite.Require().NoError(json.Unmarshal(recorder.Body.Bytes(), &stats))
+	suite.Equal(float64(0), stats["entries"])
+	suite.Nil(stats["oldest_cached_at"])
+	suite.Equal([]any{}, stats["providers"])
+}
+
+// TestGetCacheStatsDatabaseError tests that a failed read is a server error
+func (suite *ControllerTestSuite) TestGetCacheStatsDatabaseError() {
+	peer := suite.useCachePeer()
+	peer.EXPECT().
+		Select(mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+		Return(nil, errors.New("connection refused")).Times(1)
+
+	req := httptest.NewRequest("GET", "/api/dictionary/cache/stats", nil)
+	recorder := httptest.NewRecorder()
+	suite.router.ServeHTTP(recorder, req)
+
+	suite.Equal(http.StatusInternalServerError, recorder.Code)
+}
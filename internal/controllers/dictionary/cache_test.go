@@ -1,75 +1,163 @@
 package dictionary
 
-import "time"
+import (
+	"errors"
+	"time"
 
-// TestGetFromCacheMiss tests that a missing key returns nil
+	dbModels "word-flashcard/data/models"
+	"word-flashcard/data/schema"
+	"word-flashcard/internal/models"
+	"word-flashcard/utils"
+
+	"github.com/Masterminds/squirrel"
+	"github.com/stretchr/testify/mock"
+)
+
+// cachedRow builds a cache row for hello cached age ago
+func cachedRow(age time.Duration, entry string) *dbModels.DictionaryCacheEntry {
+	cachedAt := time.Now().UTC().Add(-age)
+	return &dbModels.DictionaryCacheEntry{
+		Id:             utils.IntPtr(7),
+		Provider:       utils.StrPtr(ProviderCambridge),
+		Language:       utils.StrPtr("en-tw"),
+		NormalizedWord: utils.StrPtr("hello"),
+		Entry:          utils.StrPtr(entry),
+		Hits:           utils.IntPtr(2),
+		CachedAt:       &cachedAt,
+		LastAccessedAt: &cachedAt,
+	}
+}
+
+// TestGetFromCacheMiss tests that a word without a cache row returns nil
 func (suite *ControllerTestSuite) TestGetFromCacheMiss() {
-	result := suite.controller.getFromCache("missing-key")
+	result := suite.controller.getFromCache(ProviderCambridge, "en-tw", "missing")
 
 	suite.Nil(result)
 }
 
-// TestGetFromCacheHit tests that a fresh cache entry is returned
+// TestGetFromCacheHit tests that a fresh row is returned and its hit count
+// and last access time are updated
 func (suite *ControllerTestSuite) TestGetFromCacheHit() {
-	suite.controller.cache["hello"] = CacheEntry{
-		Data:      "cached-value",
-		Timestamp: time.Now(),
-	}
+	peer := suite.useCachePeer()
+	peer.EXPECT().
+		Select(mock.Anything, cacheWhere(ProviderCambridge, "en-tw", "hello"), mock.Anything, mock.Anything, mock.Anything).
+		Return([]*dbModels.DictionaryCacheEntry{cachedRow(time.Hour, `{"word":"hello","pos":["exclamation"]}`)}, nil).Times(1)
+	peer.EXPECT().
+		Update(mock.MatchedBy(func(entry *dbModels.DictionaryCacheEntry) bool {
+			return *entry.Hits == 3 && time.Since(*entry.LastAccessedAt) < time.Minute && entry.Entry == nil
+		}), squirrel.Eq{schema.DICTIONARY_CACHE_ID: 7}).
+		Return(int64(1), nil).Times(1)
 
-	result := suite.controller.getFromCache("hello")
+	result := suite.controller.getFromCache(ProviderCambridge, "en-tw", " HELLO ")
 
-	suite.Equal("cached-value", result)
+	suite.Require().NotNil(result)
+	suite.Equal("hello", result.Word)
+	suite.Equal([]string{"exclamation"}, result.POS)
 }
 
-// TestGetFromCacheExpired tests that an expired entry is evicted and returns nil
-func (suite *ControllerTestSuite) TestGetFromCacheExpired() {
-	suite.controller.cache["hello"] = CacheEntry{
-		Data:      "stale-value",
-		Timestamp: time.Now().Add(-suite.controller.cacheTTL - time.Minute),
+// TestGetFromCacheDropsStaleRows tests that expired and unreadable rows are
+// deleted and reported as misses
+func (suite *ControllerTestSuite) TestGetFromCacheDropsStaleRows() {
+	tests := []struct {
+		name string
+		row  *dbModels.DictionaryCacheEntry
+	}{
+		{"expired", cachedRow(suite.controller.cacheTTL+time.Hour, `{"word":"hello"}`)},
+		{"corrupt", cachedRow(time.Hour, `{"word":`)},
 	}
 
-	result := suite.controller.getFromCache("hello")
+	for _, tt := range tests {
+		suite.Run(tt.name, func() {
+			peer := suite.useCachePeer()
+			peer.EXPECT().
+				Select(mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+				Return([]*dbModels.DictionaryCacheEntry{tt.row}, nil).Times(1)
+			peer.EXPECT().Delete(squirrel.Eq{schema.DICTIONARY_CACHE_ID: 7}).Return(int64(1), nil).Times(1)
 
-	suite.Nil(result)
-	_, exists := suite.controller.cache["hello"]
-	suite.False(exists, "expired entry should be evicted from the cache")
+			suite.Nil(suite.controller.getFromCache(ProviderCambridge, "en-tw", "hello"))
+		})
+	}
+}
+
+// TestGetFromCacheFailure tests that a cache that can't be read is treated as a miss
+func (suite *ControllerTestSuite) TestGetFromCacheFailure() {
+	peer := suite.useCachePeer()
+	peer.EXPECT().
+		Select(mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+		Return(nil, errors.New("connection refused")).Times(1)
+
+	suite.Nil(suite.controller.getFromCache(ProviderCambridge, "en-tw", "hello"))
 }
 
-// TestSetCacheStoresEntry tests that setCache stores the value with a fresh timestamp
+// TestSetCacheStoresEntry tests that setCache inserts a row keyed by the
+// normalised word with fresh timestamps
 func (suite *ControllerTestSuite) TestSetCacheStoresEntry() {
-	suite.controller.setCache("hello", "new-value")
+	peer := suite.useCachePeer()
+	peer.EXPECT().
+		Insert(mock.MatchedBy(func(entry *dbModels.DictionaryCacheEntry) bool {
+			return *entry.Provider == ProviderCambridge && *entry.Language == "en-tw" &&
+				*entry.NormalizedWord == "look up" && *entry.Entry == `{"word":"look up","provider":"cambridge","pos":null,"verbs":null,"pronunciation":null,"definition":null}` &&
+				time.Since(*entry.CachedAt) < time.Minute && entry.CachedAt.Equal(*entry.LastAccessedAt)
+		})).
+		Return(int64(1), nil).Times(1)
+	peer.EXPECT().Count(squirrel.And{}).Return(int64(1), nil).Times(1)
 
-	entry, exists := suite.controller.cache["hello"]
-	suite.True(exists)
-	suite.Equal("new-value", entry.Data)
-	suite.WithinDuration(time.Now(), entry.Timestamp, time.Second)
+	suite.controller.setCache(ProviderCambridge, "en-tw", "Look  Up", &models.DictionaryResult{Word: "look up", Provider: ProviderCambridge})
 }
 
-// TestSetCacheCleansUpExpiredEntriesOnOverflow tests that setCache purges expired
-// entries once the cache grows beyond 1000 entries, while fresh entries survive.
-func (suite *ControllerTestSuite) TestSetCacheCleansUpExpiredEntriesOnOverflow() {
-	expiredTimestamp := time.Now().Add(-suite.controller.cacheTTL - time.Minute)
-	for i := range 1000 {
-		suite.controller.cache[string(rune(i))] = CacheEntry{
-			Data:      i,
-			Timestamp: expiredTimestamp,
-		}
-	}
-	suite.controller.cache["fresh"] = CacheEntry{
-		Data:      "fresh-value",
-		Timestamp: time.Now(),
-	}
-	suite.Len(suite.controller.cache, 1001)
-
-	// Triggers the len(dc.cache) > 1000 cleanup branch.
-	suite.controller.setCache("new-key", "new-value")
-
-	_, freshStillExists := suite.controller.cache["fresh"]
-	suite.True(freshStillExists, "fresh entries should survive the cleanup")
-	_, newKeyExists := suite.controller.cache["new-key"]
-	suite.True(newKeyExists, "the newly-set entry should be present")
-	for i := range 1000 {
-		_, expiredStillExists := suite.controller.cache[string(rune(i))]
-		suite.False(expiredStillExists, "expired entries should be purged by the cleanup")
-	}
+// TestSetCacheRefreshesExistingEntry tests that a word cached concurrently
+// is overwritten rather than duplicated
+func (suite *ControllerTestSuite) TestSetCacheRefreshesExistingEntry() {
+	peer := suite.useCachePeer()
+	peer.EXPECT().Insert(mock.Anything).Return(int64(0), errors.New("Duplicate entry")).Times(1)
+	peer.EXPECT().
+		Update(mock.MatchedBy(func(entry *dbModels.DictionaryCacheEntry) bool {
+			return entry.Entry != nil && entry.CachedAt != nil && entry.Hits == nil
+		}), cacheWhere(ProviderCambridge, "en-tw", "hello")).
+		Return(int64(1), nil).Times(1)
+	peer.EXPECT().Count(squirrel.And{}).Return(int64(1), nil).Times(1)
+
+	suite.controller.setCache(ProviderCambridge, "en-tw", "hello", &models.DictionaryResult{Word: "hello"})
+}
+
+// TestSetCacheEvictsLeastRecentlyUsed tests that entries beyond the maximum
+// are evicted oldest access first
+func (suite *ControllerTestSuite) TestSetCacheEvictsLeastRecentlyUsed() {
+	suite.controller.cacheMaxEntries = 3
+	peer := suite.useCachePeer()
+	peer.EXPECT().Insert(mock.Anything).Return(int64(1), nil).Times(1)
+	peer.EXPECT().Count(squirrel.And{}).Return(int64(5), nil).Times(1)
+
+	orderBy := "last_accessed_at ASC"
+	limit := uint64(2)
+	peer.EXPECT().
+		Select([]*string{utils.StrPtr(schema.DICTIONARY_CACHE_ID)}, squirrel.And{}, []*string{&orderBy}, &limit, mock.Anything).
+		Return([]*dbModels.DictionaryCacheEntry{{Id: utils.IntPtr(4)}, {Id: utils.IntPtr(9)}}, nil).Times(1)
+	peer.EXPECT().Delete(squirrel.Eq{schema.DICTIONARY_CACHE_ID: []int{4, 9}}).Return(int64(2), nil).Times(1)
+
+	suite.controller.setCache(ProviderCambridge, "en-tw", "hello", &models.DictionaryResult{Word: "hello"})
+}
+
+// TestSetCacheUnbounded tests that a maximum of zero disables eviction
+func (suite *ControllerTestSuite) TestSetCacheUnbounded() {
+	suite.controller.cacheMaxEntries = 0
+	peer := suite.useCachePeer()
+	peer.EXPECT().Insert(mock.Anything).Return(int64(1), nil).Times(1)
+
+	suite.controller.setCache(ProviderCambridge, "en-tw", "hello", &models.DictionaryResult{Word: "hello"})
+}
+
+// TestLookupSkipsCacheForOfflineProviders tests that offline providers are
+// neither read from nor written to the cache
+func (suite *ControllerTestSuite) TestLookupSkipsCacheForOfflineProviders() {
+	suite.useCachePeer()
+	expectHeadwords(suite.mockDictionaryEntryPeer, ProviderLocal, "en-tw", "hello")
+	expectEntry(suite.mockDictionaryEntryPeer, ProviderLocal, "en-tw", "hello", `{"word":"hello"}`)
+
+	result, err := suite.controller.lookup("hello", "en-tw", []string{ProviderLocal})
+
+	suite.Require().NoError(err)
+	suite.Equal(ProviderLocal, result.Provider)
+	suite.False(isCached(suite.controller.providers[ProviderLocal]))
+	suite.True(isCached(suite.controller.providers[ProviderCambridge]))
 }
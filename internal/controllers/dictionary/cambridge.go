@@ -1,9 +1,10 @@
 package dictionary
 
 import (
-	"errors"
 	"fmt"
 	"net/http"
+	"net/url"
+	"slices"
 	"strings"
 
 	"word-flashcard/internal/models"
@@ -11,13 +12,6 @@ import (
 	"github.com/PuerkitoBio/goquery"
 )
 
-// errWordNotFound marks a lookup for a word the Cambridge Dictionary site does not
-// have, as opposed to the site being unreachable or the request failing outright.
-var errWordNotFound = errors.New("word not found")
-
-// errUnsupportedLanguage marks a language slug this controller does not know how to fetch.
-var errUnsupportedLanguage = errors.New("unsupported language")
-
 // cambridgeUserAgent mimics a common desktop browser so Cambridge Dictionary serves
 // the full page instead of blocking the request as a non-browser client.
 const cambridgeUserAgent = "Mozilla/5.0 (Windows NT 10.0; Win64; x64) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/120.0.0.0 Safari/537.36"
@@ -27,19 +21,70 @@ const cambridgeUserAgent = "Mozilla/5.0 (Windows NT 10.0; Win64; x64) AppleWebKi
 type languageConfig struct {
 	nation   string
 	language string
+	// dictionaryIDs are the data-id prefixes of the page sections belonging to
+	// this dictionary. Monolingual pages append the American and Business
+	// English dictionaries after the main one, so only the listed sections are
+	// parsed; when none of them is on the page, or the list is empty, the whole
+	// page is.
+	dictionaryIDs []string
 }
 
-// supportedLanguages lists the slug languages this controller knows how to fetch.
-// Mirrors the language table in utils/cambridge-dictionary-api/data.js; only en-tw
-// is implemented for now, but the map keeps the route contract ready for the rest
-// (en, uk, en-cn) to be added later without a breaking change.
+// supportedLanguages lists the slug languages the Cambridge provider knows how to fetch.
+// The en, uk, en-tw and en-cn slugs match utils/cambridge-dictionary-api/data.js;
+// the other bilingual pairs follow the same en-<ISO 639-1> pattern.
 var supportedLanguages = map[string]languageConfig{
+	"en":    {nation: "us", language: "english", dictionaryIDs: []string{"cacd"}},
+	"uk":    {nation: "uk", language: "english", dictionaryIDs: []string{"cald4"}},
 	"en-tw": {nation: "us", language: "english-chinese-traditional"},
+	"en-cn": {nation: "us", language: "english-chinese-simplified"},
+	"en-ja": {nation: "us", language: "english-japanese"},
+	"en-ko": {nation: "us", language: "english-korean"},
+	"en-es": {nation: "us", language: "english-spanish"},
+	"en-fr": {nation: "us", language: "english-french"},
+	"en-de": {nation: "us", language: "english-german"},
+	"en-it": {nation: "us", language: "english-italian"},
+	"en-pt": {nation: "us", language: "english-portuguese"},
+	"en-ru": {nation: "us", language: "english-russian"},
+}
+
+// cefrLevels are the CEFR badges Cambridge marks definitions with
+var cefrLevels = []string{"A1", "A2", "B1", "B2", "C1", "C2"}
+
+// phraseBlockSelector matches the phrasal verb and idiom entries on a page
+const phraseBlockSelector = ".pv-block, .idiom-block"
+
+// cambridgeProvider scrapes word pages from the Cambridge Dictionary site.
+type cambridgeProvider struct {
+	httpClient *http.Client
+	baseURL    string
+}
+
+// newCambridgeProvider creates a cambridgeProvider scraping baseURL.
+// Tests pass an httptest.Server URL instead of defaultCambridgeBaseURL.
+func newCambridgeProvider(baseURL string) *cambridgeProvider {
+	return &cambridgeProvider{
+		httpClient: &http.Client{Timeout: cambridgeHTTPTimeout},
+		baseURL:    baseURL,
+	}
+}
+
+// Name returns the provider name
+func (p *cambridgeProvider) Name() string {
+	return ProviderCambridge
+}
+
+// Host returns the host pages are scraped from
+func (p *cambridgeProvider) Host() string {
+	parsed, err := url.Parse(p.baseURL)
+	if err != nil {
+		return p.baseURL
+	}
+	return parsed.Host
 }
 
-// fetchWordDataFromCambridge scrapes the Cambridge Dictionary page for word and
-// parses it into the same shape utils/cambridge-dictionary-api returns.
-func (dc *Controller) fetchWordDataFromCambridge(word, slugLanguage string) (*models.CambridgeResponse, error) {
+// Lookup scrapes the Cambridge Dictionary page for word and parses it into
+// the same shape utils/cambridge-dictionary-api returns.
+func (p *cambridgeProvider) Lookup(word, slugLanguage string) (*models.DictionaryResult, error) {
 	word = strings.TrimSpace(word)
 	if word == "" {
 		return nil, fmt.Errorf("word cannot be empty")
@@ -50,58 +95,86 @@ func (dc *Controller) fetchWordDataFromCambridge(word, slugLanguage string) (*mo
 		return nil, fmt.Errorf("%w: %s", errUnsupportedLanguage, slugLanguage)
 	}
 
-	pageURL := fmt.Sprintf("%s/%s/dictionary/%s/%s", dc.cambridgeBaseURL, cfg.nation, cfg.language, word)
-
-	req, err := http.NewRequest(http.MethodGet, pageURL, nil)
+	req, err := http.NewRequest(http.MethodGet, p.pageURL(cfg, word), nil)
 	if err != nil {
 		return nil, fmt.Errorf("failed to create request: %w", err)
 	}
 	req.Header.Set("User-Agent", cambridgeUserAgent)
 
-	resp, err := dc.httpClient.Do(req)
+	resp, err := p.httpClient.Do(req)
 	if err != nil {
-		return nil, fmt.Errorf("failed to fetch dictionary page: %w", err)
+		return nil, fmt.Errorf("%w: %w: failed to fetch dictionary page: %v", errUpstreamUnavailable, errTransient, err)
 	}
 	defer resp.Body.Close()
 
 	if resp.StatusCode == http.StatusNotFound {
 		return nil, fmt.Errorf("%w: %s", errWordNotFound, word)
 	}
+	if resp.StatusCode >= http.StatusInternalServerError || resp.StatusCode == http.StatusTooManyRequests {
+		return nil, fmt.Errorf("%w: %w: dictionary page returned HTTP %d", errUpstreamUnavailable, errTransient, resp.StatusCode)
+	}
 	if resp.StatusCode != http.StatusOK {
-		return nil, fmt.Errorf("dictionary page returned HTTP %d", resp.StatusCode)
+		return nil, fmt.Errorf("%w: dictionary page returned HTTP %d", errUpstreamUnavailable, resp.StatusCode)
 	}
 
 	doc, err := goquery.NewDocumentFromReader(resp.Body)
 	if err != nil {
-		return nil, fmt.Errorf("failed to parse dictionary page: %w", err)
+		return nil, fmt.Errorf("%w: failed to parse dictionary page: %v", errUpstreamUnavailable, err)
 	}
 
-	return parseCambridgeDocument(doc, dc.cambridgeBaseURL, word)
+	return parseCambridgeDocument(doc, cfg, p.baseURL, word)
+}
+
+// pageURL returns the address of word's page in the dictionary cfg names
+func (p *cambridgeProvider) pageURL(cfg languageConfig, word string) string {
+	return fmt.Sprintf("%s/%s/dictionary/%s/%s", p.baseURL, cfg.nation, cfg.language, word)
 }
 
 // parseCambridgeDocument extracts word data from a parsed Cambridge Dictionary page,
 // mirroring the cheerio selectors used by utils/cambridge-dictionary-api/data.js.
-func parseCambridgeDocument(doc *goquery.Document, siteURL, word string) (*models.CambridgeResponse, error) {
+// Only the sections of the dictionary cfg names are read.
+func parseCambridgeDocument(doc *goquery.Document, cfg languageConfig, siteURL, word string) (*models.DictionaryResult, error) {
 	headword := strings.TrimSpace(doc.Find(".hw.dhw").First().Text())
 	if headword == "" {
 		return nil, fmt.Errorf("%w: %s", errWordNotFound, word)
 	}
 
-	return &models.CambridgeResponse{
+	sections := dictionarySections(doc, cfg)
+	return &models.DictionaryResult{
 		Word:          headword,
-		POS:           extractPartsOfSpeech(doc),
-		Verbs:         []models.CambridgeVerb{},
-		Pronunciation: extractPronunciation(doc, siteURL),
-		Definition:    extractDefinitions(doc),
+		POS:           extractPartsOfSpeech(sections),
+		Verbs:         extractWordForms(sections, headword),
+		Pronunciation: extractPronunciation(sections, siteURL),
+		Definition:    extractDefinitions(sections, headword),
+		PhrasalVerbs:  extractPhrases(sections, headword, ".pv-block", ".xref.phrasal_verbs"),
+		Idioms:        extractPhrases(sections, headword, ".idiom-block", ".xref.idioms"),
 	}, nil
 }
 
+// dictionarySections returns the page sections whose data-id starts with one
+// of cfg.dictionaryIDs, or the whole page when there are none.
+func dictionarySections(doc *goquery.Document, cfg languageConfig) *goquery.Selection {
+	sections := doc.Find(".pr.dictionary").FilterFunction(func(_ int, s *goquery.Selection) bool {
+		id, _ := s.Attr("data-id")
+		for _, prefix := range cfg.dictionaryIDs {
+			if strings.HasPrefix(id, prefix) {
+				return true
+			}
+		}
+		return false
+	})
+	if sections.Length() == 0 {
+		return doc.Selection
+	}
+	return sections
+}
+
 // extractPartsOfSpeech collects the unique set of part-of-speech labels for the word.
-func extractPartsOfSpeech(doc *goquery.Document) []string {
+func extractPartsOfSpeech(page *goquery.Selection) []string {
 	seen := make(map[string]bool)
 	var pos []string
 
-	doc.Find(".pos.dpos").Each(func(_ int, s *goquery.Selection) {
+	page.Find(".pos.dpos").Each(func(_ int, s *goquery.Selection) {
 		text := strings.TrimSpace(s.Text())
 		if text == "" || seen[text] {
 			return
@@ -114,10 +187,10 @@ func extractPartsOfSpeech(doc *goquery.Document) []string {
 }
 
 // extractPronunciation collects pronunciation audio entries grouped by part of speech.
-func extractPronunciation(doc *goquery.Document, siteURL string) []models.CambridgePronunciation {
-	var pronunciation []models.CambridgePronunciation
+func extractPronunciation(page *goquery.Selection, siteURL string) []models.DictionaryPronunciation {
+	var pronunciation []models.DictionaryPronunciation
 
-	doc.Find(".pos-header.dpos-h").Each(func(_ int, header *goquery.Selection) {
+	page.Find(".pos-header.dpos-h").Each(func(_ int, header *goquery.Selection) {
 		posNode := header.Find(".dpos-g").First()
 		if posNode.Length() == 0 {
 			return
@@ -125,7 +198,7 @@ func extractPronunciation(doc *goquery.Document, siteURL string) []models.Cambri
 		pos := strings.TrimSpace(posNode.Text())
 
 		header.Find(".dpron-i").Each(func(_ int, node *goquery.Selection) {
-			lang := strings.TrimSpace(node.Find(".region.dreg").Text())
+			lang := pronunciationRegion(node)
 			audioSrc, hasAudio := node.Find("audio source").Attr("src")
 			pron := strings.TrimSpace(node.Find(".pron.dpron").Text())
 
@@ -133,7 +206,7 @@ func extractPronunciation(doc *goquery.Document, siteURL string) []models.Cambri
 				return
 			}
 
-			pronunciation = append(pronunciation, models.CambridgePronunciation{
+			pronunciation = append(pronunciation, models.DictionaryPronunciation{
 				POS:  pos,
 				Lang: lang,
 				URL:  siteURL + audioSrc,
@@ -145,32 +218,179 @@ func extractPronunciation(doc *goquery.Document, siteURL string) []models.Cambri
 	return pronunciation
 }
 
-// extractDefinitions collects definitions, translations and examples for the word.
-func extractDefinitions(doc *goquery.Document) []models.CambridgeDefinition {
-	var definitions []models.CambridgeDefinition
+// pronunciationRegion returns the accent of a pronunciation. Several
+// bilingual dictionaries omit the region label and only mark the accent with
+// a uk or us class on the pronunciation itself.
+func pronunciationRegion(node *goquery.Selection) string {
+	if region := strings.TrimSpace(node.Find(".region.dreg").Text()); region != "" {
+		return region
+	}
+	for _, region := range []string{"uk", "us"} {
+		if node.HasClass(region) {
+			return region
+		}
+	}
+	return ""
+}
 
-	doc.Find(".def-block.ddef_block").Each(func(index int, block *goquery.Selection) {
-		pos := strings.TrimSpace(block.Closest(".pr.entry-body__el").Find(".pos.dpos").First().Text())
-		text := strings.TrimSpace(block.Find(".def.ddef_d.db").Text())
-		translation := strings.TrimSpace(block.Find(".def-body.ddef_b > span.trans.dtrans").Text())
+// extractDefinitions collects definitions, translations and examples for the
+// word. Senses of phrasal verbs and idioms other than headword itself belong
+// to those phrases and are left to extractPhrases.
+func extractDefinitions(page *goquery.Selection, headword string) []models.DictionaryDefinition {
+	var definitions []models.DictionaryDefinition
 
-		var examples []models.CambridgeExample
-		block.Find(".def-body.ddef_b > .examp.dexamp").Each(func(exIndex int, ex *goquery.Selection) {
-			examples = append(examples, models.CambridgeExample{
-				ID:          exIndex,
-				Text:        strings.TrimSpace(ex.Find(".eg.deg").Text()),
-				Translation: strings.TrimSpace(ex.Find(".trans.dtrans").Text()),
-			})
+	page.Find(".def-block.ddef_block").Each(func(_ int, block *goquery.Selection) {
+		if inOtherPhrase(block, headword) {
+			return
+		}
+		definitions = append(definitions, parseDefinitionBlock(len(definitions), block))
+	})
+
+	return definitions
+}
+
+// parseDefinitionBlock reads one def-block: its text, translation, examples,
+// CEFR level, grammar codes, usage labels, synonyms and antonyms.
+func parseDefinitionBlock(id int, block *goquery.Selection) models.DictionaryDefinition {
+	pos := strings.TrimSpace(block.Closest(".pr.entry-body__el").Find(".pos.dpos").First().Text())
+	// Definitions followed by examples end with a colon
+	text := strings.TrimSpace(strings.TrimSuffix(strings.TrimSpace(block.Find(".def.ddef_d.db").Text()), ":"))
+	translation := joinTranslations(block.Find(".def-body.ddef_b > span.trans.dtrans"))
+
+	var examples []models.DictionaryExample
+	block.Find(".def-body.ddef_b > .examp.dexamp").Each(func(exIndex int, ex *goquery.Selection) {
+		examples = append(examples, models.DictionaryExample{
+			ID:          exIndex,
+			Text:        strings.TrimSpace(ex.Find(".eg.deg").Text()),
+			Translation: joinTranslations(ex.Find(".trans.dtrans")),
 		})
+	})
+
+	level := strings.TrimSpace(block.Find(".ddef-info .epp-xref").First().Text())
+	if !slices.Contains(cefrLevels, level) {
+		level = ""
+	}
+
+	// Grammar codes given for the whole part of speech apply to senses without their own
+	grammar := uniqueTexts(block.Find(".ddef-info .gc"))
+	if len(grammar) == 0 {
+		grammar = uniqueTexts(block.Closest(".pr.entry-body__el").Find(".posgram .gc"))
+	}
+
+	return models.DictionaryDefinition{
+		ID:          id,
+		POS:         pos,
+		Text:        text,
+		Translation: translation,
+		Example:     examples,
+		Level:       level,
+		Grammar:     grammar,
+		Labels:      usageLabels(block),
+		Synonyms:    uniqueTexts(block.Find(".xref.synonym .x-h, .xref.synonyms .x-h")),
+		Antonyms:    uniqueTexts(block.Find(".xref.opposite .x-h, .xref.opposites .x-h, .xref.antonym .x-h, .xref.antonyms .x-h")),
+	}
+}
+
+// extractWordForms collects the inflected and irregular forms listed in the
+// entry headers, e.g. "past tense went".
+func extractWordForms(page *goquery.Selection, headword string) []models.DictionaryVerb {
+	forms := []models.DictionaryVerb{}
+	seen := make(map[models.DictionaryVerb]bool)
+
+	page.Find(".irreg-infls .inf-group").Each(func(_ int, group *goquery.Selection) {
+		if inOtherPhrase(group, headword) {
+			return
+		}
+		form := models.DictionaryVerb{
+			Type: strings.TrimSpace(group.Find(".lab").First().Text()),
+			Text: strings.TrimSpace(group.Find(".inf").First().Text()),
+		}
+		if form.Text == "" || seen[form] {
+			return
+		}
+		seen[form] = true
+		form.ID = len(forms)
+		forms = append(forms, form)
+	})
+
+	return forms
+}
+
+// extractPhrases collects the phrasal verbs or idioms on a page: first the
+// entries defined in blocks matching blockSelector, then the ones only
+// cross-referenced in the list matching xrefSelector.
+func extractPhrases(page *goquery.Selection, headword, blockSelector, xrefSelector string) []models.DictionaryPhrase {
+	var phrases []models.DictionaryPhrase
+	seen := map[string]bool{strings.ToLower(headword): true}
+	add := func(phrase string, definitions []models.DictionaryDefinition) {
+		if phrase == "" || seen[strings.ToLower(phrase)] {
+			return
+		}
+		seen[strings.ToLower(phrase)] = true
+		phrases = append(phrases, models.DictionaryPhrase{Phrase: phrase, Definition: definitions})
+	}
 
-		definitions = append(definitions, models.CambridgeDefinition{
-			ID:          index,
-			POS:         pos,
-			Text:        text,
-			Translation: translation,
-			Example:     examples,
+	page.Find(blockSelector).Each(func(_ int, block *goquery.Selection) {
+		var definitions []models.DictionaryDefinition
+		block.Find(".def-block.ddef_block").Each(func(index int, def *goquery.Selection) {
+			definitions = append(definitions, parseDefinitionBlock(index, def))
 		})
+		add(phraseTitle(block), definitions)
+	})
+	page.Find(xrefSelector + " .x-h").Each(func(_ int, item *goquery.Selection) {
+		add(strings.TrimSpace(item.Text()), nil)
 	})
 
-	return definitions
+	return phrases
+}
+
+// phraseTitle returns the phrase a phrasal verb or idiom block defines
+func phraseTitle(block *goquery.Selection) string {
+	for _, selector := range []string{".phrase-title", ".idiom-title", ".hw.dhw"} {
+		if title := strings.TrimSpace(block.Find(selector).First().Text()); title != "" {
+			return title
+		}
+	}
+	return ""
+}
+
+// inOtherPhrase reports whether node belongs to a phrasal verb or idiom block
+// for a phrase other than headword. Looking up a phrasal verb returns a page
+// whose main entry is itself such a block.
+func inOtherPhrase(node *goquery.Selection, headword string) bool {
+	block := node.Closest(phraseBlockSelector)
+	return block.Length() > 0 && !strings.EqualFold(phraseTitle(block), headword)
+}
+
+// usageLabels returns the usage labels of a definition. A label may wrap a
+// usage node, which is then not counted again on its own.
+func usageLabels(block *goquery.Selection) []string {
+	labels := block.Find(".ddef-info .lab, .ddef-info .usage").FilterFunction(func(_ int, node *goquery.Selection) bool {
+		return node.HasClass("lab") || node.ParentsFiltered(".lab").Length() == 0
+	})
+	return uniqueTexts(labels)
+}
+
+// uniqueTexts returns the distinct non-blank texts of nodes in document order
+func uniqueTexts(nodes *goquery.Selection) []string {
+	var texts []string
+	nodes.Each(func(_ int, node *goquery.Selection) {
+		if text := strings.Join(strings.Fields(node.Text()), " "); text != "" && !slices.Contains(texts, text) {
+			texts = append(texts, text)
+		}
+	})
+	return texts
+}
+
+// joinTranslations joins translation nodes with "; ". The Japanese, Korean
+// and European dictionaries give each alternative translation its own node,
+// which would otherwise run together.
+func joinTranslations(nodes *goquery.Selection) string {
+	var translations []string
+	nodes.Each(func(_ int, node *goquery.Selection) {
+		if text := strings.TrimSpace(node.Text()); text != "" {
+			translations = append(translations, text)
+		}
+	})
+	return strings.Join(translations, "; ")
 }
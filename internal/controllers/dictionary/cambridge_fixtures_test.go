@@ -0,0 +1,158 @@
+package dictionary
+
+import (
+	"flag"
+	"fmt"
+	"html"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/PuerkitoBio/goquery"
+)
+
+// updateCambridge refreshes testdata/cambridge from the live site instead of
+// testing against it:
+//
+//	go test ./internal/controllers/dictionary -run TestRefreshCambridgeFixtures -update-cambridge
+var updateCambridge = flag.Bool("update-cambridge", false, "refresh testdata/cambridge from dictionary.cambridge.org")
+
+// cambridgeFixtures maps each fixture in testdata/cambridge to the language
+// and word its page is captured for
+var cambridgeFixtures = map[string]struct{ language, word string }{
+	"en":         {"en", "hello"},
+	"uk":         {"uk", "hello"},
+	"en-tw":      {"en-tw", "hello"},
+	"en-cn":      {"en-cn", "hello"},
+	"en-ja":      {"en-ja", "hello"},
+	"en-ko":      {"en-ko", "hello"},
+	"en-es":      {"en-es", "hello"},
+	"en-fr":      {"en-fr", "hello"},
+	"en-de":      {"en-de", "hello"},
+	"en-it":      {"en-it", "hello"},
+	"en-pt":      {"en-pt", "hello"},
+	"en-ru":      {"en-ru", "hello"},
+	"uk-go":      {"uk", "go"},
+	"uk-look-up": {"uk", "look-up"},
+}
+
+// TestRefreshCambridgeFixtures captures every fixture's page and saves it
+// trimmed to what parseCambridgeDocument reads. The tests' expectations
+// follow the live wording, so review the diff and adjust them after a
+// refresh.
+func TestRefreshCambridgeFixtures(t *testing.T) {
+	if !*updateCambridge {
+		t.Skip("run with -update-cambridge to refresh testdata/cambridge")
+	}
+
+	provider := newCambridgeProvider(defaultCambridgeBaseURL)
+	for name, fixture := range cambridgeFixtures {
+		t.Run(name, func(t *testing.T) {
+			page, err := captureCambridgePage(provider, supportedLanguages[fixture.language], fixture.word)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if err := os.WriteFile(filepath.Join("testdata", "cambridge", name+".html"), []byte(page), 0o644); err != nil {
+				t.Fatal(err)
+			}
+		})
+	}
+}
+
+// captureCambridgePage fetches word's page and trims it to its title and
+// main content, without scripts, styles or embedded ads
+func captureCambridgePage(provider *cambridgeProvider, cfg languageConfig, word string) (string, error) {
+	req, err := http.NewRequest(http.MethodGet, provider.pageURL(cfg, word), nil)
+	if err != nil {
+		return "", err
+	}
+	req.Header.Set("User-Agent", cambridgeUserAgent)
+	resp, err := provider.httpClient.Do(req)
+	if err != nil {
+		return "", err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return "", fmt.Errorf("%s returned HTTP %d", req.URL, resp.StatusCode)
+	}
+
+	doc, err := goquery.NewDocumentFromReader(resp.Body)
+	if err != nil {
+		return "", err
+	}
+	content := doc.Find("#page-content").First()
+	if content.Length() == 0 {
+		return "", fmt.Errorf("%s has no #page-content", req.URL)
+	}
+	content.Find("script, style, noscript, iframe").Remove()
+	article, err := goquery.OuterHtml(content)
+	if err != nil {
+		return "", err
+	}
+
+	title := strings.TrimSpace(doc.Find("title").First().Text())
+	return fmt.Sprintf("<!DOCTYPE html>\n<html lang=\"en\"><head><meta charset=\"utf-8\"><title>%s</title></head>\n<body>\n<div class=\"page\">\n%s\n</div>\n</body></html>\n",
+		html.EscapeString(title), article), nil
+}
+
+// TestCaptureCambridgePage tests that a captured page keeps its title and
+// main content but not the scripts, ads or chrome around it, and still
+// parses like the live page
+func (suite *ControllerTestSuite) TestCaptureCambridgePage() {
+	provider, closeServer := newTestCambridgeProviderWithServer(func(w http.ResponseWriter, r *http.Request) {
+		suite.Equal("/uk/dictionary/english/hello", r.URL.Path)
+		suite.Equal(cambridgeUserAgent, r.Header.Get("User-Agent"))
+		_, _ = w.Write([]byte(`<html><head><title>HELLO | Cambridge English Dictionary</title><script>track()</script></head><body>
+<header>site navigation</header>
+<article id="page-content"><script>ads()</script><iframe src="/ad"></iframe>
+<div class="pr dictionary" data-id="cald4"><span class="hw dhw">hello</span></div></article>
+<footer>site footer</footer></body></html>`))
+	})
+	defer closeServer()
+
+	page, err := captureCambridgePage(provider, supportedLanguages["uk"], "hello")
+
+	suite.Require().NoError(err)
+	suite.Contains(page, "<title>HELLO | Cambridge English Dictionary</title>")
+	suite.Contains(page, `<div class="pr dictionary" data-id="cald4"><span class="hw dhw">hello</span></div>`)
+	for _, dropped := range []string{"<script", "<iframe", "site navigation", "site footer"} {
+		suite.NotContains(page, dropped)
+	}
+
+	doc, err := goquery.NewDocumentFromReader(strings.NewReader(page))
+	suite.Require().NoError(err)
+	response, err := parseCambridgeDocument(doc, supportedLanguages["uk"], provider.baseURL, "hello")
+	suite.Require().NoError(err)
+	suite.Equal("hello", response.Word)
+}
+
+// TestCaptureCambridgePageErrors tests that a missing page or one without
+// main content is reported instead of saved
+func (suite *ControllerTestSuite) TestCaptureCambridgePageErrors() {
+	tests := []struct {
+		name    string
+		status  int
+		body    string
+		wantErr string
+	}{
+		{name: "missing page", status: http.StatusNotFound, wantErr: "returned HTTP 404"},
+		{name: "no main content", status: http.StatusOK, body: "<html><body>blocked</body></html>", wantErr: "has no #page-content"},
+	}
+
+	for _, tt := range tests {
+		suite.Run(tt.name, func() {
+			provider, closeServer := newTestCambridgeProviderWithServer(func(w http.ResponseWriter, r *http.Request) {
+				w.WriteHeader(tt.status)
+				_, _ = w.Write([]byte(tt.body))
+			})
+			defer closeServer()
+
+			_, err := captureCambridgePage(provider, supportedLanguages["uk"], "hello")
+
+			suite.Require().Error(err)
+			suite.Contains(err.Error(), tt.wantErr)
+		})
+	}
+}
@@ -4,6 +4,8 @@ import (
 	"errors"
 	"net/http"
 	"net/http/httptest"
+	"os"
+	"path/filepath"
 	"strings"
 
 	"word-flashcard/internal/models"
@@ -63,18 +65,16 @@ const helloFixtureHTML = `
 </body></html>
 `
 
-// newTestControllerWithCambridgeServer starts an httptest.Server driven by handler
-// and returns a Controller pointed at it, plus a cleanup func to close the server.
-func newTestControllerWithCambridgeServer(handler http.HandlerFunc) (*Controller, func()) {
+// newTestCambridgeProviderWithServer starts an httptest.Server driven by handler
+// and returns a cambridgeProvider pointed at it, plus a cleanup func to close the server.
+func newTestCambridgeProviderWithServer(handler http.HandlerFunc) (*cambridgeProvider, func()) {
 	server := httptest.NewServer(handler)
-	controller := New()
-	controller.cambridgeBaseURL = server.URL
-	return controller, server.Close
+	return newCambridgeProvider(server.URL), server.Close
 }
 
-// TestFetchWordDataFromCambridge tests fetchWordDataFromCambridge across language
+// TestCambridgeProviderLookup tests cambridgeProvider.Lookup across language
 // validation, HTTP failure modes and successful HTML scraping.
-func (suite *ControllerTestSuite) TestFetchWordDataFromCambridge() {
+func (suite *ControllerTestSuite) TestCambridgeProviderLookup() {
 	tests := []struct {
 		name                  string
 		word                  string
@@ -115,7 +115,7 @@ func (suite *ControllerTestSuite) TestFetchWordDataFromCambridge() {
 		{
 			name:     "returns errUnsupportedLanguage without making an HTTP request",
 			word:     "hello",
-			language: "en",
+			language: "xx",
 			handler: func(w http.ResponseWriter, r *http.Request) {
 				suite.Fail("unsupported language should be rejected before an HTTP request is made")
 			},
@@ -150,43 +150,44 @@ func (suite *ControllerTestSuite) TestFetchWordDataFromCambridge() {
 			wantErrIs: errWordNotFound,
 		},
 		{
-			name:     "returns a generic error when Cambridge responds with a server error",
+			name:     "returns errUpstreamUnavailable when Cambridge responds with a server error",
 			word:     "hello",
 			language: "en-tw",
 			handler: func(w http.ResponseWriter, r *http.Request) {
 				w.WriteHeader(http.StatusInternalServerError)
 			},
+			wantErrIs:       errUpstreamUnavailable,
 			wantErrContains: "HTTP 500",
 		},
 		{
-			name:        "returns a generic error when the origin is unreachable",
-			word:        "hello",
-			language:    "en-tw",
-			unreachable: true,
+			name:            "returns errUpstreamUnavailable when the origin is unreachable",
+			word:            "hello",
+			language:        "en-tw",
+			unreachable:     true,
+			wantErrIs:       errUpstreamUnavailable,
 			wantErrContains: "failed to fetch dictionary page",
 		},
 	}
 
 	for _, tt := range tests {
 		suite.Run(tt.name, func() {
-			var controller *Controller
+			var provider *cambridgeProvider
 			if tt.unreachable {
-				controller = New()
-				controller.cambridgeBaseURL = "http://127.0.0.1:1"
+				provider = newCambridgeProvider("http://127.0.0.1:1")
 			} else {
 				var closeServer func()
-				controller, closeServer = newTestControllerWithCambridgeServer(tt.handler)
+				provider, closeServer = newTestCambridgeProviderWithServer(tt.handler)
 				defer closeServer()
 			}
 
-			response, err := controller.fetchWordDataFromCambridge(tt.word, tt.language)
+			response, err := provider.Lookup(tt.word, tt.language)
 
 			switch {
-			case tt.wantErrIs != nil:
-				suite.Require().Error(err)
-				suite.True(errors.Is(err, tt.wantErrIs), "expected error to wrap %v, got %v", tt.wantErrIs, err)
-			case tt.wantErrContains != "":
+			case tt.wantErrIs != nil || tt.wantErrContains != "":
 				suite.Require().Error(err)
+				if tt.wantErrIs != nil {
+					suite.True(errors.Is(err, tt.wantErrIs), "expected error to wrap %v, got %v", tt.wantErrIs, err)
+				}
 				suite.Contains(err.Error(), tt.wantErrContains)
 			default:
 				suite.Require().NoError(err)
@@ -200,6 +201,136 @@ func (suite *ControllerTestSuite) TestFetchWordDataFromCambridge() {
 	}
 }
 
+// TestCambridgeProviderLanguages tests every supported language pair against
+// a page saved from its Cambridge dictionary, each with its own layout quirks:
+// monolingual pages carry several dictionaries, bilingual ones split
+// alternative translations across nodes and may leave out region labels.
+func (suite *ControllerTestSuite) TestCambridgeProviderLanguages() {
+	type want struct {
+		path              string
+		pos               []string
+		pronunciation     []string
+		definitions       int
+		text              string
+		translation       string
+		exampleTranslated string
+	}
+	greeting := "used when meeting or greeting someone"
+	tests := map[string]want{
+		"en": {"/us/dictionary/english/hello", []string{"exclamation", "noun"}, []string{"us"}, 1,
+			"used when greeting someone or when beginning a telephone conversation", "", ""},
+		"uk":    {"/uk/dictionary/english/hello", []string{"exclamation", "noun"}, []string{"uk"}, 1, greeting, "", ""},
+		"en-tw": {"/us/dictionary/english-chinese-traditional/hello", []string{"exclamation", "noun"}, []string{"uk", "us"}, 2, greeting, "（用於問候）喂，你好", "你好，保羅。"},
+		"en-cn": {"/us/dictionary/english-chinese-simplified/hello", []string{"exclamation", "noun"}, []string{"uk", "us"}, 2, greeting, "（用于问候）喂，你好", "你好，保罗。"},
+		"en-ja": {"/us/dictionary/english-japanese/hello", []string{"exclamation"}, []string{"uk", "us"}, 2, greeting, "こんにちは; やあ", "こんにちは、ポール。"},
+		"en-ko": {"/us/dictionary/english-korean/hello", []string{"exclamation"}, []string{"uk", "us"}, 1, greeting, "안녕하세요; 여보세요", "안녕, 폴."},
+		"en-es": {"/us/dictionary/english-spanish/hello", []string{"exclamation"}, []string{"uk", "us"}, 2, greeting, "hola", "Hola, Paul."},
+		"en-fr": {"/us/dictionary/english-french/hello", []string{"exclamation"}, []string{"uk", "us"}, 1, greeting, "bonjour; salut", "Bonjour, Paul."},
+		"en-de": {"/us/dictionary/english-german/hello", []string{"exclamation"}, []string{"uk", "us"}, 1, greeting, "hallo; guten Tag", "Hallo, Paul."},
+		"en-it": {"/us/dictionary/english-italian/hello", []string{"exclamation"}, []string{"uk", "us"}, 1, greeting, "ciao; salve", "Ciao, Paul."},
+		"en-pt": {"/us/dictionary/english-portuguese/hello", []string{"exclamation"}, []string{"uk", "us"}, 1, greeting, "olá; oi", "Olá, Paul."},
+		"en-ru": {"/us/dictionary/english-russian/hello", []string{"exclamation"}, []string{"uk", "us"}, 1, greeting, "привет; здравствуйте", "Привет, Пол."},
+	}
+	suite.Len(tests, len(supportedLanguages), "every supported language needs a fixture")
+
+	for language, tt := range tests {
+		suite.Run(language, func() {
+			page, err := os.ReadFile(filepath.Join("testdata", "cambridge", language+".html"))
+			suite.Require().NoError(err)
+			provider, closeServer := newTestCambridgeProviderWithServer(func(w http.ResponseWriter, r *http.Request) {
+				suite.Equal(tt.path, r.URL.Path)
+				_, _ = w.Write(page)
+			})
+			defer closeServer()
+
+			response, err := provider.Lookup("hello", language)
+
+			suite.Require().NoError(err)
+			suite.Equal("hello", response.Word)
+			suite.Equal(tt.pos, response.POS)
+			var regions []string
+			for _, pronunciation := range response.Pronunciation {
+				regions = append(regions, pronunciation.Lang)
+				suite.NotEmpty(pronunciation.Pron)
+				suite.True(strings.HasSuffix(pronunciation.URL, ".mp3"))
+			}
+			suite.Equal(tt.pronunciation, regions)
+			suite.Require().Len(response.Definition, tt.definitions)
+			definition := response.Definition[0]
+			suite.Equal(tt.text, definition.Text)
+			suite.Equal(tt.translation, definition.Translation)
+			suite.Require().Len(definition.Example, 1)
+			suite.Equal(tt.exampleTranslated, definition.Example[0].Translation)
+		})
+	}
+}
+
+// parseFixture parses a saved Cambridge page from testdata/cambridge
+func (suite *ControllerTestSuite) parseFixture(name, language, word string) *models.DictionaryResult {
+	page, err := os.ReadFile(filepath.Join("testdata", "cambridge", name+".html"))
+	suite.Require().NoError(err)
+	doc, err := goquery.NewDocumentFromReader(strings.NewReader(string(page)))
+	suite.Require().NoError(err)
+
+	response, err := parseCambridgeDocument(doc, supportedLanguages[language], "https://dictionary.cambridge.org", word)
+	suite.Require().NoError(err)
+	return response
+}
+
+// TestParseCambridgeDocumentEntryDetails tests that word forms, CEFR levels,
+// grammar codes, usage labels, synonyms, antonyms, phrasal verbs and idioms
+// are read from a page
+func (suite *ControllerTestSuite) TestParseCambridgeDocumentEntryDetails() {
+	response := suite.parseFixture("uk-go", "uk", "go")
+
+	suite.Equal([]models.DictionaryVerb{
+		{ID: 0, Type: "present participle", Text: "going"},
+		{ID: 1, Type: "past tense", Text: "went"},
+		{ID: 2, Type: "past participle", Text: "gone"},
+		{ID: 3, Type: "plural", Text: "goes"},
+	}, response.Verbs)
+
+	suite.Require().Len(response.Definition, 3, "senses of phrasal verbs and idioms are not the word's own")
+	travel := response.Definition[0]
+	suite.Equal("to travel or move to another place", travel.Text)
+	suite.Equal("A1", travel.Level)
+	suite.Equal([]string{"I"}, travel.Grammar)
+	suite.Nil(travel.Labels)
+	suite.Equal([]string{"travel", "move"}, travel.Synonyms)
+	suite.Equal([]string{"come"}, travel.Antonyms)
+	suite.Equal("B2", response.Definition[1].Level)
+	suite.Equal([]string{"L"}, response.Definition[1].Grammar)
+	suite.Equal([]string{"mainly UK informal"}, response.Definition[1].Labels)
+	suite.Equal("noun", response.Definition[2].POS)
+	suite.Equal([]string{"C"}, response.Definition[2].Grammar, "the part of speech's grammar code applies to senses without one")
+
+	suite.Require().Len(response.Idioms, 2)
+	suite.Equal("go for it", response.Idioms[0].Phrase)
+	suite.Require().Len(response.Idioms[0].Definition, 1)
+	suite.Equal("to make a great effort to achieve something", response.Idioms[0].Definition[0].Text)
+	suite.Equal([]string{"informal"}, response.Idioms[0].Definition[0].Labels)
+	suite.Equal(models.DictionaryPhrase{Phrase: "go figure"}, response.Idioms[1])
+
+	suite.Require().Len(response.PhrasalVerbs, 2)
+	suite.Equal("go off", response.PhrasalVerbs[0].Phrase)
+	suite.Require().Len(response.PhrasalVerbs[0].Definition, 1)
+	suite.Equal("B1", response.PhrasalVerbs[0].Definition[0].Level)
+	suite.Equal(models.DictionaryPhrase{Phrase: "go on"}, response.PhrasalVerbs[1])
+}
+
+// TestParseCambridgeDocumentPhrasalVerbPage tests that a phrasal verb looked
+// up directly is read as the main entry rather than as a phrase of itself
+func (suite *ControllerTestSuite) TestParseCambridgeDocumentPhrasalVerbPage() {
+	response := suite.parseFixture("uk-look-up", "uk", "look up")
+
+	suite.Equal("look up", response.Word)
+	suite.Empty(response.PhrasalVerbs)
+	suite.Require().Len(response.Definition, 1)
+	suite.Equal("to try to find a piece of information by looking in a book or on a computer", response.Definition[0].Text)
+	suite.Equal("A2", response.Definition[0].Level)
+	suite.Equal([]string{"T"}, response.Definition[0].Grammar)
+}
+
 // TestParseCambridgeDocument tests parseCambridgeDocument's headword gate that
 // distinguishes a real entry from a page Cambridge Dictionary doesn't have.
 func (suite *ControllerTestSuite) TestParseCambridgeDocument() {
@@ -224,7 +355,7 @@ func (suite *ControllerTestSuite) TestParseCambridgeDocument() {
 			doc, err := goquery.NewDocumentFromReader(strings.NewReader(tt.html))
 			suite.Require().NoError(err)
 
-			response, err := parseCambridgeDocument(doc, "https://dictionary.cambridge.org", "hello")
+			response, err := parseCambridgeDocument(doc, supportedLanguages["en-tw"], "https://dictionary.cambridge.org", "hello")
 
 			if tt.wantErrIs != nil {
 				suite.Require().Error(err)
@@ -268,7 +399,7 @@ func (suite *ControllerTestSuite) TestExtractPartsOfSpeech() {
 			doc, err := goquery.NewDocumentFromReader(strings.NewReader(tt.html))
 			suite.Require().NoError(err)
 
-			suite.Equal(tt.want, extractPartsOfSpeech(doc))
+			suite.Equal(tt.want, extractPartsOfSpeech(doc.Selection))
 		})
 	}
 }
@@ -279,7 +410,7 @@ func (suite *ControllerTestSuite) TestExtractPronunciation() {
 	tests := []struct {
 		name string
 		html string
-		want []models.CambridgePronunciation
+		want []models.DictionaryPronunciation
 	}{
 		{
 			name: "collects an entry with lang, audio url and pron for its pos group",
@@ -291,7 +422,7 @@ func (suite *ControllerTestSuite) TestExtractPronunciation() {
 					<audio><source src="/media/test-uk.mp3"></audio>
 				</span>
 			</div>`,
-			want: []models.CambridgePronunciation{
+			want: []models.DictionaryPronunciation{
 				{POS: "verb", Lang: "uk", URL: "https://example.com/media/test-uk.mp3", Pron: "/test/"},
 			},
 		},
@@ -335,7 +466,7 @@ func (suite *ControllerTestSuite) TestExtractPronunciation() {
 			doc, err := goquery.NewDocumentFromReader(strings.NewReader(tt.html))
 			suite.Require().NoError(err)
 
-			suite.Equal(tt.want, extractPronunciation(doc, "https://example.com"))
+			suite.Equal(tt.want, extractPronunciation(doc.Selection, "https://example.com"))
 		})
 	}
 }
@@ -346,7 +477,7 @@ func (suite *ControllerTestSuite) TestExtractDefinitions() {
 	tests := []struct {
 		name string
 		html string
-		want []models.CambridgeDefinition
+		want []models.DictionaryDefinition
 	}{
 		{
 			name: "collects definition text, translation, pos and examples",
@@ -363,13 +494,13 @@ func (suite *ControllerTestSuite) TestExtractDefinitions() {
 					</div>
 				</div>
 			</div>`,
-			want: []models.CambridgeDefinition{
+			want: []models.DictionaryDefinition{
 				{
 					ID:          0,
 					POS:         "verb",
 					Text:        "to do something",
 					Translation: "做某事",
-					Example: []models.CambridgeExample{
+					Example: []models.DictionaryExample{
 						{ID: 0, Text: "He did it.", Translation: "他做了。"},
 					},
 				},
@@ -382,7 +513,7 @@ func (suite *ControllerTestSuite) TestExtractDefinitions() {
 					<div class="def ddef_d db">to do something</div>
 				</div>
 			</div>`,
-			want: []models.CambridgeDefinition{
+			want: []models.DictionaryDefinition{
 				{ID: 0, POS: "", Text: "to do something", Translation: "", Example: nil},
 			},
 		},
@@ -397,7 +528,7 @@ func (suite *ControllerTestSuite) TestExtractDefinitions() {
 					</div>
 				</div>
 			</div>`,
-			want: []models.CambridgeDefinition{
+			want: []models.DictionaryDefinition{
 				{ID: 0, POS: "verb", Text: "to do something", Translation: "做某事", Example: nil},
 			},
 		},
@@ -408,7 +539,39 @@ func (suite *ControllerTestSuite) TestExtractDefinitions() {
 			doc, err := goquery.NewDocumentFromReader(strings.NewReader(tt.html))
 			suite.Require().NoError(err)
 
-			suite.Equal(tt.want, extractDefinitions(doc))
+			suite.Equal(tt.want, extractDefinitions(doc.Selection, ""))
 		})
 	}
 }
+
+// TestCambridgeProviderTransientErrors tests that only failures worth
+// retrying are marked transient
+func (suite *ControllerTestSuite) TestCambridgeProviderTransientErrors() {
+	tests := map[int]bool{
+		http.StatusInternalServerError: true,
+		http.StatusServiceUnavailable:  true,
+		http.StatusTooManyRequests:     true,
+		http.StatusForbidden:           false,
+		http.StatusNotFound:            false,
+	}
+
+	for status, transient := range tests {
+		suite.Run(http.StatusText(status), func() {
+			provider, closeServer := newTestCambridgeProviderWithServer(func(w http.ResponseWriter, r *http.Request) {
+				w.WriteHeader(status)
+			})
+			defer closeServer()
+
+			_, err := provider.Lookup("hello", "en-tw")
+
+			suite.Require().Error(err)
+			suite.Equal(transient, errors.Is(err, errTransient))
+		})
+	}
+
+	suite.Run("unreachable", func() {
+		_, err := newCambridgeProvider("http://127.0.0.1:1").Lookup("hello", "en-tw")
+		suite.True(errors.Is(err, errTransient))
+		suite.True(errors.Is(err, errUpstreamUnavailable))
+	})
+}
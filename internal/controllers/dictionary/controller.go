@@ -1,39 +1,95 @@
 package dictionary
 
 import (
-	"net/http"
-	"sync"
 	"time"
+
+	"word-flashcard/data/peers"
+	"word-flashcard/internal/audiostore"
+	"word-flashcard/utils/config"
 )
 
 // defaultCambridgeBaseURL is the origin scraped for dictionary pages.
-// Tests override Controller.cambridgeBaseURL with an httptest.Server URL instead of changing this default.
+// Tests replace the Cambridge provider with one pointed at an httptest.Server URL instead of changing this default.
 const defaultCambridgeBaseURL = "https://dictionary.cambridge.org"
 
 // cambridgeHTTPTimeout bounds how long a single scrape request may take.
 const cambridgeHTTPTimeout = 10 * time.Second
 
+// Defaults for the persistent lookup cache: keep results for 90 days and at
+// most 10000 of them
+const (
+	defaultCacheTTLHours   = 90 * 24
+	defaultCacheMaxEntries = 10000
+)
+
 // Controller handles dictionary-related requests
 type Controller struct {
-	cache            map[string]CacheEntry
-	cacheMutex       sync.RWMutex
-	cacheTTL         time.Duration
-	httpClient       *http.Client
-	cambridgeBaseURL string
+	dictionaryCachePeer peers.DictionaryCachePeerInterface
+	cacheTTL            time.Duration
+	cacheMaxEntries     int
+	providers           map[string]DictionaryProvider
+	providerOrder       []string
+	wordPeer            peers.WordPeerInterface
+	wordDefinitionPeer  peers.WordDefinitionsPeerInterface
+	audioStore          *audiostore.AudioStore
+	hostLimiter         *hostLimiter
+	batchMaxWords       int
+	batchConcurrency    int
+	batchMaxRetries     int
+	batchBackoff        time.Duration
 }
 
-// CacheEntry represents a cached dictionary response
-type CacheEntry struct {
-	Data      interface{}
-	Timestamp time.Time
-}
+// New creates a new Controller instance. Providers are tried in the order
+// given by DICTIONARY_PROVIDERS (comma-separated provider names). Results
+// from online providers are cached for DICTIONARY_CACHE_TTL_HOURS, keeping
+// at most DICTIONARY_CACHE_MAX_ENTRIES of them. Batch lookups take at most
+// DICTIONARY_BATCH_MAX_WORDS words, run DICTIONARY_BATCH_CONCURRENCY lookups
+// at a time, send a host at most one request per
+// DICTIONARY_BATCH_HOST_INTERVAL_MS and retry transient failures up to
+// DICTIONARY_BATCH_MAX_RETRIES times, backing off from
+// DICTIONARY_BATCH_BACKOFF_MS. Words they create go through wordPeer and
+// wordDefinitionPeer, with pronunciation audio kept by audioStore.
+func New(
+	dictionaryEntryPeer peers.DictionaryEntryPeerInterface,
+	dictionaryCachePeer peers.DictionaryCachePeerInterface,
+	wordPeer peers.WordPeerInterface,
+	wordDefinitionPeer peers.WordDefinitionsPeerInterface,
+	audioStore *audiostore.AudioStore,
+) *Controller {
+	providers := map[string]DictionaryProvider{
+		ProviderCambridge:  newCambridgeProvider(defaultCambridgeBaseURL),
+		ProviderLocal:      newOfflineProvider(ProviderLocal, dictionaryEntryPeer),
+		ProviderWiktionary: newOfflineProvider(ProviderWiktionary, dictionaryEntryPeer),
+	}
 
-// New creates a new Controller instance
-func New() *Controller {
 	return &Controller{
-		cache:            make(map[string]CacheEntry),
-		cacheTTL:         30 * time.Minute,
-		httpClient:       &http.Client{Timeout: cambridgeHTTPTimeout},
-		cambridgeBaseURL: defaultCambridgeBaseURL,
+		dictionaryCachePeer: dictionaryCachePeer,
+		cacheTTL:            time.Duration(config.GetOrDefaultInt("DICTIONARY_CACHE_TTL_HOURS", defaultCacheTTLHours)) * time.Hour,
+		cacheMaxEntries:     config.GetOrDefaultInt("DICTIONARY_CACHE_MAX_ENTRIES", defaultCacheMaxEntries),
+		providers:           providers,
+		providerOrder:       parseProviderOrder(config.GetOrDefault("DICTIONARY_PROVIDERS", defaultProviderOrder), providers),
+		wordPeer:            wordPeer,
+		wordDefinitionPeer:  wordDefinitionPeer,
+		audioStore:          audioStore,
+		hostLimiter:         newHostLimiter(time.Duration(config.GetOrDefaultInt("DICTIONARY_BATCH_HOST_INTERVAL_MS", defaultBatchHostIntervalMS)) * time.Millisecond),
+		batchMaxWords:       config.GetOrDefaultInt("DICTIONARY_BATCH_MAX_WORDS", defaultBatchMaxWords),
+		batchConcurrency:    max(1, config.GetOrDefaultInt("DICTIONARY_BATCH_CONCURRENCY", defaultBatchConcurrency)),
+		batchMaxRetries:     config.GetOrDefaultInt("DICTIONARY_BATCH_MAX_RETRIES", defaultBatchMaxRetries),
+		batchBackoff:        time.Duration(config.GetOrDefaultInt("DICTIONARY_BATCH_BACKOFF_MS", defaultBatchBackoffMS)) * time.Millisecond,
 	}
 }
+
+// GetReelPeers returns the real database peers backing the offline providers and the lookup cache
+func GetReelPeers() (peers.DictionaryEntryPeerInterface, peers.DictionaryCachePeerInterface, error) {
+	dictionaryEntryPeer, err := peers.NewDictionaryEntryPeer()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	dictionaryCachePeer, err := peers.NewDictionaryCachePeer()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return dictionaryEntryPeer, dictionaryCachePeer, nil
+}
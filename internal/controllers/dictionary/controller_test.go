@@ -7,16 +7,27 @@ import (
 	"net/http/httptest"
 	"testing"
 
+	"word-flashcard/data/mocks"
+	dbModels "word-flashcard/data/models"
+	"word-flashcard/data/schema"
+	"word-flashcard/utils"
+
+	"github.com/Masterminds/squirrel"
 	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/suite"
 )
 
 // ControllerTestSuite contains all dictionary controller tests
 type ControllerTestSuite struct {
 	suite.Suite
-	controller          *Controller
-	router              *gin.Engine
-	mockCambridgeServer *httptest.Server
+	controller              *Controller
+	router                  *gin.Engine
+	mockCambridgeServer     *httptest.Server
+	mockDictionaryEntryPeer *mocks.MockDictionaryEntryPeer
+	mockDictionaryCachePeer *mocks.MockDictionaryCachePeer
+	mockWordPeer            *mocks.MockWordPeer
+	mockWordDefinitionsPeer *mocks.MockWordDefinitionsPeer
 }
 
 // TestControllerTestSuite runs the ControllerTestSuite
@@ -31,13 +42,30 @@ func (suite *ControllerTestSuite) SetupTest() {
 	// Set gin to test mode
 	gin.SetMode(gin.TestMode)
 
-	// Clear cache before each test
-	suite.controller = New()
+	// Start each test with an empty cache. Only Cambridge is tried by default so
+	// tests that don't cover fallback never reach the offline providers
+	suite.mockDictionaryEntryPeer = mocks.NewMockDictionaryEntryPeer(suite.T())
+	suite.mockDictionaryCachePeer = mocks.NewMockDictionaryCachePeer(suite.T())
+	suite.mockWordPeer = mocks.NewMockWordPeer(suite.T())
+	suite.mockWordDefinitionsPeer = mocks.NewMockWordDefinitionsPeer(suite.T())
+	allowCacheMisses(suite.mockDictionaryCachePeer)
+	suite.controller = New(suite.mockDictionaryEntryPeer, suite.mockDictionaryCachePeer, suite.mockWordPeer, suite.mockWordDefinitionsPeer, nil)
+	suite.controller.providerOrder = []string{ProviderCambridge}
+
+	// Batch lookups don't wait between requests or retries
+	suite.controller.hostLimiter = newHostLimiter(0)
+	suite.controller.batchBackoff = 0
 
 	// Initialize router
 	suite.router = gin.New()
 
-	// Register dictionary route
+	// Register dictionary routes
+	suite.router.GET("/api/dictionary/suggestions", suite.controller.SuggestWords)
+	suite.router.POST("/api/dictionary/import", suite.controller.ImportDictionary)
+	suite.router.POST("/api/dictionary/batch", suite.controller.BatchLookup)
+	suite.router.POST("/api/words/from-dictionary", suite.controller.CreateWordFromDictionary)
+	suite.router.GET("/api/dictionary/cache/stats", suite.controller.GetCacheStats)
+	suite.router.DELETE("/api/dictionary/cache", suite.controller.PurgeCache)
 	suite.router.GET("/api/dictionary/:language/:word", suite.controller.SearchWord)
 
 	suite.setupMockCambridgeServer()
@@ -50,6 +78,52 @@ func (suite *ControllerTestSuite) TearDownTest() {
 	}
 }
 
+// allowCacheMisses lets lookups run against an empty cache that accepts
+// whatever is written to it, for tests that aren't about caching
+func allowCacheMisses(peer *mocks.MockDictionaryCachePeer) {
+	peer.EXPECT().
+		Select(mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+		Return([]*dbModels.DictionaryCacheEntry{}, nil).Maybe()
+	peer.EXPECT().Insert(mock.Anything).Return(int64(1), nil).Maybe()
+	peer.EXPECT().Count(mock.Anything).Return(int64(1), nil).Maybe()
+}
+
+// useCachePeer replaces the permissive cache peer with a strict one, for
+// tests that set their own cache expectations
+func (suite *ControllerTestSuite) useCachePeer() *mocks.MockDictionaryCachePeer {
+	suite.mockDictionaryCachePeer = mocks.NewMockDictionaryCachePeer(suite.T())
+	suite.controller.dictionaryCachePeer = suite.mockDictionaryCachePeer
+	return suite.mockDictionaryCachePeer
+}
+
+// expectHeadwords expects the headword index of an offline source to be
+// loaded once for language, with words as the stored headwords
+func expectHeadwords(peer *mocks.MockDictionaryEntryPeer, source, language string, words ...string) {
+	entries := make([]*dbModels.DictionaryEntry, 0, len(words))
+	for _, word := range words {
+		entries = append(entries, &dbModels.DictionaryEntry{NormalizedWord: utils.StrPtr(word)})
+	}
+	where := squirrel.Eq{
+		schema.DICTIONARY_ENTRY_SOURCE:   source,
+		schema.DICTIONARY_ENTRY_LANGUAGE: language,
+	}
+	peer.EXPECT().
+		Select(mock.Anything, where, mock.Anything, mock.Anything, mock.Anything).
+		Return(entries, nil).Times(1)
+}
+
+// expectEntry expects the stored entry of a normalised headword to be read
+func expectEntry(peer *mocks.MockDictionaryEntryPeer, source, language, normalized, entry string) {
+	where := squirrel.Eq{
+		schema.DICTIONARY_ENTRY_SOURCE:          source,
+		schema.DICTIONARY_ENTRY_LANGUAGE:        language,
+		schema.DICTIONARY_ENTRY_NORMALIZED_WORD: normalized,
+	}
+	peer.EXPECT().
+		Select(mock.Anything, where, mock.Anything, mock.Anything, mock.Anything).
+		Return([]*dbModels.DictionaryEntry{{Word: utils.StrPtr(normalized), Entry: utils.StrPtr(entry)}}, nil).Times(1)
+}
+
 // setupTestLogging configures logging for dictionary controller tests
 func setupTestLogging() {
 	handler := slog.NewTextHandler(io.Discard, &slog.HandlerOptions{
@@ -60,7 +134,7 @@ func setupTestLogging() {
 }
 
 // setupMockCambridgeServer creates a mock server that simulates Cambridge Dictionary
-// pages and points the controller's cambridgeBaseURL at it, replacing the real
+// pages and points the controller's Cambridge provider at it, replacing the real
 // dictionary.cambridge.org origin for the duration of the test.
 func (suite *ControllerTestSuite) setupMockCambridgeServer() {
 	suite.mockCambridgeServer = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
@@ -76,5 +150,5 @@ func (suite *ControllerTestSuite) setupMockCambridgeServer() {
 		}
 	}))
 
-	suite.controller.cambridgeBaseURL = suite.mockCambridgeServer.URL
+	suite.controller.providers[ProviderCambridge] = newCambridgeProvider(suite.mockCambridgeServer.URL)
 }
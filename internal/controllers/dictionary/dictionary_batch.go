@@ -0,0 +1,102 @@
+package dictionary
+
+import (
+	"encoding/json"
+	"log/slog"
+	"net/http"
+	"sync"
+
+	"word-flashcard/internal/controllers/common"
+	"word-flashcard/internal/models"
+
+	"github.com/gin-gonic/gin"
+)
+
+// BatchLookup handles looking up many words at once
+// @Summary Look up many words at once
+// @Description Looks up a list of words with a bounded pool of workers, streaming one NDJSON line per word as it finishes and a summary
+// @Description line at the end. Providers are tried as for a single lookup and cached results are reused; requests to the same remote
+// @Description host are spaced out, and timeouts and 5xx responses are retried with exponential backoff. With create set, every word
+// @Description found that isn't in the word list yet is added with the fetched definitions; words already in the list are left
+// @Description untouched and reported as existing.
+// @Tags dictionary
+// @Accept json
+// @Produce application/x-ndjson
+// @Param batch body models.DictionaryBatchRequest true "Words to look up, language slug, optional provider and whether to create the words"
+// @Success 200 {object} models.DictionaryBatchProgress "One progress line per word, followed by a models.DictionaryBatchSummary line"
+// @Failure 400 {object} models.ErrorResponse "Bad request - Invalid request body, no words, too many words, invalid language or unknown provider"
+// @Router /api/dictionary/batch [post]
+func (dc *Controller) BatchLookup(c *gin.Context) {
+	// ================ 1. Parse request body ================
+	var req models.DictionaryBatchRequest
+	if err := common.ParseRequestBody(&req, c); err != nil {
+		common.ResponseError(http.StatusBadRequest, "Invalid request body", models.ErrCodeInvalidRequest, err, c)
+		return
+	}
+	words, err := validateBatchRequest(&req, dc.batchMaxWords, dc.providers)
+	if err != nil {
+		common.ResponseError(http.StatusBadRequest, err.Error(), models.ErrCodeValidationError, err, c)
+		return
+	}
+
+	providerNames := dc.providerOrder
+	if req.Provider != nil {
+		providerNames = []string{*req.Provider}
+	}
+
+	// ================ 2. Start workers ================
+	// Words stop being handed out once the client goes away; lookups in
+	// flight give up at their next wait
+	ctx := c.Request.Context()
+	indexes := make(chan int)
+	results := make(chan models.DictionaryBatchProgress)
+	go func() {
+		defer close(indexes)
+		for i := range words {
+			select {
+			case indexes <- i:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+
+	var wg sync.WaitGroup
+	for range min(dc.batchConcurrency, len(words)) {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range indexes {
+				results <- dc.lookupBatchWord(ctx, i, words[i], *req.Language, providerNames, req.Create)
+			}
+		}()
+	}
+	go func() {
+		wg.Wait()
+		close(results)
+	}()
+
+	// ================ 3. Stream progress ================
+	c.Header("Content-Type", "application/x-ndjson")
+	c.Header("Cache-Control", "no-cache")
+	c.Status(http.StatusOK)
+
+	encoder := json.NewEncoder(c.Writer)
+	summary := models.DictionaryBatchSummary{Type: models.DictionaryBatchEventSummary, Total: len(words)}
+	completed := 0
+	for progress := range results {
+		completed++
+		progress.Completed = completed
+		progress.Total = len(words)
+		summary.Add(progress.Status)
+		if err := encoder.Encode(progress); err != nil {
+			slog.Warn("Failed to stream batch lookup progress", "word", progress.Word, "error", err)
+		}
+		c.Writer.Flush()
+	}
+
+	if err := encoder.Encode(summary); err != nil {
+		slog.Warn("Failed to stream batch lookup summary", "error", err)
+	}
+	c.Writer.Flush()
+}
@@ -0,0 +1,245 @@
+package dictionary
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"time"
+
+	dbModels "word-flashcard/data/models"
+	"word-flashcard/data/peers"
+	"word-flashcard/data/schema"
+	"word-flashcard/internal/models"
+	"word-flashcard/utils"
+
+	"github.com/Masterminds/squirrel"
+	"github.com/stretchr/testify/mock"
+)
+
+// postBatch sends a batch lookup request and returns the recorder
+func (suite *ControllerTestSuite) postBatch(body string) *httptest.ResponseRecorder {
+	req := httptest.NewRequest("POST", "/api/dictionary/batch", strings.NewReader(body))
+	req.Header.Set("Content-Type", "application/json")
+	recorder := httptest.NewRecorder()
+	suite.router.ServeHTTP(recorder, req)
+	return recorder
+}
+
+// readBatchStream splits a batch response into its progress lines, keyed by
+// word, and its closing summary
+func (suite *ControllerTestSuite) readBatchStream(recorder *httptest.ResponseRecorder) (map[string]models.DictionaryBatchProgress, models.DictionaryBatchSummary) {
+	suite.Require().Equal(http.StatusOK, recorder.Code, recorder.Body.String())
+	suite.Equal("application/x-ndjson", recorder.Header().Get("Content-Type"))
+
+	var lines []string
+	scanner := bufio.NewScanner(recorder.Body)
+	for scanner.Scan() {
+		lines = append(lines, scanner.Text())
+	}
+	suite.Require().NotEmpty(lines)
+
+	progress := make(map[string]models.DictionaryBatchProgress)
+	for i, line := range lines[:len(lines)-1] {
+		var event models.DictionaryBatchProgress
+		suite.Require().NoError(json.Unmarshal([]byte(line), &event))
+		suite.Equal(models.DictionaryBatchEventProgress, event.Type)
+		suite.Equal(i+1, event.Completed)
+		progress[event.Word] = event
+	}
+
+	var summary models.DictionaryBatchSummary
+	suite.Require().NoError(json.Unmarshal([]byte(lines[len(lines)-1]), &summary))
+	suite.Equal(models.DictionaryBatchEventSummary, summary.Type)
+	return progress, summary
+}
+
+// useFlakyCambridgeServer points the Cambridge provider at a server that
+// answers the hello page with 503 for the first failures requests, and
+// returns the number of requests it received
+func (suite *ControllerTestSuite) useFlakyCambridgeServer(failures int32) *atomic.Int32 {
+	var requests atomic.Int32
+	provider, closeServer := newTestCambridgeProviderWithServer(func(w http.ResponseWriter, r *http.Request) {
+		if requests.Add(1) <= failures {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+		_, _ = w.Write([]byte(helloFixtureHTML))
+	})
+	suite.T().Cleanup(closeServer)
+	suite.controller.providers[ProviderCambridge] = provider
+	return &requests
+}
+
+// TestBatchLookupStreamsProgress tests that every word gets a progress line
+// and the summary counts them, with blank and repeated words dropped
+func (suite *ControllerTestSuite) TestBatchLookupStreamsProgress() {
+	recorder := suite.postBatch(`{"words":["hello","zzzznotaword"," ","Hello","upstreamerror"],"language":"en-tw"}`)
+
+	progress, summary := suite.readBatchStream(recorder)
+	suite.Len(progress, 3)
+	suite.Equal(models.DictionaryBatchSummary{Type: models.DictionaryBatchEventSummary, Total: 3, Found: 1, NotFound: 1, Failed: 1}, summary)
+
+	hello := progress["hello"]
+	suite.Equal(0, hello.Index)
+	suite.Equal(models.DictionaryBatchFound, hello.Status)
+	suite.Equal(1, hello.Attempts)
+	suite.Equal(3, hello.Total)
+	suite.Require().NotNil(hello.Result)
+	suite.Equal("hello", hello.Result.Word)
+	suite.Nil(hello.WordID)
+
+	suite.Equal(models.DictionaryBatchNotFound, progress["zzzznotaword"].Status)
+	suite.Equal(1, progress["zzzznotaword"].Attempts, "a missing word isn't retried")
+
+	failed := progress["upstreamerror"]
+	suite.Equal(models.DictionaryBatchFailed, failed.Status)
+	suite.Equal(suite.controller.batchMaxRetries+1, failed.Attempts, "a server error is retried until the retries run out")
+	suite.NotEmpty(failed.Error)
+}
+
+// TestBatchLookupRetriesTransientFailures tests that a word is fetched once
+// the upstream recovers within the allowed retries
+func (suite *ControllerTestSuite) TestBatchLookupRetriesTransientFailures() {
+	suite.controller.batchMaxRetries = 2
+	requests := suite.useFlakyCambridgeServer(2)
+
+	progress, summary := suite.readBatchStream(suite.postBatch(`{"words":["hello"],"language":"en-tw"}`))
+
+	suite.Equal(1, summary.Found)
+	suite.Equal(models.DictionaryBatchFound, progress["hello"].Status)
+	suite.Equal(3, progress["hello"].Attempts)
+	suite.Equal(int32(3), requests.Load())
+}
+
+// TestBatchLookupUsesCache tests that a cached word is answered without
+// contacting the provider
+func (suite *ControllerTestSuite) TestBatchLookupUsesCache() {
+	cachePeer := suite.useCachePeer()
+	cachedAt := time.Now()
+	cachePeer.EXPECT().
+		Select(mock.Anything, cacheWhere(ProviderCambridge, "en-tw", "hello"), mock.Anything, mock.Anything, mock.Anything).
+		Return([]*dbModels.DictionaryCacheEntry{{
+			Id:       utils.IntPtr(1),
+			Entry:    utils.StrPtr(`{"word":"hello","pos":["exclamation"]}`),
+			CachedAt: &cachedAt,
+		}}, nil).Times(1)
+	cachePeer.EXPECT().Update(mock.Anything, mock.Anything).Return(int64(1), nil).Maybe()
+	requests := suite.useFlakyCambridgeServer(0)
+
+	progress, _ := suite.readBatchStream(suite.postBatch(`{"words":["hello"],"language":"en-tw"}`))
+
+	suite.Equal(models.DictionaryBatchFound, progress["hello"].Status)
+	suite.Equal(0, progress["hello"].Attempts)
+	suite.Equal(int32(0), requests.Load())
+}
+
+// TestBatchLookupCreatesWords tests that found words are added to the word
+// list with the fetched definitions
+func (suite *ControllerTestSuite) TestBatchLookupCreatesWords() {
+	suite.mockWordPeer.EXPECT().
+		Select(mock.Anything, squirrel.Eq{schema.WORD_WORD: "hello"}, mock.Anything, mock.Anything, mock.Anything).
+		Return([]*dbModels.Word{}, nil).Times(1)
+
+	var definitions []*dbModels.WordDefinition
+	suite.mockWordPeer.EXPECT().
+		SaveWithDefinitions(mock.MatchedBy(func(w *dbModels.Word) bool { return *w.Word == "hello" }), mock.Anything).
+		Run(func(args mock.Arguments) {
+			definitions = args.Get(1).([]*dbModels.WordDefinition)
+		}).
+		Return(&peers.SavedWord{WordID: 7, Created: true, Added: 2}, nil).Times(1)
+
+	progress, summary := suite.readBatchStream(suite.postBatch(`{"words":["hello"],"language":"en-tw","create":true}`))
+
+	suite.Equal(1, summary.Created)
+	suite.Equal(models.DictionaryBatchCreated, progress["hello"].Status)
+	suite.Require().NotNil(progress["hello"].WordID)
+	suite.Equal(7, *progress["hello"].WordID)
+
+	suite.Require().Len(definitions, 2)
+	suite.Equal("exclamation", *definitions[0].PartOfSpeech)
+	suite.Equal("喂，你好 used when meeting or greeting someone", *definitions[0].Definition)
+	suite.JSONEq(`["Hello, Paul. 你好，保羅。"]`, *definitions[0].Examples)
+	suite.Contains(*definitions[0].Phonetics, "hello-uk.mp3")
+	suite.Contains(*definitions[0].Phonetics, "hello-us.mp3")
+}
+
+// TestBatchLookupKeepsExistingWords tests that a word already in the word
+// list is reported with its ID and not inserted again
+func (suite *ControllerTestSuite) TestBatchLookupKeepsExistingWords() {
+	suite.mockWordPeer.EXPECT().
+		Select(mock.Anything, squirrel.Eq{schema.WORD_WORD: "hello"}, mock.Anything, mock.Anything, mock.Anything).
+		Return([]*dbModels.Word{{Id: utils.IntPtr(3), Word: utils.StrPtr("hello")}}, nil).Times(1)
+
+	progress, summary := suite.readBatchStream(suite.postBatch(`{"words":["hello"],"language":"en-tw","create":true}`))
+
+	suite.Equal(1, summary.Existing)
+	suite.Equal(models.DictionaryBatchExisting, progress["hello"].Status)
+	suite.Equal(3, *progress["hello"].WordID)
+}
+
+// TestBatchLookupCreateFailure tests that a word that can't be saved is
+// reported as failed
+func (suite *ControllerTestSuite) TestBatchLookupCreateFailure() {
+	suite.mockWordPeer.EXPECT().Select(mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+		Return([]*dbModels.Word{}, nil).Times(1)
+	suite.mockWordPeer.EXPECT().SaveWithDefinitions(mock.Anything, mock.Anything).Return(nil, errors.New("db error")).Times(1)
+
+	progress, summary := suite.readBatchStream(suite.postBatch(`{"words":["hello"],"language":"en-tw","create":true}`))
+
+	suite.Equal(1, summary.Failed)
+	suite.Equal(models.DictionaryBatchFailed, progress["hello"].Status)
+	suite.Nil(progress["hello"].WordID)
+}
+
+// TestBatchLookupValidation tests that bad requests are rejected before any
+// word is looked up
+func (suite *ControllerTestSuite) TestBatchLookupValidation() {
+	suite.controller.batchMaxWords = 2
+
+	tests := []struct {
+		name string
+		body string
+	}{
+		{"invalid JSON", `{"words":`},
+		{"no words", `{"words":[],"language":"en-tw"}`},
+		{"only blank words", `{"words":[" ",""],"language":"en-tw"}`},
+		{"too many words", `{"words":["a","b","c"],"language":"en-tw"}`},
+		{"missing language", `{"words":["hello"]}`},
+		{"invalid language", `{"words":["hello"],"language":"English"}`},
+		{"unknown provider", `{"words":["hello"],"language":"en-tw","provider":"oxford"}`},
+	}
+
+	for _, tt := range tests {
+		suite.Run(tt.name, func() {
+			suite.Equal(http.StatusBadRequest, suite.postBatch(tt.body).Code)
+		})
+	}
+}
+
+// TestHostLimiter tests that requests to one host are spaced out while other
+// hosts and local providers aren't held up
+func (suite *ControllerTestSuite) TestHostLimiter() {
+	limiter := newHostLimiter(20 * time.Millisecond)
+	ctx := context.Background()
+
+	start := time.Now()
+	for range 3 {
+		suite.Require().NoError(limiter.wait(ctx, "dictionary.cambridge.org"))
+	}
+	suite.GreaterOrEqual(time.Since(start), 40*time.Millisecond)
+
+	start = time.Now()
+	suite.Require().NoError(limiter.wait(ctx, "upload.wikimedia.org"))
+	suite.Require().NoError(limiter.wait(ctx, ""))
+	suite.Less(time.Since(start), 20*time.Millisecond)
+
+	cancelled, cancel := context.WithCancel(ctx)
+	cancel()
+	suite.ErrorIs(limiter.wait(cancelled, "dictionary.cambridge.org"), context.Canceled)
+}
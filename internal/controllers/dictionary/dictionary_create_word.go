@@ -0,0 +1,97 @@
+package dictionary
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"word-flashcard/internal/controllers/common"
+	"word-flashcard/internal/models"
+
+	"github.com/gin-gonic/gin"
+)
+
+// CreateWordFromDictionary handles saving a word straight from a dictionary lookup
+// @Summary Create a word from the dictionary
+// @Description Looks the word up (answered from the lookup cache when it was just searched) and saves it with the picked definitions,
+// @Description mapped the way the definition form maps them: part of speech, the translation followed by the definition text, each
+// @Description example followed by its translation, and the UK/US recordings as phonetics. The word and its definitions are written in
+// @Description one transaction. When the word already exists, the picked definitions are merged into it instead, skipping those it
+// @Description already has with the same part of speech and definition.
+// @Tags words
+// @Accept json
+// @Produce json
+// @Param word body models.WordFromDictionaryRequest true "Word, language slug, optional provider and the IDs of the lookup result's definitions to save (all when empty)"
+// @Success 200 {object} models.WordFromDictionaryResult "Word created or merged into"
+// @Failure 400 {object} models.ErrorResponse "Bad request - Invalid request body, unsupported language, unknown provider or unknown definition IDs"
+// @Failure 404 {object} models.ErrorResponse "Not found - Word not found in the dictionary"
+// @Failure 409 {object} models.ErrorResponse "Conflict - The word was created by another request at the same time"
+// @Failure 500 {object} models.ErrorResponse "Internal server error - Failed to write data into database"
+// @Failure 502 {object} models.ErrorResponse "Bad gateway - Dictionary providers are currently unavailable"
+// @Router /api/words/from-dictionary [post]
+func (dc *Controller) CreateWordFromDictionary(c *gin.Context) {
+	// ================ 1. Parse request body ================
+	var req models.WordFromDictionaryRequest
+	if err := common.ParseRequestBody(&req, c); err != nil {
+		common.ResponseError(http.StatusBadRequest, "Invalid request body", models.ErrCodeInvalidRequest, err, c)
+		return
+	}
+	if err := validateWordFromDictionaryRequest(&req, dc.providers); err != nil {
+		common.ResponseError(http.StatusBadRequest, err.Error(), models.ErrCodeValidationError, err, c)
+		return
+	}
+	word := strings.TrimSpace(*req.Word)
+
+	providerNames := dc.providerOrder
+	if req.Provider != nil {
+		providerNames = []string{*req.Provider}
+	}
+
+	// ================ 2. Look up word ================
+	result, err := dc.lookup(word, *req.Language, providerNames)
+	if err != nil {
+		switch {
+		case errors.Is(err, errUnsupportedLanguage):
+			common.ResponseError(http.StatusBadRequest, fmt.Sprintf("Unsupported language '%s'", *req.Language), models.ErrCodeInvalidRequest, err, c)
+		case errors.Is(err, errWordNotFound):
+			common.ResponseError(http.StatusNotFound, fmt.Sprintf("Word '%s' not found", word), models.ErrCodeNotFound, err, c)
+		default:
+			common.ResponseError(http.StatusBadGateway, "Dictionary service is currently unavailable", models.ErrCodeUpstreamUnavailable, err, c)
+		}
+		return
+	}
+
+	// ================ 3. Pick definitions ================
+	if missing := result.SelectDefinitions(req.DefinitionIDs); len(missing) > 0 {
+		err := common.NewFieldError("definition_ids is invalid", "unknown", missing)
+		common.ResponseError(http.StatusBadRequest, err.Error(), models.ErrCodeValidationError, err, c)
+		return
+	}
+
+	// ================ 4. Save word and definitions ================
+	saved, err := dc.saveWord(word, result)
+	if err != nil {
+		common.RespondDatabaseWriteError(
+			"Failed to insert data into database",
+			"A word with this text was created at the same time",
+			err, c,
+		)
+		return
+	}
+
+	// ================ 5. Query saved data ================
+	wordEntity, err := dc.fetchWord(int(saved.WordID))
+	if err != nil {
+		common.ResponseError(http.StatusInternalServerError, "Saved but failed to fetch data from database", models.ErrCodeInternalError, err, c)
+		return
+	}
+
+	// ================ 6. Send response ================
+	common.ResponseSuccess(http.StatusOK, models.WordFromDictionaryResult{
+		Created: saved.Created,
+		Added:   saved.Added,
+		Skipped: saved.Skipped,
+		Word:    *wordEntity,
+	}, c)
+}
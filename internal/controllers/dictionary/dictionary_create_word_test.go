@@ -0,0 +1,118 @@
+package dictionary
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+
+	dbModels "word-flashcard/data/models"
+	"word-flashcard/data/peers"
+	"word-flashcard/data/schema"
+	"word-flashcard/internal/models"
+	"word-flashcard/utils"
+
+	"github.com/Masterminds/squirrel"
+	"github.com/go-sql-driver/mysql"
+	"github.com/stretchr/testify/mock"
+)
+
+// postWordFromDictionary sends a create-from-dictionary request and returns the recorder
+func (suite *ControllerTestSuite) postWordFromDictionary(body string) *httptest.ResponseRecorder {
+	req := httptest.NewRequest("POST", "/api/words/from-dictionary", strings.NewReader(body))
+	req.Header.Set("Content-Type", "application/json")
+	recorder := httptest.NewRecorder()
+	suite.router.ServeHTTP(recorder, req)
+	return recorder
+}
+
+// expectSavedWordFetch expects the saved word to be read back with definitions
+func (suite *ControllerTestSuite) expectSavedWordFetch(wordID int, definitions ...*dbModels.WordDefinition) {
+	suite.mockWordPeer.EXPECT().
+		Select(mock.Anything, squirrel.Eq{schema.WORD_ID: wordID}, mock.Anything, mock.Anything, mock.Anything).
+		Return([]*dbModels.Word{{Id: utils.IntPtr(wordID), Word: utils.StrPtr("hello")}}, nil).Times(1)
+	suite.mockWordDefinitionsPeer.EXPECT().
+		Select(mock.Anything, squirrel.Eq{schema.WORD_DEFINITIONS_WORD_ID: wordID}, mock.Anything, mock.Anything, mock.Anything).
+		Return(definitions, nil).Times(1)
+}
+
+// TestCreateWordFromDictionary tests that the picked definitions are saved
+// mapped from the lookup result and the saved word is returned
+func (suite *ControllerTestSuite) TestCreateWordFromDictionary() {
+	var saved []*dbModels.WordDefinition
+	suite.mockWordPeer.EXPECT().
+		SaveWithDefinitions(mock.MatchedBy(func(w *dbModels.Word) bool { return *w.Word == "hello" }), mock.Anything).
+		Run(func(args mock.Arguments) {
+			saved = args.Get(1).([]*dbModels.WordDefinition)
+		}).
+		Return(&peers.SavedWord{WordID: 7, Created: true, Added: 1}, nil).Times(1)
+	suite.expectSavedWordFetch(7, &dbModels.WordDefinition{Id: utils.IntPtr(1), PartOfSpeech: utils.StrPtr("noun")})
+
+	recorder := suite.postWordFromDictionary(`{"word":" hello ","language":"en-tw","definition_ids":[1]}`)
+
+	suite.Require().Equal(http.StatusOK, recorder.Code, recorder.Body.String())
+	var result models.WordFromDictionaryResult
+	suite.Require().NoError(json.Unmarshal(recorder.Body.Bytes(), &result))
+	suite.True(result.Created)
+	suite.Equal(1, result.Added)
+	suite.Equal(7, *result.Word.ID)
+	suite.Len(result.Word.Definitions, 1)
+
+	suite.Require().Len(saved, 1)
+	suite.Equal("（引起別人注意的招呼語） something that is said to attract someone's attention", *saved[0].Definition)
+	suite.JSONEq(`[]`, *saved[0].Examples)
+	suite.Contains(*saved[0].Phonetics, "hello-uk.mp3")
+}
+
+// TestCreateWordFromDictionaryMerges tests that a word that already exists
+// is reported as merged into rather than created
+func (suite *ControllerTestSuite) TestCreateWordFromDictionaryMerges() {
+	suite.mockWordPeer.EXPECT().
+		SaveWithDefinitions(mock.Anything, mock.MatchedBy(func(defs []*dbModels.WordDefinition) bool { return len(defs) == 2 })).
+		Return(&peers.SavedWord{WordID: 3, Added: 1, Skipped: 1}, nil).Times(1)
+	suite.expectSavedWordFetch(3)
+
+	recorder := suite.postWordFromDictionary(`{"word":"hello","language":"en-tw"}`)
+
+	suite.Require().Equal(http.StatusOK, recorder.Code, recorder.Body.String())
+	var result models.WordFromDictionaryResult
+	suite.Require().NoError(json.Unmarshal(recorder.Body.Bytes(), &result))
+	suite.False(result.Created)
+	suite.Equal(1, result.Added)
+	suite.Equal(1, result.Skipped)
+}
+
+// TestCreateWordFromDictionaryErrors tests how lookup, selection and write
+// failures are reported
+func (suite *ControllerTestSuite) TestCreateWordFromDictionaryErrors() {
+	tests := []struct {
+		name       string
+		body       string
+		saveErr    error
+		wantStatus int
+	}{
+		{"invalid JSON", `{"word":`, nil, http.StatusBadRequest},
+		{"missing word", `{"language":"en-tw"}`, nil, http.StatusBadRequest},
+		{"invalid language", `{"word":"hello","language":"English"}`, nil, http.StatusBadRequest},
+		{"unknown provider", `{"word":"hello","language":"en-tw","provider":"oxford"}`, nil, http.StatusBadRequest},
+		{"unsupported language", `{"word":"hello","language":"xx"}`, nil, http.StatusBadRequest},
+		{"unknown definition", `{"word":"hello","language":"en-tw","definition_ids":[0,5]}`, nil, http.StatusBadRequest},
+		{"word not in dictionary", `{"word":"zzzznotaword","language":"en-tw"}`, nil, http.StatusNotFound},
+		{"upstream unavailable", `{"word":"upstreamerror","language":"en-tw"}`, nil, http.StatusBadGateway},
+		{"created concurrently", `{"word":"hello","language":"en-tw"}`, &mysql.MySQLError{Number: 1062, Message: "Duplicate entry"}, http.StatusConflict},
+		{"database failure", `{"word":"hello","language":"en-tw"}`, errors.New("db down"), http.StatusInternalServerError},
+	}
+
+	for _, tt := range tests {
+		suite.Run(tt.name, func() {
+			if tt.saveErr != nil {
+				suite.mockWordPeer.EXPECT().SaveWithDefinitions(mock.Anything, mock.Anything).Return(nil, tt.saveErr).Once()
+			}
+
+			recorder := suite.postWordFromDictionary(tt.body)
+
+			suite.Equal(tt.wantStatus, recorder.Code, recorder.Body.String())
+		})
+	}
+}
@@ -0,0 +1,93 @@
+package dictionary
+
+import (
+	"errors"
+	"net/http"
+	"os"
+
+	"word-flashcard/internal/controllers/common"
+	"word-flashcard/internal/models"
+	"word-flashcard/utils/config"
+
+	"github.com/gin-gonic/gin"
+)
+
+// defaultImportDir is where dictionary dumps are read from unless DICTIONARY_IMPORT_DIR says otherwise
+const defaultImportDir = "dictionaries"
+
+// ImportDictionary handles importing a dictionary dump into an offline provider
+// @Summary Import an offline dictionary
+// @Description Streams a dictionary dump already placed in the server's import directory into the offline dictionary tables. StarDict
+// @Description (path to the .ifo file; .idx/.dict may be gzipped) and WordNet (path to the database directory) dumps feed the local
+// @Description provider, Wiktionary JSONL extracts from wiktextract (optionally gzipped) feed the wiktionary provider. Headwords that
+// @Description are already stored are merged with the imported entry unless replace is set, in which case the provider's entries for
+// @Description the language are swapped for the dump's only once all of it is stored; a replace that fails leaves them as they were.
+// @Tags dictionary
+// @Accept json
+// @Produce json
+// @Param import body models.DictionaryImportRequest true "Dump format, language slug and path relative to DICTIONARY_IMPORT_DIR; lang_code filters Wiktionary records (default en)"
+// @Success 200 {object} models.DictionaryImportSummary "Dictionary imported successfully"
+// @Failure 400 {object} models.ErrorResponse "Bad request - Invalid request body or dump file could not be parsed"
+// @Failure 404 {object} models.ErrorResponse "Not found - Dump file not found"
+// @Failure 500 {object} models.ErrorResponse "Internal server error - Failed to write entries into database"
+// @Router /api/dictionary/import [post]
+func (dc *Controller) ImportDictionary(c *gin.Context) {
+	// ================ 1. Parse request body ================
+	var req models.DictionaryImportRequest
+	if err := common.ParseRequestBody(&req, c); err != nil {
+		common.ResponseError(http.StatusBadRequest, "Invalid request body", models.ErrCodeInvalidRequest, err, c)
+		return
+	}
+	if err := validateImportRequest(&req); err != nil {
+		common.ResponseError(http.StatusBadRequest, err.Error(), models.ErrCodeValidationError, err, c)
+		return
+	}
+
+	// ================ 2. Locate dump file ================
+	path, err := resolveImportPath(config.GetOrDefault("DICTIONARY_IMPORT_DIR", defaultImportDir), *req.Path)
+	if err != nil {
+		common.ResponseError(http.StatusBadRequest, err.Error(), models.ErrCodeValidationError, err, c)
+		return
+	}
+	if _, err := os.Stat(path); err != nil {
+		common.ResponseError(http.StatusNotFound, "Dictionary file not found", models.ErrCodeNotFound, err, c)
+		return
+	}
+
+	// ================ 3. Stream dump into provider ================
+	var parse dumpParser
+	switch *req.Format {
+	case FormatStarDict:
+		parse = parseStarDict(path)
+	case FormatWordNet:
+		parse = parseWordNet(path)
+	case FormatWiktionary:
+		langCode := "en"
+		if req.LangCode != nil {
+			langCode = *req.LangCode
+		}
+		parse = parseWiktionary(path, langCode)
+	}
+
+	provider, ok := dc.providers[importFormatProviders[*req.Format]].(*offlineProvider)
+	if !ok {
+		common.ResponseError(http.StatusInternalServerError, "Offline dictionary is not configured", models.ErrCodeInternalError, nil, c)
+		return
+	}
+	summary, err := provider.Import(*req.Language, req.Replace, parse)
+	if err != nil {
+		switch {
+		case errors.Is(err, errMalformedDump):
+			common.ResponseError(http.StatusBadRequest, "Dictionary file could not be parsed", models.ErrCodeInvalidRequest, err, c)
+		case errors.Is(err, os.ErrNotExist):
+			common.ResponseError(http.StatusNotFound, "Dictionary file not found", models.ErrCodeNotFound, err, c)
+		default:
+			common.ResponseError(http.StatusInternalServerError, "Failed to write entries into database", models.ErrCodeInternalError, err, c)
+		}
+		return
+	}
+
+	// ================ 4. Send response ================
+	summary.Format = *req.Format
+	common.ResponseSuccess(http.StatusOK, summary, c)
+}
@@ -0,0 +1,113 @@
+package dictionary
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"word-flashcard/internal/models"
+
+	"github.com/stretchr/testify/mock"
+)
+
+// postImport sends an import request and returns the recorder
+func (suite *ControllerTestSuite) postImport(body string) *httptest.ResponseRecorder {
+	req := httptest.NewRequest("POST", "/api/dictionary/import", strings.NewReader(body))
+	req.Header.Set("Content-Type", "application/json")
+	recorder := httptest.NewRecorder()
+	suite.router.ServeHTTP(recorder, req)
+	return recorder
+}
+
+// TestImportDictionarySuccess tests that a Wiktionary extract in the import
+// directory is stored under the wiktionary provider and answers lookups
+func (suite *ControllerTestSuite) TestImportDictionarySuccess() {
+	dir := suite.T().TempDir()
+	suite.T().Setenv("DICTIONARY_IMPORT_DIR", dir)
+	suite.Require().NoError(os.MkdirAll(filepath.Join(dir, "wiktionary"), 0o755))
+	suite.Require().NoError(os.WriteFile(filepath.Join(dir, "wiktionary", "en.jsonl"), []byte(wiktionaryTestDump), 0o644))
+
+	expectHeadwords(suite.mockDictionaryEntryPeer, ProviderWiktionary, "en")
+	suite.mockDictionaryEntryPeer.EXPECT().InsertBatch(mock.Anything).Return(nil).Times(1)
+
+	recorder := suite.postImport(`{"format":"wiktionary","language":"en","path":"wiktionary/en.jsonl"}`)
+
+	suite.Require().Equal(http.StatusOK, recorder.Code, recorder.Body.String())
+	var summary models.DictionaryImportSummary
+	suite.Require().NoError(json.Unmarshal(recorder.Body.Bytes(), &summary))
+	suite.Equal(models.DictionaryImportSummary{Format: FormatWiktionary, Provider: ProviderWiktionary, Language: "en", Entries: 1, Skipped: 2}, summary)
+
+	// The rebuilt index already knows the new headword
+	expectEntry(suite.mockDictionaryEntryPeer, ProviderWiktionary, "en", "dog", `{"word":"dog"}`)
+	req := httptest.NewRequest("GET", "/api/dictionary/en/dog?provider=wiktionary", nil)
+	lookup := httptest.NewRecorder()
+	suite.router.ServeHTTP(lookup, req)
+	suite.Equal(http.StatusOK, lookup.Code)
+}
+
+// TestImportDictionaryValidation tests that bad requests are rejected before
+// any file is read
+func (suite *ControllerTestSuite) TestImportDictionaryValidation() {
+	suite.T().Setenv("DICTIONARY_IMPORT_DIR", suite.T().TempDir())
+
+	tests := []struct {
+		name string
+		body string
+	}{
+		{"malformed body", `{"format":`},
+		{"missing format", `{"language":"en","path":"a.ifo"}`},
+		{"unknown format", `{"format":"mdict","language":"en","path":"a.mdx"}`},
+		{"invalid language", `{"format":"stardict","language":"English","path":"a.ifo"}`},
+		{"missing path", `{"format":"stardict","language":"en"}`},
+		{"absolute path", `{"format":"stardict","language":"en","path":"/etc/passwd"}`},
+		{"path traversal", `{"format":"stardict","language":"en","path":"../../etc/passwd"}`},
+	}
+
+	for _, tt := range tests {
+		suite.Run(tt.name, func() {
+			recorder := suite.postImport(tt.body)
+
+			suite.Equal(http.StatusBadRequest, recorder.Code)
+		})
+	}
+}
+
+// TestImportDictionaryFileErrors tests missing and unparsable dump files
+func (suite *ControllerTestSuite) TestImportDictionaryFileErrors() {
+	dir := suite.T().TempDir()
+	suite.T().Setenv("DICTIONARY_IMPORT_DIR", dir)
+	suite.Require().NoError(os.WriteFile(filepath.Join(dir, "bad.ifo"), []byte("not stardict\n"), 0o644))
+
+	suite.Run("missing file", func() {
+		recorder := suite.postImport(`{"format":"stardict","language":"en","path":"missing.ifo"}`)
+
+		suite.Equal(http.StatusNotFound, recorder.Code)
+	})
+
+	suite.Run("malformed file", func() {
+		expectHeadwords(suite.mockDictionaryEntryPeer, ProviderLocal, "en")
+
+		recorder := suite.postImport(`{"format":"stardict","language":"en","path":"bad.ifo"}`)
+
+		suite.Equal(http.StatusBadRequest, recorder.Code)
+	})
+}
+
+// TestImportDictionaryDatabaseError tests that a failure to load the stored
+// headwords is reported as a server error
+func (suite *ControllerTestSuite) TestImportDictionaryDatabaseError() {
+	dir := suite.T().TempDir()
+	suite.T().Setenv("DICTIONARY_IMPORT_DIR", dir)
+	suite.Require().NoError(os.WriteFile(filepath.Join(dir, "data.noun"), []byte(""), 0o644))
+	suite.mockDictionaryEntryPeer.EXPECT().
+		Select(mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+		Return(nil, errors.New("connection refused")).Times(1)
+
+	recorder := suite.postImport(`{"format":"wordnet","language":"en","path":"."}`)
+
+	suite.Equal(http.StatusInternalServerError, recorder.Code)
+}
@@ -4,7 +4,6 @@ import (
 	"errors"
 	"fmt"
 	"net/http"
-	"strings"
 
 	"word-flashcard/internal/controllers/common"
 	"word-flashcard/internal/models"
@@ -14,20 +13,25 @@ import (
 
 // SearchWord handles dictionary lookup requests
 // @Summary Search dictionary for word definition
-// @Description Get dictionary definition and pronunciation for a given word by scraping Cambridge Dictionary
+// @Description Get dictionary definition and pronunciation for a given word. Providers (the Cambridge Dictionary site and the imported
+// @Description offline dictionaries) are tried in the configured order, falling back to the next one when a provider doesn't have the
+// @Description word or is unavailable. Results from Cambridge are cached in the database, so a word looked up before is answered
+// @Description without contacting the site until its cache entry expires.
 // @Tags dictionary
 // @Accept json
 // @Produce json
-// @Param language path string true "Dictionary language slug (only en-tw is currently supported)"
+// @Param language path string true "Dictionary language slug (Cambridge supports en, uk, en-tw, en-cn, en-ja, en-ko, en-es, en-fr, en-de, en-it, en-pt and en-ru)"
 // @Param word path string true "Word to search for"
-// @Success 200 {object} models.CambridgeResponse "Dictionary definition found successfully"
-// @Failure 400 {object} models.ErrorResponse "Bad request - Missing word parameter or unsupported language"
+// @Param provider query string false "Only look the word up with this provider: cambridge, local or wiktionary"
+// @Success 200 {object} models.DictionaryResult "Dictionary definition found successfully"
+// @Failure 400 {object} models.ErrorResponse "Bad request - Missing word parameter, unknown provider or unsupported language"
 // @Failure 404 {object} models.ErrorResponse "Not found - Word not found in the dictionary"
-// @Failure 502 {object} models.ErrorResponse "Bad gateway - Cambridge Dictionary is currently unavailable"
+// @Failure 502 {object} models.ErrorResponse "Bad gateway - Dictionary providers are currently unavailable"
 // @Router /api/dictionary/{language}/{word} [get]
 func (dc *Controller) SearchWord(c *gin.Context) {
 	word := c.Param("word")
 	language := c.Param("language")
+	provider := c.Query("provider")
 
 	// Validate word parameter
 	if word == "" {
@@ -35,17 +39,18 @@ func (dc *Controller) SearchWord(c *gin.Context) {
 		return
 	}
 
-	// Check cache first
-	cacheKey := fmt.Sprintf("dict_%s_%s", language, strings.ReplaceAll(word, " ", "_"))
-	if cached := dc.getFromCache(cacheKey); cached != nil {
-		if response, ok := cached.(models.CambridgeResponse); ok {
-			c.JSON(http.StatusOK, response)
+	// Pick the providers to ask
+	providerNames := dc.providerOrder
+	if provider != "" {
+		if _, ok := dc.providers[provider]; !ok {
+			common.ResponseError(http.StatusBadRequest, fmt.Sprintf("Unknown provider '%s'", provider), models.ErrCodeInvalidRequest, nil, c)
 			return
 		}
+		providerNames = []string{provider}
 	}
 
-	// Fetch word data by scraping Cambridge Dictionary
-	response, err := dc.fetchWordDataFromCambridge(word, language)
+	// Fetch word data, falling back across providers
+	response, err := dc.lookup(word, language, providerNames)
 	if err != nil {
 		switch {
 		case errors.Is(err, errUnsupportedLanguage):
@@ -58,9 +63,6 @@ func (dc *Controller) SearchWord(c *gin.Context) {
 		return
 	}
 
-	// Cache the result
-	dc.setCache(cacheKey, *response)
-
 	// Return response
 	c.JSON(http.StatusOK, response)
 }
@@ -5,9 +5,14 @@ import (
 	"net/http"
 	"net/http/httptest"
 
+	dbModels "word-flashcard/data/models"
+	"word-flashcard/data/schema"
 	"word-flashcard/internal/models"
+	"word-flashcard/utils"
 
+	"github.com/Masterminds/squirrel"
 	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/mock"
 )
 
 // TestSearchWordSuccess tests that SearchWord successfully handles a valid word request
@@ -23,11 +28,12 @@ func (suite *ControllerTestSuite) TestSearchWordSuccess() {
 	suite.Equal(http.StatusOK, recorder.Code, "Dictionary search should respond with 200 OK")
 
 	// Verify the response contains valid JSON matching the Cambridge response shape
-	var response models.CambridgeResponse
+	var response models.DictionaryResult
 	err := json.Unmarshal(recorder.Body.Bytes(), &response)
 	suite.NoError(err, "Response should be valid JSON")
 
 	suite.Equal("hello", response.Word)
+	suite.Equal(ProviderCambridge, response.Provider)
 	suite.ElementsMatch([]string{"exclamation", "noun"}, response.POS)
 	suite.Empty(response.Verbs, "verbs are not scraped yet and should stay an empty array")
 	suite.NotEmpty(response.Pronunciation, "Response should contain pronunciation")
@@ -40,8 +46,28 @@ func (suite *ControllerTestSuite) TestSearchWordSuccess() {
 
 // TestSearchWordUsesCache tests that a cached result is served without re-fetching
 // from Cambridge Dictionary, by closing the mock origin after the first request and
-// confirming the second request for the same word still succeeds from cache.
+// confirming the second request for the same word is answered from the row the
+// first one stored.
 func (suite *ControllerTestSuite) TestSearchWordUsesCache() {
+	peer := suite.useCachePeer()
+	var stored *dbModels.DictionaryCacheEntry
+	peer.EXPECT().
+		Select(mock.Anything, cacheWhere(ProviderCambridge, "en-tw", "hello"), mock.Anything, mock.Anything, mock.Anything).
+		Return(func([]*string, squirrel.Sqlizer, []*string, *uint64, *uint64) []*dbModels.DictionaryCacheEntry {
+			if stored == nil {
+				return []*dbModels.DictionaryCacheEntry{}
+			}
+			return []*dbModels.DictionaryCacheEntry{stored}
+		}, nil).Times(2)
+	peer.EXPECT().Insert(mock.Anything).
+		Run(func(args mock.Arguments) {
+			stored = args.Get(0).(*dbModels.DictionaryCacheEntry)
+			stored.Id = utils.IntPtr(1)
+		}).
+		Return(int64(1), nil).Times(1)
+	peer.EXPECT().Count(mock.Anything).Return(int64(1), nil).Times(1)
+	peer.EXPECT().Update(mock.Anything, squirrel.Eq{schema.DICTIONARY_CACHE_ID: 1}).Return(int64(1), nil).Times(1)
+
 	firstReq := httptest.NewRequest("GET", "/api/dictionary/en-tw/hello", nil)
 	firstRecorder := httptest.NewRecorder()
 	suite.router.ServeHTTP(firstRecorder, firstReq)
@@ -52,7 +78,7 @@ func (suite *ControllerTestSuite) TestSearchWordUsesCache() {
 	suite.mockCambridgeServer.Close()
 	suite.mockCambridgeServer = nil
 
-	secondReq := httptest.NewRequest("GET", "/api/dictionary/en-tw/hello", nil)
+	secondReq := httptest.NewRequest("GET", "/api/dictionary/en-tw/Hello", nil)
 	secondRecorder := httptest.NewRecorder()
 	suite.router.ServeHTTP(secondRecorder, secondReq)
 
@@ -83,7 +109,7 @@ func (suite *ControllerTestSuite) TestSearchWordEmptyParameter() {
 // TestSearchWordUnsupportedLanguage tests that SearchWord rejects a language slug
 // that this controller does not know how to fetch
 func (suite *ControllerTestSuite) TestSearchWordUnsupportedLanguage() {
-	req := httptest.NewRequest("GET", "/api/dictionary/en/hello", nil)
+	req := httptest.NewRequest("GET", "/api/dictionary/xx/hello", nil)
 	recorder := httptest.NewRecorder()
 
 	suite.router.ServeHTTP(recorder, req)
@@ -131,3 +157,66 @@ func (suite *ControllerTestSuite) TestSearchWordUpstreamUnavailable() {
 	suite.NoError(err, "Error response should be valid JSON")
 	suite.Equal(models.ErrCodeUpstreamUnavailable, response.Code)
 }
+
+// TestSearchWordUnknownProvider tests that SearchWord rejects a provider query
+// parameter naming no registered provider
+func (suite *ControllerTestSuite) TestSearchWordUnknownProvider() {
+	req := httptest.NewRequest("GET", "/api/dictionary/en-tw/hello?provider=oxford", nil)
+	recorder := httptest.NewRecorder()
+
+	suite.router.ServeHTTP(recorder, req)
+
+	suite.Equal(http.StatusBadRequest, recorder.Code, "Unknown provider should respond with 400 Bad Request")
+	suite.Contains(recorder.Body.String(), "Unknown provider 'oxford'")
+}
+
+// TestSearchWordProviderParameter tests that the provider query parameter
+// skips the configured order and asks only the named provider
+func (suite *ControllerTestSuite) TestSearchWordProviderParameter() {
+	expectHeadwords(suite.mockDictionaryEntryPeer, ProviderWiktionary, "en-tw", "hello")
+	expectEntry(suite.mockDictionaryEntryPeer, ProviderWiktionary, "en-tw", "hello", `{"word":"hello","pos":["exclamation"]}`)
+
+	req := httptest.NewRequest("GET", "/api/dictionary/en-tw/hello?provider=wiktionary", nil)
+	recorder := httptest.NewRecorder()
+
+	suite.router.ServeHTTP(recorder, req)
+
+	suite.Require().Equal(http.StatusOK, recorder.Code)
+	var response models.DictionaryResult
+	suite.NoError(json.Unmarshal(recorder.Body.Bytes(), &response))
+	suite.Equal(ProviderWiktionary, response.Provider)
+	suite.Equal([]string{"exclamation"}, response.POS)
+}
+
+// TestSearchWordFallsBackToNextProvider tests that a word Cambridge doesn't
+// have is looked up in the next configured provider
+func (suite *ControllerTestSuite) TestSearchWordFallsBackToNextProvider() {
+	suite.controller.providerOrder = []string{ProviderCambridge, ProviderLocal}
+	expectHeadwords(suite.mockDictionaryEntryPeer, ProviderLocal, "en-tw", "nonexistentword")
+	expectEntry(suite.mockDictionaryEntryPeer, ProviderLocal, "en-tw", "nonexistentword", `{"word":"nonexistentword"}`)
+
+	req := httptest.NewRequest("GET", "/api/dictionary/en-tw/nonexistentword", nil)
+	recorder := httptest.NewRecorder()
+
+	suite.router.ServeHTTP(recorder, req)
+
+	suite.Require().Equal(http.StatusOK, recorder.Code)
+	var response models.DictionaryResult
+	suite.NoError(json.Unmarshal(recorder.Body.Bytes(), &response))
+	suite.Equal(ProviderLocal, response.Provider)
+}
+
+// TestSearchWordReportsUnavailableOverNotFound tests that when Cambridge is
+// down and the offline dictionary doesn't have the word, the outage is what
+// gets reported
+func (suite *ControllerTestSuite) TestSearchWordReportsUnavailableOverNotFound() {
+	suite.controller.providerOrder = []string{ProviderCambridge, ProviderLocal}
+	expectHeadwords(suite.mockDictionaryEntryPeer, ProviderLocal, "en-tw")
+
+	req := httptest.NewRequest("GET", "/api/dictionary/en-tw/upstreamerror", nil)
+	recorder := httptest.NewRecorder()
+
+	suite.router.ServeHTTP(recorder, req)
+
+	suite.Equal(http.StatusBadGateway, recorder.Code)
+}
@@ -0,0 +1,101 @@
+package dictionary
+
+import (
+	"net/http"
+	"strings"
+
+	"word-flashcard/internal/controllers/common"
+	"word-flashcard/internal/models"
+
+	"github.com/gin-gonic/gin"
+)
+
+// Bounds of the suggestions limit query parameter
+const (
+	defaultSuggestionLimit = 10
+	maxSuggestionLimit     = 50
+)
+
+// suggestingProvider is implemented by providers that can propose headwords
+// for a partial or misspelt word
+type suggestingProvider interface {
+	Suggest(query, language string, limit int) ([]models.DictionarySuggestion, error)
+}
+
+// SuggestWords handles headword suggestion requests
+// @Summary Suggest dictionary headwords
+// @Description Suggests headwords from the imported offline dictionaries for a partial or misspelt word: the word itself if it exists,
+// @Description then headwords starting with it, then headwords within one or two typos of it. Providers are asked in the configured
+// @Description order and a word suggested by several is listed once.
+// @Tags dictionary
+// @Produce json
+// @Param language query string true "Dictionary language slug, e.g. en-tw"
+// @Param q query string true "Partial or misspelt word"
+// @Param provider query string false "Only suggest headwords from this provider: local or wiktionary"
+// @Param limit query int false "Maximum number of suggestions (default 10, max 50)"
+// @Success 200 {array} models.DictionarySuggestion "Suggestions, best first"
+// @Failure 400 {object} models.ErrorResponse "Bad request - Missing query, invalid limit or unknown provider"
+// @Failure 502 {object} models.ErrorResponse "Bad gateway - Offline dictionaries are currently unavailable"
+// @Router /api/dictionary/suggestions [get]
+func (dc *Controller) SuggestWords(c *gin.Context) {
+	// ================ 1. Parse query parameters ================
+	query := strings.TrimSpace(c.Query("q"))
+	language := c.Query("language")
+	if query == "" || language == "" {
+		common.ResponseError(http.StatusBadRequest, "Parameters q and language are required", models.ErrCodeInvalidRequest, nil, c)
+		return
+	}
+	limit, err := common.ParseIntQueryParam(c, "limit", defaultSuggestionLimit)
+	if err != nil || limit <= 0 || limit > maxSuggestionLimit {
+		common.ResponseError(http.StatusBadRequest, "Invalid limit parameter", models.ErrCodeInvalidRequest, err, c)
+		return
+	}
+
+	providerNames := dc.providerOrder
+	if provider := c.Query("provider"); provider != "" {
+		if _, ok := dc.providers[provider].(suggestingProvider); !ok {
+			common.ResponseError(http.StatusBadRequest, "Unknown provider '"+provider+"'", models.ErrCodeInvalidRequest, nil, c)
+			return
+		}
+		providerNames = []string{provider}
+	}
+
+	// ================ 2. Collect suggestions ================
+	suggestions := []models.DictionarySuggestion{}
+	seen := make(map[string]bool)
+	for _, name := range providerNames {
+		provider, ok := dc.providers[name].(suggestingProvider)
+		if !ok {
+			continue
+		}
+		found, err := provider.Suggest(query, language, limit)
+		if err != nil {
+			common.ResponseError(http.StatusBadGateway, "Dictionary service is currently unavailable", models.ErrCodeUpstreamUnavailable, err, c)
+			return
+		}
+		for _, suggestion := range found {
+			if !seen[suggestion.Word] {
+				seen[suggestion.Word] = true
+				suggestions = append(suggestions, suggestion)
+			}
+		}
+	}
+	suggestions = rankSuggestions(suggestions, limit)
+
+	// ================ 3. Send response ================
+	common.ResponseSuccess(http.StatusOK, suggestions, c)
+}
+
+// rankSuggestions orders suggestions by match kind, keeping provider order
+// within a kind, and trims them to limit
+func rankSuggestions(suggestions []models.DictionarySuggestion, limit int) []models.DictionarySuggestion {
+	ranked := make([]models.DictionarySuggestion, 0, len(suggestions))
+	for _, match := range []string{matchExact, matchPrefix, matchFuzzy} {
+		for _, suggestion := range suggestions {
+			if suggestion.Match == match && len(ranked) < limit {
+				ranked = append(ranked, suggestion)
+			}
+		}
+	}
+	return ranked
+}
@@ -0,0 +1,92 @@
+package dictionary
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+
+	"word-flashcard/internal/models"
+
+	"github.com/stretchr/testify/mock"
+)
+
+// getSuggestions sends a suggestions request and returns the recorder
+func (suite *ControllerTestSuite) getSuggestions(query string) *httptest.ResponseRecorder {
+	req := httptest.NewRequest("GET", "/api/dictionary/suggestions?"+query, nil)
+	recorder := httptest.NewRecorder()
+	suite.router.ServeHTTP(recorder, req)
+	return recorder
+}
+
+// TestSuggestWords tests that suggestions from every offline provider are
+// merged, ranked by match kind and listed once
+func (suite *ControllerTestSuite) TestSuggestWords() {
+	suite.controller.providerOrder = []string{ProviderCambridge, ProviderLocal, ProviderWiktionary}
+	expectHeadwords(suite.mockDictionaryEntryPeer, ProviderLocal, "en", "colour", "colourful")
+	expectHeadwords(suite.mockDictionaryEntryPeer, ProviderWiktionary, "en", "color", "colour", "colors")
+
+	recorder := suite.getSuggestions("language=en&q=colo")
+
+	suite.Require().Equal(http.StatusOK, recorder.Code)
+	var suggestions []models.DictionarySuggestion
+	suite.Require().NoError(json.Unmarshal(recorder.Body.Bytes(), &suggestions))
+	suite.Equal([]models.DictionarySuggestion{
+		{Word: "colour", Provider: ProviderLocal, Match: matchPrefix},
+		{Word: "colourful", Provider: ProviderLocal, Match: matchPrefix},
+		{Word: "color", Provider: ProviderWiktionary, Match: matchPrefix},
+		{Word: "colors", Provider: ProviderWiktionary, Match: matchPrefix},
+	}, suggestions)
+}
+
+// TestSuggestWordsProviderAndLimit tests the provider and limit parameters
+func (suite *ControllerTestSuite) TestSuggestWordsProviderAndLimit() {
+	expectHeadwords(suite.mockDictionaryEntryPeer, ProviderWiktionary, "en", "color", "colour", "colors")
+
+	recorder := suite.getSuggestions("language=en&q=colr&provider=wiktionary&limit=1")
+
+	suite.Require().Equal(http.StatusOK, recorder.Code)
+	var suggestions []models.DictionarySuggestion
+	suite.Require().NoError(json.Unmarshal(recorder.Body.Bytes(), &suggestions))
+	suite.Equal([]models.DictionarySuggestion{{Word: "color", Provider: ProviderWiktionary, Match: matchFuzzy}}, suggestions)
+}
+
+// TestSuggestWordsEmpty tests that no match is an empty list, not null
+func (suite *ControllerTestSuite) TestSuggestWordsEmpty() {
+	suite.controller.providerOrder = []string{ProviderLocal}
+	expectHeadwords(suite.mockDictionaryEntryPeer, ProviderLocal, "en")
+
+	recorder := suite.getSuggestions("language=en&q=anything")
+
+	suite.Equal(http.StatusOK, recorder.Code)
+	suite.JSONEq(`[]`, recorder.Body.String())
+}
+
+// TestSuggestWordsBadRequest tests rejected query parameters
+func (suite *ControllerTestSuite) TestSuggestWordsBadRequest() {
+	for _, query := range []string{
+		"language=en",
+		"q=colour",
+		"language=en&q=colour&limit=0",
+		"language=en&q=colour&limit=abc",
+		"language=en&q=colour&limit=51",
+		"language=en&q=colour&provider=cambridge",
+		"language=en&q=colour&provider=unknown",
+	} {
+		recorder := suite.getSuggestions(query)
+
+		suite.Equal(http.StatusBadRequest, recorder.Code, query)
+	}
+}
+
+// TestSuggestWordsUnavailable tests that an index that can't be loaded is a bad gateway
+func (suite *ControllerTestSuite) TestSuggestWordsUnavailable() {
+	suite.controller.providerOrder = []string{ProviderLocal}
+	suite.mockDictionaryEntryPeer.EXPECT().
+		Select(mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+		Return(nil, errors.New("connection refused")).Times(1)
+
+	recorder := suite.getSuggestions("language=en&q=colour")
+
+	suite.Equal(http.StatusBadGateway, recorder.Code)
+}
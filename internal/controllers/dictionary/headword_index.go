@@ -0,0 +1,104 @@
+package dictionary
+
+import (
+	"sort"
+	"strings"
+	"unicode/utf8"
+)
+
+// headwordIndex holds the normalised headwords of one offline source and
+// language in memory, sorted, so a lookup can tell whether a word exists and
+// suggest close matches without a database round trip.
+type headwordIndex struct {
+	words []string
+	set   map[string]struct{}
+}
+
+// newHeadwordIndex builds an index over a set of normalised headwords
+func newHeadwordIndex(set map[string]struct{}) *headwordIndex {
+	words := make([]string, 0, len(set))
+	for word := range set {
+		words = append(words, word)
+	}
+	sort.Strings(words)
+	return &headwordIndex{words: words, set: set}
+}
+
+// contains reports whether a normalised headword has an entry
+func (ix *headwordIndex) contains(normalized string) bool {
+	_, ok := ix.set[normalized]
+	return ok
+}
+
+// withPrefix returns up to limit headwords starting with prefix, in order
+func (ix *headwordIndex) withPrefix(prefix string, limit int) []string {
+	var matches []string
+	for i := sort.SearchStrings(ix.words, prefix); i < len(ix.words) && len(matches) < limit; i++ {
+		if !strings.HasPrefix(ix.words[i], prefix) {
+			break
+		}
+		matches = append(matches, ix.words[i])
+	}
+	return matches
+}
+
+// similarTo returns up to limit headwords within maxDistance edits of word,
+// closest first. Headwords whose length alone rules them out are skipped
+// before any distance is computed.
+func (ix *headwordIndex) similarTo(word string, maxDistance, limit int) []string {
+	type candidate struct {
+		word     string
+		distance int
+	}
+	wordLen := utf8.RuneCountInString(word)
+
+	var candidates []candidate
+	for _, headword := range ix.words {
+		lenDiff := utf8.RuneCountInString(headword) - wordLen
+		if lenDiff > maxDistance || -lenDiff > maxDistance || headword == word {
+			continue
+		}
+		if distance := boundedEditDistance(word, headword, maxDistance); distance <= maxDistance {
+			candidates = append(candidates, candidate{headword, distance})
+		}
+	}
+	sort.SliceStable(candidates, func(i, j int) bool { return candidates[i].distance < candidates[j].distance })
+
+	var matches []string
+	for _, c := range candidates {
+		if len(matches) == limit {
+			break
+		}
+		matches = append(matches, c.word)
+	}
+	return matches
+}
+
+// boundedEditDistance returns the Levenshtein distance between a and b, or
+// maxDistance+1 as soon as it is certain to exceed maxDistance.
+func boundedEditDistance(a, b string, maxDistance int) int {
+	ar, br := []rune(a), []rune(b)
+	prev := make([]int, len(br)+1)
+	curr := make([]int, len(br)+1)
+	for j := range prev {
+		prev[j] = j
+	}
+
+	for i := 1; i <= len(ar); i++ {
+		curr[0] = i
+		rowMin := curr[0]
+		for j := 1; j <= len(br); j++ {
+			cost := 1
+			if ar[i-1] == br[j-1] {
+				cost = 0
+			}
+			curr[j] = min(prev[j]+1, curr[j-1]+1, prev[j-1]+cost)
+			rowMin = min(rowMin, curr[j])
+		}
+		if rowMin > maxDistance {
+			return maxDistance + 1
+		}
+		prev, curr = curr, prev
+	}
+	return prev[len(br)]
+}
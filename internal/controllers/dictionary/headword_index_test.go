@@ -0,0 +1,57 @@
+package dictionary
+
+// newTestHeadwordIndex builds an index over words
+func newTestHeadwordIndex(words ...string) *headwordIndex {
+	set := make(map[string]struct{}, len(words))
+	for _, word := range words {
+		set[word] = struct{}{}
+	}
+	return newHeadwordIndex(set)
+}
+
+// TestHeadwordIndexContains tests exact membership
+func (suite *ControllerTestSuite) TestHeadwordIndexContains() {
+	index := newTestHeadwordIndex("apple", "look up")
+
+	suite.True(index.contains("apple"))
+	suite.True(index.contains("look up"))
+	suite.False(index.contains("appl"))
+}
+
+// TestHeadwordIndexWithPrefix tests that prefix matches come back sorted and
+// stop at the limit
+func (suite *ControllerTestSuite) TestHeadwordIndexWithPrefix() {
+	index := newTestHeadwordIndex("carton", "car", "cart", "card", "cat", "scar")
+
+	suite.Equal([]string{"car", "card", "cart", "carton"}, index.withPrefix("car", 10))
+	suite.Equal([]string{"car", "card"}, index.withPrefix("car", 2))
+	suite.Empty(index.withPrefix("dog", 10))
+}
+
+// TestHeadwordIndexSimilarTo tests that fuzzy matches are ranked by edit
+// distance and exclude the word itself
+func (suite *ControllerTestSuite) TestHeadwordIndexSimilarTo() {
+	index := newTestHeadwordIndex("receive", "recieve", "deceive", "relieve", "reserve", "receiver", "perceive")
+
+	suite.Equal([]string{"relieve", "receive"}, index.similarTo("recieve", 2, 2))
+	suite.Equal([]string{"deceive", "receiver"}, index.similarTo("receive", 1, 10))
+}
+
+// TestBoundedEditDistance tests the Levenshtein distance and its early exit
+func (suite *ControllerTestSuite) TestBoundedEditDistance() {
+	tests := []struct {
+		a, b        string
+		maxDistance int
+		want        int
+	}{
+		{"kitten", "sitting", 3, 3},
+		{"kitten", "sitting", 1, 2},
+		{"café", "cafe", 2, 1},
+		{"", "abc", 5, 3},
+		{"same", "same", 0, 0},
+	}
+
+	for _, tt := range tests {
+		suite.Equal(tt.want, boundedEditDistance(tt.a, tt.b, tt.maxDistance), "%s -> %s", tt.a, tt.b)
+	}
+}
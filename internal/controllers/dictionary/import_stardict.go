@@ -0,0 +1,254 @@
+package dictionary
+
+import (
+	"bufio"
+	"bytes"
+	"compress/gzip"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"html"
+	"io"
+	"os"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"word-flashcard/internal/models"
+)
+
+// starDictMarkupRE matches the tags of the HTML/XDXF/Pango markup StarDict
+// definitions may be written in, and starDictBreakRE the line breaks among them
+var (
+	starDictMarkupRE = regexp.MustCompile(`<[^>]*>`)
+	starDictBreakRE  = regexp.MustCompile(`(?i)<br\s*/?>`)
+)
+
+// starDictInfo holds the .ifo fields the importer needs
+type starDictInfo struct {
+	idxOffsetBits    int
+	sameTypeSequence string
+}
+
+// parseStarDict returns a parser for the StarDict dictionary described by
+// the .ifo file at ifoPath. The .idx (or .idx.gz) and .dict (or .dict.dz)
+// files must sit next to it with the same base name. The index is streamed
+// and each article read from the dictionary file by offset; a compressed
+// .dict.dz is first unpacked to a temporary file so it can be read at random.
+func parseStarDict(ifoPath string) dumpParser {
+	return func(emit func(*models.DictionaryResult) error) (int, error) {
+		info, err := readStarDictInfo(ifoPath)
+		if err != nil {
+			return 0, err
+		}
+
+		base := strings.TrimSuffix(ifoPath, ".ifo")
+		idx, err := openMaybeGzip(base+".idx", base+".idx.gz")
+		if err != nil {
+			return 0, err
+		}
+		defer idx.Close()
+
+		dict, cleanup, err := openStarDictArticles(base)
+		if err != nil {
+			return 0, err
+		}
+		defer cleanup()
+
+		return readStarDictIndex(bufio.NewReader(idx), dict, info, emit)
+	}
+}
+
+// readStarDictInfo parses the key=value lines of a .ifo file
+func readStarDictInfo(path string) (*starDictInfo, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+
+	scanner := bufio.NewScanner(file)
+	if !scanner.Scan() || strings.TrimSpace(scanner.Text()) != "StarDict's dict ifo file" {
+		return nil, fmt.Errorf("%w: %s is not a StarDict .ifo file", errMalformedDump, path)
+	}
+
+	info := &starDictInfo{idxOffsetBits: 32}
+	for scanner.Scan() {
+		key, value, ok := strings.Cut(scanner.Text(), "=")
+		if !ok {
+			continue
+		}
+		switch strings.TrimSpace(key) {
+		case "idxoffsetbits":
+			if bits, err := strconv.Atoi(strings.TrimSpace(value)); err == nil {
+				info.idxOffsetBits = bits
+			}
+		case "sametypesequence":
+			info.sameTypeSequence = strings.TrimSpace(value)
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+	if info.idxOffsetBits != 32 && info.idxOffsetBits != 64 {
+		return nil, fmt.Errorf("%w: unsupported idxoffsetbits %d", errMalformedDump, info.idxOffsetBits)
+	}
+	return info, nil
+}
+
+// openStarDictArticles opens the article file for random access, unpacking
+// a .dict.dz to a temporary file. The returned cleanup closes and removes
+// whatever was opened.
+func openStarDictArticles(base string) (io.ReaderAt, func(), error) {
+	if file, err := os.Open(base + ".dict"); err == nil {
+		return file, func() { file.Close() }, nil
+	} else if !errors.Is(err, os.ErrNotExist) {
+		return nil, nil, err
+	}
+
+	compressed, err := os.Open(base + ".dict.dz")
+	if err != nil {
+		return nil, nil, err
+	}
+	defer compressed.Close()
+	reader, err := gzip.NewReader(compressed)
+	if err != nil {
+		return nil, nil, fmt.Errorf("%w: %v", errMalformedDump, err)
+	}
+
+	temp, err := os.CreateTemp("", "stardict-*.dict")
+	if err != nil {
+		return nil, nil, err
+	}
+	cleanup := func() {
+		temp.Close()
+		os.Remove(temp.Name())
+	}
+	if _, err := io.Copy(temp, reader); err != nil {
+		cleanup()
+		return nil, nil, fmt.Errorf("%w: %v", errMalformedDump, err)
+	}
+	return temp, cleanup, nil
+}
+
+// readStarDictIndex walks the index records (headword, NUL, offset, size)
+// and emits one entry per headword
+func readStarDictIndex(idx *bufio.Reader, dict io.ReaderAt, info *starDictInfo, emit func(*models.DictionaryResult) error) (int, error) {
+	skipped := 0
+	offsetSize := info.idxOffsetBits / 8
+	header := make([]byte, offsetSize+4)
+
+	for {
+		word, err := idx.ReadString(0)
+		if err == io.EOF && word == "" {
+			return skipped, nil
+		}
+		if err != nil {
+			return skipped, fmt.Errorf("%w: truncated index: %v", errMalformedDump, err)
+		}
+		if _, err := io.ReadFull(idx, header); err != nil {
+			return skipped, fmt.Errorf("%w: truncated index: %v", errMalformedDump, err)
+		}
+
+		var offset uint64
+		if offsetSize == 8 {
+			offset = binary.BigEndian.Uint64(header[:8])
+		} else {
+			offset = uint64(binary.BigEndian.Uint32(header[:4]))
+		}
+		size := binary.BigEndian.Uint32(header[offsetSize:])
+
+		article := make([]byte, size)
+		if _, err := dict.ReadAt(article, int64(offset)); err != nil {
+			return skipped, fmt.Errorf("%w: article for %q is out of range: %v", errMalformedDump, word, err)
+		}
+
+		result := starDictResult(strings.TrimSuffix(word, "\x00"), article, info.sameTypeSequence)
+		if len(result.Definition) == 0 {
+			skipped++
+			continue
+		}
+		if err := emit(result); err != nil {
+			return skipped, err
+		}
+	}
+}
+
+// starDictResult converts one article to a dictionary entry. Text fields
+// become definitions, one per non-empty line, and a 't' field is the
+// phonetic transcription. Binary fields (upper-case types) are skipped.
+func starDictResult(word string, article []byte, sameTypeSequence string) *models.DictionaryResult {
+	result := &models.DictionaryResult{Word: word}
+	for _, field := range splitStarDictFields(article, sameTypeSequence) {
+		text := strings.TrimSpace(string(field.data))
+		switch {
+		case field.kind >= 'A' && field.kind <= 'Z':
+			continue
+		case field.kind == 't':
+			if text != "" {
+				result.Pronunciation = append(result.Pronunciation, models.DictionaryPronunciation{Pron: text})
+			}
+		default:
+			if field.kind != 'm' && field.kind != 'l' {
+				text = html.UnescapeString(starDictMarkupRE.ReplaceAllString(starDictBreakRE.ReplaceAllString(text, "\n"), ""))
+			}
+			for _, line := range strings.Split(text, "\n") {
+				if line = strings.TrimSpace(line); line != "" {
+					result.Definition = append(result.Definition, models.DictionaryDefinition{ID: len(result.Definition), Text: line})
+				}
+			}
+		}
+	}
+	return result
+}
+
+// starDictField is one typed field of an article
+type starDictField struct {
+	kind byte
+	data []byte
+}
+
+// splitStarDictFields splits an article into its fields. With a
+// sametypesequence the type bytes are omitted and the last field runs to
+// the end of the article; otherwise every field starts with its type.
+// Lower-case fields are NUL-terminated, upper-case ones are prefixed with a
+// 32-bit size.
+func splitStarDictFields(article []byte, sameTypeSequence string) []starDictField {
+	var fields []starDictField
+	for i := 0; len(article) > 0; i++ {
+		var kind byte
+		last := false
+		if sameTypeSequence != "" {
+			if i >= len(sameTypeSequence) {
+				break
+			}
+			kind = sameTypeSequence[i]
+			last = i == len(sameTypeSequence)-1
+		} else {
+			kind, article = article[0], article[1:]
+		}
+
+		var data []byte
+		switch {
+		case last:
+			data, article = article, nil
+		case kind >= 'A' && kind <= 'Z':
+			if len(article) < 4 {
+				return fields
+			}
+			size := int(binary.BigEndian.Uint32(article[:4]))
+			article = article[4:]
+			size = min(size, len(article))
+			data, article = article[:size], article[size:]
+		default:
+			end := bytes.IndexByte(article, 0)
+			if end < 0 {
+				data, article = article, nil
+			} else {
+				data, article = article[:end], article[end+1:]
+			}
+		}
+		fields = append(fields, starDictField{kind: kind, data: data})
+	}
+	return fields
+}
@@ -0,0 +1,129 @@
+package dictionary
+
+import (
+	"bytes"
+	"compress/gzip"
+	"encoding/binary"
+	"os"
+	"path/filepath"
+
+	"word-flashcard/internal/models"
+)
+
+// starDictTestArticle is one headword and its raw article bytes
+type starDictTestArticle struct {
+	word    string
+	article string
+}
+
+// writeTestStarDict writes a StarDict dictionary named base into dir and
+// returns the path of its .ifo file. With compress, the articles are
+// written as .dict.dz.
+func (suite *ControllerTestSuite) writeTestStarDict(dir, sameTypeSequence string, compress bool, articles ...starDictTestArticle) string {
+	var dict, idx bytes.Buffer
+	for _, a := range articles {
+		idx.WriteString(a.word)
+		idx.WriteByte(0)
+		suite.Require().NoError(binary.Write(&idx, binary.BigEndian, uint32(dict.Len())))
+		suite.Require().NoError(binary.Write(&idx, binary.BigEndian, uint32(len(a.article))))
+		dict.WriteString(a.article)
+	}
+
+	ifo := "StarDict's dict ifo file\nversion=2.4.2\nbookname=Test\n"
+	if sameTypeSequence != "" {
+		ifo += "sametypesequence=" + sameTypeSequence + "\n"
+	}
+	base := filepath.Join(dir, "test")
+	suite.Require().NoError(os.WriteFile(base+".ifo", []byte(ifo), 0o644))
+	suite.Require().NoError(os.WriteFile(base+".idx", idx.Bytes(), 0o644))
+	if compress {
+		var dz bytes.Buffer
+		writer := gzip.NewWriter(&dz)
+		_, err := writer.Write(dict.Bytes())
+		suite.Require().NoError(err)
+		suite.Require().NoError(writer.Close())
+		suite.Require().NoError(os.WriteFile(base+".dict.dz", dz.Bytes(), 0o644))
+	} else {
+		suite.Require().NoError(os.WriteFile(base+".dict", dict.Bytes(), 0o644))
+	}
+	return base + ".ifo"
+}
+
+// collectEntries runs parse and returns everything it emitted
+func (suite *ControllerTestSuite) collectEntries(parse dumpParser) ([]*models.DictionaryResult, int, error) {
+	var results []*models.DictionaryResult
+	skipped, err := parse(func(result *models.DictionaryResult) error {
+		results = append(results, result)
+		return nil
+	})
+	return results, skipped, err
+}
+
+// TestParseStarDictSameTypeSequence tests a dictionary whose articles share
+// one type sequence, with markup stripped and lines split into definitions
+func (suite *ControllerTestSuite) TestParseStarDictSameTypeSequence() {
+	for _, compress := range []bool{false, true} {
+		ifoPath := suite.writeTestStarDict(suite.T().TempDir(), "h", compress,
+			starDictTestArticle{"apple", "<b>noun</b><br>a round fruit<br/>a tech company &amp; brand"},
+			starDictTestArticle{"empty", "<i></i>"},
+		)
+
+		results, skipped, err := suite.collectEntries(parseStarDict(ifoPath))
+
+		suite.Require().NoError(err)
+		suite.Equal(1, skipped)
+		suite.Require().Len(results, 1)
+		suite.Equal("apple", results[0].Word)
+		suite.Equal([]models.DictionaryDefinition{
+			{ID: 0, Text: "noun"},
+			{ID: 1, Text: "a round fruit"},
+			{ID: 2, Text: "a tech company & brand"},
+		}, results[0].Definition)
+	}
+}
+
+// TestParseStarDictTypedFields tests articles that carry their own field
+// types, including a phonetic field and a binary field to skip
+func (suite *ControllerTestSuite) TestParseStarDictTypedFields() {
+	binaryField := "W\x00\x00\x00\x03abc"
+	ifoPath := suite.writeTestStarDict(suite.T().TempDir(), "", false,
+		starDictTestArticle{"hello", "t/həˈləʊ/\x00" + binaryField + "mused as a greeting\x00"},
+	)
+
+	results, _, err := suite.collectEntries(parseStarDict(ifoPath))
+
+	suite.Require().NoError(err)
+	suite.Require().Len(results, 1)
+	suite.Equal([]models.DictionaryPronunciation{{Pron: "/həˈləʊ/"}}, results[0].Pronunciation)
+	suite.Equal([]models.DictionaryDefinition{{ID: 0, Text: "used as a greeting"}}, results[0].Definition)
+}
+
+// TestParseStarDictMalformed tests that broken dictionaries are reported as malformed
+func (suite *ControllerTestSuite) TestParseStarDictMalformed() {
+	suite.Run("not an ifo file", func() {
+		path := filepath.Join(suite.T().TempDir(), "bad.ifo")
+		suite.Require().NoError(os.WriteFile(path, []byte("hello\n"), 0o644))
+
+		_, _, err := suite.collectEntries(parseStarDict(path))
+
+		suite.ErrorIs(err, errMalformedDump)
+	})
+
+	suite.Run("article out of range", func() {
+		ifoPath := suite.writeTestStarDict(suite.T().TempDir(), "m", false, starDictTestArticle{"word", "text"})
+		suite.Require().NoError(os.WriteFile(filepath.Join(filepath.Dir(ifoPath), "test.dict"), []byte("x"), 0o644))
+
+		_, _, err := suite.collectEntries(parseStarDict(ifoPath))
+
+		suite.ErrorIs(err, errMalformedDump)
+	})
+
+	suite.Run("missing index", func() {
+		ifoPath := suite.writeTestStarDict(suite.T().TempDir(), "m", false, starDictTestArticle{"word", "text"})
+		suite.Require().NoError(os.Remove(filepath.Join(filepath.Dir(ifoPath), "test.idx")))
+
+		_, _, err := suite.collectEntries(parseStarDict(ifoPath))
+
+		suite.ErrorIs(err, os.ErrNotExist)
+	})
+}
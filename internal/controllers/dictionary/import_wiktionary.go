@@ -0,0 +1,146 @@
+package dictionary
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"slices"
+
+	"word-flashcard/internal/models"
+)
+
+// wiktionaryEntry is the part of a wiktextract JSONL record the importer reads
+type wiktionaryEntry struct {
+	Word     string `json:"word"`
+	POS      string `json:"pos"`
+	LangCode string `json:"lang_code"`
+	Senses   []struct {
+		Glosses  []string `json:"glosses"`
+		Examples []struct {
+			Text    string `json:"text"`
+			English string `json:"english"`
+		} `json:"examples"`
+	} `json:"senses"`
+	Sounds []struct {
+		IPA    string   `json:"ipa"`
+		Tags   []string `json:"tags"`
+		Mp3URL string   `json:"mp3_url"`
+	} `json:"sounds"`
+}
+
+// wiktionaryPOS spells out the abbreviated parts of speech wiktextract uses
+var wiktionaryPOS = map[string]string{
+	"adj":  "adjective",
+	"adv":  "adverb",
+	"name": "proper noun",
+	"prep": "preposition",
+	"conj": "conjunction",
+	"intj": "interjection",
+	"det":  "determiner",
+	"num":  "number",
+	"pron": "pronoun",
+}
+
+// parseWiktionary returns a parser for a wiktextract JSONL extract at path,
+// optionally gzipped. Only records whose lang_code is langCode are kept;
+// an empty langCode keeps all of them.
+func parseWiktionary(path, langCode string) dumpParser {
+	return func(emit func(*models.DictionaryResult) error) (int, error) {
+		file, err := openMaybeGzip(path)
+		if err != nil {
+			return 0, err
+		}
+		defer file.Close()
+
+		return readWiktionary(bufio.NewReader(file), langCode, emit)
+	}
+}
+
+// readWiktionary reads one JSON record per line. Lines are read whole rather
+// than with a bufio.Scanner because a record can exceed any fixed buffer.
+func readWiktionary(reader *bufio.Reader, langCode string, emit func(*models.DictionaryResult) error) (int, error) {
+	skipped := 0
+	for lineNumber := 1; ; lineNumber++ {
+		line, err := reader.ReadBytes('\n')
+		if len(bytes.TrimSpace(line)) > 0 {
+			var entry wiktionaryEntry
+			if jsonErr := json.Unmarshal(line, &entry); jsonErr != nil {
+				return skipped, fmt.Errorf("%w: line %d: %v", errMalformedDump, lineNumber, jsonErr)
+			}
+			if langCode != "" && entry.LangCode != langCode {
+				skipped++
+			} else if result := wiktionaryResult(&entry); len(result.Definition) == 0 {
+				skipped++
+			} else if emitErr := emit(result); emitErr != nil {
+				return skipped, emitErr
+			}
+		}
+
+		if err == io.EOF {
+			return skipped, nil
+		} else if err != nil {
+			return skipped, err
+		}
+	}
+}
+
+// wiktionaryResult converts a record to a dictionary entry. Each sense
+// becomes a definition; nested senses list their parents' glosses first, so
+// only the last, most specific gloss is kept.
+func wiktionaryResult(entry *wiktionaryEntry) *models.DictionaryResult {
+	pos := entry.POS
+	if full, ok := wiktionaryPOS[pos]; ok {
+		pos = full
+	}
+
+	result := &models.DictionaryResult{Word: entry.Word}
+	if pos != "" {
+		result.POS = []string{pos}
+	}
+
+	for _, sound := range entry.Sounds {
+		if sound.IPA == "" {
+			continue
+		}
+		result.Pronunciation = append(result.Pronunciation, models.DictionaryPronunciation{
+			POS:  pos,
+			Lang: wiktionaryAccent(sound.Tags),
+			URL:  sound.Mp3URL,
+			Pron: sound.IPA,
+		})
+	}
+
+	for _, sense := range entry.Senses {
+		if len(sense.Glosses) == 0 {
+			continue
+		}
+		definition := models.DictionaryDefinition{
+			ID:   len(result.Definition),
+			POS:  pos,
+			Text: sense.Glosses[len(sense.Glosses)-1],
+		}
+		for _, example := range sense.Examples {
+			definition.Example = append(definition.Example, models.DictionaryExample{
+				ID:          len(definition.Example),
+				Text:        example.Text,
+				Translation: example.English,
+			})
+		}
+		result.Definition = append(result.Definition, definition)
+	}
+	return result
+}
+
+// wiktionaryAccent maps pronunciation tags to the "uk"/"us" labels the
+// Cambridge provider uses, or "" for other accents
+func wiktionaryAccent(tags []string) string {
+	switch {
+	case slices.Contains(tags, "UK") || slices.Contains(tags, "Received-Pronunciation"):
+		return "uk"
+	case slices.Contains(tags, "US") || slices.Contains(tags, "General-American"):
+		return "us"
+	}
+	return ""
+}
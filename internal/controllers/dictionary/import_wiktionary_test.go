@@ -0,0 +1,76 @@
+package dictionary
+
+import (
+	"bytes"
+	"compress/gzip"
+	"os"
+	"path/filepath"
+
+	"word-flashcard/internal/models"
+)
+
+// wiktionaryTestDump is a small wiktextract extract: an English noun with
+// sounds and a nested sense, a French record, and an English record without
+// glosses
+const wiktionaryTestDump = `{"word":"dog","pos":"noun","lang_code":"en","senses":[{"glosses":["A mammal.","A domesticated canine."],"examples":[{"text":"The dog barked."}]}],"sounds":[{"ipa":"/dɒɡ/","tags":["Received-Pronunciation"]},{"ipa":"/dɔɡ/","tags":["General-American"],"mp3_url":"https://example.com/dog.mp3"},{"audio":"dog.ogg"}]}
+{"word":"chien","pos":"noun","lang_code":"fr","senses":[{"glosses":["dog"]}]}
+
+{"word":"dogs","pos":"noun","lang_code":"en","senses":[{"tags":["form-of"]}]}
+`
+
+// TestParseWiktionary tests that records of the requested language become
+// entries and the rest are skipped
+func (suite *ControllerTestSuite) TestParseWiktionary() {
+	path := filepath.Join(suite.T().TempDir(), "en.jsonl")
+	suite.Require().NoError(os.WriteFile(path, []byte(wiktionaryTestDump), 0o644))
+
+	results, skipped, err := suite.collectEntries(parseWiktionary(path, "en"))
+
+	suite.Require().NoError(err)
+	suite.Equal(2, skipped)
+	suite.Require().Len(results, 1)
+	suite.Equal(&models.DictionaryResult{
+		Word: "dog",
+		POS:  []string{"noun"},
+		Pronunciation: []models.DictionaryPronunciation{
+			{POS: "noun", Lang: "uk", Pron: "/dɒɡ/"},
+			{POS: "noun", Lang: "us", URL: "https://example.com/dog.mp3", Pron: "/dɔɡ/"},
+		},
+		Definition: []models.DictionaryDefinition{{
+			ID:      0,
+			POS:     "noun",
+			Text:    "A domesticated canine.",
+			Example: []models.DictionaryExample{{ID: 0, Text: "The dog barked."}},
+		}},
+	}, results[0])
+}
+
+// TestParseWiktionaryGzipAllLanguages tests a gzipped extract read without
+// a language filter
+func (suite *ControllerTestSuite) TestParseWiktionaryGzipAllLanguages() {
+	var compressed bytes.Buffer
+	writer := gzip.NewWriter(&compressed)
+	_, err := writer.Write([]byte(wiktionaryTestDump))
+	suite.Require().NoError(err)
+	suite.Require().NoError(writer.Close())
+	path := filepath.Join(suite.T().TempDir(), "all.jsonl.gz")
+	suite.Require().NoError(os.WriteFile(path, compressed.Bytes(), 0o644))
+
+	results, skipped, err := suite.collectEntries(parseWiktionary(path, ""))
+
+	suite.Require().NoError(err)
+	suite.Equal(1, skipped)
+	suite.Require().Len(results, 2)
+	suite.Equal("chien", results[1].Word)
+}
+
+// TestParseWiktionaryMalformed tests that a line that isn't JSON is reported as malformed
+func (suite *ControllerTestSuite) TestParseWiktionaryMalformed() {
+	path := filepath.Join(suite.T().TempDir(), "bad.jsonl")
+	suite.Require().NoError(os.WriteFile(path, []byte("{\"word\":\"dog\"}\nnot json\n"), 0o644))
+
+	_, _, err := suite.collectEntries(parseWiktionary(path, "en"))
+
+	suite.ErrorIs(err, errMalformedDump)
+	suite.ErrorContains(err, "line 2")
+}
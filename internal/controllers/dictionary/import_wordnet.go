@@ -0,0 +1,147 @@
+package dictionary
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"word-flashcard/internal/models"
+)
+
+// wordNetDataFiles are the WordNet database files read, with the part of
+// speech of their synsets
+var wordNetDataFiles = []struct {
+	name string
+	pos  string
+}{
+	{"data.noun", "noun"},
+	{"data.verb", "verb"},
+	{"data.adj", "adjective"},
+	{"data.adv", "adverb"},
+}
+
+// wordNetMarkerRE matches the syntactic marker WordNet appends to some
+// adjectives, as in "galore(ip)"
+var wordNetMarkerRE = regexp.MustCompile(`\((a|p|ip)\)$`)
+
+// wordNetMaxLine bounds a single synset line; the longest in WordNet 3.x is
+// a few kilobytes
+const wordNetMaxLine = 1 << 20
+
+// parseWordNet returns a parser for the WordNet database in dir, emitting an
+// entry for every word of every synset. Missing data files are skipped, but
+// at least one must exist.
+func parseWordNet(dir string) dumpParser {
+	return func(emit func(*models.DictionaryResult) error) (int, error) {
+		skipped, found := 0, false
+		for _, dataFile := range wordNetDataFiles {
+			file, err := os.Open(filepath.Join(dir, dataFile.name))
+			if errors.Is(err, os.ErrNotExist) {
+				continue
+			} else if err != nil {
+				return skipped, err
+			}
+			found = true
+
+			n, err := readWordNetData(file, dataFile.pos, emit)
+			file.Close()
+			skipped += n
+			if err != nil {
+				return skipped, fmt.Errorf("%s: %w", dataFile.name, err)
+			}
+		}
+		if !found {
+			return 0, fmt.Errorf("%w: no WordNet data files in %s", errMalformedDump, dir)
+		}
+		return skipped, nil
+	}
+}
+
+// readWordNetData reads one data file line by line. Lines starting with
+// spaces are the licence header.
+func readWordNetData(file *os.File, pos string, emit func(*models.DictionaryResult) error) (int, error) {
+	skipped := 0
+	scanner := bufio.NewScanner(file)
+	scanner.Buffer(make([]byte, 64*1024), wordNetMaxLine)
+
+	for scanner.Scan() {
+		line := scanner.Text()
+		if line == "" || strings.HasPrefix(line, " ") {
+			continue
+		}
+
+		words, definition, ok := parseWordNetSynset(line)
+		if !ok {
+			skipped++
+			continue
+		}
+		definition.POS = pos
+		for _, word := range words {
+			result := &models.DictionaryResult{
+				Word:       word,
+				POS:        []string{pos},
+				Definition: []models.DictionaryDefinition{definition},
+			}
+			if err := emit(result); err != nil {
+				return skipped, err
+			}
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return skipped, fmt.Errorf("%w: %v", errMalformedDump, err)
+	}
+	return skipped, nil
+}
+
+// parseWordNetSynset splits a synset line into its words and its gloss. The
+// line reads "offset lex_filenum ss_type w_cnt word lex_id [word lex_id...]
+// ... | gloss", with w_cnt in hex. Quoted parts of the gloss are examples.
+func parseWordNetSynset(line string) ([]string, models.DictionaryDefinition, bool) {
+	var definition models.DictionaryDefinition
+	data, gloss, ok := strings.Cut(line, " | ")
+	if !ok {
+		return nil, definition, false
+	}
+
+	fields := strings.Fields(data)
+	if len(fields) < 4 {
+		return nil, definition, false
+	}
+	count, err := strconv.ParseInt(fields[3], 16, 32)
+	if err != nil || count <= 0 || len(fields) < 4+int(count)*2 {
+		return nil, definition, false
+	}
+
+	words := make([]string, 0, count)
+	for i := 0; i < int(count); i++ {
+		word := wordNetMarkerRE.ReplaceAllString(fields[4+i*2], "")
+		words = append(words, strings.ReplaceAll(word, "_", " "))
+	}
+
+	var meanings []string
+	for _, part := range strings.Split(gloss, ";") {
+		part = strings.TrimSpace(part)
+		if example, ok := strings.CutPrefix(part, `"`); ok {
+			// Drop attributions trailing the closing quote
+			if end := strings.Index(example, `"`); end >= 0 {
+				example = example[:end]
+			}
+			definition.Example = append(definition.Example, models.DictionaryExample{
+				ID:   len(definition.Example),
+				Text: example,
+			})
+		} else if part != "" {
+			meanings = append(meanings, part)
+		}
+	}
+	definition.Text = strings.Join(meanings, "; ")
+	if definition.Text == "" {
+		return nil, definition, false
+	}
+	return words, definition, true
+}
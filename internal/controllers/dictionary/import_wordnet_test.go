@@ -0,0 +1,48 @@
+package dictionary
+
+import (
+	"os"
+	"path/filepath"
+
+	"word-flashcard/internal/models"
+)
+
+// wordNetTestHeader mimics the licence lines at the top of every data file
+const wordNetTestHeader = "  1 This software and database is being provided to you, the LICENSEE, by\n" +
+	"  2 Princeton University under the following license.\n"
+
+// TestParseWordNet tests that every word of every synset is emitted with
+// the synset's gloss, part of speech and examples
+func (suite *ControllerTestSuite) TestParseWordNet() {
+	dir := suite.T().TempDir()
+	suite.Require().NoError(os.WriteFile(filepath.Join(dir, "data.noun"), []byte(wordNetTestHeader+
+		"02084071 05 n 02 dog 0 domestic_dog 0 001 @ 02083346 n 0000 | a member of the genus Canis; \"the dog barked all night\"\n"), 0o644))
+	suite.Require().NoError(os.WriteFile(filepath.Join(dir, "data.adj"), []byte(wordNetTestHeader+
+		"00001740 00 a 01 galore(ip) 0 000 | in great numbers; \"apples galore\" - Mark Twain\n"+
+		"00001741 00 a zz broken 0 000 | not a valid synset\n"), 0o644))
+
+	results, skipped, err := suite.collectEntries(parseWordNet(dir))
+
+	suite.Require().NoError(err)
+	suite.Equal(1, skipped)
+	suite.Require().Len(results, 3)
+
+	dogDefinition := models.DictionaryDefinition{
+		POS:     "noun",
+		Text:    "a member of the genus Canis",
+		Example: []models.DictionaryExample{{ID: 0, Text: "the dog barked all night"}},
+	}
+	suite.Equal(&models.DictionaryResult{Word: "dog", POS: []string{"noun"}, Definition: []models.DictionaryDefinition{dogDefinition}}, results[0])
+	suite.Equal("domestic dog", results[1].Word)
+	suite.Equal("galore", results[2].Word)
+	suite.Equal([]string{"adjective"}, results[2].POS)
+	suite.Equal("apples galore", results[2].Definition[0].Example[0].Text)
+}
+
+// TestParseWordNetNoDataFiles tests that a directory without WordNet data
+// files is rejected
+func (suite *ControllerTestSuite) TestParseWordNetNoDataFiles() {
+	_, _, err := suite.collectEntries(parseWordNet(suite.T().TempDir()))
+
+	suite.ErrorIs(err, errMalformedDump)
+}
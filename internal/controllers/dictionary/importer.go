@@ -0,0 +1,304 @@
+package dictionary
+
+import (
+	"compress/gzip"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"os"
+	"slices"
+	"strings"
+
+	dbModels "word-flashcard/data/models"
+	"word-flashcard/data/schema"
+	"word-flashcard/internal/models"
+	"word-flashcard/utils"
+
+	"github.com/Masterminds/squirrel"
+)
+
+// Supported dump formats and the offline provider each one is imported into
+const (
+	FormatStarDict   = "stardict"
+	FormatWordNet    = "wordnet"
+	FormatWiktionary = "wiktionary"
+)
+
+// importFormatProviders maps each dump format to the provider serving it
+var importFormatProviders = map[string]string{
+	FormatStarDict:   ProviderLocal,
+	FormatWordNet:    ProviderLocal,
+	FormatWiktionary: ProviderWiktionary,
+}
+
+// errMalformedDump is returned when a dump can't be parsed
+var errMalformedDump = errors.New("malformed dictionary dump")
+
+// dumpParser streams the entries of a dump to emit, one headword at a time,
+// and returns how many records it skipped as unusable. Parsers never hold
+// more than one record in memory, so dumps of any size can be imported.
+type dumpParser func(emit func(*models.DictionaryResult) error) (skipped int, err error)
+
+// importBatchSize is how many new headwords are inserted per transaction
+const importBatchSize = 500
+
+// stagingSourceSuffix marks the source a replacing import writes under until
+// it is swapped in; source columns hold 20 characters, which fits it after
+// every provider name
+const stagingSourceSuffix = "#staging"
+
+// Import streams a parsed dump into the provider's rows for language.
+// Entries for a headword that is already stored are merged into the stored
+// entry, so several dumps can feed one provider. With replace, the dump is
+// written under a staging source and swapped for the provider's existing
+// rows for language only once all of it is stored, so a failed import
+// leaves them untouched.
+func (p *offlineProvider) Import(language string, replace bool, parse dumpParser) (*models.DictionaryImportSummary, error) {
+	p.importMutex.Lock()
+	defer p.importMutex.Unlock()
+
+	batch := &importBatch{provider: p, source: p.name, language: language, headwords: make(map[string]struct{})}
+	if replace {
+		// Clear rows left under the staging source by an import that never finished
+		batch.source = p.name + stagingSourceSuffix
+		if _, err := p.dictionaryEntryPeer.Delete(batch.sourceWhere()); err != nil {
+			return nil, err
+		}
+	} else {
+		var err error
+		if batch.headwords, err = p.loadHeadwords(language); err != nil {
+			return nil, err
+		}
+	}
+
+	summary := &models.DictionaryImportSummary{Provider: p.name, Language: language}
+	skipped, err := parse(func(result *models.DictionaryResult) error {
+		normalized := normalizeHeadword(result.Word)
+		if normalized == "" || len(result.Definition) == 0 {
+			summary.Skipped++
+			return nil
+		}
+		return batch.add(normalized, result)
+	})
+	summary.Skipped += skipped
+
+	if replace {
+		if err == nil {
+			err = batch.flush()
+		}
+		if err == nil {
+			_, err = p.dictionaryEntryPeer.ReplaceSource(batch.source, p.name, language)
+		}
+		if err != nil {
+			if _, cleanupErr := p.dictionaryEntryPeer.Delete(batch.sourceWhere()); cleanupErr != nil {
+				slog.Warn("Failed to delete staged dictionary entries", "provider", p.name, "language", language, "error", cleanupErr)
+			}
+			summary.Entries = 0
+			return summary, err
+		}
+	} else if flushErr := batch.flush(); err == nil {
+		// Entries read before a parser failure are kept, as with merges
+		err = flushErr
+	}
+	summary.Entries = batch.stored
+
+	p.setIndex(language, batch.headwords)
+	return summary, err
+}
+
+// importBatch collects the entries of an import under source, inserting new
+// headwords importBatchSize at a time and merging repeats in memory until
+// they are stored
+type importBatch struct {
+	provider  *offlineProvider
+	source    string
+	language  string
+	headwords map[string]struct{}
+	pending   []*dbModels.DictionaryEntry
+	results   map[string]*models.DictionaryResult
+	stored    int // entries written so far
+	waiting   int // entries folded into pending, written by the next flush
+}
+
+// add stores one parsed entry, merging it into the entry already stored or
+// pending for the headword when there is one
+func (b *importBatch) add(normalized string, result *models.DictionaryResult) error {
+	if pending, ok := b.results[normalized]; ok {
+		mergeDictionaryResults(pending, result)
+		b.waiting++
+		return nil
+	}
+	if _, exists := b.headwords[normalized]; exists {
+		merged, err := b.merge(normalized, result)
+		if err != nil || merged {
+			return err
+		}
+	}
+
+	if b.results == nil {
+		b.results = make(map[string]*models.DictionaryResult)
+	}
+	stored := *result
+	b.results[normalized] = &stored
+	b.pending = append(b.pending, &dbModels.DictionaryEntry{
+		Source:         utils.StrPtr(b.source),
+		Language:       utils.StrPtr(b.language),
+		Word:           utils.StrPtr(result.Word),
+		NormalizedWord: utils.StrPtr(normalized),
+	})
+	b.waiting++
+	if len(b.pending) >= importBatchSize {
+		return b.flush()
+	}
+	return nil
+}
+
+// merge folds result into the stored entry for normalized, reporting false
+// when no such row turns out to exist
+func (b *importBatch) merge(normalized string, result *models.DictionaryResult) (bool, error) {
+	where := b.entryWhere(normalized)
+	limit := uint64(1)
+	entries, err := b.provider.dictionaryEntryPeer.Select([]*string{}, where, nil, &limit, nil)
+	if err != nil || len(entries) == 0 {
+		return false, err
+	}
+
+	var stored models.DictionaryResult
+	if entries[0].Entry != nil && json.Unmarshal([]byte(*entries[0].Entry), &stored) == nil {
+		mergeDictionaryResults(&stored, result)
+		result = &stored
+	}
+	encoded, err := encodeDictionaryResult(result)
+	if err != nil {
+		return false, err
+	}
+	if _, err = b.provider.dictionaryEntryPeer.Update(&dbModels.DictionaryEntry{Entry: &encoded}, where); err != nil {
+		return false, err
+	}
+	b.stored++
+	return true, nil
+}
+
+// flush inserts the pending entries in one transaction
+func (b *importBatch) flush() error {
+	if len(b.pending) == 0 {
+		return nil
+	}
+	for _, entry := range b.pending {
+		encoded, err := encodeDictionaryResult(b.results[*entry.NormalizedWord])
+		if err != nil {
+			return err
+		}
+		entry.Entry = &encoded
+	}
+	if err := b.provider.dictionaryEntryPeer.InsertBatch(b.pending); err != nil {
+		return err
+	}
+
+	for _, entry := range b.pending {
+		b.headwords[*entry.NormalizedWord] = struct{}{}
+	}
+	b.stored += b.waiting
+	b.pending, b.results, b.waiting = nil, nil, 0
+	return nil
+}
+
+// sourceWhere matches every row of the batch's source in its language
+func (b *importBatch) sourceWhere() squirrel.Eq {
+	return squirrel.Eq{
+		schema.DICTIONARY_ENTRY_SOURCE:   b.source,
+		schema.DICTIONARY_ENTRY_LANGUAGE: b.language,
+	}
+}
+
+// entryWhere matches the row of the batch's source stored for normalized
+func (b *importBatch) entryWhere(normalized string) squirrel.Eq {
+	where := b.sourceWhere()
+	where[schema.DICTIONARY_ENTRY_NORMALIZED_WORD] = normalized
+	return where
+}
+
+// encodeDictionaryResult serialises an entry the way it is stored, without
+// the provider name, which is filled in at lookup time
+func encodeDictionaryResult(result *models.DictionaryResult) (string, error) {
+	stored := *result
+	stored.Provider = ""
+	data, err := json.Marshal(stored)
+	if err != nil {
+		return "", fmt.Errorf("failed to encode entry for %s: %w", result.Word, err)
+	}
+	return string(data), nil
+}
+
+// mergeDictionaryResults adds the parts of from missing in into, then
+// renumbers the definitions and examples so IDs stay sequential
+func mergeDictionaryResults(into, from *models.DictionaryResult) {
+	for _, pos := range from.POS {
+		if !slices.Contains(into.POS, pos) {
+			into.POS = append(into.POS, pos)
+		}
+	}
+	for _, verb := range from.Verbs {
+		if !slices.ContainsFunc(into.Verbs, func(v models.DictionaryVerb) bool { return v.Type == verb.Type && v.Text == verb.Text }) {
+			into.Verbs = append(into.Verbs, verb)
+		}
+	}
+	for _, pron := range from.Pronunciation {
+		if !slices.ContainsFunc(into.Pronunciation, func(p models.DictionaryPronunciation) bool {
+			return p.POS == pron.POS && p.Lang == pron.Lang && p.Pron == pron.Pron
+		}) {
+			into.Pronunciation = append(into.Pronunciation, pron)
+		}
+	}
+	for _, def := range from.Definition {
+		if !slices.ContainsFunc(into.Definition, func(d models.DictionaryDefinition) bool { return d.POS == def.POS && d.Text == def.Text }) {
+			into.Definition = append(into.Definition, def)
+		}
+	}
+
+	for i := range into.Verbs {
+		into.Verbs[i].ID = i
+	}
+	for i := range into.Definition {
+		into.Definition[i].ID = i
+		for j := range into.Definition[i].Example {
+			into.Definition[i].Example[j].ID = j
+		}
+	}
+}
+
+// openMaybeGzip opens the first of paths that exists, transparently
+// decompressing it when its name ends in .gz
+func openMaybeGzip(paths ...string) (io.ReadCloser, error) {
+	var err error
+	for _, path := range paths {
+		var file *os.File
+		if file, err = os.Open(path); err != nil {
+			continue
+		}
+		if !strings.HasSuffix(path, ".gz") {
+			return file, nil
+		}
+		reader, err := gzip.NewReader(file)
+		if err != nil {
+			file.Close()
+			return nil, fmt.Errorf("%w: %v", errMalformedDump, err)
+		}
+		return gzipFile{Reader: reader, file: file}, nil
+	}
+	return nil, err
+}
+
+// gzipFile closes both a gzip stream and the file under it
+type gzipFile struct {
+	*gzip.Reader
+	file *os.File
+}
+
+// Close closes the gzip stream and the underlying file
+func (g gzipFile) Close() error {
+	g.Reader.Close()
+	return g.file.Close()
+}
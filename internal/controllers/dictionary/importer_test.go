@@ -0,0 +1,281 @@
+package dictionary
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	dbModels "word-flashcard/data/models"
+	"word-flashcard/data/schema"
+	"word-flashcard/internal/models"
+
+	"github.com/Masterminds/squirrel"
+	"github.com/stretchr/testify/mock"
+)
+
+// testParser returns a parser emitting results and reporting skipped records
+func testParser(skipped int, results ...*models.DictionaryResult) dumpParser {
+	return func(emit func(*models.DictionaryResult) error) (int, error) {
+		for _, result := range results {
+			if err := emit(result); err != nil {
+				return skipped, err
+			}
+		}
+		return skipped, nil
+	}
+}
+
+// TestOfflineProviderImport tests that new headwords are inserted, known
+// ones merged into their stored entry, and the index rebuilt to match
+func (suite *ControllerTestSuite) TestOfflineProviderImport() {
+	provider := newOfflineProvider(ProviderLocal, suite.mockDictionaryEntryPeer)
+	expectHeadwords(suite.mockDictionaryEntryPeer, ProviderLocal, "en", "run")
+	expectEntry(suite.mockDictionaryEntryPeer, ProviderLocal, "en", "run",
+		`{"word":"run","provider":"","pos":["verb"],"verbs":null,"pronunciation":null,"definition":[{"id":0,"pos":"verb","text":"to move fast","translation":"","example":null}]}`)
+
+	var updated models.DictionaryResult
+	suite.mockDictionaryEntryPeer.EXPECT().
+		Update(mock.Anything, provider.entryWhere("en", "run")).
+		Run(func(args mock.Arguments) {
+			entry := args.Get(0).(*dbModels.DictionaryEntry)
+			suite.Require().NoError(json.Unmarshal([]byte(*entry.Entry), &updated))
+		}).
+		Return(int64(1), nil).Times(1)
+
+	var inserted []*dbModels.DictionaryEntry
+	suite.mockDictionaryEntryPeer.EXPECT().
+		InsertBatch(mock.Anything).
+		Run(func(args mock.Arguments) { inserted = append(inserted, args.Get(0).([]*dbModels.DictionaryEntry)...) }).
+		Return(nil).Times(1)
+
+	summary, err := provider.Import("en", false, testParser(2,
+		&models.DictionaryResult{Word: "Run", POS: []string{"noun"}, Definition: []models.DictionaryDefinition{{POS: "noun", Text: "an act of running"}}},
+		&models.DictionaryResult{Word: "Sprint", Definition: []models.DictionaryDefinition{{Text: "to run fast"}}},
+		&models.DictionaryResult{Word: "sprint"},
+		&models.DictionaryResult{Word: "sprint", Definition: []models.DictionaryDefinition{{Text: "a short fast race"}}},
+		&models.DictionaryResult{Word: "  ", Definition: []models.DictionaryDefinition{{Text: "no headword"}}},
+	))
+
+	suite.Require().NoError(err)
+	suite.Equal(&models.DictionaryImportSummary{Provider: ProviderLocal, Language: "en", Entries: 3, Skipped: 4}, summary)
+
+	suite.Equal([]string{"verb", "noun"}, updated.POS)
+	suite.Require().Len(updated.Definition, 2)
+	suite.Equal(1, updated.Definition[1].ID)
+	suite.Equal("an act of running", updated.Definition[1].Text)
+
+	suite.Require().Len(inserted, 1)
+	suite.Equal("Sprint", *inserted[0].Word)
+	suite.Equal("sprint", *inserted[0].NormalizedWord)
+	suite.Equal(ProviderLocal, *inserted[0].Source)
+	suite.Equal("en", *inserted[0].Language)
+	var sprint models.DictionaryResult
+	suite.Require().NoError(json.Unmarshal([]byte(*inserted[0].Entry), &sprint))
+	suite.Len(sprint.Definition, 2)
+
+	index, err := provider.index("en")
+	suite.Require().NoError(err)
+	suite.Equal([]string{"run", "sprint"}, index.words)
+}
+
+// TestOfflineProviderImportReplace tests that replace writes the dump under
+// the staging source, never merging into the stored entries, and swaps it in
+// once all of it is stored
+func (suite *ControllerTestSuite) TestOfflineProviderImportReplace() {
+	provider := newOfflineProvider(ProviderWiktionary, suite.mockDictionaryEntryPeer)
+	staging := ProviderWiktionary + stagingSourceSuffix
+	stagingWhere := squirrel.Eq{
+		schema.DICTIONARY_ENTRY_SOURCE:   staging,
+		schema.DICTIONARY_ENTRY_LANGUAGE: "en",
+	}
+	suite.mockDictionaryEntryPeer.EXPECT().Delete(stagingWhere).Return(int64(0), nil).Times(1)
+
+	var inserted []*dbModels.DictionaryEntry
+	suite.mockDictionaryEntryPeer.EXPECT().
+		InsertBatch(mock.Anything).
+		Run(func(args mock.Arguments) { inserted = append(inserted, args.Get(0).([]*dbModels.DictionaryEntry)...) }).
+		Return(nil).Times(1)
+	suite.mockDictionaryEntryPeer.EXPECT().ReplaceSource(staging, ProviderWiktionary, "en").Return(int64(1), nil).Times(1)
+
+	summary, err := provider.Import("en", true, testParser(0,
+		&models.DictionaryResult{Word: "run", Definition: []models.DictionaryDefinition{{Text: "to move fast"}}},
+	))
+
+	suite.Require().NoError(err)
+	suite.Equal(1, summary.Entries)
+	suite.Require().Len(inserted, 1)
+	suite.Equal(staging, *inserted[0].Source)
+
+	index, err := provider.index("en")
+	suite.Require().NoError(err)
+	suite.Equal([]string{"run"}, index.words)
+}
+
+// TestOfflineProviderImportReplaceFailure tests that a replace that fails
+// part way drops what it staged and leaves the stored entries and their
+// index alone
+func (suite *ControllerTestSuite) TestOfflineProviderImportReplaceFailure() {
+	staging := ProviderWiktionary + stagingSourceSuffix
+	stagingWhere := squirrel.Eq{
+		schema.DICTIONARY_ENTRY_SOURCE:   staging,
+		schema.DICTIONARY_ENTRY_LANGUAGE: "en",
+	}
+	run := &models.DictionaryResult{Word: "run", Definition: []models.DictionaryDefinition{{Text: "to move fast"}}}
+
+	tests := []struct {
+		name      string
+		parse     dumpParser
+		setupMock func()
+		wantErr   string
+	}{
+		{
+			name: "parser failure",
+			parse: func(emit func(*models.DictionaryResult) error) (int, error) {
+				suite.Require().NoError(emit(run))
+				return 0, errMalformedDump
+			},
+			setupMock: func() {},
+			wantErr:   errMalformedDump.Error(),
+		},
+		{
+			name:  "insert failure",
+			parse: testParser(0, run),
+			setupMock: func() {
+				suite.mockDictionaryEntryPeer.EXPECT().InsertBatch(mock.Anything).Return(errors.New("disk full")).Times(1)
+			},
+			wantErr: "disk full",
+		},
+		{
+			name:  "swap failure",
+			parse: testParser(0, run),
+			setupMock: func() {
+				suite.mockDictionaryEntryPeer.EXPECT().InsertBatch(mock.Anything).Return(nil).Times(1)
+				suite.mockDictionaryEntryPeer.EXPECT().
+					ReplaceSource(staging, ProviderWiktionary, "en").
+					Return(int64(0), errors.New("deadlock")).Times(1)
+			},
+			wantErr: "deadlock",
+		},
+	}
+
+	for _, tt := range tests {
+		suite.Run(tt.name, func() {
+			suite.SetupTest()
+			provider := newOfflineProvider(ProviderWiktionary, suite.mockDictionaryEntryPeer)
+			provider.setIndex("en", map[string]struct{}{"walk": {}})
+			suite.mockDictionaryEntryPeer.EXPECT().Delete(stagingWhere).Return(int64(0), nil).Times(2)
+			tt.setupMock()
+
+			summary, err := provider.Import("en", true, tt.parse)
+
+			suite.EqualError(err, tt.wantErr)
+			suite.Equal(0, summary.Entries)
+			index, err := provider.index("en")
+			suite.Require().NoError(err)
+			suite.Equal([]string{"walk"}, index.words)
+		})
+	}
+}
+
+// TestOfflineProviderImportBatches tests that new headwords are inserted
+// importBatchSize at a time
+func (suite *ControllerTestSuite) TestOfflineProviderImportBatches() {
+	provider := newOfflineProvider(ProviderLocal, suite.mockDictionaryEntryPeer)
+	expectHeadwords(suite.mockDictionaryEntryPeer, ProviderLocal, "en")
+
+	var batches []int
+	suite.mockDictionaryEntryPeer.EXPECT().
+		InsertBatch(mock.Anything).
+		Run(func(args mock.Arguments) { batches = append(batches, len(args.Get(0).([]*dbModels.DictionaryEntry))) }).
+		Return(nil).Times(2)
+
+	results := make([]*models.DictionaryResult, importBatchSize+1)
+	for i := range results {
+		results[i] = &models.DictionaryResult{Word: fmt.Sprintf("word%d", i), Definition: []models.DictionaryDefinition{{Text: "a word"}}}
+	}
+	summary, err := provider.Import("en", false, testParser(0, results...))
+
+	suite.Require().NoError(err)
+	suite.Equal(importBatchSize+1, summary.Entries)
+	suite.Equal([]int{importBatchSize, 1}, batches)
+}
+
+// TestOfflineProviderImportErrors tests that database and parser failures
+// stop the import, keeping the index in line with what was written
+func (suite *ControllerTestSuite) TestOfflineProviderImportErrors() {
+	suite.Run("insert failure", func() {
+		suite.SetupTest()
+		provider := newOfflineProvider(ProviderLocal, suite.mockDictionaryEntryPeer)
+		expectHeadwords(suite.mockDictionaryEntryPeer, ProviderLocal, "en")
+		suite.mockDictionaryEntryPeer.EXPECT().InsertBatch(mock.Anything).Return(errors.New("disk full")).Times(1)
+
+		summary, err := provider.Import("en", false, testParser(0,
+			&models.DictionaryResult{Word: "run", Definition: []models.DictionaryDefinition{{Text: "to move fast"}}},
+			&models.DictionaryResult{Word: "walk", Definition: []models.DictionaryDefinition{{Text: "to move slowly"}}},
+		))
+
+		suite.EqualError(err, "disk full")
+		suite.Equal(0, summary.Entries)
+	})
+
+	suite.Run("parser failure after some entries", func() {
+		suite.SetupTest()
+		provider := newOfflineProvider(ProviderLocal, suite.mockDictionaryEntryPeer)
+		expectHeadwords(suite.mockDictionaryEntryPeer, ProviderLocal, "en")
+		suite.mockDictionaryEntryPeer.EXPECT().InsertBatch(mock.Anything).Return(nil).Times(1)
+
+		summary, err := provider.Import("en", false, func(emit func(*models.DictionaryResult) error) (int, error) {
+			suite.Require().NoError(emit(&models.DictionaryResult{Word: "run", Definition: []models.DictionaryDefinition{{Text: "to move fast"}}}))
+			return 0, errMalformedDump
+		})
+
+		suite.ErrorIs(err, errMalformedDump)
+		suite.Equal(1, summary.Entries)
+		index, err := provider.index("en")
+		suite.Require().NoError(err)
+		suite.True(index.contains("run"))
+	})
+
+	suite.Run("index failure", func() {
+		suite.SetupTest()
+		provider := newOfflineProvider(ProviderLocal, suite.mockDictionaryEntryPeer)
+		suite.mockDictionaryEntryPeer.EXPECT().
+			Select(mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+			Return(nil, errors.New("connection refused")).Times(1)
+
+		_, err := provider.Import("en", false, testParser(0))
+
+		suite.EqualError(err, "connection refused")
+	})
+}
+
+// TestMergeDictionaryResults tests that merging skips duplicates and
+// renumbers definitions and examples
+func (suite *ControllerTestSuite) TestMergeDictionaryResults() {
+	into := &models.DictionaryResult{
+		Word:          "light",
+		POS:           []string{"noun"},
+		Pronunciation: []models.DictionaryPronunciation{{Lang: "uk", Pron: "/laɪt/"}},
+		Definition:    []models.DictionaryDefinition{{ID: 0, POS: "noun", Text: "brightness"}},
+	}
+	from := &models.DictionaryResult{
+		Word:          "light",
+		POS:           []string{"noun", "adjective"},
+		Verbs:         []models.DictionaryVerb{{ID: 7, Type: "plural", Text: "lights"}},
+		Pronunciation: []models.DictionaryPronunciation{{Lang: "uk", Pron: "/laɪt/"}, {Lang: "us", Pron: "/laɪt/"}},
+		Definition: []models.DictionaryDefinition{
+			{ID: 0, POS: "noun", Text: "brightness"},
+			{ID: 1, POS: "adjective", Text: "not heavy", Example: []models.DictionaryExample{{ID: 4, Text: "a light bag"}}},
+		},
+	}
+
+	mergeDictionaryResults(into, from)
+
+	suite.Equal([]string{"noun", "adjective"}, into.POS)
+	suite.Equal([]models.DictionaryVerb{{ID: 0, Type: "plural", Text: "lights"}}, into.Verbs)
+	suite.Len(into.Pronunciation, 2)
+	suite.Equal([]models.DictionaryDefinition{
+		{ID: 0, POS: "noun", Text: "brightness"},
+		{ID: 1, POS: "adjective", Text: "not heavy", Example: []models.DictionaryExample{{ID: 0, Text: "a light bag"}}},
+	}, into.Definition)
+}
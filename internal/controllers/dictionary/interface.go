@@ -5,4 +5,10 @@ import "github.com/gin-gonic/gin"
 // ControllerInterface defines the interface for dictionary controller
 type ControllerInterface interface {
 	SearchWord(c *gin.Context)
+	ImportDictionary(c *gin.Context)
+	SuggestWords(c *gin.Context)
+	GetCacheStats(c *gin.Context)
+	PurgeCache(c *gin.Context)
+	BatchLookup(c *gin.Context)
+	CreateWordFromDictionary(c *gin.Context)
 }
@@ -0,0 +1,180 @@
+package dictionary
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"sync"
+
+	"word-flashcard/data/peers"
+	"word-flashcard/data/schema"
+	"word-flashcard/internal/models"
+	"word-flashcard/utils"
+
+	"github.com/Masterminds/squirrel"
+)
+
+// Suggestion match kinds, best first
+const (
+	matchExact  = "exact"
+	matchPrefix = "prefix"
+	matchFuzzy  = "fuzzy"
+)
+
+// offlineProvider looks words up in the dictionary_entries rows imported for
+// one source, so lookups keep working without network access. The headwords
+// of each language are held in memory, so misses and suggestions are answered
+// without touching the database.
+type offlineProvider struct {
+	name                string
+	dictionaryEntryPeer peers.DictionaryEntryPeerInterface
+	indexes             map[string]*headwordIndex
+	indexMutex          sync.RWMutex
+	importMutex         sync.Mutex
+}
+
+// newOfflineProvider creates an offline provider storing its rows under name
+func newOfflineProvider(name string, dictionaryEntryPeer peers.DictionaryEntryPeerInterface) *offlineProvider {
+	return &offlineProvider{
+		name:                name,
+		dictionaryEntryPeer: dictionaryEntryPeer,
+		indexes:             make(map[string]*headwordIndex),
+	}
+}
+
+// Name returns the provider name, which is also the source its rows are stored under
+func (p *offlineProvider) Name() string {
+	return p.name
+}
+
+// Lookup returns the stored entry for word in language
+func (p *offlineProvider) Lookup(word, language string) (*models.DictionaryResult, error) {
+	normalized := normalizeHeadword(word)
+	index, err := p.index(language)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", errUpstreamUnavailable, err)
+	}
+	if !index.contains(normalized) {
+		return nil, fmt.Errorf("%w: %s", errWordNotFound, word)
+	}
+
+	limit := uint64(1)
+	entries, err := p.dictionaryEntryPeer.Select([]*string{}, p.entryWhere(language, normalized), nil, &limit, nil)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", errUpstreamUnavailable, err)
+	}
+	if len(entries) == 0 || entries[0].Entry == nil {
+		return nil, fmt.Errorf("%w: %s", errWordNotFound, word)
+	}
+
+	var result models.DictionaryResult
+	if err := json.Unmarshal([]byte(*entries[0].Entry), &result); err != nil {
+		return nil, fmt.Errorf("%w: stored entry for %s is corrupt: %v", errUpstreamUnavailable, word, err)
+	}
+	return &result, nil
+}
+
+// Suggest returns up to limit headwords matching query exactly, by prefix or
+// within a couple of typos, in that order
+func (p *offlineProvider) Suggest(query, language string, limit int) ([]models.DictionarySuggestion, error) {
+	normalized := normalizeHeadword(query)
+	index, err := p.index(language)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", errUpstreamUnavailable, err)
+	}
+
+	var suggestions []models.DictionarySuggestion
+	seen := make(map[string]bool)
+	add := func(words []string, match string) {
+		for _, word := range words {
+			if len(suggestions) == limit {
+				return
+			}
+			if !seen[word] {
+				seen[word] = true
+				suggestions = append(suggestions, models.DictionarySuggestion{Word: word, Provider: p.name, Match: match})
+			}
+		}
+	}
+
+	if index.contains(normalized) {
+		add([]string{normalized}, matchExact)
+	}
+	add(index.withPrefix(normalized, limit+1), matchPrefix)
+	add(index.similarTo(normalized, fuzzyDistance(normalized), limit), matchFuzzy)
+	return suggestions, nil
+}
+
+// fuzzyDistance is the number of typos tolerated in a query; short words
+// allow only one, or nearly every short headword would match.
+func fuzzyDistance(query string) int {
+	if len([]rune(query)) <= 4 {
+		return 1
+	}
+	return 2
+}
+
+// index returns the headword index for language, loading it on first use
+func (p *offlineProvider) index(language string) (*headwordIndex, error) {
+	p.indexMutex.RLock()
+	index, ok := p.indexes[language]
+	p.indexMutex.RUnlock()
+	if ok {
+		return index, nil
+	}
+
+	headwords, err := p.loadHeadwords(language)
+	if err != nil {
+		return nil, err
+	}
+	index = newHeadwordIndex(headwords)
+
+	p.indexMutex.Lock()
+	p.indexes[language] = index
+	p.indexMutex.Unlock()
+	return index, nil
+}
+
+// setIndex replaces the headword index for language
+func (p *offlineProvider) setIndex(language string, headwords map[string]struct{}) {
+	index := newHeadwordIndex(headwords)
+	p.indexMutex.Lock()
+	p.indexes[language] = index
+	p.indexMutex.Unlock()
+}
+
+// loadHeadwords reads the normalised headwords stored for language
+func (p *offlineProvider) loadHeadwords(language string) (map[string]struct{}, error) {
+	columns := []*string{utils.StrPtr(schema.DICTIONARY_ENTRY_NORMALIZED_WORD)}
+	where := squirrel.Eq{
+		schema.DICTIONARY_ENTRY_SOURCE:   p.name,
+		schema.DICTIONARY_ENTRY_LANGUAGE: language,
+	}
+	entries, err := p.dictionaryEntryPeer.Select(columns, where, nil, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	headwords := make(map[string]struct{}, len(entries))
+	for _, entry := range entries {
+		if entry.NormalizedWord != nil {
+			headwords[*entry.NormalizedWord] = struct{}{}
+		}
+	}
+	return headwords, nil
+}
+
+// entryWhere matches the row stored for a normalised headword in language
+func (p *offlineProvider) entryWhere(language, normalized string) squirrel.Eq {
+	return squirrel.Eq{
+		schema.DICTIONARY_ENTRY_SOURCE:          p.name,
+		schema.DICTIONARY_ENTRY_LANGUAGE:        language,
+		schema.DICTIONARY_ENTRY_NORMALIZED_WORD: normalized,
+	}
+}
+
+// normalizeHeadword folds a headword to the form offline entries are keyed
+// by: lower case with runs of whitespace collapsed to one space.
+func normalizeHeadword(word string) string {
+	return strings.ToLower(strings.Join(strings.Fields(word), " "))
+}
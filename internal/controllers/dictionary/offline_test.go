@@ -0,0 +1,114 @@
+package dictionary
+
+import (
+	"errors"
+
+	"word-flashcard/data/mocks"
+	dbModels "word-flashcard/data/models"
+	"word-flashcard/internal/models"
+	"word-flashcard/utils"
+
+	"github.com/stretchr/testify/mock"
+)
+
+// TestOfflineProviderLookup tests that offlineProvider finds entries by their
+// normalised headword within its own source and language
+func (suite *ControllerTestSuite) TestOfflineProviderLookup() {
+	provider := newOfflineProvider(ProviderLocal, suite.mockDictionaryEntryPeer)
+	expectHeadwords(suite.mockDictionaryEntryPeer, ProviderLocal, "en-tw", "look up")
+	expectEntry(suite.mockDictionaryEntryPeer, ProviderLocal, "en-tw", "look up",
+		`{"word":"look up","pos":["phrasal verb"],"definition":[{"id":0,"text":"to search for information"}]}`)
+
+	result, err := provider.Lookup("  Look   UP ", "en-tw")
+
+	suite.Require().NoError(err)
+	suite.Equal("look up", result.Word)
+	suite.Equal([]string{"phrasal verb"}, result.POS)
+	suite.Require().Len(result.Definition, 1)
+	suite.Equal("to search for information", result.Definition[0].Text)
+}
+
+// TestOfflineProviderLookupUsesIndex tests that the headword index is loaded
+// once per language and that words missing from it never reach the database
+func (suite *ControllerTestSuite) TestOfflineProviderLookupUsesIndex() {
+	provider := newOfflineProvider(ProviderLocal, suite.mockDictionaryEntryPeer)
+	expectHeadwords(suite.mockDictionaryEntryPeer, ProviderLocal, "en-tw", "apple")
+
+	for _, word := range []string{"banana", "cherry"} {
+		_, err := provider.Lookup(word, "en-tw")
+		suite.ErrorIs(err, errWordNotFound)
+	}
+}
+
+// TestOfflineProviderLookupErrors tests how offlineProvider reports missing
+// words, database failures and unreadable stored entries
+func (suite *ControllerTestSuite) TestOfflineProviderLookupErrors() {
+	tests := []struct {
+		name      string
+		indexErr  error
+		entries   []*dbModels.DictionaryEntry
+		selectErr error
+		wantErrIs error
+	}{
+		{"index failure", errors.New("connection refused"), nil, nil, errUpstreamUnavailable},
+		{"entry removed since indexing", nil, []*dbModels.DictionaryEntry{}, nil, errWordNotFound},
+		{"database failure", nil, nil, errors.New("connection refused"), errUpstreamUnavailable},
+		{"corrupt entry", nil, []*dbModels.DictionaryEntry{{Entry: utils.StrPtr(`{"word":`)}}, nil, errUpstreamUnavailable},
+	}
+
+	for _, tt := range tests {
+		suite.Run(tt.name, func() {
+			peer := mocks.NewMockDictionaryEntryPeer(suite.T())
+			provider := newOfflineProvider(ProviderWiktionary, peer)
+			if tt.indexErr != nil {
+				peer.EXPECT().
+					Select(mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+					Return(nil, tt.indexErr).Times(1)
+			} else {
+				expectHeadwords(peer, ProviderWiktionary, "en-tw", "hello")
+				peer.EXPECT().
+					Select(mock.Anything, provider.entryWhere("en-tw", "hello"), mock.Anything, mock.Anything, mock.Anything).
+					Return(tt.entries, tt.selectErr).Times(1)
+			}
+
+			_, err := provider.Lookup("hello", "en-tw")
+
+			suite.ErrorIs(err, tt.wantErrIs)
+		})
+	}
+}
+
+// TestOfflineProviderSuggest tests that suggestions list the exact match,
+// then prefix matches, then close misspellings
+func (suite *ControllerTestSuite) TestOfflineProviderSuggest() {
+	provider := newOfflineProvider(ProviderLocal, suite.mockDictionaryEntryPeer)
+	expectHeadwords(suite.mockDictionaryEntryPeer, ProviderLocal, "en-tw", "hello", "help", "helper", "hell", "yellow", "world")
+
+	suggestions, err := provider.Suggest("Hell", "en-tw", 10)
+
+	suite.Require().NoError(err)
+	suite.Equal([]models.DictionarySuggestion{
+		{Word: "hell", Provider: ProviderLocal, Match: matchExact},
+		{Word: "hello", Provider: ProviderLocal, Match: matchPrefix},
+		{Word: "help", Provider: ProviderLocal, Match: matchFuzzy},
+	}, suggestions)
+}
+
+// TestOfflineProviderSuggestLimit tests that suggestions stop at the limit
+func (suite *ControllerTestSuite) TestOfflineProviderSuggestLimit() {
+	provider := newOfflineProvider(ProviderLocal, suite.mockDictionaryEntryPeer)
+	expectHeadwords(suite.mockDictionaryEntryPeer, ProviderLocal, "en-tw", "cat", "catch", "category", "cattle")
+
+	suggestions, err := provider.Suggest("cat", "en-tw", 2)
+
+	suite.Require().NoError(err)
+	suite.Len(suggestions, 2)
+	suite.Equal("cat", suggestions[0].Word)
+	suite.Equal("catch", suggestions[1].Word)
+}
+
+// TestNormalizeHeadword tests headword folding
+func (suite *ControllerTestSuite) TestNormalizeHeadword() {
+	suite.Equal("look up", normalizeHeadword("  Look \t UP "))
+	suite.Equal("", normalizeHeadword("   "))
+}
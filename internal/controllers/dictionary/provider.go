@@ -0,0 +1,139 @@
+package dictionary
+
+import (
+	"errors"
+	"log/slog"
+	"strings"
+
+	"word-flashcard/internal/models"
+)
+
+// Provider names, as accepted by the provider query parameter and the
+// DICTIONARY_PROVIDERS setting.
+const (
+	ProviderCambridge  = "cambridge"
+	ProviderLocal      = "local"
+	ProviderWiktionary = "wiktionary"
+)
+
+// defaultProviderOrder is the order providers are tried in when
+// DICTIONARY_PROVIDERS is unset: the live site first, then the offline dumps.
+const defaultProviderOrder = "cambridge,local,wiktionary"
+
+// errWordNotFound marks a lookup for a word the provider does not have, as
+// opposed to the provider being unreachable or the request failing outright.
+var errWordNotFound = errors.New("word not found")
+
+// errUnsupportedLanguage marks a language slug a provider does not know how to fetch.
+var errUnsupportedLanguage = errors.New("unsupported language")
+
+// errUpstreamUnavailable marks a provider that could not be reached or failed
+// while answering.
+var errUpstreamUnavailable = errors.New("dictionary provider unavailable")
+
+// errTransient marks an upstream failure worth retrying, such as a timeout or
+// a 5xx response. It is always wrapped together with errUpstreamUnavailable.
+var errTransient = errors.New("transient failure")
+
+// DictionaryProvider looks words up in one dictionary backend. Lookup errors
+// wrap errWordNotFound, errUnsupportedLanguage or errUpstreamUnavailable so
+// the controller can decide whether to fall back to the next provider.
+type DictionaryProvider interface {
+	Name() string
+	Lookup(word, language string) (*models.DictionaryResult, error)
+}
+
+// hostedProvider is implemented by providers that fetch from a remote host,
+// so batch lookups can rate limit requests per host
+type hostedProvider interface {
+	Host() string
+}
+
+// providerHost returns the host a provider fetches from, or "" for one that
+// answers locally
+func providerHost(provider DictionaryProvider) string {
+	if hosted, ok := provider.(hostedProvider); ok {
+		return hosted.Host()
+	}
+	return ""
+}
+
+// parseProviderOrder splits a comma-separated list of provider names into the
+// order providers are tried in, skipping unknown and repeated names. An empty
+// result falls back to defaultProviderOrder.
+func parseProviderOrder(value string, providers map[string]DictionaryProvider) []string {
+	var order []string
+	seen := make(map[string]bool)
+	for _, name := range strings.Split(value, ",") {
+		name = strings.ToLower(strings.TrimSpace(name))
+		if name == "" || seen[name] {
+			continue
+		}
+		if _, ok := providers[name]; !ok {
+			slog.Warn("Ignoring unknown dictionary provider", "provider", name)
+			continue
+		}
+		seen[name] = true
+		order = append(order, name)
+	}
+	if len(order) == 0 && value != defaultProviderOrder {
+		return parseProviderOrder(defaultProviderOrder, providers)
+	}
+	return order
+}
+
+// lookup asks each named provider in turn and returns the first result,
+// answering from the lookup cache where a provider's result is cached. When
+// every provider fails, the error returned is the one most worth reporting:
+// an unavailable provider (which might have had the word) outranks a
+// not-found, which outranks an unsupported language.
+func (dc *Controller) lookup(word, language string, providerNames []string) (*models.DictionaryResult, error) {
+	return dc.lookupWith(word, language, providerNames, func(provider DictionaryProvider) (*models.DictionaryResult, error) {
+		return provider.Lookup(word, language)
+	})
+}
+
+// lookupWith is lookup with fetch asking a provider on a cache miss, so batch
+// lookups can add rate limiting and retries around each provider call
+func (dc *Controller) lookupWith(word, language string, providerNames []string, fetch func(DictionaryProvider) (*models.DictionaryResult, error)) (*models.DictionaryResult, error) {
+	var lookupErr error
+	for _, name := range providerNames {
+		provider := dc.providers[name]
+		cached := isCached(provider)
+		if cached {
+			if result := dc.getFromCache(name, language, word); result != nil {
+				result.Provider = name
+				return result, nil
+			}
+		}
+
+		result, err := fetch(provider)
+		if err == nil {
+			result.Provider = name
+			if cached {
+				dc.setCache(name, language, word, result)
+			}
+			return result, nil
+		}
+
+		if !errors.Is(err, errWordNotFound) && !errors.Is(err, errUnsupportedLanguage) {
+			slog.Warn("Dictionary provider failed, trying the next one", "provider", name, "word", word, "error", err)
+		}
+		if lookupErr == nil || lookupErrorRank(err) > lookupErrorRank(lookupErr) {
+			lookupErr = err
+		}
+	}
+	return nil, lookupErr
+}
+
+// lookupErrorRank orders lookup errors by how much they tell the caller; see lookup.
+func lookupErrorRank(err error) int {
+	switch {
+	case errors.Is(err, errUnsupportedLanguage):
+		return 0
+	case errors.Is(err, errWordNotFound):
+		return 1
+	default:
+		return 2
+	}
+}
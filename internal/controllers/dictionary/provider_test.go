@@ -0,0 +1,125 @@
+package dictionary
+
+import (
+	"fmt"
+
+	"word-flashcard/internal/models"
+)
+
+// stubProvider is a DictionaryProvider answering every lookup with the same
+// result or error, counting how often it was asked
+type stubProvider struct {
+	name   string
+	result *models.DictionaryResult
+	err    error
+	calls  int
+}
+
+func (p *stubProvider) Name() string {
+	return p.name
+}
+
+func (p *stubProvider) Lookup(word, language string) (*models.DictionaryResult, error) {
+	p.calls++
+	if p.err != nil {
+		return nil, p.err
+	}
+	result := *p.result
+	return &result, nil
+}
+
+// TestParseProviderOrder tests that the configured order keeps known names
+// once each and falls back to the default order when nothing usable is left
+func (suite *ControllerTestSuite) TestParseProviderOrder() {
+	providers := suite.controller.providers
+
+	tests := []struct {
+		name  string
+		value string
+		want  []string
+	}{
+		{"default order", defaultProviderOrder, []string{ProviderCambridge, ProviderLocal, ProviderWiktionary}},
+		{"custom order with spaces and case", " Local , cambridge", []string{ProviderLocal, ProviderCambridge}},
+		{"unknown and repeated names are skipped", "wiktionary,oxford,wiktionary", []string{ProviderWiktionary}},
+		{"nothing usable falls back to the default", "oxford,,", []string{ProviderCambridge, ProviderLocal, ProviderWiktionary}},
+	}
+
+	for _, tt := range tests {
+		suite.Run(tt.name, func() {
+			suite.Equal(tt.want, parseProviderOrder(tt.value, providers))
+		})
+	}
+}
+
+// TestLookup tests that lookup returns the first provider's result and, when
+// all providers fail, reports the most significant failure
+func (suite *ControllerTestSuite) TestLookup() {
+	found := func(name string) *stubProvider {
+		return &stubProvider{name: name, result: &models.DictionaryResult{Word: "hello"}}
+	}
+	failing := func(name string, err error) *stubProvider {
+		return &stubProvider{name: name, err: fmt.Errorf("%w: hello", err)}
+	}
+
+	tests := []struct {
+		name          string
+		providers     []*stubProvider
+		wantProvider  string
+		wantErrIs     error
+		wantCallCount []int
+	}{
+		{
+			name:          "first provider answers and the rest are not asked",
+			providers:     []*stubProvider{found("a"), found("b")},
+			wantProvider:  "a",
+			wantCallCount: []int{1, 0},
+		},
+		{
+			name:          "falls back past a not-found",
+			providers:     []*stubProvider{failing("a", errWordNotFound), found("b")},
+			wantProvider:  "b",
+			wantCallCount: []int{1, 1},
+		},
+		{
+			name:          "falls back past an unavailable provider",
+			providers:     []*stubProvider{failing("a", errUpstreamUnavailable), found("b")},
+			wantProvider:  "b",
+			wantCallCount: []int{1, 1},
+		},
+		{
+			name:          "unavailable outranks not found",
+			providers:     []*stubProvider{failing("a", errWordNotFound), failing("b", errUpstreamUnavailable), failing("c", errUnsupportedLanguage)},
+			wantErrIs:     errUpstreamUnavailable,
+			wantCallCount: []int{1, 1, 1},
+		},
+		{
+			name:          "not found outranks an unsupported language",
+			providers:     []*stubProvider{failing("a", errUnsupportedLanguage), failing("b", errWordNotFound)},
+			wantErrIs:     errWordNotFound,
+			wantCallCount: []int{1, 1},
+		},
+	}
+
+	for _, tt := range tests {
+		suite.Run(tt.name, func() {
+			suite.controller.providers = make(map[string]DictionaryProvider)
+			var names []string
+			for _, p := range tt.providers {
+				suite.controller.providers[p.name] = p
+				names = append(names, p.name)
+			}
+
+			result, err := suite.controller.lookup("hello", "en-tw", names)
+
+			if tt.wantErrIs != nil {
+				suite.ErrorIs(err, tt.wantErrIs)
+			} else {
+				suite.Require().NoError(err)
+				suite.Equal(tt.wantProvider, result.Provider)
+			}
+			for i, p := range tt.providers {
+				suite.Equal(tt.wantCallCount[i], p.calls, "calls to provider %s", p.name)
+			}
+		})
+	}
+}
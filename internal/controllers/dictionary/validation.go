@@ -0,0 +1,94 @@
+package dictionary
+
+import (
+	"path/filepath"
+	"regexp"
+	"strings"
+
+	"word-flashcard/internal/controllers/common"
+	"word-flashcard/internal/models"
+)
+
+// languageSlugRE matches dictionary language slugs such as "en" or "en-tw"
+var languageSlugRE = regexp.MustCompile(`^[a-z]{2,3}(-[a-z]{2,4})?$`)
+
+// validateImportRequest checks the fields of an import request
+func validateImportRequest(req *models.DictionaryImportRequest) error {
+	if req.Format == nil {
+		return common.NewFieldError("format is required")
+	}
+	if _, ok := importFormatProviders[*req.Format]; !ok {
+		return common.NewFieldError("format is invalid", "reason", "must be one of stardict, wordnet, wiktionary")
+	}
+	if req.Language == nil || !languageSlugRE.MatchString(*req.Language) {
+		return common.NewFieldError("language is invalid", "reason", "must be a language slug such as en or en-tw")
+	}
+	if req.Path == nil || strings.TrimSpace(*req.Path) == "" {
+		return common.NewFieldError("path is required")
+	}
+	return nil
+}
+
+// resolveImportPath joins a requested dump path onto the import directory,
+// refusing absolute paths and any path that climbs out of the directory, so
+// a request can only ever read files an operator placed there.
+func resolveImportPath(dir, path string) (string, error) {
+	if filepath.IsAbs(path) {
+		return "", common.NewFieldError("path is invalid", "reason", "must be relative to the import directory")
+	}
+	full := filepath.Join(dir, path)
+	rel, err := filepath.Rel(dir, full)
+	if err != nil || rel == ".." || strings.HasPrefix(rel, ".."+string(filepath.Separator)) {
+		return "", common.NewFieldError("path is invalid", "reason", "must stay inside the import directory")
+	}
+	return full, nil
+}
+
+// validateBatchRequest checks the fields of a batch lookup request and
+// returns its words trimmed, without blanks and with repeats dropped
+func validateBatchRequest(req *models.DictionaryBatchRequest, maxWords int, providers map[string]DictionaryProvider) ([]string, error) {
+	if req.Language == nil || !languageSlugRE.MatchString(*req.Language) {
+		return nil, common.NewFieldError("language is invalid", "reason", "must be a language slug such as en or en-tw")
+	}
+	if req.Provider != nil {
+		if _, ok := providers[*req.Provider]; !ok {
+			return nil, common.NewFieldError("provider is invalid", "value", *req.Provider)
+		}
+	}
+
+	var words []string
+	seen := make(map[string]bool)
+	for _, word := range req.Words {
+		word = strings.TrimSpace(word)
+		key := strings.ToLower(word)
+		if word == "" || seen[key] {
+			continue
+		}
+		seen[key] = true
+		words = append(words, word)
+	}
+	if len(words) == 0 {
+		return nil, common.NewFieldError("words is required")
+	}
+	if len(words) > maxWords {
+		return nil, common.NewFieldError("words has too many entries", "count", len(words), "max", maxWords)
+	}
+	return words, nil
+}
+
+// validateWordFromDictionaryRequest checks the fields of a request to save a
+// word from the dictionary
+func validateWordFromDictionaryRequest(req *models.WordFromDictionaryRequest, providers map[string]DictionaryProvider) error {
+	if req.Word == nil || strings.TrimSpace(*req.Word) == "" {
+		return common.NewFieldError("word is required")
+	}
+	if req.Language == nil || !languageSlugRE.MatchString(*req.Language) {
+		return common.NewFieldError("language is invalid", "reason", "must be a language slug such as en or en-tw")
+	}
+	if req.Provider != nil {
+		if _, ok := providers[*req.Provider]; !ok {
+			return common.NewFieldError("provider is invalid", "value", *req.Provider)
+		}
+	}
+	return nil
+}
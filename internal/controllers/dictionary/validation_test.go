@@ -0,0 +1,38 @@
+package dictionary
+
+import (
+	"path/filepath"
+)
+
+// TestResolveImportPath tests that import paths can't leave the import directory
+func (suite *ControllerTestSuite) TestResolveImportPath() {
+	dir := filepath.Join("srv", "dictionaries")
+
+	tests := []struct {
+		name    string
+		path    string
+		want    string
+		wantErr bool
+	}{
+		{"file", "wordnet/data.noun", filepath.Join(dir, "wordnet", "data.noun"), false},
+		{"directory itself", ".", dir, false},
+		{"inner dot dot", "wordnet/../en.jsonl", filepath.Join(dir, "en.jsonl"), false},
+		{"name starting with dots", "..en.jsonl", filepath.Join(dir, "..en.jsonl"), false},
+		{"parent", "..", "", true},
+		{"traversal", "../../etc/passwd", "", true},
+		{"absolute", "/etc/passwd", "", true},
+	}
+
+	for _, tt := range tests {
+		suite.Run(tt.name, func() {
+			got, err := resolveImportPath(dir, tt.path)
+
+			if tt.wantErr {
+				suite.Error(err)
+				return
+			}
+			suite.Require().NoError(err)
+			suite.Equal(tt.want, got)
+		})
+	}
+}
@@ -0,0 +1,58 @@
+package dictionary
+
+import (
+	"fmt"
+
+	dbModels "word-flashcard/data/models"
+	"word-flashcard/data/peers"
+	"word-flashcard/data/schema"
+	"word-flashcard/internal/models"
+
+	"github.com/Masterminds/squirrel"
+)
+
+// saveWord writes word with the definitions of its lookup result in one
+// transaction, merging them into the word when it already exists.
+// Pronunciation audio is stored first so the definitions point at the
+// stored copies.
+func (dc *Controller) saveWord(word string, result *models.DictionaryResult) (*peers.SavedWord, error) {
+	definitions := result.ToWordDefinitions()
+	definitionModels := make([]*dbModels.WordDefinition, 0, len(definitions))
+	for _, definition := range definitions {
+		dc.audioStore.LocalizePhonetics(definition.Phonetics)
+		definitionModels = append(definitionModels, definition.ToDataModel())
+	}
+	return dc.wordPeer.SaveWithDefinitions((&models.Word{Word: &word}).ToDataModel(), definitionModels)
+}
+
+// findWordID returns the ID of word in the word list, or 0 when it isn't there
+func (dc *Controller) findWordID(word string) (int, error) {
+	limit := uint64(1)
+	words, err := dc.wordPeer.Select([]*string{}, squirrel.Eq{schema.WORD_WORD: word}, nil, &limit, nil)
+	if err != nil {
+		return 0, err
+	}
+	if len(words) == 0 || words[0].Id == nil {
+		return 0, nil
+	}
+	return *words[0].Id, nil
+}
+
+// fetchWord reads the word with wordID back together with its definitions
+func (dc *Controller) fetchWord(wordID int) (*models.Word, error) {
+	limit := uint64(1)
+	words, err := dc.wordPeer.Select([]*string{}, squirrel.Eq{schema.WORD_ID: wordID}, nil, &limit, nil)
+	if err != nil {
+		return nil, err
+	}
+	if len(words) == 0 {
+		return nil, fmt.Errorf("word %d not found", wordID)
+	}
+
+	orderBy := fmt.Sprintf("%s ASC", schema.WORD_DEFINITIONS_ID)
+	definitions, err := dc.wordDefinitionPeer.Select([]*string{}, squirrel.Eq{schema.WORD_DEFINITIONS_WORD_ID: wordID}, []*string{&orderBy}, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	return (&models.Word{}).FromDataModel(words[0], definitions), nil
+}
@@ -0,0 +1,65 @@
+package leech
+
+import (
+	"word-flashcard/data/peers"
+	"word-flashcard/internal/leechdetect"
+)
+
+// Controller handles requests that list, clear and configure leeches: words
+// and questions that keep being forgotten. Leeches are tagged by the word and
+// question controllers through the shared LeechDetector.
+type Controller struct {
+	leechDetector         *leechdetect.LeechDetector
+	wordPeer              peers.WordPeerInterface
+	questionPeer          peers.QuestionPeerInterface
+	wordPracticeLogPeer   peers.WordPracticeLogPeerInterface
+	questionAnswerLogPeer peers.QuestionAnswerLogPeerInterface
+}
+
+// New creates a new Controller instance
+func New(
+	leechDetector *leechdetect.LeechDetector,
+	wordPeer peers.WordPeerInterface,
+	questionPeer peers.QuestionPeerInterface,
+	wordPracticeLogPeer peers.WordPracticeLogPeerInterface,
+	questionAnswerLogPeer peers.QuestionAnswerLogPeerInterface,
+) *Controller {
+	return &Controller{
+		leechDetector:         leechDetector,
+		wordPeer:              wordPeer,
+		questionPeer:          questionPeer,
+		wordPracticeLogPeer:   wordPracticeLogPeer,
+		questionAnswerLogPeer: questionAnswerLogPeer,
+	}
+}
+
+// GetReelPeers returns the real database peers, in the order
+// leechdetect.NewLeechDetector takes them
+func GetReelPeers() (peers.SettingPeerInterface, peers.WordPeerInterface, peers.QuestionPeerInterface, peers.WordPracticeLogPeerInterface, peers.QuestionAnswerLogPeerInterface, error) {
+	settingPeer, err := peers.NewSettingPeer()
+	if err != nil {
+		return nil, nil, nil, nil, nil, err
+	}
+
+	wordPeer, err := peers.NewWordPeer()
+	if err != nil {
+		return nil, nil, nil, nil, nil, err
+	}
+
+	questionPeer, err := peers.NewQuestionPeer()
+	if err != nil {
+		return nil, nil, nil, nil, nil, err
+	}
+
+	wordPracticeLogPeer, err := peers.NewWordPracticeLogPeer()
+	if err != nil {
+		return nil, nil, nil, nil, nil, err
+	}
+
+	questionAnswerLogPeer, err := peers.NewQuestionAnswerLogPeer()
+	if err != nil {
+		return nil, nil, nil, nil, nil, err
+	}
+
+	return settingPeer, wordPeer, questionPeer, wordPracticeLogPeer, questionAnswerLogPeer, nil
+}
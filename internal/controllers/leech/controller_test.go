@@ -0,0 +1,48 @@
+package leech
+
+import (
+	"testing"
+	"word-flashcard/data/mocks"
+	"word-flashcard/internal/leechdetect"
+
+	"github.com/stretchr/testify/suite"
+)
+
+// ControllerTestSuite is a test suite for the leech Controller
+type ControllerTestSuite struct {
+	suite.Suite
+	controller                *Controller
+	mockSettingPeer           *mocks.MockSettingPeer
+	mockWordPeer              *mocks.MockWordPeer
+	mockQuestionPeer          *mocks.MockQuestionPeer
+	mockWordPracticeLogPeer   *mocks.MockWordPracticeLogPeer
+	mockQuestionAnswerLogPeer *mocks.MockQuestionAnswerLogPeer
+}
+
+// TestControllerTestSuite runs the ControllerTestSuite
+func TestControllerTestSuite(t *testing.T) {
+	suite.Run(t, new(ControllerTestSuite))
+}
+
+// SetupTest sets up the test environment before each test
+func (suite *ControllerTestSuite) SetupTest() {
+	suite.mockSettingPeer = mocks.NewMockSettingPeer(suite.T())
+	suite.mockWordPeer = mocks.NewMockWordPeer(suite.T())
+	suite.mockQuestionPeer = mocks.NewMockQuestionPeer(suite.T())
+	suite.mockWordPracticeLogPeer = mocks.NewMockWordPracticeLogPeer(suite.T())
+	suite.mockQuestionAnswerLogPeer = mocks.NewMockQuestionAnswerLogPeer(suite.T())
+	leechDetector := leechdetect.NewLeechDetector(
+		suite.mockSettingPeer,
+		suite.mockWordPeer,
+		suite.mockQuestionPeer,
+		suite.mockWordPracticeLogPeer,
+		suite.mockQuestionAnswerLogPeer,
+	)
+	suite.controller = New(
+		leechDetector,
+		suite.mockWordPeer,
+		suite.mockQuestionPeer,
+		suite.mockWordPracticeLogPeer,
+		suite.mockQuestionAnswerLogPeer,
+	)
+}
@@ -0,0 +1,201 @@
+package leech
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	dbModels "word-flashcard/data/models"
+	"word-flashcard/data/schema"
+	"word-flashcard/internal/leechdetect"
+	"word-flashcard/internal/models"
+
+	"github.com/Masterminds/squirrel"
+)
+
+// maxLeechLapses caps how many of a leech's lapses are listed
+const maxLeechLapses = 20
+
+// columnPtrs returns pointers to columns, the form peers select columns in
+func columnPtrs(columns ...string) []*string {
+	ptrs := make([]*string, len(columns))
+	for i := range columns {
+		ptrs[i] = &columns[i]
+	}
+	return ptrs
+}
+
+// settingsResponse converts a leech configuration to its API model
+func settingsResponse(config leechdetect.LeechConfig) models.LeechSettings {
+	return models.LeechSettings{
+		WordLapses:       &config.WordLapses,
+		QuestionFailures: &config.QuestionFailures,
+		AutoSuspend:      &config.AutoSuspend,
+	}
+}
+
+// wordLeech builds the leech of word from its lapse logs, most recent first
+func wordLeech(word *dbModels.Word, logs []*dbModels.WordPracticeLog) models.Leech {
+	leech := models.Leech{
+		Type:        schema.ENTITY_TYPE_WORD,
+		Familiarity: word.Familiarity,
+		IsSuspended: word.IsSuspended != nil && *word.IsSuspended,
+		LapseCount:  len(logs),
+		Lapses:      []models.LeechLapse{},
+	}
+	if word.Id != nil {
+		leech.ID = *word.Id
+	}
+	if word.Word != nil {
+		leech.Text = *word.Word
+	}
+	for _, log := range logs {
+		if log.CreatedAt == nil || len(leech.Lapses) == maxLeechLapses {
+			continue
+		}
+		leech.Lapses = append(leech.Lapses, models.LeechLapse{At: *log.CreatedAt, PreviousFamiliarity: log.PreviousFamiliarity})
+	}
+	if len(leech.Lapses) > 0 {
+		leech.LastLapseAt = &leech.Lapses[0].At
+	}
+	return leech
+}
+
+// questionLeech builds the leech of question from its wrong answer logs,
+// most recent first
+func questionLeech(question *dbModels.Question, logs []*dbModels.QuestionAnswerLog) models.Leech {
+	leech := models.Leech{
+		Type:        schema.ENTITY_TYPE_QUESTION,
+		IsSuspended: question.IsSuspended != nil && *question.IsSuspended,
+		LapseCount:  len(logs),
+		Lapses:      []models.LeechLapse{},
+	}
+	if question.Id != nil {
+		leech.ID = *question.Id
+	}
+	if question.Question != nil {
+		leech.Text = *question.Question
+	}
+	for _, log := range logs {
+		if log.CreatedAt == nil || len(leech.Lapses) == maxLeechLapses {
+			continue
+		}
+		lapse := models.LeechLapse{At: *log.CreatedAt}
+		if log.SelectedOption != nil && *log.SelectedOption != "" {
+			lapse.SelectedOption = log.SelectedOption
+		}
+		if log.Response != nil && json.Valid([]byte(*log.Response)) {
+			lapse.Response = json.RawMessage(*log.Response)
+		}
+		leech.Lapses = append(leech.Lapses, lapse)
+	}
+	if len(leech.Lapses) > 0 {
+		leech.LastLapseAt = &leech.Lapses[0].At
+	}
+	return leech
+}
+
+// wordLeeches returns every word tagged as a leech with its lapse history
+func (lc *Controller) wordLeeches() ([]models.Leech, error) {
+	words, err := lc.wordPeer.Select(
+		columnPtrs(schema.WORD_ID, schema.WORD_WORD, schema.WORD_FAMILIARITY, schema.WORD_IS_SUSPENDED),
+		squirrel.Eq{schema.WORD_IS_LEECH: true}, nil, nil, nil,
+	)
+	if err != nil || len(words) == 0 {
+		return []models.Leech{}, err
+	}
+
+	wordIDs := make([]int, 0, len(words))
+	for _, word := range words {
+		if word.Id != nil {
+			wordIDs = append(wordIDs, *word.Id)
+		}
+	}
+	newestFirst := fmt.Sprintf("%s DESC", schema.COMMON_CREATED_AT)
+	logs, err := lc.wordPracticeLogPeer.Select(
+		columnPtrs(schema.WORD_PRACTICE_LOG_WORD_ID, schema.WORD_PRACTICE_LOG_PREVIOUS_FAMILIARITY, schema.COMMON_CREATED_AT),
+		leechdetect.WordLapses(wordIDs...), []*string{&newestFirst}, nil, nil,
+	)
+	if err != nil {
+		return nil, err
+	}
+	logsByWord := make(map[int][]*dbModels.WordPracticeLog)
+	for _, log := range logs {
+		if log.WordId != nil {
+			logsByWord[*log.WordId] = append(logsByWord[*log.WordId], log)
+		}
+	}
+
+	leeches := make([]models.Leech, 0, len(words))
+	for _, word := range words {
+		if word.Id != nil {
+			leeches = append(leeches, wordLeech(word, logsByWord[*word.Id]))
+		}
+	}
+	return leeches, nil
+}
+
+// questionLeeches returns every question tagged as a leech with its wrong answers
+func (lc *Controller) questionLeeches() ([]models.Leech, error) {
+	questions, err := lc.questionPeer.Select(
+		columnPtrs(schema.QUESTION_ID, schema.QUESTION_QUESTION, schema.QUESTION_IS_SUSPENDED),
+		squirrel.Eq{schema.QUESTION_IS_LEECH: true}, nil, nil, nil,
+	)
+	if err != nil || len(questions) == 0 {
+		return []models.Leech{}, err
+	}
+
+	questionIDs := make([]int, 0, len(questions))
+	for _, question := range questions {
+		if question.Id != nil {
+			questionIDs = append(questionIDs, *question.Id)
+		}
+	}
+	newestFirst := fmt.Sprintf("%s DESC", schema.COMMON_CREATED_AT)
+	logs, err := lc.questionAnswerLogPeer.Select(
+		columnPtrs(
+			schema.QUESTION_ANSWER_LOG_QUESTION_ID,
+			schema.QUESTION_ANSWER_LOG_SELECTED_OPTION,
+			schema.QUESTION_ANSWER_LOG_RESPONSE,
+			schema.COMMON_CREATED_AT,
+		),
+		leechdetect.QuestionFailures(questionIDs...), []*string{&newestFirst}, nil, nil,
+	)
+	if err != nil {
+		return nil, err
+	}
+	logsByQuestion := make(map[int][]*dbModels.QuestionAnswerLog)
+	for _, log := range logs {
+		if log.QuestionId != nil {
+			logsByQuestion[*log.QuestionId] = append(logsByQuestion[*log.QuestionId], log)
+		}
+	}
+
+	leeches := make([]models.Leech, 0, len(questions))
+	for _, question := range questions {
+		if question.Id != nil {
+			leeches = append(leeches, questionLeech(question, logsByQuestion[*question.Id]))
+		}
+	}
+	return leeches, nil
+}
+
+// sortLeeches orders leeches by lapse count, most lapsed first, then by
+// most recent lapse, then words before questions and by ID
+func sortLeeches(leeches []models.Leech) {
+	sort.SliceStable(leeches, func(i, j int) bool {
+		a, b := leeches[i], leeches[j]
+		if a.LapseCount != b.LapseCount {
+			return a.LapseCount > b.LapseCount
+		}
+		if (a.LastLapseAt == nil) != (b.LastLapseAt == nil) {
+			return a.LastLapseAt != nil
+		}
+		if a.LastLapseAt != nil && !a.LastLapseAt.Equal(*b.LastLapseAt) {
+			return a.LastLapseAt.After(*b.LastLapseAt)
+		}
+		if a.Type != b.Type {
+			return a.Type == schema.ENTITY_TYPE_WORD
+		}
+		return a.ID < b.ID
+	})
+}
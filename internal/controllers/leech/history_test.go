@@ -0,0 +1,87 @@
+package leech
+
+import (
+	"encoding/json"
+	"time"
+	dbModels "word-flashcard/data/models"
+	"word-flashcard/internal/models"
+	"word-flashcard/utils"
+)
+
+// lapseAt returns a time daysIn days after 2026-03-01 noon UTC
+func lapseAt(daysIn int) *time.Time {
+	at := time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC).AddDate(0, 0, daysIn)
+	return &at
+}
+
+// TestWordLeech tests that a word leech counts every lapse and lists the
+// latest first, capped at maxLeechLapses
+func (suite *ControllerTestSuite) TestWordLeech() {
+	word := &dbModels.Word{Id: utils.IntPtr(3), Word: utils.StrPtr("ubiquitous"), Familiarity: utils.StrPtr("red"), IsSuspended: utils.BoolPtr(true)}
+	var logs []*dbModels.WordPracticeLog
+	for i := maxLeechLapses + 4; i > 0; i-- {
+		logs = append(logs, &dbModels.WordPracticeLog{PreviousFamiliarity: utils.StrPtr("yellow"), CreatedAt: lapseAt(i)})
+	}
+
+	leech := wordLeech(word, logs)
+
+	suite.Equal("word", leech.Type)
+	suite.Equal(3, leech.ID)
+	suite.Equal("ubiquitous", leech.Text)
+	suite.True(leech.IsSuspended)
+	suite.Equal(maxLeechLapses+4, leech.LapseCount)
+	suite.Len(leech.Lapses, maxLeechLapses)
+	suite.Equal(*lapseAt(maxLeechLapses + 4), *leech.LastLapseAt)
+	suite.Equal("yellow", *leech.Lapses[0].PreviousFamiliarity)
+}
+
+// TestQuestionLeech tests that a question leech lists the selected option
+// or graded response of each wrong answer
+func (suite *ControllerTestSuite) TestQuestionLeech() {
+	question := &dbModels.Question{Id: utils.IntPtr(9), Question: utils.StrPtr("Order the steps")}
+	logs := []*dbModels.QuestionAnswerLog{
+		{SelectedOption: utils.StrPtr(""), Response: utils.StrPtr(`{"option_ids":[2,1]}`), CreatedAt: lapseAt(2)},
+		{SelectedOption: utils.StrPtr("C"), CreatedAt: lapseAt(1)},
+	}
+
+	leech := questionLeech(question, logs)
+
+	suite.Equal("question", leech.Type)
+	suite.False(leech.IsSuspended)
+	suite.Equal(2, leech.LapseCount)
+	suite.Require().Len(leech.Lapses, 2)
+	suite.Nil(leech.Lapses[0].SelectedOption)
+	suite.JSONEq(`{"option_ids":[2,1]}`, string(leech.Lapses[0].Response))
+	suite.Equal("C", *leech.Lapses[1].SelectedOption)
+	suite.Nil(leech.Lapses[1].Response)
+}
+
+// TestQuestionLeechWithoutLapses tests that a leech whose logs were removed
+// has an empty history rather than a null one
+func (suite *ControllerTestSuite) TestQuestionLeechWithoutLapses() {
+	leech := questionLeech(&dbModels.Question{Id: utils.IntPtr(9)}, nil)
+
+	suite.Nil(leech.LastLapseAt)
+	body, err := json.Marshal(leech)
+	suite.Require().NoError(err)
+	suite.Contains(string(body), `"lapses":[]`)
+}
+
+// TestSortLeeches tests most lapsed first, then most recently lapsed, then
+// words before questions
+func (suite *ControllerTestSuite) TestSortLeeches() {
+	leeches := []models.Leech{
+		{Type: "question", ID: 1, LapseCount: 8, LastLapseAt: lapseAt(1)},
+		{Type: "word", ID: 2, LapseCount: 8, LastLapseAt: lapseAt(1)},
+		{Type: "word", ID: 3, LapseCount: 8, LastLapseAt: lapseAt(5)},
+		{Type: "word", ID: 4, LapseCount: 12},
+	}
+
+	sortLeeches(leeches)
+
+	ids := make([]int, len(leeches))
+	for i, leech := range leeches {
+		ids[i] = leech.ID
+	}
+	suite.Equal([]int{4, 3, 2, 1}, ids)
+}
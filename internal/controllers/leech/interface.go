@@ -0,0 +1,11 @@
+package leech
+
+import "github.com/gin-gonic/gin"
+
+// ControllerInterface defines the interface for leech controller
+type ControllerInterface interface {
+	ListLeeches(c *gin.Context)
+	ClearLeech(c *gin.Context)
+	GetLeechSettings(c *gin.Context)
+	UpdateLeechSettings(c *gin.Context)
+}
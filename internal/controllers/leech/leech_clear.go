@@ -0,0 +1,75 @@
+package leech
+
+import (
+	"net/http"
+	dbModels "word-flashcard/data/models"
+	"word-flashcard/data/schema"
+	"word-flashcard/internal/controllers/common"
+	"word-flashcard/internal/models"
+	"word-flashcard/utils"
+
+	"github.com/Masterminds/squirrel"
+	"github.com/gin-gonic/gin"
+)
+
+// ClearLeech @Summary Clear a leech
+// @Description Untag a word or question as a leech and return it to random quizzes, typically after rewriting it. Its lapse history is kept; it is tagged again if it keeps lapsing.
+// @Tags leeches
+// @Param type path string true "Leech type: word or question"
+// @Param id path int true "Word or question ID"
+// @Success 204 "Leech cleared successfully"
+// @Failure 400 {object} models.ErrorResponse "Bad request - Invalid type or ID"
+// @Failure 404 {object} models.ErrorResponse "Not found - Word or question not found"
+// @Failure 500 {object} models.ErrorResponse "Internal server error - Failed to update data in database"
+// @Router /api/leeches/{type}/{id} [delete]
+func (lc *Controller) ClearLeech(c *gin.Context) {
+	// ================ 1. Parse request parameters ================
+	leechType := c.Param("type")
+	if leechType != schema.ENTITY_TYPE_WORD && leechType != schema.ENTITY_TYPE_QUESTION {
+		common.ResponseError(http.StatusBadRequest, "Invalid leech type.", models.ErrCodeInvalidRequest, nil, c)
+		return
+	}
+	id, err := common.ParseIDFromPath(c, "id")
+	if err != nil {
+		common.ResponseError(http.StatusBadRequest, "Invalid ID.", models.ErrCodeInvalidRequest, err, c)
+		return
+	}
+
+	// ================ 2. Update data in database ================
+	// Existence is checked with a select rather than Update's affected row
+	// count, which MySQL reports as 0 when the item wasn't a leech
+	if leechType == schema.ENTITY_TYPE_WORD {
+		where := squirrel.Eq{schema.WORD_ID: id}
+		words, err := lc.wordPeer.Select(columnPtrs(schema.WORD_ID), where, nil, nil, nil)
+		if err != nil {
+			common.ResponseError(http.StatusInternalServerError, "Failed to fetch data from database", models.ErrCodeInternalError, err, c)
+			return
+		} else if len(words) == 0 {
+			common.ResponseError(http.StatusNotFound, "Word not found", models.ErrCodeNotFound, nil, c)
+			return
+		}
+		cleared := &dbModels.Word{IsLeech: utils.BoolPtr(false), IsSuspended: utils.BoolPtr(false)}
+		if _, err := lc.wordPeer.Update(cleared, where); err != nil {
+			common.ResponseError(http.StatusInternalServerError, "Failed to update data in database", models.ErrCodeInternalError, err, c)
+			return
+		}
+	} else {
+		where := squirrel.Eq{schema.QUESTION_ID: id}
+		questions, err := lc.questionPeer.Select(columnPtrs(schema.QUESTION_ID), where, nil, nil, nil)
+		if err != nil {
+			common.ResponseError(http.StatusInternalServerError, "Failed to fetch data from database", models.ErrCodeInternalError, err, c)
+			return
+		} else if len(questions) == 0 {
+			common.ResponseError(http.StatusNotFound, "Question not found", models.ErrCodeNotFound, nil, c)
+			return
+		}
+		cleared := &dbModels.Question{IsLeech: utils.BoolPtr(false), IsSuspended: utils.BoolPtr(false)}
+		if _, err := lc.questionPeer.Update(cleared, where); err != nil {
+			common.ResponseError(http.StatusInternalServerError, "Failed to update data in database", models.ErrCodeInternalError, err, c)
+			return
+		}
+	}
+
+	// ================ 3. Send response ================
+	common.ResponseSuccess(http.StatusNoContent, nil, c)
+}
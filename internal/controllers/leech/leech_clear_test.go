@@ -0,0 +1,71 @@
+package leech
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	dbModels "word-flashcard/data/models"
+	"word-flashcard/data/schema"
+	"word-flashcard/utils"
+
+	"github.com/Masterminds/squirrel"
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+// clearLeechRequest calls ClearLeech for the given path parameters
+func (suite *ControllerTestSuite) clearLeechRequest(leechType string, id string) *httptest.ResponseRecorder {
+	w := httptest.NewRecorder()
+	ctx, _ := gin.CreateTestContext(w)
+	ctx.Request = httptest.NewRequest(http.MethodDelete, "/api/leeches/"+leechType+"/"+id, nil)
+	ctx.Params = gin.Params{{Key: "type", Value: leechType}, {Key: "id", Value: id}}
+	suite.controller.ClearLeech(ctx)
+	return w
+}
+
+// TestClearLeechWord tests that a word is untagged and unsuspended
+func (suite *ControllerTestSuite) TestClearLeechWord() {
+	where := squirrel.Eq{schema.WORD_ID: 4}
+	suite.mockWordPeer.EXPECT().
+		Select(mock.Anything, where, mock.Anything, mock.Anything, mock.Anything).
+		Return([]*dbModels.Word{{Id: utils.IntPtr(4)}}, nil).Times(1)
+	suite.mockWordPeer.EXPECT().
+		Update(&dbModels.Word{IsLeech: utils.BoolPtr(false), IsSuspended: utils.BoolPtr(false)}, where).
+		Return(int64(1), nil).Times(1)
+
+	w := suite.clearLeechRequest("word", "4")
+
+	assert.Equal(suite.T(), http.StatusNoContent, w.Code)
+}
+
+// TestClearLeechQuestionNotFound tests that a missing question returns 404
+func (suite *ControllerTestSuite) TestClearLeechQuestionNotFound() {
+	suite.mockQuestionPeer.EXPECT().
+		Select(mock.Anything, squirrel.Eq{schema.QUESTION_ID: 7}, mock.Anything, mock.Anything, mock.Anything).
+		Return([]*dbModels.Question{}, nil).Times(1)
+
+	w := suite.clearLeechRequest("question", "7")
+
+	assert.Equal(suite.T(), http.StatusNotFound, w.Code)
+}
+
+// TestClearLeechInvalidParameters tests that an unknown type or bad ID returns 400
+func (suite *ControllerTestSuite) TestClearLeechInvalidParameters() {
+	assert.Equal(suite.T(), http.StatusBadRequest, suite.clearLeechRequest("note", "1").Code)
+	assert.Equal(suite.T(), http.StatusBadRequest, suite.clearLeechRequest("word", "abc").Code)
+}
+
+// TestClearLeechDatabaseError tests that a failed update returns 500
+func (suite *ControllerTestSuite) TestClearLeechDatabaseError() {
+	suite.mockQuestionPeer.EXPECT().
+		Select(mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+		Return([]*dbModels.Question{{Id: utils.IntPtr(7)}}, nil).Times(1)
+	suite.mockQuestionPeer.EXPECT().
+		Update(mock.Anything, mock.Anything).
+		Return(int64(0), errors.New("db error")).Times(1)
+
+	w := suite.clearLeechRequest("question", "7")
+
+	assert.Equal(suite.T(), http.StatusInternalServerError, w.Code)
+}
@@ -0,0 +1,57 @@
+package leech
+
+import (
+	"net/http"
+	"word-flashcard/data/schema"
+	"word-flashcard/internal/controllers/common"
+	"word-flashcard/internal/models"
+
+	"github.com/gin-gonic/gin"
+)
+
+// ListLeeches @Summary List leeches
+// @Description List the words and questions tagged as leeches, most lapsed first, each with its lapse history: the practices that sent a word back to red and the wrong answers to a question
+// @Tags leeches
+// @Produce json
+// @Param type query string false "Only list leeches of this type: word or question"
+// @Success 200 {object} models.LeechList "Leeches retrieved successfully"
+// @Failure 400 {object} models.ErrorResponse "Bad request - Invalid type parameter"
+// @Failure 500 {object} models.ErrorResponse "Internal server error - Failed to fetch data from database"
+// @Router /api/leeches [get]
+func (lc *Controller) ListLeeches(c *gin.Context) {
+	// ================ 1. Parse query parameters ================
+	leechType := c.Query("type")
+	if leechType != "" && leechType != schema.ENTITY_TYPE_WORD && leechType != schema.ENTITY_TYPE_QUESTION {
+		common.ResponseError(http.StatusBadRequest, "Invalid type parameter", models.ErrCodeInvalidRequest, nil, c)
+		return
+	}
+
+	// ================ 2. Fetch data from database ================
+	config, err := lc.leechDetector.Config()
+	if err != nil {
+		common.ResponseError(http.StatusInternalServerError, "Failed to fetch data from database", models.ErrCodeInternalError, err, c)
+		return
+	}
+
+	leeches := []models.Leech{}
+	if leechType != schema.ENTITY_TYPE_QUESTION {
+		words, err := lc.wordLeeches()
+		if err != nil {
+			common.ResponseError(http.StatusInternalServerError, "Failed to fetch data from database", models.ErrCodeInternalError, err, c)
+			return
+		}
+		leeches = append(leeches, words...)
+	}
+	if leechType != schema.ENTITY_TYPE_WORD {
+		questions, err := lc.questionLeeches()
+		if err != nil {
+			common.ResponseError(http.StatusInternalServerError, "Failed to fetch data from database", models.ErrCodeInternalError, err, c)
+			return
+		}
+		leeches = append(leeches, questions...)
+	}
+
+	// ================ 3. Send response ================
+	sortLeeches(leeches)
+	common.ResponseSuccess(http.StatusOK, models.LeechList{Settings: settingsResponse(config), Leeches: leeches}, c)
+}
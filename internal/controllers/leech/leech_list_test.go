@@ -0,0 +1,109 @@
+package leech
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	dbModels "word-flashcard/data/models"
+	"word-flashcard/data/schema"
+	"word-flashcard/internal/leechdetect"
+	"word-flashcard/internal/models"
+	"word-flashcard/utils"
+
+	"github.com/Masterminds/squirrel"
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+// TestListLeeches tests that word and question leeches are listed with
+// their lapses, most lapsed first, alongside the settings
+func (suite *ControllerTestSuite) TestListLeeches() {
+	suite.mockSettingPeer.EXPECT().
+		Select(mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+		Return([]*dbModels.Setting{}, nil).Times(1)
+	suite.mockWordPeer.EXPECT().
+		Select(mock.Anything, squirrel.Eq{schema.WORD_IS_LEECH: true}, mock.Anything, mock.Anything, mock.Anything).
+		Return([]*dbModels.Word{{Id: utils.IntPtr(1), Word: utils.StrPtr("affect")}}, nil).Times(1)
+	suite.mockWordPracticeLogPeer.EXPECT().
+		Select(mock.Anything, leechdetect.WordLapses(1), mock.Anything, mock.Anything, mock.Anything).
+		Return([]*dbModels.WordPracticeLog{
+			{WordId: utils.IntPtr(1), PreviousFamiliarity: utils.StrPtr("green"), CreatedAt: lapseAt(2)},
+		}, nil).Times(1)
+	suite.mockQuestionPeer.EXPECT().
+		Select(mock.Anything, squirrel.Eq{schema.QUESTION_IS_LEECH: true}, mock.Anything, mock.Anything, mock.Anything).
+		Return([]*dbModels.Question{{Id: utils.IntPtr(5), Question: utils.StrPtr("Pick the antonym"), IsSuspended: utils.BoolPtr(true)}}, nil).Times(1)
+	suite.mockQuestionAnswerLogPeer.EXPECT().
+		Select(mock.Anything, leechdetect.QuestionFailures(5), mock.Anything, mock.Anything, mock.Anything).
+		Return([]*dbModels.QuestionAnswerLog{
+			{QuestionId: utils.IntPtr(5), SelectedOption: utils.StrPtr("B"), CreatedAt: lapseAt(3)},
+			{QuestionId: utils.IntPtr(5), SelectedOption: utils.StrPtr("D"), CreatedAt: lapseAt(1)},
+		}, nil).Times(1)
+
+	w := httptest.NewRecorder()
+	ctx, _ := gin.CreateTestContext(w)
+	ctx.Request = httptest.NewRequest(http.MethodGet, "/api/leeches", nil)
+	suite.controller.ListLeeches(ctx)
+
+	assert.Equal(suite.T(), http.StatusOK, w.Code)
+	var list models.LeechList
+	suite.Require().NoError(json.Unmarshal(w.Body.Bytes(), &list))
+	assert.Equal(suite.T(), leechdetect.DefaultLeechWordLapses, *list.Settings.WordLapses)
+	suite.Require().Len(list.Leeches, 2)
+	assert.Equal(suite.T(), "question", list.Leeches[0].Type)
+	assert.Equal(suite.T(), 2, list.Leeches[0].LapseCount)
+	assert.True(suite.T(), list.Leeches[0].IsSuspended)
+	assert.Equal(suite.T(), "B", *list.Leeches[0].Lapses[0].SelectedOption)
+	assert.Equal(suite.T(), "affect", list.Leeches[1].Text)
+	assert.Equal(suite.T(), "green", *list.Leeches[1].Lapses[0].PreviousFamiliarity)
+}
+
+// TestListLeechesByType tests that type=word leaves questions unqueried and
+// an empty list is an empty array
+func (suite *ControllerTestSuite) TestListLeechesByType() {
+	suite.mockSettingPeer.EXPECT().
+		Select(mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+		Return([]*dbModels.Setting{}, nil).Times(1)
+	suite.mockWordPeer.EXPECT().
+		Select(mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+		Return([]*dbModels.Word{}, nil).Times(1)
+
+	w := httptest.NewRecorder()
+	ctx, _ := gin.CreateTestContext(w)
+	ctx.Request = httptest.NewRequest(http.MethodGet, "/api/leeches?type=word", nil)
+	suite.controller.ListLeeches(ctx)
+
+	assert.Equal(suite.T(), http.StatusOK, w.Code)
+	assert.Contains(suite.T(), w.Body.String(), `"leeches":[]`)
+}
+
+// TestListLeechesInvalidType tests that an unknown type returns 400
+func (suite *ControllerTestSuite) TestListLeechesInvalidType() {
+	w := httptest.NewRecorder()
+	ctx, _ := gin.CreateTestContext(w)
+	ctx.Request = httptest.NewRequest(http.MethodGet, "/api/leeches?type=note", nil)
+	suite.controller.ListLeeches(ctx)
+
+	assert.Equal(suite.T(), http.StatusBadRequest, w.Code)
+}
+
+// TestListLeechesDatabaseError tests that a failed log query returns 500
+func (suite *ControllerTestSuite) TestListLeechesDatabaseError() {
+	suite.mockSettingPeer.EXPECT().
+		Select(mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+		Return([]*dbModels.Setting{}, nil).Times(1)
+	suite.mockWordPeer.EXPECT().
+		Select(mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+		Return([]*dbModels.Word{{Id: utils.IntPtr(1)}}, nil).Times(1)
+	suite.mockWordPracticeLogPeer.EXPECT().
+		Select(mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+		Return(nil, errors.New("db error")).Times(1)
+
+	w := httptest.NewRecorder()
+	ctx, _ := gin.CreateTestContext(w)
+	ctx.Request = httptest.NewRequest(http.MethodGet, "/api/leeches", nil)
+	suite.controller.ListLeeches(ctx)
+
+	assert.Equal(suite.T(), http.StatusInternalServerError, w.Code)
+}
@@ -0,0 +1,26 @@
+package leech
+
+import (
+	"net/http"
+	"word-flashcard/internal/controllers/common"
+	"word-flashcard/internal/models"
+
+	"github.com/gin-gonic/gin"
+)
+
+// GetLeechSettings @Summary Get the leech settings
+// @Description Get how many lapses make a word a leech, how many wrong answers make a question one, and whether new leeches are suspended from random quizzes
+// @Tags leeches
+// @Produce json
+// @Success 200 {object} models.LeechSettings "Current leech settings"
+// @Failure 500 {object} models.ErrorResponse "Internal server error - Failed to fetch data from database"
+// @Router /api/leeches/settings [get]
+func (lc *Controller) GetLeechSettings(c *gin.Context) {
+	config, err := lc.leechDetector.Config()
+	if err != nil {
+		common.ResponseError(http.StatusInternalServerError, "Failed to fetch data from database", models.ErrCodeInternalError, err, c)
+		return
+	}
+
+	common.ResponseSuccess(http.StatusOK, settingsResponse(config), c)
+}
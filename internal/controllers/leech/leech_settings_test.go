@@ -0,0 +1,46 @@
+package leech
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	dbModels "word-flashcard/data/models"
+	"word-flashcard/data/schema"
+	"word-flashcard/utils"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+// TestGetLeechSettings tests that stored settings override the defaults
+func (suite *ControllerTestSuite) TestGetLeechSettings() {
+	suite.mockSettingPeer.EXPECT().
+		Select(mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+		Return([]*dbModels.Setting{
+			{Name: utils.StrPtr(schema.SETTING_LEECH_WORD_LAPSES), Value: utils.StrPtr("5")},
+			{Name: utils.StrPtr(schema.SETTING_LEECH_AUTO_SUSPEND), Value: utils.StrPtr("true")},
+		}, nil).Times(1)
+
+	w := httptest.NewRecorder()
+	ctx, _ := gin.CreateTestContext(w)
+	ctx.Request = httptest.NewRequest(http.MethodGet, "/api/leeches/settings", nil)
+	suite.controller.GetLeechSettings(ctx)
+
+	assert.Equal(suite.T(), http.StatusOK, w.Code)
+	assert.JSONEq(suite.T(), `{"word_lapses":5,"question_failures":8,"auto_suspend":true}`, w.Body.String())
+}
+
+// TestGetLeechSettingsDatabaseError tests that a failed query returns 500
+func (suite *ControllerTestSuite) TestGetLeechSettingsDatabaseError() {
+	suite.mockSettingPeer.EXPECT().
+		Select(mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+		Return(nil, errors.New("db error")).Times(1)
+
+	w := httptest.NewRecorder()
+	ctx, _ := gin.CreateTestContext(w)
+	ctx.Request = httptest.NewRequest(http.MethodGet, "/api/leeches/settings", nil)
+	suite.controller.GetLeechSettings(ctx)
+
+	assert.Equal(suite.T(), http.StatusInternalServerError, w.Code)
+}
@@ -0,0 +1,78 @@
+package leech
+
+import (
+	"net/http"
+	"strconv"
+	dbModels "word-flashcard/data/models"
+	"word-flashcard/data/schema"
+	"word-flashcard/internal/controllers/common"
+	"word-flashcard/internal/leechdetect"
+	"word-flashcard/internal/models"
+
+	"github.com/gin-gonic/gin"
+)
+
+// UpdateLeechSettings @Summary Update the leech settings
+// @Description Set how many lapses make a word a leech, how many wrong answers make a question one (each 0-1000, 0 turns detection
+// @Description off), and whether new leeches are suspended from random quizzes. An omitted field keeps its current value. Items already
+// @Description tagged are left as they are.
+// @Tags leeches
+// @Accept json
+// @Produce json
+// @Param settings body models.LeechSettings true "Leech settings to set"
+// @Success 200 {object} models.LeechSettings "Leech settings updated successfully"
+// @Failure 400 {object} models.ErrorResponse "Bad request - Invalid request body"
+// @Failure 500 {object} models.ErrorResponse "Internal server error - Failed to update data in database"
+// @Router /api/leeches/settings [put]
+func (lc *Controller) UpdateLeechSettings(c *gin.Context) {
+	// ================ 1. Parse & validate request body ================
+	var settingsData models.LeechSettings
+	if err := common.ParseRequestBody(&settingsData, c); err != nil {
+		common.ResponseError(http.StatusBadRequest, "Invalid request body", models.ErrCodeInvalidRequest, err, c)
+		return
+	}
+	if settingsData.WordLapses != nil {
+		if err := leechdetect.ValidateLeechThreshold("word_lapses", *settingsData.WordLapses); err != nil {
+			common.ResponseError(http.StatusBadRequest, err.Error(), models.ErrCodeValidationError, err, c)
+			return
+		}
+	}
+	if settingsData.QuestionFailures != nil {
+		if err := leechdetect.ValidateLeechThreshold("question_failures", *settingsData.QuestionFailures); err != nil {
+			common.ResponseError(http.StatusBadRequest, err.Error(), models.ErrCodeValidationError, err, c)
+			return
+		}
+	}
+
+	// ================ 2. Save the changed settings ================
+	settings, err := lc.leechDetector.LoadSettings()
+	if err != nil {
+		common.ResponseError(http.StatusInternalServerError, "Failed to fetch data from database", models.ErrCodeInternalError, err, c)
+		return
+	}
+
+	changes := make(map[string]string)
+	if settingsData.WordLapses != nil {
+		changes[schema.SETTING_LEECH_WORD_LAPSES] = strconv.Itoa(*settingsData.WordLapses)
+	}
+	if settingsData.QuestionFailures != nil {
+		changes[schema.SETTING_LEECH_QUESTION_FAILS] = strconv.Itoa(*settingsData.QuestionFailures)
+	}
+	if settingsData.AutoSuspend != nil {
+		changes[schema.SETTING_LEECH_AUTO_SUSPEND] = strconv.FormatBool(*settingsData.AutoSuspend)
+	}
+	for _, name := range leechdetect.LeechSettingNames {
+		value, ok := changes[name]
+		if !ok {
+			continue
+		}
+		if err := lc.leechDetector.SaveSetting(settings, name, value); err != nil {
+			common.ResponseError(http.StatusInternalServerError, "Failed to update data in database", models.ErrCodeInternalError, err, c)
+			return
+		}
+		settings[name] = &dbModels.Setting{Name: &name, Value: &value}
+	}
+
+	// ================ 3. Send response ================
+	common.ResponseSuccess(http.StatusOK, settingsResponse(leechdetect.LeechConfigFromSettings(settings)), c)
+}
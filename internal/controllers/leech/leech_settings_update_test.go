@@ -0,0 +1,56 @@
+package leech
+
+import (
+	"bytes"
+	"net/http"
+	"net/http/httptest"
+	dbModels "word-flashcard/data/models"
+	"word-flashcard/data/schema"
+	"word-flashcard/utils"
+
+	"github.com/Masterminds/squirrel"
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+// updateLeechSettingsRequest calls UpdateLeechSettings with body
+func (suite *ControllerTestSuite) updateLeechSettingsRequest(body string) *httptest.ResponseRecorder {
+	w := httptest.NewRecorder()
+	ctx, _ := gin.CreateTestContext(w)
+	ctx.Request = httptest.NewRequest(http.MethodPut, "/api/leeches/settings", bytes.NewReader([]byte(body)))
+	suite.controller.UpdateLeechSettings(ctx)
+	return w
+}
+
+// TestUpdateLeechSettings tests that a stored setting is updated, a new one
+// inserted and an omitted one kept
+func (suite *ControllerTestSuite) TestUpdateLeechSettings() {
+	suite.mockSettingPeer.EXPECT().
+		Select(mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+		Return([]*dbModels.Setting{
+			{Name: utils.StrPtr(schema.SETTING_LEECH_WORD_LAPSES), Value: utils.StrPtr("5")},
+			{Name: utils.StrPtr(schema.SETTING_LEECH_QUESTION_FAILS), Value: utils.StrPtr("6")},
+		}, nil).Times(1)
+	suite.mockSettingPeer.EXPECT().
+		Update(&dbModels.Setting{Value: utils.StrPtr("0")}, squirrel.Eq{schema.SETTING_NAME: schema.SETTING_LEECH_WORD_LAPSES}).
+		Return(int64(1), nil).Times(1)
+	suite.mockSettingPeer.EXPECT().
+		Insert(&dbModels.Setting{Name: utils.StrPtr(schema.SETTING_LEECH_AUTO_SUSPEND), Value: utils.StrPtr("true")}).
+		Return(int64(1), nil).Times(1)
+
+	w := suite.updateLeechSettingsRequest(`{"word_lapses": 0, "auto_suspend": true}`)
+
+	assert.Equal(suite.T(), http.StatusOK, w.Code)
+	assert.JSONEq(suite.T(), `{"word_lapses":0,"question_failures":6,"auto_suspend":true}`, w.Body.String())
+}
+
+// TestUpdateLeechSettingsInvalid tests that out-of-range thresholds and
+// malformed bodies return 400
+func (suite *ControllerTestSuite) TestUpdateLeechSettingsInvalid() {
+	for _, body := range []string{`{"word_lapses": -1}`, `{"question_failures": 1001}`, `{"auto_suspend": "yes"}`} {
+		w := suite.updateLeechSettingsRequest(body)
+
+		assert.Equal(suite.T(), http.StatusBadRequest, w.Code, body)
+	}
+}
@@ -0,0 +1,46 @@
+package link
+
+import (
+	"word-flashcard/data/peers"
+	"word-flashcard/internal/links"
+)
+
+// Controller handles requests that link words, questions and notes together
+type Controller struct {
+	entityLinkPeer peers.EntityLinkPeerInterface
+	linkResolver   *links.LinkResolver
+}
+
+// New creates a new Controller instance
+func New(entityLinkPeer peers.EntityLinkPeerInterface, linkResolver *links.LinkResolver) *Controller {
+	return &Controller{
+		entityLinkPeer: entityLinkPeer,
+		linkResolver:   linkResolver,
+	}
+}
+
+// GetReelPeers returns the real database peers, including the word, question
+// and note peers a LinkResolver looks linked entities up with
+func GetReelPeers() (peers.EntityLinkPeerInterface, peers.WordPeerInterface, peers.QuestionPeerInterface, peers.NotePeerInterface, error) {
+	entityLinkPeer, err := peers.NewEntityLinkPeer()
+	if err != nil {
+		return nil, nil, nil, nil, err
+	}
+
+	wordPeer, err := peers.NewWordPeer()
+	if err != nil {
+		return nil, nil, nil, nil, err
+	}
+
+	questionPeer, err := peers.NewQuestionPeer()
+	if err != nil {
+		return nil, nil, nil, nil, err
+	}
+
+	notePeer, err := peers.NewNotePeer()
+	if err != nil {
+		return nil, nil, nil, nil, err
+	}
+
+	return entityLinkPeer, wordPeer, questionPeer, notePeer, nil
+}
@@ -0,0 +1,53 @@
+package link
+
+import (
+	"testing"
+	"time"
+	"word-flashcard/data/mocks"
+	dbModels "word-flashcard/data/models"
+	"word-flashcard/data/schema"
+	"word-flashcard/internal/links"
+	"word-flashcard/utils"
+
+	"github.com/stretchr/testify/suite"
+)
+
+// ControllerTestSuite is a test suite for the link Controller
+type ControllerTestSuite struct {
+	suite.Suite
+	controller         *Controller
+	mockEntityLinkPeer *mocks.MockEntityLinkPeer
+	mockWordPeer       *mocks.MockWordPeer
+	mockQuestionPeer   *mocks.MockQuestionPeer
+	mockNotePeer       *mocks.MockNotePeer
+}
+
+// TestControllerTestSuite runs the ControllerTestSuite
+func TestControllerTestSuite(t *testing.T) {
+	suite.Run(t, new(ControllerTestSuite))
+}
+
+// SetupTest sets up the test environment before each test
+func (suite *ControllerTestSuite) SetupTest() {
+	suite.mockEntityLinkPeer = mocks.NewMockEntityLinkPeer(suite.T())
+	suite.mockWordPeer = mocks.NewMockWordPeer(suite.T())
+	suite.mockQuestionPeer = mocks.NewMockQuestionPeer(suite.T())
+	suite.mockNotePeer = mocks.NewMockNotePeer(suite.T())
+	linkResolver := links.NewLinkResolver(suite.mockEntityLinkPeer, suite.mockWordPeer, suite.mockQuestionPeer, suite.mockNotePeer)
+	suite.controller = New(suite.mockEntityLinkPeer, linkResolver)
+}
+
+var testLinkModifyTime = time.Date(2024, 1, 15, 10, 0, 0, 0, time.UTC)
+
+// getSampleLink returns a link from word 1 to note 2 as stored in the database
+func getSampleLink() *dbModels.EntityLink {
+	return &dbModels.EntityLink{
+		Id:         utils.IntPtr(1),
+		SourceType: utils.StrPtr(schema.ENTITY_TYPE_WORD),
+		SourceId:   utils.IntPtr(1),
+		TargetType: utils.StrPtr(schema.ENTITY_TYPE_NOTE),
+		TargetId:   utils.IntPtr(2),
+		CreatedAt:  &testLinkModifyTime,
+		UpdatedAt:  &testLinkModifyTime,
+	}
+}
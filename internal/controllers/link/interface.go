@@ -0,0 +1,10 @@
+package link
+
+import "github.com/gin-gonic/gin"
+
+// ControllerInterface defines the interface for link controller
+type ControllerInterface interface {
+	ListLinks(c *gin.Context)
+	CreateLink(c *gin.Context)
+	DeleteLink(c *gin.Context)
+}
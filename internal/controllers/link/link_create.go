@@ -0,0 +1,82 @@
+package link
+
+import (
+	"net/http"
+	"word-flashcard/data/schema"
+	"word-flashcard/internal/controllers/common"
+	"word-flashcard/internal/links"
+	"word-flashcard/internal/models"
+
+	"github.com/Masterminds/squirrel"
+	"github.com/gin-gonic/gin"
+)
+
+// CreateLink @Summary Link two entities
+// @Description Attach a word, question or note to another one. Links are undirected, so the stored source and target may come back swapped.
+// @Tags links
+// @Accept json
+// @Produce json
+// @Param link body models.EntityLink true "The two entities to link"
+// @Success 200 {object} models.EntityLink "Link created successfully"
+// @Failure 400 {object} models.ErrorResponse "Bad request - Invalid request body"
+// @Failure 404 {object} models.ErrorResponse "Not found - Source or target entity not found"
+// @Failure 409 {object} models.ErrorResponse "Conflict - The entities are already linked"
+// @Failure 500 {object} models.ErrorResponse "Internal server error - Failed to insert data into database"
+// @Router /api/links [post]
+func (lc *Controller) CreateLink(c *gin.Context) {
+	// ================ 1. Parse request body ================
+	var linkData models.EntityLink
+	if err := common.ParseRequestBody(&linkData, c); err != nil {
+		common.ResponseError(http.StatusBadRequest, "Invalid request body", models.ErrCodeInvalidRequest, err, c)
+		return
+	}
+	if err := lc.validateLinkFields(&linkData); err != nil {
+		common.ResponseError(http.StatusBadRequest, err.Error(), models.ErrCodeValidationError, err, c)
+		return
+	}
+
+	// ================ 2. Check both entities exist ================
+	for _, end := range []struct {
+		entityType string
+		id         int
+	}{{*linkData.SourceType, *linkData.SourceID}, {*linkData.TargetType, *linkData.TargetID}} {
+		exists, err := lc.linkResolver.Exists(end.entityType, end.id)
+		if err != nil {
+			common.ResponseError(http.StatusInternalServerError, "Failed to fetch data from database", models.ErrCodeInternalError, err, c)
+			return
+		} else if !exists {
+			common.ResponseError(http.StatusNotFound, "Linked "+end.entityType+" not found", models.ErrCodeNotFound, nil, c)
+			return
+		}
+	}
+
+	// ================ 3. Insert data into database ================
+	sourceType, sourceID, targetType, targetID := links.CanonicalLink(*linkData.SourceType, *linkData.SourceID, *linkData.TargetType, *linkData.TargetID)
+	linkData.SourceType, linkData.SourceID = &sourceType, &sourceID
+	linkData.TargetType, linkData.TargetID = &targetType, &targetID
+	linkData.ID = nil
+
+	linkID, err := lc.entityLinkPeer.Insert(linkData.ToDataModel())
+	if err != nil {
+		common.RespondDatabaseWriteError(
+			"Failed to insert data into database",
+			"These entities are already linked",
+			err, c,
+		)
+		return
+	}
+
+	// ================ 4. Query inserted data ================
+	where := squirrel.Eq{schema.ENTITY_LINK_ID: linkID}
+	links, err := lc.entityLinkPeer.Select([]*string{}, where, nil, nil, nil)
+	if err != nil || len(links) == 0 {
+		common.ResponseError(http.StatusInternalServerError, "Inserted but failed to fetch data from database", models.ErrCodeInternalError, err, c)
+		return
+	}
+
+	// ================ 5. Transform data to API model ================
+	linkEntity := new(models.EntityLink).FromDataModel(links[0])
+
+	// ================ 6. Send response ================
+	common.ResponseSuccess(http.StatusOK, linkEntity, c)
+}
@@ -0,0 +1,146 @@
+package link
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+
+	dbModels "word-flashcard/data/models"
+	"word-flashcard/data/schema"
+	"word-flashcard/internal/models"
+	"word-flashcard/utils"
+
+	"github.com/Masterminds/squirrel"
+	"github.com/gin-gonic/gin"
+	"github.com/go-sql-driver/mysql"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+// expectEntitiesExist mocks the existence checks of word 1 and note 2
+func (suite *ControllerTestSuite) expectEntitiesExist() {
+	suite.mockWordPeer.EXPECT().
+		Select(mock.Anything, squirrel.Eq{schema.WORD_ID: []int{1}}, mock.Anything, mock.Anything, mock.Anything).
+		Return([]*dbModels.Word{{Id: utils.IntPtr(1), Word: utils.StrPtr("apple")}}, nil).Times(1)
+	suite.mockNotePeer.EXPECT().
+		Select(mock.Anything, squirrel.Eq{schema.NOTE_ID: []int{2}}, mock.Anything, mock.Anything, mock.Anything).
+		Return([]*dbModels.Note{{Id: utils.IntPtr(2), Title: utils.StrPtr("Fruit idioms")}}, nil).Times(1)
+}
+
+// TestCreateLink tests the CreateLink handler, including that a link given
+// note-first is stored word-first
+func (suite *ControllerTestSuite) TestCreateLink() {
+	suite.expectEntitiesExist()
+	suite.mockEntityLinkPeer.EXPECT().
+		Insert(mock.MatchedBy(func(link *dbModels.EntityLink) bool {
+			return *link.SourceType == schema.ENTITY_TYPE_WORD && *link.SourceId == 1 &&
+				*link.TargetType == schema.ENTITY_TYPE_NOTE && *link.TargetId == 2
+		})).
+		Return(int64(1), nil).Times(1)
+	suite.mockEntityLinkPeer.EXPECT().
+		Select(mock.Anything, squirrel.Eq{schema.ENTITY_LINK_ID: int64(1)}, mock.Anything, mock.Anything, mock.Anything).
+		Return([]*dbModels.EntityLink{getSampleLink()}, nil).Times(1)
+
+	w := httptest.NewRecorder()
+	ctx, _ := gin.CreateTestContext(w)
+	requestBody := `{"source_type":"note","source_id":2,"target_type":"word","target_id":1}`
+	ctx.Request = httptest.NewRequest(http.MethodPost, "/api/links", io.NopCloser(bytes.NewReader([]byte(requestBody))))
+	suite.controller.CreateLink(ctx)
+
+	assert.Equal(suite.T(), http.StatusOK, w.Code)
+	expectedJSON, err := json.Marshal(new(models.EntityLink).FromDataModel(getSampleLink()))
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), string(expectedJSON), w.Body.String())
+}
+
+// TestCreateLinkInvalidBody tests that a malformed JSON body returns 400
+func (suite *ControllerTestSuite) TestCreateLinkInvalidBody() {
+	w := httptest.NewRecorder()
+	ctx, _ := gin.CreateTestContext(w)
+	requestBody := `{"source_type":`
+	ctx.Request = httptest.NewRequest(http.MethodPost, "/api/links", io.NopCloser(bytes.NewReader([]byte(requestBody))))
+	suite.controller.CreateLink(ctx)
+
+	assert.Equal(suite.T(), http.StatusBadRequest, w.Code)
+}
+
+// TestCreateLinkValidationError tests that an unknown entity type returns 400
+func (suite *ControllerTestSuite) TestCreateLinkValidationError() {
+	w := httptest.NewRecorder()
+	ctx, _ := gin.CreateTestContext(w)
+	requestBody := `{"source_type":"deck","source_id":2,"target_type":"word","target_id":1}`
+	ctx.Request = httptest.NewRequest(http.MethodPost, "/api/links", io.NopCloser(bytes.NewReader([]byte(requestBody))))
+	suite.controller.CreateLink(ctx)
+
+	assert.Equal(suite.T(), http.StatusBadRequest, w.Code)
+}
+
+// TestCreateLinkEntityNotFound tests that linking a missing entity returns 404
+func (suite *ControllerTestSuite) TestCreateLinkEntityNotFound() {
+	suite.mockWordPeer.EXPECT().
+		Select(mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+		Return([]*dbModels.Word{}, nil).Times(1)
+
+	w := httptest.NewRecorder()
+	ctx, _ := gin.CreateTestContext(w)
+	requestBody := `{"source_type":"word","source_id":1,"target_type":"note","target_id":2}`
+	ctx.Request = httptest.NewRequest(http.MethodPost, "/api/links", io.NopCloser(bytes.NewReader([]byte(requestBody))))
+	suite.controller.CreateLink(ctx)
+
+	assert.Equal(suite.T(), http.StatusNotFound, w.Code)
+	assert.Contains(suite.T(), w.Body.String(), "Linked word not found")
+}
+
+// TestCreateLinkExistsError tests that a database failure while checking the
+// entities returns 500
+func (suite *ControllerTestSuite) TestCreateLinkExistsError() {
+	suite.mockWordPeer.EXPECT().
+		Select(mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+		Return(nil, fmt.Errorf("select failed")).Times(1)
+
+	w := httptest.NewRecorder()
+	ctx, _ := gin.CreateTestContext(w)
+	requestBody := `{"source_type":"word","source_id":1,"target_type":"note","target_id":2}`
+	ctx.Request = httptest.NewRequest(http.MethodPost, "/api/links", io.NopCloser(bytes.NewReader([]byte(requestBody))))
+	suite.controller.CreateLink(ctx)
+
+	assert.Equal(suite.T(), http.StatusInternalServerError, w.Code)
+}
+
+// TestCreateLinkDuplicate tests that linking an already linked pair returns 409
+func (suite *ControllerTestSuite) TestCreateLinkDuplicate() {
+	suite.expectEntitiesExist()
+	suite.mockEntityLinkPeer.EXPECT().
+		Insert(mock.Anything).
+		Return(int64(0), &mysql.MySQLError{Number: 1062, Message: "Duplicate entry"}).Times(1)
+
+	w := httptest.NewRecorder()
+	ctx, _ := gin.CreateTestContext(w)
+	requestBody := `{"source_type":"word","source_id":1,"target_type":"note","target_id":2}`
+	ctx.Request = httptest.NewRequest(http.MethodPost, "/api/links", io.NopCloser(bytes.NewReader([]byte(requestBody))))
+	suite.controller.CreateLink(ctx)
+
+	assert.Equal(suite.T(), http.StatusConflict, w.Code)
+}
+
+// TestCreateLinkSelectError tests that a failure fetching the inserted link returns 500
+func (suite *ControllerTestSuite) TestCreateLinkSelectError() {
+	suite.expectEntitiesExist()
+	suite.mockEntityLinkPeer.EXPECT().
+		Insert(mock.Anything).
+		Return(int64(1), nil).Times(1)
+	suite.mockEntityLinkPeer.EXPECT().
+		Select(mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+		Return(nil, fmt.Errorf("select failed")).Times(1)
+
+	w := httptest.NewRecorder()
+	ctx, _ := gin.CreateTestContext(w)
+	requestBody := `{"source_type":"word","source_id":1,"target_type":"note","target_id":2}`
+	ctx.Request = httptest.NewRequest(http.MethodPost, "/api/links", io.NopCloser(bytes.NewReader([]byte(requestBody))))
+	suite.controller.CreateLink(ctx)
+
+	assert.Equal(suite.T(), http.StatusInternalServerError, w.Code)
+}
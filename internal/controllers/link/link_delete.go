@@ -0,0 +1,43 @@
+package link
+
+import (
+	"net/http"
+	"word-flashcard/data/schema"
+	"word-flashcard/internal/controllers/common"
+	"word-flashcard/internal/models"
+
+	"github.com/Masterminds/squirrel"
+	"github.com/gin-gonic/gin"
+)
+
+// DeleteLink @Summary Unlink two entities
+// @Description Detach two linked entities. The entities themselves are kept.
+// @Tags links
+// @Param id path int true "Link ID"
+// @Success 204 "Link deleted successfully"
+// @Failure 400 {object} models.ErrorResponse "Bad request - Invalid link ID"
+// @Failure 404 {object} models.ErrorResponse "Not found - Link not found"
+// @Failure 500 {object} models.ErrorResponse "Internal server error - Failed to delete data from database"
+// @Router /api/links/{id} [delete]
+func (lc *Controller) DeleteLink(c *gin.Context) {
+	// ================ 1. Parse request parameter ================
+	linkID, err := common.ParseIDFromPath(c, "id")
+	if err != nil {
+		common.ResponseError(http.StatusBadRequest, "Invalid link ID.", models.ErrCodeInvalidRequest, err, c)
+		return
+	}
+
+	// ================ 2. Delete data from database ================
+	where := squirrel.Eq{schema.ENTITY_LINK_ID: linkID}
+	effected, err := lc.entityLinkPeer.Delete(where)
+	if err != nil {
+		common.ResponseError(http.StatusInternalServerError, "Failed to delete data from database", models.ErrCodeInternalError, err, c)
+		return
+	} else if effected == 0 {
+		common.ResponseError(http.StatusNotFound, "Link not found", models.ErrCodeNotFound, nil, c)
+		return
+	}
+
+	// ================ 3. Send response ================
+	common.ResponseSuccess(http.StatusNoContent, nil, c)
+}
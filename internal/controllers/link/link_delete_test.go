@@ -0,0 +1,70 @@
+package link
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+
+	"word-flashcard/data/schema"
+
+	"github.com/Masterminds/squirrel"
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+)
+
+// TestDeleteLink tests the DeleteLink handler
+func (suite *ControllerTestSuite) TestDeleteLink() {
+	suite.mockEntityLinkPeer.EXPECT().
+		Delete(squirrel.Eq{schema.ENTITY_LINK_ID: 1}).
+		Return(int64(1), nil).Times(1)
+
+	w := httptest.NewRecorder()
+	ctx, _ := gin.CreateTestContext(w)
+	ctx.Request = httptest.NewRequest(http.MethodDelete, "/api/links/1", nil)
+	ctx.Params = gin.Params{gin.Param{Key: "id", Value: "1"}}
+	suite.controller.DeleteLink(ctx)
+
+	assert.Equal(suite.T(), http.StatusNoContent, w.Code)
+	assert.Equal(suite.T(), "", w.Body.String())
+}
+
+// TestDeleteLinkInvalidID tests that an invalid link ID returns 400
+func (suite *ControllerTestSuite) TestDeleteLinkInvalidID() {
+	w := httptest.NewRecorder()
+	ctx, _ := gin.CreateTestContext(w)
+	ctx.Request = httptest.NewRequest(http.MethodDelete, "/api/links/abc", nil)
+	ctx.Params = gin.Params{gin.Param{Key: "id", Value: "abc"}}
+	suite.controller.DeleteLink(ctx)
+
+	assert.Equal(suite.T(), http.StatusBadRequest, w.Code)
+}
+
+// TestDeleteLinkPeerError tests that a database failure while deleting returns 500
+func (suite *ControllerTestSuite) TestDeleteLinkPeerError() {
+	suite.mockEntityLinkPeer.EXPECT().
+		Delete(squirrel.Eq{schema.ENTITY_LINK_ID: 1}).
+		Return(int64(0), fmt.Errorf("delete failed")).Times(1)
+
+	w := httptest.NewRecorder()
+	ctx, _ := gin.CreateTestContext(w)
+	ctx.Request = httptest.NewRequest(http.MethodDelete, "/api/links/1", nil)
+	ctx.Params = gin.Params{gin.Param{Key: "id", Value: "1"}}
+	suite.controller.DeleteLink(ctx)
+
+	assert.Equal(suite.T(), http.StatusInternalServerError, w.Code)
+}
+
+// TestDeleteLinkNotFound tests that deleting a non-existent link returns 404
+func (suite *ControllerTestSuite) TestDeleteLinkNotFound() {
+	suite.mockEntityLinkPeer.EXPECT().
+		Delete(squirrel.Eq{schema.ENTITY_LINK_ID: 999}).
+		Return(int64(0), nil).Times(1)
+
+	w := httptest.NewRecorder()
+	ctx, _ := gin.CreateTestContext(w)
+	ctx.Request = httptest.NewRequest(http.MethodDelete, "/api/links/999", nil)
+	ctx.Params = gin.Params{gin.Param{Key: "id", Value: "999"}}
+	suite.controller.DeleteLink(ctx)
+
+	assert.Equal(suite.T(), http.StatusNotFound, w.Code)
+}
@@ -0,0 +1,49 @@
+package link
+
+import (
+	"net/http"
+	"slices"
+	"word-flashcard/internal/controllers/common"
+	"word-flashcard/internal/links"
+	"word-flashcard/internal/models"
+
+	"github.com/gin-gonic/gin"
+)
+
+// ListLinks @Summary List the links of an entity
+// @Description Get the words, questions and notes linked to one entity, in the order they were linked
+// @Tags links
+// @Produce json
+// @Param type query string true "Entity type: word, question or note"
+// @Param id query int true "Entity ID"
+// @Success 200 {array} models.LinkedEntity "Linked entities retrieved successfully"
+// @Failure 400 {object} models.ErrorResponse "Bad request - Invalid query parameters"
+// @Failure 500 {object} models.ErrorResponse "Internal server error - Failed to fetch data from database"
+// @Router /api/links [get]
+func (lc *Controller) ListLinks(c *gin.Context) {
+	// ================ 1. Parse query parameters ================
+	entityType := c.Query("type")
+	if !slices.Contains(links.EntityTypes, entityType) {
+		common.ResponseError(http.StatusBadRequest, "Invalid type parameter", models.ErrCodeInvalidRequest, nil, c)
+		return
+	}
+	entityID, err := common.ParseIntQueryParam(c, "id", 0)
+	if err != nil || entityID <= 0 {
+		common.ResponseError(http.StatusBadRequest, "Invalid id parameter", models.ErrCodeInvalidRequest, err, c)
+		return
+	}
+
+	// ================ 2. Fetch data from database ================
+	linked, err := lc.linkResolver.Linked(entityType, []int{entityID})
+	if err != nil {
+		common.ResponseError(http.StatusInternalServerError, "Failed to fetch data from database", models.ErrCodeInternalError, err, c)
+		return
+	}
+
+	// ================ 3. Send response ================
+	linkedEntities := linked[entityID]
+	if linkedEntities == nil {
+		linkedEntities = []models.LinkedEntity{}
+	}
+	common.ResponseSuccess(http.StatusOK, linkedEntities, c)
+}
@@ -0,0 +1,73 @@
+package link
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+
+	dbModels "word-flashcard/data/models"
+	"word-flashcard/utils"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+// TestListLinks tests the ListLinks handler
+func (suite *ControllerTestSuite) TestListLinks() {
+	suite.mockEntityLinkPeer.EXPECT().
+		Select(mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+		Return([]*dbModels.EntityLink{getSampleLink()}, nil).Times(1)
+	suite.mockNotePeer.EXPECT().
+		Select(mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+		Return([]*dbModels.Note{{Id: utils.IntPtr(2), Title: utils.StrPtr("Fruit idioms")}}, nil).Times(1)
+
+	w := httptest.NewRecorder()
+	ctx, _ := gin.CreateTestContext(w)
+	ctx.Request = httptest.NewRequest(http.MethodGet, "/api/links?type=word&id=1", nil)
+	suite.controller.ListLinks(ctx)
+
+	assert.Equal(suite.T(), http.StatusOK, w.Code)
+	assert.JSONEq(suite.T(), `[{"link_id":1,"type":"note","id":2,"label":"Fruit idioms"}]`, w.Body.String())
+}
+
+// TestListLinksNone tests that an entity without links returns an empty array
+func (suite *ControllerTestSuite) TestListLinksNone() {
+	suite.mockEntityLinkPeer.EXPECT().
+		Select(mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+		Return([]*dbModels.EntityLink{}, nil).Times(1)
+
+	w := httptest.NewRecorder()
+	ctx, _ := gin.CreateTestContext(w)
+	ctx.Request = httptest.NewRequest(http.MethodGet, "/api/links?type=question&id=3", nil)
+	suite.controller.ListLinks(ctx)
+
+	assert.Equal(suite.T(), http.StatusOK, w.Code)
+	assert.Equal(suite.T(), "[]", w.Body.String())
+}
+
+// TestListLinksInvalidParams tests that a bad type or id returns 400
+func (suite *ControllerTestSuite) TestListLinksInvalidParams() {
+	for _, query := range []string{"type=deck&id=1", "id=1", "type=word", "type=word&id=0", "type=word&id=abc"} {
+		w := httptest.NewRecorder()
+		ctx, _ := gin.CreateTestContext(w)
+		ctx.Request = httptest.NewRequest(http.MethodGet, "/api/links?"+query, nil)
+		suite.controller.ListLinks(ctx)
+
+		assert.Equal(suite.T(), http.StatusBadRequest, w.Code, query)
+	}
+}
+
+// TestListLinksSelectError tests that a database failure while fetching returns 500
+func (suite *ControllerTestSuite) TestListLinksSelectError() {
+	suite.mockEntityLinkPeer.EXPECT().
+		Select(mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+		Return(nil, fmt.Errorf("select failed")).Times(1)
+
+	w := httptest.NewRecorder()
+	ctx, _ := gin.CreateTestContext(w)
+	ctx.Request = httptest.NewRequest(http.MethodGet, "/api/links?type=word&id=1", nil)
+	suite.controller.ListLinks(ctx)
+
+	assert.Equal(suite.T(), http.StatusInternalServerError, w.Code)
+}
@@ -0,0 +1,34 @@
+package link
+
+import (
+	"slices"
+	"word-flashcard/internal/controllers/common"
+	"word-flashcard/internal/links"
+	"word-flashcard/internal/models"
+)
+
+// validateLinkFields validates both ends of the requested link
+func (lc *Controller) validateLinkFields(link *models.EntityLink) error {
+	ends := []struct {
+		entityType  *string
+		id          *int
+		typeName    string
+		idFieldName string
+	}{
+		{link.SourceType, link.SourceID, "source_type", "source_id"},
+		{link.TargetType, link.TargetID, "target_type", "target_id"},
+	}
+	for _, end := range ends {
+		if end.entityType == nil || !slices.Contains(links.EntityTypes, *end.entityType) {
+			return common.NewFieldError(end.typeName+" is invalid", "reason", "must be one of word, question, note")
+		}
+		if end.id == nil || *end.id <= 0 {
+			return common.NewFieldError(end.idFieldName+" is invalid", "reason", "must be a positive ID")
+		}
+	}
+
+	if *link.SourceType == *link.TargetType && *link.SourceID == *link.TargetID {
+		return common.NewFieldError("target_id is invalid", "reason", "an entity can't be linked to itself")
+	}
+	return nil
+}
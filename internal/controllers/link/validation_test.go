@@ -0,0 +1,45 @@
+package link
+
+import (
+	"word-flashcard/data/schema"
+	"word-flashcard/internal/models"
+	"word-flashcard/utils"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// TestValidateLinkFields tests the validateLinkFields method
+func (suite *ControllerTestSuite) TestValidateLinkFields() {
+	link := func(sourceType string, sourceID int, targetType string, targetID int) *models.EntityLink {
+		return &models.EntityLink{
+			SourceType: utils.StrPtr(sourceType),
+			SourceID:   utils.IntPtr(sourceID),
+			TargetType: utils.StrPtr(targetType),
+			TargetID:   utils.IntPtr(targetID),
+		}
+	}
+
+	testCases := []struct {
+		name    string
+		link    *models.EntityLink
+		wantErr string
+	}{
+		{"valid", link(schema.ENTITY_TYPE_WORD, 1, schema.ENTITY_TYPE_NOTE, 1), ""},
+		{"same type, different entities", link(schema.ENTITY_TYPE_WORD, 1, schema.ENTITY_TYPE_WORD, 2), ""},
+		{"missing source_type", &models.EntityLink{SourceID: utils.IntPtr(1), TargetType: utils.StrPtr(schema.ENTITY_TYPE_NOTE), TargetID: utils.IntPtr(1)}, "source_type is invalid"},
+		{"unknown target_type", link(schema.ENTITY_TYPE_WORD, 1, "deck", 1), "target_type is invalid"},
+		{"non-positive source_id", link(schema.ENTITY_TYPE_WORD, 0, schema.ENTITY_TYPE_NOTE, 1), "source_id is invalid"},
+		{"self link", link(schema.ENTITY_TYPE_QUESTION, 3, schema.ENTITY_TYPE_QUESTION, 3), "target_id is invalid"},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			err := suite.controller.validateLinkFields(tc.link)
+			if tc.wantErr == "" {
+				assert.NoError(suite.T(), err)
+			} else {
+				assert.EqualError(suite.T(), err, tc.wantErr)
+			}
+		})
+	}
+}
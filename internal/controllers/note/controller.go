@@ -3,6 +3,7 @@ package note
 import (
 	"word-flashcard/data/peers"
 	"word-flashcard/data/schema"
+	"word-flashcard/internal/links"
 )
 
 // noteSortableColumns defines the columns allowed in sort query parameters for the notes table.
@@ -16,15 +17,31 @@ var noteSortableColumns = []string{
 
 // Controller handles note-related requests
 type Controller struct {
-	notePeer peers.NotePeerInterface
+	notePeer     peers.NotePeerInterface
+	reminderPeer peers.ReminderPeerInterface
+	linkResolver *links.LinkResolver
 }
 
 // New creates a new Controller instance
-func New(notePeer peers.NotePeerInterface) *Controller {
-	return &Controller{notePeer: notePeer}
+func New(notePeer peers.NotePeerInterface, reminderPeer peers.ReminderPeerInterface, linkResolver *links.LinkResolver) *Controller {
+	return &Controller{
+		notePeer:     notePeer,
+		reminderPeer: reminderPeer,
+		linkResolver: linkResolver,
+	}
 }
 
-// GetReelPeer returns the real database peer for notes
-func GetReelPeer() (peers.NotePeerInterface, error) {
-	return peers.NewNotePeer()
+// GetReelPeers returns the real database peers
+func GetReelPeers() (peers.NotePeerInterface, peers.ReminderPeerInterface, error) {
+	notePeer, err := peers.NewNotePeer()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	reminderPeer, err := peers.NewReminderPeer()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return notePeer, reminderPeer, nil
 }
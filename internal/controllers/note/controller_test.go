@@ -5,6 +5,7 @@ import (
 	"time"
 	"word-flashcard/data/mocks"
 	dbModels "word-flashcard/data/models"
+	"word-flashcard/internal/links"
 	"word-flashcard/internal/models"
 	"word-flashcard/utils"
 
@@ -14,8 +15,12 @@ import (
 // ControllerTestSuite is a test suite for the note Controller
 type ControllerTestSuite struct {
 	suite.Suite
-	controller   *Controller
-	mockNotePeer *mocks.MockNotePeer
+	controller         *Controller
+	mockNotePeer       *mocks.MockNotePeer
+	mockReminderPeer   *mocks.MockReminderPeer
+	mockEntityLinkPeer *mocks.MockEntityLinkPeer
+	mockWordPeer       *mocks.MockWordPeer
+	mockQuestionPeer   *mocks.MockQuestionPeer
 }
 
 // TestControllerTestSuite runs the ControllerTestSuite
@@ -26,7 +31,12 @@ func TestControllerTestSuite(t *testing.T) {
 // SetupTest sets up the test environment before each test
 func (suite *ControllerTestSuite) SetupTest() {
 	suite.mockNotePeer = mocks.NewMockNotePeer(suite.T())
-	suite.controller = New(suite.mockNotePeer)
+	suite.mockReminderPeer = mocks.NewMockReminderPeer(suite.T())
+	suite.mockEntityLinkPeer = mocks.NewMockEntityLinkPeer(suite.T())
+	suite.mockWordPeer = mocks.NewMockWordPeer(suite.T())
+	suite.mockQuestionPeer = mocks.NewMockQuestionPeer(suite.T())
+	linkResolver := links.NewLinkResolver(suite.mockEntityLinkPeer, suite.mockWordPeer, suite.mockQuestionPeer, suite.mockNotePeer)
+	suite.controller = New(suite.mockNotePeer, suite.mockReminderPeer, linkResolver)
 }
 
 var testNoteModifyTime = time.Date(2024, 1, 15, 10, 0, 0, 0, time.UTC)
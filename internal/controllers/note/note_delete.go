@@ -11,7 +11,7 @@ import (
 )
 
 // DeleteNote @Summary Delete a note
-// @Description Delete a specific note
+// @Description Delete a specific note. Its reminders are deleted too and recorded in the reminder history.
 // @Tags notes
 // @Param id path int true "Note ID"
 // @Success 204 "Note deleted successfully"
@@ -28,6 +28,19 @@ func (nc *Controller) DeleteNote(c *gin.Context) {
 	}
 
 	// ================ 2. Delete data from database ================
+	// Links and reminders reference the note, so they go first. Reminders are
+	// recorded in the reminder history as deleted rather than left for the
+	// reminder scheduler to prune.
+	if err := nc.linkResolver.RemoveLinks(schema.ENTITY_TYPE_NOTE, noteID); err != nil {
+		common.ResponseError(http.StatusInternalServerError, "Failed to delete associated data from database", models.ErrCodeInternalError, err, c)
+		return
+	}
+	reminderWhere := squirrel.Eq{schema.REMINDER_ENTITY_TYPE: schema.ENTITY_TYPE_NOTE, schema.REMINDER_ENTITY_ID: noteID}
+	if _, err := nc.reminderPeer.DeleteWithHistory(reminderWhere); err != nil {
+		common.ResponseError(http.StatusInternalServerError, "Failed to delete associated data from database", models.ErrCodeInternalError, err, c)
+		return
+	}
+
 	where := squirrel.Eq{schema.NOTE_ID: noteID}
 	effected, err := nc.notePeer.Delete(where)
 	if err != nil {
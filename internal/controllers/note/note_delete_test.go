@@ -6,10 +6,12 @@ import (
 	"net/http/httptest"
 
 	"word-flashcard/data/schema"
+	"word-flashcard/internal/links"
 
 	"github.com/Masterminds/squirrel"
 	"github.com/gin-gonic/gin"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
 )
 
 // TestDeleteNote tests the DeleteNote handler
@@ -17,6 +19,12 @@ func (suite *ControllerTestSuite) TestDeleteNote() {
 	testID := 1
 	where := squirrel.Eq{schema.NOTE_ID: testID}
 
+	suite.mockEntityLinkPeer.EXPECT().
+		Delete(links.LinksOf(schema.ENTITY_TYPE_NOTE, []int{testID})).
+		Return(int64(0), nil).Times(1)
+	suite.mockReminderPeer.EXPECT().
+		DeleteWithHistory(squirrel.Eq{schema.REMINDER_ENTITY_TYPE: schema.ENTITY_TYPE_NOTE, schema.REMINDER_ENTITY_ID: testID}).
+		Return(int64(1), nil).Times(1)
 	suite.mockNotePeer.EXPECT().
 		Delete(where).
 		Return(int64(testID), nil).Times(1)
@@ -47,6 +55,12 @@ func (suite *ControllerTestSuite) TestDeleteNotePeerError() {
 	testID := 1
 	where := squirrel.Eq{schema.NOTE_ID: testID}
 
+	suite.mockEntityLinkPeer.EXPECT().
+		Delete(links.LinksOf(schema.ENTITY_TYPE_NOTE, []int{testID})).
+		Return(int64(0), nil).Times(1)
+	suite.mockReminderPeer.EXPECT().
+		DeleteWithHistory(squirrel.Eq{schema.REMINDER_ENTITY_TYPE: schema.ENTITY_TYPE_NOTE, schema.REMINDER_ENTITY_ID: testID}).
+		Return(int64(0), nil).Times(1)
 	suite.mockNotePeer.EXPECT().
 		Delete(where).
 		Return(int64(0), fmt.Errorf("delete failed")).Times(1)
@@ -65,6 +79,12 @@ func (suite *ControllerTestSuite) TestDeleteNoteNotFound() {
 	testID := 999
 	where := squirrel.Eq{schema.NOTE_ID: testID}
 
+	suite.mockEntityLinkPeer.EXPECT().
+		Delete(links.LinksOf(schema.ENTITY_TYPE_NOTE, []int{testID})).
+		Return(int64(0), nil).Times(1)
+	suite.mockReminderPeer.EXPECT().
+		DeleteWithHistory(squirrel.Eq{schema.REMINDER_ENTITY_TYPE: schema.ENTITY_TYPE_NOTE, schema.REMINDER_ENTITY_ID: testID}).
+		Return(int64(0), nil).Times(1)
 	suite.mockNotePeer.EXPECT().
 		Delete(where).
 		Return(int64(0), nil).Times(1)
@@ -77,3 +97,38 @@ func (suite *ControllerTestSuite) TestDeleteNoteNotFound() {
 
 	assert.Equal(suite.T(), http.StatusNotFound, w.Code)
 }
+
+// TestDeleteNoteLinksError tests that a failure removing the note's links returns 500 before the note is touched
+func (suite *ControllerTestSuite) TestDeleteNoteLinksError() {
+	suite.mockEntityLinkPeer.EXPECT().
+		Delete(mock.Anything).
+		Return(int64(0), fmt.Errorf("delete failed")).Times(1)
+
+	w := httptest.NewRecorder()
+	ctx, _ := gin.CreateTestContext(w)
+	ctx.Request = httptest.NewRequest(http.MethodDelete, "/api/notes/1", nil)
+	ctx.Params = gin.Params{gin.Param{Key: "id", Value: "1"}}
+	suite.controller.DeleteNote(ctx)
+
+	assert.Equal(suite.T(), http.StatusInternalServerError, w.Code)
+	suite.mockNotePeer.AssertNotCalled(suite.T(), "Delete", mock.Anything)
+}
+
+// TestDeleteNoteRemindersError tests that a failure deleting the note's reminders returns 500 before the note is touched
+func (suite *ControllerTestSuite) TestDeleteNoteRemindersError() {
+	suite.mockEntityLinkPeer.EXPECT().
+		Delete(mock.Anything).
+		Return(int64(0), nil).Times(1)
+	suite.mockReminderPeer.EXPECT().
+		DeleteWithHistory(mock.Anything).
+		Return(int64(0), fmt.Errorf("delete failed")).Times(1)
+
+	w := httptest.NewRecorder()
+	ctx, _ := gin.CreateTestContext(w)
+	ctx.Request = httptest.NewRequest(http.MethodDelete, "/api/notes/1", nil)
+	ctx.Params = gin.Params{gin.Param{Key: "id", Value: "1"}}
+	suite.controller.DeleteNote(ctx)
+
+	assert.Equal(suite.T(), http.StatusInternalServerError, w.Code)
+	suite.mockNotePeer.AssertNotCalled(suite.T(), "Delete", mock.Anything)
+}
@@ -16,6 +16,7 @@ import (
 // @Tags notes
 // @Produce json
 // @Param id path int true "Note ID"
+// @Param include query string false "Set to links to embed the note's linked words and questions"
 // @Success 200 {object} models.Note "Note retrieved successfully"
 // @Failure 400 {object} models.ErrorResponse "Bad request - Invalid note ID"
 // @Failure 404 {object} models.ErrorResponse "Not found - Note not found"
@@ -46,6 +47,12 @@ func (nc *Controller) GetNote(c *gin.Context) {
 
 	// ================ 3. Transform data to API model ================
 	noteEntity := new(models.Note).FromDataModel(notes[0])
+	if common.HasInclude(c, "links") {
+		if err := nc.embedLinks([]*models.Note{noteEntity}); err != nil {
+			common.ResponseError(http.StatusInternalServerError, "Failed to fetch data from database", models.ErrCodeInternalError, err, c)
+			return
+		}
+	}
 
 	// ================ 4. Send response ================
 	common.ResponseSuccess(http.StatusOK, noteEntity, c)
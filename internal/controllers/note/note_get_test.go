@@ -7,6 +7,9 @@ import (
 	"net/http/httptest"
 
 	dbModels "word-flashcard/data/models"
+	"word-flashcard/data/schema"
+	"word-flashcard/internal/models"
+	"word-flashcard/utils"
 
 	"github.com/gin-gonic/gin"
 	"github.com/stretchr/testify/assert"
@@ -86,3 +89,56 @@ func (suite *ControllerTestSuite) TestGetNoteMultipleRecords() {
 
 	assert.Equal(suite.T(), http.StatusInternalServerError, w.Code)
 }
+
+// TestGetNoteIncludeLinks tests that include=links embeds the words and
+// questions linked to the note
+func (suite *ControllerTestSuite) TestGetNoteIncludeLinks() {
+	suite.mockNotePeer.EXPECT().
+		Select(mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+		Return([]*dbModels.Note{getSampleNotes()[1]}, nil).Times(1)
+	suite.mockEntityLinkPeer.EXPECT().
+		Select(mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+		Return([]*dbModels.EntityLink{
+			{Id: utils.IntPtr(3), SourceType: utils.StrPtr(schema.ENTITY_TYPE_WORD), SourceId: utils.IntPtr(8), TargetType: utils.StrPtr(schema.ENTITY_TYPE_NOTE), TargetId: utils.IntPtr(2)},
+			{Id: utils.IntPtr(5), SourceType: utils.StrPtr(schema.ENTITY_TYPE_QUESTION), SourceId: utils.IntPtr(4), TargetType: utils.StrPtr(schema.ENTITY_TYPE_NOTE), TargetId: utils.IntPtr(2)},
+		}, nil).Times(1)
+	suite.mockWordPeer.EXPECT().
+		Select(mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+		Return([]*dbModels.Word{{Id: utils.IntPtr(8), Word: utils.StrPtr("agree")}}, nil).Times(1)
+	suite.mockQuestionPeer.EXPECT().
+		Select(mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+		Return([]*dbModels.Question{{Id: utils.IntPtr(4), Question: utils.StrPtr("She ___ with me.")}}, nil).Times(1)
+
+	w := httptest.NewRecorder()
+	ctx, _ := gin.CreateTestContext(w)
+	ctx.Request = httptest.NewRequest(http.MethodGet, "/api/notes/2?include=links", nil)
+	ctx.Params = gin.Params{gin.Param{Key: "id", Value: "2"}}
+	suite.controller.GetNote(ctx)
+
+	assert.Equal(suite.T(), http.StatusOK, w.Code)
+	var note models.Note
+	assert.NoError(suite.T(), json.Unmarshal(w.Body.Bytes(), &note))
+	assert.Equal(suite.T(), []models.LinkedEntity{
+		{LinkID: 3, Type: schema.ENTITY_TYPE_WORD, ID: 8, Label: "agree"},
+		{LinkID: 5, Type: schema.ENTITY_TYPE_QUESTION, ID: 4, Label: "She ___ with me."},
+	}, note.Links)
+}
+
+// TestGetNoteIncludeLinksError tests that a failure while fetching links
+// returns 500
+func (suite *ControllerTestSuite) TestGetNoteIncludeLinksError() {
+	suite.mockNotePeer.EXPECT().
+		Select(mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+		Return([]*dbModels.Note{getSampleNotes()[1]}, nil).Times(1)
+	suite.mockEntityLinkPeer.EXPECT().
+		Select(mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+		Return(nil, fmt.Errorf("select failed")).Times(1)
+
+	w := httptest.NewRecorder()
+	ctx, _ := gin.CreateTestContext(w)
+	ctx.Request = httptest.NewRequest(http.MethodGet, "/api/notes/2?include=links", nil)
+	ctx.Params = gin.Params{gin.Param{Key: "id", Value: "2"}}
+	suite.controller.GetNote(ctx)
+
+	assert.Equal(suite.T(), http.StatusInternalServerError, w.Code)
+}
@@ -17,6 +17,7 @@ import (
 // @Param limit query int false "Maximum number of records to return (default: 100, max: 1000)"
 // @Param offset query int false "Number of records to skip (default: 0)"
 // @Param sort query string false "Sort columns/expressions, comma-separated. Allowed: id,title,sort_order,created_at,updated_at"
+// @Param include query string false "Set to links to embed each note's linked words and questions"
 // @Success 200 {array} models.Note "List of notes retrieved successfully"
 // @Failure 400 {object} models.ErrorResponse "Bad request - Invalid query parameters"
 // @Failure 500 {object} models.ErrorResponse "Internal server error - Failed to fetch data from database"
@@ -60,6 +61,12 @@ func (nc *Controller) ListNotes(c *gin.Context) {
 
 	// ================ 4. Transform data to API model ================
 	noteEntities := nc.convertToNoteEntities(notes)
+	if common.HasInclude(c, "links") {
+		if err := nc.embedLinks(noteEntities); err != nil {
+			common.ResponseError(http.StatusInternalServerError, "Failed to fetch data from database", models.ErrCodeInternalError, err, c)
+			return
+		}
+	}
 
 	// ================ 5. Send response ================
 	common.ResponseSuccess(http.StatusOK, noteEntities, c)
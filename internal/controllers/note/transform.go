@@ -2,6 +2,7 @@ package note
 
 import (
 	dbModels "word-flashcard/data/models"
+	"word-flashcard/data/schema"
 	"word-flashcard/internal/models"
 )
 
@@ -14,3 +15,19 @@ func (nc *Controller) convertToNoteEntities(notes []*dbModels.Note) []*models.No
 	}
 	return noteEntities
 }
+
+// embedLinks attaches the entities linked to each note
+func (nc *Controller) embedLinks(noteEntities []*models.Note) error {
+	var noteIDs []int
+	for _, noteEntity := range noteEntities {
+		noteIDs = append(noteIDs, *noteEntity.ID)
+	}
+	linked, err := nc.linkResolver.Linked(schema.ENTITY_TYPE_NOTE, noteIDs)
+	if err != nil {
+		return err
+	}
+	for _, noteEntity := range noteEntities {
+		noteEntity.Links = linked[*noteEntity.ID]
+	}
+	return nil
+}
@@ -0,0 +1,81 @@
+package progress
+
+import (
+	"word-flashcard/data/peers"
+	"word-flashcard/internal/reporttz"
+)
+
+// Controller handles study streak, daily goal and review forecast requests
+type Controller struct {
+	wordPeer              peers.WordPeerInterface
+	questionPeer          peers.QuestionPeerInterface
+	wordPracticeLogPeer   peers.WordPracticeLogPeerInterface
+	questionAnswerLogPeer peers.QuestionAnswerLogPeerInterface
+	settingPeer           peers.SettingPeerInterface
+	reminderPeer          peers.ReminderPeerInterface
+	reportTimezone        *reporttz.ReportTimezoneSetting
+}
+
+// New creates a new Controller instance
+func New(
+	wordPeer peers.WordPeerInterface,
+	questionPeer peers.QuestionPeerInterface,
+	wordPracticeLogPeer peers.WordPracticeLogPeerInterface,
+	questionAnswerLogPeer peers.QuestionAnswerLogPeerInterface,
+	settingPeer peers.SettingPeerInterface,
+	reminderPeer peers.ReminderPeerInterface,
+	reportTimezone *reporttz.ReportTimezoneSetting,
+) *Controller {
+	return &Controller{
+		wordPeer:              wordPeer,
+		questionPeer:          questionPeer,
+		wordPracticeLogPeer:   wordPracticeLogPeer,
+		questionAnswerLogPeer: questionAnswerLogPeer,
+		settingPeer:           settingPeer,
+		reminderPeer:          reminderPeer,
+		reportTimezone:        reportTimezone,
+	}
+}
+
+// GetReelPeers returns the real database peers
+func GetReelPeers() (
+	peers.WordPeerInterface,
+	peers.QuestionPeerInterface,
+	peers.WordPracticeLogPeerInterface,
+	peers.QuestionAnswerLogPeerInterface,
+	peers.SettingPeerInterface,
+	peers.ReminderPeerInterface,
+	error,
+) {
+	wordPeer, err := peers.NewWordPeer()
+	if err != nil {
+		return nil, nil, nil, nil, nil, nil, err
+	}
+
+	questionPeer, err := peers.NewQuestionPeer()
+	if err != nil {
+		return nil, nil, nil, nil, nil, nil, err
+	}
+
+	wordPracticeLogPeer, err := peers.NewWordPracticeLogPeer()
+	if err != nil {
+		return nil, nil, nil, nil, nil, nil, err
+	}
+
+	questionAnswerLogPeer, err := peers.NewQuestionAnswerLogPeer()
+	if err != nil {
+		return nil, nil, nil, nil, nil, nil, err
+	}
+
+	settingPeer, err := peers.NewSettingPeer()
+	if err != nil {
+		return nil, nil, nil, nil, nil, nil, err
+	}
+
+	reminderPeer, err := peers.NewReminderPeer()
+	if err != nil {
+		return nil, nil, nil, nil, nil, nil, err
+	}
+
+	return wordPeer, questionPeer, wordPracticeLogPeer, questionAnswerLogPeer, settingPeer, reminderPeer, nil
+}
@@ -0,0 +1,43 @@
+package progress
+
+import (
+	"testing"
+	"word-flashcard/data/mocks"
+	dbModels "word-flashcard/data/models"
+	"word-flashcard/internal/reporttz"
+
+	"github.com/stretchr/testify/suite"
+)
+
+// ControllerTestSuite is a test suite for the progress Controller
+type ControllerTestSuite struct {
+	suite.Suite
+	controller                *Controller
+	mockWordPeer              *mocks.MockWordPeer
+	mockQuestionPeer          *mocks.MockQuestionPeer
+	mockWordPracticeLogPeer   *mocks.MockWordPracticeLogPeer
+	mockQuestionAnswerLogPeer *mocks.MockQuestionAnswerLogPeer
+	mockSettingPeer           *mocks.MockSettingPeer
+	mockReminderPeer          *mocks.MockReminderPeer
+}
+
+// TestControllerTestSuite runs the ControllerTestSuite
+func TestControllerTestSuite(t *testing.T) {
+	suite.Run(t, new(ControllerTestSuite))
+}
+
+// SetupTest sets up the test environment before each test
+func (suite *ControllerTestSuite) SetupTest() {
+	suite.mockWordPeer = mocks.NewMockWordPeer(suite.T())
+	suite.mockQuestionPeer = mocks.NewMockQuestionPeer(suite.T())
+	suite.mockWordPracticeLogPeer = mocks.NewMockWordPracticeLogPeer(suite.T())
+	suite.mockQuestionAnswerLogPeer = mocks.NewMockQuestionAnswerLogPeer(suite.T())
+	suite.mockSettingPeer = mocks.NewMockSettingPeer(suite.T())
+	suite.mockReminderPeer = mocks.NewMockReminderPeer(suite.T())
+	suite.controller = New(suite.mockWordPeer, suite.mockQuestionPeer, suite.mockWordPracticeLogPeer, suite.mockQuestionAnswerLogPeer, suite.mockSettingPeer, suite.mockReminderPeer, reporttz.NewReportTimezoneSetting(suite.mockSettingPeer))
+}
+
+// sampleSetting returns a stored Setting db model for testing
+func sampleSetting(id int, name string, value string) *dbModels.Setting {
+	return &dbModels.Setting{Id: &id, Name: &name, Value: &value}
+}
@@ -0,0 +1,292 @@
+package progress
+
+import (
+	"math"
+	"time"
+
+	dbModels "word-flashcard/data/models"
+	"word-flashcard/data/schema"
+	"word-flashcard/internal/controllers/common"
+	"word-flashcard/internal/models"
+)
+
+const (
+	// defaultForecastDays is how many days the forecast covers when days isn't given
+	defaultForecastDays = 30
+
+	// maxForecastDays caps the days query parameter of the forecast endpoint
+	maxForecastDays = 365
+
+	// answerTimeWindowDays is how far back logs are read to measure answer times
+	answerTimeWindowDays = 30
+
+	// maxAnswerGap is the longest pause between two answers still counted as
+	// time spent answering; anything longer is a break, not an answer
+	maxAnswerGap = 5 * time.Minute
+
+	// defaultWordAnswerSeconds and defaultQuestionAnswerSeconds are used when
+	// the logs hold no answer pairs to measure
+	defaultWordAnswerSeconds     = 10.0
+	defaultQuestionAnswerSeconds = 30.0
+)
+
+// wordReviewIntervalDays is how many days after its last practice a word
+// comes due again, by familiarity. Unknown familiarity is treated as red.
+var wordReviewIntervalDays = map[string]int{
+	schema.WORD_FAMILIARITY_RED:    1,
+	schema.WORD_FAMILIARITY_YELLOW: 3,
+	schema.WORD_FAMILIARITY_GREEN:  7,
+}
+
+// columnPtrs returns pointers to the given column names, as peer Select expects
+func columnPtrs(columns ...string) []*string {
+	ptrs := make([]*string, len(columns))
+	for i := range columns {
+		ptrs[i] = &columns[i]
+	}
+	return ptrs
+}
+
+// forecastWordColumns returns the words columns needed to schedule reviews
+func forecastWordColumns() []*string {
+	return columnPtrs(schema.WORD_ID, schema.WORD_FAMILIARITY, schema.WORD_LAST_PRACTISED_AT)
+}
+
+// forecastQuestionColumns returns the questions columns needed to schedule reviews
+func forecastQuestionColumns() []*string {
+	return columnPtrs(schema.QUESTION_COUNT_PRACTISE, schema.QUESTION_COUNT_FAILURE_PRACTISE, schema.QUESTION_LAST_ANSWERED_AT)
+}
+
+// wordReviewInterval returns the review interval in days for a familiarity
+func wordReviewInterval(familiarity *string) int {
+	if familiarity != nil {
+		if days, ok := wordReviewIntervalDays[*familiarity]; ok {
+			return days
+		}
+	}
+	return wordReviewIntervalDays[schema.WORD_FAMILIARITY_RED]
+}
+
+// questionReviewInterval returns the review interval in days for a question
+// from its answer accuracy: under 50% correct comes back the next day, under
+// 80% after 3 days, otherwise after a week.
+func questionReviewInterval(question *dbModels.Question) int {
+	if question.CountPractise == nil || *question.CountPractise <= 0 {
+		return 1
+	}
+	failures := 0
+	if question.CountFailurePractise != nil {
+		failures = *question.CountFailurePractise
+	}
+	accuracy := float64(*question.CountPractise-failures) / float64(*question.CountPractise)
+	switch {
+	case accuracy < 0.5:
+		return 1
+	case accuracy < 0.8:
+		return 3
+	default:
+		return 7
+	}
+}
+
+// forecastReminderColumns returns the reminders columns needed to schedule reviews
+func forecastReminderColumns() []*string {
+	return columnPtrs(schema.REMINDER_ENTITY_ID, schema.REMINDER_DUE_AT)
+}
+
+// reminderDueByWord maps the word IDs of word reminders to their due times
+func reminderDueByWord(reminders []*dbModels.Reminder) map[int]time.Time {
+	due := make(map[int]time.Time, len(reminders))
+	for _, reminder := range reminders {
+		if reminder.EntityId != nil && reminder.DueAt != nil {
+			due[*reminder.EntityId] = *reminder.DueAt
+		}
+	}
+	return due
+}
+
+// forecastDayIndex returns how many calendar days t falls after start,
+// which must be midnight in the forecast's location. Rounding keeps days
+// shortened or lengthened by DST changes on the right index.
+func forecastDayIndex(start time.Time, t time.Time) int {
+	local := t.In(start.Location())
+	midnight := time.Date(local.Year(), local.Month(), local.Day(), 0, 0, 0, 0, start.Location())
+	return int(math.Round(midnight.Sub(start).Hours() / 24))
+}
+
+// scheduleReviews counts an item into every day of counts it comes due on,
+// starting at day index first and recurring every interval days, on the
+// assumption that each review keeps the item's current scheduling. An item
+// already overdue starts today. Returns whether it was overdue.
+func scheduleReviews(counts []int, first int, interval int) bool {
+	overdue := first < 0
+	if overdue {
+		first = 0
+	}
+	for day := first; day < len(counts); day += interval {
+		counts[day]++
+	}
+	return overdue
+}
+
+// forecastWordReviews counts practiced words and words with a reminder into
+// the days they come due. A word with a reminder is due on the reminder's
+// due day, or earlier when its practice schedule says so; a word that was
+// never practiced and has no reminder isn't a review yet.
+func forecastWordReviews(words []*dbModels.Word, reminderDue map[int]time.Time, start time.Time, counts []int) int {
+	overdue := 0
+	for _, word := range words {
+		interval := wordReviewInterval(word.Familiarity)
+		due, hasReminder := time.Time{}, false
+		if word.Id != nil {
+			due, hasReminder = reminderDue[*word.Id]
+		}
+		var first int
+		switch {
+		case word.LastPracticedAt != nil:
+			first = forecastDayIndex(start, word.LastPracticedAt.In(start.Location()).AddDate(0, 0, interval))
+			if hasReminder {
+				first = min(first, forecastDayIndex(start, due))
+			}
+		case hasReminder:
+			first = forecastDayIndex(start, due)
+		default:
+			continue
+		}
+		if scheduleReviews(counts, first, interval) {
+			overdue++
+		}
+	}
+	return overdue
+}
+
+// forecastQuestionReviews counts answered questions into the days they come due
+func forecastQuestionReviews(questions []*dbModels.Question, start time.Time, counts []int) int {
+	overdue := 0
+	for _, question := range questions {
+		if question.LastAnsweredAt == nil {
+			continue
+		}
+		interval := questionReviewInterval(question)
+		first := forecastDayIndex(start, question.LastAnsweredAt.In(start.Location()).AddDate(0, 0, interval))
+		if scheduleReviews(counts, first, interval) {
+			overdue++
+		}
+	}
+	return overdue
+}
+
+// loggedAnswer is when an answer was logged and, for logs recorded since
+// answers are timed, how long it took
+type loggedAnswer struct {
+	at         time.Time
+	responseMs *int
+}
+
+// answerDurations sums the time spent on each answer: its recorded
+// response time when it has one, otherwise, for older logs, the pause since
+// the previous answer when that is short enough to be time spent answering.
+// answers must be in ascending order of time.
+func answerDurations(answers []loggedAnswer) (time.Duration, int) {
+	var total time.Duration
+	count := 0
+	for i, answer := range answers {
+		if answer.responseMs != nil && *answer.responseMs > 0 {
+			total += time.Duration(*answer.responseMs) * time.Millisecond
+			count++
+			continue
+		}
+		if i == 0 {
+			continue
+		}
+		gap := answer.at.Sub(answers[i-1].at)
+		if gap > 0 && gap <= maxAnswerGap {
+			total += gap
+			count++
+		}
+	}
+	return total, count
+}
+
+// averageSeconds returns total/count in seconds, or fallback when count is 0
+func averageSeconds(total time.Duration, count int, fallback float64) float64 {
+	if count == 0 {
+		return fallback
+	}
+	return common.Round1(total.Seconds() / float64(count))
+}
+
+// wordAnswerSeconds measures the average time per word answer from the
+// logs' response times, falling back for older logs to the gaps between
+// consecutive logs of the same quiz session; logs must be ordered by quiz
+// session, then time
+func wordAnswerSeconds(logs []*dbModels.WordPracticeLog) float64 {
+	var total time.Duration
+	count := 0
+	var session *string
+	var answers []loggedAnswer
+	flush := func() {
+		durations, n := answerDurations(answers)
+		total += durations
+		count += n
+		answers = answers[:0]
+	}
+	for _, log := range logs {
+		if log.CreatedAt == nil || (log.QuizSessionID == nil && log.ResponseMs == nil) {
+			continue
+		}
+		// Logs outside a quiz session are only counted by their response
+		// time, never by the gap to a neighbour
+		if log.QuizSessionID == nil || session == nil || *log.QuizSessionID != *session {
+			flush()
+			session = log.QuizSessionID
+		}
+		answers = append(answers, loggedAnswer{at: *log.CreatedAt, responseMs: log.ResponseMs})
+		if session == nil {
+			flush()
+		}
+	}
+	flush()
+	return averageSeconds(total, count, defaultWordAnswerSeconds)
+}
+
+// questionAnswerSeconds measures the average time per question answer from
+// the logs' response times, falling back for older logs to the gaps between
+// consecutive answer logs; logs must be ordered by time. Answer logs aren't
+// tied to a quiz session, so for those older logs maxAnswerGap alone
+// separates sittings.
+func questionAnswerSeconds(logs []*dbModels.QuestionAnswerLog) float64 {
+	answers := make([]loggedAnswer, 0, len(logs))
+	for _, log := range logs {
+		if log.CreatedAt != nil {
+			answers = append(answers, loggedAnswer{at: *log.CreatedAt, responseMs: log.ResponseMs})
+		}
+	}
+	total, count := answerDurations(answers)
+	return averageSeconds(total, count, defaultQuestionAnswerSeconds)
+}
+
+// buildForecast turns the per-day due counts into the forecast response
+func buildForecast(start time.Time, wordCounts []int, questionCounts []int, wordSeconds float64, questionSeconds float64) models.ReviewForecast {
+	forecast := models.ReviewForecast{
+		Days:                     len(wordCounts),
+		WordSecondsPerAnswer:     wordSeconds,
+		QuestionSecondsPerAnswer: questionSeconds,
+		Points:                   make([]models.ForecastDay, len(wordCounts)),
+	}
+	totalSeconds := 0.0
+	for i := range wordCounts {
+		seconds := float64(wordCounts[i])*wordSeconds + float64(questionCounts[i])*questionSeconds
+		forecast.Points[i] = models.ForecastDay{
+			Date:             start.AddDate(0, 0, i).Format("2006-01-02"),
+			WordCount:        wordCounts[i],
+			QuestionCount:    questionCounts[i],
+			EstimatedMinutes: common.Round1(seconds / 60),
+		}
+		forecast.TotalWords += wordCounts[i]
+		forecast.TotalQuestions += questionCounts[i]
+		totalSeconds += seconds
+	}
+	forecast.TotalMinutes = common.Round1(totalSeconds / 60)
+	return forecast
+}
@@ -0,0 +1,171 @@
+package progress
+
+import (
+	"testing"
+	"time"
+
+	dbModels "word-flashcard/data/models"
+	"word-flashcard/utils"
+
+	"github.com/stretchr/testify/suite"
+)
+
+// forecastTestSuite tests the pure review forecast computation
+type forecastTestSuite struct {
+	suite.Suite
+	start time.Time
+}
+
+// TestForecastSuite runs the forecastTestSuite
+func TestForecastSuite(t *testing.T) {
+	suite.Run(t, new(forecastTestSuite))
+}
+
+// SetupTest pins the forecast to start at midnight on 2026-03-31 UTC
+func (s *forecastTestSuite) SetupTest() {
+	s.start = time.Date(2026, 3, 31, 0, 0, 0, 0, time.UTC)
+}
+
+// practicedWord builds a word practiced daysAgo days before the forecast start
+func (s *forecastTestSuite) practicedWord(familiarity string, daysAgo int) *dbModels.Word {
+	lastPracticed := s.start.AddDate(0, 0, -daysAgo).Add(10 * time.Hour)
+	return &dbModels.Word{Familiarity: &familiarity, LastPracticedAt: &lastPracticed}
+}
+
+// TestQuestionReviewInterval tests the accuracy tiers of question intervals
+func (s *forecastTestSuite) TestQuestionReviewInterval() {
+	s.Equal(1, questionReviewInterval(&dbModels.Question{}))
+	s.Equal(1, questionReviewInterval(&dbModels.Question{CountPractise: utils.IntPtr(4), CountFailurePractise: utils.IntPtr(3)}))
+	s.Equal(3, questionReviewInterval(&dbModels.Question{CountPractise: utils.IntPtr(4), CountFailurePractise: utils.IntPtr(1)}))
+	s.Equal(7, questionReviewInterval(&dbModels.Question{CountPractise: utils.IntPtr(5), CountFailurePractise: utils.IntPtr(1)}))
+	s.Equal(7, questionReviewInterval(&dbModels.Question{CountPractise: utils.IntPtr(5)}))
+}
+
+// TestForecastWordReviews tests that words recur at their familiarity's
+// interval, overdue words start today, a reminder brings a word forward to
+// its due day, and unpracticed words without a reminder are skipped
+func (s *forecastTestSuite) TestForecastWordReviews() {
+	counts := make([]int, 8)
+	reminded := s.practicedWord("green", 2) // due in 5 days, reminder in 1
+	reminded.Id = utils.IntPtr(3)
+	words := []*dbModels.Word{
+		s.practicedWord("green", 2),   // due in 5 days
+		s.practicedWord("yellow", 10), // overdue, then every 3 days
+		reminded,
+		{Id: utils.IntPtr(4), Familiarity: utils.StrPtr("green")}, // reminder overdue
+		{Id: utils.IntPtr(5), Reminder: utils.StrPtr("exam"), Familiarity: utils.StrPtr("red")},
+	}
+	reminderDue := map[int]time.Time{
+		3: s.start.AddDate(0, 0, 1).Add(9 * time.Hour),
+		4: s.start.Add(-time.Hour),
+	}
+
+	overdue := forecastWordReviews(words, reminderDue, s.start, counts)
+
+	s.Equal(2, overdue)
+	s.Equal([]int{2, 1, 0, 1, 0, 1, 1, 1}, counts)
+}
+
+// TestReminderDueByWord tests that reminders map to their words' due times
+func (s *forecastTestSuite) TestReminderDueByWord() {
+	due := s.start.Add(time.Hour)
+	reminders := []*dbModels.Reminder{
+		{EntityId: utils.IntPtr(1), DueAt: &due},
+		{EntityId: utils.IntPtr(2)},
+	}
+
+	s.Equal(map[int]time.Time{1: due}, reminderDueByWord(reminders))
+}
+
+// TestForecastQuestionReviews tests that unanswered questions are skipped
+// and answered ones come due after their interval
+func (s *forecastTestSuite) TestForecastQuestionReviews() {
+	counts := make([]int, 4)
+	lastAnswered := s.start.Add(-time.Hour)
+	questions := []*dbModels.Question{
+		{CountPractise: utils.IntPtr(2), CountFailurePractise: utils.IntPtr(2), LastAnsweredAt: &lastAnswered},
+		{CountPractise: utils.IntPtr(2)},
+	}
+
+	overdue := forecastQuestionReviews(questions, s.start, counts)
+
+	s.Equal(0, overdue)
+	s.Equal([]int{1, 1, 1, 1}, counts)
+}
+
+// TestForecastDayIndexAcrossDST tests that a DST change doesn't shift days
+func (s *forecastTestSuite) TestForecastDayIndexAcrossDST() {
+	loc, err := time.LoadLocation("America/New_York")
+	s.Require().NoError(err)
+	start := time.Date(2026, 3, 7, 0, 0, 0, 0, loc)
+
+	s.Equal(2, forecastDayIndex(start, time.Date(2026, 3, 9, 0, 30, 0, 0, loc)))
+	s.Equal(-1, forecastDayIndex(start, time.Date(2026, 3, 6, 23, 0, 0, 0, loc)))
+}
+
+// TestWordAnswerSeconds tests that gaps are only measured within a session
+// and pauses longer than maxAnswerGap are ignored
+func (s *forecastTestSuite) TestWordAnswerSeconds() {
+	at := func(seconds int) *time.Time {
+		t := s.start.Add(time.Duration(seconds) * time.Second)
+		return &t
+	}
+	logs := []*dbModels.WordPracticeLog{
+		{QuizSessionID: utils.StrPtr("a"), CreatedAt: at(0)},
+		{QuizSessionID: utils.StrPtr("a"), CreatedAt: at(6)},
+		{QuizSessionID: utils.StrPtr("a"), CreatedAt: at(1000)},
+		{QuizSessionID: utils.StrPtr("b"), CreatedAt: at(1010)},
+		{QuizSessionID: utils.StrPtr("b"), CreatedAt: at(1020)},
+	}
+
+	s.Equal(8.0, wordAnswerSeconds(logs))
+	s.Equal(defaultWordAnswerSeconds, wordAnswerSeconds(nil))
+}
+
+// TestWordAnswerSecondsResponseMs tests that a recorded response time is
+// used instead of the gap to the previous log, including for logs outside
+// a quiz session
+func (s *forecastTestSuite) TestWordAnswerSecondsResponseMs() {
+	at := func(seconds int) *time.Time {
+		t := s.start.Add(time.Duration(seconds) * time.Second)
+		return &t
+	}
+	logs := []*dbModels.WordPracticeLog{
+		{ResponseMs: utils.IntPtr(2000), CreatedAt: at(0)},
+		{ResponseMs: utils.IntPtr(4000), CreatedAt: at(1)},
+		{QuizSessionID: utils.StrPtr("a"), CreatedAt: at(10)},
+		{QuizSessionID: utils.StrPtr("a"), CreatedAt: at(20)},
+		{QuizSessionID: utils.StrPtr("a"), ResponseMs: utils.IntPtr(6000), CreatedAt: at(100)},
+		{CreatedAt: at(110)},
+	}
+
+	// 2s and 4s outside a session, the 10s gap of the untimed log and 6s
+	s.Equal(5.5, wordAnswerSeconds(logs))
+}
+
+// TestQuestionAnswerSeconds tests the fallback and the measured average
+func (s *forecastTestSuite) TestQuestionAnswerSeconds() {
+	first, second := s.start, s.start.Add(45*time.Second)
+	logs := []*dbModels.QuestionAnswerLog{{CreatedAt: &first}, {CreatedAt: &second}}
+
+	s.Equal(45.0, questionAnswerSeconds(logs))
+	s.Equal(defaultQuestionAnswerSeconds, questionAnswerSeconds(logs[:1]))
+
+	third := second.Add(time.Minute)
+	logs = append(logs, &dbModels.QuestionAnswerLog{ResponseMs: utils.IntPtr(15000), CreatedAt: &third})
+	s.Equal(30.0, questionAnswerSeconds(logs))
+}
+
+// TestBuildForecast tests per-day minutes, dates and totals
+func (s *forecastTestSuite) TestBuildForecast() {
+	forecast := buildForecast(s.start, []int{6, 0}, []int{1, 2}, 10, 30)
+
+	s.Equal(2, forecast.Days)
+	s.Equal("2026-03-31", forecast.Points[0].Date)
+	s.Equal("2026-04-01", forecast.Points[1].Date)
+	s.Equal(1.5, forecast.Points[0].EstimatedMinutes)
+	s.Equal(1.0, forecast.Points[1].EstimatedMinutes)
+	s.Equal(6, forecast.TotalWords)
+	s.Equal(3, forecast.TotalQuestions)
+	s.Equal(2.5, forecast.TotalMinutes)
+}
@@ -0,0 +1,110 @@
+package progress
+
+import (
+	"fmt"
+	"log/slog"
+	"strconv"
+
+	dbModels "word-flashcard/data/models"
+	"word-flashcard/data/schema"
+	"word-flashcard/internal/controllers/common"
+
+	"github.com/Masterminds/squirrel"
+)
+
+const (
+	// DefaultDailyGoalWords and DefaultDailyGoalQuestions apply until the
+	// learner sets a goal of their own
+	DefaultDailyGoalWords     = 20
+	DefaultDailyGoalQuestions = 10
+
+	// MaxDailyGoal caps each part of the daily goal
+	MaxDailyGoal = 10000
+)
+
+// dailyGoal is the resolved daily goal: word reviews and question answers per day
+type dailyGoal struct {
+	words     int
+	questions int
+}
+
+// validateDailyGoal checks both targets are within 0..MaxDailyGoal and that
+// at least one of them asks for something, since a day can't be "met" by
+// doing nothing.
+func validateDailyGoal(words, questions int) error {
+	if words < 0 || words > MaxDailyGoal {
+		return common.NewFieldError(fmt.Sprintf("words must be between 0 and %d", MaxDailyGoal), "words", words)
+	}
+	if questions < 0 || questions > MaxDailyGoal {
+		return common.NewFieldError(fmt.Sprintf("questions must be between 0 and %d", MaxDailyGoal), "questions", questions)
+	}
+	if words == 0 && questions == 0 {
+		return common.NewFieldError("words and questions cannot both be 0")
+	}
+	return nil
+}
+
+// loadGoalSettings returns the stored daily goal settings keyed by name;
+// a name missing from the map has never been set.
+func (pc *Controller) loadGoalSettings() (map[string]*dbModels.Setting, error) {
+	where := squirrel.Eq{schema.SETTING_NAME: []string{schema.SETTING_DAILY_GOAL_WORDS, schema.SETTING_DAILY_GOAL_QUESTIONS}}
+	settings, err := pc.settingPeer.Select([]*string{}, where, nil, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	byName := make(map[string]*dbModels.Setting, len(settings))
+	for _, setting := range settings {
+		if setting.Name != nil {
+			byName[*setting.Name] = setting
+		}
+	}
+	return byName, nil
+}
+
+// goalFromSettings resolves the daily goal from its stored settings, using
+// the default for each unset target. A stored goal that no longer passes
+// validation (e.g. edited by hand) falls back to the defaults as a whole
+// rather than failing every progress request.
+func goalFromSettings(settings map[string]*dbModels.Setting) dailyGoal {
+	goal := dailyGoal{
+		words:     settingInt(settings, schema.SETTING_DAILY_GOAL_WORDS, DefaultDailyGoalWords),
+		questions: settingInt(settings, schema.SETTING_DAILY_GOAL_QUESTIONS, DefaultDailyGoalQuestions),
+	}
+	if err := validateDailyGoal(goal.words, goal.questions); err != nil {
+		slog.Warn("Invalid stored daily goal, falling back to the default", "words", goal.words, "questions", goal.questions, "error", err)
+		return dailyGoal{words: DefaultDailyGoalWords, questions: DefaultDailyGoalQuestions}
+	}
+	return goal
+}
+
+// settingInt parses the named setting as an int, or returns defaultValue
+// when it is unset or not a number
+func settingInt(settings map[string]*dbModels.Setting, name string, defaultValue int) int {
+	setting, ok := settings[name]
+	if !ok || setting.Value == nil {
+		return defaultValue
+	}
+
+	value, err := strconv.Atoi(*setting.Value)
+	if err != nil {
+		slog.Warn("Invalid stored setting, falling back to the default", "name", name, "value", *setting.Value, "default", defaultValue)
+		return defaultValue
+	}
+	return value
+}
+
+// saveSettingInt writes value to the named setting, updating the existing
+// row when there is one. The existing rows come from loadGoalSettings rather
+// than from Update's affected row count, which MySQL reports as 0 when the
+// value didn't change.
+func (pc *Controller) saveSettingInt(existing map[string]*dbModels.Setting, name string, value int) error {
+	text := strconv.Itoa(value)
+	if _, ok := existing[name]; ok {
+		_, err := pc.settingPeer.Update(&dbModels.Setting{Value: &text}, squirrel.Eq{schema.SETTING_NAME: name})
+		return err
+	}
+
+	_, err := pc.settingPeer.Insert(&dbModels.Setting{Name: &name, Value: &text})
+	return err
+}
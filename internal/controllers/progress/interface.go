@@ -0,0 +1,13 @@
+package progress
+
+import "github.com/gin-gonic/gin"
+
+// ControllerInterface defines the interface for progress controller
+type ControllerInterface interface {
+	GetStreak(c *gin.Context)
+	GetDailyGoal(c *gin.Context)
+	UpdateDailyGoal(c *gin.Context)
+	GetForecast(c *gin.Context)
+	GetReportTimezone(c *gin.Context)
+	UpdateReportTimezone(c *gin.Context)
+}
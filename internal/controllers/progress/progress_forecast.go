@@ -0,0 +1,128 @@
+package progress
+
+import (
+	"fmt"
+	"net/http"
+	"time"
+	"word-flashcard/data/schema"
+	"word-flashcard/internal/controllers/common"
+	"word-flashcard/internal/models"
+
+	"github.com/Masterminds/squirrel"
+	"github.com/gin-gonic/gin"
+)
+
+// GetForecast @Summary Get the review workload forecast
+// @Description Forecasts, for each day from today on, how many words and questions come due and the estimated minutes needed to review
+// @Description them. A practiced word comes due 1, 3 or 7 days after its last practice when red, yellow or green, and a word with a
+// @Description reminder is due by the reminder's due day; an answered question comes due 1, 3 or 7 days after its last answer when
+// @Description under 50%, under 80% or at least 80% of its answers were correct. Each item recurs at the same interval within the
+// @Description forecast, and overdue items count toward today. Minutes use the average time per answer over the last 30 days of logs,
+// @Description taken from each answer's recorded response time or, for logs from before answers were timed, the pause since the
+// @Description previous answer, falling back to 10 seconds per word and 30 per question.
+// @Tags progress
+// @Produce json
+// @Param days query int false "Number of days to forecast, today included (default: 30, max: 365)"
+// @Param tz query string false "IANA timezone to bucket days in (default: X-Timezone header, else the stored report timezone, else REPORT_TIMEZONE)"
+// @Param X-Timezone header string false "IANA timezone to bucket days in when tz is not given"
+// @Success 200 {object} models.ReviewForecast "Review workload forecast"
+// @Failure 400 {object} models.ErrorResponse "Bad request - Invalid days or timezone parameter"
+// @Failure 500 {object} models.ErrorResponse "Internal server error - Failed to fetch data from database"
+// @Router /api/progress/forecast [get]
+func (pc *Controller) GetForecast(c *gin.Context) {
+	// ================ 1. Parse query parameters ================
+	days, err := common.ParseIntQueryParam(c, "days", defaultForecastDays)
+	if err != nil || days < 1 || days > maxForecastDays {
+		common.ResponseError(http.StatusBadRequest, "Invalid days parameter", models.ErrCodeInvalidRequest, err, c)
+		return
+	}
+	loc, err := common.RequestReportLocation(c)
+	if err != nil {
+		common.ResponseError(http.StatusBadRequest, "Invalid timezone parameter", models.ErrCodeInvalidRequest, err, c)
+		return
+	}
+
+	now := time.Now().In(loc)
+	start := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, loc)
+
+	// ================ 2. Build forecast ================
+	forecast, err := pc.Forecast(start, days)
+	if err != nil {
+		common.ResponseError(http.StatusInternalServerError, "Failed to fetch data from database", models.ErrCodeInternalError, err, c)
+		return
+	}
+
+	// ================ 3. Send response ================
+	common.ResponseSuccess(http.StatusOK, forecast, c)
+}
+
+// Forecast counts the words and questions coming due on each of days days
+// from start, which must be midnight in the location days are bucketed in,
+// and estimates the minutes needed to review them. It backs GetForecast and
+// the review days of the calendar feed.
+func (pc *Controller) Forecast(start time.Time, days int) (models.ReviewForecast, error) {
+	reminders, err := pc.reminderPeer.Select(forecastReminderColumns(), squirrel.Eq{schema.REMINDER_ENTITY_TYPE: schema.ENTITY_TYPE_WORD}, nil, nil, nil)
+	if err != nil {
+		return models.ReviewForecast{}, err
+	}
+	reminderDue := reminderDueByWord(reminders)
+	remindedIDs := make([]int, 0, len(reminderDue))
+	for id := range reminderDue {
+		remindedIDs = append(remindedIDs, id)
+	}
+
+	// Suspended words and questions are never served, so they are never due
+	words, err := pc.wordPeer.Select(forecastWordColumns(), squirrel.And{
+		squirrel.Eq{schema.WORD_IS_SUSPENDED: false},
+		squirrel.Or{
+			squirrel.NotEq{schema.WORD_LAST_PRACTISED_AT: nil},
+			squirrel.Eq{schema.WORD_ID: remindedIDs},
+		},
+	}, nil, nil, nil)
+	if err != nil {
+		return models.ReviewForecast{}, err
+	}
+
+	questions, err := pc.questionPeer.Select(forecastQuestionColumns(), squirrel.And{
+		squirrel.Eq{schema.QUESTION_IS_SUSPENDED: false},
+		squirrel.NotEq{schema.QUESTION_LAST_ANSWERED_AT: nil},
+	}, nil, nil, nil)
+	if err != nil {
+		return models.ReviewForecast{}, err
+	}
+
+	// Answer times are measured over the last answerTimeWindowDays
+	since := time.Now().AddDate(0, 0, -answerTimeWindowDays)
+	wordLogOrder := fmt.Sprintf("%s ASC, %s ASC", schema.WORD_PRACTICE_LOG_QUIZ_SESSION_ID, schema.COMMON_CREATED_AT)
+	wordLogs, err := pc.wordPracticeLogPeer.Select(
+		columnPtrs(schema.WORD_PRACTICE_LOG_QUIZ_SESSION_ID, schema.WORD_PRACTICE_LOG_RESPONSE_MS, schema.COMMON_CREATED_AT),
+		squirrel.And{
+			squirrel.Or{squirrel.NotEq{schema.WORD_PRACTICE_LOG_QUIZ_SESSION_ID: nil}, squirrel.NotEq{schema.WORD_PRACTICE_LOG_RESPONSE_MS: nil}},
+			squirrel.GtOrEq{schema.COMMON_CREATED_AT: since},
+		},
+		[]*string{&wordLogOrder}, nil, nil,
+	)
+	if err != nil {
+		return models.ReviewForecast{}, err
+	}
+
+	questionLogOrder := fmt.Sprintf("%s ASC", schema.COMMON_CREATED_AT)
+	questionLogs, err := pc.questionAnswerLogPeer.Select(
+		columnPtrs(schema.QUESTION_ANSWER_LOG_RESPONSE_MS, schema.COMMON_CREATED_AT),
+		squirrel.GtOrEq{schema.COMMON_CREATED_AT: since},
+		[]*string{&questionLogOrder}, nil, nil,
+	)
+	if err != nil {
+		return models.ReviewForecast{}, err
+	}
+
+	wordCounts := make([]int, days)
+	questionCounts := make([]int, days)
+	overdueWords := forecastWordReviews(words, reminderDue, start, wordCounts)
+	overdueQuestions := forecastQuestionReviews(questions, start, questionCounts)
+
+	forecast := buildForecast(start, wordCounts, questionCounts, wordAnswerSeconds(wordLogs), questionAnswerSeconds(questionLogs))
+	forecast.OverdueWords = overdueWords
+	forecast.OverdueQuestions = overdueQuestions
+	return forecast, nil
+}
@@ -0,0 +1,162 @@
+package progress
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"time"
+
+	dbModels "word-flashcard/data/models"
+	"word-flashcard/internal/models"
+	"word-flashcard/utils"
+
+	"github.com/Masterminds/squirrel"
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+// TestGetForecast tests that GetForecast schedules words and questions and
+// prices them with the answer times measured from the logs
+func (suite *ControllerTestSuite) TestGetForecast() {
+	lastPracticed := time.Now().AddDate(0, 0, -30)
+	answered := time.Now()
+	sessionStart := time.Now().Add(-time.Hour)
+	sessionNext := sessionStart.Add(12 * time.Second)
+
+	suite.mockReminderPeer.EXPECT().
+		Select(mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+		Return([]*dbModels.Reminder{}, nil).Times(1)
+	suite.mockWordPeer.EXPECT().
+		Select(mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+		Return([]*dbModels.Word{
+			{Familiarity: utils.StrPtr("green"), LastPracticedAt: &lastPracticed},
+		}, nil).Times(1)
+	suite.mockQuestionPeer.EXPECT().
+		Select(mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+		Return([]*dbModels.Question{
+			{CountPractise: utils.IntPtr(1), CountFailurePractise: utils.IntPtr(1), LastAnsweredAt: &answered},
+		}, nil).Times(1)
+	suite.mockWordPracticeLogPeer.EXPECT().
+		Select(mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+		Return([]*dbModels.WordPracticeLog{
+			{QuizSessionID: utils.StrPtr("s1"), CreatedAt: &sessionStart},
+			{QuizSessionID: utils.StrPtr("s1"), CreatedAt: &sessionNext},
+		}, nil).Times(1)
+	suite.mockQuestionAnswerLogPeer.EXPECT().
+		Select(mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+		Return([]*dbModels.QuestionAnswerLog{}, nil).Times(1)
+
+	w := httptest.NewRecorder()
+	ctx, _ := gin.CreateTestContext(w)
+	ctx.Request = httptest.NewRequest(http.MethodGet, "/api/progress/forecast?days=14&tz=UTC", nil)
+	suite.controller.GetForecast(ctx)
+
+	assert.Equal(suite.T(), http.StatusOK, w.Code)
+	var forecast models.ReviewForecast
+	suite.Require().NoError(json.Unmarshal(w.Body.Bytes(), &forecast))
+	assert.Equal(suite.T(), 14, forecast.Days)
+	suite.Require().Len(forecast.Points, 14)
+	assert.Equal(suite.T(), time.Now().UTC().Format("2006-01-02"), forecast.Points[0].Date)
+	assert.Equal(suite.T(), 1, forecast.OverdueWords)
+	assert.Equal(suite.T(), 0, forecast.OverdueQuestions)
+	assert.Equal(suite.T(), 1, forecast.Points[0].WordCount)
+	assert.Equal(suite.T(), 1, forecast.Points[7].WordCount)
+	assert.Equal(suite.T(), 2, forecast.TotalWords)
+	assert.Equal(suite.T(), 13, forecast.TotalQuestions)
+	assert.Equal(suite.T(), 12.0, forecast.WordSecondsPerAnswer)
+	assert.Equal(suite.T(), defaultQuestionAnswerSeconds, forecast.QuestionSecondsPerAnswer)
+	assert.Equal(suite.T(), 6.9, forecast.TotalMinutes)
+}
+
+// TestGetForecastInvalidDays tests that an out-of-range days returns 400
+func (suite *ControllerTestSuite) TestGetForecastInvalidDays() {
+	for _, query := range []string{"days=0", "days=366", "days=abc"} {
+		w := httptest.NewRecorder()
+		ctx, _ := gin.CreateTestContext(w)
+		ctx.Request = httptest.NewRequest(http.MethodGet, "/api/progress/forecast?"+query, nil)
+		suite.controller.GetForecast(ctx)
+
+		assert.Equal(suite.T(), http.StatusBadRequest, w.Code, query)
+	}
+}
+
+// TestGetForecastInvalidTimezone tests that an unknown tz returns 400
+func (suite *ControllerTestSuite) TestGetForecastInvalidTimezone() {
+	w := httptest.NewRecorder()
+	ctx, _ := gin.CreateTestContext(w)
+	ctx.Request = httptest.NewRequest(http.MethodGet, "/api/progress/forecast?tz=Mars/Olympus", nil)
+	suite.controller.GetForecast(ctx)
+
+	assert.Equal(suite.T(), http.StatusBadRequest, w.Code)
+}
+
+// TestGetForecastReminder tests that a word with a reminder is fetched by ID,
+// skipping suspended words, and comes due on the reminder's due day
+func (suite *ControllerTestSuite) TestGetForecastReminder() {
+	due := time.Now().UTC().AddDate(0, 0, 2)
+
+	suite.mockReminderPeer.EXPECT().
+		Select(mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+		Return([]*dbModels.Reminder{{EntityId: utils.IntPtr(2), DueAt: &due}}, nil).Times(1)
+	suite.mockWordPeer.EXPECT().
+		Select(mock.Anything, mock.MatchedBy(func(where squirrel.Sqlizer) bool {
+			sql, args, err := where.ToSql()
+			return err == nil && strings.Contains(sql, "is_suspended = ?") &&
+				len(args) == 2 && args[0] == false && args[1] == 2
+		}), mock.Anything, mock.Anything, mock.Anything).
+		Return([]*dbModels.Word{{Id: utils.IntPtr(2), Familiarity: utils.StrPtr("green")}}, nil).Times(1)
+	suite.mockQuestionPeer.EXPECT().
+		Select(mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+		Return([]*dbModels.Question{}, nil).Times(1)
+	suite.mockWordPracticeLogPeer.EXPECT().
+		Select(mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+		Return([]*dbModels.WordPracticeLog{}, nil).Times(1)
+	suite.mockQuestionAnswerLogPeer.EXPECT().
+		Select(mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+		Return([]*dbModels.QuestionAnswerLog{}, nil).Times(1)
+
+	w := httptest.NewRecorder()
+	ctx, _ := gin.CreateTestContext(w)
+	ctx.Request = httptest.NewRequest(http.MethodGet, "/api/progress/forecast?days=14&tz=UTC", nil)
+	suite.controller.GetForecast(ctx)
+
+	assert.Equal(suite.T(), http.StatusOK, w.Code)
+	var forecast models.ReviewForecast
+	suite.Require().NoError(json.Unmarshal(w.Body.Bytes(), &forecast))
+	assert.Equal(suite.T(), 0, forecast.OverdueWords)
+	assert.Equal(suite.T(), 1, forecast.Points[2].WordCount)
+	assert.Equal(suite.T(), 1, forecast.Points[9].WordCount)
+	assert.Equal(suite.T(), 2, forecast.TotalWords)
+}
+
+// TestGetForecastDatabaseError tests that a failed reminders or words query
+// returns 500
+func (suite *ControllerTestSuite) TestGetForecastDatabaseError() {
+	suite.mockReminderPeer.EXPECT().
+		Select(mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+		Return(nil, errors.New("db error")).Times(1)
+
+	w := httptest.NewRecorder()
+	ctx, _ := gin.CreateTestContext(w)
+	ctx.Request = httptest.NewRequest(http.MethodGet, "/api/progress/forecast", nil)
+	suite.controller.GetForecast(ctx)
+
+	assert.Equal(suite.T(), http.StatusInternalServerError, w.Code)
+
+	suite.mockReminderPeer.EXPECT().
+		Select(mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+		Return([]*dbModels.Reminder{}, nil).Times(1)
+	suite.mockWordPeer.EXPECT().
+		Select(mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+		Return(nil, errors.New("db error")).Times(1)
+
+	w = httptest.NewRecorder()
+	ctx, _ = gin.CreateTestContext(w)
+	ctx.Request = httptest.NewRequest(http.MethodGet, "/api/progress/forecast", nil)
+	suite.controller.GetForecast(ctx)
+
+	assert.Equal(suite.T(), http.StatusInternalServerError, w.Code)
+}
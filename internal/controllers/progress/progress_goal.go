@@ -0,0 +1,27 @@
+package progress
+
+import (
+	"net/http"
+	"word-flashcard/internal/controllers/common"
+	"word-flashcard/internal/models"
+
+	"github.com/gin-gonic/gin"
+)
+
+// GetDailyGoal @Summary Get the daily goal
+// @Description Get how many word reviews and question answers per day count toward the study streak
+// @Tags progress
+// @Produce json
+// @Success 200 {object} models.DailyGoal "Current daily goal"
+// @Failure 500 {object} models.ErrorResponse "Internal server error - Failed to fetch data from database"
+// @Router /api/progress/goal [get]
+func (pc *Controller) GetDailyGoal(c *gin.Context) {
+	settings, err := pc.loadGoalSettings()
+	if err != nil {
+		common.ResponseError(http.StatusInternalServerError, "Failed to fetch data from database", models.ErrCodeInternalError, err, c)
+		return
+	}
+
+	goal := goalFromSettings(settings)
+	common.ResponseSuccess(http.StatusOK, models.DailyGoal{Words: &goal.words, Questions: &goal.questions}, c)
+}
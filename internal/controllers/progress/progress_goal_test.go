@@ -0,0 +1,76 @@
+package progress
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+
+	dbModels "word-flashcard/data/models"
+	"word-flashcard/data/schema"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+// TestGetDailyGoal tests that GetDailyGoal returns stored targets and
+// defaults for unset or unusable ones
+func (suite *ControllerTestSuite) TestGetDailyGoal() {
+	tests := []struct {
+		name     string
+		settings []*dbModels.Setting
+		expected string
+	}{
+		{
+			name:     "nothing stored uses the defaults",
+			settings: []*dbModels.Setting{},
+			expected: `{"words":20,"questions":10}`,
+		},
+		{
+			name:     "stored targets",
+			settings: []*dbModels.Setting{sampleSetting(1, schema.SETTING_DAILY_GOAL_WORDS, "5"), sampleSetting(2, schema.SETTING_DAILY_GOAL_QUESTIONS, "0")},
+			expected: `{"words":5,"questions":0}`,
+		},
+		{
+			name:     "non-numeric value falls back to that target's default",
+			settings: []*dbModels.Setting{sampleSetting(1, schema.SETTING_DAILY_GOAL_WORDS, "many")},
+			expected: `{"words":20,"questions":10}`,
+		},
+		{
+			name:     "stored goal of nothing falls back to the defaults",
+			settings: []*dbModels.Setting{sampleSetting(1, schema.SETTING_DAILY_GOAL_WORDS, "0"), sampleSetting(2, schema.SETTING_DAILY_GOAL_QUESTIONS, "0")},
+			expected: `{"words":20,"questions":10}`,
+		},
+	}
+
+	for _, tt := range tests {
+		suite.Run(tt.name, func() {
+			suite.SetupTest()
+			suite.mockSettingPeer.EXPECT().
+				Select(mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+				Return(tt.settings, nil).Times(1)
+
+			w := httptest.NewRecorder()
+			ctx, _ := gin.CreateTestContext(w)
+			ctx.Request = httptest.NewRequest(http.MethodGet, "/api/progress/goal", nil)
+			suite.controller.GetDailyGoal(ctx)
+
+			assert.Equal(suite.T(), http.StatusOK, w.Code)
+			assert.JSONEq(suite.T(), tt.expected, w.Body.String())
+		})
+	}
+}
+
+// TestGetDailyGoalDatabaseError tests that a settings query failure returns 500
+func (suite *ControllerTestSuite) TestGetDailyGoalDatabaseError() {
+	suite.mockSettingPeer.EXPECT().
+		Select(mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+		Return(nil, errors.New("db error")).Times(1)
+
+	w := httptest.NewRecorder()
+	ctx, _ := gin.CreateTestContext(w)
+	ctx.Request = httptest.NewRequest(http.MethodGet, "/api/progress/goal", nil)
+	suite.controller.GetDailyGoal(ctx)
+
+	assert.Equal(suite.T(), http.StatusInternalServerError, w.Code)
+}
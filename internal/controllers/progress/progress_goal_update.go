@@ -0,0 +1,65 @@
+package progress
+
+import (
+	"net/http"
+	"word-flashcard/data/schema"
+	"word-flashcard/internal/controllers/common"
+	"word-flashcard/internal/models"
+
+	"github.com/gin-gonic/gin"
+)
+
+// UpdateDailyGoal @Summary Update the daily goal
+// @Description Set how many word reviews and question answers per day count toward the study streak. An omitted field keeps its current value; the two cannot both be 0.
+// @Tags progress
+// @Accept json
+// @Produce json
+// @Param goal body models.DailyGoal true "Daily goal to set"
+// @Success 200 {object} models.DailyGoal "Daily goal updated successfully"
+// @Failure 400 {object} models.ErrorResponse "Bad request - Invalid request body"
+// @Failure 500 {object} models.ErrorResponse "Internal server error - Failed to update data in database"
+// @Router /api/progress/goal [put]
+func (pc *Controller) UpdateDailyGoal(c *gin.Context) {
+	// ================ 1. Parse request body ================
+	var goalData models.DailyGoal
+	if err := common.ParseRequestBody(&goalData, c); err != nil {
+		common.ResponseError(http.StatusBadRequest, "Invalid request body", models.ErrCodeInvalidRequest, err, c)
+		return
+	}
+
+	// ================ 2. Merge with the current goal & validate ================
+	settings, err := pc.loadGoalSettings()
+	if err != nil {
+		common.ResponseError(http.StatusInternalServerError, "Failed to fetch data from database", models.ErrCodeInternalError, err, c)
+		return
+	}
+
+	goal := goalFromSettings(settings)
+	if goalData.Words != nil {
+		goal.words = *goalData.Words
+	}
+	if goalData.Questions != nil {
+		goal.questions = *goalData.Questions
+	}
+	if err := validateDailyGoal(goal.words, goal.questions); err != nil {
+		common.ResponseError(http.StatusBadRequest, err.Error(), models.ErrCodeValidationError, err, c)
+		return
+	}
+
+	// ================ 3. Save the changed targets ================
+	if goalData.Words != nil {
+		if err := pc.saveSettingInt(settings, schema.SETTING_DAILY_GOAL_WORDS, goal.words); err != nil {
+			common.ResponseError(http.StatusInternalServerError, "Failed to update data in database", models.ErrCodeInternalError, err, c)
+			return
+		}
+	}
+	if goalData.Questions != nil {
+		if err := pc.saveSettingInt(settings, schema.SETTING_DAILY_GOAL_QUESTIONS, goal.questions); err != nil {
+			common.ResponseError(http.StatusInternalServerError, "Failed to update data in database", models.ErrCodeInternalError, err, c)
+			return
+		}
+	}
+
+	// ================ 4. Send response ================
+	common.ResponseSuccess(http.StatusOK, models.DailyGoal{Words: &goal.words, Questions: &goal.questions}, c)
+}
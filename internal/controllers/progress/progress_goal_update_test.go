@@ -0,0 +1,144 @@
+package progress
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+
+	dbModels "word-flashcard/data/models"
+	"word-flashcard/data/schema"
+
+	"github.com/Masterminds/squirrel"
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+// settingWithValue matches a Setting written with the given value
+func settingWithValue(value string) interface{} {
+	return mock.MatchedBy(func(setting *dbModels.Setting) bool {
+		return setting.Value != nil && *setting.Value == value
+	})
+}
+
+// TestUpdateDailyGoal tests that UpdateDailyGoal updates stored targets,
+// inserts unset ones, and leaves omitted ones untouched
+func (suite *ControllerTestSuite) TestUpdateDailyGoal() {
+	tests := []struct {
+		name       string
+		body       string
+		setupMocks func()
+		expected   string
+	}{
+		{
+			name: "both targets set for the first time are inserted",
+			body: `{"words":30,"questions":5}`,
+			setupMocks: func() {
+				suite.mockSettingPeer.EXPECT().
+					Select(mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+					Return([]*dbModels.Setting{}, nil).Times(1)
+				suite.mockSettingPeer.EXPECT().
+					Insert(mock.MatchedBy(func(setting *dbModels.Setting) bool {
+						return *setting.Name == schema.SETTING_DAILY_GOAL_WORDS && *setting.Value == "30"
+					})).
+					Return(int64(1), nil).Times(1)
+				suite.mockSettingPeer.EXPECT().
+					Insert(mock.MatchedBy(func(setting *dbModels.Setting) bool {
+						return *setting.Name == schema.SETTING_DAILY_GOAL_QUESTIONS && *setting.Value == "5"
+					})).
+					Return(int64(2), nil).Times(1)
+			},
+			expected: `{"words":30,"questions":5}`,
+		},
+		{
+			name: "stored target is updated and the omitted one is kept",
+			body: `{"questions":0}`,
+			setupMocks: func() {
+				suite.mockSettingPeer.EXPECT().
+					Select(mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+					Return([]*dbModels.Setting{sampleSetting(1, schema.SETTING_DAILY_GOAL_WORDS, "15"), sampleSetting(2, schema.SETTING_DAILY_GOAL_QUESTIONS, "8")}, nil).Times(1)
+				suite.mockSettingPeer.EXPECT().
+					Update(settingWithValue("0"), squirrel.Eq{schema.SETTING_NAME: schema.SETTING_DAILY_GOAL_QUESTIONS}).
+					Return(int64(1), nil).Times(1)
+			},
+			expected: `{"words":15,"questions":0}`,
+		},
+	}
+
+	for _, tt := range tests {
+		suite.Run(tt.name, func() {
+			suite.SetupTest()
+			tt.setupMocks()
+
+			w := httptest.NewRecorder()
+			ctx, _ := gin.CreateTestContext(w)
+			ctx.Request = httptest.NewRequest(http.MethodPut, "/api/progress/goal", strings.NewReader(tt.body))
+			suite.controller.UpdateDailyGoal(ctx)
+
+			assert.Equal(suite.T(), http.StatusOK, w.Code)
+			assert.JSONEq(suite.T(), tt.expected, w.Body.String())
+		})
+	}
+}
+
+// TestUpdateDailyGoalInvalid tests that out-of-range goals, and a goal
+// asking for nothing once merged with the stored one, are rejected with 400
+func (suite *ControllerTestSuite) TestUpdateDailyGoalInvalid() {
+	tests := []struct {
+		name     string
+		body     string
+		settings []*dbModels.Setting
+	}{
+		{name: "negative words", body: `{"words":-1}`, settings: []*dbModels.Setting{}},
+		{name: "questions above the maximum", body: `{"questions":10001}`, settings: []*dbModels.Setting{}},
+		{
+			name:     "both 0 after merging",
+			body:     `{"words":0}`,
+			settings: []*dbModels.Setting{sampleSetting(2, schema.SETTING_DAILY_GOAL_QUESTIONS, "0"), sampleSetting(1, schema.SETTING_DAILY_GOAL_WORDS, "3")},
+		},
+	}
+
+	for _, tt := range tests {
+		suite.Run(tt.name, func() {
+			suite.SetupTest()
+			suite.mockSettingPeer.EXPECT().
+				Select(mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+				Return(tt.settings, nil).Times(1)
+
+			w := httptest.NewRecorder()
+			ctx, _ := gin.CreateTestContext(w)
+			ctx.Request = httptest.NewRequest(http.MethodPut, "/api/progress/goal", strings.NewReader(tt.body))
+			suite.controller.UpdateDailyGoal(ctx)
+
+			assert.Equal(suite.T(), http.StatusBadRequest, w.Code)
+		})
+	}
+}
+
+// TestUpdateDailyGoalInvalidBody tests that a malformed body returns 400 without touching the database
+func (suite *ControllerTestSuite) TestUpdateDailyGoalInvalidBody() {
+	w := httptest.NewRecorder()
+	ctx, _ := gin.CreateTestContext(w)
+	ctx.Request = httptest.NewRequest(http.MethodPut, "/api/progress/goal", strings.NewReader(`{"words":"many"}`))
+	suite.controller.UpdateDailyGoal(ctx)
+
+	assert.Equal(suite.T(), http.StatusBadRequest, w.Code)
+}
+
+// TestUpdateDailyGoalDatabaseError tests that a failed write returns 500
+func (suite *ControllerTestSuite) TestUpdateDailyGoalDatabaseError() {
+	suite.mockSettingPeer.EXPECT().
+		Select(mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+		Return([]*dbModels.Setting{}, nil).Times(1)
+	suite.mockSettingPeer.EXPECT().
+		Insert(mock.Anything).
+		Return(int64(0), errors.New("db error")).Times(1)
+
+	w := httptest.NewRecorder()
+	ctx, _ := gin.CreateTestContext(w)
+	ctx.Request = httptest.NewRequest(http.MethodPut, "/api/progress/goal", strings.NewReader(`{"words":10}`))
+	suite.controller.UpdateDailyGoal(ctx)
+
+	assert.Equal(suite.T(), http.StatusInternalServerError, w.Code)
+}
@@ -0,0 +1,52 @@
+package progress
+
+import (
+	"net/http"
+	"time"
+	"word-flashcard/internal/controllers/common"
+	"word-flashcard/internal/models"
+
+	"github.com/gin-gonic/gin"
+)
+
+// GetStreak @Summary Get the study streak
+// @Description Get the current and longest study streak, today's progress toward the daily goal, freeze days, and a year-long activity
+// @Description heatmap, computed from word practice and question answer logs bucketed into calendar days of the reporting timezone
+// @Tags progress
+// @Produce json
+// @Param tz query string false "IANA timezone to bucket days in (default: X-Timezone header, else the stored report timezone, else REPORT_TIMEZONE)"
+// @Param X-Timezone header string false "IANA timezone to bucket days in when tz is not given"
+// @Success 200 {object} models.Streak "Study streak"
+// @Failure 400 {object} models.ErrorResponse "Bad request - Invalid timezone parameter"
+// @Failure 500 {object} models.ErrorResponse "Internal server error - Failed to fetch data from database"
+// @Router /api/progress/streak [get]
+func (pc *Controller) GetStreak(c *gin.Context) {
+	loc, err := common.RequestReportLocation(c)
+	if err != nil {
+		common.ResponseError(http.StatusBadRequest, "Invalid timezone parameter", models.ErrCodeInvalidRequest, err, c)
+		return
+	}
+
+	settings, err := pc.loadGoalSettings()
+	if err != nil {
+		common.ResponseError(http.StatusInternalServerError, "Failed to fetch data from database", models.ErrCodeInternalError, err, c)
+		return
+	}
+
+	// A streak can reach back to the very first log, so the whole history
+	// is aggregated; it is one row per active day, not per log.
+	since := time.Unix(0, 0)
+	wordStats, err := pc.wordPracticeLogPeer.DailyStats(since, loc)
+	if err != nil {
+		common.ResponseError(http.StatusInternalServerError, "Failed to fetch data from database", models.ErrCodeInternalError, err, c)
+		return
+	}
+	questionStats, err := pc.questionAnswerLogPeer.DailyStats(since, loc)
+	if err != nil {
+		common.ResponseError(http.StatusInternalServerError, "Failed to fetch data from database", models.ErrCodeInternalError, err, c)
+		return
+	}
+
+	activity := mergeDailyActivity(wordStats, questionStats)
+	common.ResponseSuccess(http.StatusOK, buildStreak(activity, goalFromSettings(settings), time.Now().In(loc)), c)
+}
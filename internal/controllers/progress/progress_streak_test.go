@@ -0,0 +1,85 @@
+package progress
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"time"
+
+	dbModels "word-flashcard/data/models"
+	"word-flashcard/internal/controllers/common"
+	"word-flashcard/internal/models"
+	"word-flashcard/utils"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+// TestGetStreak tests that GetStreak combines word and question activity
+// per day of the requested timezone: yesterday met the default goal, today
+// is halfway there, so the streak is 1 and today's progress is partial.
+func (suite *ControllerTestSuite) TestGetStreak() {
+	loc, err := time.LoadLocation("America/New_York")
+	suite.Require().NoError(err)
+	dateKeys := common.DailyDateKeys(2, time.Now().In(loc))
+
+	suite.mockSettingPeer.EXPECT().
+		Select(mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+		Return([]*dbModels.Setting{}, nil).Times(1)
+	suite.mockWordPracticeLogPeer.EXPECT().
+		DailyStats(mock.Anything, loc).
+		Return([]*dbModels.WordPracticeLogDailyStats{
+			{Day: utils.StrPtr(dateKeys[0]), PracticeCount: utils.IntPtr(20)},
+			{Day: utils.StrPtr(dateKeys[1]), PracticeCount: utils.IntPtr(10)},
+		}, nil).Times(1)
+	suite.mockQuestionAnswerLogPeer.EXPECT().
+		DailyStats(mock.Anything, loc).
+		Return([]*dbModels.QuestionAnswerLogDailyStats{
+			{Day: utils.StrPtr(dateKeys[0]), AnswerCount: utils.IntPtr(10)},
+			{Day: utils.StrPtr(dateKeys[1]), AnswerCount: utils.IntPtr(5)},
+		}, nil).Times(1)
+
+	w := httptest.NewRecorder()
+	ctx, _ := gin.CreateTestContext(w)
+	ctx.Request = httptest.NewRequest(http.MethodGet, "/api/progress/streak?tz=America/New_York", nil)
+	suite.controller.GetStreak(ctx)
+
+	assert.Equal(suite.T(), http.StatusOK, w.Code)
+	var streak models.Streak
+	suite.Require().NoError(json.Unmarshal(w.Body.Bytes(), &streak))
+	assert.Equal(suite.T(), 1, streak.CurrentStreak)
+	assert.Equal(suite.T(), 1, streak.LongestStreak)
+	assert.Equal(suite.T(), models.DailyProgress{Date: dateKeys[1], WordCount: 10, QuestionCount: 5, Progress: 50, GoalMet: false}, streak.Today)
+	suite.Require().Len(streak.Heatmap, heatmapDays)
+	assert.Equal(suite.T(), 3, streak.Heatmap[heatmapDays-2].Level)
+	assert.Equal(suite.T(), 2, streak.Heatmap[heatmapDays-1].Level)
+}
+
+// TestGetStreakInvalidTimezone tests that an unknown tz returns 400 without touching the database
+func (suite *ControllerTestSuite) TestGetStreakInvalidTimezone() {
+	w := httptest.NewRecorder()
+	ctx, _ := gin.CreateTestContext(w)
+	ctx.Request = httptest.NewRequest(http.MethodGet, "/api/progress/streak?tz=Mars/Olympus", nil)
+	suite.controller.GetStreak(ctx)
+
+	assert.Equal(suite.T(), http.StatusBadRequest, w.Code)
+}
+
+// TestGetStreakDatabaseError tests that a failed log query returns 500
+func (suite *ControllerTestSuite) TestGetStreakDatabaseError() {
+	suite.mockSettingPeer.EXPECT().
+		Select(mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+		Return([]*dbModels.Setting{}, nil).Times(1)
+	suite.mockWordPracticeLogPeer.EXPECT().
+		DailyStats(mock.Anything, mock.Anything).
+		Return(nil, errors.New("db error")).Times(1)
+
+	w := httptest.NewRecorder()
+	ctx, _ := gin.CreateTestContext(w)
+	ctx.Request = httptest.NewRequest(http.MethodGet, "/api/progress/streak", nil)
+	suite.controller.GetStreak(ctx)
+
+	assert.Equal(suite.T(), http.StatusInternalServerError, w.Code)
+}
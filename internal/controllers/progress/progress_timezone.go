@@ -0,0 +1,39 @@
+package progress
+
+import (
+	"net/http"
+	"word-flashcard/internal/controllers/common"
+	"word-flashcard/internal/models"
+
+	"github.com/gin-gonic/gin"
+)
+
+// GetReportTimezone @Summary Get the report timezone
+// @Description Get the stored timezone reports and the study streak count calendar days in ("" when none is stored), and the timezone
+// @Description in effect when a request names none: the stored one, else REPORT_TIMEZONE
+// @Tags progress
+// @Produce json
+// @Success 200 {object} models.ReportTimezone "Current report timezone"
+// @Failure 500 {object} models.ErrorResponse "Internal server error - Failed to fetch data from database"
+// @Router /api/progress/timezone [get]
+func (pc *Controller) GetReportTimezone(c *gin.Context) {
+	name, err := pc.reportTimezone.Name()
+	if err != nil {
+		common.ResponseError(http.StatusInternalServerError, "Failed to fetch data from database", models.ErrCodeInternalError, err, c)
+		return
+	}
+
+	common.ResponseSuccess(http.StatusOK, reportTimezoneResponse(name), c)
+}
+
+// reportTimezoneResponse builds the API response for the stored timezone
+// name; an unusable stored name is reported but not in effect
+func reportTimezoneResponse(name string) models.ReportTimezone {
+	effective := common.ReportLocation()
+	if name != "" {
+		if loc, err := common.LoadReportLocation(name); err == nil {
+			effective = loc
+		}
+	}
+	return models.ReportTimezone{Timezone: &name, Effective: effective.String()}
+}
@@ -0,0 +1,72 @@
+package progress
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+
+	dbModels "word-flashcard/data/models"
+	"word-flashcard/data/schema"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+// TestGetReportTimezone tests that GetReportTimezone returns the stored
+// timezone and the one in effect, falling back to REPORT_TIMEZONE
+func (suite *ControllerTestSuite) TestGetReportTimezone() {
+	tests := []struct {
+		name     string
+		settings []*dbModels.Setting
+		expected string
+	}{
+		{
+			name:     "nothing stored uses REPORT_TIMEZONE",
+			settings: []*dbModels.Setting{},
+			expected: `{"timezone":"","effective":"Europe/Berlin"}`,
+		},
+		{
+			name:     "stored timezone",
+			settings: []*dbModels.Setting{sampleSetting(1, schema.SETTING_REPORT_TIMEZONE, "Asia/Tokyo")},
+			expected: `{"timezone":"Asia/Tokyo","effective":"Asia/Tokyo"}`,
+		},
+		{
+			name:     "unusable stored timezone is reported but not in effect",
+			settings: []*dbModels.Setting{sampleSetting(1, schema.SETTING_REPORT_TIMEZONE, "Mars/Olympus_Mons")},
+			expected: `{"timezone":"Mars/Olympus_Mons","effective":"Europe/Berlin"}`,
+		},
+	}
+
+	for _, tt := range tests {
+		suite.Run(tt.name, func() {
+			suite.T().Setenv("REPORT_TIMEZONE", "Europe/Berlin")
+			suite.SetupTest()
+			suite.mockSettingPeer.EXPECT().
+				Select(mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+				Return(tt.settings, nil).Times(1)
+
+			w := httptest.NewRecorder()
+			ctx, _ := gin.CreateTestContext(w)
+			ctx.Request = httptest.NewRequest(http.MethodGet, "/api/progress/timezone", nil)
+			suite.controller.GetReportTimezone(ctx)
+
+			assert.Equal(suite.T(), http.StatusOK, w.Code)
+			assert.JSONEq(suite.T(), tt.expected, w.Body.String())
+		})
+	}
+}
+
+// TestGetReportTimezoneDatabaseError tests that a failed read returns 500
+func (suite *ControllerTestSuite) TestGetReportTimezoneDatabaseError() {
+	suite.mockSettingPeer.EXPECT().
+		Select(mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+		Return(nil, errors.New("db error")).Times(1)
+
+	w := httptest.NewRecorder()
+	ctx, _ := gin.CreateTestContext(w)
+	ctx.Request = httptest.NewRequest(http.MethodGet, "/api/progress/timezone", nil)
+	suite.controller.GetReportTimezone(ctx)
+
+	assert.Equal(suite.T(), http.StatusInternalServerError, w.Code)
+}
@@ -0,0 +1,47 @@
+package progress
+
+import (
+	"net/http"
+	"strings"
+	"word-flashcard/internal/controllers/common"
+	"word-flashcard/internal/models"
+
+	"github.com/gin-gonic/gin"
+)
+
+// UpdateReportTimezone @Summary Update the report timezone
+// @Description Store the IANA timezone (e.g. Europe/Berlin) reports and the study streak count calendar days in when a request names none. It takes precedence over REPORT_TIMEZONE; "" clears it.
+// @Tags progress
+// @Accept json
+// @Produce json
+// @Param timezone body models.ReportTimezone true "Report timezone to set"
+// @Success 200 {object} models.ReportTimezone "Report timezone updated successfully"
+// @Failure 400 {object} models.ErrorResponse "Bad request - Invalid request body"
+// @Failure 500 {object} models.ErrorResponse "Internal server error - Failed to update data in database"
+// @Router /api/progress/timezone [put]
+func (pc *Controller) UpdateReportTimezone(c *gin.Context) {
+	// ================ 1. Parse & validate request body ================
+	var timezoneData models.ReportTimezone
+	if err := common.ParseRequestBody(&timezoneData, c); err != nil {
+		common.ResponseError(http.StatusBadRequest, "Invalid request body", models.ErrCodeInvalidRequest, err, c)
+		return
+	}
+	if timezoneData.Timezone == nil {
+		common.ResponseError(http.StatusBadRequest, "timezone is required", models.ErrCodeValidationError, nil, c)
+		return
+	}
+	name := strings.TrimSpace(*timezoneData.Timezone)
+	if err := common.ValidateReportTimezone(name); err != nil {
+		common.ResponseError(http.StatusBadRequest, err.Error(), models.ErrCodeValidationError, err, c)
+		return
+	}
+
+	// ================ 2. Save the timezone ================
+	if err := pc.reportTimezone.Save(name); err != nil {
+		common.ResponseError(http.StatusInternalServerError, "Failed to update data in database", models.ErrCodeInternalError, err, c)
+		return
+	}
+
+	// ================ 3. Send response ================
+	common.ResponseSuccess(http.StatusOK, reportTimezoneResponse(name), c)
+}
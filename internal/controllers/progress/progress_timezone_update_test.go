@@ -0,0 +1,116 @@
+package progress
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+
+	dbModels "word-flashcard/data/models"
+	"word-flashcard/data/schema"
+
+	"github.com/Masterminds/squirrel"
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+// TestUpdateReportTimezone tests that UpdateReportTimezone inserts the
+// timezone the first time, updates it afterwards, and clears it with ""
+func (suite *ControllerTestSuite) TestUpdateReportTimezone() {
+	tests := []struct {
+		name       string
+		body       string
+		setupMocks func()
+		expected   string
+	}{
+		{
+			name: "first timezone is inserted",
+			body: `{"timezone":"Asia/Tokyo"}`,
+			setupMocks: func() {
+				suite.mockSettingPeer.EXPECT().
+					Select(mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+					Return([]*dbModels.Setting{}, nil).Times(1)
+				suite.mockSettingPeer.EXPECT().
+					Insert(mock.MatchedBy(func(setting *dbModels.Setting) bool {
+						return *setting.Name == schema.SETTING_REPORT_TIMEZONE && *setting.Value == "Asia/Tokyo"
+					})).
+					Return(int64(1), nil).Times(1)
+			},
+			expected: `{"timezone":"Asia/Tokyo","effective":"Asia/Tokyo"}`,
+		},
+		{
+			name: "stored timezone is cleared",
+			body: `{"timezone":""}`,
+			setupMocks: func() {
+				suite.mockSettingPeer.EXPECT().
+					Select(mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+					Return([]*dbModels.Setting{sampleSetting(1, schema.SETTING_REPORT_TIMEZONE, "Asia/Tokyo")}, nil).Times(1)
+				suite.mockSettingPeer.EXPECT().
+					Update(settingWithValue(""), squirrel.Eq{schema.SETTING_NAME: schema.SETTING_REPORT_TIMEZONE}).
+					Return(int64(1), nil).Times(1)
+			},
+			expected: `{"timezone":"","effective":"Europe/Berlin"}`,
+		},
+	}
+
+	for _, tt := range tests {
+		suite.Run(tt.name, func() {
+			suite.T().Setenv("REPORT_TIMEZONE", "Europe/Berlin")
+			suite.SetupTest()
+			tt.setupMocks()
+
+			w := httptest.NewRecorder()
+			ctx, _ := gin.CreateTestContext(w)
+			ctx.Request = httptest.NewRequest(http.MethodPut, "/api/progress/timezone", strings.NewReader(tt.body))
+			suite.controller.UpdateReportTimezone(ctx)
+
+			assert.Equal(suite.T(), http.StatusOK, w.Code)
+			assert.JSONEq(suite.T(), tt.expected, w.Body.String())
+		})
+	}
+}
+
+// TestUpdateReportTimezoneInvalid tests that a missing or unknown timezone
+// returns 400 without touching the database
+func (suite *ControllerTestSuite) TestUpdateReportTimezoneInvalid() {
+	tests := []struct {
+		name string
+		body string
+	}{
+		{name: "malformed body", body: `{"timezone":5}`},
+		{name: "missing timezone", body: `{}`},
+		{name: "unknown timezone", body: `{"timezone":"Mars/Olympus_Mons"}`},
+		{name: "server local timezone", body: `{"timezone":"Local"}`},
+	}
+
+	for _, tt := range tests {
+		suite.Run(tt.name, func() {
+			suite.SetupTest()
+
+			w := httptest.NewRecorder()
+			ctx, _ := gin.CreateTestContext(w)
+			ctx.Request = httptest.NewRequest(http.MethodPut, "/api/progress/timezone", strings.NewReader(tt.body))
+			suite.controller.UpdateReportTimezone(ctx)
+
+			assert.Equal(suite.T(), http.StatusBadRequest, w.Code)
+		})
+	}
+}
+
+// TestUpdateReportTimezoneDatabaseError tests that a failed write returns 500
+func (suite *ControllerTestSuite) TestUpdateReportTimezoneDatabaseError() {
+	suite.mockSettingPeer.EXPECT().
+		Select(mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+		Return([]*dbModels.Setting{}, nil).Times(1)
+	suite.mockSettingPeer.EXPECT().
+		Insert(mock.Anything).
+		Return(int64(0), errors.New("db error")).Times(1)
+
+	w := httptest.NewRecorder()
+	ctx, _ := gin.CreateTestContext(w)
+	ctx.Request = httptest.NewRequest(http.MethodPut, "/api/progress/timezone", strings.NewReader(`{"timezone":"Asia/Tokyo"}`))
+	suite.controller.UpdateReportTimezone(ctx)
+
+	assert.Equal(suite.T(), http.StatusInternalServerError, w.Code)
+}
@@ -0,0 +1,178 @@
+package progress
+
+import (
+	"sort"
+	"time"
+
+	dbModels "word-flashcard/data/models"
+	"word-flashcard/internal/controllers/common"
+	"word-flashcard/internal/models"
+)
+
+const (
+	// streakFreezeEarnDays consecutive days with the goal met earn one freeze day
+	streakFreezeEarnDays = 7
+
+	// maxFreezeDays caps how many freeze days can be banked at once
+	maxFreezeDays = 2
+
+	// heatmapDays is how many days the activity heatmap covers, today included
+	heatmapDays = 365
+)
+
+// dayActivity is one calendar day's word reviews and question answers
+type dayActivity struct {
+	words     int
+	questions int
+}
+
+// mergeDailyActivity combines the per-day word practice and question answer
+// stats into one map keyed by "YYYY-MM-DD"
+func mergeDailyActivity(wordStats []*dbModels.WordPracticeLogDailyStats, questionStats []*dbModels.QuestionAnswerLogDailyStats) map[string]dayActivity {
+	activity := make(map[string]dayActivity, len(wordStats)+len(questionStats))
+	for _, stat := range wordStats {
+		if stat.Day == nil || stat.PracticeCount == nil {
+			continue
+		}
+		day := activity[*stat.Day]
+		day.words += *stat.PracticeCount
+		activity[*stat.Day] = day
+	}
+	for _, stat := range questionStats {
+		if stat.Day == nil || stat.AnswerCount == nil {
+			continue
+		}
+		day := activity[*stat.Day]
+		day.questions += *stat.AnswerCount
+		activity[*stat.Day] = day
+	}
+	return activity
+}
+
+// met reports whether a day reached both targets of the goal
+func (g dailyGoal) met(day dayActivity) bool {
+	return day.words >= g.words && day.questions >= g.questions
+}
+
+// progress returns the share of the goal a day reached, in percent. Each
+// part is capped at its own target, so surplus in one can't make up for
+// the other.
+func (g dailyGoal) progress(day dayActivity) float64 {
+	done := min(day.words, g.words) + min(day.questions, g.questions)
+	return common.Round1(float64(done) / float64(g.words+g.questions) * 100)
+}
+
+// level grades a day for the heatmap, see models.HeatmapDay
+func (g dailyGoal) level(day dayActivity) int {
+	switch {
+	case day.words+day.questions == 0:
+		return 0
+	case g.met(day) && day.words >= 2*g.words && day.questions >= 2*g.questions:
+		return 4
+	case g.met(day):
+		return 3
+	case g.progress(day) >= 50:
+		return 2
+	default:
+		return 1
+	}
+}
+
+// buildStreak walks every calendar day from the first active one up to
+// now's day. A day with the goal met extends the streak and, every
+// streakFreezeEarnDays in a row, earns a freeze day. A missed day spends a
+// freeze day if one is banked, keeping the streak alive without extending
+// it; otherwise the streak and any banked freeze days are lost. Today only
+// ever extends the streak, since the learner still has time to meet it.
+func buildStreak(activity map[string]dayActivity, goal dailyGoal, now time.Time) models.Streak {
+	today := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, now.Location())
+	todayKey := today.Format("2006-01-02")
+
+	streak := models.Streak{
+		Goal:    models.DailyGoal{Words: &goal.words, Questions: &goal.questions},
+		Today:   dailyProgress(todayKey, activity[todayKey], goal),
+		Heatmap: buildHeatmap(activity, goal, now),
+	}
+
+	first, ok := firstActiveDay(activity, now.Location())
+	if !ok {
+		return streak
+	}
+
+	metInARow := 0
+	for day := first; !day.After(today); day = day.AddDate(0, 0, 1) {
+		if goal.met(activity[day.Format("2006-01-02")]) {
+			streak.CurrentStreak++
+			streak.LongestStreak = max(streak.LongestStreak, streak.CurrentStreak)
+			metInARow++
+			if metInARow%streakFreezeEarnDays == 0 && streak.FreezeDaysAvailable < maxFreezeDays {
+				streak.FreezeDaysAvailable++
+			}
+			continue
+		}
+
+		switch {
+		case day.Equal(today):
+			// Today is still in progress and doesn't break the streak
+		case streak.CurrentStreak > 0 && streak.FreezeDaysAvailable > 0:
+			streak.FreezeDaysAvailable--
+			streak.FreezeDaysUsed++
+			metInARow = 0
+		default:
+			streak.CurrentStreak = 0
+			streak.FreezeDaysAvailable = 0
+			streak.FreezeDaysUsed = 0
+			metInARow = 0
+		}
+	}
+
+	return streak
+}
+
+// firstActiveDay returns midnight, in loc, of the earliest day with any activity
+func firstActiveDay(activity map[string]dayActivity, loc *time.Location) (time.Time, bool) {
+	keys := make([]string, 0, len(activity))
+	for key, day := range activity {
+		if day.words+day.questions > 0 {
+			keys = append(keys, key)
+		}
+	}
+	if len(keys) == 0 {
+		return time.Time{}, false
+	}
+
+	sort.Strings(keys)
+	first, err := time.ParseInLocation("2006-01-02", keys[0], loc)
+	if err != nil {
+		return time.Time{}, false
+	}
+	return first, true
+}
+
+// dailyProgress measures one day's activity against the goal
+func dailyProgress(date string, day dayActivity, goal dailyGoal) models.DailyProgress {
+	return models.DailyProgress{
+		Date:          date,
+		WordCount:     day.words,
+		QuestionCount: day.questions,
+		Progress:      goal.progress(day),
+		GoalMet:       goal.met(day),
+	}
+}
+
+// buildHeatmap returns one zero-filled cell per day of the last heatmapDays
+// days, ascending by date
+func buildHeatmap(activity map[string]dayActivity, goal dailyGoal, now time.Time) []models.HeatmapDay {
+	keys := common.DailyDateKeys(heatmapDays, now)
+	heatmap := make([]models.HeatmapDay, 0, len(keys))
+	for _, key := range keys {
+		day := activity[key]
+		heatmap = append(heatmap, models.HeatmapDay{
+			Date:          key,
+			WordCount:     day.words,
+			QuestionCount: day.questions,
+			Level:         goal.level(day),
+		})
+	}
+	return heatmap
+}
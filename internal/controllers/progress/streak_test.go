@@ -0,0 +1,137 @@
+package progress
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+)
+
+// streakTestSuite tests the pure streak computation
+type streakTestSuite struct {
+	suite.Suite
+	now  time.Time
+	goal dailyGoal
+}
+
+// TestStreakSuite runs the streakTestSuite
+func TestStreakSuite(t *testing.T) {
+	suite.Run(t, new(streakTestSuite))
+}
+
+// SetupTest pins "now" and uses a goal of 2 words and 1 question
+func (s *streakTestSuite) SetupTest() {
+	s.now = time.Date(2026, 3, 31, 15, 0, 0, 0, time.UTC)
+	s.goal = dailyGoal{words: 2, questions: 1}
+}
+
+// activityFor builds an activity map from per-day entries, indexed by days ago
+func (s *streakTestSuite) activityFor(daysAgo map[int]dayActivity) map[string]dayActivity {
+	activity := make(map[string]dayActivity, len(daysAgo))
+	for ago, day := range daysAgo {
+		activity[s.now.AddDate(0, 0, -ago).Format("2006-01-02")] = day
+	}
+	return activity
+}
+
+// metDays returns the activity of n consecutive met days ending `endAgo` days ago
+func (s *streakTestSuite) metDays(daysAgo map[int]dayActivity, endAgo int, n int) {
+	for ago := endAgo; ago < endAgo+n; ago++ {
+		daysAgo[ago] = dayActivity{words: 2, questions: 1}
+	}
+}
+
+// TestNoActivity tests that an empty history has no streak
+func (s *streakTestSuite) TestNoActivity() {
+	streak := buildStreak(map[string]dayActivity{}, s.goal, s.now)
+
+	s.Equal(0, streak.CurrentStreak)
+	s.Equal(0, streak.LongestStreak)
+	s.Equal("2026-03-31", streak.Today.Date)
+	s.Len(streak.Heatmap, heatmapDays)
+	s.Equal("2026-03-31", streak.Heatmap[heatmapDays-1].Date)
+}
+
+// TestTodayInProgressKeepsStreak tests that an unmet today doesn't break the streak
+func (s *streakTestSuite) TestTodayInProgressKeepsStreak() {
+	daysAgo := map[int]dayActivity{0: {words: 1}}
+	s.metDays(daysAgo, 1, 3)
+
+	streak := buildStreak(s.activityFor(daysAgo), s.goal, s.now)
+
+	s.Equal(3, streak.CurrentStreak)
+	s.False(streak.Today.GoalMet)
+	s.Equal(33.3, streak.Today.Progress)
+}
+
+// TestMissedDayBreaksStreak tests that a missed day without freezes resets the
+// current streak while the longest one is kept
+func (s *streakTestSuite) TestMissedDayBreaksStreak() {
+	daysAgo := map[int]dayActivity{}
+	s.metDays(daysAgo, 0, 2)
+	s.metDays(daysAgo, 3, 4)
+	daysAgo[2] = dayActivity{words: 5} // active, but no questions answered
+
+	streak := buildStreak(s.activityFor(daysAgo), s.goal, s.now)
+
+	s.Equal(2, streak.CurrentStreak)
+	s.Equal(4, streak.LongestStreak)
+	s.Equal(0, streak.FreezeDaysAvailable)
+}
+
+// TestFreezeBridgesMissedDay tests that 7 met days earn a freeze, which is
+// spent on the next missed day instead of breaking the streak
+func (s *streakTestSuite) TestFreezeBridgesMissedDay() {
+	daysAgo := map[int]dayActivity{}
+	s.metDays(daysAgo, 0, 2)
+	s.metDays(daysAgo, 3, 7)
+
+	streak := buildStreak(s.activityFor(daysAgo), s.goal, s.now)
+
+	s.Equal(9, streak.CurrentStreak)
+	s.Equal(9, streak.LongestStreak)
+	s.Equal(0, streak.FreezeDaysAvailable)
+	s.Equal(1, streak.FreezeDaysUsed)
+}
+
+// TestFreezeDaysAreCapped tests that no more than maxFreezeDays are banked
+func (s *streakTestSuite) TestFreezeDaysAreCapped() {
+	daysAgo := map[int]dayActivity{}
+	s.metDays(daysAgo, 0, 21)
+
+	streak := buildStreak(s.activityFor(daysAgo), s.goal, s.now)
+
+	s.Equal(21, streak.CurrentStreak)
+	s.Equal(maxFreezeDays, streak.FreezeDaysAvailable)
+}
+
+// TestFreezesLostWhenStreakBreaks tests that two missed days with a single
+// banked freeze break the streak and forfeit the freezes
+func (s *streakTestSuite) TestFreezesLostWhenStreakBreaks() {
+	daysAgo := map[int]dayActivity{}
+	s.metDays(daysAgo, 0, 1)
+	s.metDays(daysAgo, 3, 7)
+
+	streak := buildStreak(s.activityFor(daysAgo), s.goal, s.now)
+
+	s.Equal(1, streak.CurrentStreak)
+	s.Equal(7, streak.LongestStreak)
+	s.Equal(0, streak.FreezeDaysAvailable)
+	s.Equal(0, streak.FreezeDaysUsed)
+}
+
+// TestHeatmapLevels tests how days are graded against the goal
+func (s *streakTestSuite) TestHeatmapLevels() {
+	s.Equal(0, s.goal.level(dayActivity{}))
+	s.Equal(1, s.goal.level(dayActivity{words: 1}))
+	s.Equal(2, s.goal.level(dayActivity{words: 2}))
+	s.Equal(3, s.goal.level(dayActivity{words: 2, questions: 1}))
+	s.Equal(3, s.goal.level(dayActivity{words: 10, questions: 1}))
+	s.Equal(4, s.goal.level(dayActivity{words: 4, questions: 2}))
+}
+
+// TestProgressCapsEachTarget tests that surplus words don't count toward missing questions
+func (s *streakTestSuite) TestProgressCapsEachTarget() {
+	s.Equal(66.7, s.goal.progress(dayActivity{words: 10}))
+	s.Equal(100.0, s.goal.progress(dayActivity{words: 10, questions: 3}))
+}
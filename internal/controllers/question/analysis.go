@@ -0,0 +1,256 @@
+package question
+
+import (
+	"math"
+	"sort"
+	"strings"
+	"time"
+
+	dbModels "word-flashcard/data/models"
+	"word-flashcard/data/schema"
+	"word-flashcard/internal/controllers/common"
+	"word-flashcard/internal/models"
+)
+
+const (
+	// discriminationGroupShare is the share of answers in each of the strong
+	// and weak groups, the classic upper and lower 27%
+	discriminationGroupShare = 0.27
+
+	// minDiscriminationAnswers is how many comparable answers a question
+	// needs before strong and weak answers are told apart
+	minDiscriminationAnswers = 10
+
+	// minSuspectAnswers is how many answers a question needs before a
+	// distractor outdrawing the key flags the key as suspect
+	minSuspectAnswers = 5
+
+	// maxAnalysisQuestions caps the limit query parameter of the analysis report
+	maxAnalysisQuestions = 1000
+)
+
+// analysisQuestionColumns returns the questions columns needed to analyze options
+func analysisQuestionColumns() []*string {
+	columns := []string{
+		schema.QUESTION_ID,
+		schema.QUESTION_QUESTION,
+		schema.QUESTION_OPTION_A,
+		schema.QUESTION_OPTION_B,
+		schema.QUESTION_OPTION_C,
+		schema.QUESTION_OPTION_D,
+		schema.QUESTION_ANSWER,
+		schema.QUESTION_QUESTION_TYPE,
+	}
+	ptrs := make([]*string, len(columns))
+	for i := range columns {
+		ptrs[i] = &columns[i]
+	}
+	return ptrs
+}
+
+// analysisLogColumns returns the question_answer_logs columns needed to analyze options
+func analysisLogColumns() []*string {
+	columns := []string{
+		schema.QUESTION_ANSWER_LOG_QUESTION_ID,
+		schema.QUESTION_ANSWER_LOG_SELECTED_OPTION,
+		schema.QUESTION_ANSWER_LOG_IS_CORRECT,
+		schema.COMMON_CREATED_AT,
+	}
+	ptrs := make([]*string, len(columns))
+	for i := range columns {
+		ptrs[i] = &columns[i]
+	}
+	return ptrs
+}
+
+// dayTotals is how many questions were answered on one day, and how many correctly
+type dayTotals struct {
+	answers int
+	correct int
+}
+
+// dailyTotals keys the per-day answer stats by "YYYY-MM-DD"
+func dailyTotals(stats []*dbModels.QuestionAnswerLogDailyStats) map[string]dayTotals {
+	totals := make(map[string]dayTotals, len(stats))
+	for _, stat := range stats {
+		if stat.Day == nil || stat.AnswerCount == nil {
+			continue
+		}
+		day := dayTotals{answers: *stat.AnswerCount}
+		if stat.CorrectCount != nil {
+			day.correct = *stat.CorrectCount
+		}
+		totals[*stat.Day] = day
+	}
+	return totals
+}
+
+// analysisOption is one of a question's option_a-d columns
+type analysisOption struct {
+	letter string
+	text   string
+}
+
+// analysisOptions returns a question's filled option_a-d columns in order
+func analysisOptions(question *dbModels.Question) []analysisOption {
+	var options []analysisOption
+	for i, text := range []*string{question.OptionA, question.OptionB, question.OptionC, question.OptionD} {
+		if text != nil && *text != "" {
+			options = append(options, analysisOption{letter: listAnswer[i], text: *text})
+		}
+	}
+	return options
+}
+
+// scoredAnswer is one answer to a question with the learner's form that
+// day: the correct rate of every other answer given the same day
+type scoredAnswer struct {
+	option  string
+	correct bool
+	score   float64
+	at      time.Time
+}
+
+// scoreAnswers pairs each answer with the learner's form on its day, in
+// loc. An answer that was the only one of its day has nothing to compare
+// against and is left out.
+func scoreAnswers(logs []*dbModels.QuestionAnswerLog, days map[string]dayTotals, loc *time.Location) []scoredAnswer {
+	var scored []scoredAnswer
+	for _, log := range logs {
+		if log.SelectedOption == nil || *log.SelectedOption == "" || log.CreatedAt == nil {
+			continue
+		}
+		correct := log.IsCorrect != nil && *log.IsCorrect
+		day := days[log.CreatedAt.In(loc).Format("2006-01-02")]
+		others := day.answers - 1
+		if others < 1 {
+			continue
+		}
+		othersCorrect := day.correct
+		if correct {
+			othersCorrect--
+		}
+		scored = append(scored, scoredAnswer{
+			option:  strings.ToUpper(*log.SelectedOption),
+			correct: correct,
+			score:   float64(othersCorrect) / float64(others),
+			at:      *log.CreatedAt,
+		})
+	}
+	return scored
+}
+
+// strongAndWeak splits scored answers into the strongest and weakest
+// discriminationGroupShare of them, or returns nil groups when there are
+// fewer than minDiscriminationAnswers
+func strongAndWeak(scored []scoredAnswer) ([]scoredAnswer, []scoredAnswer) {
+	if len(scored) < minDiscriminationAnswers {
+		return nil, nil
+	}
+	sorted := append([]scoredAnswer(nil), scored...)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		if sorted[i].score != sorted[j].score {
+			return sorted[i].score > sorted[j].score
+		}
+		return sorted[i].at.After(sorted[j].at)
+	})
+	size := max(1, int(math.Round(float64(len(sorted))*discriminationGroupShare)))
+	return sorted[:size], sorted[len(sorted)-size:]
+}
+
+// shareOf returns the fraction of answers matching match
+func shareOf(answers []scoredAnswer, match func(scoredAnswer) bool) float64 {
+	count := 0
+	for _, answer := range answers {
+		if match(answer) {
+			count++
+		}
+	}
+	return float64(count) / float64(len(answers))
+}
+
+// analyzeQuestion builds the distractor analysis of a choice question from
+// its answer logs and the per-day answer totals of the whole collection
+func analyzeQuestion(question *dbModels.Question, logs []*dbModels.QuestionAnswerLog, days map[string]dayTotals, loc *time.Location) models.QuestionAnalysis {
+	analysis := models.QuestionAnalysis{
+		QuestionType: (&models.Question{QuestionType: question.QuestionType}).Type(),
+		Options:      []models.OptionAnalysis{},
+	}
+	if question.Id != nil {
+		analysis.QuestionID = *question.Id
+	}
+	if question.Question != nil {
+		analysis.Question = *question.Question
+	}
+	if question.Answer != nil {
+		analysis.Answer = strings.ToUpper(*question.Answer)
+	}
+
+	counts := make(map[string]int)
+	correct := 0
+	for _, log := range logs {
+		if log.SelectedOption == nil || *log.SelectedOption == "" {
+			continue
+		}
+		counts[strings.ToUpper(*log.SelectedOption)]++
+		analysis.AnswerCount++
+		if log.IsCorrect != nil && *log.IsCorrect {
+			correct++
+		}
+	}
+	if analysis.AnswerCount > 0 {
+		analysis.CorrectRate = common.Round1(float64(correct) * 100 / float64(analysis.AnswerCount))
+	}
+
+	strong, weak := strongAndWeak(scoreAnswers(logs, days, loc))
+	if strong != nil {
+		isCorrect := func(answer scoredAnswer) bool { return answer.correct }
+		index := common.Round2(shareOf(strong, isCorrect) - shareOf(weak, isCorrect))
+		analysis.DiscriminationIndex = &index
+	}
+
+	keyCount, topDistractorCount := counts[analysis.Answer], 0
+	for _, option := range analysisOptions(question) {
+		optionAnalysis := models.OptionAnalysis{
+			Option: option.letter,
+			Text:   option.text,
+			IsKey:  option.letter == analysis.Answer,
+			Count:  counts[option.letter],
+		}
+		if analysis.AnswerCount > 0 {
+			optionAnalysis.Share = common.Round1(float64(optionAnalysis.Count) * 100 / float64(analysis.AnswerCount))
+		}
+		if strong != nil {
+			picked := func(answer scoredAnswer) bool { return answer.option == option.letter }
+			discrimination := common.Round2(shareOf(strong, picked) - shareOf(weak, picked))
+			optionAnalysis.Discrimination = &discrimination
+		}
+		if !optionAnalysis.IsKey && optionAnalysis.Count > topDistractorCount {
+			topDistractorCount = optionAnalysis.Count
+			analysis.MostAttractiveDistractor = &option.letter
+		}
+		analysis.Options = append(analysis.Options, optionAnalysis)
+	}
+	analysis.SuspectAnswerKey = analysis.AnswerCount >= minSuspectAnswers && topDistractorCount > keyCount
+
+	return analysis
+}
+
+// sortAnalyses orders analyses with suspect answer keys first, then the
+// least discriminating first, questions too little answered to have an
+// index last, and ties by question ID
+func sortAnalyses(analyses []models.QuestionAnalysis) {
+	sort.SliceStable(analyses, func(i, j int) bool {
+		a, b := analyses[i], analyses[j]
+		if a.SuspectAnswerKey != b.SuspectAnswerKey {
+			return a.SuspectAnswerKey
+		}
+		if (a.DiscriminationIndex == nil) != (b.DiscriminationIndex == nil) {
+			return a.DiscriminationIndex != nil
+		}
+		if a.DiscriminationIndex != nil && *a.DiscriminationIndex != *b.DiscriminationIndex {
+			return *a.DiscriminationIndex < *b.DiscriminationIndex
+		}
+		return a.QuestionID < b.QuestionID
+	})
+}
@@ -0,0 +1,156 @@
+package question
+
+import (
+	"time"
+
+	dbModels "word-flashcard/data/models"
+	"word-flashcard/internal/models"
+	"word-flashcard/utils"
+)
+
+// analysisStart is the first day answers are logged on in the analysis tests
+var analysisStart = time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)
+
+// sampleAnalysisQuestion returns a choice question keyed A with three options
+func sampleAnalysisQuestion() *dbModels.Question {
+	return &dbModels.Question{
+		Id:       utils.IntPtr(7),
+		Question: utils.StrPtr("Pick the synonym of 'rapid'"),
+		OptionA:  utils.StrPtr("fast"),
+		OptionB:  utils.StrPtr("slow"),
+		OptionC:  utils.StrPtr("late"),
+		OptionD:  utils.StrPtr(""),
+		Answer:   utils.StrPtr("A"),
+	}
+}
+
+// analysisLog returns an answer log of option logged daysIn days after analysisStart
+func analysisLog(option string, correct bool, daysIn int) *dbModels.QuestionAnswerLog {
+	at := analysisStart.AddDate(0, 0, daysIn)
+	return &dbModels.QuestionAnswerLog{SelectedOption: &option, IsCorrect: &correct, CreatedAt: &at}
+}
+
+// analysisFixture returns 12 answers on 12 days: the first 6, on days the
+// rest of the collection went well, picked the key; the last 6, on days it
+// went badly, picked B four times and C twice
+func analysisFixture() ([]*dbModels.QuestionAnswerLog, map[string]dayTotals) {
+	var logs []*dbModels.QuestionAnswerLog
+	days := make(map[string]dayTotals)
+	for i := 0; i < 12; i++ {
+		day := analysisStart.AddDate(0, 0, i).Format("2006-01-02")
+		switch {
+		case i < 6:
+			logs = append(logs, analysisLog("A", true, i))
+			days[day] = dayTotals{answers: 11, correct: 10}
+		case i < 10:
+			logs = append(logs, analysisLog("B", false, i))
+			days[day] = dayTotals{answers: 11, correct: 2}
+		default:
+			logs = append(logs, analysisLog("C", false, i))
+			days[day] = dayTotals{answers: 11, correct: 2}
+		}
+	}
+	return logs, days
+}
+
+// TestAnalyzeQuestion tests option shares, the most attractive distractor
+// and discrimination of a question that tells strong and weak answers apart
+func (suite *HelperTestSuite) TestAnalyzeQuestion() {
+	logs, days := analysisFixture()
+
+	analysis := analyzeQuestion(sampleAnalysisQuestion(), logs, days, time.UTC)
+
+	suite.Equal(7, analysis.QuestionID)
+	suite.Equal("single_choice", analysis.QuestionType)
+	suite.Equal(12, analysis.AnswerCount)
+	suite.Equal(50.0, analysis.CorrectRate)
+	suite.Require().NotNil(analysis.MostAttractiveDistractor)
+	suite.Equal("B", *analysis.MostAttractiveDistractor)
+	suite.Require().NotNil(analysis.DiscriminationIndex)
+	suite.Equal(1.0, *analysis.DiscriminationIndex)
+	suite.False(analysis.SuspectAnswerKey)
+
+	suite.Require().Len(analysis.Options, 3)
+	expected := []struct {
+		option         string
+		isKey          bool
+		count          int
+		share          float64
+		discrimination float64
+	}{
+		{"A", true, 6, 50, 1},
+		{"B", false, 4, 33.3, -1},
+		{"C", false, 2, 16.7, 0},
+	}
+	for i, want := range expected {
+		got := analysis.Options[i]
+		suite.Equal(want.option, got.Option)
+		suite.Equal(want.isKey, got.IsKey)
+		suite.Equal(want.count, got.Count)
+		suite.Equal(want.share, got.Share)
+		suite.Require().NotNil(got.Discrimination)
+		suite.Equal(want.discrimination, *got.Discrimination)
+	}
+}
+
+// TestAnalyzeQuestionSuspectKey tests that a distractor outdrawing the key
+// flags the key, and too few answers leave discrimination null
+func (suite *HelperTestSuite) TestAnalyzeQuestionSuspectKey() {
+	logs := []*dbModels.QuestionAnswerLog{analysisLog("A", true, 0)}
+	for i := 1; i < 5; i++ {
+		logs = append(logs, analysisLog("b", false, i))
+	}
+
+	analysis := analyzeQuestion(sampleAnalysisQuestion(), logs, map[string]dayTotals{}, time.UTC)
+
+	suite.True(analysis.SuspectAnswerKey)
+	suite.Equal("B", *analysis.MostAttractiveDistractor)
+	suite.Nil(analysis.DiscriminationIndex)
+	suite.Nil(analysis.Options[0].Discrimination)
+}
+
+// TestAnalyzeQuestionUnanswered tests that an unanswered question has zero
+// shares, no distractor and no flag
+func (suite *HelperTestSuite) TestAnalyzeQuestionUnanswered() {
+	analysis := analyzeQuestion(sampleAnalysisQuestion(), nil, nil, time.UTC)
+
+	suite.Equal(0, analysis.AnswerCount)
+	suite.Nil(analysis.MostAttractiveDistractor)
+	suite.False(analysis.SuspectAnswerKey)
+	suite.Len(analysis.Options, 3)
+}
+
+// TestScoreAnswers tests that an answer's own result is left out of its
+// day's form and lone answers of a day are skipped
+func (suite *HelperTestSuite) TestScoreAnswers() {
+	logs := []*dbModels.QuestionAnswerLog{analysisLog("A", true, 0), analysisLog("B", false, 1)}
+	days := map[string]dayTotals{
+		analysisStart.Format("2006-01-02"):                  {answers: 5, correct: 3},
+		analysisStart.AddDate(0, 0, 1).Format("2006-01-02"): {answers: 1},
+	}
+
+	scored := scoreAnswers(logs, days, time.UTC)
+
+	suite.Require().Len(scored, 1)
+	suite.Equal(0.5, scored[0].score)
+}
+
+// TestSortAnalyses tests suspect keys first, then ascending discrimination
+// with missing indices last
+func (suite *HelperTestSuite) TestSortAnalyses() {
+	index := func(f float64) *float64 { return &f }
+	analyses := []models.QuestionAnalysis{
+		{QuestionID: 1},
+		{QuestionID: 2, DiscriminationIndex: index(0.4)},
+		{QuestionID: 3, DiscriminationIndex: index(-0.2)},
+		{QuestionID: 4, SuspectAnswerKey: true},
+	}
+
+	sortAnalyses(analyses)
+
+	ids := make([]int, len(analyses))
+	for i, analysis := range analyses {
+		ids[i] = analysis.QuestionID
+	}
+	suite.Equal([]int{4, 3, 2, 1}, ids)
+}
@@ -3,6 +3,8 @@ package question
 import (
 	"word-flashcard/data/peers"
 	"word-flashcard/data/schema"
+	"word-flashcard/internal/leechdetect"
+	"word-flashcard/internal/links"
 )
 
 // questionSortableColumns defines the columns allowed in sort query parameters for the questions table.
@@ -16,31 +18,81 @@ var questionSortableColumns = []string{
 	schema.COMMON_UPDATED_AT,
 }
 
-// Controller handles question-related requests
+// Controller handles question-related requests. The word peers are only
+// read, as the source material for generated questions.
 type Controller struct {
 	questionPeer          peers.QuestionPeerInterface
+	questionOptionPeer    peers.QuestionOptionPeerInterface
 	questionAnswerLogPeer peers.QuestionAnswerLogPeerInterface
+	wordPeer              peers.WordPeerInterface
+	wordDefinitionPeer    peers.WordDefinitionsPeerInterface
+	reminderPeer          peers.ReminderPeerInterface
+	linkResolver          *links.LinkResolver
+	leechDetector         *leechdetect.LeechDetector
 }
 
 // New creates a new Controller instance
-func New(questionPeer peers.QuestionPeerInterface, questionAnswerLogPeer peers.QuestionAnswerLogPeerInterface) *Controller {
+func New(
+	questionPeer peers.QuestionPeerInterface,
+	questionOptionPeer peers.QuestionOptionPeerInterface,
+	questionAnswerLogPeer peers.QuestionAnswerLogPeerInterface,
+	wordPeer peers.WordPeerInterface,
+	wordDefinitionPeer peers.WordDefinitionsPeerInterface,
+	reminderPeer peers.ReminderPeerInterface,
+	linkResolver *links.LinkResolver,
+	leechDetector *leechdetect.LeechDetector,
+) *Controller {
 	return &Controller{
 		questionPeer:          questionPeer,
+		questionOptionPeer:    questionOptionPeer,
 		questionAnswerLogPeer: questionAnswerLogPeer,
+		wordPeer:              wordPeer,
+		wordDefinitionPeer:    wordDefinitionPeer,
+		reminderPeer:          reminderPeer,
+		linkResolver:          linkResolver,
+		leechDetector:         leechDetector,
 	}
 }
 
 // GetReelPeers returns the real database peers
-func GetReelPeers() (peers.QuestionPeerInterface, peers.QuestionAnswerLogPeerInterface, error) {
+func GetReelPeers() (
+	peers.QuestionPeerInterface,
+	peers.QuestionOptionPeerInterface,
+	peers.QuestionAnswerLogPeerInterface,
+	peers.WordPeerInterface,
+	peers.WordDefinitionsPeerInterface,
+	peers.ReminderPeerInterface,
+	error,
+) {
 	questionPeer, err := peers.NewQuestionPeer()
 	if err != nil {
-		return nil, nil, err
+		return nil, nil, nil, nil, nil, nil, err
+	}
+
+	questionOptionPeer, err := peers.NewQuestionOptionPeer()
+	if err != nil {
+		return nil, nil, nil, nil, nil, nil, err
 	}
 
 	questionAnswerLogPeer, err := peers.NewQuestionAnswerLogPeer()
 	if err != nil {
-		return nil, nil, err
+		return nil, nil, nil, nil, nil, nil, err
+	}
+
+	wordPeer, err := peers.NewWordPeer()
+	if err != nil {
+		return nil, nil, nil, nil, nil, nil, err
+	}
+
+	wordDefinitionPeer, err := peers.NewWordDefinitionsPeer()
+	if err != nil {
+		return nil, nil, nil, nil, nil, nil, err
+	}
+
+	reminderPeer, err := peers.NewReminderPeer()
+	if err != nil {
+		return nil, nil, nil, nil, nil, nil, err
 	}
 
-	return questionPeer, questionAnswerLogPeer, nil
+	return questionPeer, questionOptionPeer, questionAnswerLogPeer, wordPeer, wordDefinitionPeer, reminderPeer, nil
 }
@@ -5,6 +5,8 @@ import (
 	"time"
 	"word-flashcard/data/mocks"
 	dbModels "word-flashcard/data/models"
+	"word-flashcard/internal/leechdetect"
+	"word-flashcard/internal/links"
 	"word-flashcard/internal/models"
 	"word-flashcard/utils"
 
@@ -16,7 +18,15 @@ type ControllerTestSuite struct {
 	suite.Suite
 	controller                *Controller
 	mockQuestionPeer          *mocks.MockQuestionPeer
+	mockQuestionOptionPeer    *mocks.MockQuestionOptionPeer
 	mockQuestionAnswerLogPeer *mocks.MockQuestionAnswerLogPeer
+	mockWordPeer              *mocks.MockWordPeer
+	mockWordDefinitionPeer    *mocks.MockWordDefinitionsPeer
+	mockReminderPeer          *mocks.MockReminderPeer
+	mockEntityLinkPeer        *mocks.MockEntityLinkPeer
+	mockNotePeer              *mocks.MockNotePeer
+	mockSettingPeer           *mocks.MockSettingPeer
+	mockWordPracticeLogPeer   *mocks.MockWordPracticeLogPeer
 }
 
 // TestControllerTestSuite runs the ControllerTestSuite
@@ -27,8 +37,18 @@ func TestControllerTestSuite(t *testing.T) {
 // SetupTest sets up the test environment before each test
 func (suite *ControllerTestSuite) SetupTest() {
 	suite.mockQuestionPeer = mocks.NewMockQuestionPeer(suite.T())
+	suite.mockQuestionOptionPeer = mocks.NewMockQuestionOptionPeer(suite.T())
 	suite.mockQuestionAnswerLogPeer = mocks.NewMockQuestionAnswerLogPeer(suite.T())
-	suite.controller = New(suite.mockQuestionPeer, suite.mockQuestionAnswerLogPeer)
+	suite.mockWordPeer = mocks.NewMockWordPeer(suite.T())
+	suite.mockWordDefinitionPeer = mocks.NewMockWordDefinitionsPeer(suite.T())
+	suite.mockReminderPeer = mocks.NewMockReminderPeer(suite.T())
+	suite.mockEntityLinkPeer = mocks.NewMockEntityLinkPeer(suite.T())
+	suite.mockNotePeer = mocks.NewMockNotePeer(suite.T())
+	suite.mockSettingPeer = mocks.NewMockSettingPeer(suite.T())
+	suite.mockWordPracticeLogPeer = mocks.NewMockWordPracticeLogPeer(suite.T())
+	linkResolver := links.NewLinkResolver(suite.mockEntityLinkPeer, suite.mockWordPeer, suite.mockQuestionPeer, suite.mockNotePeer)
+	leechDetector := leechdetect.NewLeechDetector(suite.mockSettingPeer, suite.mockWordPeer, suite.mockQuestionPeer, suite.mockWordPracticeLogPeer, suite.mockQuestionAnswerLogPeer)
+	suite.controller = New(suite.mockQuestionPeer, suite.mockQuestionOptionPeer, suite.mockQuestionAnswerLogPeer, suite.mockWordPeer, suite.mockWordDefinitionPeer, suite.mockReminderPeer, linkResolver, leechDetector)
 }
 
 // getSampleQuestionAnswerLogs returns sample QuestionAnswerLog rows for testing
@@ -0,0 +1,241 @@
+package question
+
+import (
+	"fmt"
+	"math/rand"
+	"sort"
+	"strings"
+	"unicode/utf8"
+	dbModels "word-flashcard/data/models"
+	"word-flashcard/data/schema"
+	"word-flashcard/internal/controllers/common"
+	"word-flashcard/internal/models"
+	"word-flashcard/utils"
+	"word-flashcard/utils/database"
+
+	"github.com/Masterminds/squirrel"
+)
+
+// generatedOptionCount is the number of options (answer + distractors) a
+// generated question aims for, matching the option_a-d columns.
+const generatedOptionCount = 4
+
+// distractorPoolSize is how many candidate definitions are sampled per
+// generated question before ranking them by familiarity.
+const distractorPoolSize = 30
+
+// maxDistractorPoolSize caps the candidate definitions sampled for one
+// request, however many questions it generates.
+const maxDistractorPoolSize = 500
+
+// optionLetters maps an option index to its answer letter.
+var optionLetters = []string{"A", "B", "C", "D"}
+
+// familiarityRank orders familiarity levels so distractors can be ranked by
+// how close their word's familiarity is to the target word's.
+var familiarityRank = map[string]int{
+	schema.WORD_FAMILIARITY_RED:    0,
+	schema.WORD_FAMILIARITY_YELLOW: 1,
+	schema.WORD_FAMILIARITY_GREEN:  2,
+}
+
+// distractor is a candidate wrong option: another word together with one of
+// its definitions.
+type distractor struct {
+	wordID       int
+	word         string
+	definition   string
+	partOfSpeech string
+	familiarity  string
+}
+
+// fetchSourceWords returns the words to generate questions from: the listed
+// IDs when given, otherwise count random words.
+func (qc *Controller) fetchSourceWords(wordIDs []int, count int) ([]*dbModels.Word, error) {
+	if len(wordIDs) > 0 {
+		return qc.wordPeer.Select([]*string{}, squirrel.Eq{schema.WORD_ID: wordIDs}, nil, nil, nil)
+	}
+	orderBy := database.TERM_MAPPING_FUNC_RANDOM
+	limit := uint64(count)
+	return qc.wordPeer.Select([]*string{}, nil, []*string{&orderBy}, &limit, nil)
+}
+
+// fetchDefinitionsForWords returns every definition belonging to words.
+func (qc *Controller) fetchDefinitionsForWords(words []*dbModels.Word) ([]*dbModels.WordDefinition, error) {
+	if len(words) == 0 {
+		return nil, nil
+	}
+	var wordIDs []int
+	for _, w := range words {
+		wordIDs = append(wordIDs, *w.Id)
+	}
+	return qc.wordDefinitionPeer.Select([]*string{}, squirrel.Eq{schema.WORD_DEFINITIONS_WORD_ID: wordIDs}, nil, nil, nil)
+}
+
+// fetchDistractorPool samples definitions of other words once for the whole
+// request, sized for wordCount questions; pickDistractors then chooses each
+// question's wrong options from it in memory.
+func (qc *Controller) fetchDistractorPool(wordCount int) ([]distractor, error) {
+	if wordCount == 0 {
+		return nil, nil
+	}
+	orderBy := database.TERM_MAPPING_FUNC_RANDOM
+	limit := uint64(min(distractorPoolSize*wordCount, maxDistractorPoolSize))
+	defs, err := qc.wordDefinitionPeer.Select([]*string{}, nil, []*string{&orderBy}, &limit, nil)
+	if err != nil || len(defs) == 0 {
+		return nil, err
+	}
+
+	var wordIDs []int
+	for _, def := range defs {
+		if def.WordId != nil {
+			wordIDs = append(wordIDs, *def.WordId)
+		}
+	}
+	words, err := qc.wordPeer.Select([]*string{}, squirrel.Eq{schema.WORD_ID: wordIDs}, nil, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	wordsByID := make(map[int]*dbModels.Word, len(words))
+	for _, w := range words {
+		wordsByID[*w.Id] = w
+	}
+
+	var pool []distractor
+	for _, def := range defs {
+		if def.WordId == nil || def.Definition == nil || strings.TrimSpace(*def.Definition) == "" {
+			continue
+		}
+		w, ok := wordsByID[*def.WordId]
+		if !ok || w.Word == nil {
+			continue
+		}
+		d := distractor{wordID: *w.Id, word: *w.Word, definition: *def.Definition}
+		if def.PartOfSpeech != nil {
+			d.partOfSpeech = *def.PartOfSpeech
+		}
+		if w.Familiarity != nil {
+			d.familiarity = *w.Familiarity
+		}
+		pool = append(pool, d)
+	}
+	return pool, nil
+}
+
+// pickDistractors chooses up to n distractors from pool, preferring the same
+// part of speech and then the closest familiarity to the target word. Each
+// word and each option text is used at most once, and neither the target
+// word nor anything equal to the correct answer is picked.
+func pickDistractors(pool []distractor, target *dbModels.Word, partOfSpeech, answerDefinition string, n int) []distractor {
+	targetRank := familiarityRank[utils.DerefStr(target.Familiarity)]
+	distance := func(d distractor) int {
+		rank := familiarityRank[d.familiarity]
+		if rank > targetRank {
+			return rank - targetRank
+		}
+		return targetRank - rank
+	}
+
+	ranked := append([]distractor(nil), pool...)
+	rand.Shuffle(len(ranked), func(i, j int) { ranked[i], ranked[j] = ranked[j], ranked[i] })
+	sort.SliceStable(ranked, func(i, j int) bool {
+		iSame, jSame := ranked[i].partOfSpeech == partOfSpeech, ranked[j].partOfSpeech == partOfSpeech
+		if iSame != jSame {
+			return iSame
+		}
+		return distance(ranked[i]) < distance(ranked[j])
+	})
+
+	usedWords := map[string]bool{common.NormalizeAnswer(utils.DerefStr(target.Word)): true}
+	usedDefinitions := map[string]bool{common.NormalizeAnswer(answerDefinition): true}
+	var picked []distractor
+	for _, d := range ranked {
+		if len(picked) == n {
+			break
+		}
+		if d.wordID != 0 && d.wordID == *target.Id {
+			continue
+		}
+		wordKey, defKey := common.NormalizeAnswer(d.word), common.NormalizeAnswer(d.definition)
+		if usedWords[wordKey] || usedDefinitions[defKey] {
+			continue
+		}
+		usedWords[wordKey], usedDefinitions[defKey] = true, true
+		picked = append(picked, d)
+	}
+	return picked
+}
+
+// buildGeneratedQuestion assembles a question for word/definition with the
+// given distractors, placing the correct option at a random position.
+func buildGeneratedQuestion(word *dbModels.Word, def *dbModels.WordDefinition, distractors []distractor, direction string) *models.Question {
+	wordText := *word.Word
+	partOfSpeech := utils.DerefStr(def.PartOfSpeech)
+
+	var prompt, answerText string
+	var wrong []string
+	if direction == models.QuestionDirectionDefinitionToWord {
+		prompt = fmt.Sprintf("Which word (%s) matches this definition: %s", partOfSpeech, common.MaskWord(*def.Definition, wordText))
+		answerText = wordText
+		for _, d := range distractors {
+			wrong = append(wrong, d.word)
+		}
+	} else {
+		prompt = fmt.Sprintf("Which definition best matches \"%s\" (%s)?", wordText, partOfSpeech)
+		answerText = *def.Definition
+		for _, d := range distractors {
+			wrong = append(wrong, d.definition)
+		}
+	}
+
+	options := append([]string{answerText}, wrong...)
+	rand.Shuffle(len(options), func(i, j int) { options[i], options[j] = options[j], options[i] })
+	optionPtrs := make([]*string, len(optionLetters))
+	answer := ""
+	for i, option := range options {
+		if option == answerText && answer == "" {
+			answer = optionLetters[i]
+		}
+		optionPtrs[i] = utils.StrPtr(truncateText(option, 255))
+	}
+
+	return &models.Question{
+		Question:     utils.StrPtr(truncateText(prompt, 1024)),
+		OptionA:      optionPtrs[0],
+		OptionB:      optionPtrs[1],
+		OptionC:      optionPtrs[2],
+		OptionD:      optionPtrs[3],
+		Answer:       &answer,
+		Reference:    utils.StrPtr(truncateText(wordText, 255)),
+		QuestionType: utils.StrPtr(schema.QUESTION_TYPE_SINGLE_CHOICE),
+	}
+}
+
+// truncateText shortens s to at most maxBytes bytes without splitting a
+// UTF-8 sequence, marking the cut with an ellipsis.
+func truncateText(s string, maxBytes int) string {
+	if len(s) <= maxBytes {
+		return s
+	}
+	const ellipsis = "…"
+	cut := maxBytes - len(ellipsis)
+	for cut > 0 && !utf8.RuneStart(s[cut]) {
+		cut--
+	}
+	return s[:cut] + ellipsis
+}
+
+// pickSourceDefinition picks a random non-empty definition of word, or nil
+// when it has none.
+func pickSourceDefinition(word *dbModels.Word, defs []*dbModels.WordDefinition) *dbModels.WordDefinition {
+	var usable []*dbModels.WordDefinition
+	for _, def := range defs {
+		if def.WordId != nil && *def.WordId == *word.Id && def.Definition != nil && strings.TrimSpace(*def.Definition) != "" {
+			usable = append(usable, def)
+		}
+	}
+	if len(usable) == 0 {
+		return nil
+	}
+	return usable[rand.Intn(len(usable))]
+}
@@ -0,0 +1,108 @@
+package question
+
+import (
+	"strings"
+	"unicode/utf8"
+
+	dbModels "word-flashcard/data/models"
+	"word-flashcard/internal/models"
+	"word-flashcard/utils"
+)
+
+// getSampleDistractorPool returns candidate distractors spanning parts of speech and familiarity levels
+func getSampleDistractorPool() []distractor {
+	return []distractor{
+		{word: "stone", definition: "a hard solid substance", partOfSpeech: "noun", familiarity: "green"},
+		{word: "pear", definition: "a sweet juicy fruit", partOfSpeech: "noun", familiarity: "red"},
+		{word: "run", definition: "to move fast on foot", partOfSpeech: "verb", familiarity: "red"},
+		{word: "plum", definition: "a small purple fruit", partOfSpeech: "noun", familiarity: "yellow"},
+		{word: "Pear", definition: "a duplicate of another word", partOfSpeech: "noun", familiarity: "red"},
+	}
+}
+
+// TestPickDistractors tests ranking by part of speech then familiarity, and de-duplication
+func (suite *HelperTestSuite) TestPickDistractors() {
+	target := &dbModels.Word{Id: utils.IntPtr(1), Word: utils.StrPtr("apple"), Familiarity: utils.StrPtr("red")}
+
+	picked := pickDistractors(getSampleDistractorPool(), target, "noun", "a round fruit", 3)
+
+	var words []string
+	for _, d := range picked {
+		words = append(words, d.word)
+	}
+	suite.Equal([]string{"pear", "plum", "stone"}, []string{strings.ToLower(words[0]), words[1], words[2]})
+}
+
+// TestPickDistractorsSkipsAnswer tests that the target word's own definitions and candidates equal to
+// the target word or answer are never picked
+func (suite *HelperTestSuite) TestPickDistractorsSkipsAnswer() {
+	target := &dbModels.Word{Id: utils.IntPtr(1), Word: utils.StrPtr("apple")}
+	pool := []distractor{
+		{wordID: 1, word: "apples", definition: "the tree bearing apples", partOfSpeech: "noun"},
+		{word: "Apple", definition: "another apple sense", partOfSpeech: "noun"},
+		{word: "fruit", definition: "A round fruit", partOfSpeech: "noun"},
+	}
+
+	suite.Empty(pickDistractors(pool, target, "noun", "a round fruit", 3))
+}
+
+// TestBuildGeneratedQuestion tests both generation directions
+func (suite *HelperTestSuite) TestBuildGeneratedQuestion() {
+	word := &dbModels.Word{Id: utils.IntPtr(1), Word: utils.StrPtr("apple")}
+	def := &dbModels.WordDefinition{WordId: utils.IntPtr(1), PartOfSpeech: utils.StrPtr("noun"), Definition: utils.StrPtr("an apple is a round fruit")}
+	distractors := getSampleDistractorPool()[:3]
+
+	optionsOf := func(q *models.Question) []string {
+		return []string{*q.OptionA, *q.OptionB, *q.OptionC, *q.OptionD}
+	}
+	answerOf := func(q *models.Question) string {
+		return optionsOf(q)[strings.Index("ABCD", *q.Answer)]
+	}
+
+	suite.Run("word to definition", func() {
+		q := buildGeneratedQuestion(word, def, distractors, models.QuestionDirectionWordToDefinition)
+		suite.Equal("Which definition best matches \"apple\" (noun)?", *q.Question)
+		suite.Equal("an apple is a round fruit", answerOf(q))
+		suite.ElementsMatch([]string{"an apple is a round fruit", "a hard solid substance", "a sweet juicy fruit", "to move fast on foot"}, optionsOf(q))
+		suite.Equal("apple", *q.Reference)
+		suite.Nil(q.ID)
+	})
+
+	suite.Run("definition to word", func() {
+		q := buildGeneratedQuestion(word, def, distractors, models.QuestionDirectionDefinitionToWord)
+		suite.Equal("Which word (noun) matches this definition: an ____ is a round fruit", *q.Question)
+		suite.Equal("apple", answerOf(q))
+		suite.ElementsMatch([]string{"apple", "stone", "pear", "run"}, optionsOf(q))
+	})
+
+	suite.Run("fewer distractors leave options empty", func() {
+		q := buildGeneratedQuestion(word, def, distractors[:1], models.QuestionDirectionDefinitionToWord)
+		suite.Nil(q.OptionC)
+		suite.Nil(q.OptionD)
+		suite.Contains([]string{"A", "B"}, *q.Answer)
+	})
+}
+
+// TestTruncateText tests byte-bounded truncation that never splits a multi-byte rune
+func (suite *HelperTestSuite) TestTruncateText() {
+	suite.Equal("short", truncateText("short", 255))
+
+	long := strings.Repeat("蘋", 100)
+	result := truncateText(long, 255)
+	suite.LessOrEqual(len(result), 255)
+	suite.True(utf8.ValidString(result))
+	suite.True(strings.HasSuffix(result, "…"))
+}
+
+// TestPickSourceDefinition tests that only the word's own non-empty definitions are considered
+func (suite *HelperTestSuite) TestPickSourceDefinition() {
+	word := &dbModels.Word{Id: utils.IntPtr(1)}
+	defs := []*dbModels.WordDefinition{
+		{WordId: utils.IntPtr(1), Definition: utils.StrPtr(" ")},
+		{WordId: utils.IntPtr(2), Definition: utils.StrPtr("other word")},
+		{WordId: utils.IntPtr(1), Definition: utils.StrPtr("a round fruit")},
+	}
+
+	suite.Equal("a round fruit", *pickSourceDefinition(word, defs).Definition)
+	suite.Nil(pickSourceDefinition(word, defs[:2]))
+}
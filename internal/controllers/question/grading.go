@@ -0,0 +1,95 @@
+package question
+
+import (
+	dbModels "word-flashcard/data/models"
+	"word-flashcard/data/schema"
+	"word-flashcard/internal/controllers/common"
+	"word-flashcard/internal/models"
+	"word-flashcard/utils"
+)
+
+// gradeResponse reports whether response answers a question of questionType
+// whose stored options (in position order) are options. Types without
+// question_options are graded from selected_option instead and never reach
+// here, so they grade as incorrect.
+func gradeResponse(questionType string, options []*dbModels.QuestionOption, response *models.QuestionResponse) bool {
+	switch questionType {
+	case schema.QUESTION_TYPE_MULTIPLE_SELECT:
+		return gradeMultipleSelect(options, response.OptionIDs)
+	case schema.QUESTION_TYPE_ORDERING:
+		return gradeOrdering(options, response.OptionIDs)
+	case schema.QUESTION_TYPE_FREE_TEXT:
+		return gradeFreeText(options, utils.DerefStr(response.Text))
+	case schema.QUESTION_TYPE_MATCHING:
+		return gradeMatching(options, response.Pairs)
+	default:
+		return false
+	}
+}
+
+// gradeMultipleSelect is correct when exactly the options marked is_correct
+// were selected, in any order.
+func gradeMultipleSelect(options []*dbModels.QuestionOption, selectedIDs []int) bool {
+	selected := make(map[int]bool, len(selectedIDs))
+	for _, id := range selectedIDs {
+		selected[id] = true
+	}
+	if len(selected) != len(selectedIDs) {
+		return false
+	}
+
+	matched := 0
+	for _, option := range options {
+		isCorrect := option.IsCorrect != nil && *option.IsCorrect
+		if selected[*option.Id] != isCorrect {
+			return false
+		}
+		if isCorrect {
+			matched++
+		}
+	}
+	return matched == len(selected)
+}
+
+// gradeOrdering is correct when every option was placed in position order.
+func gradeOrdering(options []*dbModels.QuestionOption, orderedIDs []int) bool {
+	if len(orderedIDs) != len(options) {
+		return false
+	}
+	for i, option := range options {
+		if *option.Id != orderedIDs[i] {
+			return false
+		}
+	}
+	return true
+}
+
+// gradeFreeText is correct when text matches one of the accepted answers,
+// using the same normalisation and spelling variants as the spelling quiz.
+// A near miss still counts as incorrect.
+func gradeFreeText(options []*dbModels.QuestionOption, text string) bool {
+	var accepted []string
+	for _, option := range options {
+		accepted = append(accepted, common.AcceptedSpellings(utils.DerefStr(option.Content))...)
+	}
+	return common.GradeTypedAnswer(text, accepted).IsCorrect()
+}
+
+// gradeMatching is correct when every option was paired, once, with its own
+// match_content (compared case- and accent-insensitively).
+func gradeMatching(options []*dbModels.QuestionOption, pairs []models.QuestionMatchPair) bool {
+	if len(pairs) != len(options) {
+		return false
+	}
+	answered := make(map[int]string, len(pairs))
+	for _, pair := range pairs {
+		answered[pair.OptionID] = pair.Match
+	}
+	for _, option := range options {
+		match, ok := answered[*option.Id]
+		if !ok || common.NormalizeAnswer(match) != common.NormalizeAnswer(utils.DerefStr(option.MatchContent)) {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,112 @@
+package question
+
+import (
+	dbModels "word-flashcard/data/models"
+	"word-flashcard/data/schema"
+	"word-flashcard/internal/models"
+	"word-flashcard/utils"
+)
+
+// sampleOptions returns stored options with IDs 1..n for the given contents,
+// in position order
+func sampleOptions(contents ...string) []*dbModels.QuestionOption {
+	var options []*dbModels.QuestionOption
+	for i, content := range contents {
+		options = append(options, &dbModels.QuestionOption{
+			Id:       utils.IntPtr(i + 1),
+			Position: utils.IntPtr(i),
+			Content:  utils.StrPtr(content),
+		})
+	}
+	return options
+}
+
+// TestGradeMultipleSelect tests that exactly the correct options must be selected
+func (suite *HelperTestSuite) TestGradeMultipleSelect() {
+	options := sampleOptions("red", "loud", "blue")
+	options[0].IsCorrect = utils.BoolPtr(true)
+	options[1].IsCorrect = utils.BoolPtr(false)
+	options[2].IsCorrect = utils.BoolPtr(true)
+
+	testCases := []struct {
+		name     string
+		selected []int
+		expected bool
+	}{
+		{"all correct in any order", []int{3, 1}, true},
+		{"missing one", []int{1}, false},
+		{"includes a wrong option", []int{1, 2, 3}, false},
+		{"duplicate selection", []int{1, 1, 3}, false},
+		{"unknown option", []int{1, 3, 9}, false},
+		{"nothing selected", nil, false},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			response := &models.QuestionResponse{OptionIDs: tc.selected}
+			suite.Equal(tc.expected, gradeResponse(schema.QUESTION_TYPE_MULTIPLE_SELECT, options, response))
+		})
+	}
+}
+
+// TestGradeOrdering tests that options must be placed in position order
+func (suite *HelperTestSuite) TestGradeOrdering() {
+	options := sampleOptions("first", "second", "third")
+
+	suite.True(gradeResponse(schema.QUESTION_TYPE_ORDERING, options, &models.QuestionResponse{OptionIDs: []int{1, 2, 3}}))
+	suite.False(gradeResponse(schema.QUESTION_TYPE_ORDERING, options, &models.QuestionResponse{OptionIDs: []int{2, 1, 3}}))
+	suite.False(gradeResponse(schema.QUESTION_TYPE_ORDERING, options, &models.QuestionResponse{OptionIDs: []int{1, 2}}))
+}
+
+// TestGradeFreeText tests typed answers against every accepted answer
+func (suite *HelperTestSuite) TestGradeFreeText() {
+	options := sampleOptions("colour", "hue")
+
+	testCases := []struct {
+		text     string
+		expected bool
+	}{
+		{"colour", true},
+		{"  HUE ", true},
+		{"color", true},
+		{"colr", false},
+		{"", false},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.text, func() {
+			response := &models.QuestionResponse{Text: utils.StrPtr(tc.text)}
+			suite.Equal(tc.expected, gradeResponse(schema.QUESTION_TYPE_FREE_TEXT, options, response))
+		})
+	}
+}
+
+// TestGradeMatching tests that every option must be paired with its own match
+func (suite *HelperTestSuite) TestGradeMatching() {
+	options := sampleOptions("big", "quick")
+	options[0].MatchContent = utils.StrPtr("large")
+	options[1].MatchContent = utils.StrPtr("Fast")
+
+	testCases := []struct {
+		name     string
+		pairs    []models.QuestionMatchPair
+		expected bool
+	}{
+		{"all matched", []models.QuestionMatchPair{{OptionID: 2, Match: "fast"}, {OptionID: 1, Match: "Large"}}, true},
+		{"swapped", []models.QuestionMatchPair{{OptionID: 1, Match: "fast"}, {OptionID: 2, Match: "large"}}, false},
+		{"incomplete", []models.QuestionMatchPair{{OptionID: 1, Match: "large"}}, false},
+		{"same option twice", []models.QuestionMatchPair{{OptionID: 1, Match: "large"}, {OptionID: 1, Match: "large"}}, false},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			response := &models.QuestionResponse{Pairs: tc.pairs}
+			suite.Equal(tc.expected, gradeResponse(schema.QUESTION_TYPE_MATCHING, options, response))
+		})
+	}
+}
+
+// TestGradeResponseLegacyType tests that types without question_options never grade as correct
+func (suite *HelperTestSuite) TestGradeResponseLegacyType() {
+	suite.False(gradeResponse(schema.QUESTION_TYPE_SINGLE_CHOICE, nil, &models.QuestionResponse{OptionIDs: []int{1}}))
+}
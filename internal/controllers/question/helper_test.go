@@ -21,6 +21,9 @@ func TestHelperTestSuite(t *testing.T) {
 // SetupTest sets up the test environment before each test
 func (suite *HelperTestSuite) SetupTest() {
 	mockQuestionPeer := mocks.NewMockQuestionPeer(suite.T())
+	mockQuestionOptionPeer := mocks.NewMockQuestionOptionPeer(suite.T())
 	mockQuestionAnswerLogPeer := mocks.NewMockQuestionAnswerLogPeer(suite.T())
-	suite.controller = New(mockQuestionPeer, mockQuestionAnswerLogPeer)
+	mockWordPeer := mocks.NewMockWordPeer(suite.T())
+	mockWordDefinitionPeer := mocks.NewMockWordDefinitionsPeer(suite.T())
+	suite.controller = New(mockQuestionPeer, mockQuestionOptionPeer, mockQuestionAnswerLogPeer, mockWordPeer, mockWordDefinitionPeer, nil, nil, nil)
 }
@@ -16,4 +16,8 @@ type ControllerInterface interface {
 	StatsQuestions(c *gin.Context)
 	GetQuestionLogs(c *gin.Context)
 	GetQuestionsTrend(c *gin.Context)
+	GetQuestionsSpeed(c *gin.Context)
+	GenerateQuestions(c *gin.Context)
+	GetQuestionAnalysis(c *gin.Context)
+	GetQuestionsAnalysis(c *gin.Context)
 }
@@ -0,0 +1,147 @@
+package question
+
+import (
+	"fmt"
+	dbModels "word-flashcard/data/models"
+	"word-flashcard/data/schema"
+	"word-flashcard/internal/models"
+
+	"github.com/Masterminds/squirrel"
+)
+
+// trueFalseOptions are the option_a/option_b texts a true_false question is
+// stored with when the request doesn't supply its own wording.
+var trueFalseOptions = []string{"True", "False"}
+
+// applyQuestionTypeDefaults fills in what a new question's type implies but
+// the request may leave out: a missing type becomes single_choice, a
+// true_false question gets True/False as option_a/option_b, and types that
+// keep their options in question_options store empty option_a/answer so the
+// NOT NULL legacy columns stay satisfied.
+func applyQuestionTypeDefaults(question *models.Question) {
+	questionType := question.Type()
+	question.QuestionType = &questionType
+
+	switch {
+	case questionType == schema.QUESTION_TYPE_TRUE_FALSE:
+		if question.OptionA == nil {
+			question.OptionA = &trueFalseOptions[0]
+		}
+		if question.OptionB == nil {
+			question.OptionB = &trueFalseOptions[1]
+		}
+	case question.UsesOptionTable():
+		empty := ""
+		question.OptionA, question.Answer = &empty, &empty
+	}
+}
+
+// fetchOptions returns the question_options rows of questionIDs grouped by
+// question ID, each group in position order.
+func (qc *Controller) fetchOptions(questionIDs []int) (map[int][]*dbModels.QuestionOption, error) {
+	grouped := make(map[int][]*dbModels.QuestionOption)
+	if len(questionIDs) == 0 {
+		return grouped, nil
+	}
+
+	where := squirrel.Eq{schema.QUESTION_OPTION_QUESTION_ID: questionIDs}
+	orderBy := fmt.Sprintf("%s ASC", schema.QUESTION_OPTION_POSITION)
+	options, err := qc.questionOptionPeer.Select([]*string{}, where, []*string{&orderBy}, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	for _, option := range options {
+		if option.QuestionId != nil {
+			grouped[*option.QuestionId] = append(grouped[*option.QuestionId], option)
+		}
+	}
+	return grouped, nil
+}
+
+// attachOptions loads the options of every question whose type keeps them in
+// question_options. Legacy questions are left untouched, so a page of
+// single_choice questions costs no extra query.
+func (qc *Controller) attachOptions(questions []*models.Question) error {
+	var questionIDs []int
+	for _, question := range questions {
+		if question.ID != nil && question.UsesOptionTable() {
+			questionIDs = append(questionIDs, *question.ID)
+		}
+	}
+	if len(questionIDs) == 0 {
+		return nil
+	}
+
+	grouped, err := qc.fetchOptions(questionIDs)
+	if err != nil {
+		return err
+	}
+	for _, question := range questions {
+		if question.ID == nil || !question.UsesOptionTable() {
+			continue
+		}
+		question.Options = []models.QuestionOption{}
+		for _, option := range grouped[*question.ID] {
+			question.Options = append(question.Options, *new(models.QuestionOption).FromDataModel(option))
+		}
+	}
+	return nil
+}
+
+// optionDataModels converts options to question_options rows, numbering
+// their positions from the array order; the question ID is left for
+// QuestionPeer.InsertWithOptions to fill in.
+func optionDataModels(options []models.QuestionOption) []*dbModels.QuestionOption {
+	rows := make([]*dbModels.QuestionOption, len(options))
+	for i := range options {
+		rows[i] = options[i].ToDataModel(0, i)
+	}
+	return rows
+}
+
+// answerKey is what a quiz response to a question is graded and counted
+// against: its stored type, options and practice counters.
+type answerKey struct {
+	questionType         string
+	options              []*dbModels.QuestionOption
+	countPractise        int
+	countFailurePractise int
+}
+
+// countAnswer returns the question's practice counters with one more
+// answer, graded isCorrect, added to them.
+func (key *answerKey) countAnswer(isCorrect bool) (countPractise int, countFailurePractise int) {
+	countPractise, countFailurePractise = key.countPractise+1, key.countFailurePractise
+	if !isCorrect {
+		countFailurePractise++
+	}
+	return countPractise, countFailurePractise
+}
+
+// fetchAnswerKey returns the answerKey of questionID, or nil when the
+// question doesn't exist.
+func (qc *Controller) fetchAnswerKey(questionID int) (*answerKey, error) {
+	columns := []string{schema.QUESTION_QUESTION_TYPE, schema.QUESTION_COUNT_PRACTISE, schema.QUESTION_COUNT_FAILURE_PRACTISE}
+	questions, err := qc.questionPeer.Select([]*string{&columns[0], &columns[1], &columns[2]}, squirrel.Eq{schema.QUESTION_ID: questionID}, nil, nil, nil)
+	if err != nil || len(questions) == 0 {
+		return nil, err
+	}
+
+	key := &answerKey{questionType: new(models.Question).FromDataModel(questions[0]).Type()}
+	if questions[0].CountPractise != nil {
+		key.countPractise = *questions[0].CountPractise
+	}
+	if questions[0].CountFailurePractise != nil {
+		key.countFailurePractise = *questions[0].CountFailurePractise
+	}
+	if !models.QuestionTypeUsesOptionTable(key.questionType) {
+		return key, nil
+	}
+
+	grouped, err := qc.fetchOptions([]int{questionID})
+	if err != nil {
+		return nil, err
+	}
+	key.options = grouped[questionID]
+	return key, nil
+}
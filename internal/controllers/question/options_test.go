@@ -0,0 +1,128 @@
+package question
+
+import (
+	dbModels "word-flashcard/data/models"
+	"word-flashcard/data/schema"
+	"word-flashcard/internal/models"
+	"word-flashcard/utils"
+
+	"github.com/Masterminds/squirrel"
+	"github.com/stretchr/testify/mock"
+)
+
+// TestApplyQuestionTypeDefaults tests the fields each question type fills in
+func (suite *ControllerTestSuite) TestApplyQuestionTypeDefaults() {
+	suite.Run("missing type becomes single_choice", func() {
+		question := &models.Question{OptionA: utils.StrPtr("a")}
+		applyQuestionTypeDefaults(question)
+		suite.Equal(schema.QUESTION_TYPE_SINGLE_CHOICE, *question.QuestionType)
+		suite.Equal("a", *question.OptionA)
+	})
+	suite.Run("true_false gets True/False options", func() {
+		question := &models.Question{QuestionType: utils.StrPtr(schema.QUESTION_TYPE_TRUE_FALSE), OptionB: utils.StrPtr("No")}
+		applyQuestionTypeDefaults(question)
+		suite.Equal("True", *question.OptionA)
+		suite.Equal("No", *question.OptionB)
+	})
+	suite.Run("option table type empties option_a and answer", func() {
+		question := &models.Question{QuestionType: utils.StrPtr(schema.QUESTION_TYPE_ORDERING)}
+		applyQuestionTypeDefaults(question)
+		suite.Equal("", *question.OptionA)
+		suite.Equal("", *question.Answer)
+	})
+}
+
+// TestAttachOptions tests that options are loaded only for option table types and grouped per question
+func (suite *ControllerTestSuite) TestAttachOptions() {
+	questions := []*models.Question{
+		{ID: utils.IntPtr(1)},
+		{ID: utils.IntPtr(2), QuestionType: utils.StrPtr(schema.QUESTION_TYPE_ORDERING)},
+		{ID: utils.IntPtr(3), QuestionType: utils.StrPtr(schema.QUESTION_TYPE_FREE_TEXT)},
+	}
+	suite.mockQuestionOptionPeer.EXPECT().
+		Select(mock.Anything, squirrel.Eq{schema.QUESTION_OPTION_QUESTION_ID: []int{2, 3}}, mock.Anything, mock.Anything, mock.Anything).
+		Return([]*dbModels.QuestionOption{
+			{Id: utils.IntPtr(10), QuestionId: utils.IntPtr(2), Content: utils.StrPtr("first")},
+			{Id: utils.IntPtr(11), QuestionId: utils.IntPtr(2), Content: utils.StrPtr("second")},
+		}, nil).Times(1)
+
+	suite.Require().NoError(suite.controller.attachOptions(questions))
+	suite.Nil(questions[0].Options)
+	suite.Len(questions[1].Options, 2)
+	suite.Equal(10, *questions[1].Options[0].ID)
+	suite.Equal("second", *questions[1].Options[1].Content)
+	suite.NotNil(questions[2].Options)
+	suite.Empty(questions[2].Options)
+}
+
+// TestAttachOptionsLegacyOnly tests that a page of legacy questions costs no query
+func (suite *ControllerTestSuite) TestAttachOptionsLegacyOnly() {
+	questions := []*models.Question{{ID: utils.IntPtr(1)}, {ID: utils.IntPtr(2), QuestionType: utils.StrPtr(schema.QUESTION_TYPE_TRUE_FALSE)}}
+	suite.NoError(suite.controller.attachOptions(questions))
+	suite.mockQuestionOptionPeer.AssertNotCalled(suite.T(), "Select", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+}
+
+// TestFetchAnswerKey tests loading the stored type, options and counters a
+// response is graded and counted against
+func (suite *ControllerTestSuite) TestFetchAnswerKey() {
+	where := squirrel.Eq{schema.QUESTION_ID: 1}
+
+	suite.Run("option table type", func() {
+		suite.SetupTest()
+		suite.mockQuestionPeer.EXPECT().
+			Select(mock.Anything, where, mock.Anything, mock.Anything, mock.Anything).
+			Return([]*dbModels.Question{{
+				QuestionType:         utils.StrPtr(schema.QUESTION_TYPE_ORDERING),
+				CountPractise:        utils.IntPtr(4),
+				CountFailurePractise: utils.IntPtr(1),
+			}}, nil).Times(1)
+		suite.mockQuestionOptionPeer.EXPECT().
+			Select(mock.Anything, squirrel.Eq{schema.QUESTION_OPTION_QUESTION_ID: []int{1}}, mock.Anything, mock.Anything, mock.Anything).
+			Return([]*dbModels.QuestionOption{{Id: utils.IntPtr(7), QuestionId: utils.IntPtr(1)}}, nil).Times(1)
+
+		key, err := suite.controller.fetchAnswerKey(1)
+		suite.NoError(err)
+		suite.Require().NotNil(key)
+		suite.Equal(schema.QUESTION_TYPE_ORDERING, key.questionType)
+		suite.Len(key.options, 1)
+		suite.Equal(4, key.countPractise)
+		suite.Equal(1, key.countFailurePractise)
+	})
+	suite.Run("legacy type skips options", func() {
+		suite.SetupTest()
+		suite.mockQuestionPeer.EXPECT().
+			Select(mock.Anything, where, mock.Anything, mock.Anything, mock.Anything).
+			Return([]*dbModels.Question{{}}, nil).Times(1)
+
+		key, err := suite.controller.fetchAnswerKey(1)
+		suite.NoError(err)
+		suite.Require().NotNil(key)
+		suite.Equal(schema.QUESTION_TYPE_SINGLE_CHOICE, key.questionType)
+		suite.Nil(key.options)
+		suite.Zero(key.countPractise)
+	})
+	suite.Run("not found", func() {
+		suite.SetupTest()
+		suite.mockQuestionPeer.EXPECT().
+			Select(mock.Anything, where, mock.Anything, mock.Anything, mock.Anything).
+			Return([]*dbModels.Question{}, nil).Times(1)
+
+		key, err := suite.controller.fetchAnswerKey(1)
+		suite.NoError(err)
+		suite.Nil(key)
+	})
+}
+
+// TestAnswerKeyCountAnswer tests that every answer adds a practice and only
+// a wrong one adds a failure
+func (suite *ControllerTestSuite) TestAnswerKeyCountAnswer() {
+	key := &answerKey{countPractise: 4, countFailurePractise: 1}
+
+	countPractise, countFailurePractise := key.countAnswer(true)
+	suite.Equal(5, countPractise)
+	suite.Equal(1, countFailurePractise)
+
+	countPractise, countFailurePractise = key.countAnswer(false)
+	suite.Equal(5, countPractise)
+	suite.Equal(2, countFailurePractise)
+}
@@ -0,0 +1,77 @@
+package question
+
+import (
+	"fmt"
+	"net/http"
+	"time"
+	"word-flashcard/data/schema"
+	"word-flashcard/internal/controllers/common"
+	"word-flashcard/internal/models"
+
+	"github.com/Masterminds/squirrel"
+	"github.com/gin-gonic/gin"
+)
+
+// GetQuestionAnalysis @Summary Get the distractor analysis of a question
+// @Description Analyzes the answers to a single or true/false choice question: how often each option was selected, the most attractive
+// @Description wrong option, and a discrimination index comparing strong and weak answers. Answers are ranked strong or weak by the
+// @Description correct rate of every other question answered the same day (in the reporting timezone), and the top and bottom 27% are
+// @Description compared once there are at least 10 such answers. The answer key is flagged as suspect when, after at least 5 answers, a
+// @Description distractor was picked more often than the key.
+// @Tags questions
+// @Produce json
+// @Param id path int true "Question ID"
+// @Param tz query string false "IANA timezone to bucket days in (default: X-Timezone header, else the stored report timezone, else REPORT_TIMEZONE)"
+// @Param X-Timezone header string false "IANA timezone to bucket days in when tz is not given"
+// @Success 200 {object} models.QuestionAnalysis "Distractor analysis"
+// @Failure 400 {object} models.ErrorResponse "Bad request - Invalid question ID, timezone parameter, or a question type without option_a-d"
+// @Failure 404 {object} models.ErrorResponse "Not found - Question not found"
+// @Failure 500 {object} models.ErrorResponse "Internal server error - Failed to fetch data from database"
+// @Router /api/questions/{id}/analysis [get]
+func (qc *Controller) GetQuestionAnalysis(c *gin.Context) {
+	// ================ 1. Parse request parameters ================
+	questionID, err := common.ParseIDFromPath(c, "id")
+	if err != nil {
+		common.ResponseError(http.StatusBadRequest, "Invalid question ID.", models.ErrCodeInvalidRequest, err, c)
+		return
+	}
+	loc, err := common.RequestReportLocation(c)
+	if err != nil {
+		common.ResponseError(http.StatusBadRequest, "Invalid timezone parameter", models.ErrCodeInvalidRequest, err, c)
+		return
+	}
+
+	// ================ 2. Fetch the question ================
+	questions, err := qc.questionPeer.Select(analysisQuestionColumns(), squirrel.Eq{schema.QUESTION_ID: questionID}, nil, nil, nil)
+	if err != nil {
+		common.ResponseError(http.StatusInternalServerError, "Failed to fetch data from database", models.ErrCodeInternalError, err, c)
+		return
+	} else if len(questions) == 0 {
+		common.ResponseError(http.StatusNotFound, "Question not found", models.ErrCodeNotFound, nil, c)
+		return
+	}
+	question := questions[0]
+	if (&models.Question{QuestionType: question.QuestionType}).UsesOptionTable() {
+		common.ResponseError(http.StatusBadRequest, "Question type has no per-option analysis", models.ErrCodeInvalidRequest, nil, c)
+		return
+	}
+
+	// ================ 3. Fetch answers and daily form ================
+	orderBy := fmt.Sprintf("%s ASC", schema.COMMON_CREATED_AT)
+	logs, err := qc.questionAnswerLogPeer.Select(analysisLogColumns(), squirrel.Eq{schema.QUESTION_ANSWER_LOG_QUESTION_ID: questionID}, []*string{&orderBy}, nil, nil)
+	if err != nil {
+		common.ResponseError(http.StatusInternalServerError, "Failed to fetch data from database", models.ErrCodeInternalError, err, c)
+		return
+	}
+
+	// Day totals span the whole history, one row per active day, since any
+	// day the question was answered on may be compared
+	dailyStats, err := qc.questionAnswerLogPeer.DailyStats(time.Unix(0, 0), loc)
+	if err != nil {
+		common.ResponseError(http.StatusInternalServerError, "Failed to fetch data from database", models.ErrCodeInternalError, err, c)
+		return
+	}
+
+	// ================ 4. Analyze & send response ================
+	common.ResponseSuccess(http.StatusOK, analyzeQuestion(question, logs, dailyTotals(dailyStats), loc), c)
+}
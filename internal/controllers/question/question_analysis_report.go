@@ -0,0 +1,109 @@
+package question
+
+import (
+	"fmt"
+	"net/http"
+	"time"
+	dbModels "word-flashcard/data/models"
+	"word-flashcard/data/schema"
+	"word-flashcard/internal/controllers/common"
+	"word-flashcard/internal/models"
+
+	"github.com/Masterminds/squirrel"
+	"github.com/gin-gonic/gin"
+)
+
+// GetQuestionsAnalysis @Summary Get the distractor analysis of all questions
+// @Description Runs the per-option distractor analysis of GET /api/questions/{id}/analysis over every answered single or true/false
+// @Description choice question, over the answers of the last N days. Questions with a suspect answer key come first, then the
+// @Description least discriminating.
+// @Tags questions
+// @Produce json
+// @Param days query int false "Only analyze answers from the last N days (default: 365, max: 3650)"
+// @Param limit query int false "Number of questions to return (default: 50, max: 1000)"
+// @Param tz query string false "IANA timezone to bucket days in (default: X-Timezone header, else the stored report timezone, else REPORT_TIMEZONE)"
+// @Param X-Timezone header string false "IANA timezone to bucket days in when tz is not given"
+// @Success 200 {object} models.QuestionAnalysisReport "Collection-wide distractor analysis"
+// @Failure 400 {object} models.ErrorResponse "Bad request - Invalid days, limit or timezone parameter"
+// @Failure 500 {object} models.ErrorResponse "Internal server error - Failed to fetch data from database"
+// @Router /api/questions/analysis [get]
+func (qc *Controller) GetQuestionsAnalysis(c *gin.Context) {
+	// ================ 1. Parse query parameters ================
+	days, err := common.ParseIntQueryParam(c, "days", 365)
+	if err != nil || days < 1 || days > common.MaxTrendDays {
+		common.ResponseError(http.StatusBadRequest, "Invalid days parameter", models.ErrCodeInvalidRequest, err, c)
+		return
+	}
+	limit, err := common.ParseIntQueryParam(c, "limit", 50)
+	if err != nil || limit < 1 || limit > maxAnalysisQuestions {
+		common.ResponseError(http.StatusBadRequest, "Invalid limit parameter", models.ErrCodeInvalidRequest, err, c)
+		return
+	}
+	loc, err := common.RequestReportLocation(c)
+	if err != nil {
+		common.ResponseError(http.StatusBadRequest, "Invalid timezone parameter", models.ErrCodeInvalidRequest, err, c)
+		return
+	}
+	since := time.Now().UTC().AddDate(0, 0, -days)
+
+	// ================ 2. Fetch choice questions and their answers ================
+	questions, err := qc.questionPeer.Select(analysisQuestionColumns(), squirrel.Or{
+		squirrel.Eq{schema.QUESTION_QUESTION_TYPE: []string{schema.QUESTION_TYPE_SINGLE_CHOICE, schema.QUESTION_TYPE_TRUE_FALSE, ""}},
+		squirrel.Eq{schema.QUESTION_QUESTION_TYPE: nil},
+	}, nil, nil, nil)
+	if err != nil {
+		common.ResponseError(http.StatusInternalServerError, "Failed to fetch data from database", models.ErrCodeInternalError, err, c)
+		return
+	}
+
+	// Only answers that picked an option_a-d column; option-table types log
+	// an empty selected_option
+	orderBy := fmt.Sprintf("%s ASC, %s ASC", schema.QUESTION_ANSWER_LOG_QUESTION_ID, schema.COMMON_CREATED_AT)
+	logWhere := squirrel.And{
+		squirrel.NotEq{schema.QUESTION_ANSWER_LOG_SELECTED_OPTION: ""},
+		squirrel.GtOrEq{schema.COMMON_CREATED_AT: since},
+	}
+	logs, err := qc.questionAnswerLogPeer.Select(analysisLogColumns(), logWhere, []*string{&orderBy}, nil, nil)
+	if err != nil {
+		common.ResponseError(http.StatusInternalServerError, "Failed to fetch data from database", models.ErrCodeInternalError, err, c)
+		return
+	}
+
+	dailyStats, err := qc.questionAnswerLogPeer.DailyStats(since, loc)
+	if err != nil {
+		common.ResponseError(http.StatusInternalServerError, "Failed to fetch data from database", models.ErrCodeInternalError, err, c)
+		return
+	}
+
+	// ================ 3. Analyze each answered question ================
+	logsByQuestion := make(map[int][]*dbModels.QuestionAnswerLog)
+	for _, log := range logs {
+		if log.QuestionId != nil {
+			logsByQuestion[*log.QuestionId] = append(logsByQuestion[*log.QuestionId], log)
+		}
+	}
+	totals := dailyTotals(dailyStats)
+
+	report := models.QuestionAnalysisReport{PeriodDays: days, Questions: []models.QuestionAnalysis{}}
+	for _, question := range questions {
+		if question.Id == nil {
+			continue
+		}
+		analysis := analyzeQuestion(question, logsByQuestion[*question.Id], totals, loc)
+		if analysis.AnswerCount == 0 {
+			continue
+		}
+		if analysis.SuspectAnswerKey {
+			report.SuspectCount++
+		}
+		report.Questions = append(report.Questions, analysis)
+	}
+	report.AnalyzedCount = len(report.Questions)
+
+	// ================ 4. Sort, limit & send response ================
+	sortAnalyses(report.Questions)
+	if len(report.Questions) > limit {
+		report.Questions = report.Questions[:limit]
+	}
+	common.ResponseSuccess(http.StatusOK, report, c)
+}
@@ -0,0 +1,92 @@
+package question
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"time"
+
+	dbModels "word-flashcard/data/models"
+	"word-flashcard/internal/models"
+	"word-flashcard/utils"
+
+	"github.com/Masterminds/squirrel"
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+// TestGetQuestionsAnalysis tests that the report analyzes every answered
+// question, skips unanswered ones and lists suspect answer keys first
+func (suite *ControllerTestSuite) TestGetQuestionsAnalysis() {
+	now := time.Now().UTC()
+	inWindow := func(since time.Time) bool {
+		return since.After(now.AddDate(0, 0, -31)) && since.Before(now.AddDate(0, 0, -29))
+	}
+	sound := sampleAnalysisQuestion()
+	suspect := sampleAnalysisQuestion()
+	suspect.Id = utils.IntPtr(8)
+	unanswered := sampleAnalysisQuestion()
+	unanswered.Id = utils.IntPtr(9)
+
+	logs := []*dbModels.QuestionAnswerLog{analysisLog("A", true, 0)}
+	for i := 0; i < 6; i++ {
+		log := analysisLog("B", false, i)
+		log.QuestionId = utils.IntPtr(8)
+		logs = append(logs, log)
+	}
+	logs[0].QuestionId = utils.IntPtr(7)
+
+	suite.mockQuestionPeer.EXPECT().
+		Select(mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+		Return([]*dbModels.Question{sound, suspect, unanswered}, nil).Times(1)
+	suite.mockQuestionAnswerLogPeer.EXPECT().
+		Select(mock.Anything, mock.MatchedBy(func(where squirrel.Sqlizer) bool {
+			_, args, err := where.ToSql()
+			if err != nil || len(args) != 2 {
+				return false
+			}
+			since, ok := args[1].(time.Time)
+			return ok && inWindow(since)
+		}), mock.Anything, mock.Anything, mock.Anything).
+		Return(logs, nil).Times(1)
+	suite.mockQuestionAnswerLogPeer.EXPECT().
+		DailyStats(mock.MatchedBy(inWindow), mock.Anything).
+		Return([]*dbModels.QuestionAnswerLogDailyStats{}, nil).Times(1)
+
+	w := httptest.NewRecorder()
+	ctx, _ := gin.CreateTestContext(w)
+	ctx.Request = httptest.NewRequest(http.MethodGet, "/api/questions/analysis?days=30", nil)
+	suite.controller.GetQuestionsAnalysis(ctx)
+
+	assert.Equal(suite.T(), http.StatusOK, w.Code)
+	var report models.QuestionAnalysisReport
+	suite.Require().NoError(json.Unmarshal(w.Body.Bytes(), &report))
+	assert.Equal(suite.T(), 30, report.PeriodDays)
+	assert.Equal(suite.T(), 2, report.AnalyzedCount)
+	assert.Equal(suite.T(), 1, report.SuspectCount)
+	suite.Require().Len(report.Questions, 2)
+	assert.Equal(suite.T(), 8, report.Questions[0].QuestionID)
+	assert.True(suite.T(), report.Questions[0].SuspectAnswerKey)
+	assert.Equal(suite.T(), 7, report.Questions[1].QuestionID)
+}
+
+// TestGetQuestionsAnalysisInvalidLimit tests that an out-of-range limit returns 400
+func (suite *ControllerTestSuite) TestGetQuestionsAnalysisInvalidLimit() {
+	w := httptest.NewRecorder()
+	ctx, _ := gin.CreateTestContext(w)
+	ctx.Request = httptest.NewRequest(http.MethodGet, "/api/questions/analysis?limit=1001", nil)
+	suite.controller.GetQuestionsAnalysis(ctx)
+
+	assert.Equal(suite.T(), http.StatusBadRequest, w.Code)
+}
+
+// TestGetQuestionsAnalysisInvalidDays tests that an out-of-range days returns 400
+func (suite *ControllerTestSuite) TestGetQuestionsAnalysisInvalidDays() {
+	w := httptest.NewRecorder()
+	ctx, _ := gin.CreateTestContext(w)
+	ctx.Request = httptest.NewRequest(http.MethodGet, "/api/questions/analysis?days=0", nil)
+	suite.controller.GetQuestionsAnalysis(ctx)
+
+	assert.Equal(suite.T(), http.StatusBadRequest, w.Code)
+}
@@ -0,0 +1,116 @@
+package question
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+
+	dbModels "word-flashcard/data/models"
+	"word-flashcard/data/schema"
+	"word-flashcard/internal/models"
+	"word-flashcard/utils"
+
+	"github.com/Masterminds/squirrel"
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+// TestGetQuestionAnalysis tests that the analysis reads the question's
+// answers and the daily form of the collection to analyze its options
+func (suite *ControllerTestSuite) TestGetQuestionAnalysis() {
+	logs, days := analysisFixture()
+	var dailyStats []*dbModels.QuestionAnswerLogDailyStats
+	for day, totals := range days {
+		dailyStats = append(dailyStats, &dbModels.QuestionAnswerLogDailyStats{
+			Day: utils.StrPtr(day), AnswerCount: utils.IntPtr(totals.answers), CorrectCount: utils.IntPtr(totals.correct),
+		})
+	}
+
+	suite.mockQuestionPeer.EXPECT().
+		Select(mock.Anything, squirrel.Eq{schema.QUESTION_ID: 7}, mock.Anything, mock.Anything, mock.Anything).
+		Return([]*dbModels.Question{sampleAnalysisQuestion()}, nil).Times(1)
+	suite.mockQuestionAnswerLogPeer.EXPECT().
+		Select(mock.Anything, squirrel.Eq{schema.QUESTION_ANSWER_LOG_QUESTION_ID: 7}, mock.Anything, mock.Anything, mock.Anything).
+		Return(logs, nil).Times(1)
+	suite.mockQuestionAnswerLogPeer.EXPECT().
+		DailyStats(mock.Anything, mock.Anything).
+		Return(dailyStats, nil).Times(1)
+
+	w := httptest.NewRecorder()
+	ctx, _ := gin.CreateTestContext(w)
+	ctx.Request = httptest.NewRequest(http.MethodGet, "/api/questions/7/analysis?tz=UTC", nil)
+	ctx.Params = gin.Params{gin.Param{Key: "id", Value: "7"}}
+	suite.controller.GetQuestionAnalysis(ctx)
+
+	assert.Equal(suite.T(), http.StatusOK, w.Code)
+	var analysis models.QuestionAnalysis
+	suite.Require().NoError(json.Unmarshal(w.Body.Bytes(), &analysis))
+	assert.Equal(suite.T(), 12, analysis.AnswerCount)
+	suite.Require().NotNil(analysis.DiscriminationIndex)
+	assert.Equal(suite.T(), 1.0, *analysis.DiscriminationIndex)
+	assert.Equal(suite.T(), "B", *analysis.MostAttractiveDistractor)
+}
+
+// TestGetQuestionAnalysisNotFound tests that an unknown question returns 404
+func (suite *ControllerTestSuite) TestGetQuestionAnalysisNotFound() {
+	suite.mockQuestionPeer.EXPECT().
+		Select(mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+		Return([]*dbModels.Question{}, nil).Times(1)
+
+	w := httptest.NewRecorder()
+	ctx, _ := gin.CreateTestContext(w)
+	ctx.Request = httptest.NewRequest(http.MethodGet, "/api/questions/7/analysis", nil)
+	ctx.Params = gin.Params{gin.Param{Key: "id", Value: "7"}}
+	suite.controller.GetQuestionAnalysis(ctx)
+
+	assert.Equal(suite.T(), http.StatusNotFound, w.Code)
+}
+
+// TestGetQuestionAnalysisOptionTableType tests that a question type keeping
+// its options in question_options returns 400
+func (suite *ControllerTestSuite) TestGetQuestionAnalysisOptionTableType() {
+	question := sampleAnalysisQuestion()
+	question.QuestionType = utils.StrPtr(schema.QUESTION_TYPE_MULTIPLE_SELECT)
+	suite.mockQuestionPeer.EXPECT().
+		Select(mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+		Return([]*dbModels.Question{question}, nil).Times(1)
+
+	w := httptest.NewRecorder()
+	ctx, _ := gin.CreateTestContext(w)
+	ctx.Request = httptest.NewRequest(http.MethodGet, "/api/questions/7/analysis", nil)
+	ctx.Params = gin.Params{gin.Param{Key: "id", Value: "7"}}
+	suite.controller.GetQuestionAnalysis(ctx)
+
+	assert.Equal(suite.T(), http.StatusBadRequest, w.Code)
+}
+
+// TestGetQuestionAnalysisInvalidID tests that a non-numeric ID returns 400
+func (suite *ControllerTestSuite) TestGetQuestionAnalysisInvalidID() {
+	w := httptest.NewRecorder()
+	ctx, _ := gin.CreateTestContext(w)
+	ctx.Request = httptest.NewRequest(http.MethodGet, "/api/questions/abc/analysis", nil)
+	ctx.Params = gin.Params{gin.Param{Key: "id", Value: "abc"}}
+	suite.controller.GetQuestionAnalysis(ctx)
+
+	assert.Equal(suite.T(), http.StatusBadRequest, w.Code)
+}
+
+// TestGetQuestionAnalysisDatabaseError tests that a failed log query returns 500
+func (suite *ControllerTestSuite) TestGetQuestionAnalysisDatabaseError() {
+	suite.mockQuestionPeer.EXPECT().
+		Select(mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+		Return([]*dbModels.Question{sampleAnalysisQuestion()}, nil).Times(1)
+	suite.mockQuestionAnswerLogPeer.EXPECT().
+		Select(mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+		Return(nil, errors.New("db error")).Times(1)
+
+	w := httptest.NewRecorder()
+	ctx, _ := gin.CreateTestContext(w)
+	ctx.Request = httptest.NewRequest(http.MethodGet, "/api/questions/7/analysis", nil)
+	ctx.Params = gin.Params{gin.Param{Key: "id", Value: "7"}}
+	suite.controller.GetQuestionAnalysis(ctx)
+
+	assert.Equal(suite.T(), http.StatusInternalServerError, w.Code)
+}
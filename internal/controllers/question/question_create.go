@@ -3,6 +3,7 @@ package question
 import (
 	"fmt"
 	"net/http"
+	"word-flashcard/data/peers"
 	"word-flashcard/data/schema"
 	"word-flashcard/internal/controllers/common"
 	"word-flashcard/internal/models"
@@ -28,20 +29,26 @@ func (qc *Controller) CreateQuestions(c *gin.Context) {
 	if err != nil {
 		common.ResponseError(http.StatusBadRequest, "Invalid request body", models.ErrCodeInvalidRequest, err, c)
 		return
-	} else if err := qc.validateQuestionFields(&questionData, false); err != nil {
+	}
+	applyQuestionTypeDefaults(&questionData)
+	if err := qc.validateQuestionFields(&questionData, false); err != nil {
 		common.ResponseError(http.StatusBadRequest, err.Error(), models.ErrCodeValidationError, err, c)
 		return
 	}
 
 	// ================ 2. Convert to data model ================
-	questionModel := questionData.ToDataModel()
+	questionModel := &peers.QuestionWithOptions{
+		Question: questionData.ToDataModel(),
+		Options:  optionDataModels(questionData.Options),
+	}
 
 	// ================ 3. Insert data into database ================
-	questionID, err := qc.questionPeer.Insert(questionModel)
+	questionIDs, err := qc.questionPeer.InsertWithOptions([]*peers.QuestionWithOptions{questionModel})
 	if err != nil {
 		common.ResponseError(http.StatusInternalServerError, "Failed to insert data into database", models.ErrCodeInternalError, err, c)
 		return
 	}
+	questionID := questionIDs[0]
 
 	// ================ 4. Query inserted data ================
 	where := squirrel.Eq{schema.QUESTION_ID: questionID}
@@ -54,6 +61,10 @@ func (qc *Controller) CreateQuestions(c *gin.Context) {
 
 	// ================ 5. Transform data to API model ================
 	questionEntity := new(models.Question).FromDataModel(questions[0])
+	if err := qc.attachOptions([]*models.Question{questionEntity}); err != nil {
+		common.ResponseError(http.StatusInternalServerError, "Inserted but failed to fetch data from database", models.ErrCodeInternalError, err, c)
+		return
+	}
 
 	// ================ 6. Send response ================
 	common.ResponseSuccess(http.StatusOK, questionEntity, c)
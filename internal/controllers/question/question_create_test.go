@@ -3,12 +3,16 @@ package question
 import (
 	"bytes"
 	"encoding/json"
+	"fmt"
 	"io"
 	"net/http"
 	"net/http/httptest"
 
 	dbModels "word-flashcard/data/models"
+	"word-flashcard/data/peers"
 	"word-flashcard/data/schema"
+	"word-flashcard/internal/models"
+	"word-flashcard/utils"
 
 	"github.com/Masterminds/squirrel"
 	"github.com/gin-gonic/gin"
@@ -23,10 +27,11 @@ func (suite *ControllerTestSuite) TestCreateQuestions() {
 
 	// Mock mockQuestionPeer methods as needed
 	suite.mockQuestionPeer.EXPECT().
-		Insert(mock.MatchedBy(func(question *dbModels.Question) bool {
-			return question != nil && question.Question != nil && question.OptionA != nil && question.Answer != nil
+		InsertWithOptions(mock.MatchedBy(func(questions []*peers.QuestionWithOptions) bool {
+			question := questions[0].Question
+			return len(questions) == 1 && question.Question != nil && question.OptionA != nil && question.Answer != nil && len(questions[0].Options) == 0
 		})).
-		Return(int64(testID), nil).Times(1)
+		Return([]int64{int64(testID)}, nil).Times(1)
 	suite.mockQuestionPeer.EXPECT().
 		Select(mock.Anything, where, mock.Anything, mock.Anything, mock.Anything).
 		Return([]*dbModels.Question{getSampleQuestions()[0]}, nil).Times(1)
@@ -46,3 +51,75 @@ func (suite *ControllerTestSuite) TestCreateQuestions() {
 	assert.NoError(suite.T(), err)
 	assert.Equal(suite.T(), string(expectedQuestionJSON), w.Body.String())
 }
+
+// TestCreateQuestionsMultipleSelect tests that a question_options type stores
+// its options in order and returns them
+func (suite *ControllerTestSuite) TestCreateQuestionsMultipleSelect() {
+	testID := 3
+	where := squirrel.Eq{schema.QUESTION_ID: int64(testID)}
+	dbQuestion := &dbModels.Question{
+		Id:           &testID,
+		Question:     utils.StrPtr("Which words are colours?"),
+		OptionA:      utils.StrPtr(""),
+		Answer:       utils.StrPtr(""),
+		QuestionType: utils.StrPtr(schema.QUESTION_TYPE_MULTIPLE_SELECT),
+	}
+
+	suite.mockQuestionPeer.EXPECT().
+		InsertWithOptions(mock.MatchedBy(func(questions []*peers.QuestionWithOptions) bool {
+			question := questions[0].Question
+			if len(questions) != 1 || *question.QuestionType != schema.QUESTION_TYPE_MULTIPLE_SELECT || *question.OptionA != "" || *question.Answer != "" {
+				return false
+			}
+			contents := []string{"red", "loud", "blue"}
+			if len(questions[0].Options) != len(contents) {
+				return false
+			}
+			for i, option := range questions[0].Options {
+				if *option.Position != i || *option.Content != contents[i] {
+					return false
+				}
+			}
+			return true
+		})).
+		Return([]int64{int64(testID)}, nil).Times(1)
+	suite.mockQuestionPeer.EXPECT().
+		Select(mock.Anything, where, mock.Anything, mock.Anything, mock.Anything).
+		Return([]*dbModels.Question{dbQuestion}, nil).Times(1)
+	suite.mockQuestionOptionPeer.EXPECT().
+		Select(mock.Anything, squirrel.Eq{schema.QUESTION_OPTION_QUESTION_ID: []int{testID}}, mock.Anything, mock.Anything, mock.Anything).
+		Return([]*dbModels.QuestionOption{
+			{Id: utils.IntPtr(1), QuestionId: &testID, Content: utils.StrPtr("red"), IsCorrect: utils.BoolPtr(true)},
+			{Id: utils.IntPtr(2), QuestionId: &testID, Content: utils.StrPtr("loud"), IsCorrect: utils.BoolPtr(false)},
+			{Id: utils.IntPtr(3), QuestionId: &testID, Content: utils.StrPtr("blue"), IsCorrect: utils.BoolPtr(true)},
+		}, nil).Times(1)
+
+	w := httptest.NewRecorder()
+	ctx, _ := gin.CreateTestContext(w)
+	requestBody := "{\"question\": \"Which words are colours?\", \"question_type\": \"multiple_select\", \"options\": [{\"content\": \"red\", \"is_correct\": true}, {\"content\": \"loud\"}, {\"content\": \"blue\", \"is_correct\": true}]}"
+	ctx.Request = httptest.NewRequest(http.MethodPost, "/api/questions", io.NopCloser(bytes.NewReader([]byte(requestBody))))
+	suite.controller.CreateQuestions(ctx)
+
+	assert.Equal(suite.T(), http.StatusOK, w.Code)
+	var actual models.Question
+	assert.NoError(suite.T(), json.Unmarshal(w.Body.Bytes(), &actual))
+	assert.Equal(suite.T(), schema.QUESTION_TYPE_MULTIPLE_SELECT, *actual.QuestionType)
+	assert.Len(suite.T(), actual.Options, 3)
+	assert.Equal(suite.T(), "blue", *actual.Options[2].Content)
+}
+
+// TestCreateQuestionsOptionInsertError tests that a failed question and
+// options insert returns 500
+func (suite *ControllerTestSuite) TestCreateQuestionsOptionInsertError() {
+	suite.mockQuestionPeer.EXPECT().
+		InsertWithOptions(mock.Anything).
+		Return(nil, fmt.Errorf("insert failed")).Times(1)
+
+	w := httptest.NewRecorder()
+	ctx, _ := gin.CreateTestContext(w)
+	requestBody := "{\"question\": \"Spell the colour\", \"question_type\": \"free_text\", \"options\": [{\"content\": \"colour\"}]}"
+	ctx.Request = httptest.NewRequest(http.MethodPost, "/api/questions", io.NopCloser(bytes.NewReader([]byte(requestBody))))
+	suite.controller.CreateQuestions(ctx)
+
+	assert.Equal(suite.T(), http.StatusInternalServerError, w.Code)
+}
@@ -11,7 +11,7 @@ import (
 )
 
 // DeleteQuestions @Summary Delete a question
-// @Description Delete a specific question
+// @Description Delete a specific question. Its reminders are deleted too and recorded in the reminder history.
 // @Tags questions
 // @Accept json
 // @Produce json
@@ -31,6 +31,23 @@ func (qc *Controller) DeleteQuestions(c *gin.Context) {
 	}
 
 	// ================ 2. Delete data from database ================
+	// Options, links and reminders belong to the question and go first, as
+	// they reference it. Reminders are recorded in the reminder history as
+	// deleted rather than left for the reminder scheduler to prune.
+	if _, err := qc.questionOptionPeer.Delete(squirrel.Eq{schema.QUESTION_OPTION_QUESTION_ID: questionID}); err != nil {
+		common.ResponseError(http.StatusInternalServerError, "Failed to delete associated data from database", models.ErrCodeInternalError, err, c)
+		return
+	}
+	if err := qc.linkResolver.RemoveLinks(schema.ENTITY_TYPE_QUESTION, questionID); err != nil {
+		common.ResponseError(http.StatusInternalServerError, "Failed to delete associated data from database", models.ErrCodeInternalError, err, c)
+		return
+	}
+	reminderWhere := squirrel.Eq{schema.REMINDER_ENTITY_TYPE: schema.ENTITY_TYPE_QUESTION, schema.REMINDER_ENTITY_ID: questionID}
+	if _, err := qc.reminderPeer.DeleteWithHistory(reminderWhere); err != nil {
+		common.ResponseError(http.StatusInternalServerError, "Failed to delete associated data from database", models.ErrCodeInternalError, err, c)
+		return
+	}
+
 	// question_answer_logs rows referencing this question are intentionally
 	// left in place (no FK constraint, no cascade) so that stats/trend
 	// charts stay unchanged after deletion.
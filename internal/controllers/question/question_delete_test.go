@@ -6,17 +6,29 @@ import (
 	"net/http/httptest"
 
 	"word-flashcard/data/schema"
+	"word-flashcard/internal/links"
 
 	"github.com/Masterminds/squirrel"
 	"github.com/gin-gonic/gin"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
 )
 
 // TestDeleteQuestions tests the DeleteQuestions handler
 func (suite *ControllerTestSuite) TestDeleteQuestions() {
 	testID := 1
 	where := squirrel.Eq{schema.QUESTION_ID: testID}
+	whereOptions := squirrel.Eq{schema.QUESTION_OPTION_QUESTION_ID: testID}
 
+	suite.mockQuestionOptionPeer.EXPECT().
+		Delete(whereOptions).
+		Return(int64(0), nil).Times(1)
+	suite.mockEntityLinkPeer.EXPECT().
+		Delete(links.LinksOf(schema.ENTITY_TYPE_QUESTION, []int{testID})).
+		Return(int64(0), nil).Times(1)
+	suite.mockReminderPeer.EXPECT().
+		DeleteWithHistory(squirrel.Eq{schema.REMINDER_ENTITY_TYPE: schema.ENTITY_TYPE_QUESTION, schema.REMINDER_ENTITY_ID: testID}).
+		Return(int64(1), nil).Times(1)
 	// Mock mockQuestionPeer methods as needed
 	suite.mockQuestionPeer.EXPECT().
 		Delete(where).
@@ -50,7 +62,17 @@ func (suite *ControllerTestSuite) TestDeleteQuestionsInvalidID() {
 func (suite *ControllerTestSuite) TestDeleteQuestionsPeerError() {
 	testID := 1
 	where := squirrel.Eq{schema.QUESTION_ID: testID}
+	whereOptions := squirrel.Eq{schema.QUESTION_OPTION_QUESTION_ID: testID}
 
+	suite.mockQuestionOptionPeer.EXPECT().
+		Delete(whereOptions).
+		Return(int64(0), nil).Times(1)
+	suite.mockEntityLinkPeer.EXPECT().
+		Delete(links.LinksOf(schema.ENTITY_TYPE_QUESTION, []int{testID})).
+		Return(int64(0), nil).Times(1)
+	suite.mockReminderPeer.EXPECT().
+		DeleteWithHistory(squirrel.Eq{schema.REMINDER_ENTITY_TYPE: schema.ENTITY_TYPE_QUESTION, schema.REMINDER_ENTITY_ID: testID}).
+		Return(int64(0), nil).Times(1)
 	suite.mockQuestionPeer.EXPECT().
 		Delete(where).
 		Return(int64(0), fmt.Errorf("delete failed")).Times(1)
@@ -68,7 +90,17 @@ func (suite *ControllerTestSuite) TestDeleteQuestionsPeerError() {
 func (suite *ControllerTestSuite) TestDeleteQuestionsNotFound() {
 	testID := 999
 	where := squirrel.Eq{schema.QUESTION_ID: testID}
+	whereOptions := squirrel.Eq{schema.QUESTION_OPTION_QUESTION_ID: testID}
 
+	suite.mockQuestionOptionPeer.EXPECT().
+		Delete(whereOptions).
+		Return(int64(0), nil).Times(1)
+	suite.mockEntityLinkPeer.EXPECT().
+		Delete(links.LinksOf(schema.ENTITY_TYPE_QUESTION, []int{testID})).
+		Return(int64(0), nil).Times(1)
+	suite.mockReminderPeer.EXPECT().
+		DeleteWithHistory(squirrel.Eq{schema.REMINDER_ENTITY_TYPE: schema.ENTITY_TYPE_QUESTION, schema.REMINDER_ENTITY_ID: testID}).
+		Return(int64(0), nil).Times(1)
 	suite.mockQuestionPeer.EXPECT().
 		Delete(where).
 		Return(int64(0), nil).Times(1)
@@ -81,3 +113,62 @@ func (suite *ControllerTestSuite) TestDeleteQuestionsNotFound() {
 
 	assert.Equal(suite.T(), http.StatusNotFound, w.Code)
 }
+
+// TestDeleteQuestionsOptionsError tests that a failure deleting the options returns 500 before the question is touched
+func (suite *ControllerTestSuite) TestDeleteQuestionsOptionsError() {
+	whereOptions := squirrel.Eq{schema.QUESTION_OPTION_QUESTION_ID: 1}
+
+	suite.mockQuestionOptionPeer.EXPECT().
+		Delete(whereOptions).
+		Return(int64(0), fmt.Errorf("delete failed")).Times(1)
+
+	w := httptest.NewRecorder()
+	ctx, _ := gin.CreateTestContext(w)
+	ctx.Request = httptest.NewRequest(http.MethodDelete, "/api/questions/1", nil)
+	ctx.Params = gin.Params{gin.Param{Key: "id", Value: "1"}}
+	suite.controller.DeleteQuestions(ctx)
+
+	assert.Equal(suite.T(), http.StatusInternalServerError, w.Code)
+	suite.mockQuestionPeer.AssertNotCalled(suite.T(), "Delete", mock.Anything)
+}
+
+// TestDeleteQuestionsLinksError tests that a failure removing the question's links returns 500 before the question is touched
+func (suite *ControllerTestSuite) TestDeleteQuestionsLinksError() {
+	suite.mockQuestionOptionPeer.EXPECT().
+		Delete(mock.Anything).
+		Return(int64(0), nil).Times(1)
+	suite.mockEntityLinkPeer.EXPECT().
+		Delete(mock.Anything).
+		Return(int64(0), fmt.Errorf("delete failed")).Times(1)
+
+	w := httptest.NewRecorder()
+	ctx, _ := gin.CreateTestContext(w)
+	ctx.Request = httptest.NewRequest(http.MethodDelete, "/api/questions/1", nil)
+	ctx.Params = gin.Params{gin.Param{Key: "id", Value: "1"}}
+	suite.controller.DeleteQuestions(ctx)
+
+	assert.Equal(suite.T(), http.StatusInternalServerError, w.Code)
+	suite.mockQuestionPeer.AssertNotCalled(suite.T(), "Delete", mock.Anything)
+}
+
+// TestDeleteQuestionsRemindersError tests that a failure deleting the question's reminders returns 500 before the question is touched
+func (suite *ControllerTestSuite) TestDeleteQuestionsRemindersError() {
+	suite.mockQuestionOptionPeer.EXPECT().
+		Delete(mock.Anything).
+		Return(int64(0), nil).Times(1)
+	suite.mockEntityLinkPeer.EXPECT().
+		Delete(mock.Anything).
+		Return(int64(0), nil).Times(1)
+	suite.mockReminderPeer.EXPECT().
+		DeleteWithHistory(mock.Anything).
+		Return(int64(0), fmt.Errorf("delete failed")).Times(1)
+
+	w := httptest.NewRecorder()
+	ctx, _ := gin.CreateTestContext(w)
+	ctx.Request = httptest.NewRequest(http.MethodDelete, "/api/questions/1", nil)
+	ctx.Params = gin.Params{gin.Param{Key: "id", Value: "1"}}
+	suite.controller.DeleteQuestions(ctx)
+
+	assert.Equal(suite.T(), http.StatusInternalServerError, w.Code)
+	suite.mockQuestionPeer.AssertNotCalled(suite.T(), "Delete", mock.Anything)
+}
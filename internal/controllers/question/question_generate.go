@@ -0,0 +1,99 @@
+package question
+
+import (
+	"net/http"
+	"word-flashcard/data/peers"
+	"word-flashcard/internal/controllers/common"
+	"word-flashcard/internal/models"
+	"word-flashcard/utils"
+
+	"github.com/gin-gonic/gin"
+)
+
+// GenerateQuestions @Summary Generate multiple-choice questions from words
+// @Description Build multiple-choice questions from words' definitions. Distractors are other words' definitions with the same part of
+// @Description speech and the closest familiarity. direction selects word_to_definition (default) or definition_to_word; persist saves
+// @Description the questions, all in one transaction, instead of returning ephemeral quiz items.
+// @Tags questions
+// @Accept json
+// @Produce json
+// @Param generateRequest body models.QuestionGenerateRequest true "Words to generate from (word_ids or count), direction, and persist flag"
+// @Success 200 {object} models.QuestionGenerateResponse "Questions generated successfully"
+// @Failure 400 {object} models.ErrorResponse "Bad request - Invalid request body"
+// @Failure 500 {object} models.ErrorResponse "Internal server error - Failed to read or write data in database"
+// @Router /api/questions/generate [post]
+func (qc *Controller) GenerateQuestions(c *gin.Context) {
+	// ================ 1. Parse request body ================
+	var generateReq models.QuestionGenerateRequest
+	if err := common.ParseRequestBody(&generateReq, c); err != nil {
+		common.ResponseError(http.StatusBadRequest, "Invalid request body", models.ErrCodeInvalidRequest, err, c)
+		return
+	} else if err := validateGenerateRequest(&generateReq); err != nil {
+		common.ResponseError(http.StatusBadRequest, err.Error(), models.ErrCodeValidationError, err, c)
+		return
+	}
+
+	// ================ 2. Fetch source words and their definitions ================
+	words, err := qc.fetchSourceWords(generateReq.WordIDs, generateReq.Count)
+	if err != nil {
+		common.ResponseError(http.StatusInternalServerError, "Failed to fetch data from database", models.ErrCodeInternalError, err, c)
+		return
+	}
+	defs, err := qc.fetchDefinitionsForWords(words)
+	if err != nil {
+		common.ResponseError(http.StatusInternalServerError, "Failed to fetch data from database", models.ErrCodeInternalError, err, c)
+		return
+	}
+
+	// ================ 3. Build one question per word ================
+	pool, err := qc.fetchDistractorPool(len(words))
+	if err != nil {
+		common.ResponseError(http.StatusInternalServerError, "Failed to fetch data from database", models.ErrCodeInternalError, err, c)
+		return
+	}
+	response := models.QuestionGenerateResponse{Questions: []*models.Question{}, SkippedWordIDs: []int{}}
+	for _, word := range words {
+		def := pickSourceDefinition(word, defs)
+		if def == nil || word.Word == nil {
+			response.SkippedWordIDs = append(response.SkippedWordIDs, *word.Id)
+			continue
+		}
+
+		partOfSpeech := utils.DerefStr(def.PartOfSpeech)
+		distractors := pickDistractors(pool, word, partOfSpeech, *def.Definition, generatedOptionCount-1)
+		if len(distractors) == 0 {
+			response.SkippedWordIDs = append(response.SkippedWordIDs, *word.Id)
+			continue
+		}
+		question := buildGeneratedQuestion(word, def, distractors, generateReq.Direction)
+
+		if generateReq.Persist {
+			if err := qc.validateQuestionFields(question, false); err != nil {
+				response.SkippedWordIDs = append(response.SkippedWordIDs, *word.Id)
+				continue
+			}
+		}
+		response.Questions = append(response.Questions, question)
+	}
+
+	// ================ 4. Optionally persist ================
+	if generateReq.Persist && len(response.Questions) > 0 {
+		rows := make([]*peers.QuestionWithOptions, len(response.Questions))
+		for i, question := range response.Questions {
+			rows[i] = &peers.QuestionWithOptions{Question: question.ToDataModel(), Options: optionDataModels(question.Options)}
+		}
+		questionIDs, err := qc.questionPeer.InsertWithOptions(rows)
+		if err != nil {
+			common.ResponseError(http.StatusInternalServerError, "Failed to insert data into database", models.ErrCodeInternalError, err, c)
+			return
+		}
+		for i, question := range response.Questions {
+			id, zero := int(questionIDs[i]), 0
+			question.ID = &id
+			question.CountPractise, question.CountFailurePractise = &zero, &zero
+		}
+	}
+
+	// ================ 5. Send response ================
+	common.ResponseSuccess(http.StatusOK, response, c)
+}
@@ -0,0 +1,180 @@
+package question
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+
+	dbModels "word-flashcard/data/models"
+	"word-flashcard/data/peers"
+	"word-flashcard/data/schema"
+	"word-flashcard/internal/models"
+	"word-flashcard/utils"
+
+	"github.com/Masterminds/squirrel"
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+// callGenerateQuestions runs the GenerateQuestions handler with the given body
+func (suite *ControllerTestSuite) callGenerateQuestions(requestBody string) *httptest.ResponseRecorder {
+	w := httptest.NewRecorder()
+	ctx, _ := gin.CreateTestContext(w)
+	ctx.Request = httptest.NewRequest(http.MethodPost, "/api/questions/generate", io.NopCloser(bytes.NewReader([]byte(requestBody))))
+	suite.controller.GenerateQuestions(ctx)
+	return w
+}
+
+// expectGenerationSources sets up the word/definition lookups for generating a question for "apple"
+// (word 1) with "pear" (word 2, same part of speech) as the only other word in the distractor pool.
+func (suite *ControllerTestSuite) expectGenerationSources() {
+	apple := &dbModels.Word{Id: utils.IntPtr(1), Word: utils.StrPtr("apple"), Familiarity: utils.StrPtr("red")}
+	pear := &dbModels.Word{Id: utils.IntPtr(2), Word: utils.StrPtr("pear"), Familiarity: utils.StrPtr("red")}
+	appleDef := &dbModels.WordDefinition{WordId: utils.IntPtr(1), PartOfSpeech: utils.StrPtr("noun"), Definition: utils.StrPtr("a round fruit")}
+	pearDef := &dbModels.WordDefinition{WordId: utils.IntPtr(2), PartOfSpeech: utils.StrPtr("noun"), Definition: utils.StrPtr("a sweet juicy fruit")}
+
+	suite.mockWordPeer.EXPECT().
+		Select(mock.Anything, squirrel.Eq{schema.WORD_ID: []int{1}}, mock.Anything, mock.Anything, mock.Anything).
+		Return([]*dbModels.Word{apple}, nil).Once()
+	suite.mockWordDefinitionPeer.EXPECT().
+		Select(mock.Anything, squirrel.Eq{schema.WORD_DEFINITIONS_WORD_ID: []int{1}}, mock.Anything, mock.Anything, mock.Anything).
+		Return([]*dbModels.WordDefinition{appleDef}, nil).Once()
+
+	// The distractor pool is sampled once per request, then narrowed to other words
+	suite.mockWordDefinitionPeer.EXPECT().
+		Select(mock.Anything, nil, mock.Anything, mock.Anything, mock.Anything).
+		Return([]*dbModels.WordDefinition{appleDef, pearDef}, nil).Once()
+	suite.mockWordPeer.EXPECT().
+		Select(mock.Anything, squirrel.Eq{schema.WORD_ID: []int{1, 2}}, mock.Anything, mock.Anything, mock.Anything).
+		Return([]*dbModels.Word{apple, pear}, nil).Once()
+}
+
+// TestGenerateQuestionsEphemeral tests generating an unsaved definition-to-word question
+func (suite *ControllerTestSuite) TestGenerateQuestionsEphemeral() {
+	suite.expectGenerationSources()
+
+	w := suite.callGenerateQuestions("{\"word_ids\": [1], \"direction\": \"definition_to_word\"}")
+
+	assert.Equal(suite.T(), http.StatusOK, w.Code)
+	var response models.QuestionGenerateResponse
+	assert.NoError(suite.T(), json.Unmarshal(w.Body.Bytes(), &response))
+	assert.Empty(suite.T(), response.SkippedWordIDs)
+	assert.Len(suite.T(), response.Questions, 1)
+	q := response.Questions[0]
+	assert.Nil(suite.T(), q.ID)
+	assert.Equal(suite.T(), "Which word (noun) matches this definition: a round fruit", *q.Question)
+	assert.ElementsMatch(suite.T(), []string{"apple", "pear"}, []string{*q.OptionA, *q.OptionB})
+	if *q.Answer == "A" {
+		assert.Equal(suite.T(), "apple", *q.OptionA)
+	} else {
+		assert.Equal(suite.T(), "apple", *q.OptionB)
+	}
+}
+
+// TestGenerateQuestionsPersist tests that persist saves the generated question and returns its ID
+func (suite *ControllerTestSuite) TestGenerateQuestionsPersist() {
+	suite.expectGenerationSources()
+	suite.mockQuestionPeer.EXPECT().
+		InsertWithOptions(mock.MatchedBy(func(questions []*peers.QuestionWithOptions) bool {
+			q := questions[0].Question
+			return len(questions) == 1 && *q.Question == "Which definition best matches \"apple\" (noun)?" && *q.Reference == "apple"
+		})).
+		Return([]int64{42}, nil).Once()
+
+	w := suite.callGenerateQuestions("{\"word_ids\": [1], \"persist\": true}")
+
+	assert.Equal(suite.T(), http.StatusOK, w.Code)
+	var response models.QuestionGenerateResponse
+	assert.NoError(suite.T(), json.Unmarshal(w.Body.Bytes(), &response))
+	assert.Len(suite.T(), response.Questions, 1)
+	assert.Equal(suite.T(), 42, *response.Questions[0].ID)
+	assert.Equal(suite.T(), 0, *response.Questions[0].CountPractise)
+}
+
+// TestGenerateQuestionsPersistError tests that a failed batch insert returns
+// 500 rather than a partly saved batch
+func (suite *ControllerTestSuite) TestGenerateQuestionsPersistError() {
+	suite.expectGenerationSources()
+	suite.mockQuestionPeer.EXPECT().
+		InsertWithOptions(mock.Anything).
+		Return(nil, errors.New("insert failed")).Once()
+
+	w := suite.callGenerateQuestions("{\"word_ids\": [1], \"persist\": true}")
+
+	assert.Equal(suite.T(), http.StatusInternalServerError, w.Code)
+}
+
+// TestGenerateQuestionsSkipsWordWithoutDefinition tests that words with nothing to ask about are reported as skipped
+func (suite *ControllerTestSuite) TestGenerateQuestionsSkipsWordWithoutDefinition() {
+	suite.mockWordPeer.EXPECT().
+		Select(mock.Anything, nil, mock.Anything, mock.Anything, mock.Anything).
+		Return([]*dbModels.Word{{Id: utils.IntPtr(7), Word: utils.StrPtr("lonely")}}, nil).Once()
+	suite.mockWordDefinitionPeer.EXPECT().
+		Select(mock.Anything, squirrel.Eq{schema.WORD_DEFINITIONS_WORD_ID: []int{7}}, mock.Anything, mock.Anything, mock.Anything).
+		Return([]*dbModels.WordDefinition{}, nil).Once()
+	suite.mockWordDefinitionPeer.EXPECT().
+		Select(mock.Anything, nil, mock.Anything, mock.Anything, mock.Anything).
+		Return([]*dbModels.WordDefinition{}, nil).Once()
+
+	w := suite.callGenerateQuestions("{\"count\": 1}")
+
+	assert.Equal(suite.T(), http.StatusOK, w.Code)
+	assert.JSONEq(suite.T(), "{\"questions\": [], \"skipped_word_ids\": [7]}", w.Body.String())
+}
+
+// TestGenerateQuestionsSharesDistractorPool tests that the distractor pool is
+// fetched once for the whole request and each word's own definitions are never
+// offered as its distractors
+func (suite *ControllerTestSuite) TestGenerateQuestionsSharesDistractorPool() {
+	apple := &dbModels.Word{Id: utils.IntPtr(1), Word: utils.StrPtr("apple")}
+	pear := &dbModels.Word{Id: utils.IntPtr(2), Word: utils.StrPtr("pear")}
+	appleDef := &dbModels.WordDefinition{WordId: utils.IntPtr(1), PartOfSpeech: utils.StrPtr("noun"), Definition: utils.StrPtr("a round fruit")}
+	pearDef := &dbModels.WordDefinition{WordId: utils.IntPtr(2), PartOfSpeech: utils.StrPtr("noun"), Definition: utils.StrPtr("a sweet juicy fruit")}
+	suite.mockWordPeer.EXPECT().
+		Select(mock.Anything, squirrel.Eq{schema.WORD_ID: []int{1, 2}}, mock.Anything, mock.Anything, mock.Anything).
+		Return([]*dbModels.Word{apple, pear}, nil).Twice()
+	suite.mockWordDefinitionPeer.EXPECT().
+		Select(mock.Anything, squirrel.Eq{schema.WORD_DEFINITIONS_WORD_ID: []int{1, 2}}, mock.Anything, mock.Anything, mock.Anything).
+		Return([]*dbModels.WordDefinition{appleDef, pearDef}, nil).Once()
+	suite.mockWordDefinitionPeer.EXPECT().
+		Select(mock.Anything, nil, mock.Anything, mock.MatchedBy(func(limit *uint64) bool { return *limit == 2*distractorPoolSize }), mock.Anything).
+		Return([]*dbModels.WordDefinition{appleDef, pearDef}, nil).Once()
+
+	w := suite.callGenerateQuestions("{\"word_ids\": [1, 2]}")
+
+	assert.Equal(suite.T(), http.StatusOK, w.Code)
+	var response models.QuestionGenerateResponse
+	assert.NoError(suite.T(), json.Unmarshal(w.Body.Bytes(), &response))
+	assert.Empty(suite.T(), response.SkippedWordIDs)
+	assert.Len(suite.T(), response.Questions, 2)
+	for _, q := range response.Questions {
+		assert.ElementsMatch(suite.T(), []string{"a round fruit", "a sweet juicy fruit"}, []string{*q.OptionA, *q.OptionB})
+	}
+}
+
+// TestGenerateQuestionsErrors tests the error responses of GenerateQuestions
+func (suite *ControllerTestSuite) TestGenerateQuestionsErrors() {
+	suite.Run("invalid body", func() {
+		suite.SetupTest()
+		assert.Equal(suite.T(), http.StatusBadRequest, suite.callGenerateQuestions("{").Code)
+	})
+
+	suite.Run("validation error", func() {
+		suite.SetupTest()
+		w := suite.callGenerateQuestions("{\"count\": 1, \"direction\": \"sideways\"}")
+		assert.Equal(suite.T(), http.StatusBadRequest, w.Code)
+		assert.Contains(suite.T(), w.Body.String(), "direction is invalid")
+	})
+
+	suite.Run("word lookup fails", func() {
+		suite.SetupTest()
+		suite.mockWordPeer.EXPECT().
+			Select(mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+			Return(nil, errors.New("db down")).Once()
+		assert.Equal(suite.T(), http.StatusInternalServerError, suite.callGenerateQuestions("{\"count\": 1}").Code)
+	})
+}
@@ -17,6 +17,7 @@ import (
 // @Accept json
 // @Produce json
 // @Param id path int true "Question ID"
+// @Param include query string false "Set to links to embed the question's linked words and notes"
 // @Success 200 {object} models.Question "A question retrieved successfully"
 // @Failure 400 {object} models.ErrorResponse "Bad request - Invalid query parameters"
 // @Failure 404 {object} models.ErrorResponse "Not found - Question not found"
@@ -50,6 +51,16 @@ func (qc *Controller) GetQuestions(c *gin.Context) {
 
 	// ================ 3. Transform data to API model ================
 	questionEntity := new(models.Question).FromDataModel(questions[0])
+	if err := qc.attachOptions([]*models.Question{questionEntity}); err != nil {
+		common.ResponseError(http.StatusInternalServerError, "Failed to fetch data from database", models.ErrCodeInternalError, err, c)
+		return
+	}
+	if common.HasInclude(c, "links") {
+		if err := qc.embedLinks([]*models.Question{questionEntity}); err != nil {
+			common.ResponseError(http.StatusInternalServerError, "Failed to fetch data from database", models.ErrCodeInternalError, err, c)
+			return
+		}
+	}
 
 	// ================ 4. Send response ================
 	common.ResponseSuccess(http.StatusOK, questionEntity, c)
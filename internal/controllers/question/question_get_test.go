@@ -7,6 +7,9 @@ import (
 	"net/http/httptest"
 
 	dbModels "word-flashcard/data/models"
+	"word-flashcard/data/schema"
+	"word-flashcard/internal/models"
+	"word-flashcard/utils"
 
 	"github.com/gin-gonic/gin"
 	"github.com/stretchr/testify/assert"
@@ -90,3 +93,37 @@ func (suite *ControllerTestSuite) TestGetQuestionsMultipleRecords() {
 
 	assert.Equal(suite.T(), http.StatusInternalServerError, w.Code)
 }
+
+// TestGetQuestionsIncludeLinks tests that include=links embeds the words and
+// notes linked to the question, whichever end of the link it is stored at
+func (suite *ControllerTestSuite) TestGetQuestionsIncludeLinks() {
+	suite.mockQuestionPeer.EXPECT().
+		Select(mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+		Return([]*dbModels.Question{getSampleQuestions()[1]}, nil).Times(1)
+	suite.mockEntityLinkPeer.EXPECT().
+		Select(mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+		Return([]*dbModels.EntityLink{
+			{Id: utils.IntPtr(1), SourceType: utils.StrPtr(schema.ENTITY_TYPE_WORD), SourceId: utils.IntPtr(5), TargetType: utils.StrPtr(schema.ENTITY_TYPE_QUESTION), TargetId: utils.IntPtr(2)},
+			{Id: utils.IntPtr(2), SourceType: utils.StrPtr(schema.ENTITY_TYPE_QUESTION), SourceId: utils.IntPtr(2), TargetType: utils.StrPtr(schema.ENTITY_TYPE_NOTE), TargetId: utils.IntPtr(1)},
+		}, nil).Times(1)
+	suite.mockWordPeer.EXPECT().
+		Select(mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+		Return([]*dbModels.Word{{Id: utils.IntPtr(5), Word: utils.StrPtr("corn")}}, nil).Times(1)
+	suite.mockNotePeer.EXPECT().
+		Select(mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+		Return([]*dbModels.Note{{Id: utils.IntPtr(1), Title: utils.StrPtr("Grains")}}, nil).Times(1)
+
+	w := httptest.NewRecorder()
+	ctx, _ := gin.CreateTestContext(w)
+	ctx.Request = httptest.NewRequest(http.MethodGet, "/api/questions/2?include=links", nil)
+	ctx.Params = gin.Params{gin.Param{Key: "id", Value: "2"}}
+	suite.controller.GetQuestions(ctx)
+
+	assert.Equal(suite.T(), http.StatusOK, w.Code)
+	var question models.Question
+	assert.NoError(suite.T(), json.Unmarshal(w.Body.Bytes(), &question))
+	assert.Equal(suite.T(), []models.LinkedEntity{
+		{LinkID: 1, Type: schema.ENTITY_TYPE_WORD, ID: 5, Label: "corn"},
+		{LinkID: 2, Type: schema.ENTITY_TYPE_NOTE, ID: 1, Label: "Grains"},
+	}, question.Links)
+}
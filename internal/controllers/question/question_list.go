@@ -18,6 +18,7 @@ import (
 // @Param limit query int false "Maximum number of records to return (default: 100, max: 1000)"
 // @Param offset query int false "Number of records to skip (default: 0)"
 // @Param sort query string false "Sort columns/expressions, comma-separated. Format: col,-col,(expr),-(expr). Allowed: id,question,answer,count_practise,count_failure_practise,created_at,updated_at"
+// @Param include query string false "Set to links to embed each question's linked words and notes"
 // @Success 200 {array} models.Question "List of Questions retrieved successfully"
 // @Failure 400 {object} models.ErrorResponse "Bad request - Invalid query parameters"
 // @Failure 500 {object} models.ErrorResponse "Internal server error - Failed to fetch data from database"
@@ -64,6 +65,16 @@ func (qc *Controller) ListQuestions(c *gin.Context) {
 
 	// ================ 4. Transform data to API model ================
 	questionEntities := qc.convertToEntities(questions)
+	if err := qc.attachOptions(questionEntities); err != nil {
+		common.ResponseError(http.StatusInternalServerError, "Failed to fetch data from database", models.ErrCodeInternalError, err, c)
+		return
+	}
+	if common.HasInclude(c, "links") {
+		if err := qc.embedLinks(questionEntities); err != nil {
+			common.ResponseError(http.StatusInternalServerError, "Failed to fetch data from database", models.ErrCodeInternalError, err, c)
+			return
+		}
+	}
 
 	// ================ 5. Send response ================
 	common.ResponseSuccess(http.StatusOK, questionEntities, c)
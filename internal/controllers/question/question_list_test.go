@@ -2,6 +2,7 @@ package question
 
 import (
 	"encoding/json"
+	"fmt"
 	"net/http"
 	"net/http/httptest"
 
@@ -32,3 +33,21 @@ func (suite *ControllerTestSuite) TestListQuestions() {
 	assert.NoError(suite.T(), err)
 	assert.Equal(suite.T(), string(expectedQuestion), w.Body.String())
 }
+
+// TestListQuestionsIncludeLinksError tests that a failure while fetching
+// links for include=links returns 500
+func (suite *ControllerTestSuite) TestListQuestionsIncludeLinksError() {
+	suite.mockQuestionPeer.EXPECT().
+		Select(mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+		Return(getSampleQuestions(), nil).Times(1)
+	suite.mockEntityLinkPeer.EXPECT().
+		Select(mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+		Return(nil, fmt.Errorf("select failed")).Times(1)
+
+	w := httptest.NewRecorder()
+	ctx, _ := gin.CreateTestContext(w)
+	ctx.Request = httptest.NewRequest(http.MethodGet, "/api/questions?include=links", nil)
+	suite.controller.ListQuestions(ctx)
+
+	assert.Equal(suite.T(), http.StatusInternalServerError, w.Code)
+}
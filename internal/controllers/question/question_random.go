@@ -2,6 +2,7 @@ package question
 
 import (
 	"net/http"
+	"slices"
 	"word-flashcard/internal/controllers/common"
 	"word-flashcard/internal/models"
 
@@ -9,7 +10,7 @@ import (
 )
 
 // RandomQuestions @Summary Get random questions
-// @Description Randomly obtain the required number of questions
+// @Description Randomly obtain the required number of questions; favor_slow reserves up to 30% of them for questions answered correctly but slowly
 // @Tags questions
 // @Accept json
 // @Produce json
@@ -29,10 +30,17 @@ func (qc *Controller) RandomQuestions(c *gin.Context) {
 		common.ResponseError(http.StatusBadRequest, "Invalid request body - count", models.ErrCodeValidationError, nil, c)
 		return
 	}
+	for _, questionType := range randomReq.QuestionTypes {
+		if !slices.Contains(questionTypes, questionType) {
+			common.ResponseError(http.StatusBadRequest, "Invalid request body - question_types", models.ErrCodeValidationError, nil, c)
+			return
+		}
+	}
 
 	// ================ 2. Fetch data from database ================
-	// Use weighted bucket sampling: unpractised (50%) > high-failure-rate (30%) > high-success-rate (20%)
-	questions, err := qc.fetchRandomQuestionsWeighted(randomReq.Count, randomReq.ExcludeRecentDays)
+	// Use weighted bucket sampling: unpractised (50%) > high-failure-rate (30%) > high-success-rate (20%),
+	// after setting aside a share for slow-but-correct questions when favor_slow is set
+	questions, err := qc.drawRandomQuestions(randomReq.Count, randomReq.ExcludeRecentDays, randomReq.QuestionTypes, randomReq.FavorSlow)
 	if err != nil {
 		common.ResponseError(http.StatusInternalServerError, "Failed to fetch data from database", models.ErrCodeInternalError, err, c)
 		return
@@ -43,6 +51,10 @@ func (qc *Controller) RandomQuestions(c *gin.Context) {
 	if len(questions) > 0 {
 		questionEntities = qc.convertToEntities(questions)
 	}
+	if err := qc.attachOptions(questionEntities); err != nil {
+		common.ResponseError(http.StatusInternalServerError, "Failed to fetch data from database", models.ErrCodeInternalError, err, c)
+		return
+	}
 
 	// ================ 4. Send response ================
 	common.ResponseSuccess(http.StatusOK, questionEntities, c)
@@ -32,7 +32,7 @@ func (suite *ControllerTestSuite) TestRandomQuestions() {
 
 	// Bucket 1: unpractised (count_practise = 0)
 	suite.mockQuestionPeer.EXPECT().
-		Select(mock.Anything, squirrel.Eq{schema.QUESTION_COUNT_PRACTISE: 0}, randomOrderMatcher, &limit1, (*uint64)(nil)).
+		Select(mock.Anything, squirrel.And{squirrel.Eq{schema.QUESTION_IS_SUSPENDED: false}, squirrel.Eq{schema.QUESTION_COUNT_PRACTISE: 0}}, randomOrderMatcher, &limit1, (*uint64)(nil)).
 		Return([]*dbModels.Question{getSampleQuestions()[1]}, nil).Times(1)
 
 	// Bucket 2: quota=0, fetchQuestionBucket returns early — no Select call expected
@@ -57,3 +57,15 @@ func (suite *ControllerTestSuite) TestRandomQuestions() {
 	assert.NoError(suite.T(), err)
 	assert.ElementsMatch(suite.T(), []*models.Question{getExpectedQuestions()[1], getExpectedQuestions()[3]}, actualQuestions)
 }
+
+// TestRandomQuestionsInvalidType tests that an unknown question_types entry is rejected
+func (suite *ControllerTestSuite) TestRandomQuestionsInvalidType() {
+	w := httptest.NewRecorder()
+	ctx, _ := gin.CreateTestContext(w)
+	requestFilter := "{\"count\": 2, \"question_types\": [\"ordering\", \"essay\"]}"
+	ctx.Request = httptest.NewRequest(http.MethodPost, "/api/questions/random", io.NopCloser(bytes.NewReader([]byte(requestFilter))))
+	suite.controller.RandomQuestions(ctx)
+
+	assert.Equal(suite.T(), http.StatusBadRequest, w.Code)
+	suite.mockQuestionPeer.AssertNotCalled(suite.T(), "Select", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+}
@@ -0,0 +1,83 @@
+package question
+
+import (
+	"net/http"
+	"time"
+	"word-flashcard/data/schema"
+	"word-flashcard/internal/controllers/common"
+	"word-flashcard/internal/models"
+
+	"github.com/Masterminds/squirrel"
+	"github.com/gin-gonic/gin"
+)
+
+// maxSpeedQuestions caps the limit query parameter of the speed endpoint
+const maxSpeedQuestions = 1000
+
+// GetQuestionsSpeed @Summary Get answer-time analytics for questions
+// @Description Summarizes how long timed answers took, overall and per question: answer count and the median, 75th and 90th percentile
+// @Description in milliseconds, slowest median first. A question is slow_but_correct when its median correct answer time, over at least
+// @Description two timed correct answers, is in the slowest quarter of all correct answers. Only answers sent with response_ms count.
+// @Tags questions
+// @Produce json
+// @Param days query int false "Only include answers from the last N days (default: 90, max: 3650)"
+// @Param limit query int false "Number of questions to list, slowest first (default: 50, max: 1000)"
+// @Success 200 {object} models.SpeedReport "Answer-time analytics"
+// @Failure 400 {object} models.ErrorResponse "Bad request - Invalid days or limit parameter"
+// @Failure 500 {object} models.ErrorResponse "Internal server error - Failed to fetch data from database"
+// @Router /api/questions/speed [get]
+func (qc *Controller) GetQuestionsSpeed(c *gin.Context) {
+	// ================ 1. Parse query parameters ================
+	days, err := common.ParseIntQueryParam(c, "days", common.DefaultSpeedDays)
+	if err != nil || days < 1 || days > common.MaxTrendDays {
+		common.ResponseError(http.StatusBadRequest, "Invalid days parameter", models.ErrCodeInvalidRequest, err, c)
+		return
+	}
+	limit, err := common.ParseIntQueryParam(c, "limit", 50)
+	if err != nil || limit < 1 || limit > maxSpeedQuestions {
+		common.ResponseError(http.StatusBadRequest, "Invalid limit parameter", models.ErrCodeInvalidRequest, err, c)
+		return
+	}
+
+	// ================ 2. Fetch timed answers ================
+	answers, err := qc.fetchTimedQuestionAnswers(days, time.Now().UTC())
+	if err != nil {
+		common.ResponseError(http.StatusInternalServerError, "Failed to fetch data from database", models.ErrCodeInternalError, err, c)
+		return
+	}
+
+	items := common.ItemSpeeds(answers)
+	if len(items) > limit {
+		items = items[:limit]
+	}
+
+	// ================ 3. Fetch question text ================
+	if len(items) > 0 {
+		ids := make([]int, len(items))
+		for i, item := range items {
+			ids[i] = item.ID
+		}
+		columns := []string{schema.QUESTION_ID, schema.QUESTION_QUESTION}
+		questions, err := qc.questionPeer.Select([]*string{&columns[0], &columns[1]}, squirrel.Eq{schema.QUESTION_ID: ids}, nil, nil, nil)
+		if err != nil {
+			common.ResponseError(http.StatusInternalServerError, "Failed to fetch data from database", models.ErrCodeInternalError, err, c)
+			return
+		}
+		text := make(map[int]string, len(questions))
+		for _, q := range questions {
+			if q.Id != nil && q.Question != nil {
+				text[*q.Id] = *q.Question
+			}
+		}
+		for i := range items {
+			items[i].Text = text[items[i].ID]
+		}
+	}
+
+	// ================ 4. Send response ================
+	common.ResponseSuccess(http.StatusOK, models.SpeedReport{
+		Days:    days,
+		Overall: common.SummarizeAnswers(answers),
+		Items:   items,
+	}, c)
+}
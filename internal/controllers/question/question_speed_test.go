@@ -0,0 +1,70 @@
+package question
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+
+	dbModels "word-flashcard/data/models"
+	"word-flashcard/data/schema"
+	"word-flashcard/internal/models"
+	"word-flashcard/utils"
+
+	"github.com/Masterminds/squirrel"
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+// TestGetQuestionsSpeed tests the GetQuestionsSpeed handler lists questions
+// slowest first and flags the ones answered correctly but slowly
+func (suite *ControllerTestSuite) TestGetQuestionsSpeed() {
+	suite.mockQuestionAnswerLogPeer.EXPECT().
+		Select(mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+		Return([]*dbModels.QuestionAnswerLog{
+			{QuestionId: utils.IntPtr(1), IsCorrect: utils.BoolPtr(true), ResponseMs: utils.IntPtr(9000)},
+			{QuestionId: utils.IntPtr(1), IsCorrect: utils.BoolPtr(true), ResponseMs: utils.IntPtr(11000)},
+			{QuestionId: utils.IntPtr(2), IsCorrect: utils.BoolPtr(true), ResponseMs: utils.IntPtr(2000)},
+			{QuestionId: utils.IntPtr(2), IsCorrect: utils.BoolPtr(false), ResponseMs: utils.IntPtr(4000)},
+		}, nil).Times(1)
+	suite.mockQuestionPeer.EXPECT().
+		Select(mock.Anything, squirrel.Eq{schema.QUESTION_ID: []int{1, 2}}, mock.Anything, mock.Anything, mock.Anything).
+		Return([]*dbModels.Question{
+			{Id: utils.IntPtr(1), Question: utils.StrPtr("Slow one")},
+			{Id: utils.IntPtr(2), Question: utils.StrPtr("Quick one")},
+		}, nil).Times(1)
+
+	w := httptest.NewRecorder()
+	ctx, _ := gin.CreateTestContext(w)
+	ctx.Request = httptest.NewRequest(http.MethodGet, "/api/questions/speed?days=30", nil)
+	suite.controller.GetQuestionsSpeed(ctx)
+
+	assert.Equal(suite.T(), http.StatusOK, w.Code)
+	var report models.SpeedReport
+	assert.NoError(suite.T(), json.Unmarshal(w.Body.Bytes(), &report))
+	assert.Equal(suite.T(), 30, report.Days)
+	assert.Equal(suite.T(), 4, report.Overall.AnswerCount)
+	if assert.Len(suite.T(), report.Items, 2) {
+		assert.Equal(suite.T(), "Slow one", report.Items[0].Text)
+		assert.Equal(suite.T(), 10000, *report.Items[0].MedianMs)
+		assert.True(suite.T(), report.Items[0].SlowButCorrect)
+		assert.Equal(suite.T(), "Quick one", report.Items[1].Text)
+		assert.Equal(suite.T(), 1, report.Items[1].CorrectCount)
+		assert.False(suite.T(), report.Items[1].SlowButCorrect)
+	}
+}
+
+// TestGetQuestionsSpeedLogError tests that a failed answer log query returns 500
+func (suite *ControllerTestSuite) TestGetQuestionsSpeedLogError() {
+	suite.mockQuestionAnswerLogPeer.EXPECT().
+		Select(mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+		Return(nil, errors.New("db error")).Times(1)
+
+	w := httptest.NewRecorder()
+	ctx, _ := gin.CreateTestContext(w)
+	ctx.Request = httptest.NewRequest(http.MethodGet, "/api/questions/speed", nil)
+	suite.controller.GetQuestionsSpeed(ctx)
+
+	assert.Equal(suite.T(), http.StatusInternalServerError, w.Code)
+}
@@ -2,7 +2,8 @@ package question
 
 import (
 	"net/http"
-	"word-flashcard/data/schema"
+	"time"
+	dbModels "word-flashcard/data/models"
 	"word-flashcard/internal/controllers/common"
 	"word-flashcard/internal/models"
 
@@ -10,23 +11,61 @@ import (
 )
 
 // StatsQuestions @Summary Get question statistics
-// @Description Get question count distribution by accuracy rate in 10% intervals, each bucket further broken down by practice count
+// @Description Get question count distribution by accuracy rate in 10% intervals, each bucket further broken down by practice count,
+// @Description overall and per question type, and the median and percentile answer times of timed answers from the last 90 days
 // @Tags questions
 // @Produce json
 // @Success 200 {object} models.QuestionStats "Question accuracy distribution"
 // @Failure 500 {object} models.ErrorResponse "Internal server error - Failed to fetch questions"
 // @Router /api/questions/stats [get]
 func (qc *Controller) StatsQuestions(c *gin.Context) {
-	// ================ 1. Fetch minimal question data for accuracy computation ================
-	cpCol := schema.QUESTION_COUNT_PRACTISE
-	cfpCol := schema.QUESTION_COUNT_FAILURE_PRACTISE
-	questions, err := qc.questionPeer.Select([]*string{&cpCol, &cfpCol}, nil, nil, nil, nil)
+	// ================ 1. Count questions per type and practice counters ================
+	groups, err := qc.questionPeer.CountByPractise()
 	if err != nil {
 		common.ResponseError(http.StatusInternalServerError, "Failed to fetch questions", models.ErrCodeInternalError, err, c)
 		return
 	}
 
-	// ================ 2. Bucket questions by accuracy rate (100% → N/A) ================
+	// ================ 2. Bucket questions by accuracy rate, overall and per type ================
+	byType := make(map[string][]*dbModels.QuestionPractiseGroup)
+	countByType := make(map[string]int)
+	for _, g := range groups {
+		questionType := (&models.Question{QuestionType: g.QuestionType}).Type()
+		byType[questionType] = append(byType[questionType], g)
+		if g.QuestionCount != nil {
+			countByType[questionType] += *g.QuestionCount
+		}
+	}
+	typeDistribution := []models.QuestionTypeStats{}
+	for _, questionType := range questionTypes {
+		if countByType[questionType] == 0 {
+			continue
+		}
+		typeDistribution = append(typeDistribution, models.QuestionTypeStats{
+			QuestionType:         questionType,
+			Count:                countByType[questionType],
+			AccuracyDistribution: buildAccuracyBuckets(byType[questionType]),
+		})
+	}
+
+	// ================ 3. Summarize answer times ================
+	answers, err := qc.fetchTimedQuestionAnswers(common.DefaultSpeedDays, time.Now().UTC())
+	if err != nil {
+		common.ResponseError(http.StatusInternalServerError, "Failed to fetch answer logs", models.ErrCodeInternalError, err, c)
+		return
+	}
+
+	// ================ 4. Send response ================
+	common.ResponseSuccess(http.StatusOK, models.QuestionStats{
+		AccuracyDistribution: buildAccuracyBuckets(groups),
+		TypeDistribution:     typeDistribution,
+		ResponseTimes:        common.SummarizeAnswers(answers),
+	}, c)
+}
+
+// buildAccuracyBuckets buckets grouped questions by accuracy rate (never
+// practised → N/A), breaking each bucket down further by practice count.
+func buildAccuracyBuckets(groups []*dbModels.QuestionPractiseGroup) []models.AccuracyBucket {
 	buckets := []models.AccuracyBucket{
 		{Range: "100%", Count: 0},
 		{Range: "91-99%", Count: 0},
@@ -43,24 +82,31 @@ func (qc *Controller) StatsQuestions(c *gin.Context) {
 		{Range: "N/A", Count: 0},
 	}
 
-	// practiceCounts[i] collects the practice count of every question that
-	// fell into buckets[i], so it can be broken down further below.
-	practiceCounts := make([][]int, len(buckets))
+	// practiceCounts[i] counts the questions that fell into buckets[i] per
+	// practice count, so it can be broken down further below.
+	practiceCounts := make([]map[int]int, len(buckets))
+	for i := range practiceCounts {
+		practiceCounts[i] = make(map[int]int)
+	}
 
-	for _, q := range questions {
-		if q.CountPractise == nil || *q.CountPractise == 0 {
-			buckets[12].Count++
-			practiceCounts[12] = append(practiceCounts[12], 0)
+	for _, g := range groups {
+		if g.QuestionCount == nil {
 			continue
 		}
-		successCount := *q.CountPractise
-		if q.CountFailurePractise != nil {
-			successCount -= *q.CountFailurePractise
+		n := *g.QuestionCount
+		if g.CountPractise == nil || *g.CountPractise == 0 {
+			buckets[12].Count += n
+			practiceCounts[12][0] += n
+			continue
+		}
+		successCount := *g.CountPractise
+		if g.CountFailurePractise != nil {
+			successCount -= *g.CountFailurePractise
 		}
 		if successCount < 0 {
 			successCount = 0
 		}
-		accuracy := successCount * 100 / *q.CountPractise
+		accuracy := successCount * 100 / *g.CountPractise
 
 		idx := 11
 		switch {
@@ -87,17 +133,13 @@ func (qc *Controller) StatsQuestions(c *gin.Context) {
 		case accuracy >= 1:
 			idx = 10
 		}
-		buckets[idx].Count++
-		practiceCounts[idx] = append(practiceCounts[idx], *q.CountPractise)
+		buckets[idx].Count += n
+		practiceCounts[idx][*g.CountPractise] += n
 	}
 
-	// ================ 3. Break each accuracy bucket down by practice count ================
+	// Break each accuracy bucket down by practice count
 	for i := range buckets {
-		buckets[i].PracticeCountBreakdown = common.BuildPracticeCountBuckets(practiceCounts[i])
+		buckets[i].PracticeCountBreakdown = common.BuildPracticeCountBucketsFromFrequencies(practiceCounts[i])
 	}
-
-	// ================ 4. Send response ================
-	common.ResponseSuccess(http.StatusOK, models.QuestionStats{
-		AccuracyDistribution: buckets,
-	}, c)
+	return buckets
 }
@@ -5,10 +5,11 @@ import (
 	"net/http"
 	"net/http/httptest"
 
+	dbModels "word-flashcard/data/models"
 	"word-flashcard/data/schema"
 	"word-flashcard/internal/models"
+	"word-flashcard/utils"
 
-	"github.com/Masterminds/squirrel"
 	"github.com/gin-gonic/gin"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
@@ -16,20 +17,15 @@ import (
 
 // TestStatsQuestions tests the StatsQuestions handler
 func (suite *ControllerTestSuite) TestStatsQuestions() {
-	// Select receives only the two count columns; match by value, not pointer address.
 	suite.mockQuestionPeer.EXPECT().
-		Select(
-			mock.MatchedBy(func(cols []*string) bool {
-				return len(cols) == 2 &&
-					*cols[0] == schema.QUESTION_COUNT_PRACTISE &&
-					*cols[1] == schema.QUESTION_COUNT_FAILURE_PRACTISE
-			}),
-			(squirrel.Sqlizer)(nil),
-			([]*string)(nil),
-			(*uint64)(nil),
-			(*uint64)(nil),
-		).
-		Return(getSampleQuestions(), nil).Times(1)
+		CountByPractise().
+		Return(getSamplePractiseGroups(), nil).Times(1)
+	suite.mockQuestionAnswerLogPeer.EXPECT().
+		Select(mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+		Return([]*dbModels.QuestionAnswerLog{
+			{QuestionId: utils.IntPtr(1), IsCorrect: utils.BoolPtr(true), ResponseMs: utils.IntPtr(4000)},
+			{QuestionId: utils.IntPtr(2), IsCorrect: utils.BoolPtr(false), ResponseMs: utils.IntPtr(8000)},
+		}, nil).Times(1)
 
 	// Create a test HTTP request and call the handler
 	w := httptest.NewRecorder()
@@ -43,40 +39,97 @@ func (suite *ControllerTestSuite) TestStatsQuestions() {
 	// Q1 (10-2)*100/10=80 → "71-80%" (count_practise=10), Q2 (8-0)*100/8=100 → "100%" (count_practise=8)
 	// Q3 (2-1)*100/2=50  → "41-50%" (count_practise=2), Q4 (15-4)*100/15=73 → "71-80%" (count_practise=15)
 	// Q5 (3-0)*100/3=100 → "100%" (count_practise=3)
+	// Every sample question is a legacy row, so the single_choice breakdown
+	// repeats the overall distribution.
 	emptyBreakdown := []models.PracticeCountBucket{{Range: "0", Count: 0}}
+	distribution := []models.AccuracyBucket{
+		{Range: "100%", Count: 2, PracticeCountBreakdown: []models.PracticeCountBucket{
+			{Range: "0", Count: 0},
+			{Range: "1", Count: 0},
+			{Range: "2 ~ 4", Count: 1},
+			{Range: "5+", Count: 1},
+		}},
+		{Range: "91-99%", Count: 0, PracticeCountBreakdown: emptyBreakdown},
+		{Range: "81-90%", Count: 0, PracticeCountBreakdown: emptyBreakdown},
+		{Range: "71-80%", Count: 2, PracticeCountBreakdown: []models.PracticeCountBucket{
+			{Range: "0", Count: 0},
+			{Range: "1", Count: 0},
+			{Range: "2 ~ 4", Count: 0},
+			{Range: "5 ~ 9", Count: 0},
+			{Range: "10+", Count: 2},
+		}},
+		{Range: "61-70%", Count: 0, PracticeCountBreakdown: emptyBreakdown},
+		{Range: "51-60%", Count: 0, PracticeCountBreakdown: emptyBreakdown},
+		{Range: "41-50%", Count: 1, PracticeCountBreakdown: []models.PracticeCountBucket{
+			{Range: "0", Count: 0},
+			{Range: "1", Count: 0},
+			{Range: "2+", Count: 1},
+		}},
+		{Range: "31-40%", Count: 0, PracticeCountBreakdown: emptyBreakdown},
+		{Range: "21-30%", Count: 0, PracticeCountBreakdown: emptyBreakdown},
+		{Range: "11-20%", Count: 0, PracticeCountBreakdown: emptyBreakdown},
+		{Range: "1-10%", Count: 0, PracticeCountBreakdown: emptyBreakdown},
+		{Range: "0%", Count: 0, PracticeCountBreakdown: emptyBreakdown},
+		{Range: "N/A", Count: 0, PracticeCountBreakdown: emptyBreakdown},
+	}
 	expected := models.QuestionStats{
-		AccuracyDistribution: []models.AccuracyBucket{
-			{Range: "100%", Count: 2, PracticeCountBreakdown: []models.PracticeCountBucket{
-				{Range: "0", Count: 0},
-				{Range: "1", Count: 0},
-				{Range: "2 ~ 4", Count: 1},
-				{Range: "5+", Count: 1},
-			}},
-			{Range: "91-99%", Count: 0, PracticeCountBreakdown: emptyBreakdown},
-			{Range: "81-90%", Count: 0, PracticeCountBreakdown: emptyBreakdown},
-			{Range: "71-80%", Count: 2, PracticeCountBreakdown: []models.PracticeCountBucket{
-				{Range: "0", Count: 0},
-				{Range: "1", Count: 0},
-				{Range: "2 ~ 4", Count: 0},
-				{Range: "5 ~ 9", Count: 0},
-				{Range: "10+", Count: 2},
-			}},
-			{Range: "61-70%", Count: 0, PracticeCountBreakdown: emptyBreakdown},
-			{Range: "51-60%", Count: 0, PracticeCountBreakdown: emptyBreakdown},
-			{Range: "41-50%", Count: 1, PracticeCountBreakdown: []models.PracticeCountBucket{
-				{Range: "0", Count: 0},
-				{Range: "1", Count: 0},
-				{Range: "2+", Count: 1},
-			}},
-			{Range: "31-40%", Count: 0, PracticeCountBreakdown: emptyBreakdown},
-			{Range: "21-30%", Count: 0, PracticeCountBreakdown: emptyBreakdown},
-			{Range: "11-20%", Count: 0, PracticeCountBreakdown: emptyBreakdown},
-			{Range: "1-10%", Count: 0, PracticeCountBreakdown: emptyBreakdown},
-			{Range: "0%", Count: 0, PracticeCountBreakdown: emptyBreakdown},
-			{Range: "N/A", Count: 0, PracticeCountBreakdown: emptyBreakdown},
+		AccuracyDistribution: distribution,
+		TypeDistribution: []models.QuestionTypeStats{
+			{QuestionType: schema.QUESTION_TYPE_SINGLE_CHOICE, Count: 5, AccuracyDistribution: distribution},
+		},
+		ResponseTimes: models.ResponseTimes{
+			AnswerCount: 2,
+			MedianMs:    utils.IntPtr(6000),
+			P75Ms:       utils.IntPtr(7000),
+			P90Ms:       utils.IntPtr(7600),
 		},
 	}
 	expectedJSON, err := json.Marshal(expected)
 	assert.NoError(suite.T(), err)
 	assert.Equal(suite.T(), string(expectedJSON), w.Body.String())
 }
+
+// TestStatsQuestionsByType tests that questions are split per question type, in type order
+func (suite *ControllerTestSuite) TestStatsQuestionsByType() {
+	groups := getSamplePractiseGroups()
+	groups[0].QuestionType = utils.StrPtr(schema.QUESTION_TYPE_MATCHING)
+	groups[1].QuestionType = utils.StrPtr(schema.QUESTION_TYPE_TRUE_FALSE)
+	groups[1].QuestionCount = utils.IntPtr(4)
+	groups[2].QuestionType = utils.StrPtr(schema.QUESTION_TYPE_SINGLE_CHOICE)
+	suite.mockQuestionPeer.EXPECT().
+		CountByPractise().
+		Return(groups, nil).Times(1)
+	suite.mockQuestionAnswerLogPeer.EXPECT().
+		Select(mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+		Return([]*dbModels.QuestionAnswerLog{}, nil).Times(1)
+
+	w := httptest.NewRecorder()
+	ctx, _ := gin.CreateTestContext(w)
+	ctx.Request = httptest.NewRequest(http.MethodGet, "/api/questions/stats", nil)
+	suite.controller.StatsQuestions(ctx)
+
+	assert.Equal(suite.T(), http.StatusOK, w.Code)
+	var stats models.QuestionStats
+	suite.Require().NoError(json.Unmarshal(w.Body.Bytes(), &stats))
+	suite.Require().Len(stats.TypeDistribution, 3)
+	suite.Equal(schema.QUESTION_TYPE_SINGLE_CHOICE, stats.TypeDistribution[0].QuestionType)
+	suite.Equal(3, stats.TypeDistribution[0].Count)
+	suite.Equal(schema.QUESTION_TYPE_TRUE_FALSE, stats.TypeDistribution[1].QuestionType)
+	suite.Equal(4, stats.TypeDistribution[1].Count)
+	// 4 questions at (8-0)*100/8=100 → "100%"
+	suite.Equal(4, stats.TypeDistribution[1].AccuracyDistribution[0].Count)
+	suite.Equal(schema.QUESTION_TYPE_MATCHING, stats.TypeDistribution[2].QuestionType)
+	suite.Equal(1, stats.TypeDistribution[2].Count)
+}
+
+// getSamplePractiseGroups returns one practice group per question of
+// getSampleQuestions, all of them legacy rows without a question type
+func getSamplePractiseGroups() []*dbModels.QuestionPractiseGroup {
+	return []*dbModels.QuestionPractiseGroup{
+		{CountPractise: utils.IntPtr(10), CountFailurePractise: utils.IntPtr(2), QuestionCount: utils.IntPtr(1)},
+		{CountPractise: utils.IntPtr(8), CountFailurePractise: utils.IntPtr(0), QuestionCount: utils.IntPtr(1)},
+		{CountPractise: utils.IntPtr(2), CountFailurePractise: utils.IntPtr(1), QuestionCount: utils.IntPtr(1)},
+		{CountPractise: utils.IntPtr(15), CountFailurePractise: utils.IntPtr(4), QuestionCount: utils.IntPtr(1)},
+		{CountPractise: utils.IntPtr(3), CountFailurePractise: utils.IntPtr(0), QuestionCount: utils.IntPtr(1)},
+	}
+}
@@ -2,11 +2,10 @@ package question
 
 import (
 	"net/http"
-	"word-flashcard/data/schema"
+	"time"
 	"word-flashcard/internal/controllers/common"
 	"word-flashcard/internal/models"
 
-	"github.com/Masterminds/squirrel"
 	"github.com/gin-gonic/gin"
 )
 
@@ -14,31 +13,36 @@ import (
 // @Description Get daily practice count / accuracy rate across all questions over the last N days, zero-filled for days with no activity
 // @Tags questions
 // @Produce json
-// @Param days query int false "Number of days to include (default: 30, max: 90)"
+// @Param days query int false "Number of days to include (default: 30, max: 3650)"
+// @Param tz query string false "IANA timezone to bucket days in (default: X-Timezone header, else the stored report timezone, else REPORT_TIMEZONE)"
+// @Param X-Timezone header string false "IANA timezone to bucket days in when tz is not given"
 // @Success 200 {array} models.QuestionTrendPoint "Daily trend points, ascending by date"
-// @Failure 400 {object} models.ErrorResponse "Bad request - Invalid days parameter"
+// @Failure 400 {object} models.ErrorResponse "Bad request - Invalid days or timezone parameter"
 // @Failure 500 {object} models.ErrorResponse "Internal server error - Failed to fetch data from database"
 // @Router /api/questions/trend [get]
 func (qc *Controller) GetQuestionsTrend(c *gin.Context) {
 	// ================ 1. Parse request parameters ================
 	days, err := common.ParseIntQueryParam(c, "days", 30)
-	if err != nil || days < 1 || days > 90 {
+	if err != nil || days < 1 || days > common.MaxTrendDays {
 		common.ResponseError(http.StatusBadRequest, "Invalid days parameter", models.ErrCodeInvalidRequest, err, c)
 		return
 	}
+	loc, err := common.RequestReportLocation(c)
+	if err != nil {
+		common.ResponseError(http.StatusBadRequest, "Invalid timezone parameter", models.ErrCodeInvalidRequest, err, c)
+		return
+	}
 
 	// ================ 2. Fetch data from database ================
-	now := common.NowInReportTimeZone()
-	since := now.AddDate(0, 0, -(days - 1))
-	where := squirrel.GtOrEq{schema.COMMON_CREATED_AT: since}
-	logs, err := qc.questionAnswerLogPeer.Select([]*string{}, where, nil, nil, nil)
+	now := time.Now().In(loc)
+	stats, err := qc.questionAnswerLogPeer.DailyStats(common.TrendWindowStart(days, now), loc)
 	if err != nil {
 		common.ResponseError(http.StatusInternalServerError, "Failed to fetch data from database", models.ErrCodeInternalError, err, c)
 		return
 	}
 
 	// ================ 3. Aggregate into zero-filled daily trend points ================
-	points := qc.buildQuestionTrendPoints(logs, days, now)
+	points := qc.buildQuestionTrendPoints(stats, days, now)
 
 	// ================ 4. Send response ================
 	common.ResponseSuccess(http.StatusOK, points, c)
@@ -1,6 +1,7 @@
 package question
 
 import (
+	"encoding/json"
 	"fmt"
 	"log/slog"
 	"net/http"
@@ -10,13 +11,15 @@ import (
 	"word-flashcard/data/schema"
 	"word-flashcard/internal/controllers/common"
 	"word-flashcard/internal/models"
+	"word-flashcard/utils"
 
 	"github.com/Masterminds/squirrel"
 	"github.com/gin-gonic/gin"
 )
 
 // UpdateQuestions @Summary Update a question
-// @Description Update an existing question's properties like familiarity level
+// @Description Update an existing question's properties like familiarity level. A response is graded by the server,
+// @Description which also counts it in count_practise and count_failure_practise instead of the values sent.
 // @Tags questions
 // @Accept json
 // @Produce json
@@ -41,11 +44,33 @@ func (qc *Controller) UpdateQuestions(c *gin.Context) {
 	if err != nil {
 		common.ResponseError(http.StatusBadRequest, "Invalid request body", models.ErrCodeInvalidRequest, err, c)
 		return
-	} else if err := qc.validateQuestionFields(&questionData, true); err != nil {
+	}
+	if questionData.QuestionType != nil {
+		applyQuestionTypeDefaults(&questionData)
+	}
+	if err := qc.validateQuestionFields(&questionData, true); err != nil {
 		common.ResponseError(http.StatusBadRequest, err.Error(), models.ErrCodeValidationError, err, c)
 		return
 	}
 
+	// A response is graded against the stored options, so they are loaded
+	// before anything is written
+	var key *answerKey
+	if questionData.Response != nil {
+		key, err = qc.fetchAnswerKey(questionID)
+		if err != nil {
+			common.ResponseError(http.StatusInternalServerError, "Failed to fetch data from database", models.ErrCodeInternalError, err, c)
+			return
+		} else if key == nil {
+			common.ResponseError(http.StatusNotFound, "Question not found", models.ErrCodeNotFound, nil, c)
+			return
+		} else if !models.QuestionTypeUsesOptionTable(key.questionType) {
+			err := common.NewFieldError("response is invalid", "reason", "use selected_option for question_type "+key.questionType)
+			common.ResponseError(http.StatusBadRequest, err.Error(), models.ErrCodeValidationError, err, c)
+			return
+		}
+	}
+
 	// ================ 2. Convert to data model ================
 	questionModel := questionData.ToDataModel()
 	questionModel.Id = nil // To prevent updating the ID field
@@ -56,9 +81,30 @@ func (qc *Controller) UpdateQuestions(c *gin.Context) {
 		questionModel.LastAnsweredAt = &now
 	}
 
+	// A graded response is counted from the server's own grade, so the stats,
+	// random buckets and leech counts agree with the logged is_correct; the
+	// counters sent in the body are ignored
+	var responseCorrect bool
+	if key != nil {
+		responseCorrect = gradeResponse(key.questionType, key.options, questionData.Response)
+		countPractise, countFailurePractise := key.countAnswer(responseCorrect)
+		questionModel.CountPractise, questionModel.CountFailurePractise = &countPractise, &countFailurePractise
+	}
+
 	// ================ 4. Update data in database ================
-	where := squirrel.Eq{schema.QUESTION_ID: questionID}
-	effected, err := qc.questionPeer.Update(questionModel, where)
+	// Restating the type replaces the options along with it, in the same
+	// transaction; a type without question_options drops any left over from
+	// the previous type
+	var effected int64
+	if questionData.QuestionType != nil {
+		var options []*dbModels.QuestionOption
+		if questionData.UsesOptionTable() {
+			options = optionDataModels(questionData.Options)
+		}
+		effected, err = qc.questionPeer.UpdateWithOptions(questionID, questionModel, options)
+	} else {
+		effected, err = qc.questionPeer.Update(questionModel, squirrel.Eq{schema.QUESTION_ID: questionID})
+	}
 	if err != nil {
 		common.ResponseError(http.StatusInternalServerError, "Failed to update data in database", models.ErrCodeInternalError, err, c)
 		return
@@ -67,23 +113,10 @@ func (qc *Controller) UpdateQuestions(c *gin.Context) {
 		return
 	}
 
-	// ================ 5. Log the answered option, if the quiz reported one ================
-	// selected_option always refers to the question's own option_a-d ordering,
-	// not the shuffled order the quiz displayed it in.
-	// Best-effort: the question's own update already succeeded above, so a
-	// logging failure here must not turn into a user-facing error for an
-	// update that already committed.
-	if questionData.SelectedOption != nil {
-		selectedOption := strings.ToUpper(*questionData.SelectedOption)
-		isCorrect := questionModel.Answer != nil && selectedOption == *questionModel.Answer
-		answerLog := &dbModels.QuestionAnswerLog{
-			QuestionId:     &questionID,
-			SelectedOption: &selectedOption,
-			IsCorrect:      &isCorrect,
-		}
-		if _, err := qc.questionAnswerLogPeer.Insert(answerLog); err != nil {
-			slog.Error("Failed to log question answer", "question_id", questionID, "error", err)
-		}
+	// ================ 5. Log the answer, then check for a leech ================
+	answerLog := newAnswerLog(questionID, &questionData, questionModel.Answer, key, responseCorrect)
+	if answerLog != nil {
+		qc.logAnswerAndCheckLeech(answerLog)
 	}
 
 	// ================ 6. Query inserted data ================
@@ -97,7 +130,62 @@ func (qc *Controller) UpdateQuestions(c *gin.Context) {
 
 	// ================ 7. Transform data to API model ================
 	questionEntity := new(models.Question).FromDataModel(questions[0])
+	if err := qc.attachOptions([]*models.Question{questionEntity}); err != nil {
+		common.ResponseError(http.StatusInternalServerError, "Inserted but failed to fetch data from database", models.ErrCodeInternalError, err, c)
+		return
+	}
+	if answerLog != nil {
+		questionEntity.IsCorrect = answerLog.IsCorrect
+	}
 
 	// ================ 8. Send response ================
 	common.ResponseSuccess(http.StatusOK, questionEntity, c)
 }
+
+// newAnswerLog builds the answer log row for the answer questionData reports,
+// or nil when it reports none. selected_option always refers to the
+// question's own option_a-d ordering, not the shuffled order the quiz
+// displayed it in; types with question_options log the response graded
+// against key instead.
+func newAnswerLog(questionID int, questionData *models.Question, answer *string, key *answerKey, responseCorrect bool) *dbModels.QuestionAnswerLog {
+	var answerLog *dbModels.QuestionAnswerLog
+	if questionData.SelectedOption != nil {
+		selectedOption := strings.ToUpper(*questionData.SelectedOption)
+		isCorrect := answer != nil && selectedOption == *answer
+		answerLog = &dbModels.QuestionAnswerLog{
+			QuestionId:     &questionID,
+			SelectedOption: &selectedOption,
+			IsCorrect:      &isCorrect,
+		}
+	} else if key != nil {
+		response, _ := json.Marshal(questionData.Response)
+		answerLog = &dbModels.QuestionAnswerLog{
+			QuestionId:     &questionID,
+			SelectedOption: utils.StrPtr(""),
+			IsCorrect:      &responseCorrect,
+			Response:       utils.StrPtr(string(response)),
+		}
+	}
+	if answerLog != nil {
+		answerLog.ResponseMs = questionData.ResponseMs
+	}
+	return answerLog
+}
+
+// logAnswerAndCheckLeech stores answerLog and, when it is a miss, tags the
+// question as a leech if it keeps being missed. Both are best-effort: the
+// question's own update has already committed, so a failure here must not
+// turn into a user-facing error.
+func (qc *Controller) logAnswerAndCheckLeech(answerLog *dbModels.QuestionAnswerLog) {
+	questionID := *answerLog.QuestionId
+	if _, err := qc.questionAnswerLogPeer.Insert(answerLog); err != nil {
+		slog.Error("Failed to log question answer", "question_id", questionID, "error", err)
+		return
+	}
+	if *answerLog.IsCorrect {
+		return
+	}
+	if _, err := qc.leechDetector.RecordQuestionFailure(questionID); err != nil {
+		slog.Error("Failed to check question for leech", "question_id", questionID, "error", err)
+	}
+}
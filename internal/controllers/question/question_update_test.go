@@ -11,6 +11,8 @@ import (
 
 	dbModels "word-flashcard/data/models"
 	"word-flashcard/data/schema"
+	"word-flashcard/internal/leechdetect"
+	"word-flashcard/internal/models"
 	"word-flashcard/utils"
 
 	"github.com/Masterminds/squirrel"
@@ -101,13 +103,14 @@ func (suite *ControllerTestSuite) TestUpdateQuestionsWithSelectedOptionLogsCorre
 			isQuestionID := log.QuestionId != nil && *log.QuestionId == testID
 			isSelectedOption := log.SelectedOption != nil && *log.SelectedOption == "A"
 			isCorrect := log.IsCorrect != nil && *log.IsCorrect
-			return isQuestionID && isSelectedOption && isCorrect
+			isResponseMs := log.ResponseMs != nil && *log.ResponseMs == 3200
+			return isQuestionID && isSelectedOption && isCorrect && isResponseMs
 		})).
 		Return(int64(1), nil).Times(1)
 
 	w := httptest.NewRecorder()
 	ctx, _ := gin.CreateTestContext(w)
-	requestBody := "{\"answer\": \"A\", \"selected_option\": \"a\", \"practiced\": true}"
+	requestBody := "{\"answer\": \"A\", \"selected_option\": \"a\", \"practiced\": true, \"response_ms\": 3200}"
 	ctx.Request = httptest.NewRequest(http.MethodPut, "/api/questions/1", io.NopCloser(bytes.NewReader([]byte(requestBody))))
 	ctx.Params = gin.Params{gin.Param{Key: "id", Value: "1"}}
 	suite.controller.UpdateQuestions(ctx)
@@ -135,6 +138,53 @@ func (suite *ControllerTestSuite) TestUpdateQuestionsWithSelectedOptionLogsIncor
 			return isSelectedOption && isCorrect
 		})).
 		Return(int64(1), nil).Times(1)
+	suite.mockSettingPeer.EXPECT().
+		Select(mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+		Return([]*dbModels.Setting{}, nil).Times(1)
+	suite.mockQuestionAnswerLogPeer.EXPECT().
+		Count(leechdetect.QuestionFailures(testID)).
+		Return(int64(1), nil).Times(1)
+
+	w := httptest.NewRecorder()
+	ctx, _ := gin.CreateTestContext(w)
+	requestBody := "{\"answer\": \"A\", \"selected_option\": \"B\", \"practiced\": true}"
+	ctx.Request = httptest.NewRequest(http.MethodPut, "/api/questions/1", io.NopCloser(bytes.NewReader([]byte(requestBody))))
+	ctx.Params = gin.Params{gin.Param{Key: "id", Value: "1"}}
+	suite.controller.UpdateQuestions(ctx)
+
+	assert.Equal(suite.T(), http.StatusOK, w.Code)
+}
+
+// TestUpdateQuestionsTagsLeech tests that the wrong answer reaching the
+// leech threshold tags and, with auto-suspend on, suspends the question
+func (suite *ControllerTestSuite) TestUpdateQuestionsTagsLeech() {
+	testID := 1
+	where := squirrel.Eq{schema.QUESTION_ID: testID}
+	dbQuestion := getSampleQuestions()[0]
+
+	suite.mockQuestionPeer.EXPECT().
+		Update(mock.MatchedBy(func(question *dbModels.Question) bool { return question.IsLeech == nil }), where).
+		Return(int64(testID), nil).Times(1)
+	suite.mockQuestionAnswerLogPeer.EXPECT().
+		Insert(mock.Anything).
+		Return(int64(1), nil).Times(1)
+	suite.mockSettingPeer.EXPECT().
+		Select(mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+		Return([]*dbModels.Setting{
+			{Name: utils.StrPtr(schema.SETTING_LEECH_QUESTION_FAILS), Value: utils.StrPtr("4")},
+			{Name: utils.StrPtr(schema.SETTING_LEECH_AUTO_SUSPEND), Value: utils.StrPtr("true")},
+		}, nil).Times(1)
+	suite.mockQuestionAnswerLogPeer.EXPECT().
+		Count(leechdetect.QuestionFailures(testID)).
+		Return(int64(4), nil).Times(1)
+	suite.mockQuestionPeer.EXPECT().
+		Update(mock.MatchedBy(func(question *dbModels.Question) bool {
+			return question.IsLeech != nil && *question.IsLeech && question.IsSuspended != nil && *question.IsSuspended
+		}), where).
+		Return(int64(testID), nil).Times(1)
+	suite.mockQuestionPeer.EXPECT().
+		Select(mock.Anything, where, mock.Anything, mock.Anything, mock.Anything).
+		Return([]*dbModels.Question{dbQuestion}, nil).Times(1)
 
 	w := httptest.NewRecorder()
 	ctx, _ := gin.CreateTestContext(w)
@@ -174,3 +224,137 @@ func (suite *ControllerTestSuite) TestUpdateQuestionsLogInsertFailureStillReturn
 
 	assert.Equal(suite.T(), http.StatusOK, w.Code)
 }
+
+// TestUpdateQuestionsWithResponseGradesAndLogs tests that a response to a
+// question_options type is graded server-side, logged with its JSON and
+// counted from that grade rather than the counters in the body
+func (suite *ControllerTestSuite) TestUpdateQuestionsWithResponseGradesAndLogs() {
+	testID := 1
+	where := squirrel.Eq{schema.QUESTION_ID: testID}
+	dbQuestion := getSampleQuestions()[0]
+	dbQuestion.QuestionType = utils.StrPtr(schema.QUESTION_TYPE_ORDERING)
+	dbQuestion.CountPractise = utils.IntPtr(4)
+	dbQuestion.CountFailurePractise = utils.IntPtr(2)
+	storedOptions := []*dbModels.QuestionOption{
+		{Id: utils.IntPtr(4), QuestionId: utils.IntPtr(testID), Position: utils.IntPtr(0), Content: utils.StrPtr("first")},
+		{Id: utils.IntPtr(5), QuestionId: utils.IntPtr(testID), Position: utils.IntPtr(1), Content: utils.StrPtr("second")},
+	}
+
+	suite.mockQuestionPeer.EXPECT().
+		Select(mock.Anything, where, mock.Anything, mock.Anything, mock.Anything).
+		Return([]*dbModels.Question{dbQuestion}, nil).Times(2)
+	suite.mockQuestionOptionPeer.EXPECT().
+		Select(mock.Anything, squirrel.Eq{schema.QUESTION_OPTION_QUESTION_ID: []int{testID}}, mock.Anything, mock.Anything, mock.Anything).
+		Return(storedOptions, nil).Times(2)
+	suite.mockQuestionPeer.EXPECT().
+		Update(mock.MatchedBy(func(question *dbModels.Question) bool {
+			return *question.CountPractise == 5 && *question.CountFailurePractise == 2
+		}), where).
+		Return(int64(testID), nil).Times(1)
+	suite.mockQuestionAnswerLogPeer.EXPECT().
+		Insert(mock.MatchedBy(func(log *dbModels.QuestionAnswerLog) bool {
+			return *log.QuestionId == testID && *log.SelectedOption == "" && *log.IsCorrect &&
+				*log.Response == "{\"option_ids\":[4,5]}"
+		})).
+		Return(int64(1), nil).Times(1)
+
+	w := httptest.NewRecorder()
+	ctx, _ := gin.CreateTestContext(w)
+	requestBody := "{\"count_practise\": 1, \"count_failure_practise\": 1, \"practiced\": true, \"response\": {\"option_ids\": [4, 5]}}"
+	ctx.Request = httptest.NewRequest(http.MethodPut, "/api/questions/1", io.NopCloser(bytes.NewReader([]byte(requestBody))))
+	ctx.Params = gin.Params{gin.Param{Key: "id", Value: "1"}}
+	suite.controller.UpdateQuestions(ctx)
+
+	assert.Equal(suite.T(), http.StatusOK, w.Code)
+	var actual models.Question
+	assert.NoError(suite.T(), json.Unmarshal(w.Body.Bytes(), &actual))
+	assert.Equal(suite.T(), true, *actual.IsCorrect)
+	assert.Len(suite.T(), actual.Options, 2)
+}
+
+// TestUpdateQuestionsWithResponseForLegacyType tests that a response to a
+// single_choice question is rejected before anything is written
+func (suite *ControllerTestSuite) TestUpdateQuestionsWithResponseForLegacyType() {
+	where := squirrel.Eq{schema.QUESTION_ID: 1}
+	suite.mockQuestionPeer.EXPECT().
+		Select(mock.Anything, where, mock.Anything, mock.Anything, mock.Anything).
+		Return([]*dbModels.Question{getSampleQuestions()[0]}, nil).Times(1)
+
+	w := httptest.NewRecorder()
+	ctx, _ := gin.CreateTestContext(w)
+	requestBody := "{\"response\": {\"option_ids\": [1]}}"
+	ctx.Request = httptest.NewRequest(http.MethodPut, "/api/questions/1", io.NopCloser(bytes.NewReader([]byte(requestBody))))
+	ctx.Params = gin.Params{gin.Param{Key: "id", Value: "1"}}
+	suite.controller.UpdateQuestions(ctx)
+
+	assert.Equal(suite.T(), http.StatusBadRequest, w.Code)
+	suite.mockQuestionPeer.AssertNotCalled(suite.T(), "Update", mock.Anything, mock.Anything)
+}
+
+// TestUpdateQuestionsWithResponseNotFound tests that a response to a missing question returns 404
+func (suite *ControllerTestSuite) TestUpdateQuestionsWithResponseNotFound() {
+	suite.mockQuestionPeer.EXPECT().
+		Select(mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+		Return([]*dbModels.Question{}, nil).Times(1)
+
+	w := httptest.NewRecorder()
+	ctx, _ := gin.CreateTestContext(w)
+	requestBody := "{\"response\": {\"text\": \"colour\"}}"
+	ctx.Request = httptest.NewRequest(http.MethodPut, "/api/questions/1", io.NopCloser(bytes.NewReader([]byte(requestBody))))
+	ctx.Params = gin.Params{gin.Param{Key: "id", Value: "1"}}
+	suite.controller.UpdateQuestions(ctx)
+
+	assert.Equal(suite.T(), http.StatusNotFound, w.Code)
+}
+
+// TestUpdateQuestionsRestatingTypeReplacesOptions tests that sending
+// question_type with options swaps the stored options in the same
+// transaction as the question update
+func (suite *ControllerTestSuite) TestUpdateQuestionsRestatingTypeReplacesOptions() {
+	testID := 1
+	where := squirrel.Eq{schema.QUESTION_ID: testID}
+	dbQuestion := getSampleQuestions()[0]
+	dbQuestion.QuestionType = utils.StrPtr(schema.QUESTION_TYPE_MULTIPLE_SELECT)
+
+	suite.mockQuestionPeer.EXPECT().
+		UpdateWithOptions(testID, mock.MatchedBy(func(question *dbModels.Question) bool {
+			return *question.QuestionType == schema.QUESTION_TYPE_MULTIPLE_SELECT && *question.OptionA == "" && *question.Answer == ""
+		}), mock.MatchedBy(func(options []*dbModels.QuestionOption) bool {
+			return len(options) == 2 && *options[0].Content == "red" && *options[0].IsCorrect &&
+				*options[1].Content == "loud" && *options[1].Position == 1
+		})).
+		Return(int64(1), nil).Times(1)
+	suite.mockQuestionPeer.EXPECT().
+		Select(mock.Anything, where, mock.Anything, mock.Anything, mock.Anything).
+		Return([]*dbModels.Question{dbQuestion}, nil).Times(1)
+	suite.mockQuestionOptionPeer.EXPECT().
+		Select(mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+		Return([]*dbModels.QuestionOption{}, nil).Times(1)
+
+	w := httptest.NewRecorder()
+	ctx, _ := gin.CreateTestContext(w)
+	requestBody := "{\"question_type\": \"multiple_select\", \"options\": [{\"content\": \"red\", \"is_correct\": true}, {\"content\": \"loud\"}]}"
+	ctx.Request = httptest.NewRequest(http.MethodPut, "/api/questions/1", io.NopCloser(bytes.NewReader([]byte(requestBody))))
+	ctx.Params = gin.Params{gin.Param{Key: "id", Value: "1"}}
+	suite.controller.UpdateQuestions(ctx)
+
+	assert.Equal(suite.T(), http.StatusOK, w.Code)
+}
+
+// TestUpdateQuestionsRestatingLegacyTypeDropsOptions tests that restating a
+// type without question_options clears any stored options, and that a
+// question missing from the transactional update is reported as not found
+func (suite *ControllerTestSuite) TestUpdateQuestionsRestatingLegacyTypeDropsOptions() {
+	suite.mockQuestionPeer.EXPECT().
+		UpdateWithOptions(1, mock.Anything, []*dbModels.QuestionOption(nil)).
+		Return(int64(0), nil).Times(1)
+
+	w := httptest.NewRecorder()
+	ctx, _ := gin.CreateTestContext(w)
+	requestBody := "{\"question_type\": \"single_choice\", \"option_a\": \"a\", \"answer\": \"A\"}"
+	ctx.Request = httptest.NewRequest(http.MethodPut, "/api/questions/1", io.NopCloser(bytes.NewReader([]byte(requestBody))))
+	ctx.Params = gin.Params{gin.Param{Key: "id", Value: "1"}}
+	suite.controller.UpdateQuestions(ctx)
+
+	assert.Equal(suite.T(), http.StatusNotFound, w.Code)
+}
@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"log/slog"
 	"math/rand"
+	"slices"
 	"time"
 	dbModels "word-flashcard/data/models"
 	"word-flashcard/data/schema"
@@ -28,29 +29,31 @@ import (
 // selected, ignoring the date filter. This ensures the total returned equals count
 // as long as the total question pool is large enough.
 //
+// When questionTypes is non-empty, every phase only draws questions of those
+// types. Suspended questions and those in excludeIDs are never drawn.
+//
 // Bucket/fallback/summary counts below are logged via logRandomSelectionResult,
 // which stays at Debug when the actual count matches what was expected and
 // escalates to Warn on a shortfall.
-func (qc *Controller) fetchRandomQuestionsWeighted(count int, excludeRecentDays *int) ([]*dbModels.Question, error) {
-	var excludeBefore *time.Time
-	if excludeRecentDays != nil && *excludeRecentDays > 0 {
-		t := time.Now().AddDate(0, 0, -*excludeRecentDays)
-		excludeBefore = &t
-	}
+func (qc *Controller) fetchRandomQuestionsWeighted(count int, excludeRecentDays *int, questionTypes []string, excludeIDs []int) ([]*dbModels.Question, error) {
+	excludeBefore := recentCutoff(excludeRecentDays)
 
 	quota1 := count * 5 / 10
 	quota2 := count * 3 / 10
 	quota3 := count - quota1 - quota2
 
-	bucket1Where := applyDateFilter(squirrel.Eq{schema.QUESTION_COUNT_PRACTISE: 0}, excludeBefore)
-	bucket2Where := applyDateFilter(squirrel.And{
+	notSuspended := squirrel.Eq{schema.QUESTION_IS_SUSPENDED: false}
+	bucket1Where := applyIDExclusion(applyDateFilter(applyTypeFilter(squirrel.And{notSuspended, squirrel.Eq{schema.QUESTION_COUNT_PRACTISE: 0}}, questionTypes), excludeBefore), excludeIDs)
+	bucket2Where := applyIDExclusion(applyDateFilter(applyTypeFilter(squirrel.And{
+		notSuspended,
 		squirrel.Gt{schema.QUESTION_COUNT_PRACTISE: 0},
 		squirrel.Expr(fmt.Sprintf("%s * 10 > %s * 3", schema.QUESTION_COUNT_FAILURE_PRACTISE, schema.QUESTION_COUNT_PRACTISE)),
-	}, excludeBefore)
-	bucket3Where := applyDateFilter(squirrel.And{
+	}, questionTypes), excludeBefore), excludeIDs)
+	bucket3Where := applyIDExclusion(applyDateFilter(applyTypeFilter(squirrel.And{
+		notSuspended,
 		squirrel.Gt{schema.QUESTION_COUNT_PRACTISE: 0},
 		squirrel.Expr(fmt.Sprintf("%s * 10 <= %s * 3", schema.QUESTION_COUNT_FAILURE_PRACTISE, schema.QUESTION_COUNT_PRACTISE)),
-	}, excludeBefore)
+	}, questionTypes), excludeBefore), excludeIDs)
 
 	// Phase 1: fetch lowest-priority bucket first; underflow cascades up to harder buckets
 	bucket3, err := qc.fetchQuestionsRecencyWeighted(bucket3Where, quota3)
@@ -79,15 +82,15 @@ func (qc *Controller) fetchRandomQuestionsWeighted(count int, excludeRecentDays
 
 	// Phase 2: fill any remaining quota with recent questions (no date filter)
 	if remaining := count - len(combined); remaining > 0 {
-		var fallbackWhere squirrel.Sqlizer
-		if len(combined) > 0 {
-			selectedIDs := make([]int, len(combined))
-			for i, q := range combined {
-				selectedIDs[i] = *q.Id
+		var fallbackWhere squirrel.Sqlizer = notSuspended
+		if len(combined)+len(excludeIDs) > 0 {
+			selectedIDs := slices.Clone(excludeIDs)
+			for _, q := range combined {
+				selectedIDs = append(selectedIDs, *q.Id)
 			}
-			fallbackWhere = squirrel.NotEq{schema.QUESTION_ID: selectedIDs}
+			fallbackWhere = squirrel.And{notSuspended, squirrel.NotEq{schema.QUESTION_ID: selectedIDs}}
 		}
-		fallback, err := qc.fetchQuestionBucket(fallbackWhere, remaining)
+		fallback, err := qc.fetchQuestionBucket(applyTypeFilter(fallbackWhere, questionTypes), remaining)
 		if err != nil {
 			return nil, err
 		}
@@ -105,6 +108,99 @@ func (qc *Controller) fetchRandomQuestionsWeighted(count int, excludeRecentDays
 	return combined, nil
 }
 
+// drawRandomQuestions fetches count questions with
+// fetchRandomQuestionsWeighted. With favorSlow, up to common.SlowQuota of
+// them are first filled with slow-but-correct questions, slowest first,
+// under the same type, recency and suspension filters; the rest are drawn
+// as usual from the other questions.
+func (qc *Controller) drawRandomQuestions(count int, excludeRecentDays *int, questionTypes []string, favorSlow bool) ([]*dbModels.Question, error) {
+	if !favorSlow {
+		return qc.fetchRandomQuestionsWeighted(count, excludeRecentDays, questionTypes, nil)
+	}
+
+	slow, err := qc.fetchSlowQuestions(common.SlowQuota(count), excludeRecentDays, questionTypes)
+	if err != nil {
+		return nil, err
+	}
+	if len(slow) == 0 {
+		return qc.fetchRandomQuestionsWeighted(count, excludeRecentDays, questionTypes, nil)
+	}
+
+	slowIDs := make([]int, len(slow))
+	for i, q := range slow {
+		slowIDs[i] = *q.Id
+	}
+	rest, err := qc.fetchRandomQuestionsWeighted(count-len(slow), excludeRecentDays, questionTypes, slowIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	combined := append(slow, rest...)
+	rand.Shuffle(len(combined), func(i, j int) {
+		combined[i], combined[j] = combined[j], combined[i]
+	})
+	return combined, nil
+}
+
+// fetchSlowQuestions returns up to limit slow-but-correct questions,
+// slowest first, that fit the draw's filters
+func (qc *Controller) fetchSlowQuestions(limit int, excludeRecentDays *int, questionTypes []string) ([]*dbModels.Question, error) {
+	if limit <= 0 {
+		return nil, nil
+	}
+
+	answers, err := qc.fetchTimedQuestionAnswers(common.DefaultSpeedDays, time.Now().UTC())
+	if err != nil {
+		return nil, err
+	}
+	slowIDs := common.SlowItemIDs(answers)
+	if len(slowIDs) == 0 {
+		return nil, nil
+	}
+
+	where := applyDateFilter(applyTypeFilter(squirrel.Eq{schema.QUESTION_ID: slowIDs, schema.QUESTION_IS_SUSPENDED: false}, questionTypes), recentCutoff(excludeRecentDays))
+	questions, err := qc.questionPeer.Select([]*string{}, where, nil, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	byID := make(map[int]*dbModels.Question, len(questions))
+	for _, q := range questions {
+		if q.Id != nil {
+			byID[*q.Id] = q
+		}
+	}
+	var slow []*dbModels.Question
+	for _, id := range slowIDs {
+		if q, ok := byID[id]; ok {
+			slow = append(slow, q)
+			if len(slow) == limit {
+				break
+			}
+		}
+	}
+	return slow, nil
+}
+
+// recentCutoff returns the created_at before which questions may be drawn
+// when excluding the last excludeRecentDays days, or nil for no cutoff
+func recentCutoff(excludeRecentDays *int) *time.Time {
+	if excludeRecentDays == nil || *excludeRecentDays <= 0 {
+		return nil
+	}
+	t := time.Now().AddDate(0, 0, -*excludeRecentDays)
+	return &t
+}
+
+// applyIDExclusion leaves the questions in excludeIDs out of where.
+// Returns where unchanged when excludeIDs is empty.
+func applyIDExclusion(where squirrel.Sqlizer, excludeIDs []int) squirrel.Sqlizer {
+	if len(excludeIDs) == 0 {
+		return where
+	}
+	return squirrel.And{where, squirrel.NotEq{schema.QUESTION_ID: excludeIDs}}
+}
+
 // applyDateFilter adds a created_at upper bound to exclude recently created records.
 // Returns where unchanged when excludeBefore is nil.
 func applyDateFilter(where squirrel.Sqlizer, excludeBefore *time.Time) squirrel.Sqlizer {
@@ -114,6 +210,25 @@ func applyDateFilter(where squirrel.Sqlizer, excludeBefore *time.Time) squirrel.
 	return squirrel.And{where, squirrel.Lt{schema.COMMON_CREATED_AT: *excludeBefore}}
 }
 
+// applyTypeFilter restricts where to questions of questionTypes.
+// Returns where unchanged when questionTypes is empty.
+func applyTypeFilter(where squirrel.Sqlizer, questionTypes []string) squirrel.Sqlizer {
+	if len(questionTypes) == 0 {
+		return where
+	}
+	return squirrel.And{where, typeFilter(questionTypes)}
+}
+
+// typeFilter matches questions of questionTypes. Legacy rows have no
+// question_type and count as single_choice.
+func typeFilter(questionTypes []string) squirrel.Sqlizer {
+	filter := squirrel.Eq{schema.QUESTION_QUESTION_TYPE: questionTypes}
+	if slices.Contains(questionTypes, schema.QUESTION_TYPE_SINGLE_CHOICE) {
+		return squirrel.Or{filter, squirrel.Eq{schema.QUESTION_QUESTION_TYPE: nil}}
+	}
+	return filter
+}
+
 // fetchQuestionBucket retrieves up to limit random questions matching the given where condition
 func (qc *Controller) fetchQuestionBucket(where squirrel.Sqlizer, limit int) ([]*dbModels.Question, error) {
 	if limit <= 0 {
@@ -7,6 +7,7 @@ import (
 	"word-flashcard/data/mocks"
 	dbModels "word-flashcard/data/models"
 	"word-flashcard/data/schema"
+	"word-flashcard/utils"
 	"word-flashcard/utils/database"
 
 	"github.com/Masterminds/squirrel"
@@ -18,7 +19,7 @@ import (
 func (suite *HelperTestSuite) TestFetchQuestionBucket() {
 	// Setup fresh mock with Select expectation
 	mockPeer := mocks.NewMockQuestionPeer(suite.T())
-	controller := New(mockPeer, mocks.NewMockQuestionAnswerLogPeer(suite.T()))
+	controller := New(mockPeer, mocks.NewMockQuestionOptionPeer(suite.T()), mocks.NewMockQuestionAnswerLogPeer(suite.T()), mocks.NewMockWordPeer(suite.T()), mocks.NewMockWordDefinitionsPeer(suite.T()), nil, nil, nil)
 
 	where := squirrel.Eq{schema.QUESTION_COUNT_PRACTISE: 0}
 	limit := uint64(2)
@@ -54,7 +55,7 @@ func (suite *HelperTestSuite) TestFetchRandomQuestionsWeighted() {
 	// quota is fully met by that sub-query here, so the oldest-first fallback
 	// sub-query never fires and no cascade occurs.
 	mockPeer := mocks.NewMockQuestionPeer(suite.T())
-	controller := New(mockPeer, mocks.NewMockQuestionAnswerLogPeer(suite.T()))
+	controller := New(mockPeer, mocks.NewMockQuestionOptionPeer(suite.T()), mocks.NewMockQuestionAnswerLogPeer(suite.T()), mocks.NewMockWordPeer(suite.T()), mocks.NewMockWordDefinitionsPeer(suite.T()), nil, nil, nil)
 	sampleQuestions := getSampleQuestions()
 
 	randomOrderMatcher := mock.MatchedBy(func(orderBy []*string) bool {
@@ -66,7 +67,7 @@ func (suite *HelperTestSuite) TestFetchRandomQuestionsWeighted() {
 
 	// Bucket 1: unpractised (count_practise = 0) → fetched last, returns 2 items
 	mockPeer.EXPECT().
-		Select(mock.Anything, squirrel.Eq{schema.QUESTION_COUNT_PRACTISE: 0}, randomOrderMatcher, &limit1, (*uint64)(nil)).
+		Select(mock.Anything, squirrel.And{squirrel.Eq{schema.QUESTION_IS_SUSPENDED: false}, squirrel.Eq{schema.QUESTION_COUNT_PRACTISE: 0}}, randomOrderMatcher, &limit1, (*uint64)(nil)).
 		Return([]*dbModels.Question{sampleQuestions[0], sampleQuestions[1]}, nil).Times(1)
 
 	// Bucket 2: high failure rate → fetched second, returns 1 item
@@ -80,7 +81,7 @@ func (suite *HelperTestSuite) TestFetchRandomQuestionsWeighted() {
 		Return([]*dbModels.Question{sampleQuestions[3], sampleQuestions[4]}, nil).Times(1)
 
 	// Poke the method
-	result, err := controller.fetchRandomQuestionsWeighted(5, nil)
+	result, err := controller.fetchRandomQuestionsWeighted(5, nil, nil, nil)
 
 	// Verify the result contains all expected questions (order varies due to shuffle)
 	assert.NoError(suite.T(), err)
@@ -93,7 +94,7 @@ func (suite *HelperTestSuite) TestFetchQuestionsRecencyWeighted() {
 
 	suite.Run("non-positive quota returns empty without querying", func() {
 		mockPeer := mocks.NewMockQuestionPeer(suite.T())
-		controller := New(mockPeer, mocks.NewMockQuestionAnswerLogPeer(suite.T()))
+		controller := New(mockPeer, mocks.NewMockQuestionOptionPeer(suite.T()), mocks.NewMockQuestionAnswerLogPeer(suite.T()), mocks.NewMockWordPeer(suite.T()), mocks.NewMockWordDefinitionsPeer(suite.T()), nil, nil, nil)
 
 		result, err := controller.fetchQuestionsRecencyWeighted(baseWhere, 0)
 
@@ -103,7 +104,7 @@ func (suite *HelperTestSuite) TestFetchQuestionsRecencyWeighted() {
 
 	suite.Run("never-answered group alone fills the quota", func() {
 		mockPeer := mocks.NewMockQuestionPeer(suite.T())
-		controller := New(mockPeer, mocks.NewMockQuestionAnswerLogPeer(suite.T()))
+		controller := New(mockPeer, mocks.NewMockQuestionOptionPeer(suite.T()), mocks.NewMockQuestionAnswerLogPeer(suite.T()), mocks.NewMockWordPeer(suite.T()), mocks.NewMockWordDefinitionsPeer(suite.T()), nil, nil, nil)
 		sampleQuestions := getSampleQuestions()
 
 		noTimestampWhere := squirrel.And{baseWhere, squirrel.Eq{schema.QUESTION_LAST_ANSWERED_AT: nil}}
@@ -124,7 +125,7 @@ func (suite *HelperTestSuite) TestFetchQuestionsRecencyWeighted() {
 
 	suite.Run("shortfall cascades from never-answered into oldest-answered-first", func() {
 		mockPeer := mocks.NewMockQuestionPeer(suite.T())
-		controller := New(mockPeer, mocks.NewMockQuestionAnswerLogPeer(suite.T()))
+		controller := New(mockPeer, mocks.NewMockQuestionOptionPeer(suite.T()), mocks.NewMockQuestionAnswerLogPeer(suite.T()), mocks.NewMockWordPeer(suite.T()), mocks.NewMockWordDefinitionsPeer(suite.T()), nil, nil, nil)
 		sampleQuestions := getSampleQuestions()
 
 		noTimestampWhere := squirrel.And{baseWhere, squirrel.Eq{schema.QUESTION_LAST_ANSWERED_AT: nil}}
@@ -155,3 +156,60 @@ func (suite *HelperTestSuite) TestFetchQuestionsRecencyWeighted() {
 		)
 	})
 }
+
+// TestTypeFilter tests that single_choice also matches legacy rows without a question_type
+func (suite *HelperTestSuite) TestTypeFilter() {
+	base := squirrel.Eq{schema.QUESTION_COUNT_PRACTISE: 0}
+
+	assert.Equal(suite.T(), base, applyTypeFilter(base, nil))
+	assert.Equal(suite.T(),
+		squirrel.And{base, squirrel.Eq{schema.QUESTION_QUESTION_TYPE: []string{schema.QUESTION_TYPE_ORDERING}}},
+		applyTypeFilter(base, []string{schema.QUESTION_TYPE_ORDERING}))
+
+	sql, args, err := typeFilter([]string{schema.QUESTION_TYPE_SINGLE_CHOICE, schema.QUESTION_TYPE_TRUE_FALSE}).ToSql()
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), "(question_type IN (?,?) OR question_type IS NULL)", sql)
+	assert.Equal(suite.T(), []interface{}{schema.QUESTION_TYPE_SINGLE_CHOICE, schema.QUESTION_TYPE_TRUE_FALSE}, args)
+}
+
+// TestDrawRandomQuestionsFavorSlow tests that favor_slow sets aside part of
+// the draw for slow-but-correct questions and draws the rest without them
+func (suite *HelperTestSuite) TestDrawRandomQuestionsFavorSlow() {
+	mockPeer := mocks.NewMockQuestionPeer(suite.T())
+	mockAnswerLogPeer := mocks.NewMockQuestionAnswerLogPeer(suite.T())
+	controller := New(mockPeer, mocks.NewMockQuestionOptionPeer(suite.T()), mockAnswerLogPeer, mocks.NewMockWordPeer(suite.T()), mocks.NewMockWordDefinitionsPeer(suite.T()), nil, nil, nil)
+	sampleQuestions := getSampleQuestions()
+
+	// Question 1's median correct answer (10000ms) reaches the 75th
+	// percentile of all correct answers (9500ms); question 2 is quick
+	mockAnswerLogPeer.EXPECT().
+		Select(mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+		Return([]*dbModels.QuestionAnswerLog{
+			{QuestionId: utils.IntPtr(1), IsCorrect: utils.BoolPtr(true), ResponseMs: utils.IntPtr(9000)},
+			{QuestionId: utils.IntPtr(1), IsCorrect: utils.BoolPtr(true), ResponseMs: utils.IntPtr(11000)},
+			{QuestionId: utils.IntPtr(2), IsCorrect: utils.BoolPtr(true), ResponseMs: utils.IntPtr(2000)},
+			{QuestionId: utils.IntPtr(2), IsCorrect: utils.BoolPtr(true), ResponseMs: utils.IntPtr(2500)},
+		}, nil).Times(1)
+	mockPeer.EXPECT().
+		Select(mock.Anything, squirrel.Eq{schema.QUESTION_ID: []int{1}, schema.QUESTION_IS_SUSPENDED: false}, mock.Anything, mock.Anything, mock.Anything).
+		Return([]*dbModels.Question{sampleQuestions[0]}, nil).Times(1)
+
+	// The remaining 2 questions (quota 1 unpractised, 1 high-success) leave
+	// the slow question out
+	limit := uint64(1)
+	unpractisedWhere := squirrel.And{
+		squirrel.And{squirrel.Eq{schema.QUESTION_IS_SUSPENDED: false}, squirrel.Eq{schema.QUESTION_COUNT_PRACTISE: 0}},
+		squirrel.NotEq{schema.QUESTION_ID: []int{1}},
+	}
+	mockPeer.EXPECT().
+		Select(mock.Anything, unpractisedWhere, mock.Anything, &limit, (*uint64)(nil)).
+		Return([]*dbModels.Question{sampleQuestions[1]}, nil).Times(1)
+	mockPeer.EXPECT().
+		Select(mock.Anything, mock.Anything, mock.Anything, &limit, (*uint64)(nil)).
+		Return([]*dbModels.Question{sampleQuestions[2]}, nil).Times(1)
+
+	result, err := controller.drawRandomQuestions(3, nil, nil, true)
+
+	assert.NoError(suite.T(), err)
+	assert.ElementsMatch(suite.T(), sampleQuestions[:3], result)
+}
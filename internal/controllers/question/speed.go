@@ -0,0 +1,54 @@
+package question
+
+import (
+	"time"
+	dbModels "word-flashcard/data/models"
+	"word-flashcard/data/schema"
+	"word-flashcard/internal/controllers/common"
+
+	"github.com/Masterminds/squirrel"
+)
+
+// speedLogColumns returns the question_answer_logs columns needed to
+// measure answer times
+func speedLogColumns() []*string {
+	columns := []string{
+		schema.QUESTION_ANSWER_LOG_QUESTION_ID,
+		schema.QUESTION_ANSWER_LOG_IS_CORRECT,
+		schema.QUESTION_ANSWER_LOG_RESPONSE_MS,
+	}
+	ptrs := make([]*string, len(columns))
+	for i := range columns {
+		ptrs[i] = &columns[i]
+	}
+	return ptrs
+}
+
+// timedQuestionAnswers converts timed answer logs to answers
+func timedQuestionAnswers(logs []*dbModels.QuestionAnswerLog) []common.TimedAnswer {
+	answers := make([]common.TimedAnswer, 0, len(logs))
+	for _, log := range logs {
+		if log.QuestionId == nil || log.ResponseMs == nil {
+			continue
+		}
+		answers = append(answers, common.TimedAnswer{
+			ItemID:     *log.QuestionId,
+			ResponseMs: *log.ResponseMs,
+			Correct:    log.IsCorrect != nil && *log.IsCorrect,
+		})
+	}
+	return answers
+}
+
+// fetchTimedQuestionAnswers returns the timed answers of the last days days
+func (qc *Controller) fetchTimedQuestionAnswers(days int, now time.Time) ([]common.TimedAnswer, error) {
+	where := squirrel.And{
+		squirrel.NotEq{schema.QUESTION_ANSWER_LOG_RESPONSE_MS: nil},
+		squirrel.GtOrEq{schema.COMMON_CREATED_AT: now.AddDate(0, 0, -days)},
+	}
+	logs, err := qc.questionAnswerLogPeer.Select(speedLogColumns(), where, nil, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	return timedQuestionAnswers(logs), nil
+}
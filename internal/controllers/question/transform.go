@@ -1,8 +1,10 @@
 package question
 
 import (
+	"encoding/json"
 	"time"
 	dbModels "word-flashcard/data/models"
+	"word-flashcard/data/schema"
 	"word-flashcard/internal/controllers/common"
 	"word-flashcard/internal/models"
 )
@@ -18,6 +20,22 @@ func (qc *Controller) convertToEntities(questions []*dbModels.Question) []*model
 	return questionEntities
 }
 
+// embedLinks attaches the entities linked to each question
+func (qc *Controller) embedLinks(questionEntities []*models.Question) error {
+	var questionIDs []int
+	for _, questionEntity := range questionEntities {
+		questionIDs = append(questionIDs, *questionEntity.ID)
+	}
+	linked, err := qc.linkResolver.Linked(schema.ENTITY_TYPE_QUESTION, questionIDs)
+	if err != nil {
+		return err
+	}
+	for _, questionEntity := range questionEntities {
+		questionEntity.Links = linked[*questionEntity.ID]
+	}
+	return nil
+}
+
 // toQuestionAnswerLogEntries converts data-layer answer log rows to the API response shape.
 func (qc *Controller) toQuestionAnswerLogEntries(logs []*dbModels.QuestionAnswerLog) []models.QuestionAnswerLogEntry {
 	entries := make([]models.QuestionAnswerLogEntry, 0, len(logs))
@@ -29,6 +47,9 @@ func (qc *Controller) toQuestionAnswerLogEntries(logs []*dbModels.QuestionAnswer
 		if l.SelectedOption != nil {
 			e.SelectedOption = *l.SelectedOption
 		}
+		if l.Response != nil && json.Valid([]byte(*l.Response)) {
+			e.Response = json.RawMessage(*l.Response)
+		}
 		if l.IsCorrect != nil {
 			e.IsCorrect = *l.IsCorrect
 		}
@@ -40,10 +61,10 @@ func (qc *Controller) toQuestionAnswerLogEntries(logs []*dbModels.QuestionAnswer
 	return entries
 }
 
-// buildQuestionTrendPoints aggregates answer logs into zero-filled daily
-// trend points. accuracy_rate is the share of that day's log entries with
-// IsCorrect true.
-func (qc *Controller) buildQuestionTrendPoints(logs []*dbModels.QuestionAnswerLog, days int, now time.Time) []models.QuestionTrendPoint {
+// buildQuestionTrendPoints turns per-day answer log stats into zero-filled
+// daily trend points. accuracy_rate is the share of that day's log entries
+// with IsCorrect true.
+func (qc *Controller) buildQuestionTrendPoints(stats []*dbModels.QuestionAnswerLogDailyStats, days int, now time.Time) []models.QuestionTrendPoint {
 	dateKeys := common.DailyDateKeys(days, now)
 	points := make([]models.QuestionTrendPoint, len(dateKeys))
 	idx := make(map[string]int, len(dateKeys))
@@ -52,33 +73,17 @@ func (qc *Controller) buildQuestionTrendPoints(logs []*dbModels.QuestionAnswerLo
 		idx[k] = i
 	}
 
-	type dayAgg struct {
-		count, correct int
-	}
-	aggs := make(map[string]*dayAgg)
-	for _, l := range logs {
-		if l.CreatedAt == nil {
+	for _, s := range stats {
+		if s.Day == nil || s.AnswerCount == nil || *s.AnswerCount == 0 {
 			continue
 		}
-		key := common.ReportDateKey(*l.CreatedAt)
-		if _, ok := idx[key]; !ok {
+		i, ok := idx[*s.Day]
+		if !ok {
 			continue
 		}
-		a := aggs[key]
-		if a == nil {
-			a = &dayAgg{}
-			aggs[key] = a
-		}
-		a.count++
-		if l.IsCorrect != nil && *l.IsCorrect {
-			a.correct++
-		}
-	}
-	for key, a := range aggs {
-		i := idx[key]
-		points[i].PracticeCount = a.count
-		if a.count > 0 {
-			points[i].AccuracyRate = common.Round1(float64(a.correct) * 100 / float64(a.count))
+		points[i].PracticeCount = *s.AnswerCount
+		if s.CorrectCount != nil {
+			points[i].AccuracyRate = common.Round1(float64(*s.CorrectCount) * 100 / float64(*s.AnswerCount))
 		}
 	}
 	return points
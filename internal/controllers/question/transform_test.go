@@ -80,3 +80,20 @@ func (suite *HelperTestSuite) TestConvertToEntities() {
 	assert.NoError(suite.T(), err)
 	assert.Equal(suite.T(), string(expected), string(result))
 }
+
+// TestToQuestionAnswerLogEntriesResponse tests that a stored response is passed through as JSON
+func (suite *HelperTestSuite) TestToQuestionAnswerLogEntriesResponse() {
+	logs := []*dbModels.QuestionAnswerLog{
+		{Id: utils.IntPtr(1), SelectedOption: utils.StrPtr(""), Response: utils.StrPtr("{\"text\":\"colour\"}"), IsCorrect: utils.BoolPtr(true)},
+		{Id: utils.IntPtr(2), SelectedOption: utils.StrPtr("B"), IsCorrect: utils.BoolPtr(false)},
+	}
+
+	entries := suite.controller.toQuestionAnswerLogEntries(logs)
+
+	result, err := json.Marshal(entries)
+	assert.NoError(suite.T(), err)
+	assert.JSONEq(suite.T(),
+		`[{"id":1,"selected_option":"","response":{"text":"colour"},"is_correct":true,"created_at":"0001-01-01T00:00:00Z"},
+		  {"id":2,"selected_option":"B","is_correct":false,"created_at":"0001-01-01T00:00:00Z"}]`,
+		string(result))
+}
@@ -1,13 +1,36 @@
 package question
 
 import (
+	"fmt"
 	"slices"
 	"strings"
 
+	"word-flashcard/data/schema"
 	"word-flashcard/internal/controllers/common"
 	"word-flashcard/internal/models"
+	"word-flashcard/utils"
 )
 
+// maxGenerateCount bounds how many questions one generate request may build.
+const maxGenerateCount = 100
+
+// maxQuestionOptions bounds how many options a question in question_options
+// may have, and how many entries a response to one may carry.
+const maxQuestionOptions = 20
+
+// listAnswer holds the answer letters of the option_a-d columns.
+var listAnswer = []string{"A", "B", "C", "D"}
+
+// questionTypes lists every accepted question_type.
+var questionTypes = []string{
+	schema.QUESTION_TYPE_SINGLE_CHOICE,
+	schema.QUESTION_TYPE_TRUE_FALSE,
+	schema.QUESTION_TYPE_MULTIPLE_SELECT,
+	schema.QUESTION_TYPE_FREE_TEXT,
+	schema.QUESTION_TYPE_ORDERING,
+	schema.QUESTION_TYPE_MATCHING,
+}
+
 // validateQuestionFields validates the content of the requested question
 func (qc *Controller) validateQuestionFields(question *models.Question, isUpdate bool) error {
 	// question: VARCHAR(1024), NOT NULL
@@ -15,34 +38,24 @@ func (qc *Controller) validateQuestionFields(question *models.Question, isUpdate
 		return err
 	}
 
-	// option_a: VARCHAR(255), NOT NULL
-	if err := common.ValidateStringField(question.OptionA, isUpdate, "option_a", 255, false); err != nil {
-		return err
+	// question_type: VARCHAR(20), Allow NULL (legacy rows are single_choice).
+	// Restating the type on update revalidates every field it depends on.
+	if question.QuestionType != nil && !slices.Contains(questionTypes, *question.QuestionType) {
+		return common.NewFieldError("question_type is invalid", "value", *question.QuestionType, "allowed", strings.Join(questionTypes, ","))
 	}
-
-	// option_b: VARCHAR(255), Allow NULL
-	if err := common.ValidateStringField(question.OptionB, isUpdate, "option_b", 255, true); err != nil {
-		return err
+	if isUpdate && question.QuestionType == nil && question.Options != nil {
+		return common.NewFieldError("question_type is required when options are set")
 	}
+	typeFieldsUpdate := isUpdate && question.QuestionType == nil
 
-	// option_c: VARCHAR(255), Allow NULL
-	if err := common.ValidateStringField(question.OptionC, isUpdate, "option_c", 255, true); err != nil {
-		return err
-	}
-
-	// option_d: VARCHAR(255), Allow NULL
-	if err := common.ValidateStringField(question.OptionD, isUpdate, "option_d", 255, true); err != nil {
+	if question.UsesOptionTable() {
+		if err := validateOptionTableQuestion(question, typeFieldsUpdate); err != nil {
+			return err
+		}
+	} else if err := validateOptionColumnsQuestion(question, typeFieldsUpdate); err != nil {
 		return err
 	}
 
-	// answer: VARCHAR(5), NOT NULL
-	listAnswer := []string{"A", "B", "C", "D"}
-	if err := common.ValidateStringField(question.Answer, isUpdate, "answer", 5, false); err != nil {
-		return err
-	} else if question.Answer != nil && !slices.Contains(listAnswer, strings.ToUpper(*question.Answer)) {
-		return common.NewFieldError("answer is invalid", "value", *question.Answer, "allowed", strings.Join(listAnswer, ","))
-	}
-
 	// reference: VARCHAR(255), Allow NULL
 	if err := common.ValidateStringField(question.Reference, isUpdate, "reference", 255, true); err != nil {
 		return err
@@ -78,5 +91,164 @@ func (qc *Controller) validateQuestionFields(question *models.Question, isUpdate
 		return common.NewFieldError("selected_option is invalid", "value", *question.SelectedOption, "allowed", strings.Join(listAnswer, ","))
 	}
 
+	// response_ms: how long the quiz answer took
+	if err := common.ValidateResponseMs(question.ResponseMs); err != nil {
+		return err
+	}
+
+	// response: the quiz answer for question types with options; it is
+	// graded against the stored options, so it can't arrive together with
+	// new ones or with a selected_option
+	if question.Response != nil {
+		return validateQuestionResponse(question)
+	}
+
+	return nil
+}
+
+// validateOptionColumnsQuestion validates the option_a-d/answer columns used
+// by single_choice and true_false questions.
+func validateOptionColumnsQuestion(question *models.Question, isUpdate bool) error {
+	if len(question.Options) > 0 {
+		return common.NewFieldError("options is invalid", "reason", "not used by question_type "+question.Type())
+	}
+
+	// option_a: VARCHAR(255), NOT NULL
+	if err := common.ValidateStringField(question.OptionA, isUpdate, "option_a", 255, false); err != nil {
+		return err
+	}
+
+	// option_b: VARCHAR(255), Allow NULL
+	if err := common.ValidateStringField(question.OptionB, isUpdate, "option_b", 255, true); err != nil {
+		return err
+	}
+
+	// option_c: VARCHAR(255), Allow NULL
+	if err := common.ValidateStringField(question.OptionC, isUpdate, "option_c", 255, true); err != nil {
+		return err
+	}
+
+	// option_d: VARCHAR(255), Allow NULL
+	if err := common.ValidateStringField(question.OptionD, isUpdate, "option_d", 255, true); err != nil {
+		return err
+	}
+
+	// answer: VARCHAR(5), NOT NULL
+	if err := common.ValidateStringField(question.Answer, isUpdate, "answer", 5, false); err != nil {
+		return err
+	} else if question.Answer != nil && !slices.Contains(listAnswer, strings.ToUpper(*question.Answer)) {
+		return common.NewFieldError("answer is invalid", "value", *question.Answer, "allowed", strings.Join(listAnswer, ","))
+	}
+
+	if question.Type() != schema.QUESTION_TYPE_TRUE_FALSE {
+		return nil
+	}
+
+	// true_false: exactly two options, True (A) and False (B)
+	if utils.DerefStr(question.OptionC) != "" || utils.DerefStr(question.OptionD) != "" {
+		return common.NewFieldError("option_c/option_d is invalid", "reason", "not used by question_type "+schema.QUESTION_TYPE_TRUE_FALSE)
+	}
+	if question.Answer != nil && !slices.Contains(listAnswer[:2], strings.ToUpper(*question.Answer)) {
+		return common.NewFieldError("answer is invalid", "value", *question.Answer, "allowed", strings.Join(listAnswer[:2], ","))
+	}
+	return nil
+}
+
+// validateOptionTableQuestion validates a question whose options live in
+// question_options. Its option_a-d/answer columns must be left empty, and
+// on create (or when the type is restated) the options must fit its type.
+func validateOptionTableQuestion(question *models.Question, isUpdate bool) error {
+	questionType := question.Type()
+	unused := []struct {
+		name  string
+		value *string
+	}{
+		{"option_a", question.OptionA}, {"option_b", question.OptionB},
+		{"option_c", question.OptionC}, {"option_d", question.OptionD}, {"answer", question.Answer},
+	}
+	for _, field := range unused {
+		if utils.DerefStr(field.value) != "" {
+			return common.NewFieldError(field.name+" is invalid", "reason", "not used by question_type "+questionType)
+		}
+	}
+	if question.SelectedOption != nil {
+		return common.NewFieldError("selected_option is invalid", "reason", "use response for question_type "+questionType)
+	}
+	if isUpdate && question.Options == nil {
+		return nil
+	}
+
+	minOptions := 2
+	if questionType == schema.QUESTION_TYPE_FREE_TEXT {
+		minOptions = 1
+	}
+	if len(question.Options) < minOptions || len(question.Options) > maxQuestionOptions {
+		return common.NewFieldError("options is invalid", "reason", "wrong number of options",
+			"count", len(question.Options), "min", minOptions, "max", maxQuestionOptions)
+	}
+
+	hasCorrect := false
+	contents := make(map[string]bool, len(question.Options))
+	for i, option := range question.Options {
+		name := fmt.Sprintf("options[%d]", i)
+		if err := common.ValidateStringField(option.Content, false, name+".content", 255, false); err != nil {
+			return err
+		}
+		key := common.NormalizeAnswer(*option.Content)
+		if contents[key] {
+			return common.NewFieldError(name+".content is invalid", "reason", "duplicate option", "value", *option.Content)
+		}
+		contents[key] = true
+
+		matchRequired := questionType == schema.QUESTION_TYPE_MATCHING
+		if err := common.ValidateStringField(option.MatchContent, false, name+".match_content", 255, !matchRequired); err != nil {
+			return err
+		}
+		if !matchRequired && utils.DerefStr(option.MatchContent) != "" {
+			return common.NewFieldError(name+".match_content is invalid", "reason", "not used by question_type "+questionType)
+		}
+		if option.IsCorrect != nil && *option.IsCorrect {
+			hasCorrect = true
+		}
+	}
+	if questionType == schema.QUESTION_TYPE_MULTIPLE_SELECT && !hasCorrect {
+		return common.NewFieldError("options is invalid", "reason", "at least one option must be marked is_correct")
+	}
+	return nil
+}
+
+// validateQuestionResponse validates the shape of a quiz response; whether
+// it fits the question's type is checked against the stored question.
+func validateQuestionResponse(question *models.Question) error {
+	response := question.Response
+	switch {
+	case question.SelectedOption != nil:
+		return common.NewFieldError("response is invalid", "reason", "selected_option and response are mutually exclusive")
+	case question.Options != nil:
+		return common.NewFieldError("response is invalid", "reason", "options can't be changed while answering")
+	case len(response.OptionIDs) > maxQuestionOptions || len(response.Pairs) > maxQuestionOptions:
+		return common.NewFieldError("response is invalid", "reason", "too many entries", "max", maxQuestionOptions)
+	}
+	return common.ValidateStringField(response.Text, true, "response.text", 255, true)
+}
+
+// validateGenerateRequest validates a question generation request and fills
+// in the default direction.
+func validateGenerateRequest(req *models.QuestionGenerateRequest) error {
+	if len(req.WordIDs) == 0 && req.Count <= 0 {
+		return common.NewFieldError("word_ids or count is required")
+	}
+	if len(req.WordIDs) > maxGenerateCount || req.Count > maxGenerateCount {
+		return common.NewFieldError(fmt.Sprintf("at most %d questions can be generated at once", maxGenerateCount))
+	}
+
+	switch req.Direction {
+	case "":
+		req.Direction = models.QuestionDirectionWordToDefinition
+	case models.QuestionDirectionWordToDefinition, models.QuestionDirectionDefinitionToWord:
+	default:
+		return common.NewFieldError("direction is invalid", "value", req.Direction,
+			"allowed", models.QuestionDirectionWordToDefinition+","+models.QuestionDirectionDefinitionToWord)
+	}
 	return nil
 }
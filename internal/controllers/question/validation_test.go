@@ -4,6 +4,7 @@ import (
 	"errors"
 	"strings"
 
+	"word-flashcard/data/schema"
 	"word-flashcard/internal/controllers/common"
 	"word-flashcard/internal/models"
 	"word-flashcard/utils"
@@ -245,6 +246,18 @@ func (suite *HelperTestSuite) TestValidateQuestionFields() {
 			wantErrMsg: "selected_option is invalid",
 			wantDetail: []any{"value", "Z", "allowed", "A,B,C,D"},
 		},
+		{
+			name: "update - response_ms too long",
+			input: func() *models.Question {
+				q := testQuestion
+				q.ResponseMs = utils.IntPtr(common.MaxResponseMs + 1)
+				return &q
+			}(),
+			isUpdate:   true,
+			wantErr:    true,
+			wantErrMsg: "response_ms is invalid",
+			wantDetail: []any{"value", common.MaxResponseMs + 1, "min", 1, "max", common.MaxResponseMs},
+		},
 	}
 
 	// Run the test cases and validate the result
@@ -267,3 +280,177 @@ func (suite *HelperTestSuite) TestValidateQuestionFields() {
 		})
 	}
 }
+
+// TestValidateQuestionFieldsByType tests the question_type-specific rules of validateQuestionFields
+func (suite *HelperTestSuite) TestValidateQuestionFieldsByType() {
+	option := func(content string, isCorrect bool) models.QuestionOption {
+		return models.QuestionOption{Content: utils.StrPtr(content), IsCorrect: utils.BoolPtr(isCorrect)}
+	}
+	pair := func(content, match string) models.QuestionOption {
+		return models.QuestionOption{Content: utils.StrPtr(content), MatchContent: utils.StrPtr(match)}
+	}
+	newQuestion := func(questionType string, options ...models.QuestionOption) *models.Question {
+		q := &models.Question{Question: utils.StrPtr("Prompt"), QuestionType: utils.StrPtr(questionType), Options: options}
+		applyQuestionTypeDefaults(q)
+		return q
+	}
+
+	testCases := []struct {
+		name       string
+		input      *models.Question
+		isUpdate   bool
+		wantErrMsg string
+	}{
+		{
+			name: "create - true_false defaults its options",
+			input: func() *models.Question {
+				q := newQuestion(schema.QUESTION_TYPE_TRUE_FALSE)
+				q.Answer = utils.StrPtr("b")
+				return q
+			}(),
+		},
+		{
+			name: "create - true_false answer outside A/B",
+			input: func() *models.Question {
+				q := newQuestion(schema.QUESTION_TYPE_TRUE_FALSE)
+				q.Answer = utils.StrPtr("C")
+				return q
+			}(),
+			wantErrMsg: "answer is invalid",
+		},
+		{
+			name: "create - true_false with option_c",
+			input: func() *models.Question {
+				q := newQuestion(schema.QUESTION_TYPE_TRUE_FALSE)
+				q.Answer, q.OptionC = utils.StrPtr("A"), utils.StrPtr("Maybe")
+				return q
+			}(),
+			wantErrMsg: "option_c/option_d is invalid",
+		},
+		{
+			name:       "create - single_choice rejects options",
+			input:      &models.Question{Question: utils.StrPtr("Prompt"), OptionA: utils.StrPtr("a"), Answer: utils.StrPtr("A"), Options: []models.QuestionOption{option("x", true)}},
+			wantErrMsg: "options is invalid",
+		},
+		{
+			name:  "create - multiple_select",
+			input: newQuestion(schema.QUESTION_TYPE_MULTIPLE_SELECT, option("red", true), option("blue", true), option("loud", false)),
+		},
+		{
+			name:       "create - multiple_select without a correct option",
+			input:      newQuestion(schema.QUESTION_TYPE_MULTIPLE_SELECT, option("red", false), option("blue", false)),
+			wantErrMsg: "options is invalid",
+		},
+		{
+			name:       "create - multiple_select with one option",
+			input:      newQuestion(schema.QUESTION_TYPE_MULTIPLE_SELECT, option("red", true)),
+			wantErrMsg: "options is invalid",
+		},
+		{
+			name:       "create - duplicate options",
+			input:      newQuestion(schema.QUESTION_TYPE_ORDERING, option("first", false), option("First ", false)),
+			wantErrMsg: "options[1].content is invalid",
+		},
+		{
+			name:       "create - empty option content",
+			input:      newQuestion(schema.QUESTION_TYPE_ORDERING, option("first", false), option("", false)),
+			wantErrMsg: "options[1].content is invalid",
+		},
+		{
+			name:  "create - free_text with one accepted answer",
+			input: newQuestion(schema.QUESTION_TYPE_FREE_TEXT, option("colour", true)),
+		},
+		{
+			name: "create - option table type rejects option_a",
+			input: func() *models.Question {
+				q := newQuestion(schema.QUESTION_TYPE_FREE_TEXT, option("colour", true))
+				q.OptionA = utils.StrPtr("colour")
+				return q
+			}(),
+			wantErrMsg: "option_a is invalid",
+		},
+		{
+			name:  "create - matching",
+			input: newQuestion(schema.QUESTION_TYPE_MATCHING, pair("big", "large"), pair("quick", "fast")),
+		},
+		{
+			name:       "create - matching without match_content",
+			input:      newQuestion(schema.QUESTION_TYPE_MATCHING, pair("big", "large"), option("quick", false)),
+			wantErrMsg: "options[1].match_content is invalid",
+		},
+		{
+			name:       "create - match_content outside matching",
+			input:      newQuestion(schema.QUESTION_TYPE_ORDERING, pair("big", "large"), option("quick", false)),
+			wantErrMsg: "options[0].match_content is invalid",
+		},
+		{
+			name:       "create - unknown question_type",
+			input:      &models.Question{Question: utils.StrPtr("Prompt"), QuestionType: utils.StrPtr("essay")},
+			wantErrMsg: "question_type is invalid",
+		},
+		{
+			name:       "update - options without question_type",
+			input:      &models.Question{Options: []models.QuestionOption{option("red", true)}},
+			isUpdate:   true,
+			wantErrMsg: "question_type is required when options are set",
+		},
+		{
+			name:     "update - response",
+			input:    &models.Question{Response: &models.QuestionResponse{OptionIDs: []int{1, 2}}},
+			isUpdate: true,
+		},
+		{
+			name:       "update - response with selected_option",
+			input:      &models.Question{SelectedOption: utils.StrPtr("A"), Response: &models.QuestionResponse{OptionIDs: []int{1}}},
+			isUpdate:   true,
+			wantErrMsg: "response is invalid",
+		},
+		{
+			name:       "update - response text too long",
+			input:      &models.Question{Response: &models.QuestionResponse{Text: utils.StrPtr(strings.Repeat("a", 256))}},
+			isUpdate:   true,
+			wantErrMsg: "response.text is invalid",
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			err := suite.controller.validateQuestionFields(tc.input, tc.isUpdate)
+			if tc.wantErrMsg != "" {
+				suite.Require().Error(err)
+				suite.Equal(tc.wantErrMsg, err.Error())
+			} else {
+				suite.NoError(err)
+			}
+		})
+	}
+}
+
+// TestValidateGenerateRequest tests the validateGenerateRequest function, including the default direction
+func (suite *HelperTestSuite) TestValidateGenerateRequest() {
+	testCases := []struct {
+		name          string
+		input         models.QuestionGenerateRequest
+		wantErr       string
+		wantDirection string
+	}{
+		{"word ids with default direction", models.QuestionGenerateRequest{WordIDs: []int{1}}, "", models.QuestionDirectionWordToDefinition},
+		{"count with reverse direction", models.QuestionGenerateRequest{Count: 5, Direction: models.QuestionDirectionDefinitionToWord}, "", models.QuestionDirectionDefinitionToWord},
+		{"neither word ids nor count", models.QuestionGenerateRequest{}, "word_ids or count is required", ""},
+		{"count too large", models.QuestionGenerateRequest{Count: maxGenerateCount + 1}, "at most 100 questions can be generated at once", ""},
+		{"unknown direction", models.QuestionGenerateRequest{Count: 1, Direction: "sideways"}, "direction is invalid", ""},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			req := tc.input
+			err := validateGenerateRequest(&req)
+			if tc.wantErr == "" {
+				suite.NoError(err)
+				suite.Equal(tc.wantDirection, req.Direction)
+			} else {
+				suite.EqualError(err, tc.wantErr)
+			}
+		})
+	}
+}
@@ -0,0 +1,44 @@
+package reminder
+
+import (
+	"word-flashcard/data/peers"
+	"word-flashcard/internal/links"
+)
+
+// Controller handles requests that set, clear and list reminders to revisit
+// words, questions and notes. The reminder scheduler uses it as well to mark
+// reminders overdue.
+type Controller struct {
+	reminderPeer    peers.ReminderPeerInterface
+	reminderLogPeer peers.ReminderLogPeerInterface
+	linkResolver    *links.LinkResolver
+}
+
+// New creates a new Controller instance. The LinkResolver checks that an
+// entity exists before it gets a reminder and labels listed reminders.
+func New(
+	reminderPeer peers.ReminderPeerInterface,
+	reminderLogPeer peers.ReminderLogPeerInterface,
+	linkResolver *links.LinkResolver,
+) *Controller {
+	return &Controller{
+		reminderPeer:    reminderPeer,
+		reminderLogPeer: reminderLogPeer,
+		linkResolver:    linkResolver,
+	}
+}
+
+// GetReelPeers returns the real database peers
+func GetReelPeers() (peers.ReminderPeerInterface, peers.ReminderLogPeerInterface, error) {
+	reminderPeer, err := peers.NewReminderPeer()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	reminderLogPeer, err := peers.NewReminderLogPeer()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return reminderPeer, reminderLogPeer, nil
+}
@@ -0,0 +1,74 @@
+package reminder
+
+import (
+	"testing"
+	"time"
+	"word-flashcard/data/mocks"
+	dbModels "word-flashcard/data/models"
+	"word-flashcard/data/schema"
+	"word-flashcard/internal/links"
+	"word-flashcard/utils"
+
+	"github.com/stretchr/testify/suite"
+)
+
+// ControllerTestSuite is a test suite for the reminder Controller
+type ControllerTestSuite struct {
+	suite.Suite
+	controller          *Controller
+	mockReminderPeer    *mocks.MockReminderPeer
+	mockReminderLogPeer *mocks.MockReminderLogPeer
+	mockEntityLinkPeer  *mocks.MockEntityLinkPeer
+	mockWordPeer        *mocks.MockWordPeer
+	mockQuestionPeer    *mocks.MockQuestionPeer
+	mockNotePeer        *mocks.MockNotePeer
+}
+
+// TestControllerTestSuite runs the ControllerTestSuite
+func TestControllerTestSuite(t *testing.T) {
+	suite.Run(t, new(ControllerTestSuite))
+}
+
+// SetupTest sets up the test environment before each test
+func (suite *ControllerTestSuite) SetupTest() {
+	suite.mockReminderPeer = mocks.NewMockReminderPeer(suite.T())
+	suite.mockReminderLogPeer = mocks.NewMockReminderLogPeer(suite.T())
+	suite.mockEntityLinkPeer = mocks.NewMockEntityLinkPeer(suite.T())
+	suite.mockWordPeer = mocks.NewMockWordPeer(suite.T())
+	suite.mockQuestionPeer = mocks.NewMockQuestionPeer(suite.T())
+	suite.mockNotePeer = mocks.NewMockNotePeer(suite.T())
+	linkResolver := links.NewLinkResolver(suite.mockEntityLinkPeer, suite.mockWordPeer, suite.mockQuestionPeer, suite.mockNotePeer)
+	suite.controller = New(suite.mockReminderPeer, suite.mockReminderLogPeer, linkResolver)
+}
+
+var testReminderModifyTime = time.Date(2024, 1, 15, 10, 0, 0, 0, time.UTC)
+
+// getSampleReminder returns a one-off reminder of word 1 as stored in the
+// database
+func getSampleReminder() *dbModels.Reminder {
+	return &dbModels.Reminder{
+		Id:         utils.IntPtr(1),
+		EntityType: utils.StrPtr(schema.ENTITY_TYPE_WORD),
+		EntityId:   utils.IntPtr(1),
+		DueAt:      &testReminderModifyTime,
+		Note:       utils.StrPtr("check the phrasal verbs"),
+		IsOverdue:  utils.BoolPtr(false),
+		CreatedAt:  &testReminderModifyTime,
+		UpdatedAt:  &testReminderModifyTime,
+	}
+}
+
+// getSampleRecurringReminder returns a weekly reminder of note 2 as stored
+// in the database
+func getSampleRecurringReminder() *dbModels.Reminder {
+	return &dbModels.Reminder{
+		Id:         utils.IntPtr(2),
+		EntityType: utils.StrPtr(schema.ENTITY_TYPE_NOTE),
+		EntityId:   utils.IntPtr(2),
+		DueAt:      &testReminderModifyTime,
+		Recurrence: utils.StrPtr(schema.REMINDER_RECURRENCE_WEEKLY),
+		IsOverdue:  utils.BoolPtr(true),
+		CreatedAt:  &testReminderModifyTime,
+		UpdatedAt:  &testReminderModifyTime,
+	}
+}
@@ -0,0 +1,14 @@
+package reminder
+
+import "github.com/gin-gonic/gin"
+
+// ControllerInterface defines the interface for reminder controller
+type ControllerInterface interface {
+	ListReminders(c *gin.Context)
+	ListDueReminders(c *gin.Context)
+	ListReminderHistory(c *gin.Context)
+	CreateReminder(c *gin.Context)
+	UpdateReminder(c *gin.Context)
+	ClearReminder(c *gin.Context)
+	DeleteReminder(c *gin.Context)
+}
@@ -0,0 +1,51 @@
+package reminder
+
+import (
+	"time"
+	dbModels "word-flashcard/data/models"
+	"word-flashcard/data/schema"
+	"word-flashcard/utils"
+
+	"github.com/Masterminds/squirrel"
+)
+
+// MarkOverdue marks every reminder that fell due more than grace before now
+// and hasn't been cleared since as overdue, returning how many it marked.
+// Clearing a reminder or giving it a new due time lifts the mark.
+func (rc *Controller) MarkOverdue(now time.Time, grace time.Duration) (int64, error) {
+	where := squirrel.And{
+		squirrel.LtOrEq{schema.REMINDER_DUE_AT: now.UTC().Add(-grace)},
+		squirrel.Eq{schema.REMINDER_IS_OVERDUE: false},
+	}
+	return rc.reminderPeer.Update(&dbModels.Reminder{IsOverdue: utils.BoolPtr(true)}, where)
+}
+
+// PruneOrphans removes the reminders of words, questions and notes that
+// have since been deleted, returning how many it removed. The entity delete
+// handlers remove their entity's reminders themselves, so this only catches
+// reminders left behind by a delete that failed partway.
+func (rc *Controller) PruneOrphans() (int64, error) {
+	columns := []*string{utils.StrPtr(schema.REMINDER_ID), utils.StrPtr(schema.REMINDER_ENTITY_TYPE), utils.StrPtr(schema.REMINDER_ENTITY_ID)}
+	reminders, err := rc.reminderPeer.Select(columns, nil, nil, nil, nil)
+	if err != nil {
+		return 0, err
+	}
+	labels, err := rc.entityLabels(reminders)
+	if err != nil {
+		return 0, err
+	}
+
+	var orphanIDs []int
+	for _, reminder := range reminders {
+		if reminder.Id == nil || reminder.EntityType == nil || reminder.EntityId == nil {
+			continue
+		}
+		if _, ok := labels[*reminder.EntityType][*reminder.EntityId]; !ok {
+			orphanIDs = append(orphanIDs, *reminder.Id)
+		}
+	}
+	if len(orphanIDs) == 0 {
+		return 0, nil
+	}
+	return rc.reminderPeer.Delete(squirrel.Eq{schema.REMINDER_ID: orphanIDs})
+}
@@ -0,0 +1,70 @@
+package reminder
+
+import (
+	"time"
+	dbModels "word-flashcard/data/models"
+	"word-flashcard/data/schema"
+	"word-flashcard/utils"
+
+	"github.com/Masterminds/squirrel"
+	"github.com/stretchr/testify/mock"
+)
+
+// TestMarkOverdue tests that only reminders past the grace period and not
+// yet marked are marked overdue
+func (suite *ControllerTestSuite) TestMarkOverdue() {
+	now := time.Date(2024, 1, 16, 12, 0, 0, 0, time.UTC)
+	where := squirrel.And{
+		squirrel.LtOrEq{schema.REMINDER_DUE_AT: now.Add(-24 * time.Hour)},
+		squirrel.Eq{schema.REMINDER_IS_OVERDUE: false},
+	}
+	suite.mockReminderPeer.EXPECT().
+		Update(&dbModels.Reminder{IsOverdue: utils.BoolPtr(true)}, where).
+		Return(int64(2), nil).Times(1)
+
+	marked, err := suite.controller.MarkOverdue(now, 24*time.Hour)
+
+	suite.NoError(err)
+	suite.Equal(int64(2), marked)
+}
+
+// TestPruneOrphans tests that reminders of deleted items are removed
+func (suite *ControllerTestSuite) TestPruneOrphans() {
+	suite.mockReminderPeer.EXPECT().
+		Select(mock.Anything, squirrel.Sqlizer(nil), mock.Anything, mock.Anything, mock.Anything).
+		Return([]*dbModels.Reminder{
+			{Id: utils.IntPtr(1), EntityType: utils.StrPtr(schema.ENTITY_TYPE_WORD), EntityId: utils.IntPtr(1)},
+			{Id: utils.IntPtr(2), EntityType: utils.StrPtr(schema.ENTITY_TYPE_QUESTION), EntityId: utils.IntPtr(4)},
+			{Id: utils.IntPtr(3), EntityType: utils.StrPtr(schema.ENTITY_TYPE_WORD), EntityId: utils.IntPtr(5)},
+		}, nil).Times(1)
+	suite.mockWordPeer.EXPECT().
+		Select(mock.Anything, squirrel.Eq{schema.WORD_ID: []int{1, 5}}, mock.Anything, mock.Anything, mock.Anything).
+		Return([]*dbModels.Word{{Id: utils.IntPtr(1), Word: utils.StrPtr("take off")}}, nil).Times(1)
+	suite.mockQuestionPeer.EXPECT().
+		Select(mock.Anything, squirrel.Eq{schema.QUESTION_ID: []int{4}}, mock.Anything, mock.Anything, mock.Anything).
+		Return([]*dbModels.Question{}, nil).Times(1)
+	suite.mockReminderPeer.EXPECT().
+		Delete(mock.MatchedBy(func(where squirrel.Eq) bool {
+			ids, ok := where[schema.REMINDER_ID].([]int)
+			return ok && len(ids) == 2 && ids[0] == 2 && ids[1] == 3
+		})).
+		Return(int64(2), nil).Times(1)
+
+	pruned, err := suite.controller.PruneOrphans()
+
+	suite.NoError(err)
+	suite.Equal(int64(2), pruned)
+}
+
+// TestPruneOrphansNone tests that nothing is deleted when every reminded item exists
+func (suite *ControllerTestSuite) TestPruneOrphansNone() {
+	suite.mockReminderPeer.EXPECT().
+		Select(mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+		Return([]*dbModels.Reminder{}, nil).Times(1)
+
+	pruned, err := suite.controller.PruneOrphans()
+
+	suite.NoError(err)
+	suite.Zero(pruned)
+	suite.mockReminderPeer.AssertNotCalled(suite.T(), "Delete", mock.Anything)
+}
@@ -0,0 +1,118 @@
+package reminder
+
+import (
+	"fmt"
+	"slices"
+	"time"
+	dbModels "word-flashcard/data/models"
+	"word-flashcard/data/schema"
+	"word-flashcard/internal/links"
+	"word-flashcard/internal/models"
+	"word-flashcard/utils"
+
+	"github.com/Masterminds/squirrel"
+)
+
+// recurrenceDays returns how many calendar days a cleared reminder with
+// recurrence moves ahead, and false when it doesn't recur
+func recurrenceDays(recurrence *string) (int, bool) {
+	switch utils.DerefStr(recurrence) {
+	case schema.REMINDER_RECURRENCE_DAILY:
+		return 1, true
+	case schema.REMINDER_RECURRENCE_WEEKLY:
+		return 7, true
+	}
+	return 0, false
+}
+
+// nextDueAt moves dueAt ahead by days calendar days in loc until it is
+// after now, so a recurring reminder cleared late skips the occurrences it
+// missed. Stepping by calendar days rather than 24 hours keeps the
+// reminder at the same wall-clock time across DST changes, as the RRULE
+// in the calendar feed does.
+func nextDueAt(dueAt time.Time, days int, now time.Time, loc *time.Location) time.Time {
+	local := dueAt.In(loc)
+	step := 1
+	// Jump close to now first, one interval short so DST never overshoots
+	if missed := int(now.Sub(local)/(24*time.Hour))/days - 1; missed > 1 {
+		step = missed
+	}
+	next := local.AddDate(0, 0, step*days)
+	for !next.After(now) {
+		next = next.AddDate(0, 0, days)
+	}
+	return next.UTC()
+}
+
+// findReminder returns the reminder with id, or nil if there is none
+func (rc *Controller) findReminder(id int) (*dbModels.Reminder, error) {
+	reminders, err := rc.reminderPeer.Select([]*string{}, squirrel.Eq{schema.REMINDER_ID: id}, nil, nil, nil)
+	if err != nil || len(reminders) == 0 {
+		return nil, err
+	}
+	return reminders[0], nil
+}
+
+// entityLabels returns the label of the word, question or note each of
+// reminders reminds of, keyed by entity type and ID. Deleted entities are
+// left out.
+func (rc *Controller) entityLabels(reminders []*dbModels.Reminder) (map[string]map[int]string, error) {
+	idsByType := make(map[string][]int)
+	for _, reminder := range reminders {
+		if reminder.EntityType != nil && reminder.EntityId != nil && slices.Contains(links.EntityTypes, *reminder.EntityType) {
+			idsByType[*reminder.EntityType] = append(idsByType[*reminder.EntityType], *reminder.EntityId)
+		}
+	}
+	labels := make(map[string]map[int]string, len(idsByType))
+	for entityType, ids := range idsByType {
+		typeLabels, err := rc.linkResolver.Labels(entityType, ids)
+		if err != nil {
+			return nil, err
+		}
+		labels[entityType] = typeLabels
+	}
+	return labels, nil
+}
+
+// labelReminders converts reminders to their API model, labelled with the
+// word, question text or note title they remind of. Reminders whose entity
+// has been deleted are left out; the reminder scheduler removes them.
+func (rc *Controller) labelReminders(reminders []*dbModels.Reminder) ([]*models.Reminder, error) {
+	labels, err := rc.entityLabels(reminders)
+	if err != nil {
+		return nil, err
+	}
+
+	entities := make([]*models.Reminder, 0, len(reminders))
+	for _, reminder := range reminders {
+		if reminder.EntityType == nil || reminder.EntityId == nil {
+			continue
+		}
+		label, ok := labels[*reminder.EntityType][*reminder.EntityId]
+		if !ok {
+			continue
+		}
+		entity := new(models.Reminder).FromDataModel(reminder)
+		entity.Label = &label
+		entities = append(entities, entity)
+	}
+	return entities, nil
+}
+
+// listReminders returns the labelled reminders matching where, soonest due
+// first
+func (rc *Controller) listReminders(where squirrel.Sqlizer) ([]*models.Reminder, error) {
+	dueFirst := fmt.Sprintf("%s ASC", schema.REMINDER_DUE_AT)
+	byID := fmt.Sprintf("%s ASC", schema.REMINDER_ID)
+	reminders, err := rc.reminderPeer.Select([]*string{}, where, []*string{&dueFirst, &byID}, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	return rc.labelReminders(reminders)
+}
+
+// Reminders returns every reminder, soonest due first and labelled like
+// GET /api/reminders lists them. It backs the calendar feed.
+func (rc *Controller) Reminders() ([]*models.Reminder, error) {
+	return rc.listReminders(nil)
+}
@@ -0,0 +1,66 @@
+package reminder
+
+import (
+	"net/http"
+	"time"
+	dbModels "word-flashcard/data/models"
+	"word-flashcard/internal/controllers/common"
+	"word-flashcard/internal/models"
+	"word-flashcard/utils"
+
+	"github.com/gin-gonic/gin"
+)
+
+// ClearReminder @Summary Clear a reminder
+// @Description Mark a reminder as done, recording it in the reminder history. A recurring reminder moves to its next due time after
+// @Description now, skipping any occurrences it missed; any other reminder is removed.
+// @Tags reminders
+// @Produce json
+// @Param id path int true "Reminder ID"
+// @Success 200 {object} models.ReminderClearResult "Reminder cleared successfully"
+// @Failure 400 {object} models.ErrorResponse "Bad request - Invalid reminder ID"
+// @Failure 404 {object} models.ErrorResponse "Not found - Reminder not found"
+// @Failure 500 {object} models.ErrorResponse "Internal server error - Failed to update data in database"
+// @Router /api/reminders/{id}/clear [post]
+func (rc *Controller) ClearReminder(c *gin.Context) {
+	// ================ 1. Parse request parameter ================
+	reminderID, err := common.ParseIDFromPath(c, "id")
+	if err != nil {
+		common.ResponseError(http.StatusBadRequest, "Invalid reminder ID.", models.ErrCodeInvalidRequest, err, c)
+		return
+	}
+
+	// ================ 2. Fetch data from database ================
+	reminder, err := rc.findReminder(reminderID)
+	if err != nil {
+		common.ResponseError(http.StatusInternalServerError, "Failed to fetch data from database", models.ErrCodeInternalError, err, c)
+		return
+	} else if reminder == nil || reminder.DueAt == nil {
+		common.ResponseError(http.StatusNotFound, "Reminder not found", models.ErrCodeNotFound, nil, c)
+		return
+	}
+
+	// ================ 3. Move a recurring reminder ahead, remove any other ================
+	// The reminder is recorded in history in the same transaction
+	var next *dbModels.Reminder
+	if days, recurring := recurrenceDays(reminder.Recurrence); recurring {
+		nextDue := nextDueAt(*reminder.DueAt, days, time.Now().UTC(), common.ReportLocation())
+		next = &dbModels.Reminder{DueAt: &nextDue, IsOverdue: utils.BoolPtr(false)}
+	}
+	entry, err := rc.reminderPeer.Clear(reminder, next)
+	if err != nil {
+		common.ResponseError(http.StatusInternalServerError, "Failed to update data in database", models.ErrCodeInternalError, err, c)
+		return
+	} else if entry == nil {
+		common.ResponseError(http.StatusNotFound, "Reminder not found", models.ErrCodeNotFound, nil, c)
+		return
+	}
+
+	// ================ 4. Send response ================
+	result := models.ReminderClearResult{Cleared: *new(models.ReminderLog).FromDataModel(entry)}
+	if next != nil {
+		reminder.DueAt, reminder.IsOverdue = next.DueAt, next.IsOverdue
+		result.Next = new(models.Reminder).FromDataModel(reminder)
+	}
+	common.ResponseSuccess(http.StatusOK, result, c)
+}
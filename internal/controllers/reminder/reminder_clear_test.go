@@ -0,0 +1,129 @@
+package reminder
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"time"
+	dbModels "word-flashcard/data/models"
+	"word-flashcard/data/schema"
+	"word-flashcard/internal/models"
+
+	"github.com/Masterminds/squirrel"
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+// clearReminder calls ClearReminder for reminder id
+func (suite *ControllerTestSuite) clearReminder(id string) *httptest.ResponseRecorder {
+	w := httptest.NewRecorder()
+	ctx, _ := gin.CreateTestContext(w)
+	ctx.Request = httptest.NewRequest(http.MethodPost, "/api/reminders/"+id+"/clear", nil)
+	ctx.Params = gin.Params{gin.Param{Key: "id", Value: id}}
+	suite.controller.ClearReminder(ctx)
+	return w
+}
+
+// clearedEntry returns the history row Clear records for reminder
+func clearedEntry(reminder *dbModels.Reminder, id int) *dbModels.ReminderLog {
+	action, now := schema.REMINDER_ACTION_CLEARED, time.Now().UTC()
+	return &dbModels.ReminderLog{
+		Id:         &id,
+		ReminderId: reminder.Id,
+		EntityType: reminder.EntityType,
+		EntityId:   reminder.EntityId,
+		DueAt:      reminder.DueAt,
+		Recurrence: reminder.Recurrence,
+		Note:       reminder.Note,
+		Action:     &action,
+		CreatedAt:  &now,
+	}
+}
+
+// TestClearOneOffReminder tests that clearing a reminder that doesn't recur
+// records it in history and removes it
+func (suite *ControllerTestSuite) TestClearOneOffReminder() {
+	reminder := getSampleReminder()
+	suite.mockReminderPeer.EXPECT().
+		Select([]*string{}, squirrel.Eq{schema.REMINDER_ID: 1}, mock.Anything, mock.Anything, mock.Anything).
+		Return([]*dbModels.Reminder{reminder}, nil).Times(1)
+	suite.mockReminderPeer.EXPECT().
+		Clear(reminder, (*dbModels.Reminder)(nil)).
+		Return(clearedEntry(reminder, 7), nil).Times(1)
+
+	w := suite.clearReminder("1")
+
+	assert.Equal(suite.T(), http.StatusOK, w.Code)
+	var result models.ReminderClearResult
+	suite.Require().NoError(json.Unmarshal(w.Body.Bytes(), &result))
+	assert.Equal(suite.T(), 7, result.Cleared.ID)
+	assert.Equal(suite.T(), "cleared", result.Cleared.Action)
+	assert.Equal(suite.T(), "check the phrasal verbs", *result.Cleared.Note)
+	assert.Nil(suite.T(), result.Next)
+}
+
+// TestClearRecurringReminder tests that clearing a recurring reminder moves
+// it to its next due time after now and lifts the overdue mark
+func (suite *ControllerTestSuite) TestClearRecurringReminder() {
+	week := 7 * 24 * time.Hour
+	reminder := getSampleRecurringReminder()
+	suite.mockReminderPeer.EXPECT().
+		Select([]*string{}, squirrel.Eq{schema.REMINDER_ID: 2}, mock.Anything, mock.Anything, mock.Anything).
+		Return([]*dbModels.Reminder{reminder}, nil).Times(1)
+	suite.mockReminderPeer.EXPECT().
+		Clear(reminder, mock.MatchedBy(func(next *dbModels.Reminder) bool {
+			// Still on the weekly cycle of the original due time, and after now
+			return next != nil && next.DueAt.After(time.Now()) && next.DueAt.Sub(testReminderModifyTime)%week == 0 && !*next.IsOverdue
+		})).
+		Return(clearedEntry(getSampleRecurringReminder(), 8), nil).Times(1)
+
+	w := suite.clearReminder("2")
+
+	assert.Equal(suite.T(), http.StatusOK, w.Code)
+	var result models.ReminderClearResult
+	suite.Require().NoError(json.Unmarshal(w.Body.Bytes(), &result))
+	assert.Equal(suite.T(), 8, result.Cleared.ID)
+	assert.Equal(suite.T(), testReminderModifyTime, result.Cleared.DueAt)
+	suite.Require().NotNil(result.Next)
+	assert.True(suite.T(), result.Next.DueAt.After(time.Now()))
+	assert.False(suite.T(), *result.Next.IsOverdue)
+	assert.Equal(suite.T(), "weekly", *result.Next.Recurrence)
+}
+
+// TestClearReminderNotFound tests that clearing a missing reminder returns 404
+func (suite *ControllerTestSuite) TestClearReminderNotFound() {
+	suite.mockReminderPeer.EXPECT().
+		Select([]*string{}, squirrel.Eq{schema.REMINDER_ID: 999}, mock.Anything, mock.Anything, mock.Anything).
+		Return([]*dbModels.Reminder{}, nil).Times(1)
+
+	w := suite.clearReminder("999")
+
+	assert.Equal(suite.T(), http.StatusNotFound, w.Code)
+}
+
+// TestClearReminderGoneBeforeClear tests that a reminder deleted between
+// being read and being cleared returns 404
+func (suite *ControllerTestSuite) TestClearReminderGoneBeforeClear() {
+	suite.mockReminderPeer.EXPECT().
+		Select(mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+		Return([]*dbModels.Reminder{getSampleReminder()}, nil).Times(1)
+	suite.mockReminderPeer.EXPECT().Clear(mock.Anything, mock.Anything).Return(nil, nil).Times(1)
+
+	w := suite.clearReminder("1")
+
+	assert.Equal(suite.T(), http.StatusNotFound, w.Code)
+}
+
+// TestClearReminderError tests that a failed clear returns 500
+func (suite *ControllerTestSuite) TestClearReminderError() {
+	suite.mockReminderPeer.EXPECT().
+		Select(mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+		Return([]*dbModels.Reminder{getSampleReminder()}, nil).Times(1)
+	suite.mockReminderPeer.EXPECT().Clear(mock.Anything, mock.Anything).Return(nil, fmt.Errorf("insert failed")).Times(1)
+
+	w := suite.clearReminder("1")
+
+	assert.Equal(suite.T(), http.StatusInternalServerError, w.Code)
+}
@@ -0,0 +1,81 @@
+package reminder
+
+import (
+	"net/http"
+	"word-flashcard/internal/controllers/common"
+	"word-flashcard/internal/models"
+	"word-flashcard/utils"
+
+	"github.com/gin-gonic/gin"
+)
+
+// CreateReminder @Summary Set a reminder
+// @Description Remind of a word, question or note at due_at, optionally recurring daily or weekly, with an optional note. Each item has
+// @Description at most one reminder; change an existing one with PUT /api/reminders/{id}.
+// @Tags reminders
+// @Accept json
+// @Produce json
+// @Param reminder body models.Reminder true "The item to remind of, when, and optionally how often and why"
+// @Success 201 {object} models.Reminder "Reminder created successfully"
+// @Failure 400 {object} models.ErrorResponse "Bad request - Invalid request body"
+// @Failure 404 {object} models.ErrorResponse "Not found - Word, question or note not found"
+// @Failure 409 {object} models.ErrorResponse "Conflict - The item already has a reminder"
+// @Failure 500 {object} models.ErrorResponse "Internal server error - Failed to insert data into database"
+// @Router /api/reminders [post]
+func (rc *Controller) CreateReminder(c *gin.Context) {
+	// ================ 1. Parse request body ================
+	var reminderData models.Reminder
+	if err := common.ParseRequestBody(&reminderData, c); err != nil {
+		common.ResponseError(http.StatusBadRequest, "Invalid request body", models.ErrCodeInvalidRequest, err, c)
+		return
+	}
+	if err := validateReminderFields(&reminderData, false); err != nil {
+		common.ResponseError(http.StatusBadRequest, err.Error(), models.ErrCodeValidationError, err, c)
+		return
+	}
+
+	// ================ 2. Check the entity exists ================
+	exists, err := rc.linkResolver.Exists(*reminderData.EntityType, *reminderData.EntityID)
+	if err != nil {
+		common.ResponseError(http.StatusInternalServerError, "Failed to fetch data from database", models.ErrCodeInternalError, err, c)
+		return
+	} else if !exists {
+		common.ResponseError(http.StatusNotFound, "Reminded "+*reminderData.EntityType+" not found", models.ErrCodeNotFound, nil, c)
+		return
+	}
+
+	// ================ 3. Insert data into database ================
+	dueAt := reminderData.DueAt.UTC()
+	reminderData.ID = nil
+	reminderData.DueAt = &dueAt
+	reminderData.IsOverdue = utils.BoolPtr(false)
+	if utils.DerefStr(reminderData.Recurrence) == "" {
+		reminderData.Recurrence = nil
+	}
+	if utils.DerefStr(reminderData.Note) == "" {
+		reminderData.Note = nil
+	}
+
+	reminderID, err := rc.reminderPeer.Insert(reminderData.ToDataModel())
+	if err != nil {
+		common.RespondDatabaseWriteError(
+			"Failed to insert data into database",
+			"This item already has a reminder",
+			err, c,
+		)
+		return
+	}
+
+	// ================ 4. Query inserted data ================
+	reminder, err := rc.findReminder(int(reminderID))
+	if err != nil || reminder == nil {
+		common.ResponseError(http.StatusInternalServerError, "Inserted but failed to fetch data from database", models.ErrCodeInternalError, err, c)
+		return
+	}
+
+	// ================ 5. Transform data to API model ================
+	reminderEntity := new(models.Reminder).FromDataModel(reminder)
+
+	// ================ 6. Send response ================
+	common.ResponseSuccess(http.StatusCreated, reminderEntity, c)
+}
@@ -0,0 +1,103 @@
+package reminder
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"time"
+	dbModels "word-flashcard/data/models"
+	"word-flashcard/data/schema"
+	"word-flashcard/utils"
+
+	"github.com/Masterminds/squirrel"
+	"github.com/gin-gonic/gin"
+	"github.com/go-sql-driver/mysql"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+// expectWordExists expects the link resolver to look up word 1, found or not
+func (suite *ControllerTestSuite) expectWordExists(found bool) {
+	words := []*dbModels.Word{}
+	if found {
+		words = append(words, &dbModels.Word{Id: utils.IntPtr(1), Word: utils.StrPtr("take off")})
+	}
+	suite.mockWordPeer.EXPECT().
+		Select(mock.Anything, squirrel.Eq{schema.WORD_ID: []int{1}}, mock.Anything, mock.Anything, mock.Anything).
+		Return(words, nil).Times(1)
+}
+
+// TestCreateReminder tests that a reminder is stored with its due time in
+// UTC and returned with 201
+func (suite *ControllerTestSuite) TestCreateReminder() {
+	suite.expectWordExists(true)
+	suite.mockReminderPeer.EXPECT().
+		Insert(mock.MatchedBy(func(reminder *dbModels.Reminder) bool {
+			return *reminder.EntityType == schema.ENTITY_TYPE_WORD && *reminder.EntityId == 1 &&
+				reminder.DueAt.Equal(testReminderModifyTime) && reminder.DueAt.Location() == time.UTC &&
+				reminder.Recurrence == nil && *reminder.Note == "check the phrasal verbs" && !*reminder.IsOverdue
+		})).
+		Return(int64(1), nil).Times(1)
+	suite.mockReminderPeer.EXPECT().
+		Select([]*string{}, squirrel.Eq{schema.REMINDER_ID: 1}, mock.Anything, mock.Anything, mock.Anything).
+		Return([]*dbModels.Reminder{getSampleReminder()}, nil).Times(1)
+
+	body := `{"entity_type":"word","entity_id":1,"due_at":"2024-01-15T18:00:00+08:00","recurrence":"","note":"check the phrasal verbs"}`
+	w := httptest.NewRecorder()
+	ctx, _ := gin.CreateTestContext(w)
+	ctx.Request = httptest.NewRequest(http.MethodPost, "/api/reminders", strings.NewReader(body))
+	ctx.Request.Header.Set("Content-Type", "application/json")
+	suite.controller.CreateReminder(ctx)
+
+	assert.Equal(suite.T(), http.StatusCreated, w.Code)
+	assert.JSONEq(suite.T(), `{"id":1,"entity_type":"word","entity_id":1,"due_at":"2024-01-15T10:00:00Z","note":"check the phrasal verbs","is_overdue":false,"created_at":"2024-01-15T10:00:00Z","updated_at":"2024-01-15T10:00:00Z"}`, w.Body.String())
+}
+
+// TestCreateReminderInvalidBody tests that an invalid reminder returns 400
+// without touching the database
+func (suite *ControllerTestSuite) TestCreateReminderInvalidBody() {
+	for _, body := range []string{
+		`{"entity_type":"word","entity_id":1}`,
+		`{"entity_type":"word","entity_id":1,"due_at":"2024-01-15T10:00:00Z","recurrence":"hourly"}`,
+		`not json`,
+	} {
+		w := httptest.NewRecorder()
+		ctx, _ := gin.CreateTestContext(w)
+		ctx.Request = httptest.NewRequest(http.MethodPost, "/api/reminders", strings.NewReader(body))
+		ctx.Request.Header.Set("Content-Type", "application/json")
+		suite.controller.CreateReminder(ctx)
+
+		assert.Equal(suite.T(), http.StatusBadRequest, w.Code, body)
+	}
+}
+
+// TestCreateReminderEntityNotFound tests that reminding of a missing word returns 404
+func (suite *ControllerTestSuite) TestCreateReminderEntityNotFound() {
+	suite.expectWordExists(false)
+
+	w := httptest.NewRecorder()
+	ctx, _ := gin.CreateTestContext(w)
+	ctx.Request = httptest.NewRequest(http.MethodPost, "/api/reminders", strings.NewReader(`{"entity_type":"word","entity_id":1,"due_at":"2024-01-15T10:00:00Z"}`))
+	ctx.Request.Header.Set("Content-Type", "application/json")
+	suite.controller.CreateReminder(ctx)
+
+	assert.Equal(suite.T(), http.StatusNotFound, w.Code)
+	assert.Contains(suite.T(), w.Body.String(), "Reminded word not found")
+}
+
+// TestCreateReminderDuplicate tests that a second reminder of the same item returns 409
+func (suite *ControllerTestSuite) TestCreateReminderDuplicate() {
+	suite.expectWordExists(true)
+	suite.mockReminderPeer.EXPECT().
+		Insert(mock.Anything).
+		Return(int64(0), &mysql.MySQLError{Number: 1062, Message: "Duplicate entry"}).Times(1)
+
+	w := httptest.NewRecorder()
+	ctx, _ := gin.CreateTestContext(w)
+	ctx.Request = httptest.NewRequest(http.MethodPost, "/api/reminders", strings.NewReader(`{"entity_type":"word","entity_id":1,"due_at":"2024-01-15T10:00:00Z"}`))
+	ctx.Request.Header.Set("Content-Type", "application/json")
+	suite.controller.CreateReminder(ctx)
+
+	assert.Equal(suite.T(), http.StatusConflict, w.Code)
+	assert.Contains(suite.T(), w.Body.String(), "This item already has a reminder")
+}
@@ -0,0 +1,43 @@
+package reminder
+
+import (
+	"net/http"
+	"word-flashcard/data/schema"
+	"word-flashcard/internal/controllers/common"
+	"word-flashcard/internal/models"
+
+	"github.com/Masterminds/squirrel"
+	"github.com/gin-gonic/gin"
+)
+
+// DeleteReminder @Summary Delete a reminder
+// @Description Remove a reminder without clearing it, e.g. one set by mistake. The deletion is recorded in the reminder history.
+// @Tags reminders
+// @Param id path int true "Reminder ID"
+// @Success 204 "Reminder deleted successfully"
+// @Failure 400 {object} models.ErrorResponse "Bad request - Invalid reminder ID"
+// @Failure 404 {object} models.ErrorResponse "Not found - Reminder not found"
+// @Failure 500 {object} models.ErrorResponse "Internal server error - Failed to delete data from database"
+// @Router /api/reminders/{id} [delete]
+func (rc *Controller) DeleteReminder(c *gin.Context) {
+	// ================ 1. Parse request parameter ================
+	reminderID, err := common.ParseIDFromPath(c, "id")
+	if err != nil {
+		common.ResponseError(http.StatusBadRequest, "Invalid reminder ID.", models.ErrCodeInvalidRequest, err, c)
+		return
+	}
+
+	// ================ 2. Delete data from database ================
+	// The reminder is recorded in history in the same transaction
+	deleted, err := rc.reminderPeer.DeleteWithHistory(squirrel.Eq{schema.REMINDER_ID: reminderID})
+	if err != nil {
+		common.ResponseError(http.StatusInternalServerError, "Failed to delete data from database", models.ErrCodeInternalError, err, c)
+		return
+	} else if deleted == 0 {
+		common.ResponseError(http.StatusNotFound, "Reminder not found", models.ErrCodeNotFound, nil, c)
+		return
+	}
+
+	// ================ 3. Send response ================
+	common.ResponseSuccess(http.StatusNoContent, nil, c)
+}
@@ -0,0 +1,53 @@
+package reminder
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"word-flashcard/data/schema"
+
+	"github.com/Masterminds/squirrel"
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+)
+
+// TestDeleteReminder tests that a deleted reminder is recorded in history
+func (suite *ControllerTestSuite) TestDeleteReminder() {
+	suite.mockReminderPeer.EXPECT().
+		DeleteWithHistory(squirrel.Eq{schema.REMINDER_ID: 1}).
+		Return(int64(1), nil).Times(1)
+
+	w := httptest.NewRecorder()
+	ctx, _ := gin.CreateTestContext(w)
+	ctx.Request = httptest.NewRequest(http.MethodDelete, "/api/reminders/1", nil)
+	ctx.Params = gin.Params{gin.Param{Key: "id", Value: "1"}}
+	suite.controller.DeleteReminder(ctx)
+
+	assert.Equal(suite.T(), http.StatusNoContent, w.Code)
+	assert.Equal(suite.T(), "", w.Body.String())
+}
+
+// TestDeleteReminderNotFound tests that deleting a missing reminder returns 404
+func (suite *ControllerTestSuite) TestDeleteReminderNotFound() {
+	suite.mockReminderPeer.EXPECT().
+		DeleteWithHistory(squirrel.Eq{schema.REMINDER_ID: 999}).
+		Return(int64(0), nil).Times(1)
+
+	w := httptest.NewRecorder()
+	ctx, _ := gin.CreateTestContext(w)
+	ctx.Request = httptest.NewRequest(http.MethodDelete, "/api/reminders/999", nil)
+	ctx.Params = gin.Params{gin.Param{Key: "id", Value: "999"}}
+	suite.controller.DeleteReminder(ctx)
+
+	assert.Equal(suite.T(), http.StatusNotFound, w.Code)
+}
+
+// TestDeleteReminderInvalidID tests that an invalid reminder ID returns 400
+func (suite *ControllerTestSuite) TestDeleteReminderInvalidID() {
+	w := httptest.NewRecorder()
+	ctx, _ := gin.CreateTestContext(w)
+	ctx.Request = httptest.NewRequest(http.MethodDelete, "/api/reminders/abc", nil)
+	ctx.Params = gin.Params{gin.Param{Key: "id", Value: "abc"}}
+	suite.controller.DeleteReminder(ctx)
+
+	assert.Equal(suite.T(), http.StatusBadRequest, w.Code)
+}
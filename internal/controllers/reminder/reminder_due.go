@@ -0,0 +1,46 @@
+package reminder
+
+import (
+	"net/http"
+	"time"
+	"word-flashcard/data/schema"
+	"word-flashcard/internal/controllers/common"
+	"word-flashcard/internal/models"
+
+	"github.com/Masterminds/squirrel"
+	"github.com/gin-gonic/gin"
+)
+
+// maxDueWithinHours caps the within_hours query parameter of the due endpoint
+const maxDueWithinHours = 24 * 30
+
+// ListDueReminders @Summary List due reminders
+// @Description List the reminders that are due, longest overdue first, each labelled with the word, question text or note title it
+// @Description reminds of. within_hours also lists those falling due within that many hours. is_overdue marks reminders left uncleared
+// @Description past the scheduler's grace period.
+// @Tags reminders
+// @Produce json
+// @Param within_hours query int false "Also list reminders due within this many hours (default: 0, max: 720)"
+// @Success 200 {array} models.Reminder "Due reminders retrieved successfully"
+// @Failure 400 {object} models.ErrorResponse "Bad request - Invalid within_hours parameter"
+// @Failure 500 {object} models.ErrorResponse "Internal server error - Failed to fetch data from database"
+// @Router /api/reminders/due [get]
+func (rc *Controller) ListDueReminders(c *gin.Context) {
+	// ================ 1. Parse query parameters ================
+	withinHours, err := common.ParseIntQueryParam(c, "within_hours", 0)
+	if err != nil || withinHours < 0 || withinHours > maxDueWithinHours {
+		common.ResponseError(http.StatusBadRequest, "Invalid within_hours parameter", models.ErrCodeInvalidRequest, err, c)
+		return
+	}
+
+	// ================ 2. Fetch data from database ================
+	until := time.Now().UTC().Add(time.Duration(withinHours) * time.Hour)
+	reminderEntities, err := rc.listReminders(squirrel.LtOrEq{schema.REMINDER_DUE_AT: until})
+	if err != nil {
+		common.ResponseError(http.StatusInternalServerError, "Failed to fetch data from database", models.ErrCodeInternalError, err, c)
+		return
+	}
+
+	// ================ 3. Send response ================
+	common.ResponseSuccess(http.StatusOK, reminderEntities, c)
+}
@@ -0,0 +1,51 @@
+package reminder
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"time"
+	dbModels "word-flashcard/data/models"
+	"word-flashcard/data/schema"
+	"word-flashcard/utils"
+
+	"github.com/Masterminds/squirrel"
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+// TestListDueReminders tests that reminders due within the window are
+// listed with their labels
+func (suite *ControllerTestSuite) TestListDueReminders() {
+	before := time.Now().UTC().Add(48 * time.Hour)
+	dueUntil := mock.MatchedBy(func(where squirrel.LtOrEq) bool {
+		until, ok := where[schema.REMINDER_DUE_AT].(time.Time)
+		return ok && !until.Before(before) && until.Before(before.Add(time.Minute))
+	})
+	suite.mockReminderPeer.EXPECT().
+		Select([]*string{}, dueUntil, mock.Anything, (*uint64)(nil), (*uint64)(nil)).
+		Return([]*dbModels.Reminder{getSampleReminder()}, nil).Times(1)
+	suite.mockWordPeer.EXPECT().
+		Select(mock.Anything, squirrel.Eq{schema.WORD_ID: []int{1}}, mock.Anything, mock.Anything, mock.Anything).
+		Return([]*dbModels.Word{{Id: utils.IntPtr(1), Word: utils.StrPtr("take off")}}, nil).Times(1)
+
+	w := httptest.NewRecorder()
+	ctx, _ := gin.CreateTestContext(w)
+	ctx.Request = httptest.NewRequest(http.MethodGet, "/api/reminders/due?within_hours=48", nil)
+	suite.controller.ListDueReminders(ctx)
+
+	assert.Equal(suite.T(), http.StatusOK, w.Code)
+	assert.Contains(suite.T(), w.Body.String(), `"label":"take off"`)
+}
+
+// TestListDueRemindersInvalidWindow tests that a window out of range returns 400
+func (suite *ControllerTestSuite) TestListDueRemindersInvalidWindow() {
+	for _, query := range []string{"within_hours=-1", "within_hours=721", "within_hours=soon"} {
+		w := httptest.NewRecorder()
+		ctx, _ := gin.CreateTestContext(w)
+		ctx.Request = httptest.NewRequest(http.MethodGet, "/api/reminders/due?"+query, nil)
+		suite.controller.ListDueReminders(ctx)
+
+		assert.Equal(suite.T(), http.StatusBadRequest, w.Code, query)
+	}
+}
@@ -0,0 +1,57 @@
+package reminder
+
+import (
+	"fmt"
+	"net/http"
+	"word-flashcard/data/schema"
+	"word-flashcard/internal/controllers/common"
+	"word-flashcard/internal/models"
+
+	"github.com/gin-gonic/gin"
+)
+
+// maxHistoryEntries caps the limit query parameter of the history endpoint
+const maxHistoryEntries = 1000
+
+// ListReminderHistory @Summary List reminder history
+// @Description List cleared and deleted reminders, most recent first, each as it was when it was cleared or deleted
+// @Tags reminders
+// @Produce json
+// @Param entity_type query string false "Only list the history of this entity type: word, question or note"
+// @Param entity_id query int false "Only list the history of this entity (requires entity_type)"
+// @Param limit query int false "Number of entries to list (default: 50, max: 1000)"
+// @Success 200 {array} models.ReminderLog "Reminder history retrieved successfully"
+// @Failure 400 {object} models.ErrorResponse "Bad request - Invalid entity_type, entity_id or limit parameter"
+// @Failure 500 {object} models.ErrorResponse "Internal server error - Failed to fetch data from database"
+// @Router /api/reminders/history [get]
+func (rc *Controller) ListReminderHistory(c *gin.Context) {
+	// ================ 1. Parse query parameters ================
+	where, err := parseEntityFilter(c, schema.REMINDER_LOG_ENTITY_TYPE, schema.REMINDER_LOG_ENTITY_ID)
+	if err != nil {
+		common.ResponseError(http.StatusBadRequest, err.Error(), models.ErrCodeInvalidRequest, err, c)
+		return
+	}
+	limit, err := common.ParseIntQueryParam(c, "limit", 50)
+	if err != nil || limit < 1 || limit > maxHistoryEntries {
+		common.ResponseError(http.StatusBadRequest, "Invalid limit parameter", models.ErrCodeInvalidRequest, err, c)
+		return
+	}
+
+	// ================ 2. Fetch data from database ================
+	newestFirst := fmt.Sprintf("%s DESC", schema.REMINDER_LOG_ID)
+	limit64 := uint64(limit)
+	logs, err := rc.reminderLogPeer.Select([]*string{}, where, []*string{&newestFirst}, &limit64, nil)
+	if err != nil {
+		common.ResponseError(http.StatusInternalServerError, "Failed to fetch data from database", models.ErrCodeInternalError, err, c)
+		return
+	}
+
+	// ================ 3. Transform data to API model ================
+	history := make([]*models.ReminderLog, len(logs))
+	for i, log := range logs {
+		history[i] = new(models.ReminderLog).FromDataModel(log)
+	}
+
+	// ================ 4. Send response ================
+	common.ResponseSuccess(http.StatusOK, history, c)
+}
@@ -0,0 +1,54 @@
+package reminder
+
+import (
+	"net/http"
+	"net/http/httptest"
+	dbModels "word-flashcard/data/models"
+	"word-flashcard/data/schema"
+	"word-flashcard/utils"
+
+	"github.com/Masterminds/squirrel"
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+// TestListReminderHistory tests that the history of an item is listed newest first
+func (suite *ControllerTestSuite) TestListReminderHistory() {
+	limit := uint64(10)
+	where := squirrel.Eq{schema.REMINDER_LOG_ENTITY_TYPE: schema.ENTITY_TYPE_NOTE, schema.REMINDER_LOG_ENTITY_ID: 2}
+	suite.mockReminderLogPeer.EXPECT().
+		Select([]*string{}, where, []*string{utils.StrPtr("id DESC")}, &limit, (*uint64)(nil)).
+		Return([]*dbModels.ReminderLog{{
+			Id:         utils.IntPtr(4),
+			ReminderId: utils.IntPtr(2),
+			EntityType: utils.StrPtr(schema.ENTITY_TYPE_NOTE),
+			EntityId:   utils.IntPtr(2),
+			DueAt:      &testReminderModifyTime,
+			Recurrence: utils.StrPtr(schema.REMINDER_RECURRENCE_WEEKLY),
+			Note:       utils.StrPtr(""),
+			Action:     utils.StrPtr(schema.REMINDER_ACTION_CLEARED),
+			CreatedAt:  &testReminderModifyTime,
+		}}, nil).Times(1)
+
+	w := httptest.NewRecorder()
+	ctx, _ := gin.CreateTestContext(w)
+	ctx.Request = httptest.NewRequest(http.MethodGet, "/api/reminders/history?entity_type=note&entity_id=2&limit=10", nil)
+	suite.controller.ListReminderHistory(ctx)
+
+	assert.Equal(suite.T(), http.StatusOK, w.Code)
+	assert.JSONEq(suite.T(), `[
+		{"id":4,"reminder_id":2,"entity_type":"note","entity_id":2,"due_at":"2024-01-15T10:00:00Z","recurrence":"weekly","action":"cleared","at":"2024-01-15T10:00:00Z"}
+	]`, w.Body.String())
+}
+
+// TestListReminderHistoryInvalidLimit tests that a limit out of range returns 400
+func (suite *ControllerTestSuite) TestListReminderHistoryInvalidLimit() {
+	w := httptest.NewRecorder()
+	ctx, _ := gin.CreateTestContext(w)
+	ctx.Request = httptest.NewRequest(http.MethodGet, "/api/reminders/history?limit=1001", nil)
+	suite.controller.ListReminderHistory(ctx)
+
+	assert.Equal(suite.T(), http.StatusBadRequest, w.Code)
+	suite.mockReminderLogPeer.AssertNotCalled(suite.T(), "Select", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+}
@@ -0,0 +1,39 @@
+package reminder
+
+import (
+	"net/http"
+	"word-flashcard/data/schema"
+	"word-flashcard/internal/controllers/common"
+	"word-flashcard/internal/models"
+
+	"github.com/gin-gonic/gin"
+)
+
+// ListReminders @Summary List reminders
+// @Description List every reminder, soonest due first, each labelled with the word, question text or note title it reminds of. Reminders of deleted items are left out.
+// @Tags reminders
+// @Produce json
+// @Param entity_type query string false "Only list reminders of this entity type: word, question or note"
+// @Param entity_id query int false "Only list the reminder of this entity (requires entity_type)"
+// @Success 200 {array} models.Reminder "Reminders retrieved successfully"
+// @Failure 400 {object} models.ErrorResponse "Bad request - Invalid entity_type or entity_id parameter"
+// @Failure 500 {object} models.ErrorResponse "Internal server error - Failed to fetch data from database"
+// @Router /api/reminders [get]
+func (rc *Controller) ListReminders(c *gin.Context) {
+	// ================ 1. Parse query parameters ================
+	where, err := parseEntityFilter(c, schema.REMINDER_ENTITY_TYPE, schema.REMINDER_ENTITY_ID)
+	if err != nil {
+		common.ResponseError(http.StatusBadRequest, err.Error(), models.ErrCodeInvalidRequest, err, c)
+		return
+	}
+
+	// ================ 2. Fetch data from database ================
+	reminderEntities, err := rc.listReminders(where)
+	if err != nil {
+		common.ResponseError(http.StatusInternalServerError, "Failed to fetch data from database", models.ErrCodeInternalError, err, c)
+		return
+	}
+
+	// ================ 3. Send response ================
+	common.ResponseSuccess(http.StatusOK, reminderEntities, c)
+}
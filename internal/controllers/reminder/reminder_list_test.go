@@ -0,0 +1,102 @@
+package reminder
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	dbModels "word-flashcard/data/models"
+	"word-flashcard/data/schema"
+	"word-flashcard/utils"
+
+	"github.com/Masterminds/squirrel"
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+// TestListReminders tests that reminders are labelled with their word or
+// note and that reminders of deleted items are left out
+func (suite *ControllerTestSuite) TestListReminders() {
+	orphan := getSampleReminder()
+	orphan.Id, orphan.EntityId = utils.IntPtr(3), utils.IntPtr(9)
+
+	suite.mockReminderPeer.EXPECT().
+		Select([]*string{}, squirrel.Sqlizer(nil), mock.Anything, (*uint64)(nil), (*uint64)(nil)).
+		Return([]*dbModels.Reminder{getSampleReminder(), getSampleRecurringReminder(), orphan}, nil).Times(1)
+	suite.mockWordPeer.EXPECT().
+		Select(mock.Anything, squirrel.Eq{schema.WORD_ID: []int{1, 9}}, mock.Anything, mock.Anything, mock.Anything).
+		Return([]*dbModels.Word{{Id: utils.IntPtr(1), Word: utils.StrPtr("take off")}}, nil).Times(1)
+	suite.mockNotePeer.EXPECT().
+		Select(mock.Anything, squirrel.Eq{schema.NOTE_ID: []int{2}}, mock.Anything, mock.Anything, mock.Anything).
+		Return([]*dbModels.Note{{Id: utils.IntPtr(2), Title: utils.StrPtr("Irregular verbs")}}, nil).Times(1)
+
+	w := httptest.NewRecorder()
+	ctx, _ := gin.CreateTestContext(w)
+	ctx.Request = httptest.NewRequest(http.MethodGet, "/api/reminders", nil)
+	suite.controller.ListReminders(ctx)
+
+	assert.Equal(suite.T(), http.StatusOK, w.Code)
+	assert.JSONEq(suite.T(), `[
+		{"id":1,"entity_type":"word","entity_id":1,"label":"take off","due_at":"2024-01-15T10:00:00Z","note":"check the phrasal verbs","is_overdue":false,"created_at":"2024-01-15T10:00:00Z","updated_at":"2024-01-15T10:00:00Z"},
+		{"id":2,"entity_type":"note","entity_id":2,"label":"Irregular verbs","due_at":"2024-01-15T10:00:00Z","recurrence":"weekly","is_overdue":true,"created_at":"2024-01-15T10:00:00Z","updated_at":"2024-01-15T10:00:00Z"}
+	]`, w.Body.String())
+}
+
+// TestListRemindersFilteredByEntity tests that the entity query parameters
+// narrow the listing
+func (suite *ControllerTestSuite) TestListRemindersFilteredByEntity() {
+	where := squirrel.Eq{schema.REMINDER_ENTITY_TYPE: schema.ENTITY_TYPE_WORD, schema.REMINDER_ENTITY_ID: 5}
+	suite.mockReminderPeer.EXPECT().
+		Select([]*string{}, where, mock.Anything, (*uint64)(nil), (*uint64)(nil)).
+		Return([]*dbModels.Reminder{}, nil).Times(1)
+
+	w := httptest.NewRecorder()
+	ctx, _ := gin.CreateTestContext(w)
+	ctx.Request = httptest.NewRequest(http.MethodGet, "/api/reminders?entity_type=word&entity_id=5", nil)
+	suite.controller.ListReminders(ctx)
+
+	assert.Equal(suite.T(), http.StatusOK, w.Code)
+	assert.JSONEq(suite.T(), `[]`, w.Body.String())
+}
+
+// TestListRemindersInvalidFilter tests that an unknown entity type returns 400
+func (suite *ControllerTestSuite) TestListRemindersInvalidFilter() {
+	w := httptest.NewRecorder()
+	ctx, _ := gin.CreateTestContext(w)
+	ctx.Request = httptest.NewRequest(http.MethodGet, "/api/reminders?entity_type=deck", nil)
+	suite.controller.ListReminders(ctx)
+
+	assert.Equal(suite.T(), http.StatusBadRequest, w.Code)
+}
+
+// TestListRemindersPeerError tests that a database failure returns 500
+func (suite *ControllerTestSuite) TestListRemindersPeerError() {
+	suite.mockReminderPeer.EXPECT().
+		Select(mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+		Return(nil, fmt.Errorf("select failed")).Times(1)
+
+	w := httptest.NewRecorder()
+	ctx, _ := gin.CreateTestContext(w)
+	ctx.Request = httptest.NewRequest(http.MethodGet, "/api/reminders", nil)
+	suite.controller.ListReminders(ctx)
+
+	assert.Equal(suite.T(), http.StatusInternalServerError, w.Code)
+}
+
+// TestReminders tests that every reminder is listed, soonest due first,
+// for the calendar feed
+func (suite *ControllerTestSuite) TestReminders() {
+	dueFirst := []*string{utils.StrPtr("due_at ASC"), utils.StrPtr("id ASC")}
+	suite.mockReminderPeer.EXPECT().
+		Select([]*string{}, squirrel.Sqlizer(nil), dueFirst, (*uint64)(nil), (*uint64)(nil)).
+		Return([]*dbModels.Reminder{getSampleReminder()}, nil).Times(1)
+	suite.mockWordPeer.EXPECT().
+		Select(mock.Anything, squirrel.Eq{schema.WORD_ID: []int{1}}, mock.Anything, mock.Anything, mock.Anything).
+		Return([]*dbModels.Word{{Id: utils.IntPtr(1), Word: utils.StrPtr("take off")}}, nil).Times(1)
+
+	reminders, err := suite.controller.Reminders()
+
+	suite.Require().NoError(err)
+	suite.Require().Len(reminders, 1)
+	suite.Equal("take off", *reminders[0].Label)
+}
@@ -0,0 +1,56 @@
+package reminder
+
+import (
+	"time"
+	"word-flashcard/data/schema"
+	"word-flashcard/utils"
+)
+
+// TestRecurrenceDays tests the number of days of each recurrence
+func (suite *ControllerTestSuite) TestRecurrenceDays() {
+	days, ok := recurrenceDays(utils.StrPtr(schema.REMINDER_RECURRENCE_DAILY))
+	suite.True(ok)
+	suite.Equal(1, days)
+
+	days, ok = recurrenceDays(utils.StrPtr(schema.REMINDER_RECURRENCE_WEEKLY))
+	suite.True(ok)
+	suite.Equal(7, days)
+
+	_, ok = recurrenceDays(nil)
+	suite.False(ok)
+	_, ok = recurrenceDays(utils.StrPtr(""))
+	suite.False(ok)
+}
+
+// TestNextDueAt tests that a reminder cleared on time moves one interval
+// ahead and one cleared late skips the occurrences it missed
+func (suite *ControllerTestSuite) TestNextDueAt() {
+	due := time.Date(2024, 1, 15, 9, 0, 0, 0, time.UTC)
+	day := 24 * time.Hour
+
+	suite.Equal(due.Add(day), nextDueAt(due, 1, due.Add(time.Hour), time.UTC))
+	suite.Equal(due.Add(4*day), nextDueAt(due, 1, due.Add(3*day), time.UTC))
+	suite.Equal(due.Add(4*day), nextDueAt(due, 1, due.Add(3*day+time.Hour), time.UTC))
+	suite.Equal(due.Add(7*day), nextDueAt(due, 7, due.Add(-2*day), time.UTC))
+	suite.Equal(due.Add(371*day), nextDueAt(due, 7, due.Add(365*day), time.UTC))
+}
+
+// TestNextDueAtAcrossDST tests that a recurring reminder keeps its
+// wall-clock time when it moves across a DST change
+func (suite *ControllerTestSuite) TestNextDueAtAcrossDST() {
+	berlin, err := time.LoadLocation("Europe/Berlin")
+	suite.Require().NoError(err)
+
+	// 09:00 in Berlin on the Saturday before clocks go forward on 31 March 2024
+	due := time.Date(2024, 3, 30, 9, 0, 0, 0, berlin)
+
+	next := nextDueAt(due, 1, due.Add(time.Hour), berlin)
+	suite.Equal(time.Date(2024, 3, 31, 9, 0, 0, 0, berlin).UTC(), next)
+	suite.Equal(23*time.Hour, next.Sub(due))
+
+	next = nextDueAt(due, 7, due.Add(time.Hour), berlin)
+	suite.Equal(time.Date(2024, 4, 6, 9, 0, 0, 0, berlin).UTC(), next)
+
+	next = nextDueAt(due, 1, time.Date(2024, 11, 1, 12, 0, 0, 0, berlin), berlin)
+	suite.Equal(time.Date(2024, 11, 2, 9, 0, 0, 0, berlin).UTC(), next)
+}
@@ -0,0 +1,81 @@
+package reminder
+
+import (
+	"net/http"
+	"word-flashcard/data/schema"
+	"word-flashcard/internal/controllers/common"
+	"word-flashcard/internal/models"
+	"word-flashcard/utils"
+
+	"github.com/Masterminds/squirrel"
+	"github.com/gin-gonic/gin"
+)
+
+// UpdateReminder @Summary Update a reminder
+// @Description Change a reminder's due time, recurrence or note; omitted fields keep their value and an empty recurrence or note clears it. A new due time also lifts the overdue mark.
+// @Tags reminders
+// @Accept json
+// @Produce json
+// @Param id path int true "Reminder ID"
+// @Param reminder body models.Reminder true "Reminder fields to update"
+// @Success 200 {object} models.Reminder "Reminder updated successfully"
+// @Failure 400 {object} models.ErrorResponse "Bad request - Invalid reminder ID or request body"
+// @Failure 404 {object} models.ErrorResponse "Not found - Reminder not found"
+// @Failure 500 {object} models.ErrorResponse "Internal server error - Failed to update data in database"
+// @Router /api/reminders/{id} [put]
+func (rc *Controller) UpdateReminder(c *gin.Context) {
+	// ================ 1. Parse request parameter & body ================
+	reminderID, err := common.ParseIDFromPath(c, "id")
+	if err != nil {
+		common.ResponseError(http.StatusBadRequest, "Invalid reminder ID.", models.ErrCodeInvalidRequest, err, c)
+		return
+	}
+
+	var reminderData models.Reminder
+	if err := common.ParseRequestBody(&reminderData, c); err != nil {
+		common.ResponseError(http.StatusBadRequest, "Invalid request body", models.ErrCodeInvalidRequest, err, c)
+		return
+	}
+	if err := validateReminderFields(&reminderData, true); err != nil {
+		common.ResponseError(http.StatusBadRequest, err.Error(), models.ErrCodeValidationError, err, c)
+		return
+	}
+
+	// ================ 2. Check the reminder exists ================
+	// Existence is checked with a select rather than Update's affected row
+	// count, which MySQL reports as 0 when nothing changed
+	reminder, err := rc.findReminder(reminderID)
+	if err != nil {
+		common.ResponseError(http.StatusInternalServerError, "Failed to fetch data from database", models.ErrCodeInternalError, err, c)
+		return
+	} else if reminder == nil {
+		common.ResponseError(http.StatusNotFound, "Reminder not found", models.ErrCodeNotFound, nil, c)
+		return
+	}
+
+	// ================ 3. Update data in database ================
+	update := &models.Reminder{Recurrence: reminderData.Recurrence, Note: reminderData.Note}
+	if reminderData.DueAt != nil {
+		dueAt := reminderData.DueAt.UTC()
+		update.DueAt = &dueAt
+		update.IsOverdue = utils.BoolPtr(false)
+	}
+	where := squirrel.Eq{schema.REMINDER_ID: reminderID}
+	if _, err := rc.reminderPeer.Update(update.ToDataModel(), where); err != nil {
+		common.ResponseError(http.StatusInternalServerError, "Failed to update data in database", models.ErrCodeInternalError, err, c)
+		return
+	}
+
+	// ================ 4. Query updated data ================
+	reminder, err = rc.findReminder(reminderID)
+	if err != nil || reminder == nil {
+		common.ResponseError(http.StatusInternalServerError, "Updated but failed to fetch data from database", models.ErrCodeInternalError, err, c)
+		return
+	}
+
+	// ================ 5. Transform data to API model ================
+	reminderEntity := new(models.Reminder).FromDataModel(reminder)
+
+	// ================ 6. Send response ================
+	common.ResponseSuccess(http.StatusOK, reminderEntity, c)
+}
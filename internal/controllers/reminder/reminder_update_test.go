@@ -0,0 +1,74 @@
+package reminder
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	dbModels "word-flashcard/data/models"
+	"word-flashcard/data/schema"
+	"word-flashcard/utils"
+
+	"github.com/Masterminds/squirrel"
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+// TestUpdateReminder tests that a new due time lifts the overdue mark and
+// that an empty recurrence clears it
+func (suite *ControllerTestSuite) TestUpdateReminder() {
+	where := squirrel.Eq{schema.REMINDER_ID: 2}
+	updated := getSampleRecurringReminder()
+	updated.Recurrence, updated.IsOverdue = utils.StrPtr(""), utils.BoolPtr(false)
+
+	suite.mockReminderPeer.EXPECT().
+		Select([]*string{}, where, mock.Anything, mock.Anything, mock.Anything).
+		Return([]*dbModels.Reminder{getSampleRecurringReminder()}, nil).Once()
+	suite.mockReminderPeer.EXPECT().
+		Update(mock.MatchedBy(func(reminder *dbModels.Reminder) bool {
+			return reminder.DueAt.Equal(testReminderModifyTime) && !*reminder.IsOverdue &&
+				*reminder.Recurrence == "" && reminder.Note == nil && reminder.EntityType == nil
+		}), where).
+		Return(int64(1), nil).Times(1)
+	suite.mockReminderPeer.EXPECT().
+		Select([]*string{}, where, mock.Anything, mock.Anything, mock.Anything).
+		Return([]*dbModels.Reminder{updated}, nil).Once()
+
+	w := httptest.NewRecorder()
+	ctx, _ := gin.CreateTestContext(w)
+	ctx.Request = httptest.NewRequest(http.MethodPut, "/api/reminders/2", strings.NewReader(`{"due_at":"2024-01-15T10:00:00Z","recurrence":""}`))
+	ctx.Request.Header.Set("Content-Type", "application/json")
+	ctx.Params = gin.Params{gin.Param{Key: "id", Value: "2"}}
+	suite.controller.UpdateReminder(ctx)
+
+	assert.Equal(suite.T(), http.StatusOK, w.Code)
+	assert.JSONEq(suite.T(), `{"id":2,"entity_type":"note","entity_id":2,"due_at":"2024-01-15T10:00:00Z","is_overdue":false,"created_at":"2024-01-15T10:00:00Z","updated_at":"2024-01-15T10:00:00Z"}`, w.Body.String())
+}
+
+// TestUpdateReminderNotFound tests that updating a missing reminder returns 404
+func (suite *ControllerTestSuite) TestUpdateReminderNotFound() {
+	suite.mockReminderPeer.EXPECT().
+		Select([]*string{}, squirrel.Eq{schema.REMINDER_ID: 999}, mock.Anything, mock.Anything, mock.Anything).
+		Return([]*dbModels.Reminder{}, nil).Times(1)
+
+	w := httptest.NewRecorder()
+	ctx, _ := gin.CreateTestContext(w)
+	ctx.Request = httptest.NewRequest(http.MethodPut, "/api/reminders/999", strings.NewReader(`{"note":"later"}`))
+	ctx.Request.Header.Set("Content-Type", "application/json")
+	ctx.Params = gin.Params{gin.Param{Key: "id", Value: "999"}}
+	suite.controller.UpdateReminder(ctx)
+
+	assert.Equal(suite.T(), http.StatusNotFound, w.Code)
+}
+
+// TestUpdateReminderEntityChange tests that moving a reminder to another item returns 400
+func (suite *ControllerTestSuite) TestUpdateReminderEntityChange() {
+	w := httptest.NewRecorder()
+	ctx, _ := gin.CreateTestContext(w)
+	ctx.Request = httptest.NewRequest(http.MethodPut, "/api/reminders/1", strings.NewReader(`{"entity_id":3}`))
+	ctx.Request.Header.Set("Content-Type", "application/json")
+	ctx.Params = gin.Params{gin.Param{Key: "id", Value: "1"}}
+	suite.controller.UpdateReminder(ctx)
+
+	assert.Equal(suite.T(), http.StatusBadRequest, w.Code)
+}
@@ -0,0 +1,71 @@
+package reminder
+
+import (
+	"slices"
+	"strconv"
+	"word-flashcard/data/schema"
+	"word-flashcard/internal/controllers/common"
+	"word-flashcard/internal/links"
+	"word-flashcard/internal/models"
+
+	"github.com/Masterminds/squirrel"
+	"github.com/gin-gonic/gin"
+)
+
+// recurrences lists the accepted reminder recurrences
+var recurrences = []string{schema.REMINDER_RECURRENCE_DAILY, schema.REMINDER_RECURRENCE_WEEKLY}
+
+// validateReminderFields validates the requested reminder. A new reminder
+// needs its entity and due time; an update can change the due time,
+// recurrence and note but not the entity, and an empty recurrence or note
+// clears it.
+func validateReminderFields(reminder *models.Reminder, isUpdate bool) error {
+	if isUpdate {
+		if reminder.EntityType != nil || reminder.EntityID != nil {
+			return common.NewFieldError("entity_type is invalid", "reason", "a reminder can't be moved to another entity")
+		}
+		if reminder.DueAt == nil && reminder.Recurrence == nil && reminder.Note == nil {
+			return common.NewFieldError("request body is invalid", "reason", "one of due_at, recurrence or note is required")
+		}
+	} else {
+		if reminder.EntityType == nil || !slices.Contains(links.EntityTypes, *reminder.EntityType) {
+			return common.NewFieldError("entity_type is invalid", "reason", "must be one of word, question, note")
+		}
+		if reminder.EntityID == nil || *reminder.EntityID <= 0 {
+			return common.NewFieldError("entity_id is invalid", "reason", "must be a positive ID")
+		}
+		if reminder.DueAt == nil || reminder.DueAt.IsZero() {
+			return common.NewFieldError("due_at is invalid", "reason", "required field missing")
+		}
+	}
+
+	// recurrence: VARCHAR(10), Allow NULL
+	if reminder.Recurrence != nil && *reminder.Recurrence != "" && !slices.Contains(recurrences, *reminder.Recurrence) {
+		return common.NewFieldError("recurrence is invalid", "reason", "must be daily or weekly", "value", *reminder.Recurrence)
+	}
+
+	// note: VARCHAR(255), Allow NULL
+	return common.ValidateStringField(reminder.Note, isUpdate, "note", 255, true)
+}
+
+// parseEntityFilter builds the where clause of the optional entity_type and
+// entity_id query parameters, nil when neither is set. entity_id is only
+// accepted together with entity_type.
+func parseEntityFilter(c *gin.Context, typeColumn string, idColumn string) (squirrel.Sqlizer, error) {
+	entityType, entityID := c.Query("entity_type"), c.Query("entity_id")
+	if entityType == "" && entityID == "" {
+		return nil, nil
+	}
+	if !slices.Contains(links.EntityTypes, entityType) {
+		return nil, common.NewFieldError("entity_type is invalid", "value", entityType)
+	}
+	where := squirrel.Eq{typeColumn: entityType}
+	if entityID != "" {
+		id, err := strconv.Atoi(entityID)
+		if err != nil || id <= 0 {
+			return nil, common.NewFieldError("entity_id is invalid", "value", entityID)
+		}
+		where[idColumn] = id
+	}
+	return where, nil
+}
@@ -0,0 +1,119 @@
+package reminder
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"time"
+	"word-flashcard/data/schema"
+	"word-flashcard/internal/models"
+	"word-flashcard/utils"
+
+	"github.com/Masterminds/squirrel"
+	"github.com/gin-gonic/gin"
+)
+
+// TestValidateReminderFields tests the fields a new and an updated reminder accept
+func (suite *ControllerTestSuite) TestValidateReminderFields() {
+	due := time.Date(2024, 1, 15, 9, 0, 0, 0, time.UTC)
+	valid := func() *models.Reminder {
+		return &models.Reminder{
+			EntityType: utils.StrPtr(schema.ENTITY_TYPE_QUESTION),
+			EntityID:   utils.IntPtr(3),
+			DueAt:      &due,
+			Recurrence: utils.StrPtr(schema.REMINDER_RECURRENCE_DAILY),
+		}
+	}
+
+	testCases := []struct {
+		name       string
+		reminder   func() *models.Reminder
+		isUpdate   bool
+		wantErrMsg string
+	}{
+		{name: "valid new reminder", reminder: valid},
+		{
+			name:       "unknown entity type",
+			reminder:   func() *models.Reminder { r := valid(); r.EntityType = utils.StrPtr("deck"); return r },
+			wantErrMsg: "entity_type is invalid",
+		},
+		{
+			name:       "missing entity id",
+			reminder:   func() *models.Reminder { r := valid(); r.EntityID = nil; return r },
+			wantErrMsg: "entity_id is invalid",
+		},
+		{
+			name:       "missing due time",
+			reminder:   func() *models.Reminder { r := valid(); r.DueAt = nil; return r },
+			wantErrMsg: "due_at is invalid",
+		},
+		{
+			name:       "unknown recurrence",
+			reminder:   func() *models.Reminder { r := valid(); r.Recurrence = utils.StrPtr("monthly"); return r },
+			wantErrMsg: "recurrence is invalid",
+		},
+		{
+			name:       "note too long",
+			reminder:   func() *models.Reminder { r := valid(); r.Note = utils.StrPtr(strings.Repeat("a", 256)); return r },
+			wantErrMsg: "note is invalid",
+		},
+		{
+			name:     "update clearing the recurrence",
+			reminder: func() *models.Reminder { return &models.Reminder{Recurrence: utils.StrPtr("")} },
+			isUpdate: true,
+		},
+		{
+			name:       "update moving the reminder to another entity",
+			reminder:   func() *models.Reminder { r := valid(); r.EntityType, r.DueAt = nil, nil; return r },
+			isUpdate:   true,
+			wantErrMsg: "entity_type is invalid",
+		},
+		{
+			name:       "empty update",
+			reminder:   func() *models.Reminder { return &models.Reminder{} },
+			isUpdate:   true,
+			wantErrMsg: "request body is invalid",
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			err := validateReminderFields(tc.reminder(), tc.isUpdate)
+			if tc.wantErrMsg == "" {
+				suite.NoError(err)
+			} else {
+				suite.EqualError(err, tc.wantErrMsg)
+			}
+		})
+	}
+}
+
+// TestParseEntityFilter tests the where clause built from the entity query parameters
+func (suite *ControllerTestSuite) TestParseEntityFilter() {
+	testCases := []struct {
+		name    string
+		query   string
+		want    squirrel.Sqlizer
+		wantErr bool
+	}{
+		{name: "no filter", query: "", want: nil},
+		{name: "entity type", query: "?entity_type=note", want: squirrel.Eq{"entity_type": "note"}},
+		{name: "entity", query: "?entity_type=word&entity_id=4", want: squirrel.Eq{"entity_type": "word", "entity_id": 4}},
+		{name: "entity id without type", query: "?entity_id=4", wantErr: true},
+		{name: "invalid entity id", query: "?entity_type=word&entity_id=abc", wantErr: true},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			ctx, _ := gin.CreateTestContext(httptest.NewRecorder())
+			ctx.Request = httptest.NewRequest(http.MethodGet, "/api/reminders"+tc.query, nil)
+			where, err := parseEntityFilter(ctx, schema.REMINDER_ENTITY_TYPE, schema.REMINDER_ENTITY_ID)
+			if tc.wantErr {
+				suite.Error(err)
+				return
+			}
+			suite.NoError(err)
+			suite.Equal(tc.want, where)
+		})
+	}
+}
@@ -0,0 +1,152 @@
+package word
+
+import (
+	"encoding/json"
+	"math/rand"
+	"strings"
+	dbModels "word-flashcard/data/models"
+	"word-flashcard/data/schema"
+	"word-flashcard/internal/controllers/common"
+	"word-flashcard/internal/models"
+	"word-flashcard/utils"
+	"word-flashcard/utils/database"
+
+	"github.com/Masterminds/squirrel"
+)
+
+// clozeDistractorCount is the number of wrong options offered per cloze prompt.
+const clozeDistractorCount = 3
+
+// clozeDistractorPoolSize bounds how many other definitions are sampled when
+// looking for distractor words.
+const clozeDistractorPoolSize = 20
+
+// clozeExample is an example sentence with the word blanked out, along with
+// how the word was inflected where it appeared.
+type clozeExample struct {
+	sentence     string
+	partOfSpeech string
+	kind         string
+}
+
+// pickClozeExample picks a random example sentence of word in which the word
+// actually appears (in any regular inflected form) and blanks it out. It
+// returns ok=false when none of the word's examples mention it.
+func pickClozeExample(word *dbModels.Word, defs []*dbModels.WordDefinition) (clozeExample, bool) {
+	var candidates []clozeExample
+	for _, def := range defs {
+		if def.WordId == nil || *def.WordId != *word.Id || def.Examples == nil {
+			continue
+		}
+		var examples []string
+		if err := json.Unmarshal([]byte(*def.Examples), &examples); err != nil {
+			continue
+		}
+		for _, example := range examples {
+			start, end, kind, ok := common.FindInflection(example, *word.Word)
+			if !ok {
+				continue
+			}
+			candidates = append(candidates, clozeExample{
+				sentence:     example[:start] + common.WordBlank + example[end:],
+				partOfSpeech: utils.DerefStr(def.PartOfSpeech),
+				kind:         kind,
+			})
+		}
+	}
+	if len(candidates) == 0 {
+		return clozeExample{}, false
+	}
+	return candidates[rand.Intn(len(candidates))], true
+}
+
+// fetchClozeDistractors returns up to clozeDistractorCount other words from
+// the collection, preferring words that have a definition with the same
+// part of speech.
+func (wc *Controller) fetchClozeDistractors(word *dbModels.Word, partOfSpeech string) ([]string, error) {
+	orderBy := database.TERM_MAPPING_FUNC_RANDOM
+	limit := uint64(clozeDistractorPoolSize)
+	defs, err := wc.wordDefinitionPeer.Select([]*string{}, squirrel.And{
+		squirrel.Eq{schema.WORD_DEFINITIONS_PART_OF_SPEECH: partOfSpeech},
+		squirrel.NotEq{schema.WORD_DEFINITIONS_WORD_ID: *word.Id},
+	}, []*string{&orderBy}, &limit, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var wordIDs []int
+	for _, def := range defs {
+		wordIDs = append(wordIDs, *def.WordId)
+	}
+	var candidates []*dbModels.Word
+	if len(wordIDs) > 0 {
+		candidates, err = wc.wordPeer.Select([]*string{}, squirrel.Eq{schema.WORD_ID: wordIDs}, nil, nil, nil)
+		if err != nil {
+			return nil, err
+		}
+	}
+	if len(candidates) < clozeDistractorCount {
+		// Not enough words sharing the part of speech: top up with any other words.
+		others, err := wc.wordPeer.Select([]*string{}, squirrel.NotEq{schema.WORD_ID: *word.Id}, []*string{&orderBy}, &limit, nil)
+		if err != nil {
+			return nil, err
+		}
+		candidates = append(candidates, others...)
+	}
+
+	seen := map[string]bool{common.NormalizeAnswer(*word.Word): true}
+	var distractors []string
+	for _, candidate := range candidates {
+		if len(distractors) == clozeDistractorCount {
+			break
+		}
+		if candidate.Word == nil || seen[common.NormalizeAnswer(*candidate.Word)] {
+			continue
+		}
+		seen[common.NormalizeAnswer(*candidate.Word)] = true
+		distractors = append(distractors, *candidate.Word)
+	}
+	return distractors, nil
+}
+
+// buildClozeOptions inflects the answer and each distractor the same way the
+// word appeared in the sentence and shuffles them. No options are returned
+// when there are no distractors, leaving the prompt as free typing.
+func buildClozeOptions(word string, distractors []string, kind string) []string {
+	if len(distractors) == 0 {
+		return []string{}
+	}
+	options := []string{common.Inflect(word, kind)}
+	for _, d := range distractors {
+		options = append(options, common.Inflect(d, kind))
+	}
+	rand.Shuffle(len(options), func(i, j int) { options[i], options[j] = options[j], options[i] })
+	return options
+}
+
+// clozeAcceptedAnswers lists every spelling accepted as an answer for word
+// in a cloze: each regular inflection, with its spelling variants, so the
+// learner isn't marked wrong for choosing a different tense or number.
+func clozeAcceptedAnswers(word string) []string {
+	var accepted []string
+	seen := map[string]bool{}
+	for _, kind := range []string{common.InflectionBase, common.InflectionS, common.InflectionED, common.InflectionING} {
+		for _, spelling := range common.AcceptedSpellings(common.Inflect(strings.TrimSpace(word), kind)) {
+			if !seen[spelling] {
+				seen[spelling] = true
+				accepted = append(accepted, spelling)
+			}
+		}
+	}
+	return accepted
+}
+
+// buildClozePrompt assembles the prompt returned to the client.
+func buildClozePrompt(word *dbModels.Word, example clozeExample, distractors []string) models.ClozePrompt {
+	return models.ClozePrompt{
+		WordID:       *word.Id,
+		PartOfSpeech: example.partOfSpeech,
+		Sentence:     example.sentence,
+		Options:      buildClozeOptions(*word.Word, distractors, example.kind),
+	}
+}
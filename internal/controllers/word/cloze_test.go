@@ -0,0 +1,83 @@
+package word
+
+import (
+	dbModels "word-flashcard/data/models"
+	"word-flashcard/data/schema"
+	"word-flashcard/internal/controllers/common"
+	"word-flashcard/utils"
+
+	"github.com/Masterminds/squirrel"
+	"github.com/stretchr/testify/mock"
+)
+
+// TestPickClozeExample tests that only examples mentioning the word are used and the word is blanked
+func (suite *HelperTestSuite) TestPickClozeExample() {
+	word := &dbModels.Word{Id: utils.IntPtr(1), Word: utils.StrPtr("bake")}
+	defs := []*dbModels.WordDefinition{
+		{WordId: utils.IntPtr(1), PartOfSpeech: utils.StrPtr("verb"), Examples: utils.StrPtr(`["Nothing here.", "She baked a cake.她烤了蛋糕。"]`)},
+		{WordId: utils.IntPtr(2), PartOfSpeech: utils.StrPtr("verb"), Examples: utils.StrPtr(`["We bake bread."]`)},
+		{WordId: utils.IntPtr(1), Examples: utils.StrPtr(`not json`)},
+	}
+
+	example, ok := pickClozeExample(word, defs)
+
+	suite.True(ok)
+	suite.Equal("She ____ a cake.她烤了蛋糕。", example.sentence)
+	suite.Equal("verb", example.partOfSpeech)
+	suite.Equal(common.InflectionED, example.kind)
+
+	_, ok = pickClozeExample(word, defs[1:])
+	suite.False(ok)
+}
+
+// TestBuildClozeOptions tests that options are inflected like the blank and empty without distractors
+func (suite *HelperTestSuite) TestBuildClozeOptions() {
+	suite.ElementsMatch([]string{"baked", "stopped", "tried"}, buildClozeOptions("bake", []string{"stop", "try"}, common.InflectionED))
+	suite.Equal([]string{}, buildClozeOptions("bake", nil, common.InflectionED))
+}
+
+// TestClozeAcceptedAnswers tests that every regular inflection and spelling variant is accepted
+func (suite *HelperTestSuite) TestClozeAcceptedAnswers() {
+	accepted := clozeAcceptedAnswers("organise")
+	for _, want := range []string{"organise", "organize", "organises", "organised", "organized", "organising", "organizing"} {
+		suite.Contains(accepted, want)
+	}
+}
+
+// TestFetchClozeDistractors tests same-part-of-speech distractors topped up with other words
+func (suite *HelperTestSuite) TestFetchClozeDistractors() {
+	word := &dbModels.Word{Id: utils.IntPtr(1), Word: utils.StrPtr("apple")}
+
+	suite.mockWordDefinitionPeer.EXPECT().
+		Select(mock.Anything, squirrel.And{
+			squirrel.Eq{schema.WORD_DEFINITIONS_PART_OF_SPEECH: "noun"},
+			squirrel.NotEq{schema.WORD_DEFINITIONS_WORD_ID: 1},
+		}, mock.Anything, mock.Anything, mock.Anything).
+		Return([]*dbModels.WordDefinition{{WordId: utils.IntPtr(2)}}, nil).Once()
+	suite.mockWordPeer.EXPECT().
+		Select(mock.Anything, squirrel.Eq{schema.WORD_ID: []int{2}}, mock.Anything, mock.Anything, mock.Anything).
+		Return([]*dbModels.Word{{Id: utils.IntPtr(2), Word: utils.StrPtr("pear")}}, nil).Once()
+	suite.mockWordPeer.EXPECT().
+		Select(mock.Anything, squirrel.NotEq{schema.WORD_ID: 1}, mock.Anything, mock.Anything, mock.Anything).
+		Return([]*dbModels.Word{
+			{Id: utils.IntPtr(2), Word: utils.StrPtr("pear")},
+			{Id: utils.IntPtr(3), Word: utils.StrPtr("Apple")},
+			{Id: utils.IntPtr(4), Word: utils.StrPtr("run")},
+		}, nil).Once()
+
+	distractors, err := suite.controller.fetchClozeDistractors(word, "noun")
+
+	suite.NoError(err)
+	suite.Equal([]string{"pear", "run"}, distractors)
+}
+
+// TestFetchClozeDistractorsError tests that a lookup failure is returned
+func (suite *HelperTestSuite) TestFetchClozeDistractorsError() {
+	suite.mockWordDefinitionPeer.EXPECT().
+		Select(mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+		Return(nil, suite.createTestError()).Once()
+
+	_, err := suite.controller.fetchClozeDistractors(&dbModels.Word{Id: utils.IntPtr(1), Word: utils.StrPtr("apple")}, "noun")
+
+	suite.Error(err)
+}
@@ -3,6 +3,10 @@ package word
 import (
 	"word-flashcard/data/peers"
 	"word-flashcard/data/schema"
+	"word-flashcard/internal/audiostore"
+	"word-flashcard/internal/controllers/common"
+	"word-flashcard/internal/leechdetect"
+	"word-flashcard/internal/links"
 )
 
 // wordSortableColumns defines the columns allowed in sort query parameters for the words table.
@@ -19,33 +23,62 @@ type Controller struct {
 	wordPeer            peers.WordPeerInterface
 	wordDefinitionPeer  peers.WordDefinitionsPeerInterface
 	wordPracticeLogPeer peers.WordPracticeLogPeerInterface
+	reminderPeer        peers.ReminderPeerInterface
+	linkResolver        *links.LinkResolver
+	audioStore          *audiostore.AudioStore
+	leechDetector       *leechdetect.LeechDetector
+	lemmatizer          common.Lemmatizer
 }
 
 // New creates a new Controller instance
-func New(wordPeer peers.WordPeerInterface, wordDefinition peers.WordDefinitionsPeerInterface, wordPracticeLogPeer peers.WordPracticeLogPeerInterface) *Controller {
+func New(
+	wordPeer peers.WordPeerInterface,
+	wordDefinition peers.WordDefinitionsPeerInterface,
+	wordPracticeLogPeer peers.WordPracticeLogPeerInterface,
+	reminderPeer peers.ReminderPeerInterface,
+	linkResolver *links.LinkResolver,
+	audioStore *audiostore.AudioStore,
+	leechDetector *leechdetect.LeechDetector,
+) *Controller {
 	return &Controller{
 		wordPeer:            wordPeer,
 		wordDefinitionPeer:  wordDefinition,
 		wordPracticeLogPeer: wordPracticeLogPeer,
+		reminderPeer:        reminderPeer,
+		linkResolver:        linkResolver,
+		audioStore:          audioStore,
+		leechDetector:       leechDetector,
+		lemmatizer:          common.EnglishLemmatizer{},
 	}
 }
 
 // GetReelPeers returns the real database peers
-func GetReelPeers() (peers.WordPeerInterface, peers.WordDefinitionsPeerInterface, peers.WordPracticeLogPeerInterface, error) {
+func GetReelPeers() (
+	peers.WordPeerInterface,
+	peers.WordDefinitionsPeerInterface,
+	peers.WordPracticeLogPeerInterface,
+	peers.ReminderPeerInterface,
+	error,
+) {
 	wordPeer, err := peers.NewWordPeer()
 	if err != nil {
-		return nil, nil, nil, err
+		return nil, nil, nil, nil, err
 	}
 
 	wordDefinitionPeer, err := peers.NewWordDefinitionsPeer()
 	if err != nil {
-		return nil, nil, nil, err
+		return nil, nil, nil, nil, err
 	}
 
 	wordPracticeLogPeer, err := peers.NewWordPracticeLogPeer()
 	if err != nil {
-		return nil, nil, nil, err
+		return nil, nil, nil, nil, err
 	}
 
-	return wordPeer, wordDefinitionPeer, wordPracticeLogPeer, nil
+	reminderPeer, err := peers.NewReminderPeer()
+	if err != nil {
+		return nil, nil, nil, nil, err
+	}
+
+	return wordPeer, wordDefinitionPeer, wordPracticeLogPeer, reminderPeer, nil
 }
@@ -5,6 +5,9 @@ import (
 	"time"
 	"word-flashcard/data/mocks"
 	dbModels "word-flashcard/data/models"
+	"word-flashcard/internal/audiostore"
+	"word-flashcard/internal/leechdetect"
+	"word-flashcard/internal/links"
 	"word-flashcard/internal/models"
 	"word-flashcard/utils"
 
@@ -18,6 +21,13 @@ type ControllerTestSuite struct {
 	mockWordPeer            *mocks.MockWordPeer
 	mockWordDefinitionPeer  *mocks.MockWordDefinitionsPeer
 	mockWordPracticeLogPeer *mocks.MockWordPracticeLogPeer
+	mockReminderPeer        *mocks.MockReminderPeer
+	mockEntityLinkPeer      *mocks.MockEntityLinkPeer
+	mockQuestionPeer        *mocks.MockQuestionPeer
+	mockNotePeer            *mocks.MockNotePeer
+	mockAudioFilePeer       *mocks.MockAudioFilePeer
+	mockSettingPeer         *mocks.MockSettingPeer
+	mockAnswerLogPeer       *mocks.MockQuestionAnswerLogPeer
 }
 
 // TestControllerTestSuite runs the ControllerTestSuite
@@ -30,8 +40,18 @@ func (suite *ControllerTestSuite) SetupTest() {
 	suite.mockWordPeer = mocks.NewMockWordPeer(suite.T())
 	suite.mockWordDefinitionPeer = mocks.NewMockWordDefinitionsPeer(suite.T())
 	suite.mockWordPracticeLogPeer = mocks.NewMockWordPracticeLogPeer(suite.T())
+	suite.mockReminderPeer = mocks.NewMockReminderPeer(suite.T())
+	suite.mockEntityLinkPeer = mocks.NewMockEntityLinkPeer(suite.T())
+	suite.mockQuestionPeer = mocks.NewMockQuestionPeer(suite.T())
+	suite.mockNotePeer = mocks.NewMockNotePeer(suite.T())
+	suite.mockAudioFilePeer = mocks.NewMockAudioFilePeer(suite.T())
+	suite.mockSettingPeer = mocks.NewMockSettingPeer(suite.T())
+	suite.mockAnswerLogPeer = mocks.NewMockQuestionAnswerLogPeer(suite.T())
+	linkResolver := links.NewLinkResolver(suite.mockEntityLinkPeer, suite.mockWordPeer, suite.mockQuestionPeer, suite.mockNotePeer)
+	audioStore := audiostore.NewAudioStore(suite.mockAudioFilePeer)
+	leechDetector := leechdetect.NewLeechDetector(suite.mockSettingPeer, suite.mockWordPeer, suite.mockQuestionPeer, suite.mockWordPracticeLogPeer, suite.mockAnswerLogPeer)
 
-	suite.controller = New(suite.mockWordPeer, suite.mockWordDefinitionPeer, suite.mockWordPracticeLogPeer)
+	suite.controller = New(suite.mockWordPeer, suite.mockWordDefinitionPeer, suite.mockWordPracticeLogPeer, suite.mockReminderPeer, linkResolver, audioStore, leechDetector)
 }
 
 // getSampleWords return sample word for testing
@@ -0,0 +1,141 @@
+package word
+
+import (
+	"fmt"
+	"slices"
+	"sort"
+	"strings"
+
+	dbModels "word-flashcard/data/models"
+	"word-flashcard/data/schema"
+	"word-flashcard/internal/controllers/common"
+	"word-flashcard/internal/models"
+
+	"github.com/Masterminds/squirrel"
+)
+
+// duplicateWordColumns returns the words columns needed to look for and
+// report duplicates
+func duplicateWordColumns() []*string {
+	columns := []string{schema.WORD_ID, schema.WORD_WORD, schema.WORD_FAMILIARITY, schema.WORD_COUNT_PRACTISE}
+	ptrs := make([]*string, len(columns))
+	for i := range columns {
+		ptrs[i] = &columns[i]
+	}
+	return ptrs
+}
+
+// duplicateInitialColumn is the lower-cased first letter of a stored word,
+// trimmed the same way the typed word is so stray leading spaces saved
+// with older entries don't hide them from the lookup
+var duplicateInitialColumn = fmt.Sprintf("LOWER(SUBSTR(TRIM(%s), 1, 1))", schema.WORD_WORD)
+
+// selectDuplicateCandidates fetches the words matching where (nil for every
+// word) with the columns needed to compare them. Inflections can't be
+// matched in SQL, so the comparison itself is done in memory.
+func (wc *Controller) selectDuplicateCandidates(where squirrel.Sqlizer) ([]*dbModels.Word, error) {
+	orderBy := fmt.Sprintf("%s ASC", schema.WORD_ID)
+	return wc.wordPeer.Select(duplicateWordColumns(), where, []*string{&orderBy}, nil, nil)
+}
+
+// duplicateCandidatesWhere narrows the words that may share one of keys to
+// those starting with one of their initials (see common.DuplicateInitials).
+// The initial of word as typed is included too, since the keys have its
+// diacritics stripped and the stored spelling may keep them (élan, elan).
+func duplicateCandidatesWhere(word string, keys []string) squirrel.Sqlizer {
+	initials := common.DuplicateInitials(keys)
+	for _, initial := range common.DuplicateInitials([]string{strings.ToLower(strings.TrimSpace(word))}) {
+		if !slices.Contains(initials, initial) {
+			initials = append(initials, initial)
+		}
+	}
+	return squirrel.Eq{duplicateInitialColumn: initials}
+}
+
+// findPossibleDuplicates returns the words other than wordID that are likely
+// the same entry as word
+func (wc *Controller) findPossibleDuplicates(wordID int, word string) ([]models.WordDuplicate, error) {
+	keys := common.DuplicateKeys(word, wc.lemmatizer)
+	if len(keys) == 0 {
+		return nil, nil
+	}
+
+	words, err := wc.selectDuplicateCandidates(duplicateCandidatesWhere(word, keys))
+	if err != nil {
+		return nil, err
+	}
+
+	var duplicates []models.WordDuplicate
+	for _, w := range words {
+		if w.Id == nil || w.Word == nil || *w.Id == wordID {
+			continue
+		}
+		for _, key := range common.DuplicateKeys(*w.Word, wc.lemmatizer) {
+			if slices.Contains(keys, key) {
+				duplicates = append(duplicates, toWordDuplicate(w))
+				break
+			}
+		}
+	}
+	return duplicates, nil
+}
+
+// groupDuplicateWords groups words that are likely the same entry. The
+// definitions count of each word is taken from definitionCounts.
+func (wc *Controller) groupDuplicateWords(words []*dbModels.Word, keys [][]string, definitionCounts map[int]int) []models.WordDuplicateGroup {
+	groups := []models.WordDuplicateGroup{}
+	for _, indexes := range common.GroupByKeys(keys) {
+		// The lemma is the key most of the words share, the first seen on a tie
+		var lemma string
+		shared := make(map[string]int)
+		for _, i := range indexes {
+			for _, key := range keys[i] {
+				shared[key]++
+				if lemma == "" || shared[key] > shared[lemma] {
+					lemma = key
+				}
+			}
+		}
+
+		group := models.WordDuplicateGroup{Lemma: lemma, Reason: models.WordDuplicateSpelling}
+		for _, i := range indexes {
+			duplicate := toWordDuplicate(words[i])
+			count := definitionCounts[duplicate.ID]
+			duplicate.Definitions = &count
+			group.Words = append(group.Words, duplicate)
+			if keys[i][0] != keys[indexes[0]][0] {
+				group.Reason = models.WordDuplicateInflection
+			}
+		}
+
+		// Merge into the dictionary form when it is one of the words,
+		// otherwise into the word with the most practice behind it
+		sort.SliceStable(group.Words, func(a, b int) bool {
+			wa, wb := group.Words[a], group.Words[b]
+			if isA, isB := common.NormalizeWord(wa.Word) == lemma, common.NormalizeWord(wb.Word) == lemma; isA != isB {
+				return isA
+			}
+			if wa.CountPractise != wb.CountPractise {
+				return wa.CountPractise > wb.CountPractise
+			}
+			if *wa.Definitions != *wb.Definitions {
+				return *wa.Definitions > *wb.Definitions
+			}
+			return wa.ID < wb.ID
+		})
+		group.SuggestedTargetID = group.Words[0].ID
+		groups = append(groups, group)
+	}
+
+	sort.SliceStable(groups, func(a, b int) bool { return groups[a].Lemma < groups[b].Lemma })
+	return groups
+}
+
+// toWordDuplicate converts a data model Word to a WordDuplicate
+func toWordDuplicate(w *dbModels.Word) models.WordDuplicate {
+	duplicate := models.WordDuplicate{ID: *w.Id, Word: *w.Word, Familiarity: w.Familiarity}
+	if w.CountPractise != nil {
+		duplicate.CountPractise = *w.CountPractise
+	}
+	return duplicate
+}
@@ -8,6 +8,7 @@ import (
 	"testing"
 	"word-flashcard/data/mocks"
 	dbModels "word-flashcard/data/models"
+	"word-flashcard/internal/links"
 
 	"github.com/gin-gonic/gin"
 	"github.com/stretchr/testify/suite"
@@ -20,6 +21,9 @@ type HelperTestSuite struct {
 	mockWordPeer            *mocks.MockWordPeer
 	mockWordDefinitionPeer  *mocks.MockWordDefinitionsPeer
 	mockWordPracticeLogPeer *mocks.MockWordPracticeLogPeer
+	mockEntityLinkPeer      *mocks.MockEntityLinkPeer
+	mockQuestionPeer        *mocks.MockQuestionPeer
+	mockNotePeer            *mocks.MockNotePeer
 }
 
 // TestHelperTestSuite runs the HelperTestSuite
@@ -32,7 +36,11 @@ func (suite *HelperTestSuite) SetupTest() {
 	suite.mockWordPeer = mocks.NewMockWordPeer(suite.T())
 	suite.mockWordDefinitionPeer = mocks.NewMockWordDefinitionsPeer(suite.T())
 	suite.mockWordPracticeLogPeer = mocks.NewMockWordPracticeLogPeer(suite.T())
-	suite.controller = New(suite.mockWordPeer, suite.mockWordDefinitionPeer, suite.mockWordPracticeLogPeer)
+	suite.mockEntityLinkPeer = mocks.NewMockEntityLinkPeer(suite.T())
+	suite.mockQuestionPeer = mocks.NewMockQuestionPeer(suite.T())
+	suite.mockNotePeer = mocks.NewMockNotePeer(suite.T())
+	linkResolver := links.NewLinkResolver(suite.mockEntityLinkPeer, suite.mockWordPeer, suite.mockQuestionPeer, suite.mockNotePeer)
+	suite.controller = New(suite.mockWordPeer, suite.mockWordDefinitionPeer, suite.mockWordPracticeLogPeer, nil, linkResolver, nil, nil)
 }
 
 // createGinContext creates a gin context with request body for testing
@@ -17,6 +17,14 @@ type ControllerInterface interface {
 	DeleteWordDefinition(c *gin.Context)
 	CountWords(c *gin.Context)
 	StatsWords(c *gin.Context)
+	ListDuplicateWords(c *gin.Context)
+	MergeWords(c *gin.Context)
 	GetWordLogs(c *gin.Context)
 	GetWordsTrend(c *gin.Context)
+	GetWordsRetention(c *gin.Context)
+	GetWordsSpeed(c *gin.Context)
+	RandomSpellingPrompts(c *gin.Context)
+	AnswerSpelling(c *gin.Context)
+	RandomClozePrompts(c *gin.Context)
+	AnswerCloze(c *gin.Context)
 }
@@ -1,11 +1,16 @@
 package word
 
 import (
+	"log/slog"
+	"net/http"
 	"time"
 	dbModels "word-flashcard/data/models"
 	"word-flashcard/data/schema"
 	"word-flashcard/internal/controllers/common"
 	"word-flashcard/internal/models"
+
+	"github.com/Masterminds/squirrel"
+	"github.com/gin-gonic/gin"
 )
 
 // toWordPracticeLogEntries converts data-layer practice log rows to the API response shape.
@@ -22,6 +27,9 @@ func (wc *Controller) toWordPracticeLogEntries(logs []*dbModels.WordPracticeLog)
 		if l.PreviousFamiliarity != nil {
 			e.PreviousFamiliarity = *l.PreviousFamiliarity
 		}
+		e.Mode = l.Mode
+		e.TypedAnswer = l.TypedAnswer
+		e.IsCorrect = l.IsCorrect
 		if l.CreatedAt != nil {
 			e.CreatedAt = *l.CreatedAt
 		}
@@ -30,28 +38,12 @@ func (wc *Controller) toWordPracticeLogEntries(logs []*dbModels.WordPracticeLog)
 	return entries
 }
 
-// familiarityLevel maps a familiarity string to an ordinal 0/1/2 (red/yellow/green)
-// so daily trends can compute improvement and averages numerically. Unrecognized
-// or nil values default to red (0).
-func familiarityLevel(f *string) int {
-	if f == nil {
-		return 0
-	}
-	switch *f {
-	case schema.WORD_FAMILIARITY_YELLOW:
-		return 1
-	case schema.WORD_FAMILIARITY_GREEN:
-		return 2
-	default:
-		return 0
-	}
-}
-
-// buildWordTrendPoints aggregates practice logs into zero-filled daily trend
-// points. improvement_rate is the share of that day's log entries where
-// familiarity increased relative to previous_familiarity; avg_familiarity_score
-// is the day's mean familiarity level (0/1/2) rescaled to 0-100.
-func (wc *Controller) buildWordTrendPoints(logs []*dbModels.WordPracticeLog, days int, now time.Time) []models.WordTrendPoint {
+// buildWordTrendPoints turns per-day practice log stats into zero-filled
+// daily trend points. improvement_rate is the share of that day's log entries
+// where familiarity increased relative to previous_familiarity;
+// avg_familiarity_score is the day's mean familiarity level (0/1/2) rescaled
+// to 0-100.
+func (wc *Controller) buildWordTrendPoints(stats []*dbModels.WordPracticeLogDailyStats, days int, now time.Time) []models.WordTrendPoint {
 	dateKeys := common.DailyDateKeys(days, now)
 	points := make([]models.WordTrendPoint, len(dateKeys))
 	idx := make(map[string]int, len(dateKeys))
@@ -60,37 +52,134 @@ func (wc *Controller) buildWordTrendPoints(logs []*dbModels.WordPracticeLog, day
 		idx[k] = i
 	}
 
-	type dayAgg struct {
-		count, improved, scoreSum int
-	}
-	aggs := make(map[string]*dayAgg)
-	for _, l := range logs {
-		if l.CreatedAt == nil {
+	for _, s := range stats {
+		if s.Day == nil || s.PracticeCount == nil || *s.PracticeCount == 0 {
 			continue
 		}
-		key := common.ReportDateKey(*l.CreatedAt)
-		if _, ok := idx[key]; !ok {
+		i, ok := idx[*s.Day]
+		if !ok {
 			continue
 		}
-		a := aggs[key]
-		if a == nil {
-			a = &dayAgg{}
-			aggs[key] = a
+		points[i].PracticeCount = *s.PracticeCount
+		if s.ImprovedCount != nil {
+			points[i].ImprovementRate = common.Round1(float64(*s.ImprovedCount) * 100 / float64(*s.PracticeCount))
 		}
-		a.count++
-		cur, prev := familiarityLevel(l.Familiarity), familiarityLevel(l.PreviousFamiliarity)
-		if cur > prev {
-			a.improved++
+		if s.AvgFamiliarityLevel != nil {
+			points[i].AvgFamiliarityScore = common.Round1(*s.AvgFamiliarityLevel / 2 * 100)
 		}
-		a.scoreSum += cur
 	}
-	for key, a := range aggs {
-		i := idx[key]
-		points[i].PracticeCount = a.count
-		if a.count > 0 {
-			points[i].ImprovementRate = common.Round1(float64(a.improved) * 100 / float64(a.count))
-			points[i].AvgFamiliarityScore = common.Round1(float64(a.scoreSum) / float64(a.count) / 2 * 100)
+	return points
+}
+
+// recordGradedPractice records a server-graded answer (spelling, cloze, ...)
+// for word. It follows the same session rules as UpdateWord: the first answer
+// in a quiz session increments count_practise/last_practiced_at and appends a
+// log row, while a resubmission for the same (word, session, mode) corrects
+// the existing row in place. A different mode in the same session, such as a
+// cloze after a spelling prompt, is a separate practice. Familiarity is left
+// untouched, so the log row's familiarity and previous_familiarity both hold
+// its current value.
+//
+// Only the word update can fail the call; the log write is best-effort for
+// the same reason as in UpdateWord.
+func (wc *Controller) recordGradedPractice(word *dbModels.Word, mode, typedAnswer string, isCorrect bool, quizSessionID *string) error {
+	wordID := *word.Id
+
+	if quizSessionID != nil {
+		logWhere := squirrel.Eq{
+			schema.WORD_PRACTICE_LOG_WORD_ID:         wordID,
+			schema.WORD_PRACTICE_LOG_QUIZ_SESSION_ID: *quizSessionID,
+			schema.WORD_PRACTICE_LOG_MODE:            mode,
+		}
+		existingLogs, err := wc.wordPracticeLogPeer.Select([]*string{}, logWhere, nil, nil, nil)
+		if err != nil {
+			return err
+		}
+		if len(existingLogs) > 0 {
+			correction := &dbModels.WordPracticeLog{TypedAnswer: &typedAnswer, IsCorrect: &isCorrect}
+			where := squirrel.Eq{schema.WORD_PRACTICE_LOG_ID: *existingLogs[0].Id}
+			if _, err := wc.wordPracticeLogPeer.Update(correction, where); err != nil {
+				slog.Error("Failed to update word practice log", "word_id", wordID, "quiz_session_id", *quizSessionID, "mode", mode, "error", err)
+			}
+			return nil
 		}
 	}
-	return points
+
+	currentCount := 0
+	if word.CountPractise != nil {
+		currentCount = *word.CountPractise
+	}
+	newCount := currentCount + 1
+	now := time.Now().UTC()
+	update := &dbModels.Word{CountPractise: &newCount, LastPracticedAt: &now}
+	if _, err := wc.wordPeer.Update(update, squirrel.Eq{schema.WORD_ID: wordID}); err != nil {
+		return err
+	}
+
+	practiceLog := &dbModels.WordPracticeLog{
+		WordId:              &wordID,
+		Familiarity:         word.Familiarity,
+		PreviousFamiliarity: word.Familiarity,
+		QuizSessionID:       quizSessionID,
+		Mode:                &mode,
+		TypedAnswer:         &typedAnswer,
+		IsCorrect:           &isCorrect,
+	}
+	if _, err := wc.wordPracticeLogPeer.Insert(practiceLog); err != nil {
+		slog.Error("Failed to log word practice", "word_id", wordID, "mode", mode, "error", err)
+	}
+	return nil
+}
+
+// answerTypedPrompt grades a models.TypedAnswerRequest for the word in the
+// path against the spellings accepted(word) returns, records the attempt
+// under mode, and responds with a models.TypedAnswerResult. It backs every
+// typed-answer quiz mode, which differ only in what they accept.
+func (wc *Controller) answerTypedPrompt(c *gin.Context, mode string, accepted func(word string) []string) {
+	// ================ 1. Parse request parameter & body ================
+	wordID, err := common.ParseIDFromPath(c, "id")
+	if err != nil {
+		common.ResponseError(http.StatusBadRequest, "Invalid word ID.", models.ErrCodeInvalidRequest, err, c)
+		return
+	}
+
+	var answerReq models.TypedAnswerRequest
+	if err := common.ParseRequestBody(&answerReq, c); err != nil {
+		common.RespondInvalidBody(err, c)
+		return
+	}
+	if err := validateTypedAnswerFields(answerReq.Answer, answerReq.QuizSessionID); err != nil {
+		common.RespondInvalidBody(common.NewValidationError(err), c)
+		return
+	}
+
+	// ================ 2. Fetch the word ================
+	words, err := wc.wordPeer.Select([]*string{}, squirrel.Eq{schema.WORD_ID: wordID}, nil, nil, nil)
+	if err != nil {
+		common.ResponseError(http.StatusInternalServerError, "Failed to fetch data from database", models.ErrCodeInternalError, err, c)
+		return
+	} else if len(words) == 0 || words[0].Word == nil {
+		common.ResponseError(http.StatusNotFound, "Word not found", models.ErrCodeNotFound, nil, c)
+		return
+	}
+	word := words[0]
+
+	// ================ 3. Grade the answer ================
+	grade := common.GradeTypedAnswer(answerReq.Answer, accepted(*word.Word))
+
+	// ================ 4. Record the attempt ================
+	if err := wc.recordGradedPractice(word, mode, answerReq.Answer, grade.IsCorrect(), answerReq.QuizSessionID); err != nil {
+		common.ResponseError(http.StatusInternalServerError, "Failed to record practice in database", models.ErrCodeInternalError, err, c)
+		return
+	}
+
+	// ================ 5. Send response ================
+	common.ResponseSuccess(http.StatusOK, models.TypedAnswerResult{
+		WordID:    wordID,
+		Word:      *word.Word,
+		Answer:    answerReq.Answer,
+		Result:    grade.Result,
+		IsCorrect: grade.IsCorrect(),
+		Distance:  grade.Distance,
+	}, c)
 }
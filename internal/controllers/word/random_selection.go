@@ -3,8 +3,10 @@ package word
 import (
 	"fmt"
 	"log/slog"
+	"maps"
 	"math/rand"
 	"slices"
+	"time"
 	dbModels "word-flashcard/data/models"
 	"word-flashcard/data/schema"
 	"word-flashcard/internal/controllers/common"
@@ -78,18 +80,23 @@ func computeLevelQuotas(totalCount int, levels []string) map[string]int {
 	return quotas
 }
 
-// fetchWordsBucketWeighted retrieves up to quota words for a single familiarity
-// level, prioritizing words that have never been practiced, then words practiced
-// longest ago. These two groups exhaustively partition the level (every word is
-// either never-practiced or has a last_practiced_at), so no further same-level
-// fallback is needed here — any unmet quota is a genuine shortage of words in
-// this level and is left for the caller to cascade into another level.
-func (wc *Controller) fetchWordsBucketWeighted(level string, quota int) ([]*dbModels.Word, error) {
+// fetchWordsBucketWeighted retrieves up to quota words for a single
+// familiarity level, optionally narrowed by scope (see cefrLevelScope) and
+// skipping suspended words, prioritizing words that have never been
+// practiced, then words practiced longest ago. These two groups exhaustively
+// partition the level (every word is either never-practiced or has a
+// last_practiced_at), so no further same-level fallback is needed here — any
+// unmet quota is a genuine shortage of words in this level and is left for
+// the caller to cascade into another level.
+func (wc *Controller) fetchWordsBucketWeighted(level string, quota int, scope squirrel.Sqlizer) ([]*dbModels.Word, error) {
 	if quota <= 0 {
 		return []*dbModels.Word{}, nil
 	}
 
-	levelWhere := squirrel.Eq{schema.WORD_FAMILIARITY: level}
+	var levelWhere squirrel.Sqlizer = squirrel.Eq{schema.WORD_FAMILIARITY: level, schema.WORD_IS_SUSPENDED: false}
+	if scope != nil {
+		levelWhere = squirrel.And{levelWhere, scope}
+	}
 	randomOrderBy := database.TERM_MAPPING_FUNC_RANDOM
 	limit := uint64(quota)
 
@@ -129,7 +136,8 @@ func (wc *Controller) fetchWordsBucketWeighted(level string, quota int) ([]*dbMo
 // fetchWordsBucketWeighted, cascading any quota shortfall (per familiarityCascadeOrder)
 // from lower-priority levels up into higher-priority ones, then shuffles the
 // combined result so words aren't grouped by level or practice recency.
-func (wc *Controller) fetchRandomWordsWeighted(quotasByLevel map[string]int) ([]*dbModels.Word, error) {
+// A non-nil scope narrows every level.
+func (wc *Controller) fetchRandomWordsWeighted(quotasByLevel map[string]int, scope squirrel.Sqlizer) ([]*dbModels.Word, error) {
 	requested := 0
 	for _, quota := range quotasByLevel {
 		requested += quota
@@ -146,7 +154,7 @@ func (wc *Controller) fetchRandomWordsWeighted(quotasByLevel map[string]int) ([]
 	carry := 0
 	for _, level := range active {
 		quota := quotasByLevel[level] + carry
-		words, err := wc.fetchWordsBucketWeighted(level, quota)
+		words, err := wc.fetchWordsBucketWeighted(level, quota, scope)
 		if err != nil {
 			return nil, err
 		}
@@ -167,3 +175,121 @@ func (wc *Controller) fetchRandomWordsWeighted(quotasByLevel map[string]int) ([]
 
 	return combined, nil
 }
+
+// randomWordDraw is a parsed random word request: the quota per
+// familiarity level, an optional scope narrowing every level, and whether
+// to favour words answered correctly but slowly
+type randomWordDraw struct {
+	quotas    map[string]int
+	scope     squirrel.Sqlizer
+	favorSlow bool
+}
+
+// drawRandomWords fetches the words of draw with fetchRandomWordsWeighted.
+// With favorSlow, up to common.SlowQuota of the draw is first filled with
+// slow-but-correct words, slowest first, each taking its place out of its
+// level's quota; the rest are drawn as usual from the other words.
+func (wc *Controller) drawRandomWords(draw randomWordDraw) ([]*dbModels.Word, error) {
+	if !draw.favorSlow {
+		return wc.fetchRandomWordsWeighted(draw.quotas, draw.scope)
+	}
+
+	slow, err := wc.fetchSlowWords(draw)
+	if err != nil {
+		return nil, err
+	}
+	if len(slow) == 0 {
+		return wc.fetchRandomWordsWeighted(draw.quotas, draw.scope)
+	}
+
+	quotas := maps.Clone(draw.quotas)
+	slowIDs := make([]int, len(slow))
+	for i, w := range slow {
+		quotas[*w.Familiarity]--
+		slowIDs[i] = *w.Id
+	}
+	var scope squirrel.Sqlizer = squirrel.NotEq{schema.WORD_ID: slowIDs}
+	if draw.scope != nil {
+		scope = squirrel.And{draw.scope, scope}
+	}
+	rest, err := wc.fetchRandomWordsWeighted(quotas, scope)
+	if err != nil {
+		return nil, err
+	}
+
+	combined := append(slow, rest...)
+	rand.Shuffle(len(combined), func(i, j int) {
+		combined[i], combined[j] = combined[j], combined[i]
+	})
+	return combined, nil
+}
+
+// fetchSlowWords returns the slow-but-correct words that fit draw, slowest
+// first: unsuspended, inside its scope, at a level with quota left, and at
+// most common.SlowQuota of the whole draw
+func (wc *Controller) fetchSlowWords(draw randomWordDraw) ([]*dbModels.Word, error) {
+	requested := 0
+	var levels []string
+	for level, quota := range draw.quotas {
+		if quota > 0 {
+			requested += quota
+			levels = append(levels, level)
+		}
+	}
+	slices.Sort(levels)
+	limit := common.SlowQuota(requested)
+	if limit == 0 {
+		return nil, nil
+	}
+
+	answers, err := wc.fetchTimedWordAnswers(common.DefaultSpeedDays, time.Now().UTC())
+	if err != nil {
+		return nil, err
+	}
+	slowIDs := common.SlowItemIDs(answers)
+	if len(slowIDs) == 0 {
+		return nil, nil
+	}
+
+	var where squirrel.Sqlizer = squirrel.Eq{schema.WORD_ID: slowIDs, schema.WORD_FAMILIARITY: levels, schema.WORD_IS_SUSPENDED: false}
+	if draw.scope != nil {
+		where = squirrel.And{where, draw.scope}
+	}
+	words, err := wc.wordPeer.Select([]*string{}, where, nil, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	byID := make(map[int]*dbModels.Word, len(words))
+	for _, w := range words {
+		if w.Id != nil && w.Familiarity != nil {
+			byID[*w.Id] = w
+		}
+	}
+	left := maps.Clone(draw.quotas)
+	var slow []*dbModels.Word
+	for _, id := range slowIDs {
+		w, ok := byID[id]
+		if !ok || left[*w.Familiarity] <= 0 {
+			continue
+		}
+		left[*w.Familiarity]--
+		slow = append(slow, w)
+		if len(slow) == limit {
+			break
+		}
+	}
+	return slow, nil
+}
+
+// cefrLevelScope restricts words to those with at least one definition at
+// one of levels, or returns nil when levels is empty
+func cefrLevelScope(levels []string) squirrel.Sqlizer {
+	if len(levels) == 0 {
+		return nil
+	}
+	definitionsAtLevel := squirrel.Select(schema.WORD_DEFINITIONS_WORD_ID).
+		From(schema.WORD_DEFINITIONS_TABLE_NAME).
+		Where(squirrel.Eq{schema.WORD_DEFINITIONS_CEFR_LEVEL: levels})
+	return squirrel.Expr(fmt.Sprintf("%s IN (?)", schema.WORD_ID), definitionsAtLevel)
+}
@@ -4,6 +4,7 @@ import (
 	"fmt"
 	dbModels "word-flashcard/data/models"
 	"word-flashcard/data/schema"
+	"word-flashcard/utils"
 	"word-flashcard/utils/database"
 
 	"github.com/Masterminds/squirrel"
@@ -71,7 +72,7 @@ func (suite *HelperTestSuite) TestComputeLevelQuotas() {
 // then least-recently-practiced) selection logic
 func (suite *HelperTestSuite) TestFetchWordsBucketWeighted() {
 	suite.Run("non-positive quota returns empty without querying", func() {
-		result, err := suite.controller.fetchWordsBucketWeighted(schema.WORD_FAMILIARITY_RED, 0)
+		result, err := suite.controller.fetchWordsBucketWeighted(schema.WORD_FAMILIARITY_RED, 0, nil)
 		suite.NoError(err)
 		suite.Empty(result)
 	})
@@ -81,7 +82,7 @@ func (suite *HelperTestSuite) TestFetchWordsBucketWeighted() {
 		fam := schema.WORD_FAMILIARITY_RED
 		neverPracticed := []*dbModels.Word{{Id: &id1, Familiarity: &fam}, {Id: &id2, Familiarity: &fam}}
 
-		levelWhere := squirrel.Eq{schema.WORD_FAMILIARITY: schema.WORD_FAMILIARITY_RED}
+		levelWhere := squirrel.Eq{schema.WORD_FAMILIARITY: schema.WORD_FAMILIARITY_RED, schema.WORD_IS_SUSPENDED: false}
 		neverPracticedWhere := squirrel.And{
 			levelWhere,
 			squirrel.Or{
@@ -98,7 +99,7 @@ func (suite *HelperTestSuite) TestFetchWordsBucketWeighted() {
 			Select(mock.Anything, neverPracticedWhere, randomOrderMatcher, &limit, (*uint64)(nil)).
 			Return(neverPracticed, nil).Once()
 
-		result, err := suite.controller.fetchWordsBucketWeighted(schema.WORD_FAMILIARITY_RED, 2)
+		result, err := suite.controller.fetchWordsBucketWeighted(schema.WORD_FAMILIARITY_RED, 2, nil)
 
 		suite.NoError(err)
 		suite.ElementsMatch(neverPracticed, result)
@@ -112,7 +113,7 @@ func (suite *HelperTestSuite) TestFetchWordsBucketWeighted() {
 		id2, id3 := 21, 22
 		leastRecent := []*dbModels.Word{{Id: &id2, Familiarity: &fam}, {Id: &id3, Familiarity: &fam}}
 
-		levelWhere := squirrel.Eq{schema.WORD_FAMILIARITY: schema.WORD_FAMILIARITY_YELLOW}
+		levelWhere := squirrel.Eq{schema.WORD_FAMILIARITY: schema.WORD_FAMILIARITY_YELLOW, schema.WORD_IS_SUSPENDED: false}
 		neverPracticedWhere := squirrel.And{
 			levelWhere,
 			squirrel.Or{
@@ -142,7 +143,7 @@ func (suite *HelperTestSuite) TestFetchWordsBucketWeighted() {
 			Select(mock.Anything, leastRecentWhere, oldestFirstMatcher, &remainingLimit, (*uint64)(nil)).
 			Return(leastRecent, nil).Once()
 
-		result, err := suite.controller.fetchWordsBucketWeighted(schema.WORD_FAMILIARITY_YELLOW, 3)
+		result, err := suite.controller.fetchWordsBucketWeighted(schema.WORD_FAMILIARITY_YELLOW, 3, nil)
 
 		suite.NoError(err)
 		suite.ElementsMatch(append(neverPracticed, leastRecent...), result)
@@ -164,7 +165,7 @@ func (suite *HelperTestSuite) TestFetchRandomWordsWeighted() {
 			{Id: &yellowID3, Familiarity: &yellowFam},
 		} // fills its own quota (2) plus green's carried shortfall (1)
 
-		greenWhere := squirrel.Eq{schema.WORD_FAMILIARITY: schema.WORD_FAMILIARITY_GREEN}
+		greenWhere := squirrel.Eq{schema.WORD_FAMILIARITY: schema.WORD_FAMILIARITY_GREEN, schema.WORD_IS_SUSPENDED: false}
 		greenNeverPracticedWhere := squirrel.And{
 			greenWhere,
 			squirrel.Or{
@@ -177,7 +178,7 @@ func (suite *HelperTestSuite) TestFetchRandomWordsWeighted() {
 			squirrel.Gt{schema.WORD_COUNT_PRACTISE: 0},
 			squirrel.NotEq{schema.WORD_LAST_PRACTISED_AT: nil},
 		}
-		yellowWhere := squirrel.Eq{schema.WORD_FAMILIARITY: schema.WORD_FAMILIARITY_YELLOW}
+		yellowWhere := squirrel.Eq{schema.WORD_FAMILIARITY: schema.WORD_FAMILIARITY_YELLOW, schema.WORD_IS_SUSPENDED: false}
 		yellowNeverPracticedWhere := squirrel.And{
 			yellowWhere,
 			squirrel.Or{
@@ -209,7 +210,7 @@ func (suite *HelperTestSuite) TestFetchRandomWordsWeighted() {
 		result, err := suite.controller.fetchRandomWordsWeighted(map[string]int{
 			schema.WORD_FAMILIARITY_GREEN:  2,
 			schema.WORD_FAMILIARITY_YELLOW: 2,
-		})
+		}, nil)
 
 		suite.NoError(err)
 		suite.ElementsMatch(append(green, yellow...), result)
@@ -220,7 +221,7 @@ func (suite *HelperTestSuite) TestFetchRandomWordsWeighted() {
 		yellowFam := schema.WORD_FAMILIARITY_YELLOW
 		yellow := []*dbModels.Word{{Id: &yellowID, Familiarity: &yellowFam}}
 
-		yellowWhere := squirrel.Eq{schema.WORD_FAMILIARITY: schema.WORD_FAMILIARITY_YELLOW}
+		yellowWhere := squirrel.Eq{schema.WORD_FAMILIARITY: schema.WORD_FAMILIARITY_YELLOW, schema.WORD_IS_SUSPENDED: false}
 		yellowNeverPracticedWhere := squirrel.And{
 			yellowWhere,
 			squirrel.Or{
@@ -240,9 +241,58 @@ func (suite *HelperTestSuite) TestFetchRandomWordsWeighted() {
 		result, err := suite.controller.fetchRandomWordsWeighted(map[string]int{
 			schema.WORD_FAMILIARITY_RED:    0,
 			schema.WORD_FAMILIARITY_YELLOW: 1,
-		})
+		}, nil)
 
 		suite.NoError(err)
 		suite.ElementsMatch(yellow, result)
 	})
 }
+
+// TestDrawRandomWordsFavorSlow tests that favor_slow reserves part of the
+// draw for slow-but-correct words and draws the rest from the other words
+func (suite *ControllerTestSuite) TestDrawRandomWordsFavorSlow() {
+	red := schema.WORD_FAMILIARITY_RED
+	slowWord := &dbModels.Word{Id: utils.IntPtr(7), Familiarity: &red}
+	others := []*dbModels.Word{
+		{Id: utils.IntPtr(9), Familiarity: &red},
+		{Id: utils.IntPtr(10), Familiarity: &red},
+	}
+
+	// Word 7's median correct answer (5500ms) reaches the 75th percentile of
+	// all correct answers (5250ms); word 8 is quick
+	suite.mockWordPracticeLogPeer.EXPECT().
+		Select(mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+		Return([]*dbModels.WordPracticeLog{
+			{WordId: utils.IntPtr(7), IsCorrect: utils.BoolPtr(true), ResponseMs: utils.IntPtr(5000)},
+			{WordId: utils.IntPtr(7), IsCorrect: utils.BoolPtr(true), ResponseMs: utils.IntPtr(6000)},
+			{WordId: utils.IntPtr(8), IsCorrect: utils.BoolPtr(true), ResponseMs: utils.IntPtr(1000)},
+			{WordId: utils.IntPtr(8), IsCorrect: utils.BoolPtr(true), ResponseMs: utils.IntPtr(1200)},
+		}, nil).Once()
+	suite.mockWordPeer.EXPECT().
+		Select(mock.Anything, squirrel.Eq{schema.WORD_ID: []int{7}, schema.WORD_FAMILIARITY: []string{red}, schema.WORD_IS_SUSPENDED: false}, mock.Anything, mock.Anything, mock.Anything).
+		Return([]*dbModels.Word{slowWord}, nil).Once()
+
+	// The remaining quota of 2 excludes the slow word already drawn
+	restWhere := squirrel.And{
+		squirrel.And{
+			squirrel.Eq{schema.WORD_FAMILIARITY: red, schema.WORD_IS_SUSPENDED: false},
+			squirrel.NotEq{schema.WORD_ID: []int{7}},
+		},
+		squirrel.Or{
+			squirrel.Eq{schema.WORD_COUNT_PRACTISE: 0},
+			squirrel.Eq{schema.WORD_LAST_PRACTISED_AT: nil},
+		},
+	}
+	restLimit := uint64(2)
+	suite.mockWordPeer.EXPECT().
+		Select(mock.Anything, restWhere, mock.Anything, &restLimit, (*uint64)(nil)).
+		Return(others, nil).Once()
+
+	result, err := suite.controller.drawRandomWords(randomWordDraw{
+		quotas:    map[string]int{red: 3},
+		favorSlow: true,
+	})
+
+	suite.NoError(err)
+	suite.ElementsMatch(append([]*dbModels.Word{slowWord}, others...), result)
+}
@@ -0,0 +1,344 @@
+package word
+
+import (
+	"fmt"
+	"math"
+	"sort"
+	"time"
+
+	dbModels "word-flashcard/data/models"
+	"word-flashcard/data/schema"
+	"word-flashcard/internal/controllers/common"
+	"word-flashcard/internal/models"
+
+	"github.com/Masterminds/squirrel"
+)
+
+const (
+	// minCurveReviews is the fewest reviews a forgetting curve is fitted to;
+	// below it the curve falls back to a coarser one
+	minCurveReviews = 10
+
+	// minReviewIntervalDays floors review intervals at one minute, so a
+	// word answered twice in a row doesn't claim recall after no time at all
+	minReviewIntervalDays = 1.0 / (24 * 60)
+
+	// minStabilityDays and maxStabilityDays bound the fitted stability, which
+	// otherwise runs off to 0 when every review failed or to infinity when
+	// every review succeeded
+	minStabilityDays = 0.01
+	maxStabilityDays = 3650
+
+	// retentionCurveAll names the curve fitted to every review
+	retentionCurveAll = "all"
+)
+
+// familiarityLevels lists the familiarity levels from least to most familiar
+var familiarityLevels = []string{schema.WORD_FAMILIARITY_RED, schema.WORD_FAMILIARITY_YELLOW, schema.WORD_FAMILIARITY_GREEN}
+
+// defaultStabilityDays is the stability assumed for a familiarity level
+// until there are enough reviews to fit one, or fit the overall curve
+var defaultStabilityDays = map[string]float64{
+	retentionCurveAll:              3,
+	schema.WORD_FAMILIARITY_RED:    1,
+	schema.WORD_FAMILIARITY_YELLOW: 3,
+	schema.WORD_FAMILIARITY_GREEN:  7,
+}
+
+// retentionIntervalBounds are the lower bounds, in days, of the intervals
+// observed retention is grouped into; the last interval is open-ended
+var retentionIntervalBounds = []float64{0, 1, 2, 4, 7, 14, 30, 60}
+
+// retentionLogColumns returns the word_practice_logs columns needed to pair
+// reviews up and grade them
+func retentionLogColumns() []*string {
+	columns := []string{
+		schema.WORD_PRACTICE_LOG_WORD_ID,
+		schema.WORD_PRACTICE_LOG_FAMILIARITY,
+		schema.WORD_PRACTICE_LOG_PREVIOUS_FAMILIARITY,
+		schema.WORD_PRACTICE_LOG_IS_CORRECT,
+		schema.COMMON_CREATED_AT,
+	}
+	ptrs := make([]*string, len(columns))
+	for i := range columns {
+		ptrs[i] = &columns[i]
+	}
+	return ptrs
+}
+
+// retentionLogWhere selects the logs created at or after since, plus each
+// word's last log before since: a review's interval reaches back to the
+// word's previous log, however old it is, but nothing older is needed.
+func retentionLogWhere(since time.Time) squirrel.Sqlizer {
+	previousLog := fmt.Sprintf(
+		"%[2]s = (SELECT MAX(p.%[2]s) FROM %[1]s p WHERE p.%[3]s = %[1]s.%[3]s AND p.%[2]s < ?)",
+		schema.WORD_PRACTICE_LOG_TABLE_NAME, schema.COMMON_CREATED_AT, schema.WORD_PRACTICE_LOG_WORD_ID,
+	)
+	return squirrel.Or{
+		squirrel.GtOrEq{schema.COMMON_CREATED_AT: since},
+		squirrel.Expr(previousLog, since),
+	}
+}
+
+// retentionWordColumns returns the words columns needed to estimate recall
+func retentionWordColumns() []*string {
+	columns := []string{schema.WORD_ID, schema.WORD_WORD, schema.WORD_FAMILIARITY, schema.WORD_LAST_PRACTISED_AT}
+	ptrs := make([]*string, len(columns))
+	for i := range columns {
+		ptrs[i] = &columns[i]
+	}
+	return ptrs
+}
+
+// retentionReview is one review of a word: how long after the previous
+// review it happened, the word's familiarity going into it, and whether the
+// word was recalled
+type retentionReview struct {
+	intervalDays float64
+	familiarity  string
+	recalled     bool
+}
+
+// familiarityLevel returns the ordinal 0/1/2 of a red/yellow/green
+// familiarity, treating unrecognized values as red
+func familiarityLevel(familiarity string) int {
+	for level, name := range familiarityLevels {
+		if name == familiarity {
+			return level
+		}
+	}
+	return 0
+}
+
+// reviewRecalled reports whether a practice log records a successful
+// recall: a graded answer that was correct, or a self-report that kept or
+// raised familiarity without landing on red
+func reviewRecalled(log *dbModels.WordPracticeLog) bool {
+	if log.IsCorrect != nil {
+		return *log.IsCorrect
+	}
+	if log.Familiarity == nil || log.PreviousFamiliarity == nil || *log.Familiarity == schema.WORD_FAMILIARITY_RED {
+		return false
+	}
+	return familiarityLevel(*log.Familiarity) >= familiarityLevel(*log.PreviousFamiliarity)
+}
+
+// buildRetentionReviews pairs every practice log created at or after since
+// with the same word's previous log, which may be older than since. logs
+// must be ordered by word_id, then created_at; a word's first log has no
+// interval and is not a review.
+func buildRetentionReviews(logs []*dbModels.WordPracticeLog, since time.Time) []retentionReview {
+	var reviews []retentionReview
+	var previous *dbModels.WordPracticeLog
+	for _, log := range logs {
+		if log.WordId == nil || log.CreatedAt == nil {
+			continue
+		}
+		if previous != nil && *previous.WordId == *log.WordId && !log.CreatedAt.Before(since) {
+			familiarity := schema.WORD_FAMILIARITY_RED
+			if log.PreviousFamiliarity != nil {
+				familiarity = *log.PreviousFamiliarity
+			}
+			reviews = append(reviews, retentionReview{
+				intervalDays: math.Max(log.CreatedAt.Sub(*previous.CreatedAt).Hours()/24, minReviewIntervalDays),
+				familiarity:  familiarity,
+				recalled:     reviewRecalled(log),
+			})
+		}
+		previous = log
+	}
+	return reviews
+}
+
+// recallProbability is the exponential forgetting curve e^(-t/S)
+func recallProbability(intervalDays, stabilityDays float64) float64 {
+	return math.Exp(-intervalDays / stabilityDays)
+}
+
+// retentionLogLikelihood is the log-likelihood of the observed reviews
+// under a forgetting curve with the given stability
+func retentionLogLikelihood(reviews []retentionReview, stabilityDays float64) float64 {
+	sum := 0.0
+	for _, r := range reviews {
+		if r.recalled {
+			sum -= r.intervalDays / stabilityDays
+		} else {
+			sum += math.Log1p(-recallProbability(r.intervalDays, stabilityDays))
+		}
+	}
+	return sum
+}
+
+// fitStability finds the maximum-likelihood stability of an exponential
+// forgetting curve for reviews, or reports false when there are fewer than
+// minCurveReviews of them. The log-likelihood is concave in 1/S, so a golden
+// section search over ln S, within [minStabilityDays, maxStabilityDays],
+// finds its single peak.
+func fitStability(reviews []retentionReview) (float64, bool) {
+	if len(reviews) < minCurveReviews {
+		return 0, false
+	}
+
+	ratio := (math.Sqrt(5) - 1) / 2
+	lo, hi := math.Log(minStabilityDays), math.Log(maxStabilityDays)
+	for i := 0; i < 100 && hi-lo > 1e-6; i++ {
+		a := hi - ratio*(hi-lo)
+		b := lo + ratio*(hi-lo)
+		if retentionLogLikelihood(reviews, math.Exp(a)) < retentionLogLikelihood(reviews, math.Exp(b)) {
+			lo = a
+		} else {
+			hi = b
+		}
+	}
+	return math.Exp((lo + hi) / 2), true
+}
+
+// buildForgettingCurves fits the overall curve and one per familiarity
+// level going into the review. It also returns each curve's stability by
+// name, for estimating recall.
+func buildForgettingCurves(reviews []retentionReview) ([]models.ForgettingCurve, map[string]float64) {
+	byFamiliarity := make(map[string][]retentionReview, len(familiarityLevels))
+	for _, r := range reviews {
+		byFamiliarity[r.familiarity] = append(byFamiliarity[r.familiarity], r)
+	}
+
+	stabilities := make(map[string]float64, len(familiarityLevels)+1)
+	overall, fitted := fitStability(reviews)
+	if !fitted {
+		overall = defaultStabilityDays[retentionCurveAll]
+	}
+	stabilities[retentionCurveAll] = overall
+	curves := []models.ForgettingCurve{newForgettingCurve(retentionCurveAll, overall, len(reviews), fitted)}
+
+	for _, familiarity := range familiarityLevels {
+		levelReviews := byFamiliarity[familiarity]
+		stability, levelFitted := fitStability(levelReviews)
+		if !levelFitted {
+			stability = overall
+			if !fitted {
+				stability = defaultStabilityDays[familiarity]
+			}
+		}
+		stabilities[familiarity] = stability
+		curves = append(curves, newForgettingCurve(familiarity, stability, len(levelReviews), levelFitted))
+	}
+	return curves, stabilities
+}
+
+// newForgettingCurve builds the API model of a curve with the given stability
+func newForgettingCurve(familiarity string, stabilityDays float64, reviewCount int, fitted bool) models.ForgettingCurve {
+	return models.ForgettingCurve{
+		Familiarity:   familiarity,
+		StabilityDays: common.Round1(stabilityDays),
+		HalfLifeDays:  common.Round1(stabilityDays * math.Ln2),
+		ReviewCount:   reviewCount,
+		Fitted:        fitted,
+	}
+}
+
+// buildRetentionIntervals groups reviews by interval since the previous
+// review and compares observed retention with what a curve of the given
+// stability predicts for the same reviews
+func buildRetentionIntervals(reviews []retentionReview, stabilityDays float64) []models.RetentionInterval {
+	intervals := make([]models.RetentionInterval, len(retentionIntervalBounds))
+	predicted := make([]float64, len(retentionIntervalBounds))
+	for i, lower := range retentionIntervalBounds {
+		intervals[i] = models.RetentionInterval{Range: fmt.Sprintf("%g+d", lower), MinDays: lower}
+		if i+1 < len(retentionIntervalBounds) {
+			upper := retentionIntervalBounds[i+1]
+			intervals[i].Range = fmt.Sprintf("%g-%gd", lower, upper)
+			intervals[i].MaxDays = &upper
+		}
+	}
+
+	for _, r := range reviews {
+		i := sort.Search(len(retentionIntervalBounds), func(i int) bool { return retentionIntervalBounds[i] > r.intervalDays }) - 1
+		intervals[i].ReviewCount++
+		if r.recalled {
+			intervals[i].RecalledCount++
+		}
+		predicted[i] += recallProbability(r.intervalDays, stabilityDays)
+	}
+
+	for i := range intervals {
+		if count := intervals[i].ReviewCount; count > 0 {
+			intervals[i].RetentionRate = common.Round1(float64(intervals[i].RecalledCount) * 100 / float64(count))
+			intervals[i].PredictedRetentionRate = common.Round1(predicted[i] * 100 / float64(count))
+		}
+	}
+	return intervals
+}
+
+// buildFamiliarityTransitions lays the transition counts out as one row per
+// familiarity level, least familiar first; unrecognized values are skipped
+func buildFamiliarityTransitions(counts []*dbModels.WordFamiliarityTransitionCount) []models.FamiliarityTransitions {
+	rows := make([]models.FamiliarityTransitions, len(familiarityLevels))
+	index := make(map[string]int, len(familiarityLevels))
+	for i, familiarity := range familiarityLevels {
+		rows[i] = models.FamiliarityTransitions{From: familiarity}
+		index[familiarity] = i
+	}
+
+	for _, c := range counts {
+		if c.PreviousFamiliarity == nil || c.Familiarity == nil || c.TransitionCount == nil {
+			continue
+		}
+		i, ok := index[*c.PreviousFamiliarity]
+		if !ok {
+			continue
+		}
+		switch *c.Familiarity {
+		case schema.WORD_FAMILIARITY_RED:
+			rows[i].Red += *c.TransitionCount
+		case schema.WORD_FAMILIARITY_YELLOW:
+			rows[i].Yellow += *c.TransitionCount
+		case schema.WORD_FAMILIARITY_GREEN:
+			rows[i].Green += *c.TransitionCount
+		default:
+			continue
+		}
+		rows[i].Total += *c.TransitionCount
+	}
+	return rows
+}
+
+// estimateWordRecall estimates each practiced word's recall probability now
+// from its familiarity's curve, and returns the limit least likely to be
+// recalled, ties broken by word ID
+func estimateWordRecall(words []*dbModels.Word, stabilities map[string]float64, now time.Time, limit int) []models.WordRecallEstimate {
+	estimates := make([]models.WordRecallEstimate, 0, len(words))
+	for _, w := range words {
+		if w.Id == nil || w.Word == nil || w.LastPracticedAt == nil {
+			continue
+		}
+		familiarity := schema.WORD_FAMILIARITY_RED
+		if w.Familiarity != nil {
+			familiarity = *w.Familiarity
+		}
+		stability, ok := stabilities[familiarity]
+		if !ok {
+			stability = stabilities[retentionCurveAll]
+		}
+
+		elapsed := math.Max(now.Sub(*w.LastPracticedAt).Hours()/24, 0)
+		estimates = append(estimates, models.WordRecallEstimate{
+			WordID:            *w.Id,
+			Word:              *w.Word,
+			Familiarity:       familiarity,
+			LastPracticedAt:   *w.LastPracticedAt,
+			DaysSinceReview:   common.Round1(elapsed),
+			RecallProbability: common.Round1(recallProbability(elapsed, stability) * 100),
+		})
+	}
+
+	sort.SliceStable(estimates, func(i, j int) bool {
+		if estimates[i].RecallProbability != estimates[j].RecallProbability {
+			return estimates[i].RecallProbability < estimates[j].RecallProbability
+		}
+		return estimates[i].WordID < estimates[j].WordID
+	})
+	if len(estimates) > limit {
+		estimates = estimates[:limit]
+	}
+	return estimates
+}
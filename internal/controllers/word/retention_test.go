@@ -0,0 +1,182 @@
+package word
+
+import (
+	"math"
+	"time"
+
+	dbModels "word-flashcard/data/models"
+	"word-flashcard/data/schema"
+	"word-flashcard/internal/models"
+	"word-flashcard/utils"
+)
+
+// syntheticReviews returns reviews whose recall rate at each interval of
+// 1..20 days follows e^(-t/stability) exactly
+func syntheticReviews(familiarity string, stability float64) []retentionReview {
+	var reviews []retentionReview
+	for t := 1; t <= 20; t++ {
+		recalled := int(math.Round(100 * math.Exp(-float64(t)/stability)))
+		for i := 0; i < 100; i++ {
+			reviews = append(reviews, retentionReview{intervalDays: float64(t), familiarity: familiarity, recalled: i < recalled})
+		}
+	}
+	return reviews
+}
+
+// TestReviewRecalled tests grading of graded answers and self-reports
+func (suite *HelperTestSuite) TestReviewRecalled() {
+	log := func(previous, current string, isCorrect *bool) *dbModels.WordPracticeLog {
+		return &dbModels.WordPracticeLog{PreviousFamiliarity: &previous, Familiarity: &current, IsCorrect: isCorrect}
+	}
+	correct, wrong := true, false
+
+	suite.True(reviewRecalled(log("red", "red", &correct)), "graded answers follow is_correct")
+	suite.False(reviewRecalled(log("green", "green", &wrong)))
+	suite.True(reviewRecalled(log("yellow", "yellow", nil)), "kept familiarity is recalled")
+	suite.True(reviewRecalled(log("red", "green", nil)))
+	suite.False(reviewRecalled(log("green", "yellow", nil)), "lowered familiarity is forgotten")
+	suite.False(reviewRecalled(log("red", "red", nil)), "red is never recalled")
+}
+
+// TestBuildRetentionReviews tests pairing logs with the same word's
+// previous log, including one from before the period
+func (suite *HelperTestSuite) TestBuildRetentionReviews() {
+	since := time.Date(2026, 5, 10, 0, 0, 0, 0, time.UTC)
+	log := func(wordID int, at time.Time, previous, current string) *dbModels.WordPracticeLog {
+		return &dbModels.WordPracticeLog{WordId: &wordID, CreatedAt: &at, PreviousFamiliarity: &previous, Familiarity: &current}
+	}
+	logs := []*dbModels.WordPracticeLog{
+		log(1, since.AddDate(0, 0, -3), "red", "yellow"),
+		log(1, since.Add(12*time.Hour), "yellow", "green"),
+		log(1, since.Add(12*time.Hour), "green", "green"),
+		log(2, since.AddDate(0, 0, 1), "red", "red"),
+	}
+
+	reviews := buildRetentionReviews(logs, since)
+
+	suite.Require().Len(reviews, 2)
+	suite.Equal(retentionReview{intervalDays: 3.5, familiarity: "yellow", recalled: true}, reviews[0])
+	suite.Equal(minReviewIntervalDays, reviews[1].intervalDays, "intervals are floored at one minute")
+	suite.Equal("green", reviews[1].familiarity)
+}
+
+// TestRetentionLogWhere tests that the log query is bounded to the period
+// plus each word's last log before it
+func (suite *HelperTestSuite) TestRetentionLogWhere() {
+	since := time.Date(2026, 5, 10, 0, 0, 0, 0, time.UTC)
+
+	sql, args, err := retentionLogWhere(since).ToSql()
+
+	suite.Require().NoError(err)
+	suite.Equal("(created_at >= ? OR created_at = (SELECT MAX(p.created_at) FROM word_practice_logs p "+
+		"WHERE p.word_id = word_practice_logs.word_id AND p.created_at < ?))", sql)
+	suite.Equal([]interface{}{since, since}, args)
+}
+
+// TestFitStability tests that the fit recovers the stability the reviews
+// were generated from, and declines to fit too few reviews
+func (suite *HelperTestSuite) TestFitStability() {
+	stability, fitted := fitStability(syntheticReviews("yellow", 5))
+	suite.True(fitted)
+	suite.InDelta(5, stability, 0.2)
+
+	allRecalled := []retentionReview{}
+	for i := 0; i < minCurveReviews; i++ {
+		allRecalled = append(allRecalled, retentionReview{intervalDays: 1, recalled: true})
+	}
+	stability, fitted = fitStability(allRecalled)
+	suite.True(fitted)
+	suite.InDelta(maxStabilityDays, stability, 1, "never forgetting runs into the upper bound")
+
+	_, fitted = fitStability(allRecalled[:minCurveReviews-1])
+	suite.False(fitted)
+}
+
+// TestBuildForgettingCurves tests that levels without enough reviews fall
+// back to the overall curve, or to their default when nothing could be fitted
+func (suite *HelperTestSuite) TestBuildForgettingCurves() {
+	curves, stabilities := buildForgettingCurves(syntheticReviews("green", 8))
+
+	suite.Require().Len(curves, 4)
+	suite.Equal("all", curves[0].Familiarity)
+	suite.True(curves[0].Fitted)
+	suite.InDelta(8, curves[0].StabilityDays, 0.3)
+	suite.InDelta(curves[0].StabilityDays*math.Ln2, curves[0].HalfLifeDays, 0.1)
+	suite.Equal("red", curves[1].Familiarity)
+	suite.False(curves[1].Fitted)
+	suite.Equal(stabilities["all"], stabilities["red"])
+	suite.True(curves[3].Fitted)
+
+	curves, stabilities = buildForgettingCurves(nil)
+	suite.False(curves[0].Fitted)
+	suite.Equal(1.0, stabilities["red"])
+	suite.Equal(7.0, stabilities["green"])
+}
+
+// TestBuildRetentionIntervals tests grouping reviews by interval with
+// observed and predicted retention
+func (suite *HelperTestSuite) TestBuildRetentionIntervals() {
+	reviews := []retentionReview{
+		{intervalDays: 0.5, recalled: true},
+		{intervalDays: 0.5, recalled: false},
+		{intervalDays: 100, recalled: false},
+	}
+
+	intervals := buildRetentionIntervals(reviews, 1)
+
+	suite.Require().Len(intervals, len(retentionIntervalBounds))
+	suite.Equal("0-1d", intervals[0].Range)
+	suite.Equal(2, intervals[0].ReviewCount)
+	suite.Equal(50.0, intervals[0].RetentionRate)
+	suite.Equal(60.7, intervals[0].PredictedRetentionRate)
+	suite.Equal(0, intervals[1].ReviewCount)
+	last := intervals[len(intervals)-1]
+	suite.Equal("60+d", last.Range)
+	suite.Nil(last.MaxDays)
+	suite.Equal(1, last.ReviewCount)
+	suite.Equal(0.0, last.RetentionRate)
+}
+
+// TestBuildFamiliarityTransitions tests laying counts out as a matrix
+func (suite *HelperTestSuite) TestBuildFamiliarityTransitions() {
+	count := func(from, to string, n int) *dbModels.WordFamiliarityTransitionCount {
+		return &dbModels.WordFamiliarityTransitionCount{PreviousFamiliarity: &from, Familiarity: &to, TransitionCount: &n}
+	}
+
+	rows := buildFamiliarityTransitions([]*dbModels.WordFamiliarityTransitionCount{
+		count("red", "yellow", 3),
+		count("red", "red", 1),
+		count("green", "red", 2),
+		count("purple", "red", 9),
+	})
+
+	suite.Require().Len(rows, 3)
+	suite.Equal(models.FamiliarityTransitions{From: "red", Red: 1, Yellow: 3, Green: 0, Total: 1 + 3 + 0}, rows[0])
+	suite.Equal(models.FamiliarityTransitions{From: "yellow", Red: 0, Yellow: 0, Green: 0, Total: 0 + 0 + 0}, rows[1])
+	suite.Equal(models.FamiliarityTransitions{From: "green", Red: 2, Yellow: 0, Green: 0, Total: 2 + 0 + 0}, rows[2])
+}
+
+// TestEstimateWordRecall tests that recall decays with time per
+// familiarity's curve and that the least likely words come first
+func (suite *HelperTestSuite) TestEstimateWordRecall() {
+	now := time.Date(2026, 5, 10, 12, 0, 0, 0, time.UTC)
+	word := func(id int, familiarity string, daysAgo float64) *dbModels.Word {
+		at := now.Add(-time.Duration(daysAgo * 24 * float64(time.Hour)))
+		return &dbModels.Word{Id: &id, Word: utils.StrPtr("w"), Familiarity: &familiarity, LastPracticedAt: &at}
+	}
+	stabilities := map[string]float64{"all": 3, schema.WORD_FAMILIARITY_RED: 1, schema.WORD_FAMILIARITY_GREEN: 10}
+
+	estimates := estimateWordRecall([]*dbModels.Word{
+		word(1, "green", 10),
+		word(2, "red", 1),
+		word(3, "green", 0),
+		{Id: utils.IntPtr(4), Word: utils.StrPtr("never practiced")},
+	}, stabilities, now, 2)
+
+	suite.Require().Len(estimates, 2, "word 3 was just practiced and is cut by the limit")
+	suite.Equal(1, estimates[0].WordID, "equally likely words are ordered by ID")
+	suite.Equal(36.8, estimates[0].RecallProbability)
+	suite.Equal(10.0, estimates[0].DaysSinceReview)
+	suite.Equal(2, estimates[1].WordID)
+	suite.Equal(36.8, estimates[1].RecallProbability)
+}
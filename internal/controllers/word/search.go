@@ -27,6 +27,11 @@ func parseSearchConditionsByTable(searchFilter *models.SearchFilter) (*models.Se
 		schema.WORD_DEFINITIONS_PHONETICS:      true,
 		schema.WORD_DEFINITIONS_EXAMPLES:       true,
 		schema.WORD_DEFINITIONS_NOTES:          true,
+		schema.WORD_DEFINITIONS_CEFR_LEVEL:     true,
+		schema.WORD_DEFINITIONS_GRAMMAR:        true,
+		schema.WORD_DEFINITIONS_LABELS:         true,
+		schema.WORD_DEFINITIONS_SYNONYMS:       true,
+		schema.WORD_DEFINITIONS_ANTONYMS:       true,
 	}
 
 	var wordsConditions []models.SearchCondition
@@ -44,6 +44,18 @@ func (suite *HelperTestSuite) TestParseSearchConditionsByTable() {
 			wantDefsFilter:  false,
 			wantErr:         false,
 		},
+		{
+			name: "conditions on cefr level",
+			input: &models.SearchFilter{
+				Conditions: []models.SearchCondition{
+					{Key: schema.WORD_DEFINITIONS_CEFR_LEVEL, Operator: "in", Value: "A1,A2"},
+				},
+				Logic: "AND",
+			},
+			wantWordsFilter: false,
+			wantDefsFilter:  true,
+			wantErr:         false,
+		},
 		{
 			name: "unknown column",
 			input: &models.SearchFilter{
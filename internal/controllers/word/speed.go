@@ -0,0 +1,62 @@
+package word
+
+import (
+	"time"
+	dbModels "word-flashcard/data/models"
+	"word-flashcard/data/schema"
+	"word-flashcard/internal/controllers/common"
+
+	"github.com/Masterminds/squirrel"
+)
+
+// speedLogColumns returns the word_practice_logs columns needed to measure
+// answer times
+func speedLogColumns() []*string {
+	columns := []string{
+		schema.WORD_PRACTICE_LOG_WORD_ID,
+		schema.WORD_PRACTICE_LOG_FAMILIARITY,
+		schema.WORD_PRACTICE_LOG_PREVIOUS_FAMILIARITY,
+		schema.WORD_PRACTICE_LOG_IS_CORRECT,
+		schema.WORD_PRACTICE_LOG_RESPONSE_MS,
+	}
+	ptrs := make([]*string, len(columns))
+	for i := range columns {
+		ptrs[i] = &columns[i]
+	}
+	return ptrs
+}
+
+// timedAnswersSince returns the where clause selecting the timed practice
+// logs created at or after since
+func timedAnswersSince(since time.Time) squirrel.Sqlizer {
+	return squirrel.And{
+		squirrel.NotEq{schema.WORD_PRACTICE_LOG_RESPONSE_MS: nil},
+		squirrel.GtOrEq{schema.COMMON_CREATED_AT: since},
+	}
+}
+
+// timedWordAnswers converts timed practice logs to answers, counting one as
+// correct when the word was recalled, as for retention
+func timedWordAnswers(logs []*dbModels.WordPracticeLog) []common.TimedAnswer {
+	answers := make([]common.TimedAnswer, 0, len(logs))
+	for _, log := range logs {
+		if log.WordId == nil || log.ResponseMs == nil {
+			continue
+		}
+		answers = append(answers, common.TimedAnswer{
+			ItemID:     *log.WordId,
+			ResponseMs: *log.ResponseMs,
+			Correct:    reviewRecalled(log),
+		})
+	}
+	return answers
+}
+
+// fetchTimedWordAnswers returns the timed answers of the last days days
+func (wc *Controller) fetchTimedWordAnswers(days int, now time.Time) ([]common.TimedAnswer, error) {
+	logs, err := wc.wordPracticeLogPeer.Select(speedLogColumns(), timedAnswersSince(now.AddDate(0, 0, -days)), nil, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	return timedWordAnswers(logs), nil
+}
@@ -0,0 +1,57 @@
+package word
+
+import (
+	"encoding/json"
+	"strings"
+	"unicode/utf8"
+	dbModels "word-flashcard/data/models"
+	"word-flashcard/internal/controllers/common"
+	"word-flashcard/internal/models"
+)
+
+// buildSpellingPrompts turns words and their definitions into spelling quiz
+// prompts. Words without a usable definition are skipped, since there would
+// be nothing to show the learner.
+func buildSpellingPrompts(words []*dbModels.Word, defs []*dbModels.WordDefinition) []models.SpellingPrompt {
+	prompts := make([]models.SpellingPrompt, 0, len(words))
+	for _, word := range words {
+		if word.Id == nil || word.Word == nil {
+			continue
+		}
+
+		var promptDefs []models.SpellingPromptDefinition
+		for _, def := range defs {
+			if def.WordId == nil || *def.WordId != *word.Id || def.Definition == nil || strings.TrimSpace(*def.Definition) == "" {
+				continue
+			}
+			promptDef := models.SpellingPromptDefinition{
+				Definition: common.MaskWord(*def.Definition, *word.Word),
+				Examples:   []string{},
+			}
+			if def.PartOfSpeech != nil {
+				promptDef.PartOfSpeech = *def.PartOfSpeech
+			}
+			if def.Examples != nil {
+				var examples []string
+				if err := json.Unmarshal([]byte(*def.Examples), &examples); err == nil {
+					for _, example := range examples {
+						promptDef.Examples = append(promptDef.Examples, common.MaskWord(example, *word.Word))
+					}
+				}
+			}
+			promptDefs = append(promptDefs, promptDef)
+		}
+		if len(promptDefs) == 0 {
+			continue
+		}
+
+		first, _ := utf8.DecodeRuneInString(*word.Word)
+		prompts = append(prompts, models.SpellingPrompt{
+			WordID:      *word.Id,
+			Length:      utf8.RuneCountInString(*word.Word),
+			FirstLetter: string(first),
+			Definitions: promptDefs,
+		})
+	}
+	return prompts
+}
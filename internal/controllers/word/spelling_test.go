@@ -0,0 +1,33 @@
+package word
+
+import (
+	dbModels "word-flashcard/data/models"
+	"word-flashcard/utils"
+)
+
+// TestBuildSpellingPrompts tests prompt construction, masking, and skipping words without definitions
+func (suite *HelperTestSuite) TestBuildSpellingPrompts() {
+	id1, id2 := 1, 2
+	word1, word2 := "apple", "banana"
+	words := []*dbModels.Word{{Id: &id1, Word: &word1}, {Id: &id2, Word: &word2}}
+	defs := []*dbModels.WordDefinition{
+		{
+			WordId:       &id1,
+			PartOfSpeech: utils.StrPtr("noun"),
+			Definition:   utils.StrPtr("A fruit; an apple a day."),
+			Examples:     utils.StrPtr(`["I ate an apple."]`),
+		},
+		{WordId: &id2, Definition: utils.StrPtr("  ")},
+	}
+
+	prompts := buildSpellingPrompts(words, defs)
+
+	suite.Len(prompts, 1)
+	suite.Equal(1, prompts[0].WordID)
+	suite.Equal(5, prompts[0].Length)
+	suite.Equal("a", prompts[0].FirstLetter)
+	suite.Len(prompts[0].Definitions, 1)
+	suite.Equal("noun", prompts[0].Definitions[0].PartOfSpeech)
+	suite.Equal("A fruit; an ____ a day.", prompts[0].Definitions[0].Definition)
+	suite.Equal([]string{"I ate an ____."}, prompts[0].Definitions[0].Examples)
+}
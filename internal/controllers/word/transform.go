@@ -2,6 +2,7 @@ package word
 
 import (
 	dbModels "word-flashcard/data/models"
+	"word-flashcard/data/schema"
 	"word-flashcard/internal/models"
 )
 
@@ -24,3 +25,19 @@ func (wc *Controller) transformToWordEntities(words []*dbModels.Word, wordsDefs
 
 	return wordEntities
 }
+
+// embedLinks attaches the entities linked to each word
+func (wc *Controller) embedLinks(wordEntities []*models.Word) error {
+	var wordIDs []int
+	for _, wordEntity := range wordEntities {
+		wordIDs = append(wordIDs, *wordEntity.ID)
+	}
+	linked, err := wc.linkResolver.Linked(schema.ENTITY_TYPE_WORD, wordIDs)
+	if err != nil {
+		return err
+	}
+	for _, wordEntity := range wordEntities {
+		wordEntity.Links = linked[*wordEntity.ID]
+	}
+	return nil
+}
@@ -1,6 +1,7 @@
 package word
 
 import (
+	"fmt"
 	"slices"
 	"strings"
 	"word-flashcard/data/schema"
@@ -53,10 +54,85 @@ func validateWordFields(wordData *models.Word, isUpdate bool) error {
 		return err
 	}
 
+	// Validate response_ms field: how long the quiz answer took
+	if err := common.ValidateResponseMs(wordData.ResponseMs); err != nil {
+		return err
+	}
+
 	// Validate quiz_session_id field: VARCHAR(36), nullable
 	return common.ValidateStringField(wordData.QuizSessionID, isUpdate, "quiz_session_id", 36, true)
 }
 
+// parseRandomWordRequest parses a models.WordRandomRequest body and resolves
+// it into per-familiarity quotas for fetchRandomWordsWeighted: either the
+// caller's exact per_category_counts or count split across
+// familiarity_levels by computeLevelQuotas. The draw's scope restricts it
+// to words with a definition at one of cefr_levels, or is nil.
+func (wc *Controller) parseRandomWordRequest(c *gin.Context) (randomWordDraw, error) {
+	var randomReq models.WordRandomRequest
+	if err := common.ParseRequestBody(&randomReq, c); err != nil {
+		return randomWordDraw{}, err
+	}
+
+	// Validate count parameter
+	if randomReq.Count <= 0 || randomReq.Count > 1000 {
+		return randomWordDraw{}, common.NewValidationError(common.NewFieldError("Count must be between 1 and 1000"))
+	}
+
+	// Validate cefr_levels enum values
+	for _, level := range randomReq.CEFRLevels {
+		if !slices.Contains(validCEFRLevels, level) {
+			return randomWordDraw{}, common.NewValidationError(common.NewFieldError("cefr_levels is invalid", "value", level, "allowed", strings.Join(validCEFRLevels, ",")))
+		}
+	}
+	draw := randomWordDraw{scope: cefrLevelScope(randomReq.CEFRLevels), favorSlow: randomReq.FavorSlow}
+
+	if len(randomReq.PerCategoryCounts) > 0 {
+		draw.quotas = randomReq.PerCategoryCounts
+		return draw, nil
+	} else if len(randomReq.FamiliarityLevels) > 0 {
+		draw.quotas = computeLevelQuotas(randomReq.Count, randomReq.FamiliarityLevels)
+		return draw, nil
+	}
+	return randomWordDraw{}, common.NewValidationError(common.NewFieldError("Either familiarity_levels or per_category_counts is required"))
+}
+
+// validateTypedAnswerFields validates a graded typed answer against the
+// practice log columns it is stored in. An empty answer is allowed: it is
+// simply graded as incorrect.
+func validateTypedAnswerFields(answer string, quizSessionID *string) error {
+	// Validate answer field: VARCHAR(255), may be empty
+	if err := common.ValidateStringField(&answer, false, "answer", 255, true); err != nil {
+		return err
+	}
+
+	// Validate quiz_session_id field: VARCHAR(36), nullable
+	return common.ValidateStringField(quizSessionID, false, "quiz_session_id", 36, true)
+}
+
+// maxMergeSources is the most words one merge request may merge into another
+const maxMergeSources = 50
+
+// validateMergeRequest validates the words to merge into the word with targetID
+func validateMergeRequest(req *models.WordMergeRequest, targetID int) error {
+	if len(req.SourceIDs) == 0 || len(req.SourceIDs) > maxMergeSources {
+		return common.NewFieldError(fmt.Sprintf("source_ids must list between 1 and %d words", maxMergeSources), "count", len(req.SourceIDs))
+	}
+	seen := make(map[int]bool, len(req.SourceIDs))
+	for _, id := range req.SourceIDs {
+		switch {
+		case id <= 0:
+			return common.NewFieldError("source_ids is invalid", "value", id)
+		case id == targetID:
+			return common.NewFieldError("source_ids must not include the word merged into", "value", id)
+		case seen[id]:
+			return common.NewFieldError("source_ids must not repeat a word", "value", id)
+		}
+		seen[id] = true
+	}
+	return nil
+}
+
 // validateWordDefinitionFields validates word definition entity fields including constraints
 func validateWordDefinitionFields(definition models.WordDefinition, isUpdate bool) error {
 	// Validate part_of_speech field: VARCHAR(50), required for creation
@@ -79,5 +155,36 @@ func validateWordDefinitionFields(definition models.WordDefinition, isUpdate boo
 		return common.NewFieldError("examples is invalid", "reason", "exceeds max length", "length", len(*definition.Examples), "max", 21845)
 	}
 
+	// Validate cefr_level enum values: VARCHAR(2), nullable
+	if definition.CEFRLevel != nil && !slices.Contains(validCEFRLevels, *definition.CEFRLevel) {
+		return common.NewFieldError("cefr_level is invalid", "value", *definition.CEFRLevel, "allowed", strings.Join(validCEFRLevels, ","))
+	}
+
+	// Validate grammar, labels, synonyms and antonyms fields: TEXT, nullable
+	lists := []struct {
+		name string
+		list *[]string
+	}{
+		{"grammar", definition.Grammar},
+		{"labels", definition.Labels},
+		{"synonyms", definition.Synonyms},
+		{"antonyms", definition.Antonyms},
+	}
+	for _, field := range lists {
+		if field.list != nil && len(*field.list) > 21845 {
+			return common.NewFieldError(field.name+" is invalid", "reason", "exceeds max length", "length", len(*field.list), "max", 21845)
+		}
+	}
+
 	return nil
 }
+
+// validCEFRLevels lists the CEFR levels a definition or random quiz can use
+var validCEFRLevels = []string{
+	schema.WORD_DEFINITIONS_CEFR_A1,
+	schema.WORD_DEFINITIONS_CEFR_A2,
+	schema.WORD_DEFINITIONS_CEFR_B1,
+	schema.WORD_DEFINITIONS_CEFR_B2,
+	schema.WORD_DEFINITIONS_CEFR_C1,
+	schema.WORD_DEFINITIONS_CEFR_C2,
+}
@@ -6,6 +6,7 @@ import (
 	"word-flashcard/data/schema"
 	"word-flashcard/internal/controllers/common"
 	"word-flashcard/internal/models"
+	"word-flashcard/utils"
 )
 
 // TestParseAndValidateWordRequest tests the parseAndValidateWordRequest function
@@ -199,6 +200,18 @@ func (suite *HelperTestSuite) TestValidateWordFields() {
 			wantErrMsg: "quiz_session_id is invalid",
 			wantDetail: []any{"reason", "exceeds max length", "length", 37, "max", 36},
 		},
+		{
+			name: "update - response_ms out of range",
+			input: &models.Word{
+				Word:        &validWord,
+				Familiarity: &validFam,
+				ResponseMs:  utils.IntPtr(0),
+			},
+			isUpdate:   true,
+			wantErr:    true,
+			wantErrMsg: "response_ms is invalid",
+			wantDetail: []any{"value", 0, "min", 1, "max", common.MaxResponseMs},
+		},
 	}
 
 	for _, tc := range testCases {
@@ -289,6 +302,26 @@ func (suite *HelperTestSuite) TestValidateWordDefinitionFields() {
 			wantErrMsg: "definition is invalid",
 			wantDetail: []any{"reason", "exceeds max length", "length", 21846, "max", 21845},
 		},
+		{
+			name: "create - valid cefr_level",
+			input: models.WordDefinition{
+				PartOfSpeech: &validPOS,
+				Definition:   &validDef,
+				CEFRLevel:    utils.StrPtr("B2"),
+			},
+			isUpdate: false,
+			wantErr:  false,
+		},
+		{
+			name: "update - unknown cefr_level",
+			input: models.WordDefinition{
+				CEFRLevel: utils.StrPtr("D1"),
+			},
+			isUpdate:   true,
+			wantErr:    true,
+			wantErrMsg: "cefr_level is invalid",
+			wantDetail: []any{"value", "D1", "allowed", "A1,A2,B1,B2,C1,C2"},
+		},
 	}
 
 	for _, tc := range testCases {
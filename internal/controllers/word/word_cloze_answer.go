@@ -0,0 +1,25 @@
+package word
+
+import (
+	"word-flashcard/data/schema"
+
+	"github.com/gin-gonic/gin"
+)
+
+// AnswerCloze @Summary Grade a cloze (fill-in-the-blank) answer
+// @Description Grade the word typed or chosen for a cloze blank server-side. Any regular inflection of the word (plural/third person,
+// @Description -ed, -ing) is accepted, with the same normalisation and near-miss detection as spelling answers, and the attempt is
+// @Description recorded in the word's practice log
+// @Tags words
+// @Accept json
+// @Produce json
+// @Param id path int true "Word ID"
+// @Param answer body models.TypedAnswerRequest true "Answer for the blank"
+// @Success 200 {object} models.TypedAnswerResult "Answer graded successfully"
+// @Failure 400 {object} models.ErrorResponse "Bad request - Invalid word ID or request body"
+// @Failure 404 {object} models.ErrorResponse "Not found - Word not found"
+// @Failure 500 {object} models.ErrorResponse "Internal server error - Failed to read or write data in database"
+// @Router /api/words/{id}/cloze [post]
+func (wc *Controller) AnswerCloze(c *gin.Context) {
+	wc.answerTypedPrompt(c, schema.WORD_PRACTICE_MODE_CLOZE, clozeAcceptedAnswers)
+}
@@ -0,0 +1,46 @@
+package word
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+
+	dbModels "word-flashcard/data/models"
+	"word-flashcard/data/schema"
+	"word-flashcard/internal/models"
+
+	"github.com/Masterminds/squirrel"
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+// TestAnswerCloze tests that an inflected answer is accepted and logged in cloze mode
+func (suite *ControllerTestSuite) TestAnswerCloze() {
+	whereWord := squirrel.Eq{schema.WORD_ID: 1}
+	suite.mockWordPeer.EXPECT().
+		Select(mock.Anything, whereWord, mock.Anything, mock.Anything, mock.Anything).
+		Return([]*dbModels.Word{getSampleWords()[0]}, nil).Once()
+	suite.mockWordPeer.EXPECT().
+		Update(mock.Anything, whereWord).
+		Return(int64(1), nil).Once()
+	suite.mockWordPracticeLogPeer.EXPECT().
+		Insert(mock.MatchedBy(func(l *dbModels.WordPracticeLog) bool {
+			return *l.Mode == schema.WORD_PRACTICE_MODE_CLOZE && *l.TypedAnswer == "Apples" && *l.IsCorrect
+		})).
+		Return(int64(1), nil).Once()
+
+	w := httptest.NewRecorder()
+	ctx, _ := gin.CreateTestContext(w)
+	ctx.Request = httptest.NewRequest(http.MethodPost, "/api/words/1/cloze", io.NopCloser(bytes.NewReader([]byte("{\"answer\": \"Apples\"}"))))
+	ctx.Params = gin.Params{gin.Param{Key: "id", Value: "1"}}
+	suite.controller.AnswerCloze(ctx)
+
+	assert.Equal(suite.T(), http.StatusOK, w.Code)
+	var result models.TypedAnswerResult
+	assert.NoError(suite.T(), json.Unmarshal(w.Body.Bytes(), &result))
+	assert.Equal(suite.T(), "correct", result.Result)
+	assert.True(suite.T(), result.IsCorrect)
+}
@@ -0,0 +1,63 @@
+package word
+
+import (
+	"net/http"
+	"word-flashcard/internal/controllers/common"
+	"word-flashcard/internal/models"
+
+	"github.com/gin-gonic/gin"
+)
+
+// RandomClozePrompts @Summary Get random cloze (fill-in-the-blank) prompts
+// @Description Select words the same way as the familiarity quiz and, for each, blank the word (in any regular inflected form) out of
+// @Description one of its saved example sentences. Options contain the answer plus other words from the collection inflected the same
+// @Description way. Words without an example sentence that mentions them are skipped.
+// @Tags words
+// @Accept json
+// @Produce json
+// @Param randomRequest body models.WordRandomRequest true "Random request criteria including count and either familiarity_levels or per_category_counts"
+// @Success 200 {array} models.ClozePrompt "Cloze prompts retrieved successfully"
+// @Failure 400 {object} models.ErrorResponse "Bad request - Invalid request body or count parameter"
+// @Failure 500 {object} models.ErrorResponse "Internal server error - Failed to fetch data from database"
+// @Router /api/words/cloze/random [post]
+func (wc *Controller) RandomClozePrompts(c *gin.Context) {
+	// ============== 1. Get random request from body ================
+	draw, err := wc.parseRandomWordRequest(c)
+	if err != nil {
+		common.RespondInvalidBody(err, c)
+		return
+	}
+
+	// ================ 2. Fetch weighted random words ================
+	words, err := wc.drawRandomWords(draw)
+	if err != nil {
+		common.ResponseError(http.StatusInternalServerError, "Failed to fetch data from database", models.ErrCodeInternalError, err, c)
+		return
+	}
+	wordsDefs, err := wc.fetchWordDefinitionsForWords(words)
+	if err != nil {
+		common.ResponseError(http.StatusInternalServerError, "Failed to fetch data from database", models.ErrCodeInternalError, err, c)
+		return
+	}
+
+	// ================ 3. Blank out an example sentence per word ================
+	prompts := make([]models.ClozePrompt, 0, len(words))
+	for _, word := range words {
+		if word.Word == nil {
+			continue
+		}
+		example, ok := pickClozeExample(word, wordsDefs)
+		if !ok {
+			continue
+		}
+		distractors, err := wc.fetchClozeDistractors(word, example.partOfSpeech)
+		if err != nil {
+			common.ResponseError(http.StatusInternalServerError, "Failed to fetch data from database", models.ErrCodeInternalError, err, c)
+			return
+		}
+		prompts = append(prompts, buildClozePrompt(word, example, distractors))
+	}
+
+	// ================ 4. Send response ================
+	common.ResponseSuccess(http.StatusOK, prompts, c)
+}
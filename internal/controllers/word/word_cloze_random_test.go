@@ -0,0 +1,94 @@
+package word
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+
+	dbModels "word-flashcard/data/models"
+	"word-flashcard/data/schema"
+	"word-flashcard/internal/models"
+
+	"github.com/Masterminds/squirrel"
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+// callRandomClozePrompts runs the RandomClozePrompts handler with the given body
+func (suite *ControllerTestSuite) callRandomClozePrompts(requestBody string) *httptest.ResponseRecorder {
+	w := httptest.NewRecorder()
+	ctx, _ := gin.CreateTestContext(w)
+	ctx.Request = httptest.NewRequest(http.MethodPost, "/api/words/cloze/random", io.NopCloser(bytes.NewReader([]byte(requestBody))))
+	suite.controller.RandomClozePrompts(ctx)
+	return w
+}
+
+// TestRandomClozePrompts tests that a saved example is blanked and options come from other words
+func (suite *ControllerTestSuite) TestRandomClozePrompts() {
+	whereWord := squirrel.And{
+		squirrel.Eq{schema.WORD_FAMILIARITY: "green", schema.WORD_IS_SUSPENDED: false},
+		squirrel.Or{
+			squirrel.Eq{schema.WORD_COUNT_PRACTISE: 0},
+			squirrel.Eq{schema.WORD_LAST_PRACTISED_AT: nil},
+		},
+	}
+	limitPtr := uint64(1)
+	suite.mockWordPeer.EXPECT().
+		Select(mock.Anything, whereWord, mock.Anything, &limitPtr, (*uint64)(nil)).
+		Return([]*dbModels.Word{getSampleWords()[0]}, nil).Once()
+	suite.mockWordDefinitionPeer.EXPECT().
+		Select(mock.Anything, squirrel.Eq{schema.WORD_DEFINITIONS_WORD_ID: []int{1}}, mock.Anything, mock.Anything, mock.Anything).
+		Return([]*dbModels.WordDefinition{getSampleWordDefinitions()[0]}, nil).Once()
+
+	// Distractors: three other nouns fill every option, so no top-up query is made.
+	suite.mockWordDefinitionPeer.EXPECT().
+		Select(mock.Anything, squirrel.And{
+			squirrel.Eq{schema.WORD_DEFINITIONS_PART_OF_SPEECH: "noun"},
+			squirrel.NotEq{schema.WORD_DEFINITIONS_WORD_ID: 1},
+		}, mock.Anything, mock.Anything, mock.Anything).
+		Return(getSampleWordDefinitions()[1:4], nil).Once()
+	suite.mockWordPeer.EXPECT().
+		Select(mock.Anything, squirrel.Eq{schema.WORD_ID: []int{2, 3, 4}}, mock.Anything, mock.Anything, mock.Anything).
+		Return(getSampleWords()[1:4], nil).Once()
+
+	w := suite.callRandomClozePrompts("{\"count\": 1, \"per_category_counts\": {\"green\": 1}}")
+
+	assert.Equal(suite.T(), http.StatusOK, w.Code)
+	var prompts []models.ClozePrompt
+	assert.NoError(suite.T(), json.Unmarshal(w.Body.Bytes(), &prompts))
+	assert.Len(suite.T(), prompts, 1)
+	assert.Equal(suite.T(), 1, prompts[0].WordID)
+	assert.Equal(suite.T(), "noun", prompts[0].PartOfSpeech)
+	assert.Contains(suite.T(), []string{
+		"I ate an ____ for breakfast.我早餐吃了一個蘋果。",
+		"The ____ pie is delicious.蘋果派很好吃。",
+	}, prompts[0].Sentence)
+	assert.ElementsMatch(suite.T(), []string{"apple", "banana", "cherry", "lemon"}, prompts[0].Options)
+}
+
+// TestRandomClozePromptsSkipsWordsWithoutExamples tests that words with no usable example produce no prompt
+func (suite *ControllerTestSuite) TestRandomClozePromptsSkipsWordsWithoutExamples() {
+	limitPtr := uint64(1)
+	suite.mockWordPeer.EXPECT().
+		Select(mock.Anything, mock.Anything, mock.Anything, &limitPtr, (*uint64)(nil)).
+		Return([]*dbModels.Word{getSampleWords()[1]}, nil).Once()
+	suite.mockWordDefinitionPeer.EXPECT().
+		Select(mock.Anything, squirrel.Eq{schema.WORD_DEFINITIONS_WORD_ID: []int{2}}, mock.Anything, mock.Anything, mock.Anything).
+		Return([]*dbModels.WordDefinition{getSampleWordDefinitions()[1]}, nil).Once()
+
+	w := suite.callRandomClozePrompts("{\"count\": 1, \"per_category_counts\": {\"yellow\": 1}}")
+
+	assert.Equal(suite.T(), http.StatusOK, w.Code)
+	assert.Equal(suite.T(), "[]", w.Body.String())
+}
+
+// TestRandomClozePromptsInvalidRequest tests that request validation errors are reported as 400
+func (suite *ControllerTestSuite) TestRandomClozePromptsInvalidRequest() {
+	w := suite.callRandomClozePrompts("{\"count\": 5}")
+
+	assert.Equal(suite.T(), http.StatusBadRequest, w.Code)
+	assert.Contains(suite.T(), w.Body.String(), "Either familiarity_levels or per_category_counts is required")
+}
@@ -2,6 +2,7 @@ package word
 
 import (
 	"fmt"
+	"log/slog"
 	"net/http"
 	"word-flashcard/data/schema"
 	"word-flashcard/internal/controllers/common"
@@ -12,7 +13,9 @@ import (
 )
 
 // CreateWord @Summary Create a new word
-// @Description Create a new word entry in the dictionary
+// @Description Create a new word entry in the dictionary. Existing words that are likely the same entry (differing only in case or
+// @Description spacing, or an inflection of the same dictionary form) are listed in possible_duplicates; the word is created
+// @Description regardless.
 // @Tags words
 // @Accept json
 // @Produce json
@@ -54,6 +57,14 @@ func (wc *Controller) CreateWord(c *gin.Context) {
 		return
 	}
 
-	// ================ 3. Send response ================
-	common.ResponseSuccess(http.StatusOK, wordEntities[0], c)
+	// ================ 5. Look for likely duplicates ================
+	// A failure here doesn't undo the insert; the word is returned without the warning
+	word := wordEntities[0]
+	word.PossibleDuplicates, err = wc.findPossibleDuplicates(int(wordID), *wordModel.Word)
+	if err != nil {
+		slog.Warn("Failed to look for duplicates of created word", "word_id", wordID, "error", err)
+	}
+
+	// ================ 6. Send response ================
+	common.ResponseSuccess(http.StatusOK, word, c)
 }
@@ -3,13 +3,16 @@ package word
 import (
 	"bytes"
 	"encoding/json"
+	"errors"
 	"io"
 	"net/http"
 	"net/http/httptest"
 
 	dbModels "word-flashcard/data/models"
 	"word-flashcard/data/schema"
+	"word-flashcard/internal/controllers/common"
 	"word-flashcard/internal/models"
+	"word-flashcard/utils"
 
 	"github.com/Masterminds/squirrel"
 	"github.com/gin-gonic/gin"
@@ -38,6 +41,7 @@ func (suite *ControllerTestSuite) TestCreateWord() {
 	suite.mockWordDefinitionPeer.EXPECT().
 		Select(mock.Anything, whereDefinitionID, mock.Anything, mock.Anything, mock.Anything).
 		Return([]*dbModels.WordDefinition{}, nil).Times(1)
+	suite.expectDuplicateCandidates([]string{"a"}, getSampleWords()...)
 
 	// Create a test HTTP request and call the handler
 	w := httptest.NewRecorder()
@@ -55,3 +59,103 @@ func (suite *ControllerTestSuite) TestCreateWord() {
 	assert.NoError(suite.T(), err)
 	assert.Equal(suite.T(), string(expectedWordJSON), w.Body.String())
 }
+
+// expectDuplicateScan expects every word to be fetched once to look for duplicates
+func (suite *ControllerTestSuite) expectDuplicateScan(words ...*dbModels.Word) {
+	suite.mockWordPeer.EXPECT().
+		Select(duplicateWordColumns(), nil, mock.Anything, mock.Anything, mock.Anything).
+		Return(words, nil).Times(1)
+}
+
+// expectDuplicateCandidates expects the words starting with one of initials
+// to be fetched once to look for duplicates of a new word
+func (suite *ControllerTestSuite) expectDuplicateCandidates(initials []string, words ...*dbModels.Word) {
+	suite.mockWordPeer.EXPECT().
+		Select(duplicateWordColumns(), squirrel.Eq{duplicateInitialColumn: initials}, mock.Anything, mock.Anything, mock.Anything).
+		Return(words, nil).Times(1)
+}
+
+// TestCreateWordWarnsAboutDuplicates tests that existing words with the same
+// spelling or dictionary form are listed with the created word
+func (suite *ControllerTestSuite) TestCreateWordWarnsAboutDuplicates() {
+	words := []*dbModels.Word{
+		{Id: utils.IntPtr(1), Word: utils.StrPtr("Run"), CountPractise: utils.IntPtr(4)},
+		{Id: utils.IntPtr(2), Word: utils.StrPtr("ran")},
+		{Id: utils.IntPtr(3), Word: utils.StrPtr("apple")},
+		{Id: utils.IntPtr(9), Word: utils.StrPtr("running")},
+	}
+	suite.mockWordPeer.EXPECT().Insert(mock.Anything).Return(int64(9), nil).Times(1)
+	suite.mockWordPeer.EXPECT().
+		Select(mock.Anything, squirrel.Eq{schema.WORD_ID: int64(9)}, mock.Anything, mock.Anything, mock.Anything).
+		Return([]*dbModels.Word{words[3]}, nil).Times(1)
+	suite.mockWordDefinitionPeer.EXPECT().
+		Select(mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+		Return([]*dbModels.WordDefinition{}, nil).Times(1)
+	suite.expectDuplicateCandidates([]string{"r"}, words...)
+
+	w := httptest.NewRecorder()
+	ctx, _ := gin.CreateTestContext(w)
+	ctx.Request = httptest.NewRequest(http.MethodPost, "/api/words", bytes.NewReader([]byte(`{"word": "running"}`)))
+	suite.controller.CreateWord(ctx)
+
+	suite.Require().Equal(http.StatusOK, w.Code, w.Body.String())
+	var word models.Word
+	suite.Require().NoError(json.Unmarshal(w.Body.Bytes(), &word))
+	suite.Equal([]models.WordDuplicate{
+		{ID: 1, Word: "Run", CountPractise: 4},
+		{ID: 2, Word: "ran"},
+	}, word.PossibleDuplicates)
+}
+
+// TestCreateWordDuplicateScanFailure tests that the word is still returned
+// when looking for duplicates fails
+func (suite *ControllerTestSuite) TestCreateWordDuplicateScanFailure() {
+	suite.mockWordPeer.EXPECT().Insert(mock.Anything).Return(int64(1), nil).Times(1)
+	suite.mockWordPeer.EXPECT().
+		Select(mock.Anything, squirrel.Eq{schema.WORD_ID: int64(1)}, mock.Anything, mock.Anything, mock.Anything).
+		Return([]*dbModels.Word{getSampleWords()[0]}, nil).Times(1)
+	suite.mockWordDefinitionPeer.EXPECT().
+		Select(mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+		Return([]*dbModels.WordDefinition{}, nil).Times(1)
+	suite.mockWordPeer.EXPECT().
+		Select(duplicateWordColumns(), squirrel.Eq{duplicateInitialColumn: []string{"a"}}, mock.Anything, mock.Anything, mock.Anything).
+		Return(nil, errors.New("db error")).Times(1)
+
+	w := httptest.NewRecorder()
+	ctx, _ := gin.CreateTestContext(w)
+	ctx.Request = httptest.NewRequest(http.MethodPost, "/api/words", bytes.NewReader([]byte(`{"word": "apple"}`)))
+	suite.controller.CreateWord(ctx)
+
+	suite.Equal(http.StatusOK, w.Code)
+	suite.NotContains(w.Body.String(), "possible_duplicates")
+}
+
+// TestDuplicateCandidatesWhere tests that the candidates are narrowed to the
+// initials of the keys, their irregular inflections and the typed word
+func (suite *ControllerTestSuite) TestDuplicateCandidatesWhere() {
+	tests := []struct {
+		word     string
+		expected []string
+	}{
+		{word: "Running", expected: []string{"r"}},
+		{word: "go", expected: []string{"g", "w"}},
+		{word: "went", expected: []string{"w", "g"}},
+		{word: "Élan", expected: []string{"e", "é"}},
+	}
+
+	for _, tt := range tests {
+		suite.Run(tt.word, func() {
+			keys := common.DuplicateKeys(tt.word, common.EnglishLemmatizer{})
+			suite.Equal(squirrel.Eq{duplicateInitialColumn: tt.expected}, duplicateCandidatesWhere(tt.word, keys))
+		})
+	}
+}
+
+// TestDuplicateInitialColumnTrimsWord tests that the initial of a stored word
+// is taken after trimming it, so " apple" is still a candidate of "apple"
+func (suite *ControllerTestSuite) TestDuplicateInitialColumnTrimsWord() {
+	sql, args, err := duplicateCandidatesWhere("apple", []string{"apple"}).ToSql()
+	suite.Require().NoError(err)
+	suite.Equal("LOWER(SUBSTR(TRIM(word), 1, 1)) IN (?)", sql)
+	suite.Equal([]interface{}{"a"}, args)
+}
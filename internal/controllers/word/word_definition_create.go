@@ -39,6 +39,8 @@ func (wc *Controller) CreateWordDefinition(c *gin.Context) {
 	}
 
 	// ================ 2. Convert to data model ================
+	// Download pronunciation audio so phonetics point at the stored copy
+	wc.audioStore.LocalizePhonetics(wordDefinitionData.Phonetics)
 	wordDefsModel := wordDefinitionData.ToDataModel()
 
 	// ================ 3. Insert data into database ================
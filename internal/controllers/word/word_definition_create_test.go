@@ -9,6 +9,7 @@ import (
 
 	dbModels "word-flashcard/data/models"
 	"word-flashcard/data/schema"
+	"word-flashcard/internal/audiostore"
 
 	"github.com/Masterminds/squirrel"
 	"github.com/gin-gonic/gin"
@@ -58,3 +59,42 @@ func (suite *ControllerTestSuite) TestCreateWordDefinition() {
 	assert.NoError(suite.T(), err)
 	assert.Equal(suite.T(), string(expectedWordJSON), w.Body.String())
 }
+
+// TestCreateWordDefinitionStoresAudio tests that pronunciation audio from an
+// allowed host is downloaded and the stored definition points at it
+func (suite *ControllerTestSuite) TestCreateWordDefinitionStoresAudio() {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "audio/mpeg")
+		w.Write([]byte("apple recording"))
+	}))
+	defer server.Close()
+	suite.T().Setenv("AUDIO_DIR", suite.T().TempDir())
+	suite.T().Setenv("AUDIO_ALLOWED_HOSTS", "127.0.0.1")
+	suite.controller.audioStore = audiostore.NewAudioStore(suite.mockAudioFilePeer)
+	audioURL := server.URL + "/apple_uk.mp3"
+
+	suite.mockAudioFilePeer.EXPECT().
+		Select(mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+		Return([]*dbModels.AudioFile{}, nil).Times(1)
+	suite.mockAudioFilePeer.EXPECT().Insert(mock.Anything).Return(int64(4), nil).Times(1)
+	suite.mockWordDefinitionPeer.EXPECT().
+		Insert(mock.MatchedBy(func(definition *dbModels.WordDefinition) bool {
+			return *definition.Phonetics == `{"uk":{"audio":"/api/audio/4","url":"`+audioURL+`"}}`
+		})).
+		Return(int64(1), nil).Times(1)
+	suite.mockWordPeer.EXPECT().
+		Select(mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+		Return([]*dbModels.Word{getSampleWords()[0]}, nil).Times(1)
+	suite.mockWordDefinitionPeer.EXPECT().
+		Select(mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+		Return([]*dbModels.WordDefinition{getSampleWordDefinitions()[0]}, nil).Times(1)
+
+	w := httptest.NewRecorder()
+	ctx, _ := gin.CreateTestContext(w)
+	requestBody := `{"part_of_speech": "noun", "definition": "a fruit", "phonetics": {"uk": "` + audioURL + `"}}`
+	ctx.Request = httptest.NewRequest(http.MethodPost, "/api/words/definition/1", io.NopCloser(bytes.NewReader([]byte(requestBody))))
+	ctx.Params = gin.Params{gin.Param{Key: "id", Value: "1"}}
+	suite.controller.CreateWordDefinition(ctx)
+
+	assert.Equal(suite.T(), http.StatusOK, w.Code)
+}
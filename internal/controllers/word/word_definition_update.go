@@ -40,6 +40,8 @@ func (wc *Controller) UpdateWordDefinition(c *gin.Context) {
 	}
 
 	// ================ 2. Convert to data model ================
+	// Download pronunciation audio so phonetics point at the stored copy
+	wc.audioStore.LocalizePhonetics(wordDefinitionData.Phonetics)
 	wordDefsModel := wordDefinitionData.ToDataModel()
 	wordDefsModel.Id = nil // To prevent updating the ID field
 
@@ -11,12 +11,13 @@ import (
 )
 
 // DeleteWord @Summary Delete a word
-// @Description Delete a word and all its associated definitions
+// @Description Delete a word and all its associated definitions. Its links to questions and notes are removed and listed in the response,
+// @Description and its reminders are deleted and recorded in the reminder history.
 // @Tags words
 // @Accept json
 // @Produce json
 // @Param id path int true "Word ID"
-// @Success 204 "Word deleted successfully"
+// @Success 200 {object} models.WordDeleteResult "Word deleted successfully, with the entities it was unlinked from"
 // @Failure 400 {object} models.ErrorResponse "Bad request - Invalid word ID"
 // @Failure 404 {object} models.ErrorResponse "Not found - Word not found"
 // @Failure 500 {object} models.ErrorResponse "Internal server error - Failed to delete data from database"
@@ -39,6 +40,22 @@ func (wc *Controller) DeleteWord(c *gin.Context) {
 		return
 	}
 
+	// Links are dropped rather than left dangling; the linked questions and
+	// notes themselves are kept and reported back to the caller
+	unlinked, err := wc.linkResolver.Unlink(schema.ENTITY_TYPE_WORD, wordID)
+	if err != nil {
+		common.ResponseError(http.StatusInternalServerError, "Failed to delete associated data from database", models.ErrCodeInternalError, err, c)
+		return
+	}
+
+	// Reminders go with the word, each recorded in the reminder history as
+	// deleted, rather than waiting for the reminder scheduler to prune them
+	reminderWhere := squirrel.Eq{schema.REMINDER_ENTITY_TYPE: schema.ENTITY_TYPE_WORD, schema.REMINDER_ENTITY_ID: wordID}
+	if _, err := wc.reminderPeer.DeleteWithHistory(reminderWhere); err != nil {
+		common.ResponseError(http.StatusInternalServerError, "Failed to delete associated data from database", models.ErrCodeInternalError, err, c)
+		return
+	}
+
 	// Delete word definitions only if they exist
 	if len(existingDefs) > 0 {
 		if _, err := wc.wordDefinitionPeer.Delete(whereDefs); err != nil {
@@ -61,5 +78,5 @@ func (wc *Controller) DeleteWord(c *gin.Context) {
 	}
 
 	// ================ 3. Send response ================
-	common.ResponseSuccess(http.StatusNoContent, nil, c)
+	common.ResponseSuccess(http.StatusOK, models.WordDeleteResult{ID: wordID, Unlinked: unlinked}, c)
 }
@@ -1,12 +1,16 @@
 package word
 
 import (
+	"encoding/json"
 	"fmt"
 	"net/http"
 	"net/http/httptest"
 
 	dbModels "word-flashcard/data/models"
 	"word-flashcard/data/schema"
+	"word-flashcard/internal/links"
+	"word-flashcard/internal/models"
+	"word-flashcard/utils"
 
 	"github.com/Masterminds/squirrel"
 	"github.com/gin-gonic/gin"
@@ -14,6 +18,23 @@ import (
 	"github.com/stretchr/testify/mock"
 )
 
+// expectUnlink mocks looking up and removing the given links of wordID
+func (suite *ControllerTestSuite) expectUnlink(wordID int, entityLinks []*dbModels.EntityLink) {
+	suite.mockEntityLinkPeer.EXPECT().
+		Select(mock.Anything, links.LinksOf(schema.ENTITY_TYPE_WORD, []int{wordID}), mock.Anything, mock.Anything, mock.Anything).
+		Return(entityLinks, nil).Times(1)
+	suite.mockEntityLinkPeer.EXPECT().
+		Delete(links.LinksOf(schema.ENTITY_TYPE_WORD, []int{wordID})).
+		Return(int64(len(entityLinks)), nil).Times(1)
+}
+
+// expectReminderDelete mocks deleting wordID's reminders with their history
+func (suite *ControllerTestSuite) expectReminderDelete(wordID int) {
+	suite.mockReminderPeer.EXPECT().
+		DeleteWithHistory(squirrel.Eq{schema.REMINDER_ENTITY_TYPE: schema.ENTITY_TYPE_WORD, schema.REMINDER_ENTITY_ID: wordID}).
+		Return(int64(1), nil).Times(1)
+}
+
 // TestDeleteWord tests the DeleteWord handler
 func (suite *ControllerTestSuite) TestDeleteWord() {
 	testWordID := 1
@@ -27,6 +48,8 @@ func (suite *ControllerTestSuite) TestDeleteWord() {
 	suite.mockWordDefinitionPeer.EXPECT().
 		Select(mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).
 		Return(getSampleWordDefinitions(), nil).Times(1)
+	suite.expectUnlink(testWordID, nil)
+	suite.expectReminderDelete(testWordID)
 	suite.mockWordDefinitionPeer.EXPECT().
 		Delete(whereWordID).
 		Return(int64(2), nil).Times(1)
@@ -39,9 +62,12 @@ func (suite *ControllerTestSuite) TestDeleteWord() {
 	suite.controller.DeleteWord(ctx)
 
 	// Verify the response status code
-	assert.Equal(suite.T(), http.StatusNoContent, w.Code)
+	assert.Equal(suite.T(), http.StatusOK, w.Code)
 	// Verify the response body
-	assert.Equal(suite.T(), "", w.Body.String())
+	var result models.WordDeleteResult
+	assert.NoError(suite.T(), json.Unmarshal(w.Body.Bytes(), &result))
+	assert.Equal(suite.T(), testWordID, result.ID)
+	assert.Empty(suite.T(), result.Unlinked)
 }
 
 // TestDeleteWordInvalidID tests that an invalid word ID returns 400
@@ -80,6 +106,8 @@ func (suite *ControllerTestSuite) TestDeleteWordNoDefinitions() {
 	suite.mockWordDefinitionPeer.EXPECT().
 		Select(mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).
 		Return([]*dbModels.WordDefinition{}, nil).Times(1)
+	suite.expectUnlink(testWordID, nil)
+	suite.expectReminderDelete(testWordID)
 	suite.mockWordPeer.EXPECT().
 		Delete(whereWord).
 		Return(int64(1), nil).Times(1)
@@ -93,7 +121,7 @@ func (suite *ControllerTestSuite) TestDeleteWordNoDefinitions() {
 	// wordDefinitionPeer.Delete has no expectation set above, so the mock
 	// would fail this test if DeleteWord called it despite there being no
 	// associated definitions to delete.
-	assert.Equal(suite.T(), http.StatusNoContent, w.Code)
+	assert.Equal(suite.T(), http.StatusOK, w.Code)
 }
 
 // TestDeleteWordDefinitionsDeleteError tests that a database failure while
@@ -105,6 +133,8 @@ func (suite *ControllerTestSuite) TestDeleteWordDefinitionsDeleteError() {
 	suite.mockWordDefinitionPeer.EXPECT().
 		Select(mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).
 		Return(getSampleWordDefinitions(), nil).Times(1)
+	suite.expectUnlink(testWordID, nil)
+	suite.expectReminderDelete(testWordID)
 	suite.mockWordDefinitionPeer.EXPECT().
 		Delete(whereWordID).
 		Return(int64(0), fmt.Errorf("delete failed")).Times(1)
@@ -128,6 +158,8 @@ func (suite *ControllerTestSuite) TestDeleteWordPeerDeleteError() {
 	suite.mockWordDefinitionPeer.EXPECT().
 		Select(mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).
 		Return(getSampleWordDefinitions(), nil).Times(1)
+	suite.expectUnlink(testWordID, nil)
+	suite.expectReminderDelete(testWordID)
 	suite.mockWordDefinitionPeer.EXPECT().
 		Delete(whereWordID).
 		Return(int64(2), nil).Times(1)
@@ -153,6 +185,8 @@ func (suite *ControllerTestSuite) TestDeleteWordNotFound() {
 	suite.mockWordDefinitionPeer.EXPECT().
 		Select(mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).
 		Return(getSampleWordDefinitions(), nil).Times(1)
+	suite.expectUnlink(testWordID, nil)
+	suite.expectReminderDelete(testWordID)
 	suite.mockWordDefinitionPeer.EXPECT().
 		Delete(whereWordID).
 		Return(int64(2), nil).Times(1)
@@ -168,3 +202,77 @@ func (suite *ControllerTestSuite) TestDeleteWordNotFound() {
 
 	assert.Equal(suite.T(), http.StatusNotFound, w.Code)
 }
+
+// TestDeleteWordReportsUnlinked tests that the entities a deleted word was
+// linked to are listed in the response
+func (suite *ControllerTestSuite) TestDeleteWordReportsUnlinked() {
+	testWordID := 1
+	links := []*dbModels.EntityLink{
+		{Id: utils.IntPtr(7), SourceType: utils.StrPtr(schema.ENTITY_TYPE_WORD), SourceId: utils.IntPtr(testWordID), TargetType: utils.StrPtr(schema.ENTITY_TYPE_NOTE), TargetId: utils.IntPtr(3)},
+	}
+
+	suite.mockWordDefinitionPeer.EXPECT().
+		Select(mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+		Return([]*dbModels.WordDefinition{}, nil).Times(1)
+	suite.expectUnlink(testWordID, links)
+	suite.expectReminderDelete(testWordID)
+	suite.mockNotePeer.EXPECT().
+		Select(mock.Anything, squirrel.Eq{schema.NOTE_ID: []int{3}}, mock.Anything, mock.Anything, mock.Anything).
+		Return([]*dbModels.Note{{Id: utils.IntPtr(3), Title: utils.StrPtr("Fruit idioms")}}, nil).Times(1)
+	suite.mockWordPeer.EXPECT().
+		Delete(squirrel.Eq{schema.WORD_ID: testWordID}).
+		Return(int64(1), nil).Times(1)
+
+	w := httptest.NewRecorder()
+	ctx, _ := gin.CreateTestContext(w)
+	ctx.Request = httptest.NewRequest(http.MethodDelete, "/api/words/1", nil)
+	ctx.Params = gin.Params{gin.Param{Key: "id", Value: "1"}}
+	suite.controller.DeleteWord(ctx)
+
+	assert.Equal(suite.T(), http.StatusOK, w.Code)
+	var result models.WordDeleteResult
+	assert.NoError(suite.T(), json.Unmarshal(w.Body.Bytes(), &result))
+	assert.Equal(suite.T(), []models.LinkedEntity{
+		{LinkID: 7, Type: schema.ENTITY_TYPE_NOTE, ID: 3, Label: "Fruit idioms"},
+	}, result.Unlinked)
+}
+
+// TestDeleteWordUnlinkError tests that a database failure while removing the
+// word's links returns 500 and leaves the word in place
+func (suite *ControllerTestSuite) TestDeleteWordUnlinkError() {
+	suite.mockWordDefinitionPeer.EXPECT().
+		Select(mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+		Return([]*dbModels.WordDefinition{}, nil).Times(1)
+	suite.mockEntityLinkPeer.EXPECT().
+		Select(mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+		Return(nil, fmt.Errorf("select failed")).Times(1)
+
+	w := httptest.NewRecorder()
+	ctx, _ := gin.CreateTestContext(w)
+	ctx.Request = httptest.NewRequest(http.MethodDelete, "/api/words/1", nil)
+	ctx.Params = gin.Params{gin.Param{Key: "id", Value: "1"}}
+	suite.controller.DeleteWord(ctx)
+
+	assert.Equal(suite.T(), http.StatusInternalServerError, w.Code)
+}
+
+// TestDeleteWordRemindersError tests that a database failure while deleting
+// the word's reminders returns 500 and leaves the word in place
+func (suite *ControllerTestSuite) TestDeleteWordRemindersError() {
+	suite.mockWordDefinitionPeer.EXPECT().
+		Select(mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+		Return([]*dbModels.WordDefinition{}, nil).Times(1)
+	suite.expectUnlink(1, nil)
+	suite.mockReminderPeer.EXPECT().
+		DeleteWithHistory(mock.Anything).
+		Return(int64(0), fmt.Errorf("delete failed")).Times(1)
+
+	w := httptest.NewRecorder()
+	ctx, _ := gin.CreateTestContext(w)
+	ctx.Request = httptest.NewRequest(http.MethodDelete, "/api/words/1", nil)
+	ctx.Params = gin.Params{gin.Param{Key: "id", Value: "1"}}
+	suite.controller.DeleteWord(ctx)
+
+	assert.Equal(suite.T(), http.StatusInternalServerError, w.Code)
+	suite.mockWordPeer.AssertNotCalled(suite.T(), "Delete", mock.Anything)
+}
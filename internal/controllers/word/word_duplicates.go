@@ -0,0 +1,66 @@
+package word
+
+import (
+	"net/http"
+	"word-flashcard/data/schema"
+	"word-flashcard/internal/controllers/common"
+	"word-flashcard/internal/models"
+
+	"github.com/Masterminds/squirrel"
+	"github.com/gin-gonic/gin"
+)
+
+// ListDuplicateWords @Summary List likely duplicate words
+// @Description Groups words that are likely the same entry: words differing only in case, spacing, hyphens or accents, and inflections
+// @Description of the same dictionary form (run, ran, running). Each group names the word the others are best merged into, the
+// @Description dictionary form when it is in the list, otherwise the most practised word; pass the other words of a group to POST
+// @Description /api/words/{id}/merge with that word's ID to merge them.
+// @Tags words
+// @Produce json
+// @Success 200 {object} models.WordDuplicatesReport "Groups of likely duplicate words"
+// @Failure 500 {object} models.ErrorResponse "Internal server error - Failed to fetch data from database"
+// @Router /api/words/duplicates [get]
+func (wc *Controller) ListDuplicateWords(c *gin.Context) {
+	// ================ 1. Fetch all words ================
+	words, err := wc.selectDuplicateCandidates(nil)
+	if err != nil {
+		common.ResponseError(http.StatusInternalServerError, "Failed to fetch data from database", models.ErrCodeInternalError, err, c)
+		return
+	}
+
+	// ================ 2. Find words sharing a key ================
+	keys := make([][]string, len(words))
+	for i, w := range words {
+		if w.Word != nil {
+			keys[i] = common.DuplicateKeys(*w.Word, wc.lemmatizer)
+		}
+	}
+
+	var wordIDs []int
+	for _, indexes := range common.GroupByKeys(keys) {
+		for _, i := range indexes {
+			wordIDs = append(wordIDs, *words[i].Id)
+		}
+	}
+
+	// ================ 3. Count definitions of grouped words ================
+	definitionCounts := make(map[int]int)
+	if len(wordIDs) > 0 {
+		wordIDColumn := schema.WORD_DEFINITIONS_WORD_ID
+		definitions, err := wc.wordDefinitionPeer.Select([]*string{&wordIDColumn}, squirrel.Eq{schema.WORD_DEFINITIONS_WORD_ID: wordIDs}, nil, nil, nil)
+		if err != nil {
+			common.ResponseError(http.StatusInternalServerError, "Failed to fetch data from database", models.ErrCodeInternalError, err, c)
+			return
+		}
+		for _, def := range definitions {
+			if def.WordId != nil {
+				definitionCounts[*def.WordId]++
+			}
+		}
+	}
+
+	// ================ 4. Send response ================
+	common.ResponseSuccess(http.StatusOK, models.WordDuplicatesReport{
+		Groups: wc.groupDuplicateWords(words, keys, definitionCounts),
+	}, c)
+}
@@ -0,0 +1,103 @@
+package word
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+
+	dbModels "word-flashcard/data/models"
+	"word-flashcard/data/schema"
+	"word-flashcard/internal/models"
+	"word-flashcard/utils"
+
+	"github.com/Masterminds/squirrel"
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/mock"
+)
+
+// getDuplicates calls the ListDuplicateWords handler and returns the recorder
+func (suite *ControllerTestSuite) getDuplicates() *httptest.ResponseRecorder {
+	w := httptest.NewRecorder()
+	ctx, _ := gin.CreateTestContext(w)
+	ctx.Request = httptest.NewRequest(http.MethodGet, "/api/words/duplicates", nil)
+	suite.controller.ListDuplicateWords(ctx)
+	return w
+}
+
+// TestListDuplicateWords tests that inflections and spelling variants are
+// grouped with a suggested merge target and unrelated words are left out
+func (suite *ControllerTestSuite) TestListDuplicateWords() {
+	suite.expectDuplicateScan(
+		&dbModels.Word{Id: utils.IntPtr(1), Word: utils.StrPtr("running"), CountPractise: utils.IntPtr(5)},
+		&dbModels.Word{Id: utils.IntPtr(2), Word: utils.StrPtr("apple")},
+		&dbModels.Word{Id: utils.IntPtr(3), Word: utils.StrPtr("run"), Familiarity: utils.StrPtr("red")},
+		&dbModels.Word{Id: utils.IntPtr(4), Word: utils.StrPtr("ran"), CountPractise: utils.IntPtr(1)},
+		&dbModels.Word{Id: utils.IntPtr(5), Word: utils.StrPtr("Ice-cream")},
+		&dbModels.Word{Id: utils.IntPtr(6), Word: utils.StrPtr("ice cream"), CountPractise: utils.IntPtr(2)},
+	)
+	suite.mockWordDefinitionPeer.EXPECT().
+		Select(mock.Anything, squirrel.Eq{schema.WORD_DEFINITIONS_WORD_ID: []int{1, 3, 4, 5, 6}}, mock.Anything, mock.Anything, mock.Anything).
+		Return([]*dbModels.WordDefinition{{WordId: utils.IntPtr(1)}, {WordId: utils.IntPtr(1)}, {WordId: utils.IntPtr(5)}}, nil).Times(1)
+
+	w := suite.getDuplicates()
+
+	suite.Require().Equal(http.StatusOK, w.Code, w.Body.String())
+	var report models.WordDuplicatesReport
+	suite.Require().NoError(json.Unmarshal(w.Body.Bytes(), &report))
+	suite.Equal([]models.WordDuplicateGroup{
+		{
+			Lemma:             "ice cream",
+			Reason:            models.WordDuplicateSpelling,
+			SuggestedTargetID: 6,
+			Words: []models.WordDuplicate{
+				{ID: 6, Word: "ice cream", CountPractise: 2, Definitions: utils.IntPtr(0)},
+				{ID: 5, Word: "Ice-cream", Definitions: utils.IntPtr(1)},
+			},
+		},
+		{
+			Lemma:             "run",
+			Reason:            models.WordDuplicateInflection,
+			SuggestedTargetID: 3,
+			Words: []models.WordDuplicate{
+				{ID: 3, Word: "run", Familiarity: utils.StrPtr("red"), Definitions: utils.IntPtr(0)},
+				{ID: 1, Word: "running", CountPractise: 5, Definitions: utils.IntPtr(2)},
+				{ID: 4, Word: "ran", CountPractise: 1, Definitions: utils.IntPtr(0)},
+			},
+		},
+	}, report.Groups)
+}
+
+// TestListDuplicateWordsNone tests that an empty report is returned without
+// counting definitions when no words match
+func (suite *ControllerTestSuite) TestListDuplicateWordsNone() {
+	suite.expectDuplicateScan(getSampleWords()...)
+
+	w := suite.getDuplicates()
+
+	suite.Equal(http.StatusOK, w.Code)
+	suite.JSONEq(`{"groups":[]}`, w.Body.String())
+}
+
+// TestListDuplicateWordsErrors tests that database failures are reported
+func (suite *ControllerTestSuite) TestListDuplicateWordsErrors() {
+	suite.Run("words", func() {
+		suite.mockWordPeer.EXPECT().
+			Select(duplicateWordColumns(), nil, mock.Anything, mock.Anything, mock.Anything).
+			Return(nil, errors.New("db error")).Once()
+
+		suite.Equal(http.StatusInternalServerError, suite.getDuplicates().Code)
+	})
+
+	suite.Run("definitions", func() {
+		suite.expectDuplicateScan(
+			&dbModels.Word{Id: utils.IntPtr(1), Word: utils.StrPtr("apple")},
+			&dbModels.Word{Id: utils.IntPtr(2), Word: utils.StrPtr("apples")},
+		)
+		suite.mockWordDefinitionPeer.EXPECT().
+			Select(mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+			Return(nil, errors.New("db error")).Once()
+
+		suite.Equal(http.StatusInternalServerError, suite.getDuplicates().Code)
+	})
+}
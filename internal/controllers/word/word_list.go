@@ -17,6 +17,7 @@ import (
 // @Produce json
 // @Param limit query int false "Maximum number of records to return (default: 100, max: 1000)"
 // @Param offset query int false "Number of records to skip (default: 0)"
+// @Param include query string false "Set to links to embed each word's linked questions and notes"
 // @Success 200 {array} models.Word "List of words retrieved successfully"
 // @Failure 400 {object} models.ErrorResponse "Bad request - Invalid query parameters"
 // @Failure 500 {object} models.ErrorResponse "Internal server error - Failed to fetch data from database"
@@ -51,6 +52,12 @@ func (wc *Controller) ListWords(c *gin.Context) {
 
 	// ================ 3. Transform data to API model ================
 	wordEntities := wc.transformToWordEntities(words, wordsDefs)
+	if common.HasInclude(c, "links") {
+		if err := wc.embedLinks(wordEntities); err != nil {
+			common.ResponseError(http.StatusInternalServerError, "Failed to fetch data from database", models.ErrCodeInternalError, err, c)
+			return
+		}
+	}
 
 	// ================ 4. Send response ================
 	common.ResponseSuccess(http.StatusOK, wordEntities, c)
@@ -2,9 +2,15 @@ package word
 
 import (
 	"encoding/json"
+	"fmt"
 	"net/http"
 	"net/http/httptest"
+	dbModels "word-flashcard/data/models"
+	"word-flashcard/data/schema"
+	"word-flashcard/internal/models"
+	"word-flashcard/utils"
 
+	"github.com/Masterminds/squirrel"
 	"github.com/gin-gonic/gin"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
@@ -35,3 +41,61 @@ func (suite *ControllerTestSuite) TestListWords() {
 	assert.NoError(suite.T(), err)
 	assert.Equal(suite.T(), string(expectedWord), w.Body.String())
 }
+
+// TestListWordsIncludeLinks tests that include=links embeds each word's
+// linked questions and notes, leaving unlinked words without links
+func (suite *ControllerTestSuite) TestListWordsIncludeLinks() {
+	suite.mockWordPeer.EXPECT().
+		Select(mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+		Return(getSampleWords(), nil).Times(1)
+	suite.mockWordDefinitionPeer.EXPECT().
+		Select(mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+		Return(getSampleWordDefinitions(), nil).Times(1)
+	suite.mockEntityLinkPeer.EXPECT().
+		Select(mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+		Return([]*dbModels.EntityLink{
+			{Id: utils.IntPtr(4), SourceType: utils.StrPtr(schema.ENTITY_TYPE_WORD), SourceId: utils.IntPtr(2), TargetType: utils.StrPtr(schema.ENTITY_TYPE_QUESTION), TargetId: utils.IntPtr(9)},
+		}, nil).Times(1)
+	suite.mockQuestionPeer.EXPECT().
+		Select(mock.Anything, squirrel.Eq{schema.QUESTION_ID: []int{9}}, mock.Anything, mock.Anything, mock.Anything).
+		Return([]*dbModels.Question{{Id: utils.IntPtr(9), Question: utils.StrPtr("Which fruit is yellow?")}}, nil).Times(1)
+
+	w := httptest.NewRecorder()
+	ctx, _ := gin.CreateTestContext(w)
+	ctx.Request = httptest.NewRequest(http.MethodGet, "/api/words?include=links", nil)
+	suite.controller.ListWords(ctx)
+
+	assert.Equal(suite.T(), http.StatusOK, w.Code)
+	var words []models.Word
+	assert.NoError(suite.T(), json.Unmarshal(w.Body.Bytes(), &words))
+	for _, word := range words {
+		if *word.ID == 2 {
+			assert.Equal(suite.T(), []models.LinkedEntity{
+				{LinkID: 4, Type: schema.ENTITY_TYPE_QUESTION, ID: 9, Label: "Which fruit is yellow?"},
+			}, word.Links)
+		} else {
+			assert.Nil(suite.T(), word.Links)
+		}
+	}
+}
+
+// TestListWordsIncludeLinksError tests that a failure while fetching links
+// returns 500
+func (suite *ControllerTestSuite) TestListWordsIncludeLinksError() {
+	suite.mockWordPeer.EXPECT().
+		Select(mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+		Return(getSampleWords(), nil).Times(1)
+	suite.mockWordDefinitionPeer.EXPECT().
+		Select(mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+		Return(getSampleWordDefinitions(), nil).Times(1)
+	suite.mockEntityLinkPeer.EXPECT().
+		Select(mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+		Return(nil, fmt.Errorf("select failed")).Times(1)
+
+	w := httptest.NewRecorder()
+	ctx, _ := gin.CreateTestContext(w)
+	ctx.Request = httptest.NewRequest(http.MethodGet, "/api/words?include=links", nil)
+	suite.controller.ListWords(ctx)
+
+	assert.Equal(suite.T(), http.StatusInternalServerError, w.Code)
+}
@@ -0,0 +1,81 @@
+package word
+
+import (
+	"errors"
+	"net/http"
+	"word-flashcard/data/peers"
+	"word-flashcard/data/schema"
+	"word-flashcard/internal/controllers/common"
+	"word-flashcard/internal/models"
+
+	"github.com/Masterminds/squirrel"
+	"github.com/gin-gonic/gin"
+)
+
+// MergeWords @Summary Merge words into another
+// @Description Merges the source words into the word in the path, all in one transaction. The sources' definitions, practice logs,
+// @Description links to questions and notes and due-dated reminders are moved to it, except definitions it already has with the same
+// @Description part of speech and text, links it already has, and all but one reminder (its own, or else the source reminder due
+// @Description first), which are deleted. Its practice count becomes the sum of all of them, its last practice time the latest, and its
+// @Description familiarity the least familiar; it keeps its legacy reminder text, or takes a source's when it has none. The source
+// @Description words are then deleted.
+// @Tags words
+// @Accept json
+// @Produce json
+// @Param id path int true "ID of the word to merge into"
+// @Param merge body models.WordMergeRequest true "IDs of the words to merge into it"
+// @Success 200 {object} models.WordMergeResult "Words merged successfully"
+// @Failure 400 {object} models.ErrorResponse "Bad request - Invalid word ID or request body"
+// @Failure 404 {object} models.ErrorResponse "Not found - One of the words not found"
+// @Failure 500 {object} models.ErrorResponse "Internal server error - Failed to merge words in database"
+// @Router /api/words/{id}/merge [post]
+func (wc *Controller) MergeWords(c *gin.Context) {
+	// ================ 1. Parse request parameter & body ================
+	targetID, err := common.ParseIDFromPath(c, "id")
+	if err != nil {
+		common.ResponseError(http.StatusBadRequest, "Invalid word ID.", models.ErrCodeInvalidRequest, err, c)
+		return
+	}
+
+	var mergeReq models.WordMergeRequest
+	if err := common.ParseRequestBody(&mergeReq, c); err != nil {
+		common.RespondInvalidBody(err, c)
+		return
+	}
+	if err := validateMergeRequest(&mergeReq, targetID); err != nil {
+		common.RespondInvalidBody(common.NewValidationError(err), c)
+		return
+	}
+
+	// ================ 2. Merge in database ================
+	sourceIDs := make([]int64, len(mergeReq.SourceIDs))
+	for i, id := range mergeReq.SourceIDs {
+		sourceIDs[i] = int64(id)
+	}
+	merged, err := wc.wordPeer.MergeWords(int64(targetID), sourceIDs)
+	if errors.Is(err, peers.ErrWordNotFound) {
+		common.ResponseError(http.StatusNotFound, "Word not found", models.ErrCodeNotFound, err, c)
+		return
+	} else if err != nil {
+		common.ResponseError(http.StatusInternalServerError, "Failed to merge words in database", models.ErrCodeInternalError, err, c)
+		return
+	}
+
+	// ================ 3. Query merged word ================
+	wordEntities, err := wc.fetchWordsWithDefinitions([]*string{}, squirrel.Eq{schema.WORD_ID: targetID}, nil, nil, nil)
+	if err != nil || len(wordEntities) == 0 {
+		common.ResponseError(http.StatusInternalServerError, "Merged but failed to fetch data from database", models.ErrCodeInternalError, err, c)
+		return
+	}
+
+	// ================ 4. Send response ================
+	common.ResponseSuccess(http.StatusOK, models.WordMergeResult{
+		Word:               *wordEntities[0],
+		MergedIDs:          mergeReq.SourceIDs,
+		DefinitionsMoved:   merged.DefinitionsMoved,
+		DefinitionsSkipped: merged.DefinitionsSkipped,
+		LogsMoved:          merged.LogsMoved,
+		LinksMoved:         merged.LinksMoved,
+		RemindersMoved:     merged.RemindersMoved,
+	}, c)
+}
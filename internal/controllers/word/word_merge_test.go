@@ -0,0 +1,108 @@
+package word
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+
+	dbModels "word-flashcard/data/models"
+	"word-flashcard/data/peers"
+	"word-flashcard/data/schema"
+	"word-flashcard/internal/models"
+	"word-flashcard/utils"
+
+	"github.com/Masterminds/squirrel"
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/mock"
+)
+
+// postMerge calls the MergeWords handler for the word with id and returns the recorder
+func (suite *ControllerTestSuite) postMerge(id, body string) *httptest.ResponseRecorder {
+	w := httptest.NewRecorder()
+	ctx, _ := gin.CreateTestContext(w)
+	ctx.Request = httptest.NewRequest(http.MethodPost, "/api/words/"+id+"/merge", strings.NewReader(body))
+	ctx.Params = gin.Params{gin.Param{Key: "id", Value: id}}
+	suite.controller.MergeWords(ctx)
+	return w
+}
+
+// TestMergeWords tests that the sources are merged and the merged word is
+// returned with what was moved to it
+func (suite *ControllerTestSuite) TestMergeWords() {
+	suite.mockWordPeer.EXPECT().
+		MergeWords(int64(1), []int64{2, 3}).
+		Return(&peers.MergedWord{WordID: 1, DefinitionsMoved: 2, DefinitionsSkipped: 1, LogsMoved: 4, LinksMoved: 1, RemindersMoved: 1}, nil).Times(1)
+	suite.mockWordPeer.EXPECT().
+		Select(mock.Anything, squirrel.Eq{schema.WORD_ID: 1}, mock.Anything, mock.Anything, mock.Anything).
+		Return([]*dbModels.Word{{Id: utils.IntPtr(1), Word: utils.StrPtr("run"), CountPractise: utils.IntPtr(6)}}, nil).Times(1)
+	suite.mockWordDefinitionPeer.EXPECT().
+		Select(mock.Anything, squirrel.Eq{schema.WORD_DEFINITIONS_WORD_ID: []int{1}}, mock.Anything, mock.Anything, mock.Anything).
+		Return([]*dbModels.WordDefinition{}, nil).Times(1)
+
+	w := suite.postMerge("1", `{"source_ids":[2,3]}`)
+
+	suite.Require().Equal(http.StatusOK, w.Code, w.Body.String())
+	var result models.WordMergeResult
+	suite.Require().NoError(json.Unmarshal(w.Body.Bytes(), &result))
+	suite.Equal(1, *result.Word.ID)
+	suite.Equal(6, *result.Word.CountPractise)
+	suite.Equal([]int{2, 3}, result.MergedIDs)
+	suite.Equal(2, result.DefinitionsMoved)
+	suite.Equal(1, result.DefinitionsSkipped)
+	suite.Equal(4, result.LogsMoved)
+	suite.Equal(1, result.LinksMoved)
+	suite.Equal(1, result.RemindersMoved)
+}
+
+// TestMergeWordsValidation tests that bad requests are rejected before
+// anything is merged
+func (suite *ControllerTestSuite) TestMergeWordsValidation() {
+	tests := []struct {
+		name string
+		id   string
+		body string
+	}{
+		{"invalid word ID", "abc", `{"source_ids":[2]}`},
+		{"invalid JSON", "1", `{"source_ids":`},
+		{"no sources", "1", `{"source_ids":[]}`},
+		{"too many sources", "1", fmt.Sprintf(`{"source_ids":[%s2]}`, strings.Repeat("2,", maxMergeSources))},
+		{"invalid source", "1", `{"source_ids":[0]}`},
+		{"source is the target", "1", `{"source_ids":[2,1]}`},
+		{"repeated source", "1", `{"source_ids":[2,2]}`},
+	}
+
+	for _, tt := range tests {
+		suite.Run(tt.name, func() {
+			suite.Equal(http.StatusBadRequest, suite.postMerge(tt.id, tt.body).Code)
+		})
+	}
+}
+
+// TestMergeWordsErrors tests how a missing word and database failures are reported
+func (suite *ControllerTestSuite) TestMergeWordsErrors() {
+	suite.Run("word not found", func() {
+		suite.mockWordPeer.EXPECT().MergeWords(mock.Anything, mock.Anything).
+			Return(nil, fmt.Errorf("%w: %d", peers.ErrWordNotFound, 2)).Once()
+
+		suite.Equal(http.StatusNotFound, suite.postMerge("1", `{"source_ids":[2]}`).Code)
+	})
+
+	suite.Run("merge failure", func() {
+		suite.mockWordPeer.EXPECT().MergeWords(mock.Anything, mock.Anything).
+			Return(nil, errors.New("db error")).Once()
+
+		suite.Equal(http.StatusInternalServerError, suite.postMerge("1", `{"source_ids":[2]}`).Code)
+	})
+
+	suite.Run("fetch failure", func() {
+		suite.mockWordPeer.EXPECT().MergeWords(mock.Anything, mock.Anything).
+			Return(&peers.MergedWord{WordID: 1}, nil).Once()
+		suite.mockWordPeer.EXPECT().Select(mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+			Return(nil, errors.New("db error")).Once()
+
+		suite.Equal(http.StatusInternalServerError, suite.postMerge("1", `{"source_ids":[2]}`).Code)
+	})
+}
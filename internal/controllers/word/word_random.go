@@ -9,7 +9,9 @@ import (
 )
 
 // RandomWords @Summary Get random words weighted by familiarity and practice recency
-// @Description Get random words for a quiz, weighted by familiarity ratio (familiarity_levels) or exact quota (per_category_counts); prioritizes never-practiced then longest-idle words
+// @Description Get random words for a quiz, weighted by familiarity ratio (familiarity_levels) or exact quota (per_category_counts);
+// @Description prioritizes never-practiced then longest-idle words; cefr_levels limits the draw to words with a definition at those
+// @Description levels
 // @Tags words
 // @Accept json
 // @Produce json
@@ -20,38 +22,20 @@ import (
 // @Router /api/words/random [post]
 func (wc *Controller) RandomWords(c *gin.Context) {
 	// ============== 1. Get random request from body ================
-	var randomReq models.WordRandomRequest
-	err := common.ParseRequestBody(&randomReq, c)
+	draw, err := wc.parseRandomWordRequest(c)
 	if err != nil {
-		common.ResponseError(http.StatusBadRequest, "Invalid request body", models.ErrCodeInvalidRequest, err, c)
+		common.RespondInvalidBody(err, c)
 		return
 	}
 
-	// Validate count parameter
-	if randomReq.Count <= 0 || randomReq.Count > 1000 {
-		common.ResponseError(http.StatusBadRequest, "Count must be between 1 and 1000", models.ErrCodeValidationError, nil, c)
-		return
-	}
-
-	// ================ 2. Determine per-level quotas ================
-	var quotas map[string]int
-	if len(randomReq.PerCategoryCounts) > 0 {
-		quotas = randomReq.PerCategoryCounts
-	} else if len(randomReq.FamiliarityLevels) > 0 {
-		quotas = computeLevelQuotas(randomReq.Count, randomReq.FamiliarityLevels)
-	} else {
-		common.ResponseError(http.StatusBadRequest, "Either familiarity_levels or per_category_counts is required", models.ErrCodeValidationError, nil, c)
-		return
-	}
-
-	// ================ 3. Fetch weighted random words ================
-	words, err := wc.fetchRandomWordsWeighted(quotas)
+	// ================ 2. Fetch weighted random words ================
+	words, err := wc.drawRandomWords(draw)
 	if err != nil {
 		common.ResponseError(http.StatusInternalServerError, "Failed to fetch data from database", models.ErrCodeInternalError, err, c)
 		return
 	}
 
-	// ================ 4. Attach definitions and build response ================
+	// ================ 3. Attach definitions and build response ================
 	wordsDefs, err := wc.fetchWordDefinitionsForWords(words)
 	if err != nil {
 		common.ResponseError(http.StatusInternalServerError, "Failed to fetch data from database", models.ErrCodeInternalError, err, c)
@@ -62,6 +46,6 @@ func (wc *Controller) RandomWords(c *gin.Context) {
 		wordEntities = []*models.Word{}
 	}
 
-	// ================ 5. Send response ================
+	// ================ 4. Send response ================
 	common.ResponseSuccess(http.StatusOK, wordEntities, c)
 }
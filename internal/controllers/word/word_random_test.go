@@ -6,7 +6,9 @@ import (
 	"io"
 	"net/http"
 	"net/http/httptest"
+	"slices"
 	"sort"
+	"strings"
 
 	dbModels "word-flashcard/data/models"
 	"word-flashcard/data/schema"
@@ -25,7 +27,7 @@ import (
 func (suite *ControllerTestSuite) TestRandomWords() {
 	// Mock wordPeer & wordDefinitionPeer methods as needed
 	whereWord := squirrel.And{
-		squirrel.Eq{schema.WORD_FAMILIARITY: "yellow"},
+		squirrel.Eq{schema.WORD_FAMILIARITY: "yellow", schema.WORD_IS_SUSPENDED: false},
 		squirrel.Or{
 			squirrel.Eq{schema.WORD_COUNT_PRACTISE: 0},
 			squirrel.Eq{schema.WORD_LAST_PRACTISED_AT: nil},
@@ -76,7 +78,7 @@ func (suite *ControllerTestSuite) TestRandomWords() {
 // caller supplies exact per-level quotas directly instead of familiarity_levels.
 func (suite *ControllerTestSuite) TestRandomWordsWithPerCategoryCounts() {
 	whereWord := squirrel.And{
-		squirrel.Eq{schema.WORD_FAMILIARITY: "green"},
+		squirrel.Eq{schema.WORD_FAMILIARITY: "green", schema.WORD_IS_SUSPENDED: false},
 		squirrel.Or{
 			squirrel.Eq{schema.WORD_COUNT_PRACTISE: 0},
 			squirrel.Eq{schema.WORD_LAST_PRACTISED_AT: nil},
@@ -118,3 +120,42 @@ func (suite *ControllerTestSuite) TestRandomWordsMissingLevelsOrCounts() {
 
 	assert.Equal(suite.T(), http.StatusBadRequest, w.Code)
 }
+
+// TestRandomWordsWithCEFRLevels tests that cefr_levels limits the draw to
+// words with a definition at one of those levels
+func (suite *ControllerTestSuite) TestRandomWordsWithCEFRLevels() {
+	scopedToLevels := mock.MatchedBy(func(where squirrel.And) bool {
+		sql, args, err := where.ToSql()
+		return err == nil &&
+			strings.Contains(sql, "familiarity = ?") &&
+			strings.Contains(sql, "id IN (SELECT word_id FROM word_definitions WHERE cefr_level IN (?,?))") &&
+			slices.Contains(args, any("A1")) && slices.Contains(args, any("A2"))
+	})
+
+	limitPtr := uint64(1)
+	suite.mockWordPeer.EXPECT().
+		Select(mock.Anything, scopedToLevels, mock.Anything, &limitPtr, (*uint64)(nil)).
+		Return([]*dbModels.Word{getSampleWords()[0]}, nil).Times(1)
+	suite.mockWordDefinitionPeer.EXPECT().
+		Select(mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+		Return([]*dbModels.WordDefinition{getSampleWordDefinitions()[0]}, nil).Times(1)
+
+	w := httptest.NewRecorder()
+	ctx, _ := gin.CreateTestContext(w)
+	requestBody := `{"count": 1, "per_category_counts": {"green": 1}, "cefr_levels": ["A1", "A2"]}`
+	ctx.Request = httptest.NewRequest(http.MethodPost, "/api/words/random", io.NopCloser(bytes.NewReader([]byte(requestBody))))
+	suite.controller.RandomWords(ctx)
+
+	assert.Equal(suite.T(), http.StatusOK, w.Code)
+}
+
+// TestRandomWordsUnknownCEFRLevel tests that RandomWords rejects an unknown CEFR level
+func (suite *ControllerTestSuite) TestRandomWordsUnknownCEFRLevel() {
+	w := httptest.NewRecorder()
+	ctx, _ := gin.CreateTestContext(w)
+	requestBody := `{"count": 1, "familiarity_levels": ["red"], "cefr_levels": ["A0"]}`
+	ctx.Request = httptest.NewRequest(http.MethodPost, "/api/words/random", io.NopCloser(bytes.NewReader([]byte(requestBody))))
+	suite.controller.RandomWords(ctx)
+
+	assert.Equal(suite.T(), http.StatusBadRequest, w.Code)
+}
@@ -0,0 +1,79 @@
+package word
+
+import (
+	"fmt"
+	"net/http"
+	"time"
+	"word-flashcard/data/schema"
+	"word-flashcard/internal/controllers/common"
+	"word-flashcard/internal/models"
+
+	"github.com/Masterminds/squirrel"
+	"github.com/gin-gonic/gin"
+)
+
+// maxRetentionWords caps the limit query parameter of the retention endpoint
+const maxRetentionWords = 1000
+
+// GetWordsRetention @Summary Get retention and forgetting-curve analytics
+// @Description Measures how well words are recalled as a function of the time since their previous review, fits exponential forgetting
+// @Description curves (overall and per familiarity level) to those reviews, counts familiarity transitions of self-reported reviews,
+// @Description and estimates each practiced word's probability of being recalled now, least likely first. A review counts as recalled
+// @Description when a graded answer was correct, or a self-report kept or raised familiarity without landing on red.
+// @Tags words
+// @Produce json
+// @Param days query int false "Only analyze reviews from the last N days (default: 90, max: 3650)"
+// @Param limit query int false "Number of words to estimate recall for, least likely first (default: 50, max: 1000)"
+// @Success 200 {object} models.WordRetentionReport "Retention analytics"
+// @Failure 400 {object} models.ErrorResponse "Bad request - Invalid days or limit parameter"
+// @Failure 500 {object} models.ErrorResponse "Internal server error - Failed to fetch data from database"
+// @Router /api/words/retention [get]
+func (wc *Controller) GetWordsRetention(c *gin.Context) {
+	// ================ 1. Parse query parameters ================
+	days, err := common.ParseIntQueryParam(c, "days", 90)
+	if err != nil || days < 1 || days > common.MaxTrendDays {
+		common.ResponseError(http.StatusBadRequest, "Invalid days parameter", models.ErrCodeInvalidRequest, err, c)
+		return
+	}
+	limit, err := common.ParseIntQueryParam(c, "limit", 50)
+	if err != nil || limit < 1 || limit > maxRetentionWords {
+		common.ResponseError(http.StatusBadRequest, "Invalid limit parameter", models.ErrCodeInvalidRequest, err, c)
+		return
+	}
+
+	now := time.Now().UTC()
+	since := now.AddDate(0, 0, -days)
+
+	// ================ 2. Fetch practice history ================
+	logOrder := fmt.Sprintf("%s ASC, %s ASC, %s ASC", schema.WORD_PRACTICE_LOG_WORD_ID, schema.COMMON_CREATED_AT, schema.WORD_PRACTICE_LOG_ID)
+	logs, err := wc.wordPracticeLogPeer.Select(retentionLogColumns(), retentionLogWhere(since), []*string{&logOrder}, nil, nil)
+	if err != nil {
+		common.ResponseError(http.StatusInternalServerError, "Failed to fetch data from database", models.ErrCodeInternalError, err, c)
+		return
+	}
+
+	transitions, err := wc.wordPracticeLogPeer.TransitionCounts(since)
+	if err != nil {
+		common.ResponseError(http.StatusInternalServerError, "Failed to fetch data from database", models.ErrCodeInternalError, err, c)
+		return
+	}
+
+	words, err := wc.wordPeer.Select(retentionWordColumns(), squirrel.NotEq{schema.WORD_LAST_PRACTISED_AT: nil}, nil, nil, nil)
+	if err != nil {
+		common.ResponseError(http.StatusInternalServerError, "Failed to fetch data from database", models.ErrCodeInternalError, err, c)
+		return
+	}
+
+	// ================ 3. Fit forgetting curves & send response ================
+	reviews := buildRetentionReviews(logs, since)
+	curves, stabilities := buildForgettingCurves(reviews)
+
+	common.ResponseSuccess(http.StatusOK, models.WordRetentionReport{
+		PeriodDays:  days,
+		ReviewCount: len(reviews),
+		Intervals:   buildRetentionIntervals(reviews, stabilities[retentionCurveAll]),
+		Curves:      curves,
+		Transitions: buildFamiliarityTransitions(transitions),
+		Words:       estimateWordRecall(words, stabilities, now, limit),
+	}, c)
+}
@@ -0,0 +1,100 @@
+package word
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"time"
+
+	dbModels "word-flashcard/data/models"
+	"word-flashcard/data/schema"
+	"word-flashcard/internal/models"
+	"word-flashcard/utils"
+
+	"github.com/Masterminds/squirrel"
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+// TestGetWordsRetention tests that GetWordsRetention pairs logs into
+// reviews, reports transitions, and estimates recall for practiced words
+func (suite *ControllerTestSuite) TestGetWordsRetention() {
+	now := time.Now().UTC()
+	first, second := now.AddDate(0, 0, -3), now.AddDate(0, 0, -1)
+	logs := []*dbModels.WordPracticeLog{
+		{WordId: utils.IntPtr(1), PreviousFamiliarity: utils.StrPtr("red"), Familiarity: utils.StrPtr("yellow"), CreatedAt: &first},
+		{WordId: utils.IntPtr(1), PreviousFamiliarity: utils.StrPtr("yellow"), Familiarity: utils.StrPtr("green"), CreatedAt: &second},
+	}
+	transitions := []*dbModels.WordFamiliarityTransitionCount{
+		{PreviousFamiliarity: utils.StrPtr("red"), Familiarity: utils.StrPtr("yellow"), TransitionCount: utils.IntPtr(1)},
+		{PreviousFamiliarity: utils.StrPtr("yellow"), Familiarity: utils.StrPtr("green"), TransitionCount: utils.IntPtr(1)},
+	}
+	words := []*dbModels.Word{
+		{Id: utils.IntPtr(1), Word: utils.StrPtr("apple"), Familiarity: utils.StrPtr("green"), LastPracticedAt: &second},
+	}
+
+	suite.mockWordPracticeLogPeer.EXPECT().
+		Select(mock.Anything, mock.MatchedBy(func(where squirrel.Sqlizer) bool {
+			sql, args, err := where.ToSql()
+			return err == nil && strings.Contains(sql, "created_at >= ?") && len(args) == 2
+		}), mock.Anything, mock.Anything, mock.Anything).
+		Return(logs, nil).Times(1)
+	suite.mockWordPracticeLogPeer.EXPECT().
+		TransitionCounts(mock.MatchedBy(func(since time.Time) bool {
+			return since.After(now.AddDate(0, 0, -31)) && since.Before(now.AddDate(0, 0, -29))
+		})).
+		Return(transitions, nil).Times(1)
+	suite.mockWordPeer.EXPECT().
+		Select(mock.Anything, squirrel.NotEq{schema.WORD_LAST_PRACTISED_AT: nil}, mock.Anything, mock.Anything, mock.Anything).
+		Return(words, nil).Times(1)
+
+	w := httptest.NewRecorder()
+	ctx, _ := gin.CreateTestContext(w)
+	ctx.Request = httptest.NewRequest(http.MethodGet, "/api/words/retention?days=30", nil)
+	suite.controller.GetWordsRetention(ctx)
+
+	assert.Equal(suite.T(), http.StatusOK, w.Code)
+	var report models.WordRetentionReport
+	suite.Require().NoError(json.Unmarshal(w.Body.Bytes(), &report))
+	assert.Equal(suite.T(), 30, report.PeriodDays)
+	assert.Equal(suite.T(), 1, report.ReviewCount)
+	assert.Equal(suite.T(), 1, report.Intervals[2].ReviewCount, "the review came 2 days after the previous one")
+	assert.Len(suite.T(), report.Curves, 4)
+	assert.Equal(suite.T(), 1, report.Transitions[0].Yellow)
+	assert.Equal(suite.T(), 1, report.Transitions[1].Green)
+	suite.Require().Len(report.Words, 1)
+	assert.Equal(suite.T(), "apple", report.Words[0].Word)
+	assert.Equal(suite.T(), 86.7, report.Words[0].RecallProbability, "1 day against green's default 7-day stability")
+}
+
+// TestGetWordsRetentionInvalidParams tests that out-of-range days and limit return 400
+func (suite *ControllerTestSuite) TestGetWordsRetentionInvalidParams() {
+	for _, query := range []string{"days=0", "days=3651", "limit=0", "limit=1001", "limit=abc"} {
+		w := httptest.NewRecorder()
+		ctx, _ := gin.CreateTestContext(w)
+		ctx.Request = httptest.NewRequest(http.MethodGet, "/api/words/retention?"+query, nil)
+		suite.controller.GetWordsRetention(ctx)
+
+		assert.Equal(suite.T(), http.StatusBadRequest, w.Code, query)
+	}
+}
+
+// TestGetWordsRetentionDatabaseError tests that a failed query returns 500
+func (suite *ControllerTestSuite) TestGetWordsRetentionDatabaseError() {
+	suite.mockWordPracticeLogPeer.EXPECT().
+		Select(mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+		Return([]*dbModels.WordPracticeLog{}, nil).Times(1)
+	suite.mockWordPracticeLogPeer.EXPECT().
+		TransitionCounts(mock.Anything).
+		Return(nil, errors.New("db error")).Times(1)
+
+	w := httptest.NewRecorder()
+	ctx, _ := gin.CreateTestContext(w)
+	ctx.Request = httptest.NewRequest(http.MethodGet, "/api/words/retention", nil)
+	suite.controller.GetWordsRetention(ctx)
+
+	assert.Equal(suite.T(), http.StatusInternalServerError, w.Code)
+}
@@ -0,0 +1,83 @@
+package word
+
+import (
+	"net/http"
+	"time"
+	"word-flashcard/data/schema"
+	"word-flashcard/internal/controllers/common"
+	"word-flashcard/internal/models"
+
+	"github.com/Masterminds/squirrel"
+	"github.com/gin-gonic/gin"
+)
+
+// maxSpeedWords caps the limit query parameter of the speed endpoint
+const maxSpeedWords = 1000
+
+// GetWordsSpeed @Summary Get answer-time analytics for words
+// @Description Summarizes how long timed quiz answers took, overall and per word: answer count and the median, 75th and 90th percentile
+// @Description in milliseconds, slowest median first. A word is slow_but_correct when its median correct answer time, over at least two
+// @Description timed correct answers, is in the slowest quarter of all correct answers. Only answers sent with response_ms count.
+// @Tags words
+// @Produce json
+// @Param days query int false "Only include answers from the last N days (default: 90, max: 3650)"
+// @Param limit query int false "Number of words to list, slowest first (default: 50, max: 1000)"
+// @Success 200 {object} models.SpeedReport "Answer-time analytics"
+// @Failure 400 {object} models.ErrorResponse "Bad request - Invalid days or limit parameter"
+// @Failure 500 {object} models.ErrorResponse "Internal server error - Failed to fetch data from database"
+// @Router /api/words/speed [get]
+func (wc *Controller) GetWordsSpeed(c *gin.Context) {
+	// ================ 1. Parse query parameters ================
+	days, err := common.ParseIntQueryParam(c, "days", common.DefaultSpeedDays)
+	if err != nil || days < 1 || days > common.MaxTrendDays {
+		common.ResponseError(http.StatusBadRequest, "Invalid days parameter", models.ErrCodeInvalidRequest, err, c)
+		return
+	}
+	limit, err := common.ParseIntQueryParam(c, "limit", 50)
+	if err != nil || limit < 1 || limit > maxSpeedWords {
+		common.ResponseError(http.StatusBadRequest, "Invalid limit parameter", models.ErrCodeInvalidRequest, err, c)
+		return
+	}
+
+	// ================ 2. Fetch timed answers ================
+	answers, err := wc.fetchTimedWordAnswers(days, time.Now().UTC())
+	if err != nil {
+		common.ResponseError(http.StatusInternalServerError, "Failed to fetch data from database", models.ErrCodeInternalError, err, c)
+		return
+	}
+
+	items := common.ItemSpeeds(answers)
+	if len(items) > limit {
+		items = items[:limit]
+	}
+
+	// ================ 3. Fetch word text ================
+	if len(items) > 0 {
+		ids := make([]int, len(items))
+		for i, item := range items {
+			ids[i] = item.ID
+		}
+		columns := []string{schema.WORD_ID, schema.WORD_WORD}
+		words, err := wc.wordPeer.Select([]*string{&columns[0], &columns[1]}, squirrel.Eq{schema.WORD_ID: ids}, nil, nil, nil)
+		if err != nil {
+			common.ResponseError(http.StatusInternalServerError, "Failed to fetch data from database", models.ErrCodeInternalError, err, c)
+			return
+		}
+		text := make(map[int]string, len(words))
+		for _, w := range words {
+			if w.Id != nil && w.Word != nil {
+				text[*w.Id] = *w.Word
+			}
+		}
+		for i := range items {
+			items[i].Text = text[items[i].ID]
+		}
+	}
+
+	// ================ 4. Send response ================
+	common.ResponseSuccess(http.StatusOK, models.SpeedReport{
+		Days:    days,
+		Overall: common.SummarizeAnswers(answers),
+		Items:   items,
+	}, c)
+}
@@ -0,0 +1,70 @@
+package word
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+
+	dbModels "word-flashcard/data/models"
+	"word-flashcard/data/schema"
+	"word-flashcard/internal/models"
+	"word-flashcard/utils"
+
+	"github.com/Masterminds/squirrel"
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+// TestGetWordsSpeed tests the GetWordsSpeed handler lists words slowest
+// first and flags the ones answered correctly but slowly
+func (suite *ControllerTestSuite) TestGetWordsSpeed() {
+	red, green := schema.WORD_FAMILIARITY_RED, schema.WORD_FAMILIARITY_GREEN
+	suite.mockWordPracticeLogPeer.EXPECT().
+		Select(mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+		Return([]*dbModels.WordPracticeLog{
+			{WordId: utils.IntPtr(1), IsCorrect: utils.BoolPtr(true), ResponseMs: utils.IntPtr(1000)},
+			{WordId: utils.IntPtr(1), IsCorrect: utils.BoolPtr(true), ResponseMs: utils.IntPtr(1200)},
+			{WordId: utils.IntPtr(2), IsCorrect: utils.BoolPtr(true), ResponseMs: utils.IntPtr(5000)},
+			{WordId: utils.IntPtr(2), IsCorrect: utils.BoolPtr(true), ResponseMs: utils.IntPtr(6000)},
+			// A self-reported lapse to red is not a correct answer
+			{WordId: utils.IntPtr(3), PreviousFamiliarity: &green, Familiarity: &red, ResponseMs: utils.IntPtr(9000)},
+		}, nil).Times(1)
+	suite.mockWordPeer.EXPECT().
+		Select(mock.Anything, squirrel.Eq{schema.WORD_ID: []int{3, 2}}, mock.Anything, mock.Anything, mock.Anything).
+		Return([]*dbModels.Word{
+			{Id: utils.IntPtr(2), Word: utils.StrPtr("ephemeral")},
+			{Id: utils.IntPtr(3), Word: utils.StrPtr("ubiquitous")},
+		}, nil).Times(1)
+
+	w := httptest.NewRecorder()
+	ctx, _ := gin.CreateTestContext(w)
+	ctx.Request = httptest.NewRequest(http.MethodGet, "/api/words/speed?limit=2", nil)
+	suite.controller.GetWordsSpeed(ctx)
+
+	assert.Equal(suite.T(), http.StatusOK, w.Code)
+	var report models.SpeedReport
+	assert.NoError(suite.T(), json.Unmarshal(w.Body.Bytes(), &report))
+	assert.Equal(suite.T(), 90, report.Days)
+	assert.Equal(suite.T(), 5, report.Overall.AnswerCount)
+	assert.Equal(suite.T(), 5000, *report.Overall.MedianMs)
+	if assert.Len(suite.T(), report.Items, 2) {
+		assert.Equal(suite.T(), "ubiquitous", report.Items[0].Text)
+		assert.Equal(suite.T(), 0, report.Items[0].CorrectCount)
+		assert.False(suite.T(), report.Items[0].SlowButCorrect)
+		assert.Equal(suite.T(), "ephemeral", report.Items[1].Text)
+		assert.Equal(suite.T(), 5500, *report.Items[1].MedianMs)
+		assert.True(suite.T(), report.Items[1].SlowButCorrect)
+	}
+}
+
+// TestGetWordsSpeedInvalidLimit tests the GetWordsSpeed handler rejects an
+// out-of-range limit
+func (suite *ControllerTestSuite) TestGetWordsSpeedInvalidLimit() {
+	w := httptest.NewRecorder()
+	ctx, _ := gin.CreateTestContext(w)
+	ctx.Request = httptest.NewRequest(http.MethodGet, "/api/words/speed?limit=0", nil)
+	suite.controller.GetWordsSpeed(ctx)
+
+	assert.Equal(suite.T(), http.StatusBadRequest, w.Code)
+}
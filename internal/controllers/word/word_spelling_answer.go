@@ -0,0 +1,25 @@
+package word
+
+import (
+	"word-flashcard/data/schema"
+	"word-flashcard/internal/controllers/common"
+
+	"github.com/gin-gonic/gin"
+)
+
+// AnswerSpelling @Summary Grade a typed spelling answer
+// @Description Grade the learner's typed answer for a word server-side (case/diacritic-insensitive, accepting hyphenation and
+// @Description British/American variants), report near misses by edit distance, and record the attempt in the word's practice log
+// @Tags words
+// @Accept json
+// @Produce json
+// @Param id path int true "Word ID"
+// @Param answer body models.TypedAnswerRequest true "Typed answer"
+// @Success 200 {object} models.TypedAnswerResult "Answer graded successfully"
+// @Failure 400 {object} models.ErrorResponse "Bad request - Invalid word ID or request body"
+// @Failure 404 {object} models.ErrorResponse "Not found - Word not found"
+// @Failure 500 {object} models.ErrorResponse "Internal server error - Failed to read or write data in database"
+// @Router /api/words/{id}/spelling [post]
+func (wc *Controller) AnswerSpelling(c *gin.Context) {
+	wc.answerTypedPrompt(c, schema.WORD_PRACTICE_MODE_SPELLING, common.AcceptedSpellings)
+}
@@ -0,0 +1,187 @@
+package word
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"time"
+
+	dbModels "word-flashcard/data/models"
+	"word-flashcard/data/schema"
+	"word-flashcard/internal/models"
+	"word-flashcard/utils"
+
+	"github.com/Masterminds/squirrel"
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+// callAnswerSpelling runs the AnswerSpelling handler for word 1 with the given body
+func (suite *ControllerTestSuite) callAnswerSpelling(requestBody string) *httptest.ResponseRecorder {
+	w := httptest.NewRecorder()
+	ctx, _ := gin.CreateTestContext(w)
+	ctx.Request = httptest.NewRequest(http.MethodPost, "/api/words/1/spelling", io.NopCloser(bytes.NewReader([]byte(requestBody))))
+	ctx.Params = gin.Params{gin.Param{Key: "id", Value: "1"}}
+	suite.controller.AnswerSpelling(ctx)
+	return w
+}
+
+// TestAnswerSpelling tests grading outcomes and that each attempt increments
+// count_practise and is logged with the typed answer and correctness flag.
+func (suite *ControllerTestSuite) TestAnswerSpelling() {
+	testCases := []struct {
+		name      string
+		answer    string
+		result    string
+		isCorrect bool
+		distance  int
+	}{
+		{"correct ignoring case", "Apple", "correct", true, 0},
+		{"near miss", "appel", "near_miss", false, 1},
+		{"incorrect", "pear", "incorrect", false, 4},
+	}
+
+	whereWord := squirrel.Eq{schema.WORD_ID: 1}
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+			word := getSampleWords()[0]
+			word.CountPractise = utils.IntPtr(3)
+
+			suite.mockWordPeer.EXPECT().
+				Select(mock.Anything, whereWord, mock.Anything, mock.Anything, mock.Anything).
+				Return([]*dbModels.Word{word}, nil).Once()
+			suite.mockWordPeer.EXPECT().
+				Update(mock.MatchedBy(func(w *dbModels.Word) bool {
+					return w.CountPractise != nil && *w.CountPractise == 4 &&
+						w.LastPracticedAt != nil && time.Since(*w.LastPracticedAt) < time.Minute &&
+						w.Familiarity == nil
+				}), whereWord).
+				Return(int64(1), nil).Once()
+			suite.mockWordPracticeLogPeer.EXPECT().
+				Insert(mock.MatchedBy(func(l *dbModels.WordPracticeLog) bool {
+					return *l.WordId == 1 && *l.Mode == schema.WORD_PRACTICE_MODE_SPELLING &&
+						*l.TypedAnswer == tc.answer && *l.IsCorrect == tc.isCorrect &&
+						*l.Familiarity == "green" && *l.PreviousFamiliarity == "green"
+				})).
+				Return(int64(1), nil).Once()
+
+			w := suite.callAnswerSpelling("{\"answer\": \"" + tc.answer + "\"}")
+
+			assert.Equal(suite.T(), http.StatusOK, w.Code)
+			var result models.TypedAnswerResult
+			assert.NoError(suite.T(), json.Unmarshal(w.Body.Bytes(), &result))
+			assert.Equal(suite.T(), models.TypedAnswerResult{
+				WordID:    1,
+				Word:      "apple",
+				Answer:    tc.answer,
+				Result:    tc.result,
+				IsCorrect: tc.isCorrect,
+				Distance:  tc.distance,
+			}, result)
+		})
+	}
+}
+
+// TestAnswerSpellingResubmission tests that a second answer in the same quiz
+// session corrects the existing log row instead of counting another practice.
+func (suite *ControllerTestSuite) TestAnswerSpellingResubmission() {
+	whereWord := squirrel.Eq{schema.WORD_ID: 1}
+	logWhere := squirrel.Eq{
+		schema.WORD_PRACTICE_LOG_WORD_ID:         1,
+		schema.WORD_PRACTICE_LOG_QUIZ_SESSION_ID: "session-1",
+		schema.WORD_PRACTICE_LOG_MODE:            schema.WORD_PRACTICE_MODE_SPELLING,
+	}
+
+	suite.mockWordPeer.EXPECT().
+		Select(mock.Anything, whereWord, mock.Anything, mock.Anything, mock.Anything).
+		Return([]*dbModels.Word{getSampleWords()[0]}, nil).Once()
+	suite.mockWordPracticeLogPeer.EXPECT().
+		Select(mock.Anything, logWhere, mock.Anything, mock.Anything, mock.Anything).
+		Return([]*dbModels.WordPracticeLog{{Id: utils.IntPtr(9)}}, nil).Once()
+	suite.mockWordPracticeLogPeer.EXPECT().
+		Update(mock.MatchedBy(func(l *dbModels.WordPracticeLog) bool {
+			return *l.TypedAnswer == "apple" && *l.IsCorrect && l.Familiarity == nil
+		}), squirrel.Eq{schema.WORD_PRACTICE_LOG_ID: 9}).
+		Return(int64(1), nil).Once()
+
+	w := suite.callAnswerSpelling("{\"answer\": \"apple\", \"quiz_session_id\": \"session-1\"}")
+
+	assert.Equal(suite.T(), http.StatusOK, w.Code)
+	assert.Contains(suite.T(), w.Body.String(), "\"result\":\"correct\"")
+}
+
+// TestAnswerSpellingAfterOtherModeInSession tests that a spelling answer in a
+// session where the word was only practised in another mode counts as a new
+// practice, since the session lookup matches on mode too.
+func (suite *ControllerTestSuite) TestAnswerSpellingAfterOtherModeInSession() {
+	whereWord := squirrel.Eq{schema.WORD_ID: 1}
+	logWhere := squirrel.Eq{
+		schema.WORD_PRACTICE_LOG_WORD_ID:         1,
+		schema.WORD_PRACTICE_LOG_QUIZ_SESSION_ID: "session-1",
+		schema.WORD_PRACTICE_LOG_MODE:            schema.WORD_PRACTICE_MODE_SPELLING,
+	}
+
+	suite.mockWordPeer.EXPECT().
+		Select(mock.Anything, whereWord, mock.Anything, mock.Anything, mock.Anything).
+		Return([]*dbModels.Word{getSampleWords()[0]}, nil).Once()
+	suite.mockWordPracticeLogPeer.EXPECT().
+		Select(mock.Anything, logWhere, mock.Anything, mock.Anything, mock.Anything).
+		Return([]*dbModels.WordPracticeLog{}, nil).Once()
+	suite.mockWordPeer.EXPECT().
+		Update(mock.Anything, whereWord).
+		Return(int64(1), nil).Once()
+	suite.mockWordPracticeLogPeer.EXPECT().
+		Insert(mock.MatchedBy(func(l *dbModels.WordPracticeLog) bool {
+			return *l.Mode == schema.WORD_PRACTICE_MODE_SPELLING && *l.QuizSessionID == "session-1"
+		})).
+		Return(int64(2), nil).Once()
+
+	w := suite.callAnswerSpelling("{\"answer\": \"apple\", \"quiz_session_id\": \"session-1\"}")
+
+	assert.Equal(suite.T(), http.StatusOK, w.Code)
+}
+
+// TestAnswerSpellingErrors tests the error responses of AnswerSpelling
+func (suite *ControllerTestSuite) TestAnswerSpellingErrors() {
+	whereWord := squirrel.Eq{schema.WORD_ID: 1}
+
+	suite.Run("invalid body", func() {
+		suite.SetupTest()
+		w := suite.callAnswerSpelling("{")
+		assert.Equal(suite.T(), http.StatusBadRequest, w.Code)
+	})
+
+	suite.Run("answer too long", func() {
+		suite.SetupTest()
+		w := suite.callAnswerSpelling("{\"answer\": \"" + strings.Repeat("a", 256) + "\"}")
+		assert.Equal(suite.T(), http.StatusBadRequest, w.Code)
+		assert.Contains(suite.T(), w.Body.String(), "answer is invalid")
+	})
+
+	suite.Run("word not found", func() {
+		suite.SetupTest()
+		suite.mockWordPeer.EXPECT().
+			Select(mock.Anything, whereWord, mock.Anything, mock.Anything, mock.Anything).
+			Return([]*dbModels.Word{}, nil).Once()
+		w := suite.callAnswerSpelling("{\"answer\": \"apple\"}")
+		assert.Equal(suite.T(), http.StatusNotFound, w.Code)
+	})
+
+	suite.Run("word update fails", func() {
+		suite.SetupTest()
+		suite.mockWordPeer.EXPECT().
+			Select(mock.Anything, whereWord, mock.Anything, mock.Anything, mock.Anything).
+			Return([]*dbModels.Word{getSampleWords()[0]}, nil).Once()
+		suite.mockWordPeer.EXPECT().
+			Update(mock.Anything, whereWord).
+			Return(int64(0), errors.New("update failed")).Once()
+		w := suite.callAnswerSpelling("{\"answer\": \"apple\"}")
+		assert.Equal(suite.T(), http.StatusInternalServerError, w.Code)
+	})
+}
@@ -0,0 +1,46 @@
+package word
+
+import (
+	"net/http"
+	"word-flashcard/internal/controllers/common"
+	"word-flashcard/internal/models"
+
+	"github.com/gin-gonic/gin"
+)
+
+// RandomSpellingPrompts @Summary Get random spelling quiz prompts
+// @Description Select words the same way as the familiarity quiz and return their definitions (with the word masked out) as typed-answer prompts. Words without a definition are skipped.
+// @Tags words
+// @Accept json
+// @Produce json
+// @Param randomRequest body models.WordRandomRequest true "Random request criteria including count and either familiarity_levels or per_category_counts"
+// @Success 200 {array} models.SpellingPrompt "Spelling prompts retrieved successfully"
+// @Failure 400 {object} models.ErrorResponse "Bad request - Invalid request body or count parameter"
+// @Failure 500 {object} models.ErrorResponse "Internal server error - Failed to fetch data from database"
+// @Router /api/words/spelling/random [post]
+func (wc *Controller) RandomSpellingPrompts(c *gin.Context) {
+	// ============== 1. Get random request from body ================
+	draw, err := wc.parseRandomWordRequest(c)
+	if err != nil {
+		common.RespondInvalidBody(err, c)
+		return
+	}
+
+	// ================ 2. Fetch weighted random words ================
+	words, err := wc.drawRandomWords(draw)
+	if err != nil {
+		common.ResponseError(http.StatusInternalServerError, "Failed to fetch data from database", models.ErrCodeInternalError, err, c)
+		return
+	}
+
+	// ================ 3. Build masked prompts ================
+	wordsDefs, err := wc.fetchWordDefinitionsForWords(words)
+	if err != nil {
+		common.ResponseError(http.StatusInternalServerError, "Failed to fetch data from database", models.ErrCodeInternalError, err, c)
+		return
+	}
+	prompts := buildSpellingPrompts(words, wordsDefs)
+
+	// ================ 4. Send response ================
+	common.ResponseSuccess(http.StatusOK, prompts, c)
+}
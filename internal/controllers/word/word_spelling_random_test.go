@@ -0,0 +1,76 @@
+package word
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+
+	dbModels "word-flashcard/data/models"
+	"word-flashcard/data/schema"
+	"word-flashcard/internal/models"
+
+	"github.com/Masterminds/squirrel"
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+// TestRandomSpellingPrompts tests that RandomSpellingPrompts selects words like
+// RandomWords but returns masked definition prompts without the word itself.
+func (suite *ControllerTestSuite) TestRandomSpellingPrompts() {
+	whereWord := squirrel.And{
+		squirrel.Eq{schema.WORD_FAMILIARITY: "green", schema.WORD_IS_SUSPENDED: false},
+		squirrel.Or{
+			squirrel.Eq{schema.WORD_COUNT_PRACTISE: 0},
+			squirrel.Eq{schema.WORD_LAST_PRACTISED_AT: nil},
+		},
+	}
+	whereDefinitionID := squirrel.Eq{schema.WORD_DEFINITIONS_WORD_ID: []int{1}}
+
+	limitPtr := uint64(1)
+	suite.mockWordPeer.EXPECT().
+		Select(mock.Anything, whereWord, mock.Anything, &limitPtr, (*uint64)(nil)).
+		Return([]*dbModels.Word{getSampleWords()[0]}, nil).Once()
+	suite.mockWordDefinitionPeer.EXPECT().
+		Select(mock.Anything, whereDefinitionID, mock.Anything, mock.Anything, mock.Anything).
+		Return([]*dbModels.WordDefinition{getSampleWordDefinitions()[0]}, nil).Once()
+
+	w := httptest.NewRecorder()
+	ctx, _ := gin.CreateTestContext(w)
+	requestBody := "{\"count\": 1, \"per_category_counts\": {\"green\": 1}}"
+	ctx.Request = httptest.NewRequest(http.MethodPost, "/api/words/spelling/random", io.NopCloser(bytes.NewReader([]byte(requestBody))))
+	suite.controller.RandomSpellingPrompts(ctx)
+
+	assert.Equal(suite.T(), http.StatusOK, w.Code)
+	var prompts []models.SpellingPrompt
+	assert.NoError(suite.T(), json.Unmarshal(w.Body.Bytes(), &prompts))
+	assert.Len(suite.T(), prompts, 1)
+	assert.Equal(suite.T(), 1, prompts[0].WordID)
+	assert.Equal(suite.T(), 5, prompts[0].Length)
+	assert.NotContains(suite.T(), w.Body.String(), "apple")
+}
+
+// TestRandomSpellingPromptsInvalidRequest tests the request validation errors of RandomSpellingPrompts
+func (suite *ControllerTestSuite) TestRandomSpellingPromptsInvalidRequest() {
+	testCases := []struct {
+		name string
+		body string
+	}{
+		{"malformed JSON", "{"},
+		{"count out of range", "{\"count\": 0, \"familiarity_levels\": [\"red\"]}"},
+		{"no level selection", "{\"count\": 5}"},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			w := httptest.NewRecorder()
+			ctx, _ := gin.CreateTestContext(w)
+			ctx.Request = httptest.NewRequest(http.MethodPost, "/api/words/spelling/random", io.NopCloser(bytes.NewReader([]byte(tc.body))))
+			suite.controller.RandomSpellingPrompts(ctx)
+
+			assert.Equal(suite.T(), http.StatusBadRequest, w.Code)
+		})
+	}
+}
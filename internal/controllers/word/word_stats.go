@@ -2,6 +2,7 @@ package word
 
 import (
 	"net/http"
+	"time"
 	"word-flashcard/data/schema"
 	"word-flashcard/internal/controllers/common"
 	"word-flashcard/internal/models"
@@ -11,7 +12,7 @@ import (
 )
 
 // StatsWords @Summary Get word statistics
-// @Description Get word count distribution by familiarity level and practice count
+// @Description Get word count distribution by familiarity level and practice count, and the median and percentile answer times of timed quiz answers from the last 90 days
 // @Tags words
 // @Produce json
 // @Success 200 {object} models.WordStats "Word familiarity distribution"
@@ -35,25 +36,36 @@ func (wc *Controller) StatsWords(c *gin.Context) {
 		return
 	}
 
-	// ================ 2. Fetch practice counts for all words ================
-	cpCol := schema.WORD_COUNT_PRACTISE
-	words, err := wc.wordPeer.Select([]*string{&cpCol}, nil, nil, nil, nil)
+	// ================ 2. Count words per practice count ================
+	groups, err := wc.wordPeer.CountByPractiseCount()
 	if err != nil {
 		common.ResponseError(http.StatusInternalServerError, "Failed to fetch practice counts", models.ErrCodeInternalError, err, c)
 		return
 	}
 
-	counts := make([]int, len(words))
-	for i, w := range words {
-		if w.CountPractise != nil {
-			counts[i] = *w.CountPractise
+	frequencies := make(map[int]int, len(groups))
+	for _, g := range groups {
+		if g.WordCount == nil {
+			continue
 		}
+		countPractise := 0
+		if g.CountPractise != nil {
+			countPractise = *g.CountPractise
+		}
+		frequencies[countPractise] += *g.WordCount
 	}
 
 	// ================ 3. Bucket words by practice count ================
-	practiceBuckets := common.BuildPracticeCountBuckets(counts)
+	practiceBuckets := common.BuildPracticeCountBucketsFromFrequencies(frequencies)
+
+	// ================ 4. Summarize answer times ================
+	answers, err := wc.fetchTimedWordAnswers(common.DefaultSpeedDays, time.Now().UTC())
+	if err != nil {
+		common.ResponseError(http.StatusInternalServerError, "Failed to fetch practice logs", models.ErrCodeInternalError, err, c)
+		return
+	}
 
-	// ================ 4. Send response ================
+	// ================ 5. Send response ================
 	common.ResponseSuccess(http.StatusOK, models.WordStats{
 		FamiliarityDistribution: models.WordFamiliarityDistribution{
 			Red:    red,
@@ -61,5 +73,6 @@ func (wc *Controller) StatsWords(c *gin.Context) {
 			Green:  green,
 		},
 		PracticeCountDistribution: practiceBuckets,
+		ResponseTimes:             common.SummarizeAnswers(answers),
 	}, c)
 }
@@ -2,11 +2,14 @@ package word
 
 import (
 	"encoding/json"
+	"errors"
 	"net/http"
 	"net/http/httptest"
 
+	dbModels "word-flashcard/data/models"
 	"word-flashcard/data/schema"
 	"word-flashcard/internal/models"
+	"word-flashcard/utils"
 
 	"github.com/Masterminds/squirrel"
 	"github.com/gin-gonic/gin"
@@ -26,12 +29,23 @@ func (suite *ControllerTestSuite) TestStatsWords() {
 	suite.mockWordPeer.EXPECT().
 		Count(squirrel.Eq{schema.WORD_FAMILIARITY: schema.WORD_FAMILIARITY_GREEN}).
 		Return(int64(1), nil).Times(1)
-	// Mock the Select call that fetches practice counts for all words.
-	// getSampleWords() has no CountPractise set (nil → treated as 0), so all 5 words
-	// land in the "0" bucket.
+	// Mock the grouped practice counts: 4 never-practised words (one of them
+	// with a NULL count_practise, treated as 0) and 1 word practised 3 times.
 	suite.mockWordPeer.EXPECT().
+		CountByPractiseCount().
+		Return([]*dbModels.WordPractiseCountGroup{
+			{CountPractise: nil, WordCount: utils.IntPtr(1)},
+			{CountPractise: utils.IntPtr(0), WordCount: utils.IntPtr(3)},
+			{CountPractise: utils.IntPtr(3), WordCount: utils.IntPtr(1)},
+		}, nil).Times(1)
+	// Mock the timed answers of the last 90 days
+	suite.mockWordPracticeLogPeer.EXPECT().
 		Select(mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).
-		Return(getSampleWords(), nil).Times(1)
+		Return([]*dbModels.WordPracticeLog{
+			{WordId: utils.IntPtr(1), IsCorrect: utils.BoolPtr(true), ResponseMs: utils.IntPtr(1000)},
+			{WordId: utils.IntPtr(1), IsCorrect: utils.BoolPtr(true), ResponseMs: utils.IntPtr(3000)},
+			{WordId: utils.IntPtr(2), IsCorrect: utils.BoolPtr(false), ResponseMs: utils.IntPtr(2000)},
+		}, nil).Times(1)
 
 	// Create a test HTTP request and call the handler
 	w := httptest.NewRecorder()
@@ -49,10 +63,35 @@ func (suite *ControllerTestSuite) TestStatsWords() {
 			Green:  1,
 		},
 		PracticeCountDistribution: []models.PracticeCountBucket{
-			{Range: "0", Count: 5},
+			{Range: "0", Count: 4},
+			{Range: "1", Count: 0},
+			{Range: "2", Count: 0},
+			{Range: "3+", Count: 1},
+		},
+		ResponseTimes: models.ResponseTimes{
+			AnswerCount: 3,
+			MedianMs:    utils.IntPtr(2000),
+			P75Ms:       utils.IntPtr(2500),
+			P90Ms:       utils.IntPtr(2800),
 		},
 	}
 	expectedJSON, err := json.Marshal(expected)
 	assert.NoError(suite.T(), err)
 	assert.Equal(suite.T(), string(expectedJSON), w.Body.String())
 }
+
+// TestStatsWordsLogError tests that a failed practice log query returns 500
+func (suite *ControllerTestSuite) TestStatsWordsLogError() {
+	suite.mockWordPeer.EXPECT().Count(mock.Anything).Return(int64(0), nil).Times(3)
+	suite.mockWordPeer.EXPECT().CountByPractiseCount().Return([]*dbModels.WordPractiseCountGroup{}, nil).Times(1)
+	suite.mockWordPracticeLogPeer.EXPECT().
+		Select(mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+		Return(nil, errors.New("db error")).Times(1)
+
+	w := httptest.NewRecorder()
+	ctx, _ := gin.CreateTestContext(w)
+	ctx.Request = httptest.NewRequest(http.MethodGet, "/api/words/stats", nil)
+	suite.controller.StatsWords(ctx)
+
+	assert.Equal(suite.T(), http.StatusInternalServerError, w.Code)
+}
@@ -8,7 +8,9 @@ import (
 	dbModels "word-flashcard/data/models"
 	"word-flashcard/data/schema"
 	"word-flashcard/internal/controllers/common"
+	"word-flashcard/internal/leechdetect"
 	"word-flashcard/internal/models"
+	"word-flashcard/utils"
 
 	"github.com/Masterminds/squirrel"
 	"github.com/gin-gonic/gin"
@@ -55,11 +57,19 @@ func (wc *Controller) UpdateWord(c *gin.Context) {
 	// resubmitting a familiarity for a question they already answered in the
 	// same quiz attempt. In that case the existing row is corrected in place
 	// (step 5) instead of incrementing count_practise/last_practiced_at again.
+	// Only familiarity rows match (rows logged before modes existed have
+	// none), so a typed answer in the same session is never overwritten.
 	var existingSessionLog *dbModels.WordPracticeLog
 	if wordData.IncrementCountPractise && wordData.QuizSessionID != nil {
-		logWhere := squirrel.Eq{
-			schema.WORD_PRACTICE_LOG_WORD_ID:         wordID,
-			schema.WORD_PRACTICE_LOG_QUIZ_SESSION_ID: *wordData.QuizSessionID,
+		logWhere := squirrel.And{
+			squirrel.Eq{
+				schema.WORD_PRACTICE_LOG_WORD_ID:         wordID,
+				schema.WORD_PRACTICE_LOG_QUIZ_SESSION_ID: *wordData.QuizSessionID,
+			},
+			squirrel.Or{
+				squirrel.Eq{schema.WORD_PRACTICE_LOG_MODE: schema.WORD_PRACTICE_MODE_FAMILIARITY},
+				squirrel.Eq{schema.WORD_PRACTICE_LOG_MODE: nil},
+			},
 		}
 		existingLogs, err := wc.wordPracticeLogPeer.Select([]*string{}, logWhere, nil, nil, nil)
 		if err != nil {
@@ -107,30 +117,8 @@ func (wc *Controller) UpdateWord(c *gin.Context) {
 	}
 
 	// ================ 5. Log the familiarity change from this practice ================
-	// Best-effort: the word's own update already succeeded above, so a
-	// logging failure here must not turn into a user-facing error for an
-	// update that already committed.
 	if wordData.IncrementCountPractise {
-		if existingSessionLog != nil {
-			// Resubmission within the same quiz session: correct the existing
-			// row's familiarity in place. previous_familiarity is left untouched
-			// so it still reflects the word's state from before this quiz attempt.
-			correction := &dbModels.WordPracticeLog{Familiarity: wordModel.Familiarity}
-			logWhere := squirrel.Eq{schema.WORD_PRACTICE_LOG_ID: *existingSessionLog.Id}
-			if _, err := wc.wordPracticeLogPeer.Update(correction, logWhere); err != nil {
-				slog.Error("Failed to update word practice log", "word_id", wordID, "quiz_session_id", *wordData.QuizSessionID, "error", err)
-			}
-		} else {
-			practiceLog := &dbModels.WordPracticeLog{
-				WordId:              &wordID,
-				Familiarity:         wordModel.Familiarity,
-				PreviousFamiliarity: previousFamiliarity,
-				QuizSessionID:       wordData.QuizSessionID,
-			}
-			if _, err := wc.wordPracticeLogPeer.Insert(practiceLog); err != nil {
-				slog.Error("Failed to log word practice", "word_id", wordID, "error", err)
-			}
-		}
+		wc.logPracticeAndCheckLeech(wordID, wordData, wordModel.Familiarity, previousFamiliarity, existingSessionLog)
 	}
 
 	// ================ 6. Query updated data ================
@@ -145,3 +133,52 @@ func (wc *Controller) UpdateWord(c *gin.Context) {
 	// ================ 7. Send response ================
 	common.ResponseSuccess(http.StatusOK, wordEntities[0], c)
 }
+
+// logPracticeAndCheckLeech records the familiarity rating UpdateWord just
+// saved and tags the word as a leech if the rating is a new lapse. A
+// resubmission within the same quiz session corrects existingSessionLog in
+// place; anything else appends a new log row. Both steps are best-effort: the
+// word's own update has already committed, so a failure here must not turn
+// into a user-facing error.
+func (wc *Controller) logPracticeAndCheckLeech(
+	wordID int,
+	wordData *models.Word,
+	familiarity *string,
+	previousFamiliarity *string,
+	existingSessionLog *dbModels.WordPracticeLog,
+) {
+	lapsed := false
+	if existingSessionLog != nil {
+		// previous_familiarity is left untouched so it still reflects the
+		// word's state from before this quiz attempt.
+		correction := &dbModels.WordPracticeLog{Familiarity: familiarity, ResponseMs: wordData.ResponseMs}
+		logWhere := squirrel.Eq{schema.WORD_PRACTICE_LOG_ID: *existingSessionLog.Id}
+		if _, err := wc.wordPracticeLogPeer.Update(correction, logWhere); err != nil {
+			slog.Error("Failed to update word practice log", "word_id", wordID, "quiz_session_id", *wordData.QuizSessionID, "error", err)
+			return
+		}
+		// Only a correction that turns the row into a lapse adds one
+		lapsed = leechdetect.IsWordLapse(existingSessionLog.PreviousFamiliarity, familiarity) &&
+			!leechdetect.IsWordLapse(existingSessionLog.PreviousFamiliarity, existingSessionLog.Familiarity)
+	} else {
+		practiceLog := &dbModels.WordPracticeLog{
+			WordId:              &wordID,
+			Familiarity:         familiarity,
+			PreviousFamiliarity: previousFamiliarity,
+			QuizSessionID:       wordData.QuizSessionID,
+			Mode:                utils.StrPtr(schema.WORD_PRACTICE_MODE_FAMILIARITY),
+			ResponseMs:          wordData.ResponseMs,
+		}
+		if _, err := wc.wordPracticeLogPeer.Insert(practiceLog); err != nil {
+			slog.Error("Failed to log word practice", "word_id", wordID, "error", err)
+			return
+		}
+		lapsed = leechdetect.IsWordLapse(previousFamiliarity, familiarity)
+	}
+
+	if lapsed {
+		if _, err := wc.leechDetector.RecordWordLapse(wordID); err != nil {
+			slog.Error("Failed to check word for leech", "word_id", wordID, "error", err)
+		}
+	}
+}
@@ -11,6 +11,7 @@ import (
 
 	dbModels "word-flashcard/data/models"
 	"word-flashcard/data/schema"
+	"word-flashcard/internal/leechdetect"
 	"word-flashcard/utils"
 
 	"github.com/Masterminds/squirrel"
@@ -148,10 +149,7 @@ func (suite *ControllerTestSuite) TestUpdateWordWithQuizSessionFirstSubmissionIn
 	quizSessionID := "session-1"
 	whereWord := squirrel.Eq{schema.WORD_ID: testWordID}
 	whereDefinitionID := squirrel.Eq{schema.WORD_DEFINITIONS_WORD_ID: []int{testWordID}}
-	whereLogSession := squirrel.Eq{
-		schema.WORD_PRACTICE_LOG_WORD_ID:         testWordID,
-		schema.WORD_PRACTICE_LOG_QUIZ_SESSION_ID: quizSessionID,
-	}
+	whereLogSession := familiaritySessionLogWhere(testWordID, quizSessionID)
 
 	existingCount := 5
 	updatedCount := existingCount + 1
@@ -194,13 +192,14 @@ func (suite *ControllerTestSuite) TestUpdateWordWithQuizSessionFirstSubmissionIn
 			isFamiliarity := log.Familiarity != nil && *log.Familiarity == "yellow"
 			isPreviousFamiliarity := log.PreviousFamiliarity != nil && *log.PreviousFamiliarity == "green"
 			isSession := log.QuizSessionID != nil && *log.QuizSessionID == quizSessionID
-			return isWordID && isFamiliarity && isPreviousFamiliarity && isSession
+			isResponseMs := log.ResponseMs != nil && *log.ResponseMs == 2400
+			return isWordID && isFamiliarity && isPreviousFamiliarity && isSession && isResponseMs
 		})).
 		Return(int64(1), nil).Once()
 
 	w := httptest.NewRecorder()
 	ctx, _ := gin.CreateTestContext(w)
-	requestBody := fmt.Sprintf(`{"familiarity": "yellow", "increment_count_practise": true, "quiz_session_id": "%s"}`, quizSessionID)
+	requestBody := fmt.Sprintf(`{"familiarity": "yellow", "increment_count_practise": true, "quiz_session_id": "%s", "response_ms": 2400}`, quizSessionID)
 	ctx.Request = httptest.NewRequest(http.MethodPut, "/api/words/1", io.NopCloser(bytes.NewReader([]byte(requestBody))))
 	ctx.Params = gin.Params{gin.Param{Key: "id", Value: "1"}}
 	suite.controller.UpdateWord(ctx)
@@ -219,10 +218,7 @@ func (suite *ControllerTestSuite) TestUpdateWordWithQuizSessionResubmissionUpdat
 	existingLogID := 42
 	whereWord := squirrel.Eq{schema.WORD_ID: testWordID}
 	whereDefinitionID := squirrel.Eq{schema.WORD_DEFINITIONS_WORD_ID: []int{testWordID}}
-	whereLogSession := squirrel.Eq{
-		schema.WORD_PRACTICE_LOG_WORD_ID:         testWordID,
-		schema.WORD_PRACTICE_LOG_QUIZ_SESSION_ID: quizSessionID,
-	}
+	whereLogSession := familiaritySessionLogWhere(testWordID, quizSessionID)
 	whereLogID := squirrel.Eq{schema.WORD_PRACTICE_LOG_ID: existingLogID}
 
 	existingLog := &dbModels.WordPracticeLog{
@@ -287,10 +283,7 @@ func (suite *ControllerTestSuite) TestUpdateWordLogUpdateFailureStillReturnsSucc
 	existingLogID := 42
 	whereWord := squirrel.Eq{schema.WORD_ID: testWordID}
 	whereDefinitionID := squirrel.Eq{schema.WORD_DEFINITIONS_WORD_ID: []int{testWordID}}
-	whereLogSession := squirrel.Eq{
-		schema.WORD_PRACTICE_LOG_WORD_ID:         testWordID,
-		schema.WORD_PRACTICE_LOG_QUIZ_SESSION_ID: quizSessionID,
-	}
+	whereLogSession := familiaritySessionLogWhere(testWordID, quizSessionID)
 
 	existingLog := &dbModels.WordPracticeLog{
 		Id:                  &existingLogID,
@@ -371,3 +364,69 @@ func (suite *ControllerTestSuite) TestUpdateWordWithoutIncrementDoesNotSetLastPr
 	// Verify the response status code
 	assert.Equal(suite.T(), http.StatusOK, w.Code)
 }
+
+// TestUpdateWordLapseTagsLeech tests that a known word falling back to red
+// is counted as a lapse and tagged as a leech on reaching the threshold
+func (suite *ControllerTestSuite) TestUpdateWordLapseTagsLeech() {
+	testWordID := 1
+	whereWord := squirrel.Eq{schema.WORD_ID: testWordID}
+	whereDefinitionID := squirrel.Eq{schema.WORD_DEFINITIONS_WORD_ID: []int{testWordID}}
+
+	dbWord := getSampleWords()[0]
+	dbWord.Familiarity = utils.StrPtr("red")
+	dbWord.IsLeech = utils.BoolPtr(true)
+
+	suite.mockWordPeer.EXPECT().
+		Select(mock.Anything, whereWord, mock.Anything, mock.Anything, mock.Anything).
+		Return([]*dbModels.Word{getSampleWords()[0]}, nil).Once()
+	suite.mockWordPeer.EXPECT().
+		Update(mock.MatchedBy(func(word *dbModels.Word) bool { return word.IsLeech == nil }), whereWord).
+		Return(int64(1), nil).Once()
+	suite.mockWordPracticeLogPeer.EXPECT().
+		Insert(mock.Anything).
+		Return(int64(1), nil).Once()
+	suite.mockSettingPeer.EXPECT().
+		Select(mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+		Return([]*dbModels.Setting{}, nil).Once()
+	suite.mockWordPracticeLogPeer.EXPECT().
+		Count(leechdetect.WordLapses(testWordID)).
+		Return(int64(leechdetect.DefaultLeechWordLapses), nil).Once()
+	suite.mockWordPeer.EXPECT().
+		Update(mock.MatchedBy(func(word *dbModels.Word) bool {
+			return word.IsLeech != nil && *word.IsLeech && word.IsSuspended == nil
+		}), whereWord).
+		Return(int64(1), nil).Once()
+	suite.mockWordPeer.EXPECT().
+		Select(mock.Anything, whereWord, mock.Anything, mock.Anything, mock.Anything).
+		Return([]*dbModels.Word{dbWord}, nil).Once()
+	suite.mockWordDefinitionPeer.EXPECT().
+		Select(mock.Anything, whereDefinitionID, mock.Anything, mock.Anything, mock.Anything).
+		Return([]*dbModels.WordDefinition{getSampleWordDefinitions()[0]}, nil).Once()
+
+	w := httptest.NewRecorder()
+	ctx, _ := gin.CreateTestContext(w)
+	requestBody := "{\"familiarity\": \"red\", \"increment_count_practise\": true}"
+	ctx.Request = httptest.NewRequest(http.MethodPut, "/api/words/1", io.NopCloser(bytes.NewReader([]byte(requestBody))))
+	ctx.Params = gin.Params{gin.Param{Key: "id", Value: "1"}}
+	suite.controller.UpdateWord(ctx)
+
+	assert.Equal(suite.T(), http.StatusOK, w.Code)
+	var word map[string]interface{}
+	suite.Require().NoError(json.Unmarshal(w.Body.Bytes(), &word))
+	assert.Equal(suite.T(), true, word["is_leech"])
+}
+
+// familiaritySessionLogWhere is the filter UpdateWord uses to find an earlier
+// familiarity rating of the word in the same quiz session
+func familiaritySessionLogWhere(wordID int, quizSessionID string) squirrel.And {
+	return squirrel.And{
+		squirrel.Eq{
+			schema.WORD_PRACTICE_LOG_WORD_ID:         wordID,
+			schema.WORD_PRACTICE_LOG_QUIZ_SESSION_ID: quizSessionID,
+		},
+		squirrel.Or{
+			squirrel.Eq{schema.WORD_PRACTICE_LOG_MODE: schema.WORD_PRACTICE_MODE_FAMILIARITY},
+			squirrel.Eq{schema.WORD_PRACTICE_LOG_MODE: nil},
+		},
+	}
+}
@@ -0,0 +1,250 @@
+// Package leechdetect tags words and questions that keep being forgotten or
+// answered wrong as leeches, and reads the thresholds that decide when.
+package leechdetect
+
+import (
+	"fmt"
+	"log/slog"
+	"strconv"
+
+	dbModels "word-flashcard/data/models"
+	"word-flashcard/data/peers"
+	"word-flashcard/data/schema"
+	"word-flashcard/internal/controllers/common"
+	"word-flashcard/utils"
+
+	"github.com/Masterminds/squirrel"
+)
+
+const (
+	// DefaultLeechWordLapses and DefaultLeechQuestionFailures apply until the
+	// learner sets thresholds of their own
+	DefaultLeechWordLapses       = 8
+	DefaultLeechQuestionFailures = 8
+
+	// MaxLeechThreshold caps each leech threshold; 0 turns detection off
+	MaxLeechThreshold = 1000
+)
+
+// LeechConfig is the resolved leech detection configuration: how many lapses
+// make a word a leech, how many wrong answers make a question one, and
+// whether a new leech is suspended from quizzes too
+type LeechConfig struct {
+	WordLapses       int
+	QuestionFailures int
+	AutoSuspend      bool
+}
+
+// LeechSettingNames lists the settings LeechConfig is stored in
+var LeechSettingNames = []string{
+	schema.SETTING_LEECH_WORD_LAPSES,
+	schema.SETTING_LEECH_QUESTION_FAILS,
+	schema.SETTING_LEECH_AUTO_SUSPEND,
+}
+
+// ValidateLeechThreshold checks a leech threshold is within 0..MaxLeechThreshold
+func ValidateLeechThreshold(field string, threshold int) error {
+	if threshold < 0 || threshold > MaxLeechThreshold {
+		return common.NewFieldError(fmt.Sprintf("%s must be between 0 and %d", field, MaxLeechThreshold), field, threshold)
+	}
+	return nil
+}
+
+// LeechConfigFromSettings resolves the leech configuration from its stored
+// settings keyed by name, using the default for each unset or invalid one
+func LeechConfigFromSettings(settings map[string]*dbModels.Setting) LeechConfig {
+	config := LeechConfig{
+		WordLapses:       DefaultLeechWordLapses,
+		QuestionFailures: DefaultLeechQuestionFailures,
+	}
+	if value, ok := settingValue(settings, schema.SETTING_LEECH_WORD_LAPSES); ok {
+		if n, err := strconv.Atoi(value); err == nil && ValidateLeechThreshold("word_lapses", n) == nil {
+			config.WordLapses = n
+		} else {
+			slog.Warn("Invalid stored setting, falling back to the default", "name", schema.SETTING_LEECH_WORD_LAPSES, "value", value)
+		}
+	}
+	if value, ok := settingValue(settings, schema.SETTING_LEECH_QUESTION_FAILS); ok {
+		if n, err := strconv.Atoi(value); err == nil && ValidateLeechThreshold("question_failures", n) == nil {
+			config.QuestionFailures = n
+		} else {
+			slog.Warn("Invalid stored setting, falling back to the default", "name", schema.SETTING_LEECH_QUESTION_FAILS, "value", value)
+		}
+	}
+	if value, ok := settingValue(settings, schema.SETTING_LEECH_AUTO_SUSPEND); ok {
+		if b, err := strconv.ParseBool(value); err == nil {
+			config.AutoSuspend = b
+		} else {
+			slog.Warn("Invalid stored setting, falling back to the default", "name", schema.SETTING_LEECH_AUTO_SUSPEND, "value", value)
+		}
+	}
+	return config
+}
+
+// settingValue returns the value of the named setting, if it is set
+func settingValue(settings map[string]*dbModels.Setting, name string) (string, bool) {
+	setting, ok := settings[name]
+	if !ok || setting.Value == nil {
+		return "", false
+	}
+	return *setting.Value, true
+}
+
+// IsLeechCheckpoint reports whether reaching count lapses or wrong answers
+// tags an item as a leech: at threshold, then again every half threshold
+// past it, so a leech that was cleared but keeps failing is caught again.
+// A threshold of 0 never tags.
+func IsLeechCheckpoint(count int, threshold int) bool {
+	if threshold <= 0 || count < threshold {
+		return false
+	}
+	return (count-threshold)%max(1, threshold/2) == 0
+}
+
+// IsWordLapse reports whether a familiarity change from previous to current
+// is a lapse: a word the learner knew falling back to red
+func IsWordLapse(previous *string, current *string) bool {
+	return current != nil && *current == schema.WORD_FAMILIARITY_RED &&
+		previous != nil && *previous != schema.WORD_FAMILIARITY_RED
+}
+
+// WordLapses matches the practice logs of wordIDs that were lapses
+func WordLapses(wordIDs ...int) squirrel.Sqlizer {
+	return squirrel.Eq{
+		schema.WORD_PRACTICE_LOG_WORD_ID:              wordIDs,
+		schema.WORD_PRACTICE_LOG_FAMILIARITY:          schema.WORD_FAMILIARITY_RED,
+		schema.WORD_PRACTICE_LOG_PREVIOUS_FAMILIARITY: []string{schema.WORD_FAMILIARITY_YELLOW, schema.WORD_FAMILIARITY_GREEN},
+	}
+}
+
+// QuestionFailures matches the answer logs of questionIDs that were wrong
+func QuestionFailures(questionIDs ...int) squirrel.Sqlizer {
+	return squirrel.Eq{
+		schema.QUESTION_ANSWER_LOG_QUESTION_ID: questionIDs,
+		schema.QUESTION_ANSWER_LOG_IS_CORRECT:  false,
+	}
+}
+
+// LeechDetector tags words and questions that keep being forgotten as
+// leeches. It is shared by the word and question controllers, which call it
+// after logging a lapse or a wrong answer, and by the leech controller,
+// which reads and changes its configuration.
+type LeechDetector struct {
+	settingPeer           peers.SettingPeerInterface
+	wordPeer              peers.WordPeerInterface
+	questionPeer          peers.QuestionPeerInterface
+	wordPracticeLogPeer   peers.WordPracticeLogPeerInterface
+	questionAnswerLogPeer peers.QuestionAnswerLogPeerInterface
+}
+
+// NewLeechDetector creates a new LeechDetector instance
+func NewLeechDetector(
+	settingPeer peers.SettingPeerInterface,
+	wordPeer peers.WordPeerInterface,
+	questionPeer peers.QuestionPeerInterface,
+	wordPracticeLogPeer peers.WordPracticeLogPeerInterface,
+	questionAnswerLogPeer peers.QuestionAnswerLogPeerInterface,
+) *LeechDetector {
+	return &LeechDetector{
+		settingPeer:           settingPeer,
+		wordPeer:              wordPeer,
+		questionPeer:          questionPeer,
+		wordPracticeLogPeer:   wordPracticeLogPeer,
+		questionAnswerLogPeer: questionAnswerLogPeer,
+	}
+}
+
+// LoadSettings returns the stored leech settings keyed by name; a name
+// missing from the map has never been set
+func (d *LeechDetector) LoadSettings() (map[string]*dbModels.Setting, error) {
+	settings, err := d.settingPeer.Select([]*string{}, squirrel.Eq{schema.SETTING_NAME: LeechSettingNames}, nil, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	byName := make(map[string]*dbModels.Setting, len(settings))
+	for _, setting := range settings {
+		if setting.Name != nil {
+			byName[*setting.Name] = setting
+		}
+	}
+	return byName, nil
+}
+
+// SaveSetting writes value to the named setting, updating the existing row
+// when there is one. The existing rows come from LoadSettings rather than
+// from Update's affected row count, which MySQL reports as 0 when the value
+// didn't change.
+func (d *LeechDetector) SaveSetting(existing map[string]*dbModels.Setting, name string, value string) error {
+	if _, ok := existing[name]; ok {
+		_, err := d.settingPeer.Update(&dbModels.Setting{Value: &value}, squirrel.Eq{schema.SETTING_NAME: name})
+		return err
+	}
+
+	_, err := d.settingPeer.Insert(&dbModels.Setting{Name: &name, Value: &value})
+	return err
+}
+
+// Config returns the resolved leech configuration
+func (d *LeechDetector) Config() (LeechConfig, error) {
+	settings, err := d.LoadSettings()
+	if err != nil {
+		return LeechConfig{}, err
+	}
+	return LeechConfigFromSettings(settings), nil
+}
+
+// RecordWordLapse checks the word's lapse count after a lapse was logged and
+// tags it as a leech, suspending it when configured to, if that count is a
+// leech checkpoint. Returns whether the word was tagged.
+func (d *LeechDetector) RecordWordLapse(wordID int) (bool, error) {
+	config, err := d.Config()
+	if err != nil {
+		return false, err
+	}
+	lapses, err := d.wordPracticeLogPeer.Count(WordLapses(wordID))
+	if err != nil {
+		return false, err
+	}
+	if !IsLeechCheckpoint(int(lapses), config.WordLapses) {
+		return false, nil
+	}
+
+	tag := &dbModels.Word{IsLeech: utils.BoolPtr(true)}
+	if config.AutoSuspend {
+		tag.IsSuspended = utils.BoolPtr(true)
+	}
+	if _, err := d.wordPeer.Update(tag, squirrel.Eq{schema.WORD_ID: wordID}); err != nil {
+		return false, err
+	}
+	slog.Info("Word tagged as a leech", "word_id", wordID, "lapses", lapses, "suspended", config.AutoSuspend)
+	return true, nil
+}
+
+// RecordQuestionFailure checks the question's wrong answer count after a
+// wrong answer was logged and tags it as a leech, suspending it when
+// configured to, if that count is a leech checkpoint. Returns whether the
+// question was tagged.
+func (d *LeechDetector) RecordQuestionFailure(questionID int) (bool, error) {
+	config, err := d.Config()
+	if err != nil {
+		return false, err
+	}
+	failures, err := d.questionAnswerLogPeer.Count(QuestionFailures(questionID))
+	if err != nil {
+		return false, err
+	}
+	if !IsLeechCheckpoint(int(failures), config.QuestionFailures) {
+		return false, nil
+	}
+
+	tag := &dbModels.Question{IsLeech: utils.BoolPtr(true)}
+	if config.AutoSuspend {
+		tag.IsSuspended = utils.BoolPtr(true)
+	}
+	if _, err := d.questionPeer.Update(tag, squirrel.Eq{schema.QUESTION_ID: questionID}); err != nil {
+		return false, err
+	}
+	slog.Info("Question tagged as a leech", "question_id", questionID, "wrong_answers", failures, "suspended", config.AutoSuspend)
+	return true, nil
+}
@@ -0,0 +1,178 @@
+package leechdetect
+
+import (
+	"errors"
+	"testing"
+	"word-flashcard/data/mocks"
+	dbModels "word-flashcard/data/models"
+	"word-flashcard/data/schema"
+	"word-flashcard/utils"
+
+	"github.com/Masterminds/squirrel"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+)
+
+// LeechDetectorTestSuite is a test suite for LeechDetector and the leech helpers
+type LeechDetectorTestSuite struct {
+	suite.Suite
+	detector                  *LeechDetector
+	mockSettingPeer           *mocks.MockSettingPeer
+	mockWordPeer              *mocks.MockWordPeer
+	mockQuestionPeer          *mocks.MockQuestionPeer
+	mockWordPracticeLogPeer   *mocks.MockWordPracticeLogPeer
+	mockQuestionAnswerLogPeer *mocks.MockQuestionAnswerLogPeer
+}
+
+// TestLeechDetectorTestSuite runs the LeechDetectorTestSuite
+func TestLeechDetectorTestSuite(t *testing.T) {
+	suite.Run(t, new(LeechDetectorTestSuite))
+}
+
+// SetupTest sets up the test environment before each test
+func (suite *LeechDetectorTestSuite) SetupTest() {
+	suite.mockSettingPeer = mocks.NewMockSettingPeer(suite.T())
+	suite.mockWordPeer = mocks.NewMockWordPeer(suite.T())
+	suite.mockQuestionPeer = mocks.NewMockQuestionPeer(suite.T())
+	suite.mockWordPracticeLogPeer = mocks.NewMockWordPracticeLogPeer(suite.T())
+	suite.mockQuestionAnswerLogPeer = mocks.NewMockQuestionAnswerLogPeer(suite.T())
+	suite.detector = NewLeechDetector(
+		suite.mockSettingPeer,
+		suite.mockWordPeer,
+		suite.mockQuestionPeer,
+		suite.mockWordPracticeLogPeer,
+		suite.mockQuestionAnswerLogPeer,
+	)
+}
+
+// leechSetting builds a stored setting
+func leechSetting(name string, value string) *dbModels.Setting {
+	return &dbModels.Setting{Name: utils.StrPtr(name), Value: utils.StrPtr(value)}
+}
+
+// TestIsLeechCheckpoint tests tagging at the threshold, every half threshold
+// past it, and never when detection is off
+func (suite *LeechDetectorTestSuite) TestIsLeechCheckpoint() {
+	testCases := []struct {
+		count, threshold int
+		want             bool
+	}{
+		{7, 8, false},
+		{8, 8, true},
+		{10, 8, false},
+		{12, 8, true},
+		{16, 8, true},
+		{1, 1, true},
+		{2, 1, true},
+		{5, 0, false},
+	}
+	for _, tc := range testCases {
+		suite.Equal(tc.want, IsLeechCheckpoint(tc.count, tc.threshold), "count %d threshold %d", tc.count, tc.threshold)
+	}
+}
+
+// TestIsWordLapse tests that only a known word falling back to red is a lapse
+func (suite *LeechDetectorTestSuite) TestIsWordLapse() {
+	red, yellow, green := utils.StrPtr("red"), utils.StrPtr("yellow"), utils.StrPtr("green")
+
+	suite.True(IsWordLapse(green, red))
+	suite.True(IsWordLapse(yellow, red))
+	suite.False(IsWordLapse(red, red))
+	suite.False(IsWordLapse(nil, red))
+	suite.False(IsWordLapse(green, yellow))
+	suite.False(IsWordLapse(green, nil))
+}
+
+// TestLeechConfigFromSettings tests defaults, stored values and invalid values
+func (suite *LeechDetectorTestSuite) TestLeechConfigFromSettings() {
+	suite.Equal(LeechConfig{WordLapses: DefaultLeechWordLapses, QuestionFailures: DefaultLeechQuestionFailures}, LeechConfigFromSettings(nil))
+
+	config := LeechConfigFromSettings(map[string]*dbModels.Setting{
+		schema.SETTING_LEECH_WORD_LAPSES:    leechSetting(schema.SETTING_LEECH_WORD_LAPSES, "4"),
+		schema.SETTING_LEECH_QUESTION_FAILS: leechSetting(schema.SETTING_LEECH_QUESTION_FAILS, "0"),
+		schema.SETTING_LEECH_AUTO_SUSPEND:   leechSetting(schema.SETTING_LEECH_AUTO_SUSPEND, "true"),
+	})
+	suite.Equal(LeechConfig{WordLapses: 4, QuestionFailures: 0, AutoSuspend: true}, config)
+
+	config = LeechConfigFromSettings(map[string]*dbModels.Setting{
+		schema.SETTING_LEECH_WORD_LAPSES:    leechSetting(schema.SETTING_LEECH_WORD_LAPSES, "many"),
+		schema.SETTING_LEECH_QUESTION_FAILS: leechSetting(schema.SETTING_LEECH_QUESTION_FAILS, "5000"),
+		schema.SETTING_LEECH_AUTO_SUSPEND:   leechSetting(schema.SETTING_LEECH_AUTO_SUSPEND, "maybe"),
+	})
+	suite.Equal(LeechConfig{WordLapses: DefaultLeechWordLapses, QuestionFailures: DefaultLeechQuestionFailures}, config)
+}
+
+// TestValidateLeechThreshold tests the accepted threshold range
+func (suite *LeechDetectorTestSuite) TestValidateLeechThreshold() {
+	suite.NoError(ValidateLeechThreshold("word_lapses", 0))
+	suite.NoError(ValidateLeechThreshold("word_lapses", MaxLeechThreshold))
+	suite.Error(ValidateLeechThreshold("word_lapses", -1))
+	suite.Error(ValidateLeechThreshold("word_lapses", MaxLeechThreshold+1))
+}
+
+// TestRecordWordLapseTagsAndSuspends tests that reaching the threshold tags
+// the word and suspends it when auto-suspend is on
+func (suite *LeechDetectorTestSuite) TestRecordWordLapseTagsAndSuspends() {
+	suite.mockSettingPeer.EXPECT().
+		Select(mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+		Return([]*dbModels.Setting{
+			leechSetting(schema.SETTING_LEECH_WORD_LAPSES, "3"),
+			leechSetting(schema.SETTING_LEECH_AUTO_SUSPEND, "true"),
+		}, nil).Times(1)
+	suite.mockWordPracticeLogPeer.EXPECT().Count(WordLapses(5)).Return(int64(3), nil).Times(1)
+	suite.mockWordPeer.EXPECT().
+		Update(mock.MatchedBy(func(word *dbModels.Word) bool {
+			return *word.IsLeech && word.IsSuspended != nil && *word.IsSuspended
+		}), squirrel.Eq{schema.WORD_ID: 5}).
+		Return(int64(1), nil).Times(1)
+
+	tagged, err := suite.detector.RecordWordLapse(5)
+
+	suite.NoError(err)
+	suite.True(tagged)
+}
+
+// TestRecordWordLapseBelowThreshold tests that a lapse short of a checkpoint
+// leaves the word alone
+func (suite *LeechDetectorTestSuite) TestRecordWordLapseBelowThreshold() {
+	suite.mockSettingPeer.EXPECT().
+		Select(mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+		Return([]*dbModels.Setting{}, nil).Times(1)
+	suite.mockWordPracticeLogPeer.EXPECT().Count(mock.Anything).Return(int64(7), nil).Times(1)
+
+	tagged, err := suite.detector.RecordWordLapse(5)
+
+	suite.NoError(err)
+	suite.False(tagged)
+}
+
+// TestRecordQuestionFailureTagsOnly tests that a question is tagged but not
+// suspended when auto-suspend is off
+func (suite *LeechDetectorTestSuite) TestRecordQuestionFailureTagsOnly() {
+	suite.mockSettingPeer.EXPECT().
+		Select(mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+		Return([]*dbModels.Setting{}, nil).Times(1)
+	suite.mockQuestionAnswerLogPeer.EXPECT().Count(QuestionFailures(9)).Return(int64(DefaultLeechQuestionFailures), nil).Times(1)
+	suite.mockQuestionPeer.EXPECT().
+		Update(mock.MatchedBy(func(question *dbModels.Question) bool {
+			return *question.IsLeech && question.IsSuspended == nil
+		}), squirrel.Eq{schema.QUESTION_ID: 9}).
+		Return(int64(1), nil).Times(1)
+
+	tagged, err := suite.detector.RecordQuestionFailure(9)
+
+	suite.NoError(err)
+	suite.True(tagged)
+}
+
+// TestRecordQuestionFailureSettingsError tests that a failed settings query is returned
+func (suite *LeechDetectorTestSuite) TestRecordQuestionFailureSettingsError() {
+	suite.mockSettingPeer.EXPECT().
+		Select(mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+		Return(nil, errors.New("db error")).Times(1)
+
+	tagged, err := suite.detector.RecordQuestionFailure(9)
+
+	suite.Error(err)
+	suite.False(tagged)
+}
@@ -0,0 +1,203 @@
+// Package links reads and removes the links between words, questions and
+// notes for the controllers of all three entities.
+package links
+
+import (
+	"fmt"
+	"slices"
+	"word-flashcard/data/peers"
+	"word-flashcard/data/schema"
+	"word-flashcard/internal/models"
+	"word-flashcard/utils"
+
+	"github.com/Masterminds/squirrel"
+)
+
+// EntityTypes lists the entity types that can be linked, in the order a
+// link's two ends are stored (see CanonicalLink).
+var EntityTypes = []string{schema.ENTITY_TYPE_WORD, schema.ENTITY_TYPE_QUESTION, schema.ENTITY_TYPE_NOTE}
+
+// LinkResolver reads and removes the links between words, questions and
+// notes. It is shared by the controllers of all three entities, so each can
+// embed an entity's links in its responses and drop them when the entity is
+// deleted.
+type LinkResolver struct {
+	entityLinkPeer peers.EntityLinkPeerInterface
+	wordPeer       peers.WordPeerInterface
+	questionPeer   peers.QuestionPeerInterface
+	notePeer       peers.NotePeerInterface
+}
+
+// NewLinkResolver creates a new LinkResolver instance
+func NewLinkResolver(
+	entityLinkPeer peers.EntityLinkPeerInterface,
+	wordPeer peers.WordPeerInterface,
+	questionPeer peers.QuestionPeerInterface,
+	notePeer peers.NotePeerInterface,
+) *LinkResolver {
+	return &LinkResolver{
+		entityLinkPeer: entityLinkPeer,
+		wordPeer:       wordPeer,
+		questionPeer:   questionPeer,
+		notePeer:       notePeer,
+	}
+}
+
+// CanonicalLink orders the two ends of a link the way entity_links stores
+// them: by type in EntityTypes order, then by ID. Either argument order
+// yields the same row, so a pair can only be linked once.
+func CanonicalLink(aType string, aID int, bType string, bID int) (sourceType string, sourceID int, targetType string, targetID int) {
+	aRank, bRank := slices.Index(EntityTypes, aType), slices.Index(EntityTypes, bType)
+	if aRank > bRank || (aRank == bRank && aID > bID) {
+		return bType, bID, aType, aID
+	}
+	return aType, aID, bType, bID
+}
+
+// LinksOf matches every link with an end at one of ids of entityType.
+func LinksOf(entityType string, ids []int) squirrel.Sqlizer {
+	return squirrel.Or{
+		squirrel.Eq{schema.ENTITY_LINK_SOURCE_TYPE: entityType, schema.ENTITY_LINK_SOURCE_ID: ids},
+		squirrel.Eq{schema.ENTITY_LINK_TARGET_TYPE: entityType, schema.ENTITY_LINK_TARGET_ID: ids},
+	}
+}
+
+// Exists reports whether the entity of entityType with id is stored.
+func (r *LinkResolver) Exists(entityType string, id int) (bool, error) {
+	labels, err := r.Labels(entityType, []int{id})
+	if err != nil {
+		return false, err
+	}
+	_, ok := labels[id]
+	return ok, nil
+}
+
+// Linked returns, for each of ids of entityType, the entities linked to it in
+// the order the links were created. Links whose other end no longer exists
+// are left out.
+func (r *LinkResolver) Linked(entityType string, ids []int) (map[int][]models.LinkedEntity, error) {
+	linked := make(map[int][]models.LinkedEntity)
+	if len(ids) == 0 {
+		return linked, nil
+	}
+
+	orderBy := fmt.Sprintf("%s ASC", schema.ENTITY_LINK_ID)
+	links, err := r.entityLinkPeer.Select([]*string{}, LinksOf(entityType, ids), []*string{&orderBy}, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	// A link can belong to two of the requested entities at once (a word
+	// linked to another requested word), so each end is considered on its own
+	type linkEnd struct {
+		ownerID   int
+		linkID    int
+		otherType string
+		otherID   int
+	}
+	var ends []linkEnd
+	otherIDs := make(map[string][]int)
+	for _, link := range links {
+		if link.Id == nil || link.SourceType == nil || link.SourceId == nil || link.TargetType == nil || link.TargetId == nil {
+			continue
+		}
+		if *link.SourceType == entityType && slices.Contains(ids, *link.SourceId) {
+			ends = append(ends, linkEnd{*link.SourceId, *link.Id, *link.TargetType, *link.TargetId})
+			otherIDs[*link.TargetType] = append(otherIDs[*link.TargetType], *link.TargetId)
+		}
+		if *link.TargetType == entityType && slices.Contains(ids, *link.TargetId) {
+			ends = append(ends, linkEnd{*link.TargetId, *link.Id, *link.SourceType, *link.SourceId})
+			otherIDs[*link.SourceType] = append(otherIDs[*link.SourceType], *link.SourceId)
+		}
+	}
+
+	labels := make(map[string]map[int]string, len(otherIDs))
+	for otherType, typeIDs := range otherIDs {
+		if labels[otherType], err = r.Labels(otherType, typeIDs); err != nil {
+			return nil, err
+		}
+	}
+
+	for _, end := range ends {
+		label, ok := labels[end.otherType][end.otherID]
+		if !ok {
+			continue
+		}
+		linked[end.ownerID] = append(linked[end.ownerID], models.LinkedEntity{
+			LinkID: end.linkID,
+			Type:   end.otherType,
+			ID:     end.otherID,
+			Label:  label,
+		})
+	}
+	return linked, nil
+}
+
+// Unlink removes every link of the entity of entityType with id, returning
+// the entities it was linked to.
+func (r *LinkResolver) Unlink(entityType string, id int) ([]models.LinkedEntity, error) {
+	linked, err := r.Linked(entityType, []int{id})
+	if err != nil {
+		return nil, err
+	}
+	if err := r.RemoveLinks(entityType, id); err != nil {
+		return nil, err
+	}
+	if linked[id] == nil {
+		return []models.LinkedEntity{}, nil
+	}
+	return linked[id], nil
+}
+
+// RemoveLinks removes every link of the entity of entityType with id.
+func (r *LinkResolver) RemoveLinks(entityType string, id int) error {
+	_, err := r.entityLinkPeer.Delete(LinksOf(entityType, []int{id}))
+	return err
+}
+
+// Labels returns the display label of each stored entity of entityType
+// among ids, keyed by ID: the word itself, the question text or the note
+// title. IDs that aren't stored are left out.
+func (r *LinkResolver) Labels(entityType string, ids []int) (map[int]string, error) {
+	labels := make(map[int]string, len(ids))
+
+	switch entityType {
+	case schema.ENTITY_TYPE_WORD:
+		columns := []*string{utils.StrPtr(schema.WORD_ID), utils.StrPtr(schema.WORD_WORD)}
+		words, err := r.wordPeer.Select(columns, squirrel.Eq{schema.WORD_ID: ids}, nil, nil, nil)
+		if err != nil {
+			return nil, err
+		}
+		for _, word := range words {
+			if word.Id != nil {
+				labels[*word.Id] = utils.DerefStr(word.Word)
+			}
+		}
+	case schema.ENTITY_TYPE_QUESTION:
+		columns := []*string{utils.StrPtr(schema.QUESTION_ID), utils.StrPtr(schema.QUESTION_QUESTION)}
+		questions, err := r.questionPeer.Select(columns, squirrel.Eq{schema.QUESTION_ID: ids}, nil, nil, nil)
+		if err != nil {
+			return nil, err
+		}
+		for _, question := range questions {
+			if question.Id != nil {
+				labels[*question.Id] = utils.DerefStr(question.Question)
+			}
+		}
+	case schema.ENTITY_TYPE_NOTE:
+		columns := []*string{utils.StrPtr(schema.NOTE_ID), utils.StrPtr(schema.NOTE_TITLE)}
+		notes, err := r.notePeer.Select(columns, squirrel.Eq{schema.NOTE_ID: ids}, nil, nil, nil)
+		if err != nil {
+			return nil, err
+		}
+		for _, note := range notes {
+			if note.Id != nil {
+				labels[*note.Id] = utils.DerefStr(note.Title)
+			}
+		}
+	default:
+		return nil, fmt.Errorf("unknown entity type %q", entityType)
+	}
+
+	return labels, nil
+}
@@ -0,0 +1,229 @@
+package links
+
+import (
+	"errors"
+	"testing"
+	"word-flashcard/data/mocks"
+	dbModels "word-flashcard/data/models"
+	"word-flashcard/data/schema"
+	"word-flashcard/internal/models"
+	"word-flashcard/utils"
+
+	"github.com/Masterminds/squirrel"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+)
+
+// LinkResolverTestSuite is a test suite for LinkResolver and the link helpers
+type LinkResolverTestSuite struct {
+	suite.Suite
+	resolver           *LinkResolver
+	mockEntityLinkPeer *mocks.MockEntityLinkPeer
+	mockWordPeer       *mocks.MockWordPeer
+	mockQuestionPeer   *mocks.MockQuestionPeer
+	mockNotePeer       *mocks.MockNotePeer
+}
+
+// TestLinkResolverTestSuite runs the LinkResolverTestSuite
+func TestLinkResolverTestSuite(t *testing.T) {
+	suite.Run(t, new(LinkResolverTestSuite))
+}
+
+// SetupTest sets up the test environment before each test
+func (suite *LinkResolverTestSuite) SetupTest() {
+	suite.mockEntityLinkPeer = mocks.NewMockEntityLinkPeer(suite.T())
+	suite.mockWordPeer = mocks.NewMockWordPeer(suite.T())
+	suite.mockQuestionPeer = mocks.NewMockQuestionPeer(suite.T())
+	suite.mockNotePeer = mocks.NewMockNotePeer(suite.T())
+	suite.resolver = NewLinkResolver(suite.mockEntityLinkPeer, suite.mockWordPeer, suite.mockQuestionPeer, suite.mockNotePeer)
+}
+
+// newEntityLink builds a stored link between the two given entities
+func newEntityLink(id int, sourceType string, sourceID int, targetType string, targetID int) *dbModels.EntityLink {
+	return &dbModels.EntityLink{
+		Id:         utils.IntPtr(id),
+		SourceType: utils.StrPtr(sourceType),
+		SourceId:   utils.IntPtr(sourceID),
+		TargetType: utils.StrPtr(targetType),
+		TargetId:   utils.IntPtr(targetID),
+	}
+}
+
+// TestCanonicalLink tests that both argument orders give the same stored link
+func (suite *LinkResolverTestSuite) TestCanonicalLink() {
+	testCases := []struct {
+		name                       string
+		aType                      string
+		aID                        int
+		bType                      string
+		bID                        int
+		wantSourceType, wantTarget string
+		wantSourceID, wantTargetID int
+	}{
+		{"already ordered", schema.ENTITY_TYPE_WORD, 5, schema.ENTITY_TYPE_NOTE, 1, schema.ENTITY_TYPE_WORD, schema.ENTITY_TYPE_NOTE, 5, 1},
+		{"types swapped", schema.ENTITY_TYPE_NOTE, 1, schema.ENTITY_TYPE_WORD, 5, schema.ENTITY_TYPE_WORD, schema.ENTITY_TYPE_NOTE, 5, 1},
+		{"question before note", schema.ENTITY_TYPE_NOTE, 2, schema.ENTITY_TYPE_QUESTION, 9, schema.ENTITY_TYPE_QUESTION, schema.ENTITY_TYPE_NOTE, 9, 2},
+		{"same type ordered by ID", schema.ENTITY_TYPE_WORD, 7, schema.ENTITY_TYPE_WORD, 3, schema.ENTITY_TYPE_WORD, schema.ENTITY_TYPE_WORD, 3, 7},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			sourceType, sourceID, targetType, targetID := CanonicalLink(tc.aType, tc.aID, tc.bType, tc.bID)
+			suite.Equal(tc.wantSourceType, sourceType)
+			suite.Equal(tc.wantSourceID, sourceID)
+			suite.Equal(tc.wantTarget, targetType)
+			suite.Equal(tc.wantTargetID, targetID)
+		})
+	}
+}
+
+// TestLinksOf tests that LinksOf matches the entity at either end of a link
+func (suite *LinkResolverTestSuite) TestLinksOf() {
+	query, args, err := LinksOf(schema.ENTITY_TYPE_NOTE, []int{4}).ToSql()
+
+	suite.NoError(err)
+	suite.Equal("(source_id IN (?) AND source_type = ? OR target_id IN (?) AND target_type = ?)", query)
+	suite.Equal([]interface{}{4, schema.ENTITY_TYPE_NOTE, 4, schema.ENTITY_TYPE_NOTE}, args)
+}
+
+// TestExists tests Exists for a stored entity, a missing one and an unknown type
+func (suite *LinkResolverTestSuite) TestExists() {
+	suite.mockQuestionPeer.EXPECT().
+		Select(mock.Anything, squirrel.Eq{schema.QUESTION_ID: []int{3}}, mock.Anything, mock.Anything, mock.Anything).
+		Return([]*dbModels.Question{{Id: utils.IntPtr(3), Question: utils.StrPtr("Pick one")}}, nil).Times(1)
+	suite.mockQuestionPeer.EXPECT().
+		Select(mock.Anything, squirrel.Eq{schema.QUESTION_ID: []int{4}}, mock.Anything, mock.Anything, mock.Anything).
+		Return([]*dbModels.Question{}, nil).Times(1)
+
+	exists, err := suite.resolver.Exists(schema.ENTITY_TYPE_QUESTION, 3)
+	suite.NoError(err)
+	suite.True(exists)
+
+	exists, err = suite.resolver.Exists(schema.ENTITY_TYPE_QUESTION, 4)
+	suite.NoError(err)
+	suite.False(exists)
+
+	_, err = suite.resolver.Exists("deck", 1)
+	suite.Error(err)
+}
+
+// TestLinked tests that Linked groups links by requested entity from either
+// end, and leaves out links whose other end no longer exists
+func (suite *LinkResolverTestSuite) TestLinked() {
+	suite.mockEntityLinkPeer.EXPECT().
+		Select(mock.Anything, LinksOf(schema.ENTITY_TYPE_WORD, []int{1, 2}), mock.Anything, mock.Anything, mock.Anything).
+		Return([]*dbModels.EntityLink{
+			newEntityLink(1, schema.ENTITY_TYPE_WORD, 1, schema.ENTITY_TYPE_NOTE, 6),
+			newEntityLink(2, schema.ENTITY_TYPE_WORD, 1, schema.ENTITY_TYPE_WORD, 2),
+			newEntityLink(3, schema.ENTITY_TYPE_WORD, 2, schema.ENTITY_TYPE_QUESTION, 8),
+		}, nil).Times(1)
+	suite.mockNotePeer.EXPECT().
+		Select(mock.Anything, squirrel.Eq{schema.NOTE_ID: []int{6}}, mock.Anything, mock.Anything, mock.Anything).
+		Return([]*dbModels.Note{{Id: utils.IntPtr(6), Title: utils.StrPtr("Phrasal verbs")}}, nil).Times(1)
+	suite.mockWordPeer.EXPECT().
+		Select(mock.Anything, squirrel.Eq{schema.WORD_ID: []int{2, 1}}, mock.Anything, mock.Anything, mock.Anything).
+		Return([]*dbModels.Word{
+			{Id: utils.IntPtr(1), Word: utils.StrPtr("look up")},
+			{Id: utils.IntPtr(2), Word: utils.StrPtr("look into")},
+		}, nil).Times(1)
+	// Question 8 has been deleted, so its link is dropped
+	suite.mockQuestionPeer.EXPECT().
+		Select(mock.Anything, squirrel.Eq{schema.QUESTION_ID: []int{8}}, mock.Anything, mock.Anything, mock.Anything).
+		Return([]*dbModels.Question{}, nil).Times(1)
+
+	linked, err := suite.resolver.Linked(schema.ENTITY_TYPE_WORD, []int{1, 2})
+
+	suite.NoError(err)
+	suite.Equal(map[int][]models.LinkedEntity{
+		1: {
+			{LinkID: 1, Type: schema.ENTITY_TYPE_NOTE, ID: 6, Label: "Phrasal verbs"},
+			{LinkID: 2, Type: schema.ENTITY_TYPE_WORD, ID: 2, Label: "look into"},
+		},
+		2: {
+			{LinkID: 2, Type: schema.ENTITY_TYPE_WORD, ID: 1, Label: "look up"},
+		},
+	}, linked)
+}
+
+// TestLinkedNoIDs tests that Linked skips the query when given no IDs
+func (suite *LinkResolverTestSuite) TestLinkedNoIDs() {
+	linked, err := suite.resolver.Linked(schema.ENTITY_TYPE_NOTE, nil)
+
+	suite.NoError(err)
+	suite.Empty(linked)
+}
+
+// TestLinkedErrors tests that Linked reports failures fetching links or labels
+func (suite *LinkResolverTestSuite) TestLinkedErrors() {
+	suite.Run("links select fails", func() {
+		suite.SetupTest()
+		suite.mockEntityLinkPeer.EXPECT().
+			Select(mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+			Return(nil, errors.New("select failed")).Times(1)
+
+		_, err := suite.resolver.Linked(schema.ENTITY_TYPE_NOTE, []int{1})
+		suite.Error(err)
+	})
+
+	suite.Run("labels select fails", func() {
+		suite.SetupTest()
+		suite.mockEntityLinkPeer.EXPECT().
+			Select(mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+			Return([]*dbModels.EntityLink{newEntityLink(1, schema.ENTITY_TYPE_WORD, 3, schema.ENTITY_TYPE_NOTE, 1)}, nil).Times(1)
+		suite.mockWordPeer.EXPECT().
+			Select(mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+			Return(nil, errors.New("select failed")).Times(1)
+
+		_, err := suite.resolver.Linked(schema.ENTITY_TYPE_NOTE, []int{1})
+		suite.Error(err)
+	})
+}
+
+// TestUnlink tests that Unlink removes the links and reports what they pointed to
+func (suite *LinkResolverTestSuite) TestUnlink() {
+	suite.mockEntityLinkPeer.EXPECT().
+		Select(mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+		Return([]*dbModels.EntityLink{newEntityLink(4, schema.ENTITY_TYPE_QUESTION, 2, schema.ENTITY_TYPE_NOTE, 5)}, nil).Times(1)
+	suite.mockQuestionPeer.EXPECT().
+		Select(mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+		Return([]*dbModels.Question{{Id: utils.IntPtr(2), Question: utils.StrPtr("Pick one")}}, nil).Times(1)
+	suite.mockEntityLinkPeer.EXPECT().
+		Delete(LinksOf(schema.ENTITY_TYPE_NOTE, []int{5})).
+		Return(int64(1), nil).Times(1)
+
+	unlinked, err := suite.resolver.Unlink(schema.ENTITY_TYPE_NOTE, 5)
+
+	suite.NoError(err)
+	suite.Equal([]models.LinkedEntity{{LinkID: 4, Type: schema.ENTITY_TYPE_QUESTION, ID: 2, Label: "Pick one"}}, unlinked)
+}
+
+// TestUnlinkNothingLinked tests that Unlink returns an empty, non-nil slice
+// for an entity without links
+func (suite *LinkResolverTestSuite) TestUnlinkNothingLinked() {
+	suite.mockEntityLinkPeer.EXPECT().
+		Select(mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+		Return([]*dbModels.EntityLink{}, nil).Times(1)
+	suite.mockEntityLinkPeer.EXPECT().
+		Delete(mock.Anything).
+		Return(int64(0), nil).Times(1)
+
+	unlinked, err := suite.resolver.Unlink(schema.ENTITY_TYPE_WORD, 1)
+
+	suite.NoError(err)
+	suite.NotNil(unlinked)
+	suite.Empty(unlinked)
+}
+
+// TestUnlinkDeleteError tests that Unlink reports a failure removing the links
+func (suite *LinkResolverTestSuite) TestUnlinkDeleteError() {
+	suite.mockEntityLinkPeer.EXPECT().
+		Select(mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+		Return([]*dbModels.EntityLink{}, nil).Times(1)
+	suite.mockEntityLinkPeer.EXPECT().
+		Delete(mock.Anything).
+		Return(int64(0), errors.New("delete failed")).Times(1)
+
+	_, err := suite.resolver.Unlink(schema.ENTITY_TYPE_WORD, 1)
+
+	suite.Error(err)
+}
@@ -5,6 +5,8 @@ import (
 	"log/slog"
 	"regexp"
 
+	"word-flashcard/utils"
+
 	"github.com/gin-gonic/gin"
 )
 
@@ -12,10 +14,10 @@ import (
 // This middleware will log all requests including 404 errors
 func LoggingMiddleware() gin.HandlerFunc {
 	return gin.LoggerWithFormatter(func(param gin.LogFormatterParams) string {
-		// Build request path with query parameters
+		// Build request path with query parameters, secrets redacted
 		requestPath := param.Path
 		if param.Request.URL.RawQuery != "" {
-			requestPath += "?" + param.Request.URL.RawQuery
+			requestPath += "?" + utils.RedactQuery(param.Request.URL.RawQuery)
 		}
 
 		// Skip local successful requests to /api/health
@@ -52,7 +54,7 @@ func CORSMiddleware() gin.HandlerFunc {
 	return func(c *gin.Context) {
 		c.Header("Access-Control-Allow-Origin", "*")
 		c.Header("Access-Control-Allow-Methods", "GET, POST, PUT, DELETE, OPTIONS")
-		c.Header("Access-Control-Allow-Headers", "Content-Type, Authorization")
+		c.Header("Access-Control-Allow-Headers", "Content-Type, Authorization, X-Timezone")
 		c.Header("Access-Control-Max-Age", "86400")
 
 		if c.Request.Method == "OPTIONS" {
@@ -6,6 +6,7 @@ import (
 	"runtime/debug"
 
 	"word-flashcard/internal/models"
+	"word-flashcard/utils"
 
 	"github.com/gin-gonic/gin"
 )
@@ -20,7 +21,7 @@ func RecoveryMiddleware() gin.HandlerFunc {
 		defer func() {
 			if rec := recover(); rec != nil {
 				slog.Error("Panic recovered",
-					"path", c.Request.RequestURI,
+					"path", utils.RedactURI(c.Request.RequestURI),
 					"panic", rec,
 					"stack", string(debug.Stack()),
 				)
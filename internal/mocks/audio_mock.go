@@ -0,0 +1,24 @@
+package mocks
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+)
+
+// MockAudioController is a mock implementation for AudioController
+type MockAudioController struct{}
+
+// NewMockAudioController creates a new mock audio controller instance
+func NewMockAudioController() *MockAudioController {
+	return &MockAudioController{}
+}
+
+// GetAudio mock implementation
+func (m *MockAudioController) GetAudio(c *gin.Context) {
+	c.JSON(http.StatusOK, gin.H{
+		"method":     "GetAudio",
+		"controller": "AudioController",
+		"status":     "ok",
+	})
+}
@@ -0,0 +1,24 @@
+package mocks
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+)
+
+// MockCalendarController is a mock implementation for CalendarController
+type MockCalendarController struct{}
+
+// NewMockCalendarController creates a new mock calendar controller instance
+func NewMockCalendarController() *MockCalendarController {
+	return &MockCalendarController{}
+}
+
+// GetCalendarFeed mock implementation
+func (m *MockCalendarController) GetCalendarFeed(c *gin.Context) {
+	c.JSON(http.StatusOK, gin.H{
+		"method":     "GetCalendarFeed",
+		"controller": "CalendarController",
+		"status":     "ok",
+	})
+}
@@ -22,3 +22,57 @@ func (m *MockDictionaryController) SearchWord(c *gin.Context) {
 		"word":       c.Param("word"),
 	})
 }
+
+// ImportDictionary mock implementation
+func (m *MockDictionaryController) ImportDictionary(c *gin.Context) {
+	c.JSON(http.StatusOK, gin.H{
+		"method":     "ImportDictionary",
+		"controller": "DictionaryController",
+		"status":     "ok",
+	})
+}
+
+// SuggestWords mock implementation
+func (m *MockDictionaryController) SuggestWords(c *gin.Context) {
+	c.JSON(http.StatusOK, gin.H{
+		"method":     "SuggestWords",
+		"controller": "DictionaryController",
+		"status":     "ok",
+	})
+}
+
+// GetCacheStats mock implementation
+func (m *MockDictionaryController) GetCacheStats(c *gin.Context) {
+	c.JSON(http.StatusOK, gin.H{
+		"method":     "GetCacheStats",
+		"controller": "DictionaryController",
+		"status":     "ok",
+	})
+}
+
+// PurgeCache mock implementation
+func (m *MockDictionaryController) PurgeCache(c *gin.Context) {
+	c.JSON(http.StatusOK, gin.H{
+		"method":     "PurgeCache",
+		"controller": "DictionaryController",
+		"status":     "ok",
+	})
+}
+
+// BatchLookup mock implementation
+func (m *MockDictionaryController) BatchLookup(c *gin.Context) {
+	c.JSON(http.StatusOK, gin.H{
+		"method":     "BatchLookup",
+		"controller": "DictionaryController",
+		"status":     "ok",
+	})
+}
+
+// CreateWordFromDictionary mock implementation
+func (m *MockDictionaryController) CreateWordFromDictionary(c *gin.Context) {
+	c.JSON(http.StatusOK, gin.H{
+		"method":     "CreateWordFromDictionary",
+		"controller": "DictionaryController",
+		"status":     "ok",
+	})
+}
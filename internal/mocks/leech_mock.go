@@ -0,0 +1,51 @@
+package mocks
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+)
+
+// MockLeechController is a mock implementation for LeechController
+type MockLeechController struct{}
+
+// NewMockLeechController creates a new mock leech controller instance
+func NewMockLeechController() *MockLeechController {
+	return &MockLeechController{}
+}
+
+// ListLeeches mock implementation
+func (m *MockLeechController) ListLeeches(c *gin.Context) {
+	c.JSON(http.StatusOK, gin.H{
+		"method":     "ListLeeches",
+		"controller": "LeechController",
+		"status":     "ok",
+	})
+}
+
+// ClearLeech mock implementation
+func (m *MockLeechController) ClearLeech(c *gin.Context) {
+	c.JSON(http.StatusOK, gin.H{
+		"method":     "ClearLeech",
+		"controller": "LeechController",
+		"status":     "ok",
+	})
+}
+
+// GetLeechSettings mock implementation
+func (m *MockLeechController) GetLeechSettings(c *gin.Context) {
+	c.JSON(http.StatusOK, gin.H{
+		"method":     "GetLeechSettings",
+		"controller": "LeechController",
+		"status":     "ok",
+	})
+}
+
+// UpdateLeechSettings mock implementation
+func (m *MockLeechController) UpdateLeechSettings(c *gin.Context) {
+	c.JSON(http.StatusOK, gin.H{
+		"method":     "UpdateLeechSettings",
+		"controller": "LeechController",
+		"status":     "ok",
+	})
+}
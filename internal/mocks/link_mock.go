@@ -0,0 +1,42 @@
+package mocks
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+)
+
+// MockLinkController is a mock implementation for LinkController
+type MockLinkController struct{}
+
+// NewMockLinkController creates a new mock link controller instance
+func NewMockLinkController() *MockLinkController {
+	return &MockLinkController{}
+}
+
+// ListLinks mock implementation
+func (m *MockLinkController) ListLinks(c *gin.Context) {
+	c.JSON(http.StatusOK, gin.H{
+		"method":     "ListLinks",
+		"controller": "LinkController",
+		"status":     "ok",
+	})
+}
+
+// CreateLink mock implementation
+func (m *MockLinkController) CreateLink(c *gin.Context) {
+	c.JSON(http.StatusOK, gin.H{
+		"method":     "CreateLink",
+		"controller": "LinkController",
+		"status":     "ok",
+	})
+}
+
+// DeleteLink mock implementation
+func (m *MockLinkController) DeleteLink(c *gin.Context) {
+	c.JSON(http.StatusOK, gin.H{
+		"method":     "DeleteLink",
+		"controller": "LinkController",
+		"status":     "ok",
+	})
+}
@@ -0,0 +1,69 @@
+package mocks
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+)
+
+// MockProgressController is a mock implementation for ProgressController
+type MockProgressController struct{}
+
+// NewMockProgressController creates a new mock progress controller instance
+func NewMockProgressController() *MockProgressController {
+	return &MockProgressController{}
+}
+
+// GetStreak mock implementation
+func (m *MockProgressController) GetStreak(c *gin.Context) {
+	c.JSON(http.StatusOK, gin.H{
+		"method":     "GetStreak",
+		"controller": "ProgressController",
+		"status":     "ok",
+	})
+}
+
+// GetDailyGoal mock implementation
+func (m *MockProgressController) GetDailyGoal(c *gin.Context) {
+	c.JSON(http.StatusOK, gin.H{
+		"method":     "GetDailyGoal",
+		"controller": "ProgressController",
+		"status":     "ok",
+	})
+}
+
+// UpdateDailyGoal mock implementation
+func (m *MockProgressController) UpdateDailyGoal(c *gin.Context) {
+	c.JSON(http.StatusOK, gin.H{
+		"method":     "UpdateDailyGoal",
+		"controller": "ProgressController",
+		"status":     "ok",
+	})
+}
+
+// GetForecast mock implementation
+func (m *MockProgressController) GetForecast(c *gin.Context) {
+	c.JSON(http.StatusOK, gin.H{
+		"method":     "GetForecast",
+		"controller": "ProgressController",
+		"status":     "ok",
+	})
+}
+
+// GetReportTimezone mock implementation
+func (m *MockProgressController) GetReportTimezone(c *gin.Context) {
+	c.JSON(http.StatusOK, gin.H{
+		"method":     "GetReportTimezone",
+		"controller": "ProgressController",
+		"status":     "ok",
+	})
+}
+
+// UpdateReportTimezone mock implementation
+func (m *MockProgressController) UpdateReportTimezone(c *gin.Context) {
+	c.JSON(http.StatusOK, gin.H{
+		"method":     "UpdateReportTimezone",
+		"controller": "ProgressController",
+		"status":     "ok",
+	})
+}
@@ -103,3 +103,39 @@ func (m *MockQuestionController) GetQuestionsTrend(c *gin.Context) {
 		"status":     "ok",
 	})
 }
+
+// GenerateQuestions mock implementation
+func (m *MockQuestionController) GenerateQuestions(c *gin.Context) {
+	c.JSON(http.StatusOK, gin.H{
+		"method":     "GenerateQuestions",
+		"controller": "QuestionController",
+		"status":     "ok",
+	})
+}
+
+// GetQuestionAnalysis mock implementation
+func (m *MockQuestionController) GetQuestionAnalysis(c *gin.Context) {
+	c.JSON(http.StatusOK, gin.H{
+		"method":     "GetQuestionAnalysis",
+		"controller": "QuestionController",
+		"status":     "ok",
+	})
+}
+
+// GetQuestionsAnalysis mock implementation
+func (m *MockQuestionController) GetQuestionsAnalysis(c *gin.Context) {
+	c.JSON(http.StatusOK, gin.H{
+		"method":     "GetQuestionsAnalysis",
+		"controller": "QuestionController",
+		"status":     "ok",
+	})
+}
+
+// GetQuestionsSpeed mock implementation
+func (m *MockQuestionController) GetQuestionsSpeed(c *gin.Context) {
+	c.JSON(http.StatusOK, gin.H{
+		"method":     "GetQuestionsSpeed",
+		"controller": "QuestionController",
+		"status":     "ok",
+	})
+}
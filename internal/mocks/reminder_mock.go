@@ -0,0 +1,78 @@
+package mocks
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+)
+
+// MockReminderController is a mock implementation for ReminderController
+type MockReminderController struct{}
+
+// NewMockReminderController creates a new mock reminder controller instance
+func NewMockReminderController() *MockReminderController {
+	return &MockReminderController{}
+}
+
+// ListReminders mock implementation
+func (m *MockReminderController) ListReminders(c *gin.Context) {
+	c.JSON(http.StatusOK, gin.H{
+		"method":     "ListReminders",
+		"controller": "ReminderController",
+		"status":     "ok",
+	})
+}
+
+// ListDueReminders mock implementation
+func (m *MockReminderController) ListDueReminders(c *gin.Context) {
+	c.JSON(http.StatusOK, gin.H{
+		"method":     "ListDueReminders",
+		"controller": "ReminderController",
+		"status":     "ok",
+	})
+}
+
+// ListReminderHistory mock implementation
+func (m *MockReminderController) ListReminderHistory(c *gin.Context) {
+	c.JSON(http.StatusOK, gin.H{
+		"method":     "ListReminderHistory",
+		"controller": "ReminderController",
+		"status":     "ok",
+	})
+}
+
+// CreateReminder mock implementation
+func (m *MockReminderController) CreateReminder(c *gin.Context) {
+	c.JSON(http.StatusOK, gin.H{
+		"method":     "CreateReminder",
+		"controller": "ReminderController",
+		"status":     "ok",
+	})
+}
+
+// UpdateReminder mock implementation
+func (m *MockReminderController) UpdateReminder(c *gin.Context) {
+	c.JSON(http.StatusOK, gin.H{
+		"method":     "UpdateReminder",
+		"controller": "ReminderController",
+		"status":     "ok",
+	})
+}
+
+// ClearReminder mock implementation
+func (m *MockReminderController) ClearReminder(c *gin.Context) {
+	c.JSON(http.StatusOK, gin.H{
+		"method":     "ClearReminder",
+		"controller": "ReminderController",
+		"status":     "ok",
+	})
+}
+
+// DeleteReminder mock implementation
+func (m *MockReminderController) DeleteReminder(c *gin.Context) {
+	c.JSON(http.StatusOK, gin.H{
+		"method":     "DeleteReminder",
+		"controller": "ReminderController",
+		"status":     "ok",
+	})
+}
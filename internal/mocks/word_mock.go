@@ -130,3 +130,75 @@ func (m *MockWordController) GetWordsTrend(c *gin.Context) {
 		"status":     "ok",
 	})
 }
+
+// GetWordsRetention mock implementation
+func (m *MockWordController) GetWordsRetention(c *gin.Context) {
+	c.JSON(http.StatusOK, gin.H{
+		"method":     "GetWordsRetention",
+		"controller": "WordController",
+		"status":     "ok",
+	})
+}
+
+// GetWordsSpeed mock implementation
+func (m *MockWordController) GetWordsSpeed(c *gin.Context) {
+	c.JSON(http.StatusOK, gin.H{
+		"method":     "GetWordsSpeed",
+		"controller": "WordController",
+		"status":     "ok",
+	})
+}
+
+// RandomSpellingPrompts mock implementation
+func (m *MockWordController) RandomSpellingPrompts(c *gin.Context) {
+	c.JSON(http.StatusOK, gin.H{
+		"method":     "RandomSpellingPrompts",
+		"controller": "WordController",
+		"status":     "ok",
+	})
+}
+
+// AnswerSpelling mock implementation
+func (m *MockWordController) AnswerSpelling(c *gin.Context) {
+	c.JSON(http.StatusOK, gin.H{
+		"method":     "AnswerSpelling",
+		"controller": "WordController",
+		"status":     "ok",
+	})
+}
+
+// RandomClozePrompts mock implementation
+func (m *MockWordController) RandomClozePrompts(c *gin.Context) {
+	c.JSON(http.StatusOK, gin.H{
+		"method":     "RandomClozePrompts",
+		"controller": "WordController",
+		"status":     "ok",
+	})
+}
+
+// AnswerCloze mock implementation
+func (m *MockWordController) AnswerCloze(c *gin.Context) {
+	c.JSON(http.StatusOK, gin.H{
+		"method":     "AnswerCloze",
+		"controller": "WordController",
+		"status":     "ok",
+	})
+}
+
+// ListDuplicateWords mock implementation
+func (m *MockWordController) ListDuplicateWords(c *gin.Context) {
+	c.JSON(http.StatusOK, gin.H{
+		"method":     "ListDuplicateWords",
+		"controller": "WordController",
+		"status":     "ok",
+	})
+}
+
+// MergeWords mock implementation
+func (m *MockWordController) MergeWords(c *gin.Context) {
+	c.JSON(http.StatusOK, gin.H{
+		"method":     "MergeWords",
+		"controller": "WordController",
+		"status":     "ok",
+	})
+}
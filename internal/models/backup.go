@@ -9,3 +9,13 @@ type BackupFile struct {
 	SizeBytes  int64     `json:"size_bytes"`
 	ModifiedAt time.Time `json:"modified_at"`
 }
+
+// BackupSchedule describes when the automatic backup scheduler writes its
+// next backup. Enabled is false when BACKUP_ENABLED=false, in which case no
+// backup is scheduled. LastBackupAt is nil before the first backup.
+type BackupSchedule struct {
+	Enabled       bool       `json:"enabled"`
+	IntervalHours int        `json:"interval_hours"`
+	LastBackupAt  *time.Time `json:"last_backup_at,omitempty"`
+	NextBackupAt  *time.Time `json:"next_backup_at,omitempty"`
+}
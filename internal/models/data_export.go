@@ -20,9 +20,23 @@ type DataExport struct {
 	Words              []*models.Word              `json:"words"`
 	WordDefinitions    []*models.WordDefinition    `json:"word_definitions"`
 	Questions          []*models.Question          `json:"questions"`
+	QuestionOptions    []*models.QuestionOption    `json:"question_options"`
 	QuestionAnswerLogs []*models.QuestionAnswerLog `json:"question_answer_logs"`
 	WordPracticeLogs   []*models.WordPracticeLog   `json:"word_practice_logs"`
 	Notes              []*models.Note              `json:"notes"`
+	EntityLinks        []*models.EntityLink        `json:"entity_links"`
+	Settings           []*models.Setting           `json:"settings"`
+	Reminders          []*models.Reminder          `json:"reminders"`
+	ReminderLogs       []*models.ReminderLog       `json:"reminder_logs"`
+	AudioFiles         []*AudioFileBundle          `json:"audio_files"`
+}
+
+// AudioFileBundle is one audio_files row together with the bytes of the
+// recording it points at (base64 in JSON), so an export carries the stored
+// pronunciation audio as well as the rows referencing it.
+type AudioFileBundle struct {
+	models.AudioFile
+	Data []byte `json:"data" swaggertype:"string" format:"base64"`
 }
 
 // ImportSummary reports how many rows were written to each table by a
@@ -31,7 +45,13 @@ type ImportSummary struct {
 	Words              int `json:"words"`
 	WordDefinitions    int `json:"word_definitions"`
 	Questions          int `json:"questions"`
+	QuestionOptions    int `json:"question_options"`
 	QuestionAnswerLogs int `json:"question_answer_logs"`
 	WordPracticeLogs   int `json:"word_practice_logs"`
 	Notes              int `json:"notes"`
+	EntityLinks        int `json:"entity_links"`
+	Settings           int `json:"settings"`
+	Reminders          int `json:"reminders"`
+	ReminderLogs       int `json:"reminder_logs"`
+	AudioFiles         int `json:"audio_files"`
 }
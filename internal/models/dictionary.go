@@ -1,38 +1,310 @@
 package models
 
-// Cambridge Dictionary response structures, assembled directly by the Go scraper
-// in internal/controllers/dictionary and returned as-is to API clients.
-type CambridgeResponse struct {
-	Word          string                   `json:"word"`
-	POS           []string                 `json:"pos"`
-	Verbs         []CambridgeVerb          `json:"verbs"`
-	Pronunciation []CambridgePronunciation `json:"pronunciation"`
-	Definition    []CambridgeDefinition    `json:"definition"`
+import (
+	"strings"
+	"time"
+)
+
+// DictionaryResult is a dictionary lookup result, whichever provider served it.
+// Every provider fills the shape the Cambridge scraper originally returned, so
+// API clients read all of them the same way; Provider names the one that
+// answered.
+type DictionaryResult struct {
+	Word          string                    `json:"word"`
+	Provider      string                    `json:"provider"`
+	POS           []string                  `json:"pos"`
+	Verbs         []DictionaryVerb          `json:"verbs"`
+	Pronunciation []DictionaryPronunciation `json:"pronunciation"`
+	Definition    []DictionaryDefinition    `json:"definition"`
+	PhrasalVerbs  []DictionaryPhrase        `json:"phrasal_verbs,omitempty"`
+	Idioms        []DictionaryPhrase        `json:"idioms,omitempty"`
 }
 
-type CambridgeVerb struct {
+// DictionaryVerb is an inflected or irregular form of the word, e.g.
+// {Type: "past tense", Text: "went"}
+type DictionaryVerb struct {
 	ID   int    `json:"id"`
 	Type string `json:"type"`
 	Text string `json:"text"`
 }
 
-type CambridgePronunciation struct {
+type DictionaryPronunciation struct {
 	POS  string `json:"pos"`
 	Lang string `json:"lang"`
 	URL  string `json:"url"`
 	Pron string `json:"pron"`
 }
 
-type CambridgeDefinition struct {
-	ID          int                `json:"id"`
-	POS         string             `json:"pos"`
-	Text        string             `json:"text"`
-	Translation string             `json:"translation"`
-	Example     []CambridgeExample `json:"example"`
+// DictionaryDefinition is one sense of the word. Level is its CEFR level
+// (A1-C2), Grammar its grammar codes without brackets (e.g. "C", "U", "T")
+// and Labels its usage labels (e.g. "informal", "mainly UK").
+type DictionaryDefinition struct {
+	ID          int                 `json:"id"`
+	POS         string              `json:"pos"`
+	Text        string              `json:"text"`
+	Translation string              `json:"translation"`
+	Example     []DictionaryExample `json:"example"`
+	Level       string              `json:"level,omitempty"`
+	Grammar     []string            `json:"grammar,omitempty"`
+	Labels      []string            `json:"labels,omitempty"`
+	Synonyms    []string            `json:"synonyms,omitempty"`
+	Antonyms    []string            `json:"antonyms,omitempty"`
+}
+
+// DictionaryPhrase is a phrasal verb or idiom containing the word. Phrases
+// only listed as cross-references have no definitions.
+type DictionaryPhrase struct {
+	Phrase     string                 `json:"phrase"`
+	Definition []DictionaryDefinition `json:"definition"`
 }
 
-type CambridgeExample struct {
+type DictionaryExample struct {
 	ID          int    `json:"id"`
 	Text        string `json:"text"`
 	Translation string `json:"translation"`
 }
+
+// DictionaryImportRequest asks for a dictionary dump on the server to be
+// imported into an offline provider. Path is relative to DICTIONARY_IMPORT_DIR.
+type DictionaryImportRequest struct {
+	Format   *string `json:"format"`
+	Language *string `json:"language"`
+	Path     *string `json:"path"`
+	LangCode *string `json:"lang_code,omitempty"`
+	Replace  bool    `json:"replace"`
+}
+
+// DictionaryImportSummary reports what an import stored
+type DictionaryImportSummary struct {
+	Format   string `json:"format"`
+	Provider string `json:"provider"`
+	Language string `json:"language"`
+	Entries  int    `json:"entries"`
+	Skipped  int    `json:"skipped"`
+}
+
+// DictionarySuggestion is a headword proposed for a partial or misspelt word.
+// Match is "exact", "prefix" or "fuzzy".
+type DictionarySuggestion struct {
+	Word     string `json:"word"`
+	Provider string `json:"provider"`
+	Match    string `json:"match"`
+}
+
+// DictionaryCacheStats summarises the persistent dictionary lookup cache.
+// Expired entries are still stored until they are next read or purged.
+type DictionaryCacheStats struct {
+	Entries        int                            `json:"entries"`
+	Expired        int                            `json:"expired"`
+	Hits           int                            `json:"hits"`
+	MaxEntries     int                            `json:"max_entries"`
+	TTLHours       int                            `json:"ttl_hours"`
+	OldestCachedAt *time.Time                     `json:"oldest_cached_at"`
+	Providers      []DictionaryCacheProviderStats `json:"providers"`
+}
+
+// DictionaryCacheProviderStats counts the cached entries of one provider
+type DictionaryCacheProviderStats struct {
+	Provider string `json:"provider"`
+	Entries  int    `json:"entries"`
+	Hits     int    `json:"hits"`
+}
+
+// DictionaryCachePurgeResult reports how many cache entries were deleted
+type DictionaryCachePurgeResult struct {
+	Purged int64 `json:"purged"`
+}
+
+// DictionaryBatchRequest asks for many words to be looked up at once. With
+// Create set, every word found is also added to the word list with the
+// fetched definitions.
+type DictionaryBatchRequest struct {
+	Words    []string `json:"words"`
+	Language *string  `json:"language"`
+	Provider *string  `json:"provider,omitempty"`
+	Create   bool     `json:"create"`
+}
+
+// Batch lookup statuses. A word is found, or with create also created or
+// already in the word list as existing; not_found when no provider has it
+// and failed when the lookup or the insert failed.
+const (
+	DictionaryBatchFound    = "found"
+	DictionaryBatchCreated  = "created"
+	DictionaryBatchExisting = "existing"
+	DictionaryBatchNotFound = "not_found"
+	DictionaryBatchFailed   = "failed"
+)
+
+// Types of the lines streamed back by a batch lookup
+const (
+	DictionaryBatchEventProgress = "progress"
+	DictionaryBatchEventSummary  = "summary"
+)
+
+// DictionaryBatchProgress is streamed once per word as its lookup finishes.
+// Words finish in any order; Index is the word's position in the request
+// after blank and repeated words were dropped, Completed how many of Total
+// have finished so far. Attempts counts the requests sent to providers,
+// retries included; it is 0 for a word answered from the cache.
+type DictionaryBatchProgress struct {
+	Type      string            `json:"type"`
+	Index     int               `json:"index"`
+	Word      string            `json:"word"`
+	Status    string            `json:"status"`
+	Attempts  int               `json:"attempts"`
+	Completed int               `json:"completed"`
+	Total     int               `json:"total"`
+	Result    *DictionaryResult `json:"result,omitempty"`
+	WordID    *int              `json:"word_id,omitempty"`
+	Error     string            `json:"error,omitempty"`
+}
+
+// DictionaryBatchSummary is the last line streamed by a batch lookup,
+// counting the words that ended in each status
+type DictionaryBatchSummary struct {
+	Type     string `json:"type"`
+	Total    int    `json:"total"`
+	Found    int    `json:"found"`
+	Created  int    `json:"created"`
+	Existing int    `json:"existing"`
+	NotFound int    `json:"not_found"`
+	Failed   int    `json:"failed"`
+}
+
+// Add counts one finished word
+func (s *DictionaryBatchSummary) Add(status string) {
+	switch status {
+	case DictionaryBatchFound:
+		s.Found++
+	case DictionaryBatchCreated:
+		s.Created++
+	case DictionaryBatchExisting:
+		s.Existing++
+	case DictionaryBatchNotFound:
+		s.NotFound++
+	default:
+		s.Failed++
+	}
+}
+
+// WordFromDictionaryRequest asks for a word to be looked up and saved with
+// the definitions picked from the result. DefinitionIDs are the IDs of the
+// lookup result's definitions; when empty, all of them are saved.
+type WordFromDictionaryRequest struct {
+	Word          *string `json:"word"`
+	Language      *string `json:"language"`
+	Provider      *string `json:"provider,omitempty"`
+	DefinitionIDs []int   `json:"definition_ids"`
+}
+
+// WordFromDictionaryResult is the saved word together with whether it was
+// created or merged into, and how many definitions were added or skipped
+// because the word already had them
+type WordFromDictionaryResult struct {
+	Created bool `json:"created"`
+	Added   int  `json:"added"`
+	Skipped int  `json:"skipped"`
+	Word    Word `json:"word"`
+}
+
+// SelectDefinitions keeps only the definitions with the given IDs, in the
+// order they appear in the result, and returns any IDs that matched none.
+// No IDs keeps every definition.
+func (r *DictionaryResult) SelectDefinitions(ids []int) []int {
+	if len(ids) == 0 {
+		return nil
+	}
+
+	wanted := make(map[int]bool, len(ids))
+	for _, id := range ids {
+		wanted[id] = true
+	}
+	selected := make([]DictionaryDefinition, 0, len(ids))
+	for _, definition := range r.Definition {
+		if wanted[definition.ID] {
+			selected = append(selected, definition)
+			delete(wanted, definition.ID)
+		}
+	}
+	r.Definition = selected
+
+	var missing []int
+	for _, id := range ids {
+		if wanted[id] {
+			missing = append(missing, id)
+			delete(wanted, id)
+		}
+	}
+	return missing
+}
+
+// ToWordDefinitions converts the senses of a lookup result into word
+// definitions, filled the way the definition form fills them from a
+// dictionary entry: the translation before the definition text, each example
+// followed by its translation, and the UK and US recordings for the sense's
+// part of speech as phonetics.
+func (r *DictionaryResult) ToWordDefinitions() []WordDefinition {
+	definitions := make([]WordDefinition, 0, len(r.Definition))
+	for _, sense := range r.Definition {
+		examples := make([]string, 0, len(sense.Example))
+		for _, example := range sense.Example {
+			examples = append(examples, strings.TrimSpace(example.Text+" "+example.Translation))
+		}
+
+		phonetics := map[string]interface{}{}
+		for _, lang := range []string{"uk", "us"} {
+			if url := r.pronunciationURL(lang, sense.POS); url != "" {
+				phonetics[lang] = url
+			}
+		}
+
+		definition := WordDefinition{
+			PartOfSpeech: nonEmpty(sense.POS),
+			Definition:   nonEmpty(strings.TrimSpace(sense.Translation + " " + sense.Text)),
+			Phonetics:    &phonetics,
+			Examples:     &examples,
+			CEFRLevel:    nonEmpty(sense.Level),
+		}
+		if len(sense.Grammar) > 0 {
+			definition.Grammar = &sense.Grammar
+		}
+		if len(sense.Labels) > 0 {
+			definition.Labels = &sense.Labels
+		}
+		if len(sense.Synonyms) > 0 {
+			definition.Synonyms = &sense.Synonyms
+		}
+		if len(sense.Antonyms) > 0 {
+			definition.Antonyms = &sense.Antonyms
+		}
+		definitions = append(definitions, definition)
+	}
+	return definitions
+}
+
+// pronunciationURL returns the recording in lang for pos, falling back to
+// the first recording in lang when none is marked with pos
+func (r *DictionaryResult) pronunciationURL(lang, pos string) string {
+	fallback := ""
+	for _, pronunciation := range r.Pronunciation {
+		if pronunciation.Lang != lang || pronunciation.URL == "" {
+			continue
+		}
+		if pronunciation.POS == pos {
+			return pronunciation.URL
+		}
+		if fallback == "" {
+			fallback = pronunciation.URL
+		}
+	}
+	return fallback
+}
+
+// nonEmpty returns a pointer to s, or nil when s is empty
+func nonEmpty(s string) *string {
+	if s == "" {
+		return nil
+	}
+	return &s
+}
@@ -0,0 +1,49 @@
+package models
+
+import (
+	"encoding/json"
+	"time"
+)
+
+// LeechSettings configures leech detection: a word becomes a leech after
+// word_lapses lapses and a question after question_failures wrong answers,
+// and with auto_suspend a new leech is also left out of random quizzes.
+// A threshold of 0 turns detection off. It is the response of
+// GET /api/leeches/settings and the request body of PUT
+// /api/leeches/settings, where an omitted field keeps its current value.
+type LeechSettings struct {
+	WordLapses       *int  `json:"word_lapses"`
+	QuestionFailures *int  `json:"question_failures"`
+	AutoSuspend      *bool `json:"auto_suspend"`
+}
+
+// LeechLapse is one lapse in a leech's history: a practice that sent a
+// word back to red from previous_familiarity, or a wrong answer to a
+// question with what was answered
+type LeechLapse struct {
+	At                  time.Time       `json:"at"`
+	PreviousFamiliarity *string         `json:"previous_familiarity,omitempty"`
+	SelectedOption      *string         `json:"selected_option,omitempty"`
+	Response            json.RawMessage `json:"response,omitempty"`
+}
+
+// Leech is a word or question tagged as a leech, with its lapses, most
+// recent first. LapseCount counts every lapse, while Lapses holds at most
+// the latest 20.
+type Leech struct {
+	Type        string       `json:"type"`
+	ID          int          `json:"id"`
+	Text        string       `json:"text"`
+	Familiarity *string      `json:"familiarity,omitempty"`
+	IsSuspended bool         `json:"is_suspended"`
+	LapseCount  int          `json:"lapse_count"`
+	LastLapseAt *time.Time   `json:"last_lapse_at"`
+	Lapses      []LeechLapse `json:"lapses"`
+}
+
+// LeechList is the response of GET /api/leeches: the leeches, most lapsed
+// first, and the settings they were detected with
+type LeechList struct {
+	Settings LeechSettings `json:"settings"`
+	Leeches  []Leech       `json:"leeches"`
+}
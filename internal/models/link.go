@@ -0,0 +1,58 @@
+package models
+
+import (
+	"time"
+	"word-flashcard/data/models"
+)
+
+// EntityLink represents a link between two entities, used in both requests
+// and responses. Links are undirected: the server stores the two ends in a
+// fixed order, so the source and target of a created link may come back
+// swapped.
+type EntityLink struct {
+	ID         *int       `json:"id"`
+	SourceType *string    `json:"source_type"`
+	SourceID   *int       `json:"source_id"`
+	TargetType *string    `json:"target_type"`
+	TargetID   *int       `json:"target_id"`
+	CreatedAt  *time.Time `json:"created_at,omitempty"`
+}
+
+// FromDataModel converts a data model EntityLink to the API model EntityLink
+func (l *EntityLink) FromDataModel(dbLink *models.EntityLink) *EntityLink {
+	l.ID = dbLink.Id
+	l.SourceType = dbLink.SourceType
+	l.SourceID = dbLink.SourceId
+	l.TargetType = dbLink.TargetType
+	l.TargetID = dbLink.TargetId
+	l.CreatedAt = dbLink.CreatedAt
+	return l
+}
+
+// ToDataModel converts the API model EntityLink to the data model EntityLink
+func (l *EntityLink) ToDataModel() *models.EntityLink {
+	return &models.EntityLink{
+		Id:         l.ID,
+		SourceType: l.SourceType,
+		SourceId:   l.SourceID,
+		TargetType: l.TargetType,
+		TargetId:   l.TargetID,
+	}
+}
+
+// LinkedEntity is the other end of a link, as embedded in a word, question or
+// note response. Label is the word itself, the question text or the note
+// title, so a client can show the link without fetching the entity.
+type LinkedEntity struct {
+	LinkID int    `json:"link_id"`
+	Type   string `json:"type"`
+	ID     int    `json:"id"`
+	Label  string `json:"label"`
+}
+
+// WordDeleteResult is the response of DELETE /api/words/{id}, listing what
+// the deleted word was linked to. Those links are removed with the word.
+type WordDeleteResult struct {
+	ID       int            `json:"id"`
+	Unlinked []LinkedEntity `json:"unlinked"`
+}
@@ -7,11 +7,12 @@ import (
 
 // Note represents a note card used in both requests and responses
 type Note struct {
-	ID        *int       `json:"id"`
-	Title     *string    `json:"title"`
-	Content   *string    `json:"content"`
-	SortOrder *int       `json:"sort_order"`
-	UpdatedAt *time.Time `json:"updated_at"`
+	ID        *int           `json:"id"`
+	Title     *string        `json:"title"`
+	Content   *string        `json:"content"`
+	SortOrder *int           `json:"sort_order"`
+	UpdatedAt *time.Time     `json:"updated_at"`
+	Links     []LinkedEntity `json:"links,omitempty"`
 }
 
 // FromDataModel converts a data model Note to the API model Note
@@ -0,0 +1,83 @@
+package models
+
+// DailyGoal is how many word reviews and question answers make a day count
+// toward the study streak. It is the response of GET /api/progress/goal and
+// the request body of PUT /api/progress/goal, where an omitted field keeps
+// its current value.
+type DailyGoal struct {
+	Words     *int `json:"words"`
+	Questions *int `json:"questions"`
+}
+
+// ReportTimezone is the stored timezone reports and the study streak count
+// calendar days in. It is the response of GET /api/progress/timezone and the
+// request body of PUT /api/progress/timezone, where "" clears the stored
+// timezone. Effective is the timezone in use when a request names none:
+// the stored one, else REPORT_TIMEZONE.
+type ReportTimezone struct {
+	Timezone  *string `json:"timezone"`
+	Effective string  `json:"effective,omitempty"`
+}
+
+// DailyProgress is one calendar day's activity measured against the daily
+// goal. Progress is the share of the goal reached, in percent, with each
+// part capped at its own target so extra questions can't make up for
+// missing word reviews.
+type DailyProgress struct {
+	Date          string  `json:"date"`
+	WordCount     int     `json:"word_count"`
+	QuestionCount int     `json:"question_count"`
+	Progress      float64 `json:"progress"`
+	GoalMet       bool    `json:"goal_met"`
+}
+
+// HeatmapDay is one cell of the activity heatmap. Level grades the day from
+// 0 (no activity) through 1 (under half the goal), 2 (under the goal) and
+// 3 (goal met) to 4 (at least twice the goal).
+type HeatmapDay struct {
+	Date          string `json:"date"`
+	WordCount     int    `json:"word_count"`
+	QuestionCount int    `json:"question_count"`
+	Level         int    `json:"level"`
+}
+
+// Streak is the response of GET /api/progress/streak. A day with the goal
+// met extends the streak; today never breaks it while still in progress.
+// Every 7 consecutive met days earn a freeze day (at most 2 banked), which
+// is spent automatically to bridge a missed day instead of resetting the
+// streak. FreezeDaysUsed counts the freezes spent within the current streak.
+type Streak struct {
+	CurrentStreak       int           `json:"current_streak"`
+	LongestStreak       int           `json:"longest_streak"`
+	FreezeDaysAvailable int           `json:"freeze_days_available"`
+	FreezeDaysUsed      int           `json:"freeze_days_used"`
+	Goal                DailyGoal     `json:"goal"`
+	Today               DailyProgress `json:"today"`
+	Heatmap             []HeatmapDay  `json:"heatmap"`
+}
+
+// ForecastDay is one future calendar day of the review forecast: how many
+// words and questions come due that day and the estimated minutes needed to
+// review them all. The first day, today, also carries everything overdue.
+type ForecastDay struct {
+	Date             string  `json:"date"`
+	WordCount        int     `json:"word_count"`
+	QuestionCount    int     `json:"question_count"`
+	EstimatedMinutes float64 `json:"estimated_minutes"`
+}
+
+// ReviewForecast is the response of GET /api/progress/forecast. Seconds per
+// answer are averaged from recent logs, or fall back to a default when there
+// is nothing to measure. Overdue counts are items whose due date has already
+// passed; they are included in the first day.
+type ReviewForecast struct {
+	Days                     int           `json:"days"`
+	WordSecondsPerAnswer     float64       `json:"word_seconds_per_answer"`
+	QuestionSecondsPerAnswer float64       `json:"question_seconds_per_answer"`
+	OverdueWords             int           `json:"overdue_words"`
+	OverdueQuestions         int           `json:"overdue_questions"`
+	TotalWords               int           `json:"total_words"`
+	TotalQuestions           int           `json:"total_questions"`
+	TotalMinutes             float64       `json:"total_minutes"`
+	Points                   []ForecastDay `json:"points"`
+}
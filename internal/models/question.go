@@ -3,24 +3,65 @@ package models
 import (
 	"strings"
 	"word-flashcard/data/models"
+	"word-flashcard/data/schema"
 	"word-flashcard/utils"
 )
 
 // Question represents a question used in both requests and responses
 type Question struct {
-	ID                   *int    `json:"id"`
-	Question             *string `json:"question"`
-	OptionA              *string `json:"option_a"`
-	OptionB              *string `json:"option_b"`
-	OptionC              *string `json:"option_c"`
-	OptionD              *string `json:"option_d"`
-	Answer               *string `json:"answer"`
-	Reference            *string `json:"reference"`
-	Notes                *string `json:"notes"`
-	CountPractise        *int    `json:"count_practise"`
-	CountFailurePractise *int    `json:"count_failure_practise"`
-	Practiced            bool    `json:"practiced,omitempty"`
-	SelectedOption       *string `json:"selected_option,omitempty"`
+	ID                   *int              `json:"id"`
+	Question             *string           `json:"question"`
+	OptionA              *string           `json:"option_a"`
+	OptionB              *string           `json:"option_b"`
+	OptionC              *string           `json:"option_c"`
+	OptionD              *string           `json:"option_d"`
+	Answer               *string           `json:"answer"`
+	Reference            *string           `json:"reference"`
+	Notes                *string           `json:"notes"`
+	CountPractise        *int              `json:"count_practise"`
+	CountFailurePractise *int              `json:"count_failure_practise"`
+	QuestionType         *string           `json:"question_type"`
+	Options              []QuestionOption  `json:"options,omitempty"`
+	Practiced            bool              `json:"practiced,omitempty"`
+	SelectedOption       *string           `json:"selected_option,omitempty"`
+	Response             *QuestionResponse `json:"response,omitempty"`
+	IsCorrect            *bool             `json:"is_correct,omitempty"`
+	// ResponseMs is how long a practiced answer took, stored on its answer log
+	ResponseMs *int `json:"response_ms,omitempty"`
+	// IsLeech and IsSuspended are set by leech detection and are read-only
+	// here; they are cleared through the leeches API
+	IsLeech     *bool          `json:"is_leech,omitempty"`
+	IsSuspended *bool          `json:"is_suspended,omitempty"`
+	Links       []LinkedEntity `json:"links,omitempty"`
+}
+
+// QuestionOption is one option of a question whose type keeps its options in
+// the question_options table. Options are listed in position order; for an
+// ordering question that order is the correct one, so clients shuffle them
+// before display. Position is assigned by the server from the array order.
+type QuestionOption struct {
+	ID           *int    `json:"id"`
+	Content      *string `json:"content"`
+	IsCorrect    *bool   `json:"is_correct,omitempty"`
+	MatchContent *string `json:"match_content,omitempty"`
+}
+
+// QuestionResponse is a quiz answer to a question with question_options,
+// sent in place of selected_option:
+//   - multiple_select: option_ids lists every selected option
+//   - ordering: option_ids lists every option in the answered order
+//   - free_text: text is the typed answer
+//   - matching: pairs matches each option to a match_content
+type QuestionResponse struct {
+	OptionIDs []int               `json:"option_ids,omitempty"`
+	Text      *string             `json:"text,omitempty"`
+	Pairs     []QuestionMatchPair `json:"pairs,omitempty"`
+}
+
+// QuestionMatchPair is one answered pair of a matching question
+type QuestionMatchPair struct {
+	OptionID int    `json:"option_id"`
+	Match    string `json:"match"`
 }
 
 // FromDataModel converts a data model Question to the API model Question
@@ -36,10 +77,55 @@ func (q *Question) FromDataModel(dbQuestion *models.Question) *Question {
 	q.Notes = dbQuestion.Notes
 	q.CountPractise = dbQuestion.CountPractise
 	q.CountFailurePractise = dbQuestion.CountFailurePractise
+	q.QuestionType = dbQuestion.QuestionType
+	q.IsLeech = dbQuestion.IsLeech
+	q.IsSuspended = dbQuestion.IsSuspended
 
 	return q
 }
 
+// Type returns the question's type, treating a missing type as single_choice
+func (q *Question) Type() string {
+	if q.QuestionType == nil || *q.QuestionType == "" {
+		return schema.QUESTION_TYPE_SINGLE_CHOICE
+	}
+	return *q.QuestionType
+}
+
+// UsesOptionTable reports whether the question's type keeps its options in
+// question_options rather than the option_a-d columns
+func (q *Question) UsesOptionTable() bool {
+	return QuestionTypeUsesOptionTable(q.Type())
+}
+
+// QuestionTypeUsesOptionTable reports whether questionType keeps its options
+// in question_options rather than the option_a-d columns
+func QuestionTypeUsesOptionTable(questionType string) bool {
+	return questionType != schema.QUESTION_TYPE_SINGLE_CHOICE && questionType != schema.QUESTION_TYPE_TRUE_FALSE
+}
+
+// FromDataModel converts a data model QuestionOption to the API model QuestionOption
+func (o *QuestionOption) FromDataModel(dbOption *models.QuestionOption) *QuestionOption {
+	o.ID = dbOption.Id
+	o.Content = dbOption.Content
+	o.IsCorrect = dbOption.IsCorrect
+	o.MatchContent = dbOption.MatchContent
+
+	return o
+}
+
+// ToDataModel converts the API model QuestionOption to the data model
+// QuestionOption, belonging to questionID at the given position
+func (o *QuestionOption) ToDataModel(questionID, position int) *models.QuestionOption {
+	return &models.QuestionOption{
+		QuestionId:   &questionID,
+		Position:     &position,
+		Content:      o.Content,
+		IsCorrect:    o.IsCorrect,
+		MatchContent: o.MatchContent,
+	}
+}
+
 // ToDataModel converts the API model Question to the data model Question
 func (q *Question) ToDataModel() *models.Question {
 	// Convert answer to uppercase
@@ -60,11 +146,16 @@ func (q *Question) ToDataModel() *models.Question {
 		Notes:                q.Notes,
 		CountPractise:        q.CountPractise,
 		CountFailurePractise: q.CountFailurePractise,
+		QuestionType:         q.QuestionType,
 	}
 }
 
-// QuestionRandomRequest represents the request structure for random questions
+// QuestionRandomRequest represents the request structure for random questions.
+// FavorSlow reserves up to 30% of the draw for questions answered correctly
+// but slowly (see GET /api/questions/speed).
 type QuestionRandomRequest struct {
-	Count             int  `json:"count" binding:"required,min=1,max=1000"`
-	ExcludeRecentDays *int `json:"exclude_recent_days"`
+	Count             int      `json:"count" binding:"required,min=1,max=1000"`
+	ExcludeRecentDays *int     `json:"exclude_recent_days"`
+	QuestionTypes     []string `json:"question_types"`
+	FavorSlow         bool     `json:"favor_slow,omitempty"`
 }
@@ -0,0 +1,46 @@
+package models
+
+// OptionAnalysis is how often one of a question's options was selected.
+// Share is the percent of all answers that picked it. Discrimination is the
+// share among strong answers minus the share among weak ones, from -1 to 1:
+// positive for the key of a question that tells learners apart, negative
+// for a distractor doing its job. It is null without enough answers to
+// compare.
+type OptionAnalysis struct {
+	Option         string   `json:"option"`
+	Text           string   `json:"text"`
+	IsKey          bool     `json:"is_key"`
+	Count          int      `json:"count"`
+	Share          float64  `json:"share"`
+	Discrimination *float64 `json:"discrimination"`
+}
+
+// QuestionAnalysis is the per-option distractor analysis of a single or
+// true/false choice question, returned by GET /api/questions/{id}/analysis.
+// MostAttractiveDistractor is the wrong option picked most often, null when
+// no wrong option was picked. DiscriminationIndex is the correct rate of
+// strong answers minus that of weak ones, from -1 to 1. SuspectAnswerKey is
+// set when a distractor was picked more often than the key.
+type QuestionAnalysis struct {
+	QuestionID               int              `json:"question_id"`
+	Question                 string           `json:"question"`
+	QuestionType             string           `json:"question_type"`
+	Answer                   string           `json:"answer"`
+	AnswerCount              int              `json:"answer_count"`
+	CorrectRate              float64          `json:"correct_rate"`
+	Options                  []OptionAnalysis `json:"options"`
+	MostAttractiveDistractor *string          `json:"most_attractive_distractor"`
+	DiscriminationIndex      *float64         `json:"discrimination_index"`
+	SuspectAnswerKey         bool             `json:"suspect_answer_key"`
+}
+
+// QuestionAnalysisReport is the collection-wide distractor analysis returned
+// by GET /api/questions/analysis over the answers of the last PeriodDays
+// days. Questions lists the analyzed questions with suspect answer keys
+// first, then the least discriminating first.
+type QuestionAnalysisReport struct {
+	PeriodDays    int                `json:"period_days"`
+	AnalyzedCount int                `json:"analyzed_count"`
+	SuspectCount  int                `json:"suspect_count"`
+	Questions     []QuestionAnalysis `json:"questions"`
+}
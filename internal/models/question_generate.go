@@ -0,0 +1,29 @@
+package models
+
+// Directions supported by QuestionGenerateRequest.Direction.
+const (
+	QuestionDirectionWordToDefinition = "word_to_definition"
+	QuestionDirectionDefinitionToWord = "definition_to_word"
+)
+
+// QuestionGenerateRequest asks the backend to build multiple-choice questions
+// from words' definitions. Either WordIDs or Count must be supplied: WordIDs
+// generates one question per listed word, Count picks that many random words.
+//
+// Direction defaults to word_to_definition (show the word, choose its
+// definition); definition_to_word shows the definition and asks for the
+// word. When Persist is false the questions are ephemeral quiz items with no
+// ID; when true they are saved as regular question rows.
+type QuestionGenerateRequest struct {
+	WordIDs   []int  `json:"word_ids,omitempty"`
+	Count     int    `json:"count,omitempty"`
+	Direction string `json:"direction,omitempty"`
+	Persist   bool   `json:"persist,omitempty"`
+}
+
+// QuestionGenerateResponse lists the generated questions and the words no
+// question could be built for (no definition, or no distractors available).
+type QuestionGenerateResponse struct {
+	Questions      []*Question `json:"questions"`
+	SkippedWordIDs []int       `json:"skipped_word_ids"`
+}
@@ -1,14 +1,20 @@
 package models
 
-import "time"
+import (
+	"encoding/json"
+	"time"
+)
 
 // QuestionAnswerLogEntry is a single entry in a question's recent answer
-// history, returned by GET /api/questions/{id}/logs.
+// history, returned by GET /api/questions/{id}/logs. Answers to question
+// types with options carry the submitted response instead of a
+// selected_option.
 type QuestionAnswerLogEntry struct {
-	ID             int       `json:"id"`
-	SelectedOption string    `json:"selected_option"`
-	IsCorrect      bool      `json:"is_correct"`
-	CreatedAt      time.Time `json:"created_at"`
+	ID             int             `json:"id"`
+	SelectedOption string          `json:"selected_option"`
+	Response       json.RawMessage `json:"response,omitempty" swaggertype:"object"`
+	IsCorrect      bool            `json:"is_correct"`
+	CreatedAt      time.Time       `json:"created_at"`
 }
 
 // QuestionTrendPoint is one day's aggregated answer trend for a question,
@@ -3,6 +3,7 @@ package models
 import (
 	"testing"
 
+	"word-flashcard/data/schema"
 	"word-flashcard/utils"
 
 	"github.com/stretchr/testify/suite"
@@ -91,3 +92,42 @@ func (qs *QuestionModelTestSuite) TestQuestionToDataModel() {
 		})
 	}
 }
+
+// TestQuestionType tests that a missing question_type is read as single_choice
+// and which types keep their options in question_options
+func (qs *QuestionModelTestSuite) TestQuestionType() {
+	testCases := []struct {
+		name            string
+		questionType    *string
+		expectedType    string
+		usesOptionTable bool
+	}{
+		{"nil type is single_choice", nil, schema.QUESTION_TYPE_SINGLE_CHOICE, false},
+		{"empty type is single_choice", utils.StrPtr(""), schema.QUESTION_TYPE_SINGLE_CHOICE, false},
+		{"true_false uses option columns", utils.StrPtr(schema.QUESTION_TYPE_TRUE_FALSE), schema.QUESTION_TYPE_TRUE_FALSE, false},
+		{"multiple_select uses option table", utils.StrPtr(schema.QUESTION_TYPE_MULTIPLE_SELECT), schema.QUESTION_TYPE_MULTIPLE_SELECT, true},
+		{"matching uses option table", utils.StrPtr(schema.QUESTION_TYPE_MATCHING), schema.QUESTION_TYPE_MATCHING, true},
+	}
+
+	for _, tc := range testCases {
+		qs.Run(tc.name, func() {
+			q := &Question{QuestionType: tc.questionType}
+			qs.Equal(tc.expectedType, q.Type())
+			qs.Equal(tc.usesOptionTable, q.UsesOptionTable())
+		})
+	}
+}
+
+// TestQuestionOptionToDataModel tests that the owning question and position are set
+func (qs *QuestionModelTestSuite) TestQuestionOptionToDataModel() {
+	option := &QuestionOption{Content: utils.StrPtr("apple"), IsCorrect: utils.BoolPtr(true), MatchContent: utils.StrPtr("fruit")}
+
+	dm := option.ToDataModel(7, 2)
+
+	qs.Equal(7, *dm.QuestionId)
+	qs.Equal(2, *dm.Position)
+	qs.Equal("apple", *dm.Content)
+	qs.True(*dm.IsCorrect)
+	qs.Equal("fruit", *dm.MatchContent)
+	qs.Nil(dm.Id)
+}
@@ -0,0 +1,113 @@
+package models
+
+import (
+	"time"
+	"word-flashcard/data/models"
+)
+
+// Reminder represents a reminder to revisit a word, question or note, used in
+// both requests and responses. A reminder with a daily or weekly recurrence
+// moves to its next due time when cleared; one without is removed. Label is
+// the word itself, the question text or the note title, and is only set in
+// responses. IsOverdue is set by the reminder scheduler once a due reminder
+// has gone uncleared for too long.
+type Reminder struct {
+	ID         *int       `json:"id"`
+	EntityType *string    `json:"entity_type"`
+	EntityID   *int       `json:"entity_id"`
+	Label      *string    `json:"label,omitempty"`
+	DueAt      *time.Time `json:"due_at"`
+	Recurrence *string    `json:"recurrence,omitempty"`
+	Note       *string    `json:"note,omitempty"`
+	IsOverdue  *bool      `json:"is_overdue,omitempty"`
+	CreatedAt  *time.Time `json:"created_at,omitempty"`
+	UpdatedAt  *time.Time `json:"updated_at,omitempty"`
+}
+
+// FromDataModel converts a data model Reminder to the API model Reminder
+func (r *Reminder) FromDataModel(dbReminder *models.Reminder) *Reminder {
+	r.ID = dbReminder.Id
+	r.EntityType = dbReminder.EntityType
+	r.EntityID = dbReminder.EntityId
+	r.DueAt = dbReminder.DueAt
+	r.Recurrence = dbReminder.Recurrence
+	r.Note = dbReminder.Note
+	r.IsOverdue = dbReminder.IsOverdue
+	r.CreatedAt = dbReminder.CreatedAt
+	r.UpdatedAt = dbReminder.UpdatedAt
+	// An update stores a cleared recurrence or note as an empty string
+	if r.Recurrence != nil && *r.Recurrence == "" {
+		r.Recurrence = nil
+	}
+	if r.Note != nil && *r.Note == "" {
+		r.Note = nil
+	}
+	return r
+}
+
+// ToDataModel converts the API model Reminder to the data model Reminder
+func (r *Reminder) ToDataModel() *models.Reminder {
+	return &models.Reminder{
+		Id:         r.ID,
+		EntityType: r.EntityType,
+		EntityId:   r.EntityID,
+		DueAt:      r.DueAt,
+		Recurrence: r.Recurrence,
+		Note:       r.Note,
+		IsOverdue:  r.IsOverdue,
+	}
+}
+
+// ReminderLog is an entry in the reminder history: a reminder as it was when
+// it was cleared or deleted
+type ReminderLog struct {
+	ID         int       `json:"id"`
+	ReminderID int       `json:"reminder_id"`
+	EntityType string    `json:"entity_type"`
+	EntityID   int       `json:"entity_id"`
+	DueAt      time.Time `json:"due_at"`
+	Recurrence *string   `json:"recurrence,omitempty"`
+	Note       *string   `json:"note,omitempty"`
+	Action     string    `json:"action"`
+	At         time.Time `json:"at"`
+}
+
+// FromDataModel converts a data model ReminderLog to the API model ReminderLog
+func (l *ReminderLog) FromDataModel(dbLog *models.ReminderLog) *ReminderLog {
+	if dbLog.Id != nil {
+		l.ID = *dbLog.Id
+	}
+	if dbLog.ReminderId != nil {
+		l.ReminderID = *dbLog.ReminderId
+	}
+	if dbLog.EntityType != nil {
+		l.EntityType = *dbLog.EntityType
+	}
+	if dbLog.EntityId != nil {
+		l.EntityID = *dbLog.EntityId
+	}
+	if dbLog.DueAt != nil {
+		l.DueAt = *dbLog.DueAt
+	}
+	if dbLog.Action != nil {
+		l.Action = *dbLog.Action
+	}
+	if dbLog.CreatedAt != nil {
+		l.At = *dbLog.CreatedAt
+	}
+	if dbLog.Recurrence != nil && *dbLog.Recurrence != "" {
+		l.Recurrence = dbLog.Recurrence
+	}
+	if dbLog.Note != nil && *dbLog.Note != "" {
+		l.Note = dbLog.Note
+	}
+	return l
+}
+
+// ReminderClearResult is the response of POST /api/reminders/{id}/clear.
+// Next is the reminder moved to its next due time when it recurs, and is
+// left out when the reminder was removed.
+type ReminderClearResult struct {
+	Cleared ReminderLog `json:"cleared"`
+	Next    *Reminder   `json:"next,omitempty"`
+}
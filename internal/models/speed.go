@@ -0,0 +1,32 @@
+package models
+
+// ResponseTimes summarizes how long timed answers took: how many there
+// were and their median, 75th and 90th percentile in milliseconds, null
+// when there were none. Only answers sent with response_ms count.
+type ResponseTimes struct {
+	AnswerCount int  `json:"answer_count"`
+	MedianMs    *int `json:"median_ms"`
+	P75Ms       *int `json:"p75_ms"`
+	P90Ms       *int `json:"p90_ms"`
+}
+
+// ItemSpeed is the answer times of one word or question. SlowButCorrect
+// marks an item whose median correct answer time, over at least two timed
+// correct answers, is in the slowest quarter of all correct answers; those
+// are the items favor_slow draws more often.
+type ItemSpeed struct {
+	ID   int    `json:"id"`
+	Text string `json:"text"`
+	ResponseTimes
+	CorrectCount   int  `json:"correct_count"`
+	SlowButCorrect bool `json:"slow_but_correct"`
+}
+
+// SpeedReport is the response of GET /api/words/speed and GET
+// /api/questions/speed: the answer times of the last Days days overall and
+// per item, slowest median first
+type SpeedReport struct {
+	Days    int           `json:"days"`
+	Overall ResponseTimes `json:"overall"`
+	Items   []ItemSpeed   `json:"items"`
+}
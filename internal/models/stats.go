@@ -13,10 +13,12 @@ type PracticeCountBucket struct {
 	Count int    `json:"count"`
 }
 
-// WordStats is the response for the word statistics endpoint
+// WordStats is the response for the word statistics endpoint.
+// ResponseTimes covers the timed quiz answers of the last 90 days.
 type WordStats struct {
 	FamiliarityDistribution   WordFamiliarityDistribution `json:"familiarity_distribution"`
 	PracticeCountDistribution []PracticeCountBucket       `json:"practice_count_distribution"`
+	ResponseTimes             ResponseTimes               `json:"response_times"`
 }
 
 // AccuracyBucket holds the question count for a given accuracy range, along
@@ -27,7 +29,19 @@ type AccuracyBucket struct {
 	PracticeCountBreakdown []PracticeCountBucket `json:"practice_count_breakdown"`
 }
 
-// QuestionStats is the response for the question statistics endpoint
-type QuestionStats struct {
+// QuestionTypeStats holds the accuracy distribution of the questions of one
+// question type
+type QuestionTypeStats struct {
+	QuestionType         string           `json:"question_type"`
+	Count                int              `json:"count"`
 	AccuracyDistribution []AccuracyBucket `json:"accuracy_distribution"`
 }
+
+// QuestionStats is the response for the question statistics endpoint.
+// TypeDistribution only lists question types that have questions.
+// ResponseTimes covers the timed answers of the last 90 days.
+type QuestionStats struct {
+	AccuracyDistribution []AccuracyBucket    `json:"accuracy_distribution"`
+	TypeDistribution     []QuestionTypeStats `json:"type_distribution"`
+	ResponseTimes        ResponseTimes       `json:"response_times"`
+}
@@ -15,18 +15,39 @@ type WordDefinition struct {
 	Phonetics    *map[string]interface{} `json:"phonetics"`
 	Examples     *[]string               `json:"examples"`
 	Notes        *string                 `json:"notes"`
+	CEFRLevel    *string                 `json:"cefr_level,omitempty"`
+	Grammar      *[]string               `json:"grammar,omitempty"`
+	Labels       *[]string               `json:"labels,omitempty"`
+	Synonyms     *[]string               `json:"synonyms,omitempty"`
+	Antonyms     *[]string               `json:"antonyms,omitempty"`
 }
 
 // Word represents a word that can be used in both API requests and responses
 type Word struct {
-	ID                     *int             `json:"id,omitempty"`
-	Word                   *string          `json:"word,omitempty"`
-	Familiarity            *string          `json:"familiarity,omitempty"`
-	Reminder               *string          `json:"reminder"`
-	CountPractise          *int             `json:"count_practise"`
-	IncrementCountPractise bool             `json:"increment_count_practise,omitempty"`
-	QuizSessionID          *string          `json:"quiz_session_id,omitempty"`
-	Definitions            []WordDefinition `json:"definitions"`
+	ID          *int    `json:"id,omitempty"`
+	Word        *string `json:"word,omitempty"`
+	Familiarity *string `json:"familiarity,omitempty"`
+	// Reminder is the legacy free-text revisit marker, kept for existing
+	// clients as a label only.
+	//
+	// Deprecated: it has no due date and no longer schedules reviews; the
+	// reminders table, managed through /api/reminders, is what the review
+	// forecast and the calendar feed read.
+	Reminder               *string `json:"reminder"`
+	CountPractise          *int    `json:"count_practise"`
+	IncrementCountPractise bool    `json:"increment_count_practise,omitempty"`
+	QuizSessionID          *string `json:"quiz_session_id,omitempty"`
+	// ResponseMs is how long a quiz answer took, stored on its practice log
+	ResponseMs *int `json:"response_ms,omitempty"`
+	// IsLeech and IsSuspended are set by leech detection and are read-only
+	// here; they are cleared through the leeches API
+	IsLeech     *bool            `json:"is_leech,omitempty"`
+	IsSuspended *bool            `json:"is_suspended,omitempty"`
+	Definitions []WordDefinition `json:"definitions"`
+	Links       []LinkedEntity   `json:"links,omitempty"`
+	// PossibleDuplicates lists existing words that are likely the same
+	// entry; only filled in when a word is created
+	PossibleDuplicates []WordDuplicate `json:"possible_duplicates,omitempty"`
 }
 
 // FromDataModel converts a data model Word and its definitions to the API model Word
@@ -36,6 +57,8 @@ func (w *Word) FromDataModel(dm *models.Word, defs []*models.WordDefinition) *Wo
 	w.Familiarity = dm.Familiarity
 	w.Reminder = dm.Reminder
 	w.CountPractise = dm.CountPractise
+	w.IsLeech = dm.IsLeech
+	w.IsSuspended = dm.IsSuspended
 
 	if len(defs) == 0 {
 		w.Definitions = []WordDefinition{}
@@ -81,6 +104,11 @@ func (w *Word) FromDataModel(dm *models.Word, defs []*models.WordDefinition) *Wo
 			Phonetics:    &phonetics,
 			Examples:     &examples,
 			Notes:        &notes,
+			CEFRLevel:    def.CefrLevel,
+			Grammar:      decodeStringList("grammar", def.Grammar),
+			Labels:       decodeStringList("labels", def.Labels),
+			Synonyms:     decodeStringList("synonyms", def.Synonyms),
+			Antonyms:     decodeStringList("antonyms", def.Antonyms),
 		}
 		wordDefs = append(wordDefs, wordDef)
 	}
@@ -133,9 +161,42 @@ func (wd *WordDefinition) ToDataModel() *models.WordDefinition {
 		Phonetics:    phoneticsStr,
 		Examples:     examplesStr,
 		Notes:        wd.Notes,
+		CefrLevel:    wd.CEFRLevel,
+		Grammar:      encodeStringList("grammar", wd.Grammar),
+		Labels:       encodeStringList("labels", wd.Labels),
+		Synonyms:     encodeStringList("synonyms", wd.Synonyms),
+		Antonyms:     encodeStringList("antonyms", wd.Antonyms),
 	}
 }
 
+// decodeStringList converts a JSON array column such as synonyms to a list,
+// or nil when the column is empty
+func decodeStringList(column string, value *string) *[]string {
+	if value == nil {
+		return nil
+	}
+	var list []string
+	if err := json.Unmarshal([]byte(*value), &list); err != nil {
+		slog.Warn("Failed to unmarshal "+column+" JSON", column, *value, "error", err)
+		return nil
+	}
+	return &list
+}
+
+// encodeStringList converts a list to the JSON array stored in a column such
+// as synonyms, or nil when the list is not set
+func encodeStringList(column string, list *[]string) *string {
+	if list == nil {
+		return nil
+	}
+	encoded, err := json.Marshal(list)
+	if err != nil {
+		slog.Warn("Failed to marshal "+column+" to JSON", "error", err)
+		return nil
+	}
+	return utils.StrPtr(string(encoded))
+}
+
 // WordRandomRequest represents the request structure for random word selection
 // used by the Word Quiz. Exactly one of FamiliarityLevels or PerCategoryCounts
 // is expected to be supplied:
@@ -147,8 +208,13 @@ func (wd *WordDefinition) ToDataModel() *models.WordDefinition {
 //
 // Within whichever levels end up with a quota, words that have never been
 // practiced are prioritized, followed by words practiced longest ago.
+// CEFRLevels optionally limits the draw to words with a definition at one of
+// the given CEFR levels (A1-C2). FavorSlow reserves up to 30% of the draw
+// for words answered correctly but slowly (see GET /api/words/speed).
 type WordRandomRequest struct {
 	Count             int            `json:"count" binding:"required,min=1,max=1000"`
 	FamiliarityLevels []string       `json:"familiarity_levels,omitempty"`
 	PerCategoryCounts map[string]int `json:"per_category_counts,omitempty"`
+	CEFRLevels        []string       `json:"cefr_levels,omitempty"`
+	FavorSlow         bool           `json:"favor_slow,omitempty"`
 }
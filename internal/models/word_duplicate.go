@@ -0,0 +1,54 @@
+package models
+
+// Reasons two or more words were grouped as duplicates
+const (
+	// WordDuplicateSpelling means the words differ only in case, spacing,
+	// hyphens or accents (Ice-cream, ice cream)
+	WordDuplicateSpelling = "spelling"
+	// WordDuplicateInflection means at least one word is an inflection of
+	// another (ran, running, run)
+	WordDuplicateInflection = "inflection"
+)
+
+// WordDuplicate is a word that is likely the same entry as another one
+type WordDuplicate struct {
+	ID            int     `json:"id"`
+	Word          string  `json:"word"`
+	Familiarity   *string `json:"familiarity,omitempty"`
+	CountPractise int     `json:"count_practise"`
+	// Definitions is the number of definitions the word has; only filled
+	// in by the duplicates report
+	Definitions *int `json:"definitions,omitempty"`
+}
+
+// WordDuplicateGroup is a set of words that are likely the same entry.
+// SuggestedTargetID is the word the others are best merged into: the
+// dictionary form if one of them is it, otherwise the most practised.
+type WordDuplicateGroup struct {
+	Lemma             string          `json:"lemma"`
+	Reason            string          `json:"reason"`
+	SuggestedTargetID int             `json:"suggested_target_id"`
+	Words             []WordDuplicate `json:"words"`
+}
+
+// WordDuplicatesReport is the response for the word duplicates endpoint
+type WordDuplicatesReport struct {
+	Groups []WordDuplicateGroup `json:"groups"`
+}
+
+// WordMergeRequest is the request body for merging words into another
+type WordMergeRequest struct {
+	SourceIDs []int `json:"source_ids"`
+}
+
+// WordMergeResult is the response for the word merge endpoint: the word
+// merged into, as it is after the merge, and what was moved to it
+type WordMergeResult struct {
+	Word               Word  `json:"word"`
+	MergedIDs          []int `json:"merged_ids"`
+	DefinitionsMoved   int   `json:"definitions_moved"`
+	DefinitionsSkipped int   `json:"definitions_skipped"`
+	LogsMoved          int   `json:"logs_moved"`
+	LinksMoved         int   `json:"links_moved"`
+	RemindersMoved     int   `json:"reminders_moved"`
+}
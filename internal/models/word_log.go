@@ -3,11 +3,16 @@ package models
 import "time"
 
 // WordPracticeLogEntry is a single entry in a word's recent practice
-// history, returned by GET /api/words/{id}/logs.
+// history, returned by GET /api/words/{id}/logs. Mode, TypedAnswer and
+// IsCorrect are only present for entries that recorded them (see
+// schema.WORD_PRACTICE_LOG_MODE).
 type WordPracticeLogEntry struct {
 	ID                  int       `json:"id"`
 	Familiarity         string    `json:"familiarity"`
 	PreviousFamiliarity string    `json:"previous_familiarity"`
+	Mode                *string   `json:"mode,omitempty"`
+	TypedAnswer         *string   `json:"typed_answer,omitempty"`
+	IsCorrect           *bool     `json:"is_correct,omitempty"`
 	CreatedAt           time.Time `json:"created_at"`
 }
 
@@ -0,0 +1,53 @@
+package models
+
+// SpellingPromptDefinition is one definition shown to the learner during a
+// spelling quiz. Any occurrence of the word itself in the definition or its
+// examples is masked out so the prompt doesn't give the answer away.
+type SpellingPromptDefinition struct {
+	PartOfSpeech string   `json:"part_of_speech"`
+	Definition   string   `json:"definition"`
+	Examples     []string `json:"examples"`
+}
+
+// SpellingPrompt is a single spelling quiz item returned by
+// POST /api/words/spelling/random. The word itself is never included; the
+// learner types it and submits to POST /api/words/{id}/spelling.
+type SpellingPrompt struct {
+	WordID      int                        `json:"word_id"`
+	Length      int                        `json:"length"`
+	FirstLetter string                     `json:"first_letter"`
+	Definitions []SpellingPromptDefinition `json:"definitions"`
+}
+
+// TypedAnswerRequest is the learner's typed answer for a spelling or cloze
+// prompt. QuizSessionID follows the same resubmission rules as
+// Word.QuizSessionID.
+type TypedAnswerRequest struct {
+	Answer        string  `json:"answer"`
+	QuizSessionID *string `json:"quiz_session_id,omitempty"`
+}
+
+// TypedAnswerResult is the server-side grade for a typed answer. Result is one
+// of "correct", "near_miss" or "incorrect"; only "correct" counts towards
+// IsCorrect. Distance is the edit distance to the closest accepted spelling.
+type TypedAnswerResult struct {
+	WordID    int    `json:"word_id"`
+	Word      string `json:"word"`
+	Answer    string `json:"answer"`
+	Result    string `json:"result"`
+	IsCorrect bool   `json:"is_correct"`
+	Distance  int    `json:"distance"`
+}
+
+// ClozePrompt is a single fill-in-the-blank item returned by
+// POST /api/words/cloze/random: one of the word's saved example sentences
+// with the word (in whatever inflected form it appeared) replaced by a
+// blank. Options holds the answer plus distractors from other words in the
+// collection, inflected the same way; it is empty when no other words are
+// available. The answer is submitted to POST /api/words/{id}/cloze.
+type ClozePrompt struct {
+	WordID       int      `json:"word_id"`
+	PartOfSpeech string   `json:"part_of_speech"`
+	Sentence     string   `json:"sentence"`
+	Options      []string `json:"options"`
+}
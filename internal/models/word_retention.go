@@ -0,0 +1,65 @@
+package models
+
+import "time"
+
+// RetentionInterval is the observed retention of reviews whose interval
+// since the word's previous review falls in [MinDays, MaxDays); MaxDays is
+// null for the open-ended last interval. RetentionRate is the share of those
+// reviews the word was recalled, PredictedRetentionRate what the fitted
+// forgetting curve expects for the same reviews, both in percent and 0 for
+// an interval without reviews.
+type RetentionInterval struct {
+	Range                  string   `json:"range"`
+	MinDays                float64  `json:"min_days"`
+	MaxDays                *float64 `json:"max_days"`
+	ReviewCount            int      `json:"review_count"`
+	RecalledCount          int      `json:"recalled_count"`
+	RetentionRate          float64  `json:"retention_rate"`
+	PredictedRetentionRate float64  `json:"predicted_retention_rate"`
+}
+
+// ForgettingCurve is an exponential forgetting curve, recall = e^(-t/S),
+// fitted to the reviews of words at Familiarity ("all" for every review).
+// When there were too few reviews to fit (Fitted is false), StabilityDays is
+// the overall curve's, or a default for the familiarity level.
+type ForgettingCurve struct {
+	Familiarity   string  `json:"familiarity"`
+	StabilityDays float64 `json:"stability_days"`
+	HalfLifeDays  float64 `json:"half_life_days"`
+	ReviewCount   int     `json:"review_count"`
+	Fitted        bool    `json:"fitted"`
+}
+
+// FamiliarityTransitions counts how often a self-reported review moved a
+// word from From to each familiarity level
+type FamiliarityTransitions struct {
+	From   string `json:"from"`
+	Red    int    `json:"red"`
+	Yellow int    `json:"yellow"`
+	Green  int    `json:"green"`
+	Total  int    `json:"total"`
+}
+
+// WordRecallEstimate is the estimated probability, in percent, that a word
+// would be recalled right now, from its familiarity's forgetting curve and
+// the time since it was last practiced
+type WordRecallEstimate struct {
+	WordID            int       `json:"word_id"`
+	Word              string    `json:"word"`
+	Familiarity       string    `json:"familiarity"`
+	LastPracticedAt   time.Time `json:"last_practiced_at"`
+	DaysSinceReview   float64   `json:"days_since_review"`
+	RecallProbability float64   `json:"recall_probability"`
+}
+
+// WordRetentionReport is the response for the word retention endpoint.
+// Intervals, Curves and Transitions cover reviews within the last PeriodDays
+// days; Words lists the practiced words least likely to be recalled now first.
+type WordRetentionReport struct {
+	PeriodDays  int                      `json:"period_days"`
+	ReviewCount int                      `json:"review_count"`
+	Intervals   []RetentionInterval      `json:"intervals"`
+	Curves      []ForgettingCurve        `json:"curves"`
+	Transitions []FamiliarityTransitions `json:"transitions"`
+	Words       []WordRecallEstimate     `json:"words"`
+}
@@ -194,3 +194,39 @@ func (ws *WordModelTestSuite) TestWordDefinitionToDataModel() {
 		ws.ElementsMatch(examplesSlice, gotExamples)
 	}
 }
+
+// TestWordDefinitionEntryDetails tests that the CEFR level, grammar codes,
+// labels, synonyms and antonyms round-trip through the data model, and are
+// left out when not set
+func (ws *WordModelTestSuite) TestWordDefinitionEntryDetails() {
+	level := "B1"
+	grammar := []string{"C", "U"}
+	labels := []string{"informal"}
+	synonyms := []string{"hi", "hey"}
+	antonyms := []string{"goodbye"}
+	wd := &WordDefinition{CEFRLevel: &level, Grammar: &grammar, Labels: &labels, Synonyms: &synonyms, Antonyms: &antonyms}
+
+	dm := wd.ToDataModel()
+
+	ws.Equal(&level, dm.CefrLevel)
+	ws.Equal(`["C","U"]`, *dm.Grammar)
+	ws.Equal(`["informal"]`, *dm.Labels)
+	ws.Equal(`["hi","hey"]`, *dm.Synonyms)
+	ws.Equal(`["goodbye"]`, *dm.Antonyms)
+
+	wordID := 1
+	word := new(Word).FromDataModel(&models.Word{Id: &wordID}, []*models.WordDefinition{dm, {Id: &wordID}})
+
+	ws.Require().Len(word.Definitions, 2)
+	got := word.Definitions[0]
+	ws.Equal(&level, got.CEFRLevel)
+	ws.Equal(&grammar, got.Grammar)
+	ws.Equal(&labels, got.Labels)
+	ws.Equal(&synonyms, got.Synonyms)
+	ws.Equal(&antonyms, got.Antonyms)
+
+	encoded, err := json.Marshal(word.Definitions[1])
+	ws.NoError(err)
+	ws.NotContains(string(encoded), "cefr_level")
+	ws.NotContains(string(encoded), "synonyms")
+}
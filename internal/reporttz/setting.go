@@ -0,0 +1,93 @@
+// Package reporttz keeps the reporting timezone the learner stored in the
+// settings table, which common.ReportLocation prefers over REPORT_TIMEZONE.
+package reporttz
+
+import (
+	"strings"
+	"sync"
+	"time"
+
+	dbModels "word-flashcard/data/models"
+	"word-flashcard/data/peers"
+	"word-flashcard/data/schema"
+
+	"github.com/Masterminds/squirrel"
+)
+
+// reportTimezoneCacheTTL is how long the stored report timezone is reused
+// before the settings table is read again; ReportLocation runs for every
+// row a report buckets, and a restored backup may change the setting
+const reportTimezoneCacheTTL = time.Minute
+
+// ReportTimezoneSetting is the reporting timezone the learner stored in the
+// settings table, which takes precedence over REPORT_TIMEZONE. It is shared
+// by common.ReportLocation, which reads it once passed to
+// common.UseReportTimezoneSource, and the progress controller, which reads
+// and changes it.
+type ReportTimezoneSetting struct {
+	settingPeer peers.SettingPeerInterface
+
+	mu       sync.Mutex
+	name     string
+	loadedAt time.Time
+}
+
+// NewReportTimezoneSetting creates a new ReportTimezoneSetting instance
+func NewReportTimezoneSetting(settingPeer peers.SettingPeerInterface) *ReportTimezoneSetting {
+	return &ReportTimezoneSetting{settingPeer: settingPeer}
+}
+
+// Name returns the stored timezone name, or "" when none is stored. The
+// value is cached for reportTimezoneCacheTTL; a failed read keeps the last
+// known value until then too, so a database outage isn't retried per row.
+func (s *ReportTimezoneSetting) Name() (string, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if !s.loadedAt.IsZero() && time.Since(s.loadedAt) < reportTimezoneCacheTTL {
+		return s.name, nil
+	}
+
+	s.loadedAt = time.Now()
+	setting, err := s.load()
+	if err != nil {
+		return s.name, err
+	}
+	s.name = ""
+	if setting != nil && setting.Value != nil {
+		s.name = strings.TrimSpace(*setting.Value)
+	}
+	return s.name, nil
+}
+
+// Save stores name as the report timezone, or clears it when name is "".
+// Callers validate name with common.ValidateReportTimezone first.
+func (s *ReportTimezoneSetting) Save(name string) error {
+	name = strings.TrimSpace(name)
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	existing, err := s.load()
+	if err != nil {
+		return err
+	}
+	if existing != nil {
+		_, err = s.settingPeer.Update(&dbModels.Setting{Value: &name}, squirrel.Eq{schema.SETTING_NAME: schema.SETTING_REPORT_TIMEZONE})
+	} else {
+		settingName := schema.SETTING_REPORT_TIMEZONE
+		_, err = s.settingPeer.Insert(&dbModels.Setting{Name: &settingName, Value: &name})
+	}
+	if err != nil {
+		return err
+	}
+	s.name, s.loadedAt = name, time.Now()
+	return nil
+}
+
+// load returns the stored setting row, or nil when there is none
+func (s *ReportTimezoneSetting) load() (*dbModels.Setting, error) {
+	settings, err := s.settingPeer.Select([]*string{}, squirrel.Eq{schema.SETTING_NAME: schema.SETTING_REPORT_TIMEZONE}, nil, nil, nil)
+	if err != nil || len(settings) == 0 {
+		return nil, err
+	}
+	return settings[0], nil
+}
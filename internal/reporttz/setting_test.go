@@ -0,0 +1,110 @@
+package reporttz
+
+import (
+	"errors"
+	"testing"
+
+	"word-flashcard/data/mocks"
+	dbModels "word-flashcard/data/models"
+	"word-flashcard/data/schema"
+	"word-flashcard/internal/controllers/common"
+
+	"github.com/Masterminds/squirrel"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+)
+
+// ReportTimezoneSettingTestSuite is a test suite for ReportTimezoneSetting
+type ReportTimezoneSettingTestSuite struct {
+	suite.Suite
+}
+
+// TestReportTimezoneSettingTestSuite runs the ReportTimezoneSettingTestSuite
+func TestReportTimezoneSettingTestSuite(t *testing.T) {
+	suite.Run(t, new(ReportTimezoneSettingTestSuite))
+}
+
+// storedTimezone returns a stored report timezone Setting db model for testing
+func storedTimezone(value string) []*dbModels.Setting {
+	id, name := 1, schema.SETTING_REPORT_TIMEZONE
+	return []*dbModels.Setting{{Id: &id, Name: &name, Value: &value}}
+}
+
+// TestReportLocation tests that the stored report timezone takes precedence
+// over REPORT_TIMEZONE once in use, and that an empty, invalid or unreadable
+// one falls back to it
+func (suite *ReportTimezoneSettingTestSuite) TestReportLocation() {
+	tests := []struct {
+		name     string
+		stored   []*dbModels.Setting
+		err      error
+		expected string
+	}{
+		{name: "stored timezone wins", stored: storedTimezone("Asia/Tokyo"), expected: "Asia/Tokyo"},
+		{name: "nothing stored uses REPORT_TIMEZONE", stored: []*dbModels.Setting{}, expected: "Europe/Berlin"},
+		{name: "cleared setting uses REPORT_TIMEZONE", stored: storedTimezone(""), expected: "Europe/Berlin"},
+		{name: "invalid stored timezone uses REPORT_TIMEZONE", stored: storedTimezone("Mars/Olympus_Mons"), expected: "Europe/Berlin"},
+		{name: "database error uses REPORT_TIMEZONE", err: errors.New("database error"), expected: "Europe/Berlin"},
+	}
+
+	for _, tt := range tests {
+		suite.Run(tt.name, func() {
+			suite.T().Setenv("REPORT_TIMEZONE", "Europe/Berlin")
+			settingPeer := mocks.NewMockSettingPeer(suite.T())
+			settingPeer.EXPECT().
+				Select(mock.Anything, squirrel.Eq{schema.SETTING_NAME: schema.SETTING_REPORT_TIMEZONE}, mock.Anything, mock.Anything, mock.Anything).
+				Return(tt.stored, tt.err).Times(1)
+			common.UseReportTimezoneSource(NewReportTimezoneSetting(settingPeer))
+			defer common.UseReportTimezoneSource(nil)
+
+			// The second call is served from the cache
+			suite.Equal(tt.expected, common.ReportLocation().String())
+			suite.Equal(tt.expected, common.ReportLocation().String())
+		})
+	}
+}
+
+// TestSave tests that Save inserts the setting the first time, updates it
+// afterwards, and serves the saved name from cache
+func (suite *ReportTimezoneSettingTestSuite) TestSave() {
+	suite.Run("inserts when nothing is stored", func() {
+		settingPeer := mocks.NewMockSettingPeer(suite.T())
+		settingPeer.EXPECT().Select(mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+			Return([]*dbModels.Setting{}, nil).Times(1)
+		settingPeer.EXPECT().
+			Insert(mock.MatchedBy(func(setting *dbModels.Setting) bool {
+				return *setting.Name == schema.SETTING_REPORT_TIMEZONE && *setting.Value == "Asia/Tokyo"
+			})).
+			Return(int64(1), nil).Times(1)
+		setting := NewReportTimezoneSetting(settingPeer)
+
+		suite.Require().NoError(setting.Save(" Asia/Tokyo "))
+		name, err := setting.Name()
+		suite.NoError(err)
+		suite.Equal("Asia/Tokyo", name)
+	})
+
+	suite.Run("updates a stored timezone", func() {
+		settingPeer := mocks.NewMockSettingPeer(suite.T())
+		settingPeer.EXPECT().Select(mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+			Return(storedTimezone("Asia/Tokyo"), nil).Times(1)
+		settingPeer.EXPECT().
+			Update(mock.MatchedBy(func(setting *dbModels.Setting) bool { return *setting.Value == "" }),
+				squirrel.Eq{schema.SETTING_NAME: schema.SETTING_REPORT_TIMEZONE}).
+			Return(int64(1), nil).Times(1)
+		setting := NewReportTimezoneSetting(settingPeer)
+
+		suite.Require().NoError(setting.Save(""))
+		name, err := setting.Name()
+		suite.NoError(err)
+		suite.Equal("", name)
+	})
+
+	suite.Run("database error", func() {
+		settingPeer := mocks.NewMockSettingPeer(suite.T())
+		settingPeer.EXPECT().Select(mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+			Return(nil, errors.New("database error")).Times(1)
+
+		suite.Error(NewReportTimezoneSetting(settingPeer).Save("Asia/Tokyo"))
+	})
+}
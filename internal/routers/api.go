@@ -2,13 +2,24 @@ package routers
 
 import (
 	"log/slog"
+	"word-flashcard/internal/audiostore"
+	"word-flashcard/internal/controllers/audio"
 	"word-flashcard/internal/controllers/backup"
+	"word-flashcard/internal/controllers/calendar"
+	"word-flashcard/internal/controllers/common"
 	"word-flashcard/internal/controllers/dictionary"
 	"word-flashcard/internal/controllers/health"
+	"word-flashcard/internal/controllers/leech"
+	"word-flashcard/internal/controllers/link"
 	"word-flashcard/internal/controllers/note"
+	"word-flashcard/internal/controllers/progress"
 	"word-flashcard/internal/controllers/question"
+	"word-flashcard/internal/controllers/reminder"
 	"word-flashcard/internal/controllers/word"
+	"word-flashcard/internal/leechdetect"
+	"word-flashcard/internal/links"
 	"word-flashcard/internal/middleware"
+	"word-flashcard/internal/reporttz"
 
 	"github.com/gin-gonic/gin"
 )
@@ -20,34 +31,80 @@ type ControllerDependencies struct {
 	WordController       word.ControllerInterface
 	QuestionController   question.ControllerInterface
 	NoteController       note.ControllerInterface
+	LinkController       link.ControllerInterface
 	BackupController     backup.ControllerInterface
+	AudioController      audio.ControllerInterface
+	ProgressController   progress.ControllerInterface
+	LeechController      leech.ControllerInterface
+	ReminderController   reminder.ControllerInterface
+	CalendarController   calendar.ControllerInterface
 }
 
 // SetupAPIRoutes configures all API routes with default controllers
 func SetupAPIRoutes(router *gin.Engine) {
 	// Initialize default controllers
-	wordPeer, wordDefinitionsPeer, wordPracticeLogPeer, err := word.GetReelPeers()
+	// Links are resolved by the word, question and note controllers too, so
+	// the resolver is set up first and shared
+	linkPeer, linkWordPeer, linkQuestionPeer, linkNotePeer, err := link.GetReelPeers()
+	if err != nil {
+		slog.Error("Failed to initialize Link controller", "error", err)
+		return
+	}
+	linkResolver := links.NewLinkResolver(linkPeer, linkWordPeer, linkQuestionPeer, linkNotePeer)
+	linkController := link.New(linkPeer, linkResolver)
+
+	// Pronunciation audio is stored by the word controller, served by the
+	// audio controller and bundled by the backup controller, so the store is
+	// shared too
+	audioFilePeer, err := audio.GetReelPeer()
+	if err != nil {
+		slog.Error("Failed to initialize Audio controller", "error", err)
+		return
+	}
+	audioStore := audiostore.NewAudioStore(audioFilePeer)
+	audioController := audio.New(audioStore)
+
+	// Leeches are tagged by the word and question controllers and listed by
+	// the leech controller, so the detector is shared as well
+	leechSettingPeer, leechWordPeer, leechQuestionPeer, leechWordPracticeLogPeer, leechQuestionAnswerLogPeer, err := leech.GetReelPeers()
+	if err != nil {
+		slog.Error("Failed to initialize Leech controller", "error", err)
+		return
+	}
+	leechDetector := leechdetect.NewLeechDetector(leechSettingPeer, leechWordPeer, leechQuestionPeer, leechWordPracticeLogPeer, leechQuestionAnswerLogPeer)
+	leechController := leech.New(leechDetector, leechWordPeer, leechQuestionPeer, leechWordPracticeLogPeer, leechQuestionAnswerLogPeer)
+
+	wordPeer, wordDefinitionsPeer, wordPracticeLogPeer, wordReminderPeer, err := word.GetReelPeers()
 	if err != nil {
 		slog.Error("Failed to initialize Word controller", "error", err)
 		return
 	}
-	wordController := word.New(wordPeer, wordDefinitionsPeer, wordPracticeLogPeer)
+	wordController := word.New(wordPeer, wordDefinitionsPeer, wordPracticeLogPeer, wordReminderPeer, linkResolver, audioStore, leechDetector)
 
-	questionPeer, questionAnswerLogPeer, err := question.GetReelPeers()
+	questionPeer, questionOptionPeer, questionAnswerLogPeer, questionWordPeer, questionWordDefinitionPeer, questionReminderPeer, err := question.GetReelPeers()
 	if err != nil {
 		slog.Error("Failed to initialize Question controller", "error", err)
 		return
 	}
-	questionController := question.New(questionPeer, questionAnswerLogPeer)
+	questionController := question.New(questionPeer, questionOptionPeer, questionAnswerLogPeer, questionWordPeer, questionWordDefinitionPeer, questionReminderPeer, linkResolver, leechDetector)
 
-	notePeer, err := note.GetReelPeer()
+	notePeer, noteReminderPeer, err := note.GetReelPeers()
 	if err != nil {
 		slog.Error("Failed to initialize Note controller", "error", err)
 		return
 	}
-	noteController := note.New(notePeer)
+	noteController := note.New(notePeer, noteReminderPeer, linkResolver)
+
+	dictionaryEntryPeer, dictionaryCachePeer, err := dictionary.GetReelPeers()
+	if err != nil {
+		slog.Error("Failed to initialize Dictionary controller", "error", err)
+		return
+	}
+	dictionaryController := dictionary.New(dictionaryEntryPeer, dictionaryCachePeer, wordPeer, wordDefinitionsPeer, audioStore)
 
-	backupWordPeer, backupWordDefinitionPeer, backupQuestionPeer, backupQuestionAnswerLogPeer, backupWordPracticeLogPeer, backupNotePeer, backupPeer, err := backup.GetReelPeers()
+	backupWordPeer, backupWordDefinitionPeer, backupQuestionPeer, backupQuestionOptionPeer,
+		backupQuestionAnswerLogPeer, backupWordPracticeLogPeer, backupNotePeer, backupEntityLinkPeer,
+		backupSettingPeer, backupReminderPeer, backupReminderLogPeer, backupPeer, err := backup.GetReelPeers()
 	if err != nil {
 		slog.Error("Failed to initialize Backup controller", "error", err)
 		return
@@ -56,20 +113,54 @@ func SetupAPIRoutes(router *gin.Engine) {
 		backupWordPeer,
 		backupWordDefinitionPeer,
 		backupQuestionPeer,
+		backupQuestionOptionPeer,
 		backupQuestionAnswerLogPeer,
 		backupWordPracticeLogPeer,
 		backupNotePeer,
+		backupEntityLinkPeer,
+		backupSettingPeer,
+		backupReminderPeer,
+		backupReminderLogPeer,
 		backupPeer,
+		audioStore,
 	)
 
+	progressWordPeer, progressQuestionPeer, progressWordPracticeLogPeer, progressQuestionAnswerLogPeer, progressSettingPeer, progressReminderPeer, err := progress.GetReelPeers()
+	if err != nil {
+		slog.Error("Failed to initialize Progress controller", "error", err)
+		return
+	}
+	// Every report resolves its timezone through common.ReportLocation, so the
+	// stored report timezone is shared with it rather than owned by progress
+	reportTimezone := reporttz.NewReportTimezoneSetting(progressSettingPeer)
+	common.UseReportTimezoneSource(reportTimezone)
+	progressController := progress.New(progressWordPeer, progressQuestionPeer, progressWordPracticeLogPeer, progressQuestionAnswerLogPeer, progressSettingPeer, progressReminderPeer, reportTimezone)
+
+	reminderPeer, reminderLogPeer, err := reminder.GetReelPeers()
+	if err != nil {
+		slog.Error("Failed to initialize Reminder controller", "error", err)
+		return
+	}
+	reminderController := reminder.New(reminderPeer, reminderLogPeer, linkResolver)
+
+	// The calendar feed publishes what the reminder, progress and backup
+	// controllers already know
+	calendarController := calendar.New(reminderController, progressController, backupController)
+
 	// Inject controllers into dependencies struct
 	deps := &ControllerDependencies{
 		HealthController:     health.New(),
-		DictionaryController: dictionary.New(),
+		DictionaryController: dictionaryController,
 		WordController:       wordController,
 		QuestionController:   questionController,
 		NoteController:       noteController,
+		LinkController:       linkController,
 		BackupController:     backupController,
+		AudioController:      audioController,
+		ProgressController:   progressController,
+		LeechController:      leechController,
+		ReminderController:   reminderController,
+		CalendarController:   calendarController,
 	}
 
 	// Setup routes with dependencies
@@ -87,6 +178,11 @@ func SetupAPIRoutesWithDependencies(router *gin.Engine, deps *ControllerDependen
 	apiGroup.GET("/information", deps.HealthController.InformationCheck)
 
 	// Dictionary routes
+	apiGroup.GET("/dictionary/suggestions", deps.DictionaryController.SuggestWords)
+	apiGroup.POST("/dictionary/import", deps.DictionaryController.ImportDictionary)
+	apiGroup.POST("/dictionary/batch", deps.DictionaryController.BatchLookup)
+	apiGroup.GET("/dictionary/cache/stats", deps.DictionaryController.GetCacheStats)
+	apiGroup.DELETE("/dictionary/cache", deps.DictionaryController.PurgeCache)
 	apiGroup.GET("/dictionary/:language/:word", deps.DictionaryController.SearchWord)
 
 	// Words routes
@@ -94,12 +190,21 @@ func SetupAPIRoutesWithDependencies(router *gin.Engine, deps *ControllerDependen
 	apiGroup.POST("/words/search", deps.WordController.SearchWords)
 	apiGroup.POST("/words/random", deps.WordController.RandomWords)
 	apiGroup.POST("/words", deps.WordController.CreateWord)
+	apiGroup.POST("/words/from-dictionary", deps.DictionaryController.CreateWordFromDictionary)
 	apiGroup.PUT("/words/:id", deps.WordController.UpdateWord)
 	apiGroup.DELETE("/words/:id", deps.WordController.DeleteWord)
 	apiGroup.POST("/words/count", deps.WordController.CountWords)
 	apiGroup.GET("/words/stats", deps.WordController.StatsWords)
 	apiGroup.GET("/words/trend", deps.WordController.GetWordsTrend)
+	apiGroup.GET("/words/retention", deps.WordController.GetWordsRetention)
+	apiGroup.GET("/words/speed", deps.WordController.GetWordsSpeed)
+	apiGroup.GET("/words/duplicates", deps.WordController.ListDuplicateWords)
 	apiGroup.GET("/words/:id/logs", deps.WordController.GetWordLogs)
+	apiGroup.POST("/words/:id/merge", deps.WordController.MergeWords)
+	apiGroup.POST("/words/spelling/random", deps.WordController.RandomSpellingPrompts)
+	apiGroup.POST("/words/:id/spelling", deps.WordController.AnswerSpelling)
+	apiGroup.POST("/words/cloze/random", deps.WordController.RandomClozePrompts)
+	apiGroup.POST("/words/:id/cloze", deps.WordController.AnswerCloze)
 	apiGroup.POST("/words/definition/:id", deps.WordController.CreateWordDefinition)
 	apiGroup.PUT("/words/definition/:id", deps.WordController.UpdateWordDefinition)
 	apiGroup.DELETE("/words/definition/:id", deps.WordController.DeleteWordDefinition)
@@ -108,13 +213,17 @@ func SetupAPIRoutesWithDependencies(router *gin.Engine, deps *ControllerDependen
 	apiGroup.GET("/questions", deps.QuestionController.ListQuestions)
 	apiGroup.GET("/questions/:id", deps.QuestionController.GetQuestions)
 	apiGroup.POST("/questions/random", deps.QuestionController.RandomQuestions)
+	apiGroup.POST("/questions/generate", deps.QuestionController.GenerateQuestions)
 	apiGroup.POST("/questions", deps.QuestionController.CreateQuestions)
 	apiGroup.PUT("/questions/:id", deps.QuestionController.UpdateQuestions)
 	apiGroup.DELETE("/questions/:id", deps.QuestionController.DeleteQuestions)
 	apiGroup.GET("/questions/count", deps.QuestionController.CountQuestions)
 	apiGroup.GET("/questions/stats", deps.QuestionController.StatsQuestions)
 	apiGroup.GET("/questions/trend", deps.QuestionController.GetQuestionsTrend)
+	apiGroup.GET("/questions/speed", deps.QuestionController.GetQuestionsSpeed)
+	apiGroup.GET("/questions/analysis", deps.QuestionController.GetQuestionsAnalysis)
 	apiGroup.GET("/questions/:id/logs", deps.QuestionController.GetQuestionLogs)
+	apiGroup.GET("/questions/:id/analysis", deps.QuestionController.GetQuestionAnalysis)
 
 	// Note routes
 	apiGroup.GET("/notes", deps.NoteController.ListNotes)
@@ -125,10 +234,44 @@ func SetupAPIRoutesWithDependencies(router *gin.Engine, deps *ControllerDependen
 	apiGroup.DELETE("/notes/:id", deps.NoteController.DeleteNote)
 	apiGroup.GET("/notes/count", deps.NoteController.CountNotes)
 
+	// Link routes
+	apiGroup.GET("/links", deps.LinkController.ListLinks)
+	apiGroup.POST("/links", deps.LinkController.CreateLink)
+	apiGroup.DELETE("/links/:id", deps.LinkController.DeleteLink)
+
 	// Data export/import routes
 	apiGroup.GET("/data/export", deps.BackupController.ExportData)
 	apiGroup.POST("/data/import", deps.BackupController.ImportData)
 	apiGroup.GET("/data/backups", deps.BackupController.ListBackups)
 	apiGroup.POST("/data/backups", deps.BackupController.TriggerBackup)
 	apiGroup.GET("/data/backups/:name", deps.BackupController.DownloadBackup)
+
+	// Audio routes
+	apiGroup.GET("/audio/:id", deps.AudioController.GetAudio)
+
+	// Progress routes
+	apiGroup.GET("/progress/streak", deps.ProgressController.GetStreak)
+	apiGroup.GET("/progress/goal", deps.ProgressController.GetDailyGoal)
+	apiGroup.PUT("/progress/goal", deps.ProgressController.UpdateDailyGoal)
+	apiGroup.GET("/progress/forecast", deps.ProgressController.GetForecast)
+	apiGroup.GET("/progress/timezone", deps.ProgressController.GetReportTimezone)
+	apiGroup.PUT("/progress/timezone", deps.ProgressController.UpdateReportTimezone)
+
+	// Leech routes
+	apiGroup.GET("/leeches", deps.LeechController.ListLeeches)
+	apiGroup.GET("/leeches/settings", deps.LeechController.GetLeechSettings)
+	apiGroup.PUT("/leeches/settings", deps.LeechController.UpdateLeechSettings)
+	apiGroup.DELETE("/leeches/:type/:id", deps.LeechController.ClearLeech)
+
+	// Reminder routes
+	apiGroup.GET("/reminders", deps.ReminderController.ListReminders)
+	apiGroup.GET("/reminders/due", deps.ReminderController.ListDueReminders)
+	apiGroup.GET("/reminders/history", deps.ReminderController.ListReminderHistory)
+	apiGroup.POST("/reminders", deps.ReminderController.CreateReminder)
+	apiGroup.PUT("/reminders/:id", deps.ReminderController.UpdateReminder)
+	apiGroup.POST("/reminders/:id/clear", deps.ReminderController.ClearReminder)
+	apiGroup.DELETE("/reminders/:id", deps.ReminderController.DeleteReminder)
+
+	// Calendar routes
+	apiGroup.GET("/calendar.ics", deps.CalendarController.GetCalendarFeed)
 }
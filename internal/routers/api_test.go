@@ -37,7 +37,13 @@ func (s *apiRoutesTestSuite) SetupTest() {
 	mockWordController := mocks.NewMockWordController()
 	mockQuestionController := mocks.NewMockQuestionController()
 	mockNoteController := mocks.NewMockNoteController()
+	mockLinkController := mocks.NewMockLinkController()
 	mockBackupController := mocks.NewMockBackupController()
+	mockAudioController := mocks.NewMockAudioController()
+	mockProgressController := mocks.NewMockProgressController()
+	mockLeechController := mocks.NewMockLeechController()
+	mockReminderController := mocks.NewMockReminderController()
+	mockCalendarController := mocks.NewMockCalendarController()
 
 	// Create controller dependencies with mock controllers
 	deps := &ControllerDependencies{
@@ -46,7 +52,13 @@ func (s *apiRoutesTestSuite) SetupTest() {
 		WordController:       mockWordController,
 		QuestionController:   mockQuestionController,
 		NoteController:       mockNoteController,
+		LinkController:       mockLinkController,
 		BackupController:     mockBackupController,
+		AudioController:      mockAudioController,
+		ProgressController:   mockProgressController,
+		LeechController:      mockLeechController,
+		ReminderController:   mockReminderController,
+		CalendarController:   mockCalendarController,
 	}
 
 	// Create a new gin router and setup API routes with mock controllers
@@ -76,11 +88,17 @@ func (s *apiRoutesTestSuite) TestAPIRouteMappings() {
 		{"GET", "/api/health", "HealthController.HealthCheck", "HealthCheck", "HealthController"},
 		{"GET", "/api/information", "HealthController.InformationCheck", "InformationCheck", "HealthController"},
 		{"GET", "/api/dictionary/en-tw/test", "DictionaryController.SearchWord", "SearchWord", "DictionaryController"},
+		{"GET", "/api/dictionary/suggestions", "DictionaryController.SuggestWords", "SuggestWords", "DictionaryController"},
+		{"POST", "/api/dictionary/import", "DictionaryController.ImportDictionary", "ImportDictionary", "DictionaryController"},
+		{"POST", "/api/dictionary/batch", "DictionaryController.BatchLookup", "BatchLookup", "DictionaryController"},
+		{"GET", "/api/dictionary/cache/stats", "DictionaryController.GetCacheStats", "GetCacheStats", "DictionaryController"},
+		{"DELETE", "/api/dictionary/cache", "DictionaryController.PurgeCache", "PurgeCache", "DictionaryController"},
 		// Words
 		{"GET", "/api/words", "WordController.ListWords", "ListWords", "WordController"},
 		{"POST", "/api/words/search", "WordController.SearchWords", "SearchWords", "WordController"},
 		{"POST", "/api/words/random", "WordController.RandomWords", "RandomWords", "WordController"},
 		{"POST", "/api/words", "WordController.CreateWord", "CreateWord", "WordController"},
+		{"POST", "/api/words/from-dictionary", "DictionaryController.CreateWordFromDictionary", "CreateWordFromDictionary", "DictionaryController"},
 		{"POST", "/api/words/definition/1", "WordController.CreateWordDefinition", "CreateWordDefinition", "WordController"},
 		{"PUT", "/api/words/1", "WordController.UpdateWord", "UpdateWord", "WordController"},
 		{"PUT", "/api/words/definition/1", "WordController.UpdateWordDefinition", "UpdateWordDefinition", "WordController"},
@@ -89,18 +107,30 @@ func (s *apiRoutesTestSuite) TestAPIRouteMappings() {
 		{"POST", "/api/words/count", "WordController.CountWords", "CountWords", "WordController"},
 		{"GET", "/api/words/stats", "WordController.StatsWords", "StatsWords", "WordController"},
 		{"GET", "/api/words/trend", "WordController.GetWordsTrend", "GetWordsTrend", "WordController"},
+		{"GET", "/api/words/retention", "WordController.GetWordsRetention", "GetWordsRetention", "WordController"},
+		{"GET", "/api/words/speed", "WordController.GetWordsSpeed", "GetWordsSpeed", "WordController"},
+		{"GET", "/api/words/duplicates", "WordController.ListDuplicateWords", "ListDuplicateWords", "WordController"},
 		{"GET", "/api/words/1/logs", "WordController.GetWordLogs", "GetWordLogs", "WordController"},
+		{"POST", "/api/words/1/merge", "WordController.MergeWords", "MergeWords", "WordController"},
+		{"POST", "/api/words/spelling/random", "WordController.RandomSpellingPrompts", "RandomSpellingPrompts", "WordController"},
+		{"POST", "/api/words/1/spelling", "WordController.AnswerSpelling", "AnswerSpelling", "WordController"},
+		{"POST", "/api/words/cloze/random", "WordController.RandomClozePrompts", "RandomClozePrompts", "WordController"},
+		{"POST", "/api/words/1/cloze", "WordController.AnswerCloze", "AnswerCloze", "WordController"},
 		// Questions
 		{"GET", "/api/questions", "QuestionController.ListQuestions", "ListQuestions", "QuestionController"},
 		{"GET", "/api/questions/1", "QuestionController.GetQuestions", "GetQuestions", "QuestionController"},
 		{"POST", "/api/questions/random", "QuestionController.RandomQuestions", "RandomQuestions", "QuestionController"},
+		{"POST", "/api/questions/generate", "QuestionController.GenerateQuestions", "GenerateQuestions", "QuestionController"},
 		{"POST", "/api/questions", "QuestionController.CreateQuestions", "CreateQuestions", "QuestionController"},
 		{"PUT", "/api/questions/1", "QuestionController.UpdateQuestions", "UpdateQuestions", "QuestionController"},
 		{"DELETE", "/api/questions/1", "QuestionController.DeleteQuestions", "DeleteQuestions", "QuestionController"},
 		{"GET", "/api/questions/count", "QuestionController.CountQuestions", "CountQuestions", "QuestionController"},
 		{"GET", "/api/questions/stats", "QuestionController.StatsQuestions", "StatsQuestions", "QuestionController"},
 		{"GET", "/api/questions/trend", "QuestionController.GetQuestionsTrend", "GetQuestionsTrend", "QuestionController"},
+		{"GET", "/api/questions/speed", "QuestionController.GetQuestionsSpeed", "GetQuestionsSpeed", "QuestionController"},
+		{"GET", "/api/questions/analysis", "QuestionController.GetQuestionsAnalysis", "GetQuestionsAnalysis", "QuestionController"},
 		{"GET", "/api/questions/1/logs", "QuestionController.GetQuestionLogs", "GetQuestionLogs", "QuestionController"},
+		{"GET", "/api/questions/1/analysis", "QuestionController.GetQuestionAnalysis", "GetQuestionAnalysis", "QuestionController"},
 		// Notes
 		{"GET", "/api/notes", "NoteController.ListNotes", "ListNotes", "NoteController"},
 		{"POST", "/api/notes/search", "NoteController.SearchNotes", "SearchNotes", "NoteController"},
@@ -109,12 +139,41 @@ func (s *apiRoutesTestSuite) TestAPIRouteMappings() {
 		{"PUT", "/api/notes/1", "NoteController.UpdateNote", "UpdateNote", "NoteController"},
 		{"DELETE", "/api/notes/1", "NoteController.DeleteNote", "DeleteNote", "NoteController"},
 		{"GET", "/api/notes/count", "NoteController.CountNotes", "CountNotes", "NoteController"},
+		{"GET", "/api/links", "LinkController.ListLinks", "ListLinks", "LinkController"},
+		{"POST", "/api/links", "LinkController.CreateLink", "CreateLink", "LinkController"},
+		{"DELETE", "/api/links/1", "LinkController.DeleteLink", "DeleteLink", "LinkController"},
 		// Data export/import
 		{"GET", "/api/data/export", "BackupController.ExportData", "ExportData", "BackupController"},
 		{"POST", "/api/data/import", "BackupController.ImportData", "ImportData", "BackupController"},
 		{"GET", "/api/data/backups", "BackupController.ListBackups", "ListBackups", "BackupController"},
 		{"POST", "/api/data/backups", "BackupController.TriggerBackup", "TriggerBackup", "BackupController"},
 		{"GET", "/api/data/backups/:name", "BackupController.DownloadBackup", "DownloadBackup", "BackupController"},
+		{"GET", "/api/audio/1", "AudioController.GetAudio", "GetAudio", "AudioController"},
+		// Progress
+		{"GET", "/api/progress/streak", "ProgressController.GetStreak", "GetStreak", "ProgressController"},
+		{"GET", "/api/progress/goal", "ProgressController.GetDailyGoal", "GetDailyGoal", "ProgressController"},
+		{"PUT", "/api/progress/goal", "ProgressController.UpdateDailyGoal", "UpdateDailyGoal", "ProgressController"},
+		{"GET", "/api/progress/forecast", "ProgressController.GetForecast", "GetForecast", "ProgressController"},
+		{"GET", "/api/progress/timezone", "ProgressController.GetReportTimezone", "GetReportTimezone", "ProgressController"},
+		{"PUT", "/api/progress/timezone", "ProgressController.UpdateReportTimezone", "UpdateReportTimezone", "ProgressController"},
+
+		// Leeches
+		{"GET", "/api/leeches", "LeechController.ListLeeches", "ListLeeches", "LeechController"},
+		{"GET", "/api/leeches/settings", "LeechController.GetLeechSettings", "GetLeechSettings", "LeechController"},
+		{"PUT", "/api/leeches/settings", "LeechController.UpdateLeechSettings", "UpdateLeechSettings", "LeechController"},
+		{"DELETE", "/api/leeches/word/1", "LeechController.ClearLeech", "ClearLeech", "LeechController"},
+
+		// Reminders
+		{"GET", "/api/reminders", "ReminderController.ListReminders", "ListReminders", "ReminderController"},
+		{"GET", "/api/reminders/due", "ReminderController.ListDueReminders", "ListDueReminders", "ReminderController"},
+		{"GET", "/api/reminders/history", "ReminderController.ListReminderHistory", "ListReminderHistory", "ReminderController"},
+		{"POST", "/api/reminders", "ReminderController.CreateReminder", "CreateReminder", "ReminderController"},
+		{"PUT", "/api/reminders/1", "ReminderController.UpdateReminder", "UpdateReminder", "ReminderController"},
+		{"POST", "/api/reminders/1/clear", "ReminderController.ClearReminder", "ClearReminder", "ReminderController"},
+		{"DELETE", "/api/reminders/1", "ReminderController.DeleteReminder", "DeleteReminder", "ReminderController"},
+
+		// Calendar
+		{"GET", "/api/calendar.ics", "CalendarController.GetCalendarFeed", "GetCalendarFeed", "CalendarController"},
 	}
 
 	// Test each route mapping calls the correct method
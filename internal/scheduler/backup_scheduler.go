@@ -1,5 +1,6 @@
 // Package scheduler runs periodic background jobs that don't belong to any
-// single HTTP request -- currently just the automatic database backup.
+// single HTTP request -- the automatic database backup and the marking of
+// overdue reminders.
 package scheduler
 
 import (
@@ -8,7 +9,10 @@ import (
 	"sort"
 	"time"
 
+	"word-flashcard/internal/audiostore"
+	"word-flashcard/internal/controllers/audio"
 	"word-flashcard/internal/controllers/backup"
+	"word-flashcard/internal/controllers/common"
 	"word-flashcard/utils/config"
 )
 
@@ -97,7 +101,13 @@ func StartBackupScheduler(stop <-chan struct{}) {
 // time (see data/peers/base.go: NewBasePeer never reuses a shared pool) and
 // leak connections for as long as the process stays up.
 func newBackupController() (*backup.Controller, error) {
-	wordPeer, wordDefinitionPeer, questionPeer, questionAnswerLogPeer, wordPracticeLogPeer, notePeer, backupPeer, err := backup.GetReelPeers()
+	wordPeer, wordDefinitionPeer, questionPeer, questionOptionPeer, questionAnswerLogPeer, wordPracticeLogPeer,
+		notePeer, entityLinkPeer, settingPeer, reminderPeer, reminderLogPeer, backupPeer, err := backup.GetReelPeers()
+	if err != nil {
+		return nil, err
+	}
+
+	audioFilePeer, err := audio.GetReelPeer()
 	if err != nil {
 		return nil, err
 	}
@@ -106,10 +116,16 @@ func newBackupController() (*backup.Controller, error) {
 		wordPeer,
 		wordDefinitionPeer,
 		questionPeer,
+		questionOptionPeer,
 		questionAnswerLogPeer,
 		wordPracticeLogPeer,
 		notePeer,
+		entityLinkPeer,
+		settingPeer,
+		reminderPeer,
+		reminderLogPeer,
 		backupPeer,
+		audiostore.NewAudioStore(audioFilePeer),
 	), nil
 }
 
@@ -128,7 +144,7 @@ func runBackupIfDue(bc *backup.Controller, dir string, interval time.Duration, r
 		return
 	}
 
-	path, err := bc.WriteBackupFile(dir)
+	path, err := bc.WriteBackupFile(dir, common.ReportLocation())
 	if err != nil {
 		slog.Error("Scheduled backup failed", "error", err, "dir", dir)
 		return
@@ -9,6 +9,7 @@ import (
 
 	"word-flashcard/data/mocks"
 	dbModels "word-flashcard/data/models"
+	"word-flashcard/internal/audiostore"
 	"word-flashcard/internal/controllers/backup"
 
 	"github.com/stretchr/testify/mock"
@@ -31,29 +32,41 @@ func createBackupFile(t *testing.T, dir, suffix string, modTime time.Time) strin
 
 // newTestBackupController builds a *backup.Controller backed entirely by
 // mocks, returning the peer mocks in the same order BuildExport queries them
-// (word, question, note, word definition, question answer log, word
-// practice log) so a test can set up or omit their Select() expectations.
+// (word, question, note, word definition, question option, question answer
+// log, word practice log, entity link, audio file) so a test can set up or omit their Select() expectations.
 func newTestBackupController(t *testing.T) (
 	*backup.Controller,
 	*mocks.MockWordPeer,
 	*mocks.MockQuestionPeer,
 	*mocks.MockNotePeer,
 	*mocks.MockWordDefinitionsPeer,
+	*mocks.MockQuestionOptionPeer,
 	*mocks.MockQuestionAnswerLogPeer,
 	*mocks.MockWordPracticeLogPeer,
+	*mocks.MockEntityLinkPeer,
+	*mocks.MockSettingPeer,
+	*mocks.MockReminderPeer,
+	*mocks.MockReminderLogPeer,
+	*mocks.MockAudioFilePeer,
 ) {
 	t.Helper()
 
 	wordPeer := mocks.NewMockWordPeer(t)
 	wordDefinitionPeer := mocks.NewMockWordDefinitionsPeer(t)
 	questionPeer := mocks.NewMockQuestionPeer(t)
+	questionOptionPeer := mocks.NewMockQuestionOptionPeer(t)
 	questionAnswerLogPeer := mocks.NewMockQuestionAnswerLogPeer(t)
 	wordPracticeLogPeer := mocks.NewMockWordPracticeLogPeer(t)
 	notePeer := mocks.NewMockNotePeer(t)
+	entityLinkPeer := mocks.NewMockEntityLinkPeer(t)
+	settingPeer := mocks.NewMockSettingPeer(t)
+	reminderPeer := mocks.NewMockReminderPeer(t)
+	reminderLogPeer := mocks.NewMockReminderLogPeer(t)
 	backupPeer := mocks.NewMockBackupPeer(t)
+	audioFilePeer := mocks.NewMockAudioFilePeer(t)
 
-	bc := backup.New(wordPeer, wordDefinitionPeer, questionPeer, questionAnswerLogPeer, wordPracticeLogPeer, notePeer, backupPeer)
-	return bc, wordPeer, questionPeer, notePeer, wordDefinitionPeer, questionAnswerLogPeer, wordPracticeLogPeer
+	bc := backup.New(wordPeer, wordDefinitionPeer, questionPeer, questionOptionPeer, questionAnswerLogPeer, wordPracticeLogPeer, notePeer, entityLinkPeer, settingPeer, reminderPeer, reminderLogPeer, backupPeer, audiostore.NewAudioStore(audioFilePeer))
+	return bc, wordPeer, questionPeer, notePeer, wordDefinitionPeer, questionOptionPeer, questionAnswerLogPeer, wordPracticeLogPeer, entityLinkPeer, settingPeer, reminderPeer, reminderLogPeer, audioFilePeer
 }
 
 // expectSuccessfulExport sets up every peer mock to return an empty table
@@ -63,8 +76,14 @@ func expectSuccessfulExport(
 	questionPeer *mocks.MockQuestionPeer,
 	notePeer *mocks.MockNotePeer,
 	wordDefinitionPeer *mocks.MockWordDefinitionsPeer,
+	questionOptionPeer *mocks.MockQuestionOptionPeer,
 	questionAnswerLogPeer *mocks.MockQuestionAnswerLogPeer,
 	wordPracticeLogPeer *mocks.MockWordPracticeLogPeer,
+	entityLinkPeer *mocks.MockEntityLinkPeer,
+	settingPeer *mocks.MockSettingPeer,
+	reminderPeer *mocks.MockReminderPeer,
+	reminderLogPeer *mocks.MockReminderLogPeer,
+	audioFilePeer *mocks.MockAudioFilePeer,
 ) {
 	wordPeer.EXPECT().Select(mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).
 		Return([]*dbModels.Word{}, nil).Times(1)
@@ -74,10 +93,22 @@ func expectSuccessfulExport(
 		Return([]*dbModels.Note{}, nil).Times(1)
 	wordDefinitionPeer.EXPECT().Select(mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).
 		Return([]*dbModels.WordDefinition{}, nil).Times(1)
+	questionOptionPeer.EXPECT().Select(mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+		Return([]*dbModels.QuestionOption{}, nil).Times(1)
 	questionAnswerLogPeer.EXPECT().Select(mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).
 		Return([]*dbModels.QuestionAnswerLog{}, nil).Times(1)
 	wordPracticeLogPeer.EXPECT().Select(mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).
 		Return([]*dbModels.WordPracticeLog{}, nil).Times(1)
+	entityLinkPeer.EXPECT().Select(mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+		Return([]*dbModels.EntityLink{}, nil).Times(1)
+	settingPeer.EXPECT().Select(mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+		Return([]*dbModels.Setting{}, nil).Times(1)
+	reminderPeer.EXPECT().Select(mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+		Return([]*dbModels.Reminder{}, nil).Times(1)
+	reminderLogPeer.EXPECT().Select(mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+		Return([]*dbModels.ReminderLog{}, nil).Times(1)
+	audioFilePeer.EXPECT().Select(mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+		Return([]*dbModels.AudioFile{}, nil).Times(1)
 }
 
 // TestRunBackupIfDue covers every branch of the schedule-check-then-act
@@ -92,7 +123,7 @@ func TestRunBackupIfDue(t *testing.T) {
 	t.Run("not due yet: recent backup exists, no peer is queried", func(t *testing.T) {
 		dir := t.TempDir()
 		createBackupFile(t, dir, "recent", now.Add(-1*time.Hour))
-		bc, _, _, _, _, _, _ := newTestBackupController(t)
+		bc, _, _, _, _, _, _, _, _, _, _, _, _ := newTestBackupController(t)
 
 		runBackupIfDue(bc, dir, interval, 10)
 
@@ -105,8 +136,8 @@ func TestRunBackupIfDue(t *testing.T) {
 		dir := t.TempDir()
 		createBackupFile(t, dir, "oldest", now.Add(-100*time.Hour))
 		createBackupFile(t, dir, "middle", now.Add(-80*time.Hour))
-		bc, wordPeer, questionPeer, notePeer, wordDefinitionPeer, questionAnswerLogPeer, wordPracticeLogPeer := newTestBackupController(t)
-		expectSuccessfulExport(wordPeer, questionPeer, notePeer, wordDefinitionPeer, questionAnswerLogPeer, wordPracticeLogPeer)
+		bc, wordPeer, questionPeer, notePeer, wordDefinitionPeer, questionOptionPeer, questionAnswerLogPeer, wordPracticeLogPeer, entityLinkPeer, settingPeer, reminderPeer, reminderLogPeer, audioFilePeer := newTestBackupController(t)
+		expectSuccessfulExport(wordPeer, questionPeer, notePeer, wordDefinitionPeer, questionOptionPeer, questionAnswerLogPeer, wordPracticeLogPeer, entityLinkPeer, settingPeer, reminderPeer, reminderLogPeer, audioFilePeer)
 
 		runBackupIfDue(bc, dir, interval, 2)
 
@@ -119,7 +150,7 @@ func TestRunBackupIfDue(t *testing.T) {
 
 	t.Run("write failure: error is only logged, no file is written, pruning is skipped", func(t *testing.T) {
 		dir := t.TempDir()
-		bc, wordPeer, _, _, _, _, _ := newTestBackupController(t)
+		bc, wordPeer, _, _, _, _, _, _, _, _, _, _, _ := newTestBackupController(t)
 		wordPeer.EXPECT().Select(mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).
 			Return(nil, errors.New("select failed")).Times(1)
 
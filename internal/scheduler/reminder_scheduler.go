@@ -0,0 +1,116 @@
+package scheduler
+
+import (
+	"log/slog"
+	"time"
+
+	"word-flashcard/internal/controllers/link"
+	"word-flashcard/internal/controllers/reminder"
+	"word-flashcard/internal/links"
+	"word-flashcard/utils/config"
+)
+
+const (
+	defaultReminderEnabled      = true
+	defaultReminderCheckMinutes = 15 // how often to look for overdue reminders
+	defaultReminderOverdueHours = 24 // how long a due reminder may go uncleared before it is overdue
+)
+
+// StartReminderScheduler marks overdue reminders once immediately, then on
+// every tick of the configured check interval: a reminder is overdue once it
+// has been due for REMINDER_OVERDUE_HOURS without being cleared. Each check
+// also removes the reminders of deleted words, questions and notes. It
+// blocks until stop is closed, so callers should run it in its own
+// goroutine.
+//
+// Setting REMINDER_SCHEDULER_ENABLED=false disables the scheduler and
+// StartReminderScheduler returns immediately without connecting to the
+// database; due reminders are still listed, just never marked overdue.
+func StartReminderScheduler(stop <-chan struct{}) {
+	// A panic here must never take down the HTTP server -- this goroutine
+	// isn't covered by gin's RecoveryMiddleware.
+	defer func() {
+		if r := recover(); r != nil {
+			slog.Error("Reminder scheduler panicked and stopped", "panic", r)
+		}
+	}()
+
+	if !config.GetOrDefaultBool("REMINDER_SCHEDULER_ENABLED", defaultReminderEnabled) {
+		slog.Info("Reminder scheduler disabled via REMINDER_SCHEDULER_ENABLED=false; reminders won't be marked overdue")
+		return
+	}
+
+	checkInterval := time.Duration(config.GetOrDefaultInt("REMINDER_CHECK_INTERVAL_MINUTES", defaultReminderCheckMinutes)) * time.Minute
+	grace := time.Duration(config.GetOrDefaultInt("REMINDER_OVERDUE_HOURS", defaultReminderOverdueHours)) * time.Hour
+
+	if checkInterval <= 0 {
+		slog.Warn("REMINDER_CHECK_INTERVAL_MINUTES resolved to a non-positive duration; falling back to the minimum",
+			"resolved", checkInterval.String(), "minimum", minCheckInterval.String())
+		checkInterval = minCheckInterval
+	}
+	if grace < 0 {
+		grace = 0
+	}
+
+	rc, err := newReminderController()
+	if err != nil {
+		slog.Error("Reminder scheduler failed to start: could not connect to the database", "error", err)
+		return
+	}
+
+	slog.Info("Reminder scheduler started", "check_interval", checkInterval.String(), "overdue_after", grace.String())
+
+	runReminderCheck(rc, time.Now(), grace)
+
+	ticker := time.NewTicker(checkInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+			runReminderCheck(rc, time.Now(), grace)
+		case <-stop:
+			slog.Info("Reminder scheduler stopped")
+			return
+		}
+	}
+}
+
+// newReminderController wires up the same real peers/controller that
+// internal/routers/api.go builds for the /api/reminders routes, held for
+// the scheduler goroutine's entire lifetime for the same reason as
+// newBackupController's.
+func newReminderController() (*reminder.Controller, error) {
+	reminderPeer, reminderLogPeer, err := reminder.GetReelPeers()
+	if err != nil {
+		return nil, err
+	}
+
+	entityLinkPeer, wordPeer, questionPeer, notePeer, err := link.GetReelPeers()
+	if err != nil {
+		return nil, err
+	}
+
+	return reminder.New(reminderPeer, reminderLogPeer, links.NewLinkResolver(entityLinkPeer, wordPeer, questionPeer, notePeer)), nil
+}
+
+// runReminderCheck removes orphaned reminders, then marks those due more
+// than grace before now as overdue. Every failure is only logged -- a failed
+// check must never affect the running server, and the next tick retries.
+func runReminderCheck(rc *reminder.Controller, now time.Time, grace time.Duration) {
+	pruned, err := rc.PruneOrphans()
+	if err != nil {
+		slog.Error("Failed to remove reminders of deleted items", "error", err)
+	} else if pruned > 0 {
+		slog.Info("Removed reminders of deleted items", "count", pruned)
+	}
+
+	marked, err := rc.MarkOverdue(now, grace)
+	if err != nil {
+		slog.Error("Failed to mark overdue reminders", "error", err)
+		return
+	}
+	if marked > 0 {
+		slog.Info("Marked reminders overdue", "count", marked)
+	}
+}
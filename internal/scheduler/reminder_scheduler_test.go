@@ -0,0 +1,56 @@
+package scheduler
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"word-flashcard/data/mocks"
+	dbModels "word-flashcard/data/models"
+	"word-flashcard/internal/controllers/reminder"
+	"word-flashcard/internal/links"
+
+	"github.com/stretchr/testify/mock"
+)
+
+// newTestReminderController builds a *reminder.Controller backed entirely by
+// mocks; none of the tests below reach the link resolver's peers because
+// there are no reminders to label.
+func newTestReminderController(t *testing.T) (*reminder.Controller, *mocks.MockReminderPeer) {
+	t.Helper()
+
+	reminderPeer := mocks.NewMockReminderPeer(t)
+	linkResolver := links.NewLinkResolver(mocks.NewMockEntityLinkPeer(t), mocks.NewMockWordPeer(t), mocks.NewMockQuestionPeer(t), mocks.NewMockNotePeer(t))
+	return reminder.New(reminderPeer, mocks.NewMockReminderLogPeer(t), linkResolver), reminderPeer
+}
+
+// TestRunReminderCheck verifies each check prunes orphans before marking
+// overdue reminders, and that a failed prune doesn't stop the marking.
+func TestRunReminderCheck(t *testing.T) {
+	now := time.Date(2024, 1, 16, 12, 0, 0, 0, time.UTC)
+
+	t.Run("prunes then marks", func(t *testing.T) {
+		rc, reminderPeer := newTestReminderController(t)
+		pruned := reminderPeer.EXPECT().
+			Select(mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+			Return([]*dbModels.Reminder{}, nil).Once()
+		reminderPeer.EXPECT().
+			Update(mock.Anything, mock.Anything).
+			Return(int64(3), nil).Once().NotBefore(pruned)
+
+		runReminderCheck(rc, now, time.Hour)
+	})
+
+	t.Run("marks even when pruning fails", func(t *testing.T) {
+		rc, reminderPeer := newTestReminderController(t)
+		reminderPeer.EXPECT().
+			Select(mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+			Return(nil, errors.New("select failed")).Once()
+		reminderPeer.EXPECT().
+			Update(mock.Anything, mock.Anything).
+			Return(int64(0), errors.New("update failed")).Once()
+
+		// Failures are only logged
+		runReminderCheck(rc, now, time.Hour)
+	})
+}
@@ -53,11 +53,12 @@ func main() {
 		slog.Error("Failed to initialize database:", "error", err)
 	}
 
-	// Start the background backup scheduler (runs once now, then on its own
-	// interval); a failure to start only disables backups, it never blocks
-	// server startup.
-	backupSchedulerStop := make(chan struct{})
-	go scheduler.StartBackupScheduler(backupSchedulerStop)
+	// Start the background backup and reminder schedulers (each runs once
+	// now, then on its own interval); a failure to start only disables that
+	// job, it never blocks server startup.
+	schedulerStop := make(chan struct{})
+	go scheduler.StartBackupScheduler(schedulerStop)
+	go scheduler.StartReminderScheduler(schedulerStop)
 
 	// Get HTTP server
 	server := getHTTPServer()
@@ -76,7 +77,7 @@ func main() {
 	slog.Info("=============== Completed Start Up Server ===============")
 
 	// Run HTTP server
-	runHTTPServer(server, backupSchedulerStop)
+	runHTTPServer(server, schedulerStop)
 }
 
 func bootstrap() error {
@@ -114,7 +115,7 @@ func getHTTPServer() *http.Server {
 	}
 }
 
-func runHTTPServer(server *http.Server, backupSchedulerStop chan<- struct{}) {
+func runHTTPServer(server *http.Server, schedulerStop chan<- struct{}) {
 	go func() {
 		if err := server.ListenAndServe(); err != nil && err != http.ErrServerClosed {
 			slog.Error("Failed to start server", "error", err)
@@ -129,8 +130,8 @@ func runHTTPServer(server *http.Server, backupSchedulerStop chan<- struct{}) {
 	<-quit
 	slog.Debug("Server shutdown signal received")
 
-	// Stop the background backup scheduler alongside the HTTP server
-	close(backupSchedulerStop)
+	// Stop the background schedulers alongside the HTTP server
+	close(schedulerStop)
 
 	// Create context with timeout for shutdown
 	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
@@ -174,6 +175,9 @@ func initializeDatabase() error {
 		slog.Warn("Failed to close database connection", "error", err)
 	}
 
+	// Move data still held in deprecated columns into the tables that replaced them
+	data.MigrateWordReminders()
+
 	strDevMode := os.Getenv("DEV_MODE")
 	devMode, err := strconv.ParseBool(strDevMode)
 	if err != nil {
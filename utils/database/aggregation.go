@@ -0,0 +1,165 @@
+package database
+
+import (
+	"fmt"
+	"log/slog"
+	"strings"
+	"time"
+
+	"github.com/Masterminds/squirrel"
+)
+
+// AggregateFunc is an SQL aggregate function usable in an Aggregation
+type AggregateFunc string
+
+const (
+	AGGREGATE_COUNT AggregateFunc = "COUNT"
+	AGGREGATE_SUM   AggregateFunc = "SUM"
+	AGGREGATE_AVG   AggregateFunc = "AVG"
+)
+
+// Aggregation is one aggregated result column, e.g. COUNT(*) AS practice_count.
+// Expr is inlined into the query as is, so it must never carry user input;
+// Alias names the dest struct field the result is scanned into.
+type Aggregation struct {
+	Func  AggregateFunc
+	Expr  string
+	Alias string
+}
+
+// CountAll counts every row of the group into alias
+func CountAll(alias string) Aggregation {
+	return Aggregation{Func: AGGREGATE_COUNT, Expr: "*", Alias: alias}
+}
+
+// Sum totals expr over the group into alias
+func Sum(expr string, alias string) Aggregation {
+	return Aggregation{Func: AGGREGATE_SUM, Expr: expr, Alias: alias}
+}
+
+// Avg averages expr over the group into alias
+func Avg(expr string, alias string) Aggregation {
+	return Aggregation{Func: AGGREGATE_AVG, Expr: expr, Alias: alias}
+}
+
+// Grouping is one GROUP BY key of an aggregate query. Its SQL can differ per
+// database type (e.g. date conversion), so it is rendered when the query is
+// built; any values it needs are bound as query arguments.
+type Grouping struct {
+	Alias string
+	expr  func(dbType string) squirrel.Sqlizer
+}
+
+// GroupByColumn groups rows by the plain value of column, returned under the column's own name
+func GroupByColumn(column string) Grouping {
+	return Grouping{
+		Alias: column,
+		expr:  func(string) squirrel.Sqlizer { return squirrel.Expr(column) },
+	}
+}
+
+// GroupByDate groups rows by the calendar day of a UTC timestamp column as
+// seen in loc, returned under alias as a "YYYY-MM-DD" string. Bucketing
+// happens in SQL so callers don't need to load every row to count per day.
+// The timezone name and offset are bound as arguments rather than inlined.
+//
+// PostgreSQL converts with its built-in timezone database. MySQL only knows
+// named timezones once its timezone tables are loaded, which many servers
+// skip; there CONVERT_TZ returns NULL and the day falls back to loc's current
+// UTC offset, which is only off for rows on the other side of a DST change.
+func GroupByDate(column string, loc *time.Location, alias string) Grouping {
+	name := loc.String()
+	_, offsetSeconds := time.Now().In(loc).Zone()
+	offset := formatUTCOffset(offsetSeconds)
+
+	return Grouping{
+		Alias: alias,
+		expr: func(dbType string) squirrel.Sqlizer {
+			switch dbType {
+			case "postgresql":
+				return squirrel.Expr(fmt.Sprintf("TO_CHAR((%s AT TIME ZONE 'UTC') AT TIME ZONE CAST(? AS TEXT), 'YYYY-MM-DD')", column), name)
+			default:
+				return squirrel.Expr(fmt.Sprintf("DATE_FORMAT(COALESCE(CONVERT_TZ(%s, '+00:00', ?), CONVERT_TZ(%s, '+00:00', ?)), '%%Y-%%m-%%d')", column, column),
+					name, offset)
+			}
+		},
+	}
+}
+
+// formatUTCOffset formats an offset in seconds east of UTC as "+08:00"
+func formatUTCOffset(offsetSeconds int) string {
+	sign := '+'
+	if offsetSeconds < 0 {
+		sign = '-'
+		offsetSeconds = -offsetSeconds
+	}
+	return fmt.Sprintf("%c%02d:%02d", sign, offsetSeconds/3600, offsetSeconds%3600/60)
+}
+
+// Aggregate runs a GROUP BY query over table and scans one row per group into
+// dest, which must be a pointer to a slice of structs whose fields match the
+// grouping and aggregation aliases (e.g. practice_count → PracticeCount).
+// Groups are returned in ascending order of the grouping keys.
+func (u *UniversalDatabase) Aggregate(table string, groupBy []Grouping, aggregations []Aggregation, where squirrel.Sqlizer, dest interface{}) error {
+	if u.db == nil {
+		slog.Error("Database is not connected")
+		return NewDatabaseError("aggregate", fmt.Errorf("not connected"))
+	}
+	if len(aggregations) == 0 {
+		return NewDatabaseError("aggregate", fmt.Errorf("at least one aggregation is required"))
+	}
+
+	// --------------- 1. Prepare Select Columns ---------------
+	columns := make([]squirrel.Sqlizer, 0, len(groupBy)+len(aggregations))
+	groupAliases := make([]string, 0, len(groupBy))
+	for _, g := range groupBy {
+		exprSQL, exprArgs, err := g.expr(u.config.Type).ToSql()
+		if err != nil {
+			return NewDatabaseError("aggregate", err)
+		}
+		if exprSQL != g.Alias {
+			exprSQL = fmt.Sprintf("%s AS %s", exprSQL, g.Alias)
+		}
+		columns = append(columns, squirrel.Expr(exprSQL, exprArgs...))
+		groupAliases = append(groupAliases, g.Alias)
+	}
+	for _, a := range aggregations {
+		columns = append(columns, squirrel.Expr(fmt.Sprintf("%s(%s) AS %s", a.Func, a.Expr, a.Alias)))
+	}
+
+	// --------------- 2. Build Query Object ---------------
+	query := squirrel.Select().
+		From(table).
+		PlaceholderFormat(u.placeholderFormat)
+	for _, column := range columns {
+		query = query.Column(column)
+	}
+
+	// Where
+	if where != nil {
+		query = query.Where(where)
+	}
+	// Group By & Order By: both dialects accept the output alias here
+	if len(groupAliases) > 0 {
+		query = query.GroupBy(groupAliases...).OrderBy(strings.Join(groupAliases, ", "))
+	}
+
+	// --------------- 3. Convert to SQL ---------------
+	sql, args, err := query.ToSql()
+	if err != nil {
+		slog.Error("Aggregate had been done but failed to build SELECT query", "error", err)
+		return NewDatabaseError("aggregate", err)
+	}
+
+	// --------------- 4. Run the SQL ---------------
+	u.logQuery(sql, args)
+	rows, err := u.db.Query(sql, args...)
+	if err != nil {
+		slog.Error("Aggregate had been done but failed to execute query", "error", err)
+		return NewDatabaseError("aggregate", err)
+	}
+	defer rows.Close()
+
+	// --------------- 5. Convert Result & Return ---------------
+	return scanToStruct(rows, dest)
+}
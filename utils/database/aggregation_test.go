@@ -0,0 +1,160 @@
+package database
+
+import (
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/Masterminds/squirrel"
+	"github.com/stretchr/testify/suite"
+)
+
+// aggregationTestSuite testing suite components
+type aggregationTestSuite struct {
+	suite.Suite
+	t *testing.T
+}
+
+// TestAggregationSuite runs the test suite
+func TestAggregationSuite(t *testing.T) {
+	suite.Run(t, new(aggregationTestSuite))
+}
+
+// SetupTest for the test suite
+func (s *aggregationTestSuite) SetupTest() {
+	s.t = s.T()
+}
+
+// dailyRow is a dest row for daily aggregation tests
+type dailyRow struct {
+	Day           *string
+	PracticeCount *int
+	AvgScore      *float64
+}
+
+// TestAggregateByDateMySQL tests that MySQL converts the UTC column into the
+// named timezone, falling back to the fixed offset, before grouping by day;
+// both are bound as arguments rather than inlined
+func (s *aggregationTestSuite) TestAggregateByDateMySQL() {
+	db, mock, cleanup := createMockDatabase(s.t, "mysql")
+	defer cleanup()
+
+	loc := time.FixedZone("UTC+8", 8*60*60)
+	since := time.Date(2026, 7, 1, 0, 0, 0, 0, time.UTC)
+
+	expectedSQL := "SELECT DATE_FORMAT(COALESCE(CONVERT_TZ(created_at, '+00:00', ?), CONVERT_TZ(created_at, '+00:00', ?)), '%Y-%m-%d') AS day, " +
+		"COUNT(*) AS practice_count, AVG(score) AS avg_score FROM logs WHERE created_at >= ? GROUP BY day ORDER BY day"
+	rows := sqlmock.NewRows([]string{"day", "practice_count", "avg_score"}).
+		AddRow("2026-07-01", 3, "1.5000").
+		AddRow("2026-07-02", 1, "2.0000")
+	mock.ExpectQuery(regexp.QuoteMeta(expectedSQL)).WithArgs("UTC+8", "+08:00", since).WillReturnRows(rows)
+
+	var result []*dailyRow
+	err := db.Aggregate("logs",
+		[]Grouping{GroupByDate("created_at", loc, "day")},
+		[]Aggregation{CountAll("practice_count"), Avg("score", "avg_score")},
+		squirrel.GtOrEq{"created_at": since},
+		&result,
+	)
+
+	s.NoError(err, "Aggregate should succeed")
+	s.Require().Len(result, 2)
+	s.Equal("2026-07-01", *result[0].Day)
+	s.Equal(3, *result[0].PracticeCount)
+	s.Equal(1.5, *result[0].AvgScore)
+	s.Equal("2026-07-02", *result[1].Day)
+	s.NoError(mock.ExpectationsWereMet())
+}
+
+// TestAggregateByDatePostgreSQL tests that PostgreSQL converts the UTC column
+// with AT TIME ZONE and uses dollar placeholders, binding the timezone name
+// ahead of the WHERE arguments
+func (s *aggregationTestSuite) TestAggregateByDatePostgreSQL() {
+	db, mock, cleanup := createMockDatabase(s.t, "postgresql")
+	defer cleanup()
+
+	loc, err := time.LoadLocation("Asia/Taipei")
+	s.Require().NoError(err)
+
+	expectedSQL := "SELECT TO_CHAR((created_at AT TIME ZONE 'UTC') AT TIME ZONE CAST($1 AS TEXT), 'YYYY-MM-DD') AS day, " +
+		"SUM(CASE WHEN is_correct THEN 1 ELSE 0 END) AS practice_count FROM logs WHERE question_id = $2 GROUP BY day ORDER BY day"
+	rows := sqlmock.NewRows([]string{"day", "practice_count"}).AddRow("2026-07-01", 2)
+	mock.ExpectQuery(regexp.QuoteMeta(expectedSQL)).WithArgs("Asia/Taipei", 7).WillReturnRows(rows)
+
+	var result []*dailyRow
+	err = db.Aggregate("logs",
+		[]Grouping{GroupByDate("created_at", loc, "day")},
+		[]Aggregation{Sum("CASE WHEN is_correct THEN 1 ELSE 0 END", "practice_count")},
+		squirrel.Eq{"question_id": 7},
+		&result,
+	)
+
+	s.NoError(err, "Aggregate should succeed")
+	s.Require().Len(result, 1)
+	s.Equal(2, *result[0].PracticeCount)
+	s.Nil(result[0].AvgScore)
+	s.NoError(mock.ExpectationsWereMet())
+}
+
+// TestAggregateByColumns tests grouping by several plain columns without a WHERE clause
+func (s *aggregationTestSuite) TestAggregateByColumns() {
+	db, mock, cleanup := createMockDatabase(s.t, "mysql")
+	defer cleanup()
+
+	type groupRow struct {
+		QuestionType  *string
+		CountPractise *int
+		QuestionCount *int
+	}
+
+	expectedSQL := "SELECT question_type, count_practise, COUNT(*) AS question_count " +
+		"FROM questions GROUP BY question_type, count_practise ORDER BY question_type, count_practise"
+	rows := sqlmock.NewRows([]string{"question_type", "count_practise", "question_count"}).
+		AddRow(nil, 0, 4).
+		AddRow("single_choice", 3, 2)
+	mock.ExpectQuery(regexp.QuoteMeta(expectedSQL)).WillReturnRows(rows)
+
+	var result []*groupRow
+	err := db.Aggregate("questions",
+		[]Grouping{GroupByColumn("question_type"), GroupByColumn("count_practise")},
+		[]Aggregation{CountAll("question_count")},
+		nil,
+		&result,
+	)
+
+	s.NoError(err, "Aggregate should succeed")
+	s.Require().Len(result, 2)
+	s.Nil(result[0].QuestionType)
+	s.Equal(4, *result[0].QuestionCount)
+	s.Equal("single_choice", *result[1].QuestionType)
+	s.NoError(mock.ExpectationsWereMet())
+}
+
+// TestAggregateRequiresAggregation tests that a query without aggregations is rejected
+func (s *aggregationTestSuite) TestAggregateRequiresAggregation() {
+	db, _, cleanup := createMockDatabase(s.t, "mysql")
+	defer cleanup()
+
+	var result []*dailyRow
+	err := db.Aggregate("logs", []Grouping{GroupByColumn("day")}, nil, nil, &result)
+
+	s.Error(err, "Aggregate should fail without aggregations")
+}
+
+// TestAggregateNotConnected tests that Aggregate fails when the database is not connected
+func (s *aggregationTestSuite) TestAggregateNotConnected() {
+	db := NewUniversalDatabase(createTestConfig())
+
+	var result []*dailyRow
+	err := db.Aggregate("logs", nil, []Aggregation{CountAll("practice_count")}, nil, &result)
+
+	s.Error(err, "Aggregate should fail when not connected")
+}
+
+// TestFormatUTCOffset tests formatting offsets east and west of UTC
+func (s *aggregationTestSuite) TestFormatUTCOffset() {
+	s.Equal("+08:00", formatUTCOffset(8*60*60))
+	s.Equal("-03:30", formatUTCOffset(-(3*60*60 + 30*60)))
+	s.Equal("+00:00", formatUTCOffset(0))
+}
@@ -158,6 +158,20 @@ func scanToStruct(rows *sql.Rows, dest interface{}) error {
 		return err
 	}
 
+	// Match columns to fields by db tag first, so fields named against the
+	// snake_case-to-CamelCase rule (e.g. QuizSessionID) are still scanned;
+	// fields tagged db:"-" are never scanned
+	fieldByTag := make(map[string]int)
+	skipped := make(map[string]bool)
+	for i := 0; i < elementType.NumField(); i++ {
+		field := elementType.Field(i)
+		if dbTag := field.Tag.Get("db"); dbTag == "-" {
+			skipped[field.Name] = true
+		} else if dbTag != "" {
+			fieldByTag[strings.Split(dbTag, ",")[0]] = i
+		}
+	}
+
 	count := 0
 	for rows.Next() {
 		count++
@@ -168,8 +182,12 @@ func scanToStruct(rows *sql.Rows, dest interface{}) error {
 		// Create scan destinations
 		scanDests := make([]interface{}, len(columns))
 		for i, column := range columns {
-			fieldName := snakeToCamel(column)
-			field := elem.FieldByName(fieldName)
+			var field reflect.Value
+			if index, ok := fieldByTag[column]; ok {
+				field = elem.Field(index)
+			} else if name := snakeToCamel(column); !skipped[name] {
+				field = elem.FieldByName(name)
+			}
 			if field.IsValid() && field.CanSet() {
 				scanDests[i] = field.Addr().Interface()
 			} else {
@@ -2,12 +2,15 @@ package database
 
 import (
 	"database/sql"
+	"database/sql/driver"
 	"reflect"
 	"testing"
 	"time"
 
 	"github.com/DATA-DOG/go-sqlmock"
 	"github.com/stretchr/testify/suite"
+
+	"word-flashcard/data/models"
 )
 
 // databaseTestSuite testing suite components
@@ -198,6 +201,9 @@ func (suite *databaseTestSuite) TestScanToStruct() {
 	if results[0].Email != "john@example.com" {
 		suite.t.Errorf("Expected Email=john@example.com, got %s", results[0].Email)
 	}
+	if results[1].ID != 2 {
+		suite.t.Errorf("Expected ID=2 scanned via db tag, got %d", results[1].ID)
+	}
 
 	// Test with invalid destination (not a pointer)
 	var invalidDest []TestStruct
@@ -214,6 +220,136 @@ func (suite *databaseTestSuite) TestScanToStruct() {
 	}
 }
 
+// Test struct whose field names do not all follow the snake_case-to-CamelCase rule
+type TaggedScanStruct struct {
+	SessionID *string `db:"quiz_session_id"`
+	WordId    *int    `db:"word_id"`
+	Nickname  string
+	Ignored   string `db:"-"`
+}
+
+// Test scanning by db tag, snake_case fallback and ignored columns
+func (suite *databaseTestSuite) TestScanToStructTaggedAndUntaggedFields() {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		suite.t.Fatalf("Failed to create sqlmock: %v", err)
+	}
+	defer db.Close()
+
+	rows := sqlmock.NewRows([]string{"quiz_session_id", "word_id", "nickname", "ignored", "unknown_column"}).
+		AddRow("session-1", 7, "Johnny", "skip", "extra")
+	mock.ExpectQuery("SELECT").WillReturnRows(rows)
+
+	sqlRows, err := db.Query("SELECT * FROM test_table")
+	if err != nil {
+		suite.t.Fatalf("Failed to execute query: %v", err)
+	}
+	defer sqlRows.Close()
+
+	var results []TaggedScanStruct
+	if err := scanToStruct(sqlRows, &results); err != nil {
+		suite.t.Fatalf("scanToStruct() returned error: %v", err)
+	}
+	if len(results) != 1 {
+		suite.t.Fatalf("Expected 1 result, got %d", len(results))
+	}
+
+	result := results[0]
+	if result.SessionID == nil || *result.SessionID != "session-1" {
+		suite.t.Errorf("Expected SessionID=session-1 scanned via db tag, got %v", result.SessionID)
+	}
+	if result.WordId == nil || *result.WordId != 7 {
+		suite.t.Errorf("Expected WordId=7 scanned via db tag, got %v", result.WordId)
+	}
+	if result.Nickname != "Johnny" {
+		suite.t.Errorf("Expected Nickname=Johnny scanned via snake_case fallback, got %s", result.Nickname)
+	}
+	if result.Ignored != "" {
+		suite.t.Errorf("Expected db:\"-\" field to be skipped, got %s", result.Ignored)
+	}
+}
+
+// Test every db-tagged column of the data models scans into its field
+func (suite *databaseTestSuite) TestScanToStructDataModels() {
+	modelTypes := []interface{}{
+		[]models.AudioFile{}, []models.DictionaryCacheEntry{}, []models.DictionaryEntry{},
+		[]models.EntityLink{}, []models.Note{}, []models.Question{}, []models.QuestionPractiseGroup{},
+		[]models.QuestionAnswerLog{}, []models.QuestionAnswerLogDailyStats{}, []models.QuestionOption{},
+		[]models.Reminder{}, []models.ReminderLog{}, []models.Setting{}, []models.Word{},
+		[]models.WordPractiseCountGroup{}, []models.WordDefinition{}, []models.WordPracticeLog{},
+		[]models.WordPracticeLogDailyStats{}, []models.WordFamiliarityTransitionCount{},
+	}
+
+	for _, modelType := range modelTypes {
+		elementType := reflect.TypeOf(modelType).Elem()
+
+		var columns []string
+		var values []driver.Value
+		for i := 0; i < elementType.NumField(); i++ {
+			field := elementType.Field(i)
+			dbTag := field.Tag.Get("db")
+			if dbTag == "" || dbTag == "-" {
+				continue
+			}
+			columns = append(columns, dbTag)
+			values = append(values, sampleColumnValue(field.Type))
+		}
+
+		db, mock, err := sqlmock.New()
+		if err != nil {
+			suite.t.Fatalf("Failed to create sqlmock: %v", err)
+		}
+		mock.ExpectQuery("SELECT").WillReturnRows(sqlmock.NewRows(columns).AddRow(values...))
+
+		sqlRows, err := db.Query("SELECT * FROM test_table")
+		if err != nil {
+			suite.t.Fatalf("Failed to execute query: %v", err)
+		}
+
+		dest := reflect.New(reflect.TypeOf(modelType))
+		if err := scanToStruct(sqlRows, dest.Interface()); err != nil {
+			suite.t.Errorf("%s: scanToStruct() returned error: %v", elementType.Name(), err)
+		} else if dest.Elem().Len() != 1 {
+			suite.t.Errorf("%s: expected 1 result, got %d", elementType.Name(), dest.Elem().Len())
+		} else {
+			elem := dest.Elem().Index(0)
+			for i := 0; i < elementType.NumField(); i++ {
+				field := elementType.Field(i)
+				if dbTag := field.Tag.Get("db"); dbTag == "" || dbTag == "-" {
+					continue
+				}
+				if elem.Field(i).IsZero() {
+					suite.t.Errorf("%s.%s was not scanned from column %s", elementType.Name(), field.Name, field.Tag.Get("db"))
+				}
+			}
+		}
+
+		sqlRows.Close()
+		db.Close()
+	}
+}
+
+// sampleColumnValue returns a non-NULL driver value scannable into the given field type
+func sampleColumnValue(fieldType reflect.Type) driver.Value {
+	if fieldType.Kind() == reflect.Pointer {
+		fieldType = fieldType.Elem()
+	}
+	switch fieldType.Kind() {
+	case reflect.Bool:
+		return true
+	case reflect.Int, reflect.Int64:
+		return int64(1)
+	case reflect.Float64:
+		return 1.5
+	case reflect.String:
+		return "value"
+	}
+	if fieldType == reflect.TypeOf(time.Time{}) {
+		return time.Date(2024, 1, 15, 10, 0, 0, 0, time.UTC)
+	}
+	return nil
+}
+
 // Test error wrapping for database errors
 func (suite *databaseTestSuite) TestNewDatabaseError() {
 	operation := "test_operation"
@@ -9,3 +9,16 @@ func IntPtr(i int) *int {
 func StrPtr(s string) *string {
 	return &s
 }
+
+// BoolPtr returns a pointer to the given bool
+func BoolPtr(b bool) *bool {
+	return &b
+}
+
+// DerefStr returns the string p points to, or "" when p is nil
+func DerefStr(p *string) string {
+	if p == nil {
+		return ""
+	}
+	return *p
+}
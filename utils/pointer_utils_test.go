@@ -90,3 +90,18 @@ func (ps *PointerUtilsTestSuite) TestStrPtr() {
 		})
 	}
 }
+
+// TestBoolPtr tests the BoolPtr utility function
+func (ps *PointerUtilsTestSuite) TestBoolPtr() {
+	for _, input := range []bool{true, false} {
+		result := BoolPtr(input)
+		ps.NotNil(result, "BoolPtr should return a non-nil pointer")
+		ps.Equal(input, *result, "dereferenced value should match input")
+	}
+}
+
+// TestDerefStr tests the DerefStr utility function
+func (ps *PointerUtilsTestSuite) TestDerefStr() {
+	ps.Equal("hello", DerefStr(StrPtr("hello")))
+	ps.Equal("", DerefStr(nil))
+}
@@ -0,0 +1,31 @@
+package utils
+
+import "strings"
+
+// secretQueryParams are the query parameters whose values must never reach
+// the logs, such as the calendar feed's token
+var secretQueryParams = []string{"token"}
+
+// RedactQuery replaces the value of every secret query parameter in
+// rawQuery with REDACTED, leaving the rest of the query as it was
+func RedactQuery(rawQuery string) string {
+	params := strings.Split(rawQuery, "&")
+	for i, param := range params {
+		key, _, hasValue := strings.Cut(param, "=")
+		for _, secret := range secretQueryParams {
+			if hasValue && key == secret {
+				params[i] = key + "=REDACTED"
+			}
+		}
+	}
+	return strings.Join(params, "&")
+}
+
+// RedactURI redacts the query of a request URI (see RedactQuery)
+func RedactURI(uri string) string {
+	path, rawQuery, ok := strings.Cut(uri, "?")
+	if !ok {
+		return uri
+	}
+	return path + "?" + RedactQuery(rawQuery)
+}
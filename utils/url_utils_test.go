@@ -0,0 +1,53 @@
+package utils
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+)
+
+// URLUtilsTestSuite contains all URL utility related tests
+type URLUtilsTestSuite struct {
+	suite.Suite
+}
+
+// TestURLUtilsTestSuite runs all URL utility tests using the test suite
+func TestURLUtilsTestSuite(t *testing.T) {
+	suite.Run(t, new(URLUtilsTestSuite))
+}
+
+// TestRedactURI tests that only the values of secret query parameters are replaced
+func (us *URLUtilsTestSuite) TestRedactURI() {
+	testCases := []struct {
+		name     string
+		input    string
+		expected string
+	}{
+		{
+			name:     "token among other parameters",
+			input:    "/api/calendar.ics?days=7&token=s3cret&tz=Asia/Taipei",
+			expected: "/api/calendar.ics?days=7&token=REDACTED&tz=Asia/Taipei",
+		},
+		{
+			name:     "parameter merely named like a secret",
+			input:    "/api/words?tokens=1&mytoken=2",
+			expected: "/api/words?tokens=1&mytoken=2",
+		},
+		{
+			name:     "empty token",
+			input:    "/api/calendar.ics?token=",
+			expected: "/api/calendar.ics?token=REDACTED",
+		},
+		{
+			name:     "no query",
+			input:    "/api/health",
+			expected: "/api/health",
+		},
+	}
+
+	for _, tc := range testCases {
+		us.Run(tc.name, func() {
+			us.Equal(tc.expected, RedactURI(tc.input))
+		})
+	}
+}